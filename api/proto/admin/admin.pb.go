@@ -60,11 +60,11 @@ func (*GetSystemStatsRequest) Descriptor() ([]byte, []int) {
 
 type GetSystemStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalUsers    int64                  `protobuf:"varint,1,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`          // 总用户数
-	ActiveUsers   int64                  `protobuf:"varint,2,opt,name=active_users,json=activeUsers,proto3" json:"active_users,omitempty"`       // 活跃用户数（近7天）
-	TotalGroups   int64                  `protobuf:"varint,3,opt,name=total_groups,json=totalGroups,proto3" json:"total_groups,omitempty"`       // 总群组数
-	TotalMessages int64                  `protobuf:"varint,4,opt,name=total_messages,json=totalMessages,proto3" json:"total_messages,omitempty"` // 总消息数
-	BannedUsers   int64                  `protobuf:"varint,5,opt,name=banned_users,json=bannedUsers,proto3" json:"banned_users,omitempty"`       // 封禁用户数
+	TotalUsers    int64                  `protobuf:"varint,1,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`          // total users
+	ActiveUsers   int64                  `protobuf:"varint,2,opt,name=active_users,json=activeUsers,proto3" json:"active_users,omitempty"`       // active users (last 7 days)
+	TotalGroups   int64                  `protobuf:"varint,3,opt,name=total_groups,json=totalGroups,proto3" json:"total_groups,omitempty"`       // total groups
+	TotalMessages int64                  `protobuf:"varint,4,opt,name=total_messages,json=totalMessages,proto3" json:"total_messages,omitempty"` // total messages
+	BannedUsers   int64                  `protobuf:"varint,5,opt,name=banned_users,json=bannedUsers,proto3" json:"banned_users,omitempty"`       // banned users
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -230,6 +230,50 @@ func (x *UnbanUserRequest) GetUserId() string {
 	return ""
 }
 
+type UnlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRequest) Reset() {
+	*x = UnlockUserRequest{}
+	mi := &file_admin_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRequest) ProtoMessage() {}
+
+func (x *UnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 var File_admin_admin_proto protoreflect.FileDescriptor
 
 const file_admin_admin_proto_rawDesc = "" +
@@ -247,11 +291,15 @@ const file_admin_admin_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"+\n" +
 	"\x10UnbanUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId2\xf3\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\",\n" +
+	"\x11UnlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId2\xba\x02\n" +
 	"\fAdminService\x12]\n" +
 	"\x0eGetSystemStats\x12$.anychat.admin.GetSystemStatsRequest\x1a%.anychat.admin.GetSystemStatsResponse\x12?\n" +
 	"\aBanUser\x12\x1d.anychat.admin.BanUserRequest\x1a\x15.anychat.common.Empty\x12C\n" +
-	"\tUnbanUser\x12\x1f.anychat.admin.UnbanUserRequest\x1a\x15.anychat.common.EmptyB3Z1github.com/anychat/server/api/proto/admin;adminpbb\x06proto3"
+	"\tUnbanUser\x12\x1f.anychat.admin.UnbanUserRequest\x1a\x15.anychat.common.Empty\x12E\n" +
+	"\n" +
+	"UnlockUser\x12 .anychat.admin.UnlockUserRequest\x1a\x15.anychat.common.EmptyB3Z1github.com/anychat/server/api/proto/admin;adminpbb\x06proto3"
 
 var (
 	file_admin_admin_proto_rawDescOnce sync.Once
@@ -265,23 +313,26 @@ func file_admin_admin_proto_rawDescGZIP() []byte {
 	return file_admin_admin_proto_rawDescData
 }
 
-var file_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_admin_admin_proto_goTypes = []any{
 	(*GetSystemStatsRequest)(nil),  // 0: anychat.admin.GetSystemStatsRequest
 	(*GetSystemStatsResponse)(nil), // 1: anychat.admin.GetSystemStatsResponse
 	(*BanUserRequest)(nil),         // 2: anychat.admin.BanUserRequest
 	(*UnbanUserRequest)(nil),       // 3: anychat.admin.UnbanUserRequest
-	(*common.Empty)(nil),           // 4: anychat.common.Empty
+	(*UnlockUserRequest)(nil),      // 4: anychat.admin.UnlockUserRequest
+	(*common.Empty)(nil),           // 5: anychat.common.Empty
 }
 var file_admin_admin_proto_depIdxs = []int32{
 	0, // 0: anychat.admin.AdminService.GetSystemStats:input_type -> anychat.admin.GetSystemStatsRequest
 	2, // 1: anychat.admin.AdminService.BanUser:input_type -> anychat.admin.BanUserRequest
 	3, // 2: anychat.admin.AdminService.UnbanUser:input_type -> anychat.admin.UnbanUserRequest
-	1, // 3: anychat.admin.AdminService.GetSystemStats:output_type -> anychat.admin.GetSystemStatsResponse
-	4, // 4: anychat.admin.AdminService.BanUser:output_type -> anychat.common.Empty
-	4, // 5: anychat.admin.AdminService.UnbanUser:output_type -> anychat.common.Empty
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	4, // 3: anychat.admin.AdminService.UnlockUser:input_type -> anychat.admin.UnlockUserRequest
+	1, // 4: anychat.admin.AdminService.GetSystemStats:output_type -> anychat.admin.GetSystemStatsResponse
+	5, // 5: anychat.admin.AdminService.BanUser:output_type -> anychat.common.Empty
+	5, // 6: anychat.admin.AdminService.UnbanUser:output_type -> anychat.common.Empty
+	5, // 7: anychat.admin.AdminService.UnlockUser:output_type -> anychat.common.Empty
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -298,7 +349,7 @@ func file_admin_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_admin_proto_rawDesc), len(file_admin_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
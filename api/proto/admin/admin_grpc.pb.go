@@ -23,20 +23,23 @@ const (
 	AdminService_GetSystemStats_FullMethodName = "/anychat.admin.AdminService/GetSystemStats"
 	AdminService_BanUser_FullMethodName        = "/anychat.admin.AdminService/BanUser"
 	AdminService_UnbanUser_FullMethodName      = "/anychat.admin.AdminService/UnbanUser"
+	AdminService_UnlockUser_FullMethodName     = "/anychat.admin.AdminService/UnlockUser"
 )
 
 // AdminServiceClient is the client API for AdminService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// AdminService 管理后台服务
+// AdminService admin backend service
 type AdminServiceClient interface {
-	// GetSystemStats 获取系统统计概览
+	// GetSystemStats get system statistics overview
 	GetSystemStats(ctx context.Context, in *GetSystemStatsRequest, opts ...grpc.CallOption) (*GetSystemStatsResponse, error)
-	// BanUser 封禁用户
+	// BanUser ban user
 	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// UnbanUser 解封用户
+	// UnbanUser unban user
 	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// UnlockUser lift a login lockout
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*common.Empty, error)
 }
 
 type adminServiceClient struct {
@@ -77,18 +80,30 @@ func (c *adminServiceClient) UnbanUser(ctx context.Context, in *UnbanUserRequest
 	return out, nil
 }
 
+func (c *adminServiceClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, AdminService_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
 //
-// AdminService 管理后台服务
+// AdminService admin backend service
 type AdminServiceServer interface {
-	// GetSystemStats 获取系统统计概览
+	// GetSystemStats get system statistics overview
 	GetSystemStats(context.Context, *GetSystemStatsRequest) (*GetSystemStatsResponse, error)
-	// BanUser 封禁用户
+	// BanUser ban user
 	BanUser(context.Context, *BanUserRequest) (*common.Empty, error)
-	// UnbanUser 解封用户
+	// UnbanUser unban user
 	UnbanUser(context.Context, *UnbanUserRequest) (*common.Empty, error)
+	// UnlockUser lift a login lockout
+	UnlockUser(context.Context, *UnlockUserRequest) (*common.Empty, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -108,6 +123,9 @@ func (UnimplementedAdminServiceServer) BanUser(context.Context, *BanUserRequest)
 func (UnimplementedAdminServiceServer) UnbanUser(context.Context, *UnbanUserRequest) (*common.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UnbanUser not implemented")
 }
+func (UnimplementedAdminServiceServer) UnlockUser(context.Context, *UnlockUserRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockUser not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -183,6 +201,24 @@ func _AdminService_UnbanUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +238,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnbanUser",
 			Handler:    _AdminService_UnbanUser_Handler,
 		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _AdminService_UnlockUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/admin.proto",
@@ -140,6 +140,7 @@ const (
 	VerificationPurpose_VERIFICATION_PURPOSE_CHANGE_PHONE   VerificationPurpose = 5
 	VerificationPurpose_VERIFICATION_PURPOSE_BIND_EMAIL     VerificationPurpose = 6
 	VerificationPurpose_VERIFICATION_PURPOSE_CHANGE_EMAIL   VerificationPurpose = 7
+	VerificationPurpose_VERIFICATION_PURPOSE_DELETE_ACCOUNT VerificationPurpose = 8
 )
 
 // Enum value maps for VerificationPurpose.
@@ -153,6 +154,7 @@ var (
 		5: "VERIFICATION_PURPOSE_CHANGE_PHONE",
 		6: "VERIFICATION_PURPOSE_BIND_EMAIL",
 		7: "VERIFICATION_PURPOSE_CHANGE_EMAIL",
+		8: "VERIFICATION_PURPOSE_DELETE_ACCOUNT",
 	}
 	VerificationPurpose_value = map[string]int32{
 		"VERIFICATION_PURPOSE_UNSPECIFIED":    0,
@@ -163,6 +165,7 @@ var (
 		"VERIFICATION_PURPOSE_CHANGE_PHONE":   5,
 		"VERIFICATION_PURPOSE_BIND_EMAIL":     6,
 		"VERIFICATION_PURPOSE_CHANGE_EMAIL":   7,
+		"VERIFICATION_PURPOSE_DELETE_ACCOUNT": 8,
 	}
 )
 
@@ -193,12 +196,177 @@ func (VerificationPurpose) EnumDescriptor() ([]byte, []int) {
 	return file_auth_auth_proto_rawDescGZIP(), []int{2}
 }
 
-// SendVerificationCodeRequest 发送验证码请求
+type QRLoginStatus int32
+
+const (
+	QRLoginStatus_QR_LOGIN_STATUS_UNSPECIFIED QRLoginStatus = 0
+	QRLoginStatus_QR_LOGIN_STATUS_PENDING     QRLoginStatus = 1 // waiting for scan
+	QRLoginStatus_QR_LOGIN_STATUS_SCANNED     QRLoginStatus = 2 // scanned by mobile, waiting for confirmation
+	QRLoginStatus_QR_LOGIN_STATUS_CONFIRMED   QRLoginStatus = 3 // confirmed, login tokens returned
+	QRLoginStatus_QR_LOGIN_STATUS_CANCELLED   QRLoginStatus = 4 // rejected by mobile
+	QRLoginStatus_QR_LOGIN_STATUS_EXPIRED     QRLoginStatus = 5
+)
+
+// Enum value maps for QRLoginStatus.
+var (
+	QRLoginStatus_name = map[int32]string{
+		0: "QR_LOGIN_STATUS_UNSPECIFIED",
+		1: "QR_LOGIN_STATUS_PENDING",
+		2: "QR_LOGIN_STATUS_SCANNED",
+		3: "QR_LOGIN_STATUS_CONFIRMED",
+		4: "QR_LOGIN_STATUS_CANCELLED",
+		5: "QR_LOGIN_STATUS_EXPIRED",
+	}
+	QRLoginStatus_value = map[string]int32{
+		"QR_LOGIN_STATUS_UNSPECIFIED": 0,
+		"QR_LOGIN_STATUS_PENDING":     1,
+		"QR_LOGIN_STATUS_SCANNED":     2,
+		"QR_LOGIN_STATUS_CONFIRMED":   3,
+		"QR_LOGIN_STATUS_CANCELLED":   4,
+		"QR_LOGIN_STATUS_EXPIRED":     5,
+	}
+)
+
+func (x QRLoginStatus) Enum() *QRLoginStatus {
+	p := new(QRLoginStatus)
+	*p = x
+	return p
+}
+
+func (x QRLoginStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QRLoginStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_auth_proto_enumTypes[3].Descriptor()
+}
+
+func (QRLoginStatus) Type() protoreflect.EnumType {
+	return &file_auth_auth_proto_enumTypes[3]
+}
+
+func (x QRLoginStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QRLoginStatus.Descriptor instead.
+func (QRLoginStatus) EnumDescriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{3}
+}
+
+type LoginResult int32
+
+const (
+	LoginResult_LOGIN_RESULT_UNSPECIFIED LoginResult = 0
+	LoginResult_LOGIN_RESULT_SUCCESS     LoginResult = 1
+	LoginResult_LOGIN_RESULT_FAILED      LoginResult = 2 // wrong password
+	LoginResult_LOGIN_RESULT_BLOCKED     LoginResult = 3 // lockout, delay, CAPTCHA or password reset required
+)
+
+// Enum value maps for LoginResult.
+var (
+	LoginResult_name = map[int32]string{
+		0: "LOGIN_RESULT_UNSPECIFIED",
+		1: "LOGIN_RESULT_SUCCESS",
+		2: "LOGIN_RESULT_FAILED",
+		3: "LOGIN_RESULT_BLOCKED",
+	}
+	LoginResult_value = map[string]int32{
+		"LOGIN_RESULT_UNSPECIFIED": 0,
+		"LOGIN_RESULT_SUCCESS":     1,
+		"LOGIN_RESULT_FAILED":      2,
+		"LOGIN_RESULT_BLOCKED":     3,
+	}
+)
+
+func (x LoginResult) Enum() *LoginResult {
+	p := new(LoginResult)
+	*p = x
+	return p
+}
+
+func (x LoginResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoginResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_auth_proto_enumTypes[4].Descriptor()
+}
+
+func (LoginResult) Type() protoreflect.EnumType {
+	return &file_auth_auth_proto_enumTypes[4]
+}
+
+func (x LoginResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoginResult.Descriptor instead.
+func (LoginResult) EnumDescriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{4}
+}
+
+type AccountDeletionStatus int32
+
+const (
+	AccountDeletionStatus_ACCOUNT_DELETION_STATUS_UNSPECIFIED AccountDeletionStatus = 0
+	AccountDeletionStatus_ACCOUNT_DELETION_STATUS_PENDING     AccountDeletionStatus = 1 // in the grace period, logging in cancels it
+	AccountDeletionStatus_ACCOUNT_DELETION_STATUS_ERASING     AccountDeletionStatus = 2 // grace period over, data is being erased
+	AccountDeletionStatus_ACCOUNT_DELETION_STATUS_COMPLETED   AccountDeletionStatus = 3
+	AccountDeletionStatus_ACCOUNT_DELETION_STATUS_CANCELLED   AccountDeletionStatus = 4
+)
+
+// Enum value maps for AccountDeletionStatus.
+var (
+	AccountDeletionStatus_name = map[int32]string{
+		0: "ACCOUNT_DELETION_STATUS_UNSPECIFIED",
+		1: "ACCOUNT_DELETION_STATUS_PENDING",
+		2: "ACCOUNT_DELETION_STATUS_ERASING",
+		3: "ACCOUNT_DELETION_STATUS_COMPLETED",
+		4: "ACCOUNT_DELETION_STATUS_CANCELLED",
+	}
+	AccountDeletionStatus_value = map[string]int32{
+		"ACCOUNT_DELETION_STATUS_UNSPECIFIED": 0,
+		"ACCOUNT_DELETION_STATUS_PENDING":     1,
+		"ACCOUNT_DELETION_STATUS_ERASING":     2,
+		"ACCOUNT_DELETION_STATUS_COMPLETED":   3,
+		"ACCOUNT_DELETION_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x AccountDeletionStatus) Enum() *AccountDeletionStatus {
+	p := new(AccountDeletionStatus)
+	*p = x
+	return p
+}
+
+func (x AccountDeletionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountDeletionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_auth_proto_enumTypes[5].Descriptor()
+}
+
+func (AccountDeletionStatus) Type() protoreflect.EnumType {
+	return &file_auth_auth_proto_enumTypes[5]
+}
+
+func (x AccountDeletionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountDeletionStatus.Descriptor instead.
+func (AccountDeletionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{5}
+}
+
+// SendVerificationCodeRequest send verification code request
 type SendVerificationCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`                                                                     // 手机号或邮箱
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`                                                                     // phone number or email
 	TargetType    VerificationTargetType `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=anychat.auth.VerificationTargetType" json:"target_type,omitempty"` // 1-sms 2-email
-	Purpose       VerificationPurpose    `protobuf:"varint,3,opt,name=purpose,proto3,enum=anychat.auth.VerificationPurpose" json:"purpose,omitempty"`                            // 1-register 2-login 3-reset_password 4-bind_phone 5-change_phone 6-bind_email 7-change_email
+	Purpose       VerificationPurpose    `protobuf:"varint,3,opt,name=purpose,proto3,enum=anychat.auth.VerificationPurpose" json:"purpose,omitempty"`                            // 1-register 2-login 3-reset_password 4-bind_phone 5-change_phone 6-bind_email 7-change_email 8-delete_account
 	DeviceId      string                 `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	IpAddress     string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -270,7 +438,7 @@ func (x *SendVerificationCodeRequest) GetIpAddress() string {
 	return ""
 }
 
-// SendVerificationCodeResponse 发送验证码响应
+// SendVerificationCodeResponse send verification code response
 type SendVerificationCodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CodeId        string                 `protobuf:"bytes,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
@@ -323,7 +491,7 @@ func (x *SendVerificationCodeResponse) GetExpiresIn() int64 {
 	return 0
 }
 
-// RegisterRequest 注册请求
+// RegisterRequest registration request
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PhoneNumber   *string                `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3,oneof" json:"phone_number,omitempty"`
@@ -333,7 +501,7 @@ type RegisterRequest struct {
 	Nickname      *string                `protobuf:"bytes,5,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
 	DeviceType    DeviceType             `protobuf:"varint,6,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"` // 1-ios 2-android 3-web 4-pc 5-h5
 	DeviceId      string                 `protobuf:"bytes,7,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	ClientVersion string                 `protobuf:"bytes,8,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"` // 客户端版本号，用于客户端升级判断
+	ClientVersion string                 `protobuf:"bytes,8,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"` // client version, used for upgrade checks
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -424,7 +592,7 @@ func (x *RegisterRequest) GetClientVersion() string {
 	return ""
 }
 
-// RegisterResponse 注册响应
+// RegisterResponse registration response
 type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -493,15 +661,17 @@ func (x *RegisterResponse) GetExpiresIn() int64 {
 	return 0
 }
 
-// LoginRequest 登录请求
+// LoginRequest login request
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"` // 手机号或邮箱
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"` // phone number or email
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	DeviceType    DeviceType             `protobuf:"varint,3,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"` // 1-ios 2-android 3-web 4-pc 5-h5
 	DeviceId      string                 `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	ClientVersion string                 `protobuf:"bytes,5,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"` // 客户端版本号，用于客户端升级判断
-	IpAddress     string                 `protobuf:"bytes,6,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`             // 客户端IP地址
+	ClientVersion string                 `protobuf:"bytes,5,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`    // client version, used for upgrade checks
+	IpAddress     string                 `protobuf:"bytes,6,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`                // client IP address
+	CaptchaToken  *string                `protobuf:"bytes,7,opt,name=captcha_token,json=captchaToken,proto3,oneof" json:"captcha_token,omitempty"` // required once repeated failures trigger a CAPTCHA challenge
+	UserAgent     string                 `protobuf:"bytes,8,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`                // client User-Agent, kept in login history
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -578,7 +748,130 @@ func (x *LoginRequest) GetIpAddress() string {
 	return ""
 }
 
-// LoginResponse 登录响应
+func (x *LoginRequest) GetCaptchaToken() string {
+	if x != nil && x.CaptchaToken != nil {
+		return *x.CaptchaToken
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+// LoginByCodeRequest verification code login request
+type LoginByCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`                                                                     // phone number or email
+	TargetType    VerificationTargetType `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=anychat.auth.VerificationTargetType" json:"target_type,omitempty"` // 1-sms 2-email
+	VerifyCode    string                 `protobuf:"bytes,3,opt,name=verify_code,json=verifyCode,proto3" json:"verify_code,omitempty"`
+	DeviceType    DeviceType             `protobuf:"varint,4,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"` // 1-ios 2-android 3-web 4-pc 5-h5
+	DeviceId      string                 `protobuf:"bytes,5,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	ClientVersion string                 `protobuf:"bytes,6,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"` // client version, used for upgrade checks
+	IpAddress     string                 `protobuf:"bytes,7,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`             // client IP address
+	Nickname      *string                `protobuf:"bytes,8,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`                          // used only when an unknown phone number is auto-registered
+	UserAgent     string                 `protobuf:"bytes,9,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`             // client User-Agent, kept in login history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginByCodeRequest) Reset() {
+	*x = LoginByCodeRequest{}
+	mi := &file_auth_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginByCodeRequest) ProtoMessage() {}
+
+func (x *LoginByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginByCodeRequest.ProtoReflect.Descriptor instead.
+func (*LoginByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LoginByCodeRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetTargetType() VerificationTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return VerificationTargetType_VERIFICATION_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *LoginByCodeRequest) GetVerifyCode() string {
+	if x != nil {
+		return x.VerifyCode
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetDeviceType() DeviceType {
+	if x != nil {
+		return x.DeviceType
+	}
+	return DeviceType_DEVICE_TYPE_UNSPECIFIED
+}
+
+func (x *LoginByCodeRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+// LoginResponse login response
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -586,13 +879,15 @@ type LoginResponse struct {
 	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	ExpiresIn     int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 	User          *common.UserInfo       `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,6,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"` // true when a second factor is needed, tokens are empty
+	MfaToken      string                 `protobuf:"bytes,7,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`           // challenge token for VerifyLoginMFA
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_auth_auth_proto_msgTypes[5]
+	mi := &file_auth_auth_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -604,7 +899,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[5]
+	mi := &file_auth_auth_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +912,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{5}
+	return file_auth_auth_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LoginResponse) GetUserId() string {
@@ -655,10 +950,24 @@ func (x *LoginResponse) GetUser() *common.UserInfo {
 	return nil
 }
 
-// LogoutRequest 登出请求
+func (x *LoginResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+// LogoutRequest logout request
 type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 由gateway从JWT提取
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
 	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -666,7 +975,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_auth_auth_proto_msgTypes[6]
+	mi := &file_auth_auth_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -678,7 +987,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[6]
+	mi := &file_auth_auth_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +1000,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{6}
+	return file_auth_auth_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LogoutRequest) GetUserId() string {
@@ -708,7 +1017,7 @@ func (x *LogoutRequest) GetDeviceId() string {
 	return ""
 }
 
-// RefreshTokenRequest 刷新Token请求
+// RefreshTokenRequest refresh token request
 type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
@@ -718,7 +1027,7 @@ type RefreshTokenRequest struct {
 
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
-	mi := &file_auth_auth_proto_msgTypes[7]
+	mi := &file_auth_auth_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -730,7 +1039,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[7]
+	mi := &file_auth_auth_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +1052,7 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{7}
+	return file_auth_auth_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RefreshTokenRequest) GetRefreshToken() string {
@@ -753,7 +1062,7 @@ func (x *RefreshTokenRequest) GetRefreshToken() string {
 	return ""
 }
 
-// RefreshTokenResponse 刷新Token响应
+// RefreshTokenResponse refresh token response
 type RefreshTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
@@ -765,7 +1074,7 @@ type RefreshTokenResponse struct {
 
 func (x *RefreshTokenResponse) Reset() {
 	*x = RefreshTokenResponse{}
-	mi := &file_auth_auth_proto_msgTypes[8]
+	mi := &file_auth_auth_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -777,7 +1086,7 @@ func (x *RefreshTokenResponse) String() string {
 func (*RefreshTokenResponse) ProtoMessage() {}
 
 func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[8]
+	mi := &file_auth_auth_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1099,7 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{8}
+	return file_auth_auth_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RefreshTokenResponse) GetAccessToken() string {
@@ -814,20 +1123,21 @@ func (x *RefreshTokenResponse) GetExpiresIn() int64 {
 	return 0
 }
 
-// ChangePasswordRequest 修改密码请求
+// ChangePasswordRequest change password request
 type ChangePasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 由gateway从JWT提取
-	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 当前设备ID，用于排除强制下线
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // extracted from JWT by gateway
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // current device ID, used to exclude forced logout
 	OldPassword   string                 `protobuf:"bytes,3,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
 	NewPassword   string                 `protobuf:"bytes,4,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	MfaCode       *string                `protobuf:"bytes,5,opt,name=mfa_code,json=mfaCode,proto3,oneof" json:"mfa_code,omitempty"` // required when MFA is enabled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChangePasswordRequest) Reset() {
 	*x = ChangePasswordRequest{}
-	mi := &file_auth_auth_proto_msgTypes[9]
+	mi := &file_auth_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -839,7 +1149,7 @@ func (x *ChangePasswordRequest) String() string {
 func (*ChangePasswordRequest) ProtoMessage() {}
 
 func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[9]
+	mi := &file_auth_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +1162,7 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{9}
+	return file_auth_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ChangePasswordRequest) GetUserId() string {
@@ -883,19 +1193,26 @@ func (x *ChangePasswordRequest) GetNewPassword() string {
 	return ""
 }
 
-// ResetPasswordRequest 重置密码请求
+func (x *ChangePasswordRequest) GetMfaCode() string {
+	if x != nil && x.MfaCode != nil {
+		return *x.MfaCode
+	}
+	return ""
+}
+
+// ResetPasswordRequest reset password request
 type ResetPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`                            // 手机号或邮箱
-	VerifyCode    string                 `protobuf:"bytes,2,opt,name=verify_code,json=verifyCode,proto3" json:"verify_code,omitempty"`    // 验证码
-	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // 新密码
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`                            // phone number or email
+	VerifyCode    string                 `protobuf:"bytes,2,opt,name=verify_code,json=verifyCode,proto3" json:"verify_code,omitempty"`    // verification code
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // new password
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResetPasswordRequest) Reset() {
 	*x = ResetPasswordRequest{}
-	mi := &file_auth_auth_proto_msgTypes[10]
+	mi := &file_auth_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -907,7 +1224,7 @@ func (x *ResetPasswordRequest) String() string {
 func (*ResetPasswordRequest) ProtoMessage() {}
 
 func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[10]
+	mi := &file_auth_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,7 +1237,7 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{10}
+	return file_auth_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ResetPasswordRequest) GetAccount() string {
@@ -944,29 +1261,30 @@ func (x *ResetPasswordRequest) GetNewPassword() string {
 	return ""
 }
 
-// ValidateTokenRequest 验证Token请求
-type ValidateTokenRequest struct {
+// IssueAdminTokenRequest issue admin token request
+type IssueAdminTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Role          int32                  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"` // admin role, carried in the deviceType claim
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateTokenRequest) Reset() {
-	*x = ValidateTokenRequest{}
-	mi := &file_auth_auth_proto_msgTypes[11]
+func (x *IssueAdminTokenRequest) Reset() {
+	*x = IssueAdminTokenRequest{}
+	mi := &file_auth_auth_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateTokenRequest) String() string {
+func (x *IssueAdminTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateTokenRequest) ProtoMessage() {}
+func (*IssueAdminTokenRequest) ProtoMessage() {}
 
-func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[11]
+func (x *IssueAdminTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,44 +1295,49 @@ func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateTokenRequest.ProtoReflect.Descriptor instead.
-func (*ValidateTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use IssueAdminTokenRequest.ProtoReflect.Descriptor instead.
+func (*IssueAdminTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ValidateTokenRequest) GetAccessToken() string {
+func (x *IssueAdminTokenRequest) GetAdminId() string {
 	if x != nil {
-		return x.AccessToken
+		return x.AdminId
 	}
 	return ""
 }
 
-// ValidateTokenResponse 验证Token响应
-type ValidateTokenResponse struct {
+func (x *IssueAdminTokenRequest) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+// IssueAdminTokenResponse issue admin token response
+type IssueAdminTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	DeviceType    DeviceType             `protobuf:"varint,4,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // aud=anychat-admin, rejected by the user API
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`      // seconds
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateTokenResponse) Reset() {
-	*x = ValidateTokenResponse{}
-	mi := &file_auth_auth_proto_msgTypes[12]
+func (x *IssueAdminTokenResponse) Reset() {
+	*x = IssueAdminTokenResponse{}
+	mi := &file_auth_auth_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateTokenResponse) String() string {
+func (x *IssueAdminTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateTokenResponse) ProtoMessage() {}
+func (*IssueAdminTokenResponse) ProtoMessage() {}
 
-func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[12]
+func (x *IssueAdminTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,37 +1348,2397 @@ func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateTokenResponse.ProtoReflect.Descriptor instead.
-func (*ValidateTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use IssueAdminTokenResponse.ProtoReflect.Descriptor instead.
+func (*IssueAdminTokenResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *IssueAdminTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *IssueAdminTokenResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// ValidateTokenRequest validate token request
+type ValidateTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTokenRequest) Reset() {
+	*x = ValidateTokenRequest{}
+	mi := &file_auth_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTokenRequest) ProtoMessage() {}
+
+func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTokenRequest.ProtoReflect.Descriptor instead.
+func (*ValidateTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ValidateTokenRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+// ValidateTokenResponse validate token response
+type ValidateTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	DeviceType    DeviceType             `protobuf:"varint,4,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTokenResponse) Reset() {
+	*x = ValidateTokenResponse{}
+	mi := &file_auth_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTokenResponse) ProtoMessage() {}
+
+func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTokenResponse.ProtoReflect.Descriptor instead.
+func (*ValidateTokenResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ValidateTokenResponse) GetValid() bool {
 	if x != nil {
-		return x.Valid
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateTokenResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ValidateTokenResponse) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ValidateTokenResponse) GetDeviceType() DeviceType {
+	if x != nil {
+		return x.DeviceType
+	}
+	return DeviceType_DEVICE_TYPE_UNSPECIFIED
+}
+
+// CreateQRLoginTicketRequest create QR login ticket request
+type CreateQRLoginTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceType    DeviceType             `protobuf:"varint,1,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"` // 3-web 4-pc 5-h5
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	ClientVersion string                 `protobuf:"bytes,3,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"` // desktop User-Agent, kept in login history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQRLoginTicketRequest) Reset() {
+	*x = CreateQRLoginTicketRequest{}
+	mi := &file_auth_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQRLoginTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQRLoginTicketRequest) ProtoMessage() {}
+
+func (x *CreateQRLoginTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQRLoginTicketRequest.ProtoReflect.Descriptor instead.
+func (*CreateQRLoginTicketRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateQRLoginTicketRequest) GetDeviceType() DeviceType {
+	if x != nil {
+		return x.DeviceType
+	}
+	return DeviceType_DEVICE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateQRLoginTicketRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CreateQRLoginTicketRequest) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *CreateQRLoginTicketRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *CreateQRLoginTicketRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+// CreateQRLoginTicketResponse create QR login ticket response
+type CreateQRLoginTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        string                 `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	QrContent     string                 `protobuf:"bytes,2,opt,name=qr_content,json=qrContent,proto3" json:"qr_content,omitempty"`    // content to render as QR code
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`   // seconds
+	PollSecret    string                 `protobuf:"bytes,4,opt,name=poll_secret,json=pollSecret,proto3" json:"poll_secret,omitempty"` // required when polling status, only returned here
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQRLoginTicketResponse) Reset() {
+	*x = CreateQRLoginTicketResponse{}
+	mi := &file_auth_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQRLoginTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQRLoginTicketResponse) ProtoMessage() {}
+
+func (x *CreateQRLoginTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQRLoginTicketResponse.ProtoReflect.Descriptor instead.
+func (*CreateQRLoginTicketResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateQRLoginTicketResponse) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *CreateQRLoginTicketResponse) GetQrContent() string {
+	if x != nil {
+		return x.QrContent
+	}
+	return ""
+}
+
+func (x *CreateQRLoginTicketResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *CreateQRLoginTicketResponse) GetPollSecret() string {
+	if x != nil {
+		return x.PollSecret
+	}
+	return ""
+}
+
+// GetQRLoginStatusRequest QR login status request
+type GetQRLoginStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        string                 `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`                                        // must match the device that created the ticket
+	LastStatus    QRLoginStatus          `protobuf:"varint,3,opt,name=last_status,json=lastStatus,proto3,enum=anychat.auth.QRLoginStatus" json:"last_status,omitempty"` // status already known by the client, returns once it changes
+	WaitSeconds   int32                  `protobuf:"varint,4,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`                              // long-poll wait, max 30
+	PollSecret    string                 `protobuf:"bytes,5,opt,name=poll_secret,json=pollSecret,proto3" json:"poll_secret,omitempty"`                                  // returned when the ticket was created
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQRLoginStatusRequest) Reset() {
+	*x = GetQRLoginStatusRequest{}
+	mi := &file_auth_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQRLoginStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQRLoginStatusRequest) ProtoMessage() {}
+
+func (x *GetQRLoginStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQRLoginStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetQRLoginStatusRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetQRLoginStatusRequest) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *GetQRLoginStatusRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *GetQRLoginStatusRequest) GetLastStatus() QRLoginStatus {
+	if x != nil {
+		return x.LastStatus
+	}
+	return QRLoginStatus_QR_LOGIN_STATUS_UNSPECIFIED
+}
+
+func (x *GetQRLoginStatusRequest) GetWaitSeconds() int32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+func (x *GetQRLoginStatusRequest) GetPollSecret() string {
+	if x != nil {
+		return x.PollSecret
+	}
+	return ""
+}
+
+// GetQRLoginStatusResponse QR login status response
+type GetQRLoginStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        QRLoginStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=anychat.auth.QRLoginStatus" json:"status,omitempty"`
+	Login         *LoginResponse         `protobuf:"bytes,2,opt,name=login,proto3,oneof" json:"login,omitempty"` // set when status is confirmed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQRLoginStatusResponse) Reset() {
+	*x = GetQRLoginStatusResponse{}
+	mi := &file_auth_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQRLoginStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQRLoginStatusResponse) ProtoMessage() {}
+
+func (x *GetQRLoginStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQRLoginStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetQRLoginStatusResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetQRLoginStatusResponse) GetStatus() QRLoginStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QRLoginStatus_QR_LOGIN_STATUS_UNSPECIFIED
+}
+
+func (x *GetQRLoginStatusResponse) GetLogin() *LoginResponse {
+	if x != nil {
+		return x.Login
+	}
+	return nil
+}
+
+// ScanQRLoginResponse desktop device waiting for confirmation
+type ScanQRLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceType    DeviceType             `protobuf:"varint,1,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"`
+	ClientVersion string                 `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanQRLoginResponse) Reset() {
+	*x = ScanQRLoginResponse{}
+	mi := &file_auth_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanQRLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanQRLoginResponse) ProtoMessage() {}
+
+func (x *ScanQRLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanQRLoginResponse.ProtoReflect.Descriptor instead.
+func (*ScanQRLoginResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ScanQRLoginResponse) GetDeviceType() DeviceType {
+	if x != nil {
+		return x.DeviceType
+	}
+	return DeviceType_DEVICE_TYPE_UNSPECIFIED
+}
+
+func (x *ScanQRLoginResponse) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *ScanQRLoginResponse) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ScanQRLoginResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// QRLoginActionRequest scan/confirm/cancel QR login request
+type QRLoginActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	DeviceType    DeviceType             `protobuf:"varint,3,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"`
+	Ticket        string                 `protobuf:"bytes,4,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QRLoginActionRequest) Reset() {
+	*x = QRLoginActionRequest{}
+	mi := &file_auth_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QRLoginActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QRLoginActionRequest) ProtoMessage() {}
+
+func (x *QRLoginActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QRLoginActionRequest.ProtoReflect.Descriptor instead.
+func (*QRLoginActionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QRLoginActionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QRLoginActionRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *QRLoginActionRequest) GetDeviceType() DeviceType {
+	if x != nil {
+		return x.DeviceType
+	}
+	return DeviceType_DEVICE_TYPE_UNSPECIFIED
+}
+
+func (x *QRLoginActionRequest) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *QRLoginActionRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+// VerifyLoginMFARequest complete login MFA challenge request
+type VerifyLoginMFARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // TOTP or recovery code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyLoginMFARequest) Reset() {
+	*x = VerifyLoginMFARequest{}
+	mi := &file_auth_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyLoginMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLoginMFARequest) ProtoMessage() {}
+
+func (x *VerifyLoginMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLoginMFARequest.ProtoReflect.Descriptor instead.
+func (*VerifyLoginMFARequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *VerifyLoginMFARequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyLoginMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// MFAUserRequest MFA request carrying only the user
+type MFAUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MFAUserRequest) Reset() {
+	*x = MFAUserRequest{}
+	mi := &file_auth_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAUserRequest) ProtoMessage() {}
+
+func (x *MFAUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAUserRequest.ProtoReflect.Descriptor instead.
+func (*MFAUserRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MFAUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// MFAStatusResponse MFA status response
+type MFAStatusResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Enabled                bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RemainingRecoveryCodes int64                  `protobuf:"varint,2,opt,name=remaining_recovery_codes,json=remainingRecoveryCodes,proto3" json:"remaining_recovery_codes,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MFAStatusResponse) Reset() {
+	*x = MFAStatusResponse{}
+	mi := &file_auth_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAStatusResponse) ProtoMessage() {}
+
+func (x *MFAStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAStatusResponse.ProtoReflect.Descriptor instead.
+func (*MFAStatusResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MFAStatusResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MFAStatusResponse) GetRemainingRecoveryCodes() int64 {
+	if x != nil {
+		return x.RemainingRecoveryCodes
+	}
+	return 0
+}
+
+// BeginMFAEnrollmentResponse TOTP enrollment response
+type BeginMFAEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`                           // base32 secret for manual entry
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"` // otpauth:// URI rendered as QR code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginMFAEnrollmentResponse) Reset() {
+	*x = BeginMFAEnrollmentResponse{}
+	mi := &file_auth_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginMFAEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginMFAEnrollmentResponse) ProtoMessage() {}
+
+func (x *BeginMFAEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginMFAEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*BeginMFAEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BeginMFAEnrollmentResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *BeginMFAEnrollmentResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+// ConfirmMFAEnrollmentRequest confirm TOTP enrollment request
+type ConfirmMFAEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmMFAEnrollmentRequest) Reset() {
+	*x = ConfirmMFAEnrollmentRequest{}
+	mi := &file_auth_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmMFAEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmMFAEnrollmentRequest) ProtoMessage() {}
+
+func (x *ConfirmMFAEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmMFAEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmMFAEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ConfirmMFAEnrollmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmMFAEnrollmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// MFARecoveryCodesResponse recovery codes, only returned once
+type MFARecoveryCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MFARecoveryCodesResponse) Reset() {
+	*x = MFARecoveryCodesResponse{}
+	mi := &file_auth_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFARecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFARecoveryCodesResponse) ProtoMessage() {}
+
+func (x *MFARecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFARecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*MFARecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MFARecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// MFAReauthRequest re-authentication for sensitive MFA changes
+type MFAReauthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"` // TOTP or recovery code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MFAReauthRequest) Reset() {
+	*x = MFAReauthRequest{}
+	mi := &file_auth_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAReauthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAReauthRequest) ProtoMessage() {}
+
+func (x *MFAReauthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAReauthRequest.ProtoReflect.Descriptor instead.
+func (*MFAReauthRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MFAReauthRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MFAReauthRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *MFAReauthRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// UnlockAccountRequest unlock account request
+type UnlockAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountRequest) Reset() {
+	*x = UnlockAccountRequest{}
+	mi := &file_auth_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountRequest) ProtoMessage() {}
+
+func (x *UnlockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnlockAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ListDevicesRequest list devices request
+type ListDevicesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // extracted from JWT by gateway
+	CurrentDeviceId string                 `protobuf:"bytes,2,opt,name=current_device_id,json=currentDeviceId,proto3" json:"current_device_id,omitempty"` // extracted from JWT by gateway, marks is_current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	mi := &file_auth_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesRequest) ProtoMessage() {}
+
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListDevicesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDevicesRequest) GetCurrentDeviceId() string {
+	if x != nil {
+		return x.CurrentDeviceId
+	}
+	return ""
+}
+
+// DeviceInfo device of the user
+type DeviceInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	DeviceType    DeviceType             `protobuf:"varint,2,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // user-defined name, empty until renamed
+	ClientVersion string                 `protobuf:"bytes,4,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	LastLoginAt   int64                  `protobuf:"varint,5,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"` // unix seconds, 0 if unknown
+	LastLoginIp   string                 `protobuf:"bytes,6,opt,name=last_login_ip,json=lastLoginIp,proto3" json:"last_login_ip,omitempty"`
+	LastActiveAt  int64                  `protobuf:"varint,7,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"` // last login or token refresh, unix seconds
+	IsCurrent     bool                   `protobuf:"varint,8,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	SignedIn      bool                   `protobuf:"varint,9,opt,name=signed_in,json=signedIn,proto3" json:"signed_in,omitempty"` // has a live session
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceInfo) Reset() {
+	*x = DeviceInfo{}
+	mi := &file_auth_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceInfo) ProtoMessage() {}
+
+func (x *DeviceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceInfo.ProtoReflect.Descriptor instead.
+func (*DeviceInfo) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeviceInfo) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetDeviceType() DeviceType {
+	if x != nil {
+		return x.DeviceType
+	}
+	return DeviceType_DEVICE_TYPE_UNSPECIFIED
+}
+
+func (x *DeviceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetLastLoginAt() int64 {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return 0
+}
+
+func (x *DeviceInfo) GetLastLoginIp() string {
+	if x != nil {
+		return x.LastLoginIp
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetLastActiveAt() int64 {
+	if x != nil {
+		return x.LastActiveAt
+	}
+	return 0
+}
+
+func (x *DeviceInfo) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+func (x *DeviceInfo) GetSignedIn() bool {
+	if x != nil {
+		return x.SignedIn
+	}
+	return false
+}
+
+// ListDevicesResponse list devices response
+type ListDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*DeviceInfo          `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	mi := &file_auth_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListDevicesResponse) GetDevices() []*DeviceInfo {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+// DeviceRequest device operation request
+type DeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // extracted from JWT by gateway
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // target device for LogoutDevice, current device for LogoutOtherDevices
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceRequest) Reset() {
+	*x = DeviceRequest{}
+	mi := &file_auth_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceRequest) ProtoMessage() {}
+
+func (x *DeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceRequest.ProtoReflect.Descriptor instead.
+func (*DeviceRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeviceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+// RenameDeviceRequest rename device request
+type RenameDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // 1-64 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameDeviceRequest) Reset() {
+	*x = RenameDeviceRequest{}
+	mi := &file_auth_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameDeviceRequest) ProtoMessage() {}
+
+func (x *RenameDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RenameDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RenameDeviceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RenameDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *RenameDeviceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// OIDCProviderInfo configured identity provider
+type OIDCProviderInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // used in URLs and as provider of linked identities
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCProviderInfo) Reset() {
+	*x = OIDCProviderInfo{}
+	mi := &file_auth_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCProviderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCProviderInfo) ProtoMessage() {}
+
+func (x *OIDCProviderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCProviderInfo.ProtoReflect.Descriptor instead.
+func (*OIDCProviderInfo) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *OIDCProviderInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OIDCProviderInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+// ListOIDCProvidersResponse identity provider list
+type ListOIDCProvidersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []*OIDCProviderInfo    `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOIDCProvidersResponse) Reset() {
+	*x = ListOIDCProvidersResponse{}
+	mi := &file_auth_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOIDCProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOIDCProvidersResponse) ProtoMessage() {}
+
+func (x *ListOIDCProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOIDCProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListOIDCProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListOIDCProvidersResponse) GetProviders() []*OIDCProviderInfo {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// BeginOIDCLoginRequest start an authorization
+type BeginOIDCLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	RedirectUri   string                 `protobuf:"bytes,2,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"` // must be registered for the provider, empty uses the default
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // set by gateway when an authenticated user links an identity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginOIDCLoginRequest) Reset() {
+	*x = BeginOIDCLoginRequest{}
+	mi := &file_auth_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginOIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginOIDCLoginRequest) ProtoMessage() {}
+
+func (x *BeginOIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginOIDCLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginOIDCLoginRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BeginOIDCLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *BeginOIDCLoginRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *BeginOIDCLoginRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// BeginOIDCLoginResponse authorization to open in a browser
+type BeginOIDCLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	State            string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ExpiresIn        int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // seconds the state stays valid
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BeginOIDCLoginResponse) Reset() {
+	*x = BeginOIDCLoginResponse{}
+	mi := &file_auth_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginOIDCLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginOIDCLoginResponse) ProtoMessage() {}
+
+func (x *BeginOIDCLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginOIDCLoginResponse.ProtoReflect.Descriptor instead.
+func (*BeginOIDCLoginResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BeginOIDCLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *BeginOIDCLoginResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *BeginOIDCLoginResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// CompleteOIDCLoginRequest sign in with the provider callback
+type CompleteOIDCLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	DeviceType    DeviceType             `protobuf:"varint,4,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"` // 1-ios 2-android 3-web 4-pc 5-h5
+	DeviceId      string                 `protobuf:"bytes,5,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	ClientVersion string                 `protobuf:"bytes,6,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,7,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Nickname      *string                `protobuf:"bytes,8,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"` // used only when a new account is created
+	UserAgent     string                 `protobuf:"bytes,9,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOIDCLoginRequest) Reset() {
+	*x = CompleteOIDCLoginRequest{}
+	mi := &file_auth_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOIDCLoginRequest) ProtoMessage() {}
+
+func (x *CompleteOIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOIDCLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOIDCLoginRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CompleteOIDCLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetDeviceType() DeviceType {
+	if x != nil {
+		return x.DeviceType
+	}
+	return DeviceType_DEVICE_TYPE_UNSPECIFIED
+}
+
+func (x *CompleteOIDCLoginRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+// LinkIdentityRequest link identity request
+type LinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
+	mi := &file_auth_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityRequest) ProtoMessage() {}
+
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LinkIdentityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// IdentityInfo identity linked to the user
+type IdentityInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`    // provider display name
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                   // email claim when linked
+	LinkedAt      int64                  `protobuf:"varint,4,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`            // unix seconds
+	LastLoginAt   int64                  `protobuf:"varint,5,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"` // unix seconds, 0 if never used to sign in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityInfo) Reset() {
+	*x = IdentityInfo{}
+	mi := &file_auth_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityInfo) ProtoMessage() {}
+
+func (x *IdentityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityInfo.ProtoReflect.Descriptor instead.
+func (*IdentityInfo) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *IdentityInfo) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *IdentityInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *IdentityInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *IdentityInfo) GetLinkedAt() int64 {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return 0
+}
+
+func (x *IdentityInfo) GetLastLoginAt() int64 {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return 0
+}
+
+// ListIdentitiesRequest list identities request
+type ListIdentitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesRequest) Reset() {
+	*x = ListIdentitiesRequest{}
+	mi := &file_auth_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesRequest) ProtoMessage() {}
+
+func (x *ListIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListIdentitiesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ListIdentitiesResponse linked identity list
+type ListIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*IdentityInfo        `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesResponse) Reset() {
+	*x = ListIdentitiesResponse{}
+	mi := &file_auth_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesResponse) ProtoMessage() {}
+
+func (x *ListIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListIdentitiesResponse) GetIdentities() []*IdentityInfo {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// UnlinkIdentityRequest unlink identity request
+type UnlinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	mi := &file_auth_auth_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UnlinkIdentityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnlinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// ListLoginHistoryRequest login history request
+type ListLoginHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                // default and max 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginHistoryRequest) Reset() {
+	*x = ListLoginHistoryRequest{}
+	mi := &file_auth_auth_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginHistoryRequest) ProtoMessage() {}
+
+func (x *ListLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListLoginHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListLoginHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// LoginHistoryEntry one login attempt
+type LoginHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	DeviceType    DeviceType             `protobuf:"varint,3,opt,name=device_type,json=deviceType,proto3,enum=anychat.auth.DeviceType" json:"device_type,omitempty"`
+	LoginMethod   string                 `protobuf:"bytes,4,opt,name=login_method,json=loginMethod,proto3" json:"login_method,omitempty"` // password, code, qr, oidc
+	IpAddress     string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"` // empty without a GeoIP database
+	UserAgent     string                 `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Result        LoginResult            `protobuf:"varint,8,opt,name=result,proto3,enum=anychat.auth.LoginResult" json:"result,omitempty"`
+	Unusual       bool                   `protobuf:"varint,9,opt,name=unusual,proto3" json:"unusual,omitempty"`
+	RiskReasons   []string               `protobuf:"bytes,10,rep,name=risk_reasons,json=riskReasons,proto3" json:"risk_reasons,omitempty"` // new_device, new_network, new_country
+	Reported      bool                   `protobuf:"varint,11,opt,name=reported,proto3" json:"reported,omitempty"`                         // reported as "this wasn't me"
+	CreatedAt     int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`      // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginHistoryEntry) Reset() {
+	*x = LoginHistoryEntry{}
+	mi := &file_auth_auth_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginHistoryEntry) ProtoMessage() {}
+
+func (x *LoginHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginHistoryEntry.ProtoReflect.Descriptor instead.
+func (*LoginHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LoginHistoryEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LoginHistoryEntry) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *LoginHistoryEntry) GetDeviceType() DeviceType {
+	if x != nil {
+		return x.DeviceType
+	}
+	return DeviceType_DEVICE_TYPE_UNSPECIFIED
+}
+
+func (x *LoginHistoryEntry) GetLoginMethod() string {
+	if x != nil {
+		return x.LoginMethod
+	}
+	return ""
+}
+
+func (x *LoginHistoryEntry) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *LoginHistoryEntry) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *LoginHistoryEntry) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LoginHistoryEntry) GetResult() LoginResult {
+	if x != nil {
+		return x.Result
+	}
+	return LoginResult_LOGIN_RESULT_UNSPECIFIED
+}
+
+func (x *LoginHistoryEntry) GetUnusual() bool {
+	if x != nil {
+		return x.Unusual
 	}
 	return false
 }
 
-func (x *ValidateTokenResponse) GetUserId() string {
+func (x *LoginHistoryEntry) GetRiskReasons() []string {
+	if x != nil {
+		return x.RiskReasons
+	}
+	return nil
+}
+
+func (x *LoginHistoryEntry) GetReported() bool {
+	if x != nil {
+		return x.Reported
+	}
+	return false
+}
+
+func (x *LoginHistoryEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// ListLoginHistoryResponse login history, newest first
+type ListLoginHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LoginHistoryEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginHistoryResponse) Reset() {
+	*x = ListLoginHistoryResponse{}
+	mi := &file_auth_auth_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginHistoryResponse) ProtoMessage() {}
+
+func (x *ListLoginHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListLoginHistoryResponse) GetEntries() []*LoginHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// ReportUnusualLoginRequest report unusual login request
+type ReportUnusualLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // from the link in the unusual login email
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportUnusualLoginRequest) Reset() {
+	*x = ReportUnusualLoginRequest{}
+	mi := &file_auth_auth_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportUnusualLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportUnusualLoginRequest) ProtoMessage() {}
+
+func (x *ReportUnusualLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportUnusualLoginRequest.ProtoReflect.Descriptor instead.
+func (*ReportUnusualLoginRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReportUnusualLoginRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// DeleteAccountRequest delete account request
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // extracted from JWT by gateway
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                       // required when the account has a password
+	VerifyCode    string                 `protobuf:"bytes,3,opt,name=verify_code,json=verifyCode,proto3" json:"verify_code,omitempty"` // purpose delete_account, sent to the bound phone or else email, for accounts without a password
+	MfaCode       string                 `protobuf:"bytes,4,opt,name=mfa_code,json=mfaCode,proto3" json:"mfa_code,omitempty"`          // required when MFA is enabled
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                           // optional, kept in the audit trail
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_auth_auth_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteAccountRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ValidateTokenResponse) GetDeviceId() string {
+func (x *DeleteAccountRequest) GetPassword() string {
 	if x != nil {
-		return x.DeviceId
+		return x.Password
 	}
 	return ""
 }
 
-func (x *ValidateTokenResponse) GetDeviceType() DeviceType {
+func (x *DeleteAccountRequest) GetVerifyCode() string {
 	if x != nil {
-		return x.DeviceType
+		return x.VerifyCode
 	}
-	return DeviceType_DEVICE_TYPE_UNSPECIFIED
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetMfaCode() string {
+	if x != nil {
+		return x.MfaCode
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// GetAccountDeletionRequest account deletion status request
+type GetAccountDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // extracted from JWT by gateway
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountDeletionRequest) Reset() {
+	*x = GetAccountDeletionRequest{}
+	mi := &file_auth_auth_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountDeletionRequest) ProtoMessage() {}
+
+func (x *GetAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetAccountDeletionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// AccountDeletionInfo account deletion request and its progress
+type AccountDeletionInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status         AccountDeletionStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=anychat.auth.AccountDeletionStatus" json:"status,omitempty"`
+	RequestedAt    int64                  `protobuf:"varint,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`         // unix seconds
+	EraseAfter     int64                  `protobuf:"varint,4,opt,name=erase_after,json=eraseAfter,proto3" json:"erase_after,omitempty"`            // end of the grace period, unix seconds
+	CancelledAt    int64                  `protobuf:"varint,5,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`         // 0 unless cancelled
+	CompletedAt    int64                  `protobuf:"varint,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`         // 0 unless completed
+	CompletedSteps []string               `protobuf:"bytes,7,rep,name=completed_steps,json=completedSteps,proto3" json:"completed_steps,omitempty"` // services whose data is already erased
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AccountDeletionInfo) Reset() {
+	*x = AccountDeletionInfo{}
+	mi := &file_auth_auth_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDeletionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDeletionInfo) ProtoMessage() {}
+
+func (x *AccountDeletionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDeletionInfo.ProtoReflect.Descriptor instead.
+func (*AccountDeletionInfo) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AccountDeletionInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccountDeletionInfo) GetStatus() AccountDeletionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccountDeletionStatus_ACCOUNT_DELETION_STATUS_UNSPECIFIED
+}
+
+func (x *AccountDeletionInfo) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+func (x *AccountDeletionInfo) GetEraseAfter() int64 {
+	if x != nil {
+		return x.EraseAfter
+	}
+	return 0
+}
+
+func (x *AccountDeletionInfo) GetCancelledAt() int64 {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return 0
+}
+
+func (x *AccountDeletionInfo) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *AccountDeletionInfo) GetCompletedSteps() []string {
+	if x != nil {
+		return x.CompletedSteps
+	}
+	return nil
 }
 
 var File_auth_auth_proto protoreflect.FileDescriptor
@@ -1094,7 +3777,7 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x1d\n" +
 	"\n" +
-	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"\xe2\x01\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"\xbd\x02\n" +
 	"\fLoginRequest\x12\x18\n" +
 	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x129\n" +
@@ -1103,14 +3786,36 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\tdevice_id\x18\x04 \x01(\tR\bdeviceId\x12%\n" +
 	"\x0eclient_version\x18\x05 \x01(\tR\rclientVersion\x12\x1d\n" +
 	"\n" +
-	"ip_address\x18\x06 \x01(\tR\tipAddress\"\xbd\x01\n" +
+	"ip_address\x18\x06 \x01(\tR\tipAddress\x12(\n" +
+	"\rcaptcha_token\x18\a \x01(\tH\x00R\fcaptchaToken\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\b \x01(\tR\tuserAgentB\x10\n" +
+	"\x0e_captcha_token\"\xff\x02\n" +
+	"\x12LoginByCodeRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12E\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2$.anychat.auth.VerificationTargetTypeR\n" +
+	"targetType\x12\x1f\n" +
+	"\vverify_code\x18\x03 \x01(\tR\n" +
+	"verifyCode\x129\n" +
+	"\vdevice_type\x18\x04 \x01(\x0e2\x18.anychat.auth.DeviceTypeR\n" +
+	"deviceType\x12\x1b\n" +
+	"\tdevice_id\x18\x05 \x01(\tR\bdeviceId\x12%\n" +
+	"\x0eclient_version\x18\x06 \x01(\tR\rclientVersion\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\a \x01(\tR\tipAddress\x12\x1f\n" +
+	"\bnickname\x18\b \x01(\tH\x00R\bnickname\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\t \x01(\tR\tuserAgentB\v\n" +
+	"\t_nickname\"\xfd\x01\n" +
 	"\rLoginResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x1d\n" +
 	"\n" +
 	"expires_in\x18\x04 \x01(\x03R\texpiresIn\x12,\n" +
-	"\x04user\x18\x05 \x01(\v2\x18.anychat.common.UserInfoR\x04user\"E\n" +
+	"\x04user\x18\x05 \x01(\v2\x18.anychat.common.UserInfoR\x04user\x12!\n" +
+	"\fmfa_required\x18\x06 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\a \x01(\tR\bmfaToken\"E\n" +
 	"\rLogoutRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\":\n" +
@@ -1120,17 +3825,26 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
 	"\n" +
-	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"\x93\x01\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"\xc0\x01\n" +
 	"\x15ChangePasswordRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12!\n" +
 	"\fold_password\x18\x03 \x01(\tR\voldPassword\x12!\n" +
-	"\fnew_password\x18\x04 \x01(\tR\vnewPassword\"t\n" +
+	"\fnew_password\x18\x04 \x01(\tR\vnewPassword\x12\x1e\n" +
+	"\bmfa_code\x18\x05 \x01(\tH\x00R\amfaCode\x88\x01\x01B\v\n" +
+	"\t_mfa_code\"t\n" +
 	"\x14ResetPasswordRequest\x12\x18\n" +
 	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x1f\n" +
 	"\vverify_code\x18\x02 \x01(\tR\n" +
 	"verifyCode\x12!\n" +
-	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"9\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"G\n" +
+	"\x16IssueAdminTokenRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\x05R\x04role\"[\n" +
+	"\x17IssueAdminTokenResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\"9\n" +
 	"\x14ValidateTokenRequest\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"\x9e\x01\n" +
 	"\x15ValidateTokenResponse\x12\x14\n" +
@@ -1138,7 +3852,191 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x129\n" +
 	"\vdevice_type\x18\x04 \x01(\x0e2\x18.anychat.auth.DeviceTypeR\n" +
-	"deviceType*\x94\x01\n" +
+	"deviceType\"\xd9\x01\n" +
+	"\x1aCreateQRLoginTicketRequest\x129\n" +
+	"\vdevice_type\x18\x01 \x01(\x0e2\x18.anychat.auth.DeviceTypeR\n" +
+	"deviceType\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12%\n" +
+	"\x0eclient_version\x18\x03 \x01(\tR\rclientVersion\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\"\x94\x01\n" +
+	"\x1bCreateQRLoginTicketResponse\x12\x16\n" +
+	"\x06ticket\x18\x01 \x01(\tR\x06ticket\x12\x1d\n" +
+	"\n" +
+	"qr_content\x18\x02 \x01(\tR\tqrContent\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x12\x1f\n" +
+	"\vpoll_secret\x18\x04 \x01(\tR\n" +
+	"pollSecret\"\xd0\x01\n" +
+	"\x17GetQRLoginStatusRequest\x12\x16\n" +
+	"\x06ticket\x18\x01 \x01(\tR\x06ticket\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12<\n" +
+	"\vlast_status\x18\x03 \x01(\x0e2\x1b.anychat.auth.QRLoginStatusR\n" +
+	"lastStatus\x12!\n" +
+	"\fwait_seconds\x18\x04 \x01(\x05R\vwaitSeconds\x12\x1f\n" +
+	"\vpoll_secret\x18\x05 \x01(\tR\n" +
+	"pollSecret\"\x91\x01\n" +
+	"\x18GetQRLoginStatusResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.anychat.auth.QRLoginStatusR\x06status\x126\n" +
+	"\x05login\x18\x02 \x01(\v2\x1b.anychat.auth.LoginResponseH\x00R\x05login\x88\x01\x01B\b\n" +
+	"\x06_login\"\xb5\x01\n" +
+	"\x13ScanQRLoginResponse\x129\n" +
+	"\vdevice_type\x18\x01 \x01(\x0e2\x18.anychat.auth.DeviceTypeR\n" +
+	"deviceType\x12%\n" +
+	"\x0eclient_version\x18\x02 \x01(\tR\rclientVersion\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"\xbe\x01\n" +
+	"\x14QRLoginActionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x129\n" +
+	"\vdevice_type\x18\x03 \x01(\x0e2\x18.anychat.auth.DeviceTypeR\n" +
+	"deviceType\x12\x16\n" +
+	"\x06ticket\x18\x04 \x01(\tR\x06ticket\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tR\tipAddress\"H\n" +
+	"\x15VerifyLoginMFARequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\")\n" +
+	"\x0eMFAUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"g\n" +
+	"\x11MFAStatusResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x128\n" +
+	"\x18remaining_recovery_codes\x18\x02 \x01(\x03R\x16remainingRecoveryCodes\"U\n" +
+	"\x1aBeginMFAEnrollmentResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"J\n" +
+	"\x1bConfirmMFAEnrollmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"A\n" +
+	"\x18MFARecoveryCodesResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"[\n" +
+	"\x10MFAReauthRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"/\n" +
+	"\x14UnlockAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"Y\n" +
+	"\x12ListDevicesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x11current_device_id\x18\x02 \x01(\tR\x0fcurrentDeviceId\"\xc9\x02\n" +
+	"\n" +
+	"DeviceInfo\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x129\n" +
+	"\vdevice_type\x18\x02 \x01(\x0e2\x18.anychat.auth.DeviceTypeR\n" +
+	"deviceType\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\x0eclient_version\x18\x04 \x01(\tR\rclientVersion\x12\"\n" +
+	"\rlast_login_at\x18\x05 \x01(\x03R\vlastLoginAt\x12\"\n" +
+	"\rlast_login_ip\x18\x06 \x01(\tR\vlastLoginIp\x12$\n" +
+	"\x0elast_active_at\x18\a \x01(\x03R\flastActiveAt\x12\x1d\n" +
+	"\n" +
+	"is_current\x18\b \x01(\bR\tisCurrent\x12\x1b\n" +
+	"\tsigned_in\x18\t \x01(\bR\bsignedIn\"I\n" +
+	"\x13ListDevicesResponse\x122\n" +
+	"\adevices\x18\x01 \x03(\v2\x18.anychat.auth.DeviceInfoR\adevices\"E\n" +
+	"\rDeviceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\"_\n" +
+	"\x13RenameDeviceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"I\n" +
+	"\x10OIDCProviderInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"Y\n" +
+	"\x19ListOIDCProvidersResponse\x12<\n" +
+	"\tproviders\x18\x01 \x03(\v2\x1e.anychat.auth.OIDCProviderInfoR\tproviders\"o\n" +
+	"\x15BeginOIDCLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12!\n" +
+	"\fredirect_uri\x18\x02 \x01(\tR\vredirectUri\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"z\n" +
+	"\x16BeginOIDCLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"\xcb\x02\n" +
+	"\x18CompleteOIDCLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x129\n" +
+	"\vdevice_type\x18\x04 \x01(\x0e2\x18.anychat.auth.DeviceTypeR\n" +
+	"deviceType\x12\x1b\n" +
+	"\tdevice_id\x18\x05 \x01(\tR\bdeviceId\x12%\n" +
+	"\x0eclient_version\x18\x06 \x01(\tR\rclientVersion\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\a \x01(\tR\tipAddress\x12\x1f\n" +
+	"\bnickname\x18\b \x01(\tH\x00R\bnickname\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\t \x01(\tR\tuserAgentB\v\n" +
+	"\t_nickname\"t\n" +
+	"\x13LinkIdentityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\"\xa4\x01\n" +
+	"\fIdentityInfo\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1b\n" +
+	"\tlinked_at\x18\x04 \x01(\x03R\blinkedAt\x12\"\n" +
+	"\rlast_login_at\x18\x05 \x01(\x03R\vlastLoginAt\"0\n" +
+	"\x15ListIdentitiesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"T\n" +
+	"\x16ListIdentitiesResponse\x12:\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x1a.anychat.auth.IdentityInfoR\n" +
+	"identities\"L\n" +
+	"\x15UnlinkIdentityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\"H\n" +
+	"\x17ListLoginHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xa3\x03\n" +
+	"\x11LoginHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x129\n" +
+	"\vdevice_type\x18\x03 \x01(\x0e2\x18.anychat.auth.DeviceTypeR\n" +
+	"deviceType\x12!\n" +
+	"\flogin_method\x18\x04 \x01(\tR\vloginMethod\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tR\tipAddress\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x121\n" +
+	"\x06result\x18\b \x01(\x0e2\x19.anychat.auth.LoginResultR\x06result\x12\x18\n" +
+	"\aunusual\x18\t \x01(\bR\aunusual\x12!\n" +
+	"\frisk_reasons\x18\n" +
+	" \x03(\tR\vriskReasons\x12\x1a\n" +
+	"\breported\x18\v \x01(\bR\breported\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\"U\n" +
+	"\x18ListLoginHistoryResponse\x129\n" +
+	"\aentries\x18\x01 \x03(\v2\x1f.anychat.auth.LoginHistoryEntryR\aentries\"1\n" +
+	"\x19ReportUnusualLoginRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x9f\x01\n" +
+	"\x14DeleteAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vverify_code\x18\x03 \x01(\tR\n" +
+	"verifyCode\x12\x19\n" +
+	"\bmfa_code\x18\x04 \x01(\tR\amfaCode\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"4\n" +
+	"\x19GetAccountDeletionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x95\x02\n" +
+	"\x13AccountDeletionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\x06status\x18\x02 \x01(\x0e2#.anychat.auth.AccountDeletionStatusR\x06status\x12!\n" +
+	"\frequested_at\x18\x03 \x01(\x03R\vrequestedAt\x12\x1f\n" +
+	"\verase_after\x18\x04 \x01(\x03R\n" +
+	"eraseAfter\x12!\n" +
+	"\fcancelled_at\x18\x05 \x01(\x03R\vcancelledAt\x12!\n" +
+	"\fcompleted_at\x18\x06 \x01(\x03R\vcompletedAt\x12'\n" +
+	"\x0fcompleted_steps\x18\a \x03(\tR\x0ecompletedSteps*\x94\x01\n" +
 	"\n" +
 	"DeviceType\x12\x1b\n" +
 	"\x17DEVICE_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
@@ -1150,7 +4048,7 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\x16VerificationTargetType\x12(\n" +
 	"$VERIFICATION_TARGET_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cVERIFICATION_TARGET_TYPE_SMS\x10\x01\x12\"\n" +
-	"\x1eVERIFICATION_TARGET_TYPE_EMAIL\x10\x02*\xbf\x02\n" +
+	"\x1eVERIFICATION_TARGET_TYPE_EMAIL\x10\x02*\xe8\x02\n" +
 	"\x13VerificationPurpose\x12$\n" +
 	" VERIFICATION_PURPOSE_UNSPECIFIED\x10\x00\x12!\n" +
 	"\x1dVERIFICATION_PURPOSE_REGISTER\x10\x01\x12\x1e\n" +
@@ -1159,16 +4057,64 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\x1fVERIFICATION_PURPOSE_BIND_PHONE\x10\x04\x12%\n" +
 	"!VERIFICATION_PURPOSE_CHANGE_PHONE\x10\x05\x12#\n" +
 	"\x1fVERIFICATION_PURPOSE_BIND_EMAIL\x10\x06\x12%\n" +
-	"!VERIFICATION_PURPOSE_CHANGE_EMAIL\x10\a2\x92\x05\n" +
+	"!VERIFICATION_PURPOSE_CHANGE_EMAIL\x10\a\x12'\n" +
+	"#VERIFICATION_PURPOSE_DELETE_ACCOUNT\x10\b*\xc5\x01\n" +
+	"\rQRLoginStatus\x12\x1f\n" +
+	"\x1bQR_LOGIN_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17QR_LOGIN_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17QR_LOGIN_STATUS_SCANNED\x10\x02\x12\x1d\n" +
+	"\x19QR_LOGIN_STATUS_CONFIRMED\x10\x03\x12\x1d\n" +
+	"\x19QR_LOGIN_STATUS_CANCELLED\x10\x04\x12\x1b\n" +
+	"\x17QR_LOGIN_STATUS_EXPIRED\x10\x05*x\n" +
+	"\vLoginResult\x12\x1c\n" +
+	"\x18LOGIN_RESULT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14LOGIN_RESULT_SUCCESS\x10\x01\x12\x17\n" +
+	"\x13LOGIN_RESULT_FAILED\x10\x02\x12\x18\n" +
+	"\x14LOGIN_RESULT_BLOCKED\x10\x03*\xd8\x01\n" +
+	"\x15AccountDeletionStatus\x12'\n" +
+	"#ACCOUNT_DELETION_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fACCOUNT_DELETION_STATUS_PENDING\x10\x01\x12#\n" +
+	"\x1fACCOUNT_DELETION_STATUS_ERASING\x10\x02\x12%\n" +
+	"!ACCOUNT_DELETION_STATUS_COMPLETED\x10\x03\x12%\n" +
+	"!ACCOUNT_DELETION_STATUS_CANCELLED\x10\x042\x83\x18\n" +
 	"\vAuthService\x12m\n" +
 	"\x14SendVerificationCode\x12).anychat.auth.SendVerificationCodeRequest\x1a*.anychat.auth.SendVerificationCodeResponse\x12I\n" +
 	"\bRegister\x12\x1d.anychat.auth.RegisterRequest\x1a\x1e.anychat.auth.RegisterResponse\x12@\n" +
-	"\x05Login\x12\x1a.anychat.auth.LoginRequest\x1a\x1b.anychat.auth.LoginResponse\x12<\n" +
+	"\x05Login\x12\x1a.anychat.auth.LoginRequest\x1a\x1b.anychat.auth.LoginResponse\x12L\n" +
+	"\vLoginByCode\x12 .anychat.auth.LoginByCodeRequest\x1a\x1b.anychat.auth.LoginResponse\x12<\n" +
 	"\x06Logout\x12\x1b.anychat.auth.LogoutRequest\x1a\x15.anychat.common.Empty\x12U\n" +
 	"\fRefreshToken\x12!.anychat.auth.RefreshTokenRequest\x1a\".anychat.auth.RefreshTokenResponse\x12L\n" +
 	"\x0eChangePassword\x12#.anychat.auth.ChangePasswordRequest\x1a\x15.anychat.common.Empty\x12J\n" +
 	"\rResetPassword\x12\".anychat.auth.ResetPasswordRequest\x1a\x15.anychat.common.Empty\x12X\n" +
-	"\rValidateToken\x12\".anychat.auth.ValidateTokenRequest\x1a#.anychat.auth.ValidateTokenResponseB1Z/github.com/anychat/server/api/proto/auth;authpbb\x06proto3"
+	"\rValidateToken\x12\".anychat.auth.ValidateTokenRequest\x1a#.anychat.auth.ValidateTokenResponse\x12^\n" +
+	"\x0fIssueAdminToken\x12$.anychat.auth.IssueAdminTokenRequest\x1a%.anychat.auth.IssueAdminTokenResponse\x12j\n" +
+	"\x13CreateQRLoginTicket\x12(.anychat.auth.CreateQRLoginTicketRequest\x1a).anychat.auth.CreateQRLoginTicketResponse\x12a\n" +
+	"\x10GetQRLoginStatus\x12%.anychat.auth.GetQRLoginStatusRequest\x1a&.anychat.auth.GetQRLoginStatusResponse\x12T\n" +
+	"\vScanQRLogin\x12\".anychat.auth.QRLoginActionRequest\x1a!.anychat.auth.ScanQRLoginResponse\x12K\n" +
+	"\x0eConfirmQRLogin\x12\".anychat.auth.QRLoginActionRequest\x1a\x15.anychat.common.Empty\x12J\n" +
+	"\rCancelQRLogin\x12\".anychat.auth.QRLoginActionRequest\x1a\x15.anychat.common.Empty\x12R\n" +
+	"\x0eVerifyLoginMFA\x12#.anychat.auth.VerifyLoginMFARequest\x1a\x1b.anychat.auth.LoginResponse\x12M\n" +
+	"\fGetMFAStatus\x12\x1c.anychat.auth.MFAUserRequest\x1a\x1f.anychat.auth.MFAStatusResponse\x12\\\n" +
+	"\x12BeginMFAEnrollment\x12\x1c.anychat.auth.MFAUserRequest\x1a(.anychat.auth.BeginMFAEnrollmentResponse\x12i\n" +
+	"\x14ConfirmMFAEnrollment\x12).anychat.auth.ConfirmMFAEnrollmentRequest\x1a&.anychat.auth.MFARecoveryCodesResponse\x12C\n" +
+	"\n" +
+	"DisableMFA\x12\x1e.anychat.auth.MFAReauthRequest\x1a\x15.anychat.common.Empty\x12a\n" +
+	"\x17RegenerateRecoveryCodes\x12\x1e.anychat.auth.MFAReauthRequest\x1a&.anychat.auth.MFARecoveryCodesResponse\x12J\n" +
+	"\rUnlockAccount\x12\".anychat.auth.UnlockAccountRequest\x1a\x15.anychat.common.Empty\x12R\n" +
+	"\vListDevices\x12 .anychat.auth.ListDevicesRequest\x1a!.anychat.auth.ListDevicesResponse\x12B\n" +
+	"\fLogoutDevice\x12\x1b.anychat.auth.DeviceRequest\x1a\x15.anychat.common.Empty\x12H\n" +
+	"\x12LogoutOtherDevices\x12\x1b.anychat.auth.DeviceRequest\x1a\x15.anychat.common.Empty\x12H\n" +
+	"\fRenameDevice\x12!.anychat.auth.RenameDeviceRequest\x1a\x15.anychat.common.Empty\x12S\n" +
+	"\x11ListOIDCProviders\x12\x15.anychat.common.Empty\x1a'.anychat.auth.ListOIDCProvidersResponse\x12[\n" +
+	"\x0eBeginOIDCLogin\x12#.anychat.auth.BeginOIDCLoginRequest\x1a$.anychat.auth.BeginOIDCLoginResponse\x12X\n" +
+	"\x11CompleteOIDCLogin\x12&.anychat.auth.CompleteOIDCLoginRequest\x1a\x1b.anychat.auth.LoginResponse\x12M\n" +
+	"\fLinkIdentity\x12!.anychat.auth.LinkIdentityRequest\x1a\x1a.anychat.auth.IdentityInfo\x12[\n" +
+	"\x0eListIdentities\x12#.anychat.auth.ListIdentitiesRequest\x1a$.anychat.auth.ListIdentitiesResponse\x12L\n" +
+	"\x0eUnlinkIdentity\x12#.anychat.auth.UnlinkIdentityRequest\x1a\x15.anychat.common.Empty\x12a\n" +
+	"\x10ListLoginHistory\x12%.anychat.auth.ListLoginHistoryRequest\x1a&.anychat.auth.ListLoginHistoryResponse\x12T\n" +
+	"\x12ReportUnusualLogin\x12'.anychat.auth.ReportUnusualLoginRequest\x1a\x15.anychat.common.Empty\x12V\n" +
+	"\rDeleteAccount\x12\".anychat.auth.DeleteAccountRequest\x1a!.anychat.auth.AccountDeletionInfo\x12`\n" +
+	"\x12GetAccountDeletion\x12'.anychat.auth.GetAccountDeletionRequest\x1a!.anychat.auth.AccountDeletionInfoB1Z/github.com/anychat/server/api/proto/auth;authpbb\x06proto3"
 
 var (
 	file_auth_auth_proto_rawDescOnce sync.Once
@@ -1182,56 +4128,171 @@ func file_auth_auth_proto_rawDescGZIP() []byte {
 	return file_auth_auth_proto_rawDescData
 }
 
-var file_auth_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_auth_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_auth_auth_proto_goTypes = []any{
 	(DeviceType)(0),                      // 0: anychat.auth.DeviceType
 	(VerificationTargetType)(0),          // 1: anychat.auth.VerificationTargetType
 	(VerificationPurpose)(0),             // 2: anychat.auth.VerificationPurpose
-	(*SendVerificationCodeRequest)(nil),  // 3: anychat.auth.SendVerificationCodeRequest
-	(*SendVerificationCodeResponse)(nil), // 4: anychat.auth.SendVerificationCodeResponse
-	(*RegisterRequest)(nil),              // 5: anychat.auth.RegisterRequest
-	(*RegisterResponse)(nil),             // 6: anychat.auth.RegisterResponse
-	(*LoginRequest)(nil),                 // 7: anychat.auth.LoginRequest
-	(*LoginResponse)(nil),                // 8: anychat.auth.LoginResponse
-	(*LogoutRequest)(nil),                // 9: anychat.auth.LogoutRequest
-	(*RefreshTokenRequest)(nil),          // 10: anychat.auth.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),         // 11: anychat.auth.RefreshTokenResponse
-	(*ChangePasswordRequest)(nil),        // 12: anychat.auth.ChangePasswordRequest
-	(*ResetPasswordRequest)(nil),         // 13: anychat.auth.ResetPasswordRequest
-	(*ValidateTokenRequest)(nil),         // 14: anychat.auth.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),        // 15: anychat.auth.ValidateTokenResponse
-	(*common.UserInfo)(nil),              // 16: anychat.common.UserInfo
-	(*common.Empty)(nil),                 // 17: anychat.common.Empty
+	(QRLoginStatus)(0),                   // 3: anychat.auth.QRLoginStatus
+	(LoginResult)(0),                     // 4: anychat.auth.LoginResult
+	(AccountDeletionStatus)(0),           // 5: anychat.auth.AccountDeletionStatus
+	(*SendVerificationCodeRequest)(nil),  // 6: anychat.auth.SendVerificationCodeRequest
+	(*SendVerificationCodeResponse)(nil), // 7: anychat.auth.SendVerificationCodeResponse
+	(*RegisterRequest)(nil),              // 8: anychat.auth.RegisterRequest
+	(*RegisterResponse)(nil),             // 9: anychat.auth.RegisterResponse
+	(*LoginRequest)(nil),                 // 10: anychat.auth.LoginRequest
+	(*LoginByCodeRequest)(nil),           // 11: anychat.auth.LoginByCodeRequest
+	(*LoginResponse)(nil),                // 12: anychat.auth.LoginResponse
+	(*LogoutRequest)(nil),                // 13: anychat.auth.LogoutRequest
+	(*RefreshTokenRequest)(nil),          // 14: anychat.auth.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),         // 15: anychat.auth.RefreshTokenResponse
+	(*ChangePasswordRequest)(nil),        // 16: anychat.auth.ChangePasswordRequest
+	(*ResetPasswordRequest)(nil),         // 17: anychat.auth.ResetPasswordRequest
+	(*IssueAdminTokenRequest)(nil),       // 18: anychat.auth.IssueAdminTokenRequest
+	(*IssueAdminTokenResponse)(nil),      // 19: anychat.auth.IssueAdminTokenResponse
+	(*ValidateTokenRequest)(nil),         // 20: anychat.auth.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),        // 21: anychat.auth.ValidateTokenResponse
+	(*CreateQRLoginTicketRequest)(nil),   // 22: anychat.auth.CreateQRLoginTicketRequest
+	(*CreateQRLoginTicketResponse)(nil),  // 23: anychat.auth.CreateQRLoginTicketResponse
+	(*GetQRLoginStatusRequest)(nil),      // 24: anychat.auth.GetQRLoginStatusRequest
+	(*GetQRLoginStatusResponse)(nil),     // 25: anychat.auth.GetQRLoginStatusResponse
+	(*ScanQRLoginResponse)(nil),          // 26: anychat.auth.ScanQRLoginResponse
+	(*QRLoginActionRequest)(nil),         // 27: anychat.auth.QRLoginActionRequest
+	(*VerifyLoginMFARequest)(nil),        // 28: anychat.auth.VerifyLoginMFARequest
+	(*MFAUserRequest)(nil),               // 29: anychat.auth.MFAUserRequest
+	(*MFAStatusResponse)(nil),            // 30: anychat.auth.MFAStatusResponse
+	(*BeginMFAEnrollmentResponse)(nil),   // 31: anychat.auth.BeginMFAEnrollmentResponse
+	(*ConfirmMFAEnrollmentRequest)(nil),  // 32: anychat.auth.ConfirmMFAEnrollmentRequest
+	(*MFARecoveryCodesResponse)(nil),     // 33: anychat.auth.MFARecoveryCodesResponse
+	(*MFAReauthRequest)(nil),             // 34: anychat.auth.MFAReauthRequest
+	(*UnlockAccountRequest)(nil),         // 35: anychat.auth.UnlockAccountRequest
+	(*ListDevicesRequest)(nil),           // 36: anychat.auth.ListDevicesRequest
+	(*DeviceInfo)(nil),                   // 37: anychat.auth.DeviceInfo
+	(*ListDevicesResponse)(nil),          // 38: anychat.auth.ListDevicesResponse
+	(*DeviceRequest)(nil),                // 39: anychat.auth.DeviceRequest
+	(*RenameDeviceRequest)(nil),          // 40: anychat.auth.RenameDeviceRequest
+	(*OIDCProviderInfo)(nil),             // 41: anychat.auth.OIDCProviderInfo
+	(*ListOIDCProvidersResponse)(nil),    // 42: anychat.auth.ListOIDCProvidersResponse
+	(*BeginOIDCLoginRequest)(nil),        // 43: anychat.auth.BeginOIDCLoginRequest
+	(*BeginOIDCLoginResponse)(nil),       // 44: anychat.auth.BeginOIDCLoginResponse
+	(*CompleteOIDCLoginRequest)(nil),     // 45: anychat.auth.CompleteOIDCLoginRequest
+	(*LinkIdentityRequest)(nil),          // 46: anychat.auth.LinkIdentityRequest
+	(*IdentityInfo)(nil),                 // 47: anychat.auth.IdentityInfo
+	(*ListIdentitiesRequest)(nil),        // 48: anychat.auth.ListIdentitiesRequest
+	(*ListIdentitiesResponse)(nil),       // 49: anychat.auth.ListIdentitiesResponse
+	(*UnlinkIdentityRequest)(nil),        // 50: anychat.auth.UnlinkIdentityRequest
+	(*ListLoginHistoryRequest)(nil),      // 51: anychat.auth.ListLoginHistoryRequest
+	(*LoginHistoryEntry)(nil),            // 52: anychat.auth.LoginHistoryEntry
+	(*ListLoginHistoryResponse)(nil),     // 53: anychat.auth.ListLoginHistoryResponse
+	(*ReportUnusualLoginRequest)(nil),    // 54: anychat.auth.ReportUnusualLoginRequest
+	(*DeleteAccountRequest)(nil),         // 55: anychat.auth.DeleteAccountRequest
+	(*GetAccountDeletionRequest)(nil),    // 56: anychat.auth.GetAccountDeletionRequest
+	(*AccountDeletionInfo)(nil),          // 57: anychat.auth.AccountDeletionInfo
+	(*common.UserInfo)(nil),              // 58: anychat.common.UserInfo
+	(*common.Empty)(nil),                 // 59: anychat.common.Empty
 }
 var file_auth_auth_proto_depIdxs = []int32{
 	1,  // 0: anychat.auth.SendVerificationCodeRequest.target_type:type_name -> anychat.auth.VerificationTargetType
 	2,  // 1: anychat.auth.SendVerificationCodeRequest.purpose:type_name -> anychat.auth.VerificationPurpose
 	0,  // 2: anychat.auth.RegisterRequest.device_type:type_name -> anychat.auth.DeviceType
 	0,  // 3: anychat.auth.LoginRequest.device_type:type_name -> anychat.auth.DeviceType
-	16, // 4: anychat.auth.LoginResponse.user:type_name -> anychat.common.UserInfo
-	0,  // 5: anychat.auth.ValidateTokenResponse.device_type:type_name -> anychat.auth.DeviceType
-	3,  // 6: anychat.auth.AuthService.SendVerificationCode:input_type -> anychat.auth.SendVerificationCodeRequest
-	5,  // 7: anychat.auth.AuthService.Register:input_type -> anychat.auth.RegisterRequest
-	7,  // 8: anychat.auth.AuthService.Login:input_type -> anychat.auth.LoginRequest
-	9,  // 9: anychat.auth.AuthService.Logout:input_type -> anychat.auth.LogoutRequest
-	10, // 10: anychat.auth.AuthService.RefreshToken:input_type -> anychat.auth.RefreshTokenRequest
-	12, // 11: anychat.auth.AuthService.ChangePassword:input_type -> anychat.auth.ChangePasswordRequest
-	13, // 12: anychat.auth.AuthService.ResetPassword:input_type -> anychat.auth.ResetPasswordRequest
-	14, // 13: anychat.auth.AuthService.ValidateToken:input_type -> anychat.auth.ValidateTokenRequest
-	4,  // 14: anychat.auth.AuthService.SendVerificationCode:output_type -> anychat.auth.SendVerificationCodeResponse
-	6,  // 15: anychat.auth.AuthService.Register:output_type -> anychat.auth.RegisterResponse
-	8,  // 16: anychat.auth.AuthService.Login:output_type -> anychat.auth.LoginResponse
-	17, // 17: anychat.auth.AuthService.Logout:output_type -> anychat.common.Empty
-	11, // 18: anychat.auth.AuthService.RefreshToken:output_type -> anychat.auth.RefreshTokenResponse
-	17, // 19: anychat.auth.AuthService.ChangePassword:output_type -> anychat.common.Empty
-	17, // 20: anychat.auth.AuthService.ResetPassword:output_type -> anychat.common.Empty
-	15, // 21: anychat.auth.AuthService.ValidateToken:output_type -> anychat.auth.ValidateTokenResponse
-	14, // [14:22] is the sub-list for method output_type
-	6,  // [6:14] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	1,  // 4: anychat.auth.LoginByCodeRequest.target_type:type_name -> anychat.auth.VerificationTargetType
+	0,  // 5: anychat.auth.LoginByCodeRequest.device_type:type_name -> anychat.auth.DeviceType
+	58, // 6: anychat.auth.LoginResponse.user:type_name -> anychat.common.UserInfo
+	0,  // 7: anychat.auth.ValidateTokenResponse.device_type:type_name -> anychat.auth.DeviceType
+	0,  // 8: anychat.auth.CreateQRLoginTicketRequest.device_type:type_name -> anychat.auth.DeviceType
+	3,  // 9: anychat.auth.GetQRLoginStatusRequest.last_status:type_name -> anychat.auth.QRLoginStatus
+	3,  // 10: anychat.auth.GetQRLoginStatusResponse.status:type_name -> anychat.auth.QRLoginStatus
+	12, // 11: anychat.auth.GetQRLoginStatusResponse.login:type_name -> anychat.auth.LoginResponse
+	0,  // 12: anychat.auth.ScanQRLoginResponse.device_type:type_name -> anychat.auth.DeviceType
+	0,  // 13: anychat.auth.QRLoginActionRequest.device_type:type_name -> anychat.auth.DeviceType
+	0,  // 14: anychat.auth.DeviceInfo.device_type:type_name -> anychat.auth.DeviceType
+	37, // 15: anychat.auth.ListDevicesResponse.devices:type_name -> anychat.auth.DeviceInfo
+	41, // 16: anychat.auth.ListOIDCProvidersResponse.providers:type_name -> anychat.auth.OIDCProviderInfo
+	0,  // 17: anychat.auth.CompleteOIDCLoginRequest.device_type:type_name -> anychat.auth.DeviceType
+	47, // 18: anychat.auth.ListIdentitiesResponse.identities:type_name -> anychat.auth.IdentityInfo
+	0,  // 19: anychat.auth.LoginHistoryEntry.device_type:type_name -> anychat.auth.DeviceType
+	4,  // 20: anychat.auth.LoginHistoryEntry.result:type_name -> anychat.auth.LoginResult
+	52, // 21: anychat.auth.ListLoginHistoryResponse.entries:type_name -> anychat.auth.LoginHistoryEntry
+	5,  // 22: anychat.auth.AccountDeletionInfo.status:type_name -> anychat.auth.AccountDeletionStatus
+	6,  // 23: anychat.auth.AuthService.SendVerificationCode:input_type -> anychat.auth.SendVerificationCodeRequest
+	8,  // 24: anychat.auth.AuthService.Register:input_type -> anychat.auth.RegisterRequest
+	10, // 25: anychat.auth.AuthService.Login:input_type -> anychat.auth.LoginRequest
+	11, // 26: anychat.auth.AuthService.LoginByCode:input_type -> anychat.auth.LoginByCodeRequest
+	13, // 27: anychat.auth.AuthService.Logout:input_type -> anychat.auth.LogoutRequest
+	14, // 28: anychat.auth.AuthService.RefreshToken:input_type -> anychat.auth.RefreshTokenRequest
+	16, // 29: anychat.auth.AuthService.ChangePassword:input_type -> anychat.auth.ChangePasswordRequest
+	17, // 30: anychat.auth.AuthService.ResetPassword:input_type -> anychat.auth.ResetPasswordRequest
+	20, // 31: anychat.auth.AuthService.ValidateToken:input_type -> anychat.auth.ValidateTokenRequest
+	18, // 32: anychat.auth.AuthService.IssueAdminToken:input_type -> anychat.auth.IssueAdminTokenRequest
+	22, // 33: anychat.auth.AuthService.CreateQRLoginTicket:input_type -> anychat.auth.CreateQRLoginTicketRequest
+	24, // 34: anychat.auth.AuthService.GetQRLoginStatus:input_type -> anychat.auth.GetQRLoginStatusRequest
+	27, // 35: anychat.auth.AuthService.ScanQRLogin:input_type -> anychat.auth.QRLoginActionRequest
+	27, // 36: anychat.auth.AuthService.ConfirmQRLogin:input_type -> anychat.auth.QRLoginActionRequest
+	27, // 37: anychat.auth.AuthService.CancelQRLogin:input_type -> anychat.auth.QRLoginActionRequest
+	28, // 38: anychat.auth.AuthService.VerifyLoginMFA:input_type -> anychat.auth.VerifyLoginMFARequest
+	29, // 39: anychat.auth.AuthService.GetMFAStatus:input_type -> anychat.auth.MFAUserRequest
+	29, // 40: anychat.auth.AuthService.BeginMFAEnrollment:input_type -> anychat.auth.MFAUserRequest
+	32, // 41: anychat.auth.AuthService.ConfirmMFAEnrollment:input_type -> anychat.auth.ConfirmMFAEnrollmentRequest
+	34, // 42: anychat.auth.AuthService.DisableMFA:input_type -> anychat.auth.MFAReauthRequest
+	34, // 43: anychat.auth.AuthService.RegenerateRecoveryCodes:input_type -> anychat.auth.MFAReauthRequest
+	35, // 44: anychat.auth.AuthService.UnlockAccount:input_type -> anychat.auth.UnlockAccountRequest
+	36, // 45: anychat.auth.AuthService.ListDevices:input_type -> anychat.auth.ListDevicesRequest
+	39, // 46: anychat.auth.AuthService.LogoutDevice:input_type -> anychat.auth.DeviceRequest
+	39, // 47: anychat.auth.AuthService.LogoutOtherDevices:input_type -> anychat.auth.DeviceRequest
+	40, // 48: anychat.auth.AuthService.RenameDevice:input_type -> anychat.auth.RenameDeviceRequest
+	59, // 49: anychat.auth.AuthService.ListOIDCProviders:input_type -> anychat.common.Empty
+	43, // 50: anychat.auth.AuthService.BeginOIDCLogin:input_type -> anychat.auth.BeginOIDCLoginRequest
+	45, // 51: anychat.auth.AuthService.CompleteOIDCLogin:input_type -> anychat.auth.CompleteOIDCLoginRequest
+	46, // 52: anychat.auth.AuthService.LinkIdentity:input_type -> anychat.auth.LinkIdentityRequest
+	48, // 53: anychat.auth.AuthService.ListIdentities:input_type -> anychat.auth.ListIdentitiesRequest
+	50, // 54: anychat.auth.AuthService.UnlinkIdentity:input_type -> anychat.auth.UnlinkIdentityRequest
+	51, // 55: anychat.auth.AuthService.ListLoginHistory:input_type -> anychat.auth.ListLoginHistoryRequest
+	54, // 56: anychat.auth.AuthService.ReportUnusualLogin:input_type -> anychat.auth.ReportUnusualLoginRequest
+	55, // 57: anychat.auth.AuthService.DeleteAccount:input_type -> anychat.auth.DeleteAccountRequest
+	56, // 58: anychat.auth.AuthService.GetAccountDeletion:input_type -> anychat.auth.GetAccountDeletionRequest
+	7,  // 59: anychat.auth.AuthService.SendVerificationCode:output_type -> anychat.auth.SendVerificationCodeResponse
+	9,  // 60: anychat.auth.AuthService.Register:output_type -> anychat.auth.RegisterResponse
+	12, // 61: anychat.auth.AuthService.Login:output_type -> anychat.auth.LoginResponse
+	12, // 62: anychat.auth.AuthService.LoginByCode:output_type -> anychat.auth.LoginResponse
+	59, // 63: anychat.auth.AuthService.Logout:output_type -> anychat.common.Empty
+	15, // 64: anychat.auth.AuthService.RefreshToken:output_type -> anychat.auth.RefreshTokenResponse
+	59, // 65: anychat.auth.AuthService.ChangePassword:output_type -> anychat.common.Empty
+	59, // 66: anychat.auth.AuthService.ResetPassword:output_type -> anychat.common.Empty
+	21, // 67: anychat.auth.AuthService.ValidateToken:output_type -> anychat.auth.ValidateTokenResponse
+	19, // 68: anychat.auth.AuthService.IssueAdminToken:output_type -> anychat.auth.IssueAdminTokenResponse
+	23, // 69: anychat.auth.AuthService.CreateQRLoginTicket:output_type -> anychat.auth.CreateQRLoginTicketResponse
+	25, // 70: anychat.auth.AuthService.GetQRLoginStatus:output_type -> anychat.auth.GetQRLoginStatusResponse
+	26, // 71: anychat.auth.AuthService.ScanQRLogin:output_type -> anychat.auth.ScanQRLoginResponse
+	59, // 72: anychat.auth.AuthService.ConfirmQRLogin:output_type -> anychat.common.Empty
+	59, // 73: anychat.auth.AuthService.CancelQRLogin:output_type -> anychat.common.Empty
+	12, // 74: anychat.auth.AuthService.VerifyLoginMFA:output_type -> anychat.auth.LoginResponse
+	30, // 75: anychat.auth.AuthService.GetMFAStatus:output_type -> anychat.auth.MFAStatusResponse
+	31, // 76: anychat.auth.AuthService.BeginMFAEnrollment:output_type -> anychat.auth.BeginMFAEnrollmentResponse
+	33, // 77: anychat.auth.AuthService.ConfirmMFAEnrollment:output_type -> anychat.auth.MFARecoveryCodesResponse
+	59, // 78: anychat.auth.AuthService.DisableMFA:output_type -> anychat.common.Empty
+	33, // 79: anychat.auth.AuthService.RegenerateRecoveryCodes:output_type -> anychat.auth.MFARecoveryCodesResponse
+	59, // 80: anychat.auth.AuthService.UnlockAccount:output_type -> anychat.common.Empty
+	38, // 81: anychat.auth.AuthService.ListDevices:output_type -> anychat.auth.ListDevicesResponse
+	59, // 82: anychat.auth.AuthService.LogoutDevice:output_type -> anychat.common.Empty
+	59, // 83: anychat.auth.AuthService.LogoutOtherDevices:output_type -> anychat.common.Empty
+	59, // 84: anychat.auth.AuthService.RenameDevice:output_type -> anychat.common.Empty
+	42, // 85: anychat.auth.AuthService.ListOIDCProviders:output_type -> anychat.auth.ListOIDCProvidersResponse
+	44, // 86: anychat.auth.AuthService.BeginOIDCLogin:output_type -> anychat.auth.BeginOIDCLoginResponse
+	12, // 87: anychat.auth.AuthService.CompleteOIDCLogin:output_type -> anychat.auth.LoginResponse
+	47, // 88: anychat.auth.AuthService.LinkIdentity:output_type -> anychat.auth.IdentityInfo
+	49, // 89: anychat.auth.AuthService.ListIdentities:output_type -> anychat.auth.ListIdentitiesResponse
+	59, // 90: anychat.auth.AuthService.UnlinkIdentity:output_type -> anychat.common.Empty
+	53, // 91: anychat.auth.AuthService.ListLoginHistory:output_type -> anychat.auth.ListLoginHistoryResponse
+	59, // 92: anychat.auth.AuthService.ReportUnusualLogin:output_type -> anychat.common.Empty
+	57, // 93: anychat.auth.AuthService.DeleteAccount:output_type -> anychat.auth.AccountDeletionInfo
+	57, // 94: anychat.auth.AuthService.GetAccountDeletion:output_type -> anychat.auth.AccountDeletionInfo
+	59, // [59:95] is the sub-list for method output_type
+	23, // [23:59] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_auth_auth_proto_init() }
@@ -1240,13 +4301,18 @@ func file_auth_auth_proto_init() {
 		return
 	}
 	file_auth_auth_proto_msgTypes[2].OneofWrappers = []any{}
+	file_auth_auth_proto_msgTypes[4].OneofWrappers = []any{}
+	file_auth_auth_proto_msgTypes[5].OneofWrappers = []any{}
+	file_auth_auth_proto_msgTypes[10].OneofWrappers = []any{}
+	file_auth_auth_proto_msgTypes[19].OneofWrappers = []any{}
+	file_auth_auth_proto_msgTypes[39].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_auth_proto_rawDesc), len(file_auth_auth_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   13,
+			NumEnums:      6,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,38 +20,122 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_SendVerificationCode_FullMethodName = "/anychat.auth.AuthService/SendVerificationCode"
-	AuthService_Register_FullMethodName             = "/anychat.auth.AuthService/Register"
-	AuthService_Login_FullMethodName                = "/anychat.auth.AuthService/Login"
-	AuthService_Logout_FullMethodName               = "/anychat.auth.AuthService/Logout"
-	AuthService_RefreshToken_FullMethodName         = "/anychat.auth.AuthService/RefreshToken"
-	AuthService_ChangePassword_FullMethodName       = "/anychat.auth.AuthService/ChangePassword"
-	AuthService_ResetPassword_FullMethodName        = "/anychat.auth.AuthService/ResetPassword"
-	AuthService_ValidateToken_FullMethodName        = "/anychat.auth.AuthService/ValidateToken"
+	AuthService_SendVerificationCode_FullMethodName    = "/anychat.auth.AuthService/SendVerificationCode"
+	AuthService_Register_FullMethodName                = "/anychat.auth.AuthService/Register"
+	AuthService_Login_FullMethodName                   = "/anychat.auth.AuthService/Login"
+	AuthService_LoginByCode_FullMethodName             = "/anychat.auth.AuthService/LoginByCode"
+	AuthService_Logout_FullMethodName                  = "/anychat.auth.AuthService/Logout"
+	AuthService_RefreshToken_FullMethodName            = "/anychat.auth.AuthService/RefreshToken"
+	AuthService_ChangePassword_FullMethodName          = "/anychat.auth.AuthService/ChangePassword"
+	AuthService_ResetPassword_FullMethodName           = "/anychat.auth.AuthService/ResetPassword"
+	AuthService_ValidateToken_FullMethodName           = "/anychat.auth.AuthService/ValidateToken"
+	AuthService_IssueAdminToken_FullMethodName         = "/anychat.auth.AuthService/IssueAdminToken"
+	AuthService_CreateQRLoginTicket_FullMethodName     = "/anychat.auth.AuthService/CreateQRLoginTicket"
+	AuthService_GetQRLoginStatus_FullMethodName        = "/anychat.auth.AuthService/GetQRLoginStatus"
+	AuthService_ScanQRLogin_FullMethodName             = "/anychat.auth.AuthService/ScanQRLogin"
+	AuthService_ConfirmQRLogin_FullMethodName          = "/anychat.auth.AuthService/ConfirmQRLogin"
+	AuthService_CancelQRLogin_FullMethodName           = "/anychat.auth.AuthService/CancelQRLogin"
+	AuthService_VerifyLoginMFA_FullMethodName          = "/anychat.auth.AuthService/VerifyLoginMFA"
+	AuthService_GetMFAStatus_FullMethodName            = "/anychat.auth.AuthService/GetMFAStatus"
+	AuthService_BeginMFAEnrollment_FullMethodName      = "/anychat.auth.AuthService/BeginMFAEnrollment"
+	AuthService_ConfirmMFAEnrollment_FullMethodName    = "/anychat.auth.AuthService/ConfirmMFAEnrollment"
+	AuthService_DisableMFA_FullMethodName              = "/anychat.auth.AuthService/DisableMFA"
+	AuthService_RegenerateRecoveryCodes_FullMethodName = "/anychat.auth.AuthService/RegenerateRecoveryCodes"
+	AuthService_UnlockAccount_FullMethodName           = "/anychat.auth.AuthService/UnlockAccount"
+	AuthService_ListDevices_FullMethodName             = "/anychat.auth.AuthService/ListDevices"
+	AuthService_LogoutDevice_FullMethodName            = "/anychat.auth.AuthService/LogoutDevice"
+	AuthService_LogoutOtherDevices_FullMethodName      = "/anychat.auth.AuthService/LogoutOtherDevices"
+	AuthService_RenameDevice_FullMethodName            = "/anychat.auth.AuthService/RenameDevice"
+	AuthService_ListOIDCProviders_FullMethodName       = "/anychat.auth.AuthService/ListOIDCProviders"
+	AuthService_BeginOIDCLogin_FullMethodName          = "/anychat.auth.AuthService/BeginOIDCLogin"
+	AuthService_CompleteOIDCLogin_FullMethodName       = "/anychat.auth.AuthService/CompleteOIDCLogin"
+	AuthService_LinkIdentity_FullMethodName            = "/anychat.auth.AuthService/LinkIdentity"
+	AuthService_ListIdentities_FullMethodName          = "/anychat.auth.AuthService/ListIdentities"
+	AuthService_UnlinkIdentity_FullMethodName          = "/anychat.auth.AuthService/UnlinkIdentity"
+	AuthService_ListLoginHistory_FullMethodName        = "/anychat.auth.AuthService/ListLoginHistory"
+	AuthService_ReportUnusualLogin_FullMethodName      = "/anychat.auth.AuthService/ReportUnusualLogin"
+	AuthService_DeleteAccount_FullMethodName           = "/anychat.auth.AuthService/DeleteAccount"
+	AuthService_GetAccountDeletion_FullMethodName      = "/anychat.auth.AuthService/GetAccountDeletion"
 )
 
 // AuthServiceClient is the client API for AuthService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// AuthService 认证服务
+// AuthService authentication service
 type AuthServiceClient interface {
-	// SendVerificationCode 发送验证码
+	// SendVerificationCode send verification code
 	SendVerificationCode(ctx context.Context, in *SendVerificationCodeRequest, opts ...grpc.CallOption) (*SendVerificationCodeResponse, error)
-	// Register 用户注册
+	// Register user registration
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
-	// Login 用户登录
+	// Login user login
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	// Logout 用户登出
+	// LoginByCode passwordless login with an SMS/email verification code
+	LoginByCode(ctx context.Context, in *LoginByCodeRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Logout user logout
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// RefreshToken 刷新访问令牌
+	// RefreshToken refresh access token
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
-	// ChangePassword 修改密码
+	// ChangePassword change password
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// ResetPassword 重置密码（忘记密码）
+	// ResetPassword reset password (forgot password)
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// ValidateToken 验证Token（供gateway调用）
+	// ValidateToken validate token (called by gateway)
 	ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error)
+	// IssueAdminToken sign an admin console access token (called by admin-service after it authenticated the admin)
+	IssueAdminToken(ctx context.Context, in *IssueAdminTokenRequest, opts ...grpc.CallOption) (*IssueAdminTokenResponse, error)
+	// CreateQRLoginTicket create QR login ticket (web/pc/h5)
+	CreateQRLoginTicket(ctx context.Context, in *CreateQRLoginTicketRequest, opts ...grpc.CallOption) (*CreateQRLoginTicketResponse, error)
+	// GetQRLoginStatus long-poll QR login status (web/pc/h5)
+	GetQRLoginStatus(ctx context.Context, in *GetQRLoginStatusRequest, opts ...grpc.CallOption) (*GetQRLoginStatusResponse, error)
+	// ScanQRLogin mark QR login ticket scanned (logged-in mobile)
+	ScanQRLogin(ctx context.Context, in *QRLoginActionRequest, opts ...grpc.CallOption) (*ScanQRLoginResponse, error)
+	// ConfirmQRLogin confirm QR login (logged-in mobile)
+	ConfirmQRLogin(ctx context.Context, in *QRLoginActionRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// CancelQRLogin reject QR login (logged-in mobile)
+	CancelQRLogin(ctx context.Context, in *QRLoginActionRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// VerifyLoginMFA complete login with a TOTP or recovery code after mfa_required
+	VerifyLoginMFA(ctx context.Context, in *VerifyLoginMFARequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// GetMFAStatus get two-factor authentication status
+	GetMFAStatus(ctx context.Context, in *MFAUserRequest, opts ...grpc.CallOption) (*MFAStatusResponse, error)
+	// BeginMFAEnrollment generate a TOTP secret pending confirmation
+	BeginMFAEnrollment(ctx context.Context, in *MFAUserRequest, opts ...grpc.CallOption) (*BeginMFAEnrollmentResponse, error)
+	// ConfirmMFAEnrollment enable MFA with the first TOTP code, returns recovery codes
+	ConfirmMFAEnrollment(ctx context.Context, in *ConfirmMFAEnrollmentRequest, opts ...grpc.CallOption) (*MFARecoveryCodesResponse, error)
+	// DisableMFA disable MFA (requires password and MFA code)
+	DisableMFA(ctx context.Context, in *MFAReauthRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// RegenerateRecoveryCodes replace recovery codes (requires password and MFA code)
+	RegenerateRecoveryCodes(ctx context.Context, in *MFAReauthRequest, opts ...grpc.CallOption) (*MFARecoveryCodesResponse, error)
+	// UnlockAccount lift a login lockout caused by repeated failures (admin)
+	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// ListDevices list devices of the user with session status
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	// LogoutDevice sign out one device: revoke its session, close its WebSocket
+	LogoutDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// LogoutOtherDevices sign out every device except the current one
+	LogoutOtherDevices(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// RenameDevice set a user-defined device name
+	RenameDevice(ctx context.Context, in *RenameDeviceRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// List configured OIDC identity providers
+	ListOIDCProviders(ctx context.Context, in *common.Empty, opts ...grpc.CallOption) (*ListOIDCProvidersResponse, error)
+	// Start an OIDC authorization (authorization code + PKCE), also used to link an identity
+	BeginOIDCLogin(ctx context.Context, in *BeginOIDCLoginRequest, opts ...grpc.CallOption) (*BeginOIDCLoginResponse, error)
+	// Sign in with the code returned by the identity provider
+	CompleteOIDCLogin(ctx context.Context, in *CompleteOIDCLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Link the identity of a link authorization to the current user
+	LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*IdentityInfo, error)
+	// List identities linked to the current user
+	ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error)
+	// Unlink an identity from the current user
+	UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// ListLoginHistory recent logins and rejected password attempts of the user
+	ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...grpc.CallOption) (*ListLoginHistoryResponse, error)
+	// ReportUnusualLogin "this wasn't me" from an unusual login email: sign the device out and require a password reset
+	ReportUnusualLogin(ctx context.Context, in *ReportUnusualLoginRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// DeleteAccount re-verify the user and schedule the account for erasure after the grace period
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*AccountDeletionInfo, error)
+	// GetAccountDeletion latest deletion request of the user
+	GetAccountDeletion(ctx context.Context, in *GetAccountDeletionRequest, opts ...grpc.CallOption) (*AccountDeletionInfo, error)
 }
 
 type authServiceClient struct {
@@ -92,6 +176,16 @@ func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ..
 	return out, nil
 }
 
+func (c *authServiceClient) LoginByCode(ctx context.Context, in *LoginByCodeRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*common.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(common.Empty)
@@ -142,28 +236,354 @@ func (c *authServiceClient) ValidateToken(ctx context.Context, in *ValidateToken
 	return out, nil
 }
 
+func (c *authServiceClient) IssueAdminToken(ctx context.Context, in *IssueAdminTokenRequest, opts ...grpc.CallOption) (*IssueAdminTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueAdminTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_IssueAdminToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateQRLoginTicket(ctx context.Context, in *CreateQRLoginTicketRequest, opts ...grpc.CallOption) (*CreateQRLoginTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQRLoginTicketResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateQRLoginTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetQRLoginStatus(ctx context.Context, in *GetQRLoginStatusRequest, opts ...grpc.CallOption) (*GetQRLoginStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQRLoginStatusResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetQRLoginStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ScanQRLogin(ctx context.Context, in *QRLoginActionRequest, opts ...grpc.CallOption) (*ScanQRLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScanQRLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_ScanQRLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmQRLogin(ctx context.Context, in *QRLoginActionRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmQRLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CancelQRLogin(ctx context.Context, in *QRLoginActionRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, AuthService_CancelQRLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyLoginMFA(ctx context.Context, in *VerifyLoginMFARequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyLoginMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetMFAStatus(ctx context.Context, in *MFAUserRequest, opts ...grpc.CallOption) (*MFAStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAStatusResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetMFAStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) BeginMFAEnrollment(ctx context.Context, in *MFAUserRequest, opts ...grpc.CallOption) (*BeginMFAEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginMFAEnrollmentResponse)
+	err := c.cc.Invoke(ctx, AuthService_BeginMFAEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmMFAEnrollment(ctx context.Context, in *ConfirmMFAEnrollmentRequest, opts ...grpc.CallOption) (*MFARecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFARecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmMFAEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DisableMFA(ctx context.Context, in *MFAReauthRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, AuthService_DisableMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RegenerateRecoveryCodes(ctx context.Context, in *MFAReauthRequest, opts ...grpc.CallOption) (*MFARecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFARecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, AuthService_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, AuthService_UnlockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LogoutDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, AuthService_LogoutDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LogoutOtherDevices(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, AuthService_LogoutOtherDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RenameDevice(ctx context.Context, in *RenameDeviceRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, AuthService_RenameDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListOIDCProviders(ctx context.Context, in *common.Empty, opts ...grpc.CallOption) (*ListOIDCProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOIDCProvidersResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListOIDCProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) BeginOIDCLogin(ctx context.Context, in *BeginOIDCLoginRequest, opts ...grpc.CallOption) (*BeginOIDCLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_BeginOIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CompleteOIDCLogin(ctx context.Context, in *CompleteOIDCLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_CompleteOIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*IdentityInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IdentityInfo)
+	err := c.cc.Invoke(ctx, AuthService_LinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIdentitiesResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, AuthService_UnlinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...grpc.CallOption) (*ListLoginHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginHistoryResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListLoginHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ReportUnusualLogin(ctx context.Context, in *ReportUnusualLoginRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, AuthService_ReportUnusualLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*AccountDeletionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountDeletionInfo)
+	err := c.cc.Invoke(ctx, AuthService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetAccountDeletion(ctx context.Context, in *GetAccountDeletionRequest, opts ...grpc.CallOption) (*AccountDeletionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountDeletionInfo)
+	err := c.cc.Invoke(ctx, AuthService_GetAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
 //
-// AuthService 认证服务
+// AuthService authentication service
 type AuthServiceServer interface {
-	// SendVerificationCode 发送验证码
+	// SendVerificationCode send verification code
 	SendVerificationCode(context.Context, *SendVerificationCodeRequest) (*SendVerificationCodeResponse, error)
-	// Register 用户注册
+	// Register user registration
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	// Login 用户登录
+	// Login user login
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	// Logout 用户登出
+	// LoginByCode passwordless login with an SMS/email verification code
+	LoginByCode(context.Context, *LoginByCodeRequest) (*LoginResponse, error)
+	// Logout user logout
 	Logout(context.Context, *LogoutRequest) (*common.Empty, error)
-	// RefreshToken 刷新访问令牌
+	// RefreshToken refresh access token
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
-	// ChangePassword 修改密码
+	// ChangePassword change password
 	ChangePassword(context.Context, *ChangePasswordRequest) (*common.Empty, error)
-	// ResetPassword 重置密码（忘记密码）
+	// ResetPassword reset password (forgot password)
 	ResetPassword(context.Context, *ResetPasswordRequest) (*common.Empty, error)
-	// ValidateToken 验证Token（供gateway调用）
+	// ValidateToken validate token (called by gateway)
 	ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error)
+	// IssueAdminToken sign an admin console access token (called by admin-service after it authenticated the admin)
+	IssueAdminToken(context.Context, *IssueAdminTokenRequest) (*IssueAdminTokenResponse, error)
+	// CreateQRLoginTicket create QR login ticket (web/pc/h5)
+	CreateQRLoginTicket(context.Context, *CreateQRLoginTicketRequest) (*CreateQRLoginTicketResponse, error)
+	// GetQRLoginStatus long-poll QR login status (web/pc/h5)
+	GetQRLoginStatus(context.Context, *GetQRLoginStatusRequest) (*GetQRLoginStatusResponse, error)
+	// ScanQRLogin mark QR login ticket scanned (logged-in mobile)
+	ScanQRLogin(context.Context, *QRLoginActionRequest) (*ScanQRLoginResponse, error)
+	// ConfirmQRLogin confirm QR login (logged-in mobile)
+	ConfirmQRLogin(context.Context, *QRLoginActionRequest) (*common.Empty, error)
+	// CancelQRLogin reject QR login (logged-in mobile)
+	CancelQRLogin(context.Context, *QRLoginActionRequest) (*common.Empty, error)
+	// VerifyLoginMFA complete login with a TOTP or recovery code after mfa_required
+	VerifyLoginMFA(context.Context, *VerifyLoginMFARequest) (*LoginResponse, error)
+	// GetMFAStatus get two-factor authentication status
+	GetMFAStatus(context.Context, *MFAUserRequest) (*MFAStatusResponse, error)
+	// BeginMFAEnrollment generate a TOTP secret pending confirmation
+	BeginMFAEnrollment(context.Context, *MFAUserRequest) (*BeginMFAEnrollmentResponse, error)
+	// ConfirmMFAEnrollment enable MFA with the first TOTP code, returns recovery codes
+	ConfirmMFAEnrollment(context.Context, *ConfirmMFAEnrollmentRequest) (*MFARecoveryCodesResponse, error)
+	// DisableMFA disable MFA (requires password and MFA code)
+	DisableMFA(context.Context, *MFAReauthRequest) (*common.Empty, error)
+	// RegenerateRecoveryCodes replace recovery codes (requires password and MFA code)
+	RegenerateRecoveryCodes(context.Context, *MFAReauthRequest) (*MFARecoveryCodesResponse, error)
+	// UnlockAccount lift a login lockout caused by repeated failures (admin)
+	UnlockAccount(context.Context, *UnlockAccountRequest) (*common.Empty, error)
+	// ListDevices list devices of the user with session status
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	// LogoutDevice sign out one device: revoke its session, close its WebSocket
+	LogoutDevice(context.Context, *DeviceRequest) (*common.Empty, error)
+	// LogoutOtherDevices sign out every device except the current one
+	LogoutOtherDevices(context.Context, *DeviceRequest) (*common.Empty, error)
+	// RenameDevice set a user-defined device name
+	RenameDevice(context.Context, *RenameDeviceRequest) (*common.Empty, error)
+	// List configured OIDC identity providers
+	ListOIDCProviders(context.Context, *common.Empty) (*ListOIDCProvidersResponse, error)
+	// Start an OIDC authorization (authorization code + PKCE), also used to link an identity
+	BeginOIDCLogin(context.Context, *BeginOIDCLoginRequest) (*BeginOIDCLoginResponse, error)
+	// Sign in with the code returned by the identity provider
+	CompleteOIDCLogin(context.Context, *CompleteOIDCLoginRequest) (*LoginResponse, error)
+	// Link the identity of a link authorization to the current user
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*IdentityInfo, error)
+	// List identities linked to the current user
+	ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error)
+	// Unlink an identity from the current user
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*common.Empty, error)
+	// ListLoginHistory recent logins and rejected password attempts of the user
+	ListLoginHistory(context.Context, *ListLoginHistoryRequest) (*ListLoginHistoryResponse, error)
+	// ReportUnusualLogin "this wasn't me" from an unusual login email: sign the device out and require a password reset
+	ReportUnusualLogin(context.Context, *ReportUnusualLoginRequest) (*common.Empty, error)
+	// DeleteAccount re-verify the user and schedule the account for erasure after the grace period
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*AccountDeletionInfo, error)
+	// GetAccountDeletion latest deletion request of the user
+	GetAccountDeletion(context.Context, *GetAccountDeletionRequest) (*AccountDeletionInfo, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -183,6 +603,9 @@ func (UnimplementedAuthServiceServer) Register(context.Context, *RegisterRequest
 func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedAuthServiceServer) LoginByCode(context.Context, *LoginByCodeRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LoginByCode not implemented")
+}
 func (UnimplementedAuthServiceServer) Logout(context.Context, *LogoutRequest) (*common.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method Logout not implemented")
 }
@@ -198,6 +621,87 @@ func (UnimplementedAuthServiceServer) ResetPassword(context.Context, *ResetPassw
 func (UnimplementedAuthServiceServer) ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidateToken not implemented")
 }
+func (UnimplementedAuthServiceServer) IssueAdminToken(context.Context, *IssueAdminTokenRequest) (*IssueAdminTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueAdminToken not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateQRLoginTicket(context.Context, *CreateQRLoginTicketRequest) (*CreateQRLoginTicketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateQRLoginTicket not implemented")
+}
+func (UnimplementedAuthServiceServer) GetQRLoginStatus(context.Context, *GetQRLoginStatusRequest) (*GetQRLoginStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQRLoginStatus not implemented")
+}
+func (UnimplementedAuthServiceServer) ScanQRLogin(context.Context, *QRLoginActionRequest) (*ScanQRLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScanQRLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmQRLogin(context.Context, *QRLoginActionRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmQRLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) CancelQRLogin(context.Context, *QRLoginActionRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelQRLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifyLoginMFA(context.Context, *VerifyLoginMFARequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyLoginMFA not implemented")
+}
+func (UnimplementedAuthServiceServer) GetMFAStatus(context.Context, *MFAUserRequest) (*MFAStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMFAStatus not implemented")
+}
+func (UnimplementedAuthServiceServer) BeginMFAEnrollment(context.Context, *MFAUserRequest) (*BeginMFAEnrollmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BeginMFAEnrollment not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmMFAEnrollment(context.Context, *ConfirmMFAEnrollmentRequest) (*MFARecoveryCodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmMFAEnrollment not implemented")
+}
+func (UnimplementedAuthServiceServer) DisableMFA(context.Context, *MFAReauthRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableMFA not implemented")
+}
+func (UnimplementedAuthServiceServer) RegenerateRecoveryCodes(context.Context, *MFAReauthRequest) (*MFARecoveryCodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedAuthServiceServer) UnlockAccount(context.Context, *UnlockAccountRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedAuthServiceServer) LogoutDevice(context.Context, *DeviceRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method LogoutDevice not implemented")
+}
+func (UnimplementedAuthServiceServer) LogoutOtherDevices(context.Context, *DeviceRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method LogoutOtherDevices not implemented")
+}
+func (UnimplementedAuthServiceServer) RenameDevice(context.Context, *RenameDeviceRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameDevice not implemented")
+}
+func (UnimplementedAuthServiceServer) ListOIDCProviders(context.Context, *common.Empty) (*ListOIDCProvidersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOIDCProviders not implemented")
+}
+func (UnimplementedAuthServiceServer) BeginOIDCLogin(context.Context, *BeginOIDCLoginRequest) (*BeginOIDCLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BeginOIDCLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) CompleteOIDCLogin(context.Context, *CompleteOIDCLoginRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteOIDCLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) LinkIdentity(context.Context, *LinkIdentityRequest) (*IdentityInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkIdentity not implemented")
+}
+func (UnimplementedAuthServiceServer) ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIdentities not implemented")
+}
+func (UnimplementedAuthServiceServer) UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkIdentity not implemented")
+}
+func (UnimplementedAuthServiceServer) ListLoginHistory(context.Context, *ListLoginHistoryRequest) (*ListLoginHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLoginHistory not implemented")
+}
+func (UnimplementedAuthServiceServer) ReportUnusualLogin(context.Context, *ReportUnusualLoginRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportUnusualLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*AccountDeletionInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) GetAccountDeletion(context.Context, *GetAccountDeletionRequest) (*AccountDeletionInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountDeletion not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -273,6 +777,24 @@ func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_LoginByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginByCode(ctx, req.(*LoginByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LogoutRequest)
 	if err := dec(in); err != nil {
@@ -363,13 +885,499 @@ func _AuthService_ValidateToken_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AuthService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "anychat.auth.AuthService",
-	HandlerType: (*AuthServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _AuthService_IssueAdminToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueAdminTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).IssueAdminToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_IssueAdminToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).IssueAdminToken(ctx, req.(*IssueAdminTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateQRLoginTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQRLoginTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateQRLoginTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateQRLoginTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateQRLoginTicket(ctx, req.(*CreateQRLoginTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetQRLoginStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQRLoginStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetQRLoginStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetQRLoginStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetQRLoginStatus(ctx, req.(*GetQRLoginStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ScanQRLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QRLoginActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ScanQRLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ScanQRLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ScanQRLogin(ctx, req.(*QRLoginActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmQRLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QRLoginActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmQRLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmQRLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmQRLogin(ctx, req.(*QRLoginActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CancelQRLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QRLoginActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CancelQRLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CancelQRLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CancelQRLogin(ctx, req.(*QRLoginActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyLoginMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLoginMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyLoginMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyLoginMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyLoginMFA(ctx, req.(*VerifyLoginMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetMFAStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFAUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetMFAStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetMFAStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetMFAStatus(ctx, req.(*MFAUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_BeginMFAEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFAUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).BeginMFAEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_BeginMFAEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).BeginMFAEnrollment(ctx, req.(*MFAUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmMFAEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmMFAEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmMFAEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmMFAEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmMFAEnrollment(ctx, req.(*ConfirmMFAEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DisableMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFAReauthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DisableMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DisableMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DisableMFA(ctx, req.(*MFAReauthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFAReauthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RegenerateRecoveryCodes(ctx, req.(*MFAReauthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UnlockAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UnlockAccount(ctx, req.(*UnlockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LogoutDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LogoutDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LogoutDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LogoutDevice(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LogoutOtherDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LogoutOtherDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LogoutOtherDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LogoutOtherDevices(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RenameDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RenameDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RenameDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RenameDevice(ctx, req.(*RenameDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListOIDCProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListOIDCProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListOIDCProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListOIDCProviders(ctx, req.(*common.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_BeginOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginOIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).BeginOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_BeginOIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).BeginOIDCLogin(ctx, req.(*BeginOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CompleteOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CompleteOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CompleteOIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CompleteOIDCLogin(ctx, req.(*CompleteOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LinkIdentity(ctx, req.(*LinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListIdentities(ctx, req.(*ListIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UnlinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UnlinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UnlinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UnlinkIdentity(ctx, req.(*UnlinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListLoginHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListLoginHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListLoginHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListLoginHistory(ctx, req.(*ListLoginHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ReportUnusualLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportUnusualLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ReportUnusualLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ReportUnusualLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ReportUnusualLogin(ctx, req.(*ReportUnusualLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetAccountDeletion(ctx, req.(*GetAccountDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "anychat.auth.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "SendVerificationCode",
 			Handler:    _AuthService_SendVerificationCode_Handler,
@@ -382,6 +1390,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _AuthService_Login_Handler,
 		},
+		{
+			MethodName: "LoginByCode",
+			Handler:    _AuthService_LoginByCode_Handler,
+		},
 		{
 			MethodName: "Logout",
 			Handler:    _AuthService_Logout_Handler,
@@ -402,6 +1414,114 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateToken",
 			Handler:    _AuthService_ValidateToken_Handler,
 		},
+		{
+			MethodName: "IssueAdminToken",
+			Handler:    _AuthService_IssueAdminToken_Handler,
+		},
+		{
+			MethodName: "CreateQRLoginTicket",
+			Handler:    _AuthService_CreateQRLoginTicket_Handler,
+		},
+		{
+			MethodName: "GetQRLoginStatus",
+			Handler:    _AuthService_GetQRLoginStatus_Handler,
+		},
+		{
+			MethodName: "ScanQRLogin",
+			Handler:    _AuthService_ScanQRLogin_Handler,
+		},
+		{
+			MethodName: "ConfirmQRLogin",
+			Handler:    _AuthService_ConfirmQRLogin_Handler,
+		},
+		{
+			MethodName: "CancelQRLogin",
+			Handler:    _AuthService_CancelQRLogin_Handler,
+		},
+		{
+			MethodName: "VerifyLoginMFA",
+			Handler:    _AuthService_VerifyLoginMFA_Handler,
+		},
+		{
+			MethodName: "GetMFAStatus",
+			Handler:    _AuthService_GetMFAStatus_Handler,
+		},
+		{
+			MethodName: "BeginMFAEnrollment",
+			Handler:    _AuthService_BeginMFAEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmMFAEnrollment",
+			Handler:    _AuthService_ConfirmMFAEnrollment_Handler,
+		},
+		{
+			MethodName: "DisableMFA",
+			Handler:    _AuthService_DisableMFA_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _AuthService_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "UnlockAccount",
+			Handler:    _AuthService_UnlockAccount_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _AuthService_ListDevices_Handler,
+		},
+		{
+			MethodName: "LogoutDevice",
+			Handler:    _AuthService_LogoutDevice_Handler,
+		},
+		{
+			MethodName: "LogoutOtherDevices",
+			Handler:    _AuthService_LogoutOtherDevices_Handler,
+		},
+		{
+			MethodName: "RenameDevice",
+			Handler:    _AuthService_RenameDevice_Handler,
+		},
+		{
+			MethodName: "ListOIDCProviders",
+			Handler:    _AuthService_ListOIDCProviders_Handler,
+		},
+		{
+			MethodName: "BeginOIDCLogin",
+			Handler:    _AuthService_BeginOIDCLogin_Handler,
+		},
+		{
+			MethodName: "CompleteOIDCLogin",
+			Handler:    _AuthService_CompleteOIDCLogin_Handler,
+		},
+		{
+			MethodName: "LinkIdentity",
+			Handler:    _AuthService_LinkIdentity_Handler,
+		},
+		{
+			MethodName: "ListIdentities",
+			Handler:    _AuthService_ListIdentities_Handler,
+		},
+		{
+			MethodName: "UnlinkIdentity",
+			Handler:    _AuthService_UnlinkIdentity_Handler,
+		},
+		{
+			MethodName: "ListLoginHistory",
+			Handler:    _AuthService_ListLoginHistory_Handler,
+		},
+		{
+			MethodName: "ReportUnusualLogin",
+			Handler:    _AuthService_ReportUnusualLogin_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _AuthService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "GetAccountDeletion",
+			Handler:    _AuthService_GetAccountDeletion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth/auth.proto",
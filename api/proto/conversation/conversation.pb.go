@@ -8,9 +8,9 @@ package conversationpb
 
 import (
 	common "github.com/anychat/server/api/proto/common"
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -75,24 +75,24 @@ func (ConversationType) EnumDescriptor() ([]byte, []int) {
 	return file_conversation_conversation_proto_rawDescGZIP(), []int{0}
 }
 
-// Conversation 会话
+// Conversation conversation
 type Conversation struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId     string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
 	ConversationType   ConversationType       `protobuf:"varint,2,opt,name=conversation_type,json=conversationType,proto3,enum=anychat.conversation.ConversationType" json:"conversation_type,omitempty"` // 1-single/2-group/3-system
 	UserId             string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TargetId           string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // 单聊为对方用户ID，群聊为群ID
+	TargetId           string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // for single chat: peer user ID; for group chat: group ID
 	LastMessageId      string                 `protobuf:"bytes,5,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
 	LastMessageContent string                 `protobuf:"bytes,6,opt,name=last_message_content,json=lastMessageContent,proto3" json:"last_message_content,omitempty"`
-	LastMessageTime    *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=last_message_time,json=lastMessageTime,proto3" json:"last_message_time,omitempty"`
+	LastMessageTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_message_time,json=lastMessageTime,proto3" json:"last_message_time,omitempty"`
 	UnreadCount        int32                  `protobuf:"varint,8,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
 	IsPinned           bool                   `protobuf:"varint,9,opt,name=is_pinned,json=isPinned,proto3" json:"is_pinned,omitempty"`
 	IsMuted            bool                   `protobuf:"varint,10,opt,name=is_muted,json=isMuted,proto3" json:"is_muted,omitempty"`
-	PinTime            *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=pin_time,json=pinTime,proto3" json:"pin_time,omitempty"`                                     // 置顶时间（用于排序）
-	BurnAfterReading   int32                  `protobuf:"varint,14,opt,name=burn_after_reading,json=burnAfterReading,proto3" json:"burn_after_reading,omitempty"`       // 阅后即焚时长(秒),0表示未启用
-	AutoDeleteDuration int32                  `protobuf:"varint,15,opt,name=auto_delete_duration,json=autoDeleteDuration,proto3" json:"auto_delete_duration,omitempty"` // 自动删除时长(秒),0表示未启用
-	CreatedAt          *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PinTime            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=pin_time,json=pinTime,proto3" json:"pin_time,omitempty"`                                     // pin timestamp (for sorting)
+	BurnAfterReading   int32                  `protobuf:"varint,14,opt,name=burn_after_reading,json=burnAfterReading,proto3" json:"burn_after_reading,omitempty"`       // burn-after-reading duration (seconds), 0 means disabled
+	AutoDeleteDuration int32                  `protobuf:"varint,15,opt,name=auto_delete_duration,json=autoDeleteDuration,proto3" json:"auto_delete_duration,omitempty"` // auto-delete duration (seconds), 0 means disabled
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -169,7 +169,7 @@ func (x *Conversation) GetLastMessageContent() string {
 	return ""
 }
 
-func (x *Conversation) GetLastMessageTime() *timestamp.Timestamp {
+func (x *Conversation) GetLastMessageTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.LastMessageTime
 	}
@@ -197,7 +197,7 @@ func (x *Conversation) GetIsMuted() bool {
 	return false
 }
 
-func (x *Conversation) GetPinTime() *timestamp.Timestamp {
+func (x *Conversation) GetPinTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.PinTime
 	}
@@ -218,26 +218,26 @@ func (x *Conversation) GetAutoDeleteDuration() int32 {
 	return 0
 }
 
-func (x *Conversation) GetCreatedAt() *timestamp.Timestamp {
+func (x *Conversation) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Conversation) GetUpdatedAt() *timestamp.Timestamp {
+func (x *Conversation) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-// GetConversationsRequest 获取会话列表请求
+// GetConversationsRequest get conversation list request
 type GetConversationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	UpdatedBefore *int64                 `protobuf:"varint,3,opt,name=updated_before,json=updatedBefore,proto3,oneof" json:"updated_before,omitempty"` // Unix时间戳，用于增量同步（仅返回此时间之前更新的会话）
+	UpdatedBefore *int64                 `protobuf:"varint,3,opt,name=updated_before,json=updatedBefore,proto3,oneof" json:"updated_before,omitempty"` // Unix timestamp for incremental sync (returns only conversations updated before this time)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -293,7 +293,7 @@ func (x *GetConversationsRequest) GetUpdatedBefore() int64 {
 	return 0
 }
 
-// GetConversationsResponse 获取会话列表响应
+// GetConversationsResponse get conversation list response
 type GetConversationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Conversations []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
@@ -346,7 +346,7 @@ func (x *GetConversationsResponse) GetHasMore() bool {
 	return false
 }
 
-// GetConversationRequest 获取单个会话请求
+// GetConversationRequest get single conversation request
 type GetConversationRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -399,7 +399,7 @@ func (x *GetConversationRequest) GetConversationId() string {
 	return ""
 }
 
-// GetConversationByUserAndTargetRequest 根据用户ID、会话类型和目标ID获取会话请求
+// GetConversationByUserAndTargetRequest get conversation by user ID, conversation type, and target ID request
 type GetConversationByUserAndTargetRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -460,7 +460,7 @@ func (x *GetConversationByUserAndTargetRequest) GetTargetId() string {
 	return ""
 }
 
-// CreateOrUpdateConversationRequest 创建或更新会话请求
+// CreateOrUpdateConversationRequest create or update conversation request
 type CreateOrUpdateConversationRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	ConversationType     ConversationType       `protobuf:"varint,1,opt,name=conversation_type,json=conversationType,proto3,enum=anychat.conversation.ConversationType" json:"conversation_type,omitempty"` // 1-single/2-group/3-system
@@ -468,7 +468,7 @@ type CreateOrUpdateConversationRequest struct {
 	TargetId             string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
 	LastMessageId        string                 `protobuf:"bytes,4,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
 	LastMessageContent   string                 `protobuf:"bytes,5,opt,name=last_message_content,json=lastMessageContent,proto3" json:"last_message_content,omitempty"`
-	LastMessageTimestamp int64                  `protobuf:"varint,6,opt,name=last_message_timestamp,json=lastMessageTimestamp,proto3" json:"last_message_timestamp,omitempty"` // Unix时间戳（秒）
+	LastMessageTimestamp int64                  `protobuf:"varint,6,opt,name=last_message_timestamp,json=lastMessageTimestamp,proto3" json:"last_message_timestamp,omitempty"` // Unix timestamp (seconds)
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -545,7 +545,7 @@ func (x *CreateOrUpdateConversationRequest) GetLastMessageTimestamp() int64 {
 	return 0
 }
 
-// DeleteConversationRequest 删除会话请求
+// DeleteConversationRequest delete conversation request
 type DeleteConversationRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -598,7 +598,7 @@ func (x *DeleteConversationRequest) GetConversationId() string {
 	return ""
 }
 
-// SetPinnedRequest 设置置顶请求
+// SetPinnedRequest set pin request
 type SetPinnedRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -659,7 +659,7 @@ func (x *SetPinnedRequest) GetPinned() bool {
 	return false
 }
 
-// SetMutedRequest 设置免打扰请求
+// SetMutedRequest set do-not-disturb request
 type SetMutedRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -720,7 +720,7 @@ func (x *SetMutedRequest) GetMuted() bool {
 	return false
 }
 
-// ClearUnreadRequest 清除未读数请求
+// ClearUnreadRequest clear unread count request
 type ClearUnreadRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -773,7 +773,7 @@ func (x *ClearUnreadRequest) GetConversationId() string {
 	return ""
 }
 
-// GetTotalUnreadRequest 获取总未读数请求
+// GetTotalUnreadRequest get total unread count request
 type GetTotalUnreadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -818,7 +818,7 @@ func (x *GetTotalUnreadRequest) GetUserId() string {
 	return ""
 }
 
-// GetTotalUnreadResponse 获取总未读数响应
+// GetTotalUnreadResponse get total unread count response
 type GetTotalUnreadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TotalUnread   int32                  `protobuf:"varint,1,opt,name=total_unread,json=totalUnread,proto3" json:"total_unread,omitempty"`
@@ -863,7 +863,7 @@ func (x *GetTotalUnreadResponse) GetTotalUnread() int32 {
 	return 0
 }
 
-// IncrUnreadRequest 增加未读数请求
+// IncrUnreadRequest increment unread count request
 type IncrUnreadRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -924,12 +924,12 @@ func (x *IncrUnreadRequest) GetCount() int32 {
 	return 0
 }
 
-// SetBurnAfterReadingRequest 设置阅后即焚请求
+// SetBurnAfterReadingRequest set burn-after-reading request
 type SetBurnAfterReadingRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	Duration       int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"` // 秒,0表示取消
+	Duration       int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"` // seconds, 0 means disable
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -985,12 +985,12 @@ func (x *SetBurnAfterReadingRequest) GetDuration() int32 {
 	return 0
 }
 
-// SetAutoDeleteRequest 设置自动删除请求
+// SetAutoDeleteRequest set auto-delete request
 type SetAutoDeleteRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	Duration       int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"` // 秒,0表示取消
+	Duration       int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"` // seconds, 0 means disable
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1126,7 +1126,7 @@ const file_conversation_conversation_proto_rawDesc = "" +
 	"\x1dCONVERSATION_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18CONVERSATION_TYPE_SINGLE\x10\x01\x12\x1b\n" +
 	"\x17CONVERSATION_TYPE_GROUP\x10\x02\x12\x1c\n" +
-	"\x18CONVERSATION_TYPE_SYSTEM\x10\x032\x9b\b\n" +
+	"\x18CONVERSATION_TYPE_SYSTEM\x10\x032\xf9\b\n" +
 	"\x13ConversationService\x12q\n" +
 	"\x10GetConversations\x12-.anychat.conversation.GetConversationsRequest\x1a..anychat.conversation.GetConversationsResponse\x12c\n" +
 	"\x0fGetConversation\x12,.anychat.conversation.GetConversationRequest\x1a\".anychat.conversation.Conversation\x12y\n" +
@@ -1139,7 +1139,8 @@ const file_conversation_conversation_proto_rawDesc = "" +
 	"\n" +
 	"IncrUnread\x12'.anychat.conversation.IncrUnreadRequest\x1a\x15.anychat.common.Empty\x12^\n" +
 	"\x13SetBurnAfterReading\x120.anychat.conversation.SetBurnAfterReadingRequest\x1a\x15.anychat.common.Empty\x12R\n" +
-	"\rSetAutoDelete\x12*.anychat.conversation.SetAutoDeleteRequest\x1a\x15.anychat.common.EmptyBAZ?github.com/anychat/server/api/proto/conversation;conversationpbb\x06proto3"
+	"\rSetAutoDelete\x12*.anychat.conversation.SetAutoDeleteRequest\x1a\x15.anychat.common.Empty\x12\\\n" +
+	"\rEraseUserData\x12$.anychat.common.EraseUserDataRequest\x1a%.anychat.common.EraseUserDataResponseBAZ?github.com/anychat/server/api/proto/conversation;conversationpbb\x06proto3"
 
 var (
 	file_conversation_conversation_proto_rawDescOnce sync.Once
@@ -1172,8 +1173,10 @@ var file_conversation_conversation_proto_goTypes = []any{
 	(*IncrUnreadRequest)(nil),                     // 13: anychat.conversation.IncrUnreadRequest
 	(*SetBurnAfterReadingRequest)(nil),            // 14: anychat.conversation.SetBurnAfterReadingRequest
 	(*SetAutoDeleteRequest)(nil),                  // 15: anychat.conversation.SetAutoDeleteRequest
-	(*timestamp.Timestamp)(nil),                   // 16: google.protobuf.Timestamp
-	(*common.Empty)(nil),                          // 17: anychat.common.Empty
+	(*timestamppb.Timestamp)(nil),                 // 16: google.protobuf.Timestamp
+	(*common.EraseUserDataRequest)(nil),           // 17: anychat.common.EraseUserDataRequest
+	(*common.Empty)(nil),                          // 18: anychat.common.Empty
+	(*common.EraseUserDataResponse)(nil),          // 19: anychat.common.EraseUserDataResponse
 }
 var file_conversation_conversation_proto_depIdxs = []int32{
 	0,  // 0: anychat.conversation.Conversation.conversation_type:type_name -> anychat.conversation.ConversationType
@@ -1195,19 +1198,21 @@ var file_conversation_conversation_proto_depIdxs = []int32{
 	13, // 16: anychat.conversation.ConversationService.IncrUnread:input_type -> anychat.conversation.IncrUnreadRequest
 	14, // 17: anychat.conversation.ConversationService.SetBurnAfterReading:input_type -> anychat.conversation.SetBurnAfterReadingRequest
 	15, // 18: anychat.conversation.ConversationService.SetAutoDelete:input_type -> anychat.conversation.SetAutoDeleteRequest
-	3,  // 19: anychat.conversation.ConversationService.GetConversations:output_type -> anychat.conversation.GetConversationsResponse
-	1,  // 20: anychat.conversation.ConversationService.GetConversation:output_type -> anychat.conversation.Conversation
-	1,  // 21: anychat.conversation.ConversationService.CreateOrUpdateConversation:output_type -> anychat.conversation.Conversation
-	17, // 22: anychat.conversation.ConversationService.DeleteConversation:output_type -> anychat.common.Empty
-	17, // 23: anychat.conversation.ConversationService.SetPinned:output_type -> anychat.common.Empty
-	17, // 24: anychat.conversation.ConversationService.SetMuted:output_type -> anychat.common.Empty
-	17, // 25: anychat.conversation.ConversationService.ClearUnread:output_type -> anychat.common.Empty
-	12, // 26: anychat.conversation.ConversationService.GetTotalUnread:output_type -> anychat.conversation.GetTotalUnreadResponse
-	17, // 27: anychat.conversation.ConversationService.IncrUnread:output_type -> anychat.common.Empty
-	17, // 28: anychat.conversation.ConversationService.SetBurnAfterReading:output_type -> anychat.common.Empty
-	17, // 29: anychat.conversation.ConversationService.SetAutoDelete:output_type -> anychat.common.Empty
-	19, // [19:30] is the sub-list for method output_type
-	8,  // [8:19] is the sub-list for method input_type
+	17, // 19: anychat.conversation.ConversationService.EraseUserData:input_type -> anychat.common.EraseUserDataRequest
+	3,  // 20: anychat.conversation.ConversationService.GetConversations:output_type -> anychat.conversation.GetConversationsResponse
+	1,  // 21: anychat.conversation.ConversationService.GetConversation:output_type -> anychat.conversation.Conversation
+	1,  // 22: anychat.conversation.ConversationService.CreateOrUpdateConversation:output_type -> anychat.conversation.Conversation
+	18, // 23: anychat.conversation.ConversationService.DeleteConversation:output_type -> anychat.common.Empty
+	18, // 24: anychat.conversation.ConversationService.SetPinned:output_type -> anychat.common.Empty
+	18, // 25: anychat.conversation.ConversationService.SetMuted:output_type -> anychat.common.Empty
+	18, // 26: anychat.conversation.ConversationService.ClearUnread:output_type -> anychat.common.Empty
+	12, // 27: anychat.conversation.ConversationService.GetTotalUnread:output_type -> anychat.conversation.GetTotalUnreadResponse
+	18, // 28: anychat.conversation.ConversationService.IncrUnread:output_type -> anychat.common.Empty
+	18, // 29: anychat.conversation.ConversationService.SetBurnAfterReading:output_type -> anychat.common.Empty
+	18, // 30: anychat.conversation.ConversationService.SetAutoDelete:output_type -> anychat.common.Empty
+	19, // 31: anychat.conversation.ConversationService.EraseUserData:output_type -> anychat.common.EraseUserDataResponse
+	20, // [20:32] is the sub-list for method output_type
+	8,  // [8:20] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
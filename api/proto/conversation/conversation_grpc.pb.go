@@ -31,36 +31,39 @@ const (
 	ConversationService_IncrUnread_FullMethodName                 = "/anychat.conversation.ConversationService/IncrUnread"
 	ConversationService_SetBurnAfterReading_FullMethodName        = "/anychat.conversation.ConversationService/SetBurnAfterReading"
 	ConversationService_SetAutoDelete_FullMethodName              = "/anychat.conversation.ConversationService/SetAutoDelete"
+	ConversationService_EraseUserData_FullMethodName              = "/anychat.conversation.ConversationService/EraseUserData"
 )
 
 // ConversationServiceClient is the client API for ConversationService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// ConversationService 会话管理服务
+// ConversationService conversation management service
 type ConversationServiceClient interface {
-	// GetConversations 获取用户会话列表（支持增量同步）
+	// GetConversations get user conversation list (supports incremental sync)
 	GetConversations(ctx context.Context, in *GetConversationsRequest, opts ...grpc.CallOption) (*GetConversationsResponse, error)
-	// GetConversation 获取单个会话详情
+	// GetConversation get single conversation details
 	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*Conversation, error)
-	// CreateOrUpdateConversation 创建或更新会话（消息到达时由消息服务调用）
+	// CreateOrUpdateConversation create or update conversation (called by message service when messages arrive)
 	CreateOrUpdateConversation(ctx context.Context, in *CreateOrUpdateConversationRequest, opts ...grpc.CallOption) (*Conversation, error)
-	// DeleteConversation 删除会话
+	// DeleteConversation delete conversation
 	DeleteConversation(ctx context.Context, in *DeleteConversationRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// SetPinned 设置会话置顶/取消置顶
+	// SetPinned pin/unpin conversation
 	SetPinned(ctx context.Context, in *SetPinnedRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// SetMuted 设置会话免打扰/取消免打扰
+	// SetMuted enable/disable do-not-disturb for conversation
 	SetMuted(ctx context.Context, in *SetMutedRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// ClearUnread 清除会话未读数（标记已读）
+	// ClearUnread clear conversation unread count (mark as read)
 	ClearUnread(ctx context.Context, in *ClearUnreadRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// GetTotalUnread 获取用户所有会话的总未读数
+	// GetTotalUnread get total unread count across all user conversations
 	GetTotalUnread(ctx context.Context, in *GetTotalUnreadRequest, opts ...grpc.CallOption) (*GetTotalUnreadResponse, error)
-	// IncrUnread 增加会话未读数（消息到达时由消息服务调用）
+	// IncrUnread increment conversation unread count (called by message service when messages arrive)
 	IncrUnread(ctx context.Context, in *IncrUnreadRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// SetBurnAfterReading 设置阅后即焚时长（秒），0表示取消
+	// SetBurnAfterReading set burn-after-reading duration (seconds), 0 means disable
 	SetBurnAfterReading(ctx context.Context, in *SetBurnAfterReadingRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// SetAutoDelete 设置自动删除时长（秒），0表示取消
+	// SetAutoDelete set auto-delete duration (seconds), 0 means disable
 	SetAutoDelete(ctx context.Context, in *SetAutoDeleteRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error)
 }
 
 type conversationServiceClient struct {
@@ -181,34 +184,46 @@ func (c *conversationServiceClient) SetAutoDelete(ctx context.Context, in *SetAu
 	return out, nil
 }
 
+func (c *conversationServiceClient) EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.EraseUserDataResponse)
+	err := c.cc.Invoke(ctx, ConversationService_EraseUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConversationServiceServer is the server API for ConversationService service.
 // All implementations must embed UnimplementedConversationServiceServer
 // for forward compatibility.
 //
-// ConversationService 会话管理服务
+// ConversationService conversation management service
 type ConversationServiceServer interface {
-	// GetConversations 获取用户会话列表（支持增量同步）
+	// GetConversations get user conversation list (supports incremental sync)
 	GetConversations(context.Context, *GetConversationsRequest) (*GetConversationsResponse, error)
-	// GetConversation 获取单个会话详情
+	// GetConversation get single conversation details
 	GetConversation(context.Context, *GetConversationRequest) (*Conversation, error)
-	// CreateOrUpdateConversation 创建或更新会话（消息到达时由消息服务调用）
+	// CreateOrUpdateConversation create or update conversation (called by message service when messages arrive)
 	CreateOrUpdateConversation(context.Context, *CreateOrUpdateConversationRequest) (*Conversation, error)
-	// DeleteConversation 删除会话
+	// DeleteConversation delete conversation
 	DeleteConversation(context.Context, *DeleteConversationRequest) (*common.Empty, error)
-	// SetPinned 设置会话置顶/取消置顶
+	// SetPinned pin/unpin conversation
 	SetPinned(context.Context, *SetPinnedRequest) (*common.Empty, error)
-	// SetMuted 设置会话免打扰/取消免打扰
+	// SetMuted enable/disable do-not-disturb for conversation
 	SetMuted(context.Context, *SetMutedRequest) (*common.Empty, error)
-	// ClearUnread 清除会话未读数（标记已读）
+	// ClearUnread clear conversation unread count (mark as read)
 	ClearUnread(context.Context, *ClearUnreadRequest) (*common.Empty, error)
-	// GetTotalUnread 获取用户所有会话的总未读数
+	// GetTotalUnread get total unread count across all user conversations
 	GetTotalUnread(context.Context, *GetTotalUnreadRequest) (*GetTotalUnreadResponse, error)
-	// IncrUnread 增加会话未读数（消息到达时由消息服务调用）
+	// IncrUnread increment conversation unread count (called by message service when messages arrive)
 	IncrUnread(context.Context, *IncrUnreadRequest) (*common.Empty, error)
-	// SetBurnAfterReading 设置阅后即焚时长（秒），0表示取消
+	// SetBurnAfterReading set burn-after-reading duration (seconds), 0 means disable
 	SetBurnAfterReading(context.Context, *SetBurnAfterReadingRequest) (*common.Empty, error)
-	// SetAutoDelete 设置自动删除时长（秒），0表示取消
+	// SetAutoDelete set auto-delete duration (seconds), 0 means disable
 	SetAutoDelete(context.Context, *SetAutoDeleteRequest) (*common.Empty, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error)
 	mustEmbedUnimplementedConversationServiceServer()
 }
 
@@ -252,6 +267,9 @@ func (UnimplementedConversationServiceServer) SetBurnAfterReading(context.Contex
 func (UnimplementedConversationServiceServer) SetAutoDelete(context.Context, *SetAutoDeleteRequest) (*common.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetAutoDelete not implemented")
 }
+func (UnimplementedConversationServiceServer) EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EraseUserData not implemented")
+}
 func (UnimplementedConversationServiceServer) mustEmbedUnimplementedConversationServiceServer() {}
 func (UnimplementedConversationServiceServer) testEmbeddedByValue()                             {}
 
@@ -471,6 +489,24 @@ func _ConversationService_SetAutoDelete_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConversationService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).EraseUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_EraseUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).EraseUserData(ctx, req.(*common.EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConversationService_ServiceDesc is the grpc.ServiceDesc for ConversationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -522,6 +558,10 @@ var ConversationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAutoDelete",
 			Handler:    _ConversationService_SetAutoDelete_Handler,
 		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _ConversationService_EraseUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "conversation/conversation.proto",
@@ -7,6 +7,7 @@
 package file
 
 import (
+	common "github.com/anychat/server/api/proto/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,7 +22,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// FileType 文件类型
+// FileType file type
 type FileType int32
 
 const (
@@ -80,13 +81,14 @@ func (FileType) EnumDescriptor() ([]byte, []int) {
 	return file_file_file_proto_rawDescGZIP(), []int{0}
 }
 
-// FileStatus 文件状态
+// FileStatus file status
 type FileStatus int32
 
 const (
-	FileStatus_FILE_STATUS_DELETED    FileStatus = 0
-	FileStatus_FILE_STATUS_ACTIVE     FileStatus = 1
-	FileStatus_FILE_STATUS_PROCESSING FileStatus = 2
+	FileStatus_FILE_STATUS_DELETED     FileStatus = 0
+	FileStatus_FILE_STATUS_ACTIVE      FileStatus = 1
+	FileStatus_FILE_STATUS_PROCESSING  FileStatus = 2 // upload not completed, or waiting for the malware scan
+	FileStatus_FILE_STATUS_QUARANTINED FileStatus = 3 // held back by the malware scan
 )
 
 // Enum value maps for FileStatus.
@@ -95,11 +97,13 @@ var (
 		0: "FILE_STATUS_DELETED",
 		1: "FILE_STATUS_ACTIVE",
 		2: "FILE_STATUS_PROCESSING",
+		3: "FILE_STATUS_QUARANTINED",
 	}
 	FileStatus_value = map[string]int32{
-		"FILE_STATUS_DELETED":    0,
-		"FILE_STATUS_ACTIVE":     1,
-		"FILE_STATUS_PROCESSING": 2,
+		"FILE_STATUS_DELETED":     0,
+		"FILE_STATUS_ACTIVE":      1,
+		"FILE_STATUS_PROCESSING":  2,
+		"FILE_STATUS_QUARANTINED": 3,
 	}
 )
 
@@ -130,7 +134,7 @@ func (FileStatus) EnumDescriptor() ([]byte, []int) {
 	return file_file_file_proto_rawDescGZIP(), []int{1}
 }
 
-// UploadStatus 分片上传状态
+// UploadStatus multipart upload status
 type UploadStatus int32
 
 const (
@@ -186,7 +190,122 @@ func (UploadStatus) EnumDescriptor() ([]byte, []int) {
 	return file_file_file_proto_rawDescGZIP(), []int{2}
 }
 
-// FileInfo 文件信息
+// StorageOwnerType owner of a storage quota
+type StorageOwnerType int32
+
+const (
+	StorageOwnerType_STORAGE_OWNER_TYPE_UNSPECIFIED StorageOwnerType = 0
+	StorageOwnerType_STORAGE_OWNER_TYPE_USER        StorageOwnerType = 1
+	StorageOwnerType_STORAGE_OWNER_TYPE_GROUP       StorageOwnerType = 2
+)
+
+// Enum value maps for StorageOwnerType.
+var (
+	StorageOwnerType_name = map[int32]string{
+		0: "STORAGE_OWNER_TYPE_UNSPECIFIED",
+		1: "STORAGE_OWNER_TYPE_USER",
+		2: "STORAGE_OWNER_TYPE_GROUP",
+	}
+	StorageOwnerType_value = map[string]int32{
+		"STORAGE_OWNER_TYPE_UNSPECIFIED": 0,
+		"STORAGE_OWNER_TYPE_USER":        1,
+		"STORAGE_OWNER_TYPE_GROUP":       2,
+	}
+)
+
+func (x StorageOwnerType) Enum() *StorageOwnerType {
+	p := new(StorageOwnerType)
+	*p = x
+	return p
+}
+
+func (x StorageOwnerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StorageOwnerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_file_file_proto_enumTypes[3].Descriptor()
+}
+
+func (StorageOwnerType) Type() protoreflect.EnumType {
+	return &file_file_file_proto_enumTypes[3]
+}
+
+func (x StorageOwnerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StorageOwnerType.Descriptor instead.
+func (StorageOwnerType) EnumDescriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{3}
+}
+
+// FileScanStatus malware scan status
+type FileScanStatus int32
+
+const (
+	FileScanStatus_FILE_SCAN_STATUS_UNSPECIFIED FileScanStatus = 0
+	FileScanStatus_FILE_SCAN_STATUS_PENDING     FileScanStatus = 1
+	FileScanStatus_FILE_SCAN_STATUS_RUNNING     FileScanStatus = 2
+	FileScanStatus_FILE_SCAN_STATUS_CLEAN       FileScanStatus = 3
+	FileScanStatus_FILE_SCAN_STATUS_QUARANTINED FileScanStatus = 4 // infected, or the scanner kept failing
+	FileScanStatus_FILE_SCAN_STATUS_RELEASED    FileScanStatus = 5 // let through by an administrator
+	FileScanStatus_FILE_SCAN_STATUS_REJECTED    FileScanStatus = 6 // deleted by an administrator
+	FileScanStatus_FILE_SCAN_STATUS_SKIPPED     FileScanStatus = 7 // over the scan size limit, or deleted before the scan
+)
+
+// Enum value maps for FileScanStatus.
+var (
+	FileScanStatus_name = map[int32]string{
+		0: "FILE_SCAN_STATUS_UNSPECIFIED",
+		1: "FILE_SCAN_STATUS_PENDING",
+		2: "FILE_SCAN_STATUS_RUNNING",
+		3: "FILE_SCAN_STATUS_CLEAN",
+		4: "FILE_SCAN_STATUS_QUARANTINED",
+		5: "FILE_SCAN_STATUS_RELEASED",
+		6: "FILE_SCAN_STATUS_REJECTED",
+		7: "FILE_SCAN_STATUS_SKIPPED",
+	}
+	FileScanStatus_value = map[string]int32{
+		"FILE_SCAN_STATUS_UNSPECIFIED": 0,
+		"FILE_SCAN_STATUS_PENDING":     1,
+		"FILE_SCAN_STATUS_RUNNING":     2,
+		"FILE_SCAN_STATUS_CLEAN":       3,
+		"FILE_SCAN_STATUS_QUARANTINED": 4,
+		"FILE_SCAN_STATUS_RELEASED":    5,
+		"FILE_SCAN_STATUS_REJECTED":    6,
+		"FILE_SCAN_STATUS_SKIPPED":     7,
+	}
+)
+
+func (x FileScanStatus) Enum() *FileScanStatus {
+	p := new(FileScanStatus)
+	*p = x
+	return p
+}
+
+func (x FileScanStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileScanStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_file_file_proto_enumTypes[4].Descriptor()
+}
+
+func (FileScanStatus) Type() protoreflect.EnumType {
+	return &file_file_file_proto_enumTypes[4]
+}
+
+func (x FileScanStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileScanStatus.Descriptor instead.
+func (FileScanStatus) EnumDescriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{4}
+}
+
+// FileInfo file info
 type FileInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
@@ -343,7 +462,7 @@ func (x *FileInfo) GetThumbnailUrl() string {
 	return ""
 }
 
-// GenerateUploadTokenRequest 生成上传凭证请求
+// GenerateUploadTokenRequest generate upload token request
 type GenerateUploadTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -351,7 +470,9 @@ type GenerateUploadTokenRequest struct {
 	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
 	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
 	FileType      FileType               `protobuf:"varint,5,opt,name=file_type,json=fileType,proto3,enum=file.FileType" json:"file_type,omitempty"`
-	ExpiresHours  *int32                 `protobuf:"varint,6,opt,name=expires_hours,json=expiresHours,proto3,oneof" json:"expires_hours,omitempty"` // 文件过期时间（小时），0表示永不过期
+	ExpiresHours  *int32                 `protobuf:"varint,6,opt,name=expires_hours,json=expiresHours,proto3,oneof" json:"expires_hours,omitempty"` // file expiration time (hours), 0 means never expires
+	Sha256        *string                `protobuf:"bytes,7,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`                                  // SHA-256 of the content (hex), enables instant upload and is verified on completion
+	GroupId       *string                `protobuf:"bytes,8,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`                 // count the file against this group's quota, the uploader must be a member
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -428,12 +549,28 @@ func (x *GenerateUploadTokenRequest) GetExpiresHours() int32 {
 	return 0
 }
 
-// GenerateUploadTokenResponse 生成上传凭证响应
+func (x *GenerateUploadTokenRequest) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+func (x *GenerateUploadTokenRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+// GenerateUploadTokenResponse generate upload token response
 type GenerateUploadTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
-	UploadUrl     string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
-	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // URL有效期（秒）
+	UploadUrl     string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`                                                                                       // empty for instant uploads
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`                                                                                      // URL validity (seconds)
+	InstantUpload bool                   `protobuf:"varint,4,opt,name=instant_upload,json=instantUpload,proto3" json:"instant_upload,omitempty"`                                                                          // identical content is already stored, the file is active and needs no upload
+	UploadHeaders map[string]string      `protobuf:"bytes,5,rep,name=upload_headers,json=uploadHeaders,proto3" json:"upload_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // signed headers (Content-Length, Content-Type) the PUT must send unchanged
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -489,7 +626,21 @@ func (x *GenerateUploadTokenResponse) GetExpiresIn() int64 {
 	return 0
 }
 
-// CompleteUploadRequest 完成上传请求
+func (x *GenerateUploadTokenResponse) GetInstantUpload() bool {
+	if x != nil {
+		return x.InstantUpload
+	}
+	return false
+}
+
+func (x *GenerateUploadTokenResponse) GetUploadHeaders() map[string]string {
+	if x != nil {
+		return x.UploadHeaders
+	}
+	return nil
+}
+
+// CompleteUploadRequest complete upload request
 type CompleteUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
@@ -542,12 +693,12 @@ func (x *CompleteUploadRequest) GetUserId() string {
 	return ""
 }
 
-// GenerateDownloadURLRequest 生成下载链接请求
+// GenerateDownloadURLRequest generate download URL request
 type GenerateDownloadURLRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	FileId         string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
 	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ExpiresMinutes *int32                 `protobuf:"varint,3,opt,name=expires_minutes,json=expiresMinutes,proto3,oneof" json:"expires_minutes,omitempty"` // URL有效期（分钟），默认60分钟
+	ExpiresMinutes *int32                 `protobuf:"varint,3,opt,name=expires_minutes,json=expiresMinutes,proto3,oneof" json:"expires_minutes,omitempty"` // URL validity (minutes), default 60
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -603,12 +754,14 @@ func (x *GenerateDownloadURLRequest) GetExpiresMinutes() int32 {
 	return 0
 }
 
-// GenerateDownloadURLResponse 生成下载链接响应
+// GenerateDownloadURLResponse generate download URL response
 type GenerateDownloadURLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DownloadUrl   string                 `protobuf:"bytes,1,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
-	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // URL有效期（秒）
-	ThumbnailUrl  *string                `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`               // URL validity (seconds)
+	ThumbnailUrl  *string                `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"` // default thumbnail size
+	Thumbnails    []*ThumbnailURL        `protobuf:"bytes,4,rep,name=thumbnails,proto3" json:"thumbnails,omitempty"`                               // every generated thumbnail size
+	FileId        string                 `protobuf:"bytes,5,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -664,7 +817,196 @@ func (x *GenerateDownloadURLResponse) GetThumbnailUrl() string {
 	return ""
 }
 
-// GetFileInfoRequest 获取文件信息请求
+func (x *GenerateDownloadURLResponse) GetThumbnails() []*ThumbnailURL {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+func (x *GenerateDownloadURLResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+// BatchGenerateDownloadURLsRequest batch generate download URLs request
+type BatchGenerateDownloadURLsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FileIds        []string               `protobuf:"bytes,1,rep,name=file_ids,json=fileIds,proto3" json:"file_ids,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpiresMinutes *int32                 `protobuf:"varint,3,opt,name=expires_minutes,json=expiresMinutes,proto3,oneof" json:"expires_minutes,omitempty"` // URL validity (minutes), default 60
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchGenerateDownloadURLsRequest) Reset() {
+	*x = BatchGenerateDownloadURLsRequest{}
+	mi := &file_file_file_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGenerateDownloadURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGenerateDownloadURLsRequest) ProtoMessage() {}
+
+func (x *BatchGenerateDownloadURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGenerateDownloadURLsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGenerateDownloadURLsRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchGenerateDownloadURLsRequest) GetFileIds() []string {
+	if x != nil {
+		return x.FileIds
+	}
+	return nil
+}
+
+func (x *BatchGenerateDownloadURLsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BatchGenerateDownloadURLsRequest) GetExpiresMinutes() int32 {
+	if x != nil && x.ExpiresMinutes != nil {
+		return *x.ExpiresMinutes
+	}
+	return 0
+}
+
+// BatchGenerateDownloadURLsResponse batch generate download URLs response
+type BatchGenerateDownloadURLsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Urls          []*GenerateDownloadURLResponse `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"` // missing, expired and unreadable files are omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGenerateDownloadURLsResponse) Reset() {
+	*x = BatchGenerateDownloadURLsResponse{}
+	mi := &file_file_file_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGenerateDownloadURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGenerateDownloadURLsResponse) ProtoMessage() {}
+
+func (x *BatchGenerateDownloadURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGenerateDownloadURLsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGenerateDownloadURLsResponse) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchGenerateDownloadURLsResponse) GetUrls() []*GenerateDownloadURLResponse {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+// ThumbnailURL download URL of one thumbnail size
+type ThumbnailURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          int32                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"` // longest edge configured for this size
+	Width         int32                  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThumbnailURL) Reset() {
+	*x = ThumbnailURL{}
+	mi := &file_file_file_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThumbnailURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThumbnailURL) ProtoMessage() {}
+
+func (x *ThumbnailURL) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThumbnailURL.ProtoReflect.Descriptor instead.
+func (*ThumbnailURL) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ThumbnailURL) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ThumbnailURL) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ThumbnailURL) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ThumbnailURL) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// GetFileInfoRequest get file info request
 type GetFileInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
@@ -675,7 +1017,7 @@ type GetFileInfoRequest struct {
 
 func (x *GetFileInfoRequest) Reset() {
 	*x = GetFileInfoRequest{}
-	mi := &file_file_file_proto_msgTypes[6]
+	mi := &file_file_file_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -687,7 +1029,7 @@ func (x *GetFileInfoRequest) String() string {
 func (*GetFileInfoRequest) ProtoMessage() {}
 
 func (x *GetFileInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_file_proto_msgTypes[6]
+	mi := &file_file_file_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +1042,7 @@ func (x *GetFileInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetFileInfoRequest) Descriptor() ([]byte, []int) {
-	return file_file_file_proto_rawDescGZIP(), []int{6}
+	return file_file_file_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetFileInfoRequest) GetFileId() string {
@@ -717,7 +1059,7 @@ func (x *GetFileInfoRequest) GetUserId() string {
 	return ""
 }
 
-// DeleteFileRequest 删除文件请求
+// DeleteFileRequest delete file request
 type DeleteFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
@@ -728,7 +1070,7 @@ type DeleteFileRequest struct {
 
 func (x *DeleteFileRequest) Reset() {
 	*x = DeleteFileRequest{}
-	mi := &file_file_file_proto_msgTypes[7]
+	mi := &file_file_file_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +1082,7 @@ func (x *DeleteFileRequest) String() string {
 func (*DeleteFileRequest) ProtoMessage() {}
 
 func (x *DeleteFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_file_proto_msgTypes[7]
+	mi := &file_file_file_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +1095,7 @@ func (x *DeleteFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFileRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFileRequest) Descriptor() ([]byte, []int) {
-	return file_file_file_proto_rawDescGZIP(), []int{7}
+	return file_file_file_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteFileRequest) GetFileId() string {
@@ -770,7 +1112,7 @@ func (x *DeleteFileRequest) GetUserId() string {
 	return ""
 }
 
-// DeleteFileResponse 删除文件响应
+// DeleteFileResponse delete file response
 type DeleteFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -780,7 +1122,7 @@ type DeleteFileResponse struct {
 
 func (x *DeleteFileResponse) Reset() {
 	*x = DeleteFileResponse{}
-	mi := &file_file_file_proto_msgTypes[8]
+	mi := &file_file_file_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -792,7 +1134,7 @@ func (x *DeleteFileResponse) String() string {
 func (*DeleteFileResponse) ProtoMessage() {}
 
 func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_file_proto_msgTypes[8]
+	mi := &file_file_file_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +1147,7 @@ func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFileResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFileResponse) Descriptor() ([]byte, []int) {
-	return file_file_file_proto_rawDescGZIP(), []int{8}
+	return file_file_file_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteFileResponse) GetSuccess() bool {
@@ -815,11 +1157,11 @@ func (x *DeleteFileResponse) GetSuccess() bool {
 	return false
 }
 
-// ListUserFilesRequest 列出用户文件请求
+// ListUserFilesRequest list user files request
 type ListUserFilesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FileType      *FileType              `protobuf:"varint,2,opt,name=file_type,json=fileType,proto3,enum=file.FileType,oneof" json:"file_type,omitempty"` // 可选：按文件类型过滤
+	FileType      *FileType              `protobuf:"varint,2,opt,name=file_type,json=fileType,proto3,enum=file.FileType,oneof" json:"file_type,omitempty"` // optional: filter by file type
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -828,7 +1170,7 @@ type ListUserFilesRequest struct {
 
 func (x *ListUserFilesRequest) Reset() {
 	*x = ListUserFilesRequest{}
-	mi := &file_file_file_proto_msgTypes[9]
+	mi := &file_file_file_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -840,7 +1182,7 @@ func (x *ListUserFilesRequest) String() string {
 func (*ListUserFilesRequest) ProtoMessage() {}
 
 func (x *ListUserFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_file_proto_msgTypes[9]
+	mi := &file_file_file_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,7 +1195,7 @@ func (x *ListUserFilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserFilesRequest.ProtoReflect.Descriptor instead.
 func (*ListUserFilesRequest) Descriptor() ([]byte, []int) {
-	return file_file_file_proto_rawDescGZIP(), []int{9}
+	return file_file_file_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListUserFilesRequest) GetUserId() string {
@@ -884,7 +1226,7 @@ func (x *ListUserFilesRequest) GetPageSize() int32 {
 	return 0
 }
 
-// ListUserFilesResponse 列出用户文件响应
+// ListUserFilesResponse list user files response
 type ListUserFilesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Files         []*FileInfo            `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
@@ -897,7 +1239,7 @@ type ListUserFilesResponse struct {
 
 func (x *ListUserFilesResponse) Reset() {
 	*x = ListUserFilesResponse{}
-	mi := &file_file_file_proto_msgTypes[10]
+	mi := &file_file_file_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -909,7 +1251,7 @@ func (x *ListUserFilesResponse) String() string {
 func (*ListUserFilesResponse) ProtoMessage() {}
 
 func (x *ListUserFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_file_proto_msgTypes[10]
+	mi := &file_file_file_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1264,7 @@ func (x *ListUserFilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserFilesResponse.ProtoReflect.Descriptor instead.
 func (*ListUserFilesResponse) Descriptor() ([]byte, []int) {
-	return file_file_file_proto_rawDescGZIP(), []int{10}
+	return file_file_file_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListUserFilesResponse) GetFiles() []*FileInfo {
@@ -953,7 +1295,7 @@ func (x *ListUserFilesResponse) GetPageSize() int32 {
 	return 0
 }
 
-// BatchGetFileInfoRequest 批量获取文件信息请求
+// BatchGetFileInfoRequest batch get file info request
 type BatchGetFileInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileIds       []string               `protobuf:"bytes,1,rep,name=file_ids,json=fileIds,proto3" json:"file_ids,omitempty"`
@@ -964,7 +1306,7 @@ type BatchGetFileInfoRequest struct {
 
 func (x *BatchGetFileInfoRequest) Reset() {
 	*x = BatchGetFileInfoRequest{}
-	mi := &file_file_file_proto_msgTypes[11]
+	mi := &file_file_file_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -976,7 +1318,7 @@ func (x *BatchGetFileInfoRequest) String() string {
 func (*BatchGetFileInfoRequest) ProtoMessage() {}
 
 func (x *BatchGetFileInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_file_proto_msgTypes[11]
+	mi := &file_file_file_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,7 +1331,7 @@ func (x *BatchGetFileInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetFileInfoRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetFileInfoRequest) Descriptor() ([]byte, []int) {
-	return file_file_file_proto_rawDescGZIP(), []int{11}
+	return file_file_file_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *BatchGetFileInfoRequest) GetFileIds() []string {
@@ -1006,7 +1348,7 @@ func (x *BatchGetFileInfoRequest) GetUserId() string {
 	return ""
 }
 
-// BatchGetFileInfoResponse 批量获取文件信息响应
+// BatchGetFileInfoResponse batch get file info response
 type BatchGetFileInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Files         []*FileInfo            `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
@@ -1016,7 +1358,7 @@ type BatchGetFileInfoResponse struct {
 
 func (x *BatchGetFileInfoResponse) Reset() {
 	*x = BatchGetFileInfoResponse{}
-	mi := &file_file_file_proto_msgTypes[12]
+	mi := &file_file_file_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1028,7 +1370,7 @@ func (x *BatchGetFileInfoResponse) String() string {
 func (*BatchGetFileInfoResponse) ProtoMessage() {}
 
 func (x *BatchGetFileInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_file_proto_msgTypes[12]
+	mi := &file_file_file_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,7 +1383,7 @@ func (x *BatchGetFileInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetFileInfoResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetFileInfoResponse) Descriptor() ([]byte, []int) {
-	return file_file_file_proto_rawDescGZIP(), []int{12}
+	return file_file_file_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BatchGetFileInfoResponse) GetFiles() []*FileInfo {
@@ -1051,14 +1393,1932 @@ func (x *BatchGetFileInfoResponse) GetFiles() []*FileInfo {
 	return nil
 }
 
-var File_file_file_proto protoreflect.FileDescriptor
+// InitiateMultipartUploadRequest initiate multipart upload request
+type InitiateMultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	FileType      FileType               `protobuf:"varint,5,opt,name=file_type,json=fileType,proto3,enum=file.FileType" json:"file_type,omitempty"`
+	PartSize      *int64                 `protobuf:"varint,6,opt,name=part_size,json=partSize,proto3,oneof" json:"part_size,omitempty"`             // bytes, 5MB-100MB, defaults to the server setting
+	ExpiresHours  *int32                 `protobuf:"varint,7,opt,name=expires_hours,json=expiresHours,proto3,oneof" json:"expires_hours,omitempty"` // file expiration time (hours), 0 means never expires
+	GroupId       *string                `protobuf:"bytes,8,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`                 // count the file against this group's quota, the uploader must be a member
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_file_file_proto_rawDesc = "" +
-	"\n" +
-	"\x0ffile/file.proto\x12\x04file\"\xca\x04\n" +
-	"\bFileInfo\x12\x17\n" +
-	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+func (x *InitiateMultipartUploadRequest) Reset() {
+	*x = InitiateMultipartUploadRequest{}
+	mi := &file_file_file_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateMultipartUploadRequest) ProtoMessage() {}
+
+func (x *InitiateMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*InitiateMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InitiateMultipartUploadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InitiateMultipartUploadRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *InitiateMultipartUploadRequest) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *InitiateMultipartUploadRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *InitiateMultipartUploadRequest) GetFileType() FileType {
+	if x != nil {
+		return x.FileType
+	}
+	return FileType_FILE_TYPE_UNSPECIFIED
+}
+
+func (x *InitiateMultipartUploadRequest) GetPartSize() int64 {
+	if x != nil && x.PartSize != nil {
+		return *x.PartSize
+	}
+	return 0
+}
+
+func (x *InitiateMultipartUploadRequest) GetExpiresHours() int32 {
+	if x != nil && x.ExpiresHours != nil {
+		return *x.ExpiresHours
+	}
+	return 0
+}
+
+func (x *InitiateMultipartUploadRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+// MultipartUpload multipart upload session
+type MultipartUpload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileSize      int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	PartSize      int64                  `protobuf:"varint,5,opt,name=part_size,json=partSize,proto3" json:"part_size,omitempty"`
+	TotalParts    int32                  `protobuf:"varint,6,opt,name=total_parts,json=totalParts,proto3" json:"total_parts,omitempty"`
+	UploadedParts []int32                `protobuf:"varint,7,rep,packed,name=uploaded_parts,json=uploadedParts,proto3" json:"uploaded_parts,omitempty"` // part numbers as last listed from storage
+	Status        UploadStatus           `protobuf:"varint,8,opt,name=status,proto3,enum=file.UploadStatus" json:"status,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // the upload is aborted if not completed by then
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipartUpload) Reset() {
+	*x = MultipartUpload{}
+	mi := &file_file_file_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipartUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipartUpload) ProtoMessage() {}
+
+func (x *MultipartUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipartUpload.ProtoReflect.Descriptor instead.
+func (*MultipartUpload) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MultipartUpload) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *MultipartUpload) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *MultipartUpload) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *MultipartUpload) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *MultipartUpload) GetPartSize() int64 {
+	if x != nil {
+		return x.PartSize
+	}
+	return 0
+}
+
+func (x *MultipartUpload) GetTotalParts() int32 {
+	if x != nil {
+		return x.TotalParts
+	}
+	return 0
+}
+
+func (x *MultipartUpload) GetUploadedParts() []int32 {
+	if x != nil {
+		return x.UploadedParts
+	}
+	return nil
+}
+
+func (x *MultipartUpload) GetStatus() UploadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UploadStatus_UPLOAD_STATUS_UNSPECIFIED
+}
+
+func (x *MultipartUpload) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// GetPartUploadURLsRequest get part upload URLs request
+type GetPartUploadURLsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PartNumbers   []int32                `protobuf:"varint,3,rep,packed,name=part_numbers,json=partNumbers,proto3" json:"part_numbers,omitempty"` // 1-based, at most 100 per request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartUploadURLsRequest) Reset() {
+	*x = GetPartUploadURLsRequest{}
+	mi := &file_file_file_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartUploadURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartUploadURLsRequest) ProtoMessage() {}
+
+func (x *GetPartUploadURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartUploadURLsRequest.ProtoReflect.Descriptor instead.
+func (*GetPartUploadURLsRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPartUploadURLsRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *GetPartUploadURLsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPartUploadURLsRequest) GetPartNumbers() []int32 {
+	if x != nil {
+		return x.PartNumbers
+	}
+	return nil
+}
+
+// PartUploadURL presigned PUT URL of one part
+type PartUploadURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartNumber    int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartUploadURL) Reset() {
+	*x = PartUploadURL{}
+	mi := &file_file_file_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartUploadURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartUploadURL) ProtoMessage() {}
+
+func (x *PartUploadURL) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartUploadURL.ProtoReflect.Descriptor instead.
+func (*PartUploadURL) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PartUploadURL) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *PartUploadURL) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+// GetPartUploadURLsResponse get part upload URLs response
+type GetPartUploadURLsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parts         []*PartUploadURL       `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // URL validity (seconds)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartUploadURLsResponse) Reset() {
+	*x = GetPartUploadURLsResponse{}
+	mi := &file_file_file_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartUploadURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartUploadURLsResponse) ProtoMessage() {}
+
+func (x *GetPartUploadURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartUploadURLsResponse.ProtoReflect.Descriptor instead.
+func (*GetPartUploadURLsResponse) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPartUploadURLsResponse) GetParts() []*PartUploadURL {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *GetPartUploadURLsResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// ListUploadedPartsRequest list uploaded parts request
+type ListUploadedPartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUploadedPartsRequest) Reset() {
+	*x = ListUploadedPartsRequest{}
+	mi := &file_file_file_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUploadedPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUploadedPartsRequest) ProtoMessage() {}
+
+func (x *ListUploadedPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUploadedPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListUploadedPartsRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListUploadedPartsRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *ListUploadedPartsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// UploadedPart part already stored
+type UploadedPart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartNumber    int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadedPart) Reset() {
+	*x = UploadedPart{}
+	mi := &file_file_file_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadedPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadedPart) ProtoMessage() {}
+
+func (x *UploadedPart) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadedPart.ProtoReflect.Descriptor instead.
+func (*UploadedPart) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadedPart) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *UploadedPart) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadedPart) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// ListUploadedPartsResponse list uploaded parts response
+type ListUploadedPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upload        *MultipartUpload       `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	Parts         []*UploadedPart        `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
+	UploadedBytes int64                  `protobuf:"varint,3,opt,name=uploaded_bytes,json=uploadedBytes,proto3" json:"uploaded_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUploadedPartsResponse) Reset() {
+	*x = ListUploadedPartsResponse{}
+	mi := &file_file_file_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUploadedPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUploadedPartsResponse) ProtoMessage() {}
+
+func (x *ListUploadedPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUploadedPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListUploadedPartsResponse) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListUploadedPartsResponse) GetUpload() *MultipartUpload {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *ListUploadedPartsResponse) GetParts() []*UploadedPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ListUploadedPartsResponse) GetUploadedBytes() int64 {
+	if x != nil {
+		return x.UploadedBytes
+	}
+	return 0
+}
+
+// CompleteMultipartUploadRequest complete multipart upload request
+type CompleteMultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMultipartUploadRequest) Reset() {
+	*x = CompleteMultipartUploadRequest{}
+	mi := &file_file_file_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMultipartUploadRequest) ProtoMessage() {}
+
+func (x *CompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CompleteMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// AbortMultipartUploadRequest abort multipart upload request
+type AbortMultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortMultipartUploadRequest) Reset() {
+	*x = AbortMultipartUploadRequest{}
+	mi := &file_file_file_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortMultipartUploadRequest) ProtoMessage() {}
+
+func (x *AbortMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*AbortMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AbortMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *AbortMultipartUploadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// AbortMultipartUploadResponse abort multipart upload response
+type AbortMultipartUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortMultipartUploadResponse) Reset() {
+	*x = AbortMultipartUploadResponse{}
+	mi := &file_file_file_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortMultipartUploadResponse) ProtoMessage() {}
+
+func (x *AbortMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*AbortMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AbortMultipartUploadResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GrantMessageFileAccessRequest grant message file access request
+type GrantMessageFileAccessRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MessageId        string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ConversationId   string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ConversationType int32                  `protobuf:"varint,3,opt,name=conversation_type,json=conversationType,proto3" json:"conversation_type,omitempty"` // 1-single 2-group
+	TargetId         string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`                          // peer user ID or group ID
+	SenderId         string                 `protobuf:"bytes,5,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	FileIds          []string               `protobuf:"bytes,6,rep,name=file_ids,json=fileIds,proto3" json:"file_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GrantMessageFileAccessRequest) Reset() {
+	*x = GrantMessageFileAccessRequest{}
+	mi := &file_file_file_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantMessageFileAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantMessageFileAccessRequest) ProtoMessage() {}
+
+func (x *GrantMessageFileAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantMessageFileAccessRequest.ProtoReflect.Descriptor instead.
+func (*GrantMessageFileAccessRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GrantMessageFileAccessRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *GrantMessageFileAccessRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *GrantMessageFileAccessRequest) GetConversationType() int32 {
+	if x != nil {
+		return x.ConversationType
+	}
+	return 0
+}
+
+func (x *GrantMessageFileAccessRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *GrantMessageFileAccessRequest) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *GrantMessageFileAccessRequest) GetFileIds() []string {
+	if x != nil {
+		return x.FileIds
+	}
+	return nil
+}
+
+// GrantMessageFileAccessResponse grant message file access response
+type GrantMessageFileAccessResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GrantedFileIds  []string               `protobuf:"bytes,1,rep,name=granted_file_ids,json=grantedFileIds,proto3" json:"granted_file_ids,omitempty"`
+	RejectedFileIds []string               `protobuf:"bytes,2,rep,name=rejected_file_ids,json=rejectedFileIds,proto3" json:"rejected_file_ids,omitempty"` // not found, not active, or not readable by the sender
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GrantMessageFileAccessResponse) Reset() {
+	*x = GrantMessageFileAccessResponse{}
+	mi := &file_file_file_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantMessageFileAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantMessageFileAccessResponse) ProtoMessage() {}
+
+func (x *GrantMessageFileAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantMessageFileAccessResponse.ProtoReflect.Descriptor instead.
+func (*GrantMessageFileAccessResponse) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GrantMessageFileAccessResponse) GetGrantedFileIds() []string {
+	if x != nil {
+		return x.GrantedFileIds
+	}
+	return nil
+}
+
+func (x *GrantMessageFileAccessResponse) GetRejectedFileIds() []string {
+	if x != nil {
+		return x.RejectedFileIds
+	}
+	return nil
+}
+
+// RevokeMessageFileAccessRequest revoke message file access request
+type RevokeMessageFileAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageIds    []string               `protobuf:"bytes,1,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // recalled, deleted, auto_deleted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMessageFileAccessRequest) Reset() {
+	*x = RevokeMessageFileAccessRequest{}
+	mi := &file_file_file_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMessageFileAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMessageFileAccessRequest) ProtoMessage() {}
+
+func (x *RevokeMessageFileAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMessageFileAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokeMessageFileAccessRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RevokeMessageFileAccessRequest) GetMessageIds() []string {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+func (x *RevokeMessageFileAccessRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// RevokeMessageFileAccessResponse revoke message file access response
+type RevokeMessageFileAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revoked       int64                  `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMessageFileAccessResponse) Reset() {
+	*x = RevokeMessageFileAccessResponse{}
+	mi := &file_file_file_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMessageFileAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMessageFileAccessResponse) ProtoMessage() {}
+
+func (x *RevokeMessageFileAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMessageFileAccessResponse.ProtoReflect.Descriptor instead.
+func (*RevokeMessageFileAccessResponse) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevokeMessageFileAccessResponse) GetRevoked() int64 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+// StorageUsage storage usage and quota of a user or group
+type StorageUsage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType       StorageOwnerType       `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=file.StorageOwnerType" json:"owner_type,omitempty"`
+	OwnerId         string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	UsedBytes       int64                  `protobuf:"varint,3,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	QuotaBytes      int64                  `protobuf:"varint,4,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"` // 0 means unlimited
+	FileCount       int32                  `protobuf:"varint,5,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	Tier            string                 `protobuf:"bytes,6,opt,name=tier,proto3" json:"tier,omitempty"`                                               // users only
+	QuotaOverridden bool                   `protobuf:"varint,7,opt,name=quota_overridden,json=quotaOverridden,proto3" json:"quota_overridden,omitempty"` // quota set by an administrator instead of the tier or group default
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StorageUsage) Reset() {
+	*x = StorageUsage{}
+	mi := &file_file_file_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageUsage) ProtoMessage() {}
+
+func (x *StorageUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageUsage.ProtoReflect.Descriptor instead.
+func (*StorageUsage) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StorageUsage) GetOwnerType() StorageOwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return StorageOwnerType_STORAGE_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *StorageUsage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *StorageUsage) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *StorageUsage) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *StorageUsage) GetFileCount() int32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *StorageUsage) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *StorageUsage) GetQuotaOverridden() bool {
+	if x != nil {
+		return x.QuotaOverridden
+	}
+	return false
+}
+
+// GetStorageUsageRequest get storage usage request
+type GetStorageUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GroupId       *string                `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"` // usage of a group the user belongs to instead of the user's own
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageUsageRequest) Reset() {
+	*x = GetStorageUsageRequest{}
+	mi := &file_file_file_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageRequest) ProtoMessage() {}
+
+func (x *GetStorageUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetStorageUsageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetStorageUsageRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+// GetOwnerStorageUsageRequest get storage usage of any owner request
+type GetOwnerStorageUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType     StorageOwnerType       `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=file.StorageOwnerType" json:"owner_type,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOwnerStorageUsageRequest) Reset() {
+	*x = GetOwnerStorageUsageRequest{}
+	mi := &file_file_file_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOwnerStorageUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOwnerStorageUsageRequest) ProtoMessage() {}
+
+func (x *GetOwnerStorageUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOwnerStorageUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetOwnerStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetOwnerStorageUsageRequest) GetOwnerType() StorageOwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return StorageOwnerType_STORAGE_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *GetOwnerStorageUsageRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+// SetStorageQuotaRequest set storage quota request
+type SetStorageQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType     StorageOwnerType       `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=file.StorageOwnerType" json:"owner_type,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Tier          *string                `protobuf:"bytes,3,opt,name=tier,proto3,oneof" json:"tier,omitempty"`                                // users only, empty resets to the default tier
+	QuotaBytes    *int64                 `protobuf:"varint,4,opt,name=quota_bytes,json=quotaBytes,proto3,oneof" json:"quota_bytes,omitempty"` // override, 0 means unlimited
+	ClearQuota    bool                   `protobuf:"varint,5,opt,name=clear_quota,json=clearQuota,proto3" json:"clear_quota,omitempty"`       // drop the override and use the tier or group default again
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStorageQuotaRequest) Reset() {
+	*x = SetStorageQuotaRequest{}
+	mi := &file_file_file_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStorageQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStorageQuotaRequest) ProtoMessage() {}
+
+func (x *SetStorageQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStorageQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetStorageQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetStorageQuotaRequest) GetOwnerType() StorageOwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return StorageOwnerType_STORAGE_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *SetStorageQuotaRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SetStorageQuotaRequest) GetTier() string {
+	if x != nil && x.Tier != nil {
+		return *x.Tier
+	}
+	return ""
+}
+
+func (x *SetStorageQuotaRequest) GetQuotaBytes() int64 {
+	if x != nil && x.QuotaBytes != nil {
+		return *x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *SetStorageQuotaRequest) GetClearQuota() bool {
+	if x != nil {
+		return x.ClearQuota
+	}
+	return false
+}
+
+// SetAvatarRequest set avatar request
+type SetAvatarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // uploader of the source image
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"` // uploaded image
+	OwnerType     StorageOwnerType       `protobuf:"varint,3,opt,name=owner_type,json=ownerType,proto3,enum=file.StorageOwnerType" json:"owner_type,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // user ID or group ID, permission is checked by the caller
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAvatarRequest) Reset() {
+	*x = SetAvatarRequest{}
+	mi := &file_file_file_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAvatarRequest) ProtoMessage() {}
+
+func (x *SetAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAvatarRequest.ProtoReflect.Descriptor instead.
+func (*SetAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetAvatarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetAvatarRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *SetAvatarRequest) GetOwnerType() StorageOwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return StorageOwnerType_STORAGE_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *SetAvatarRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+// RemoveAvatarRequest remove avatar request
+type RemoveAvatarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType     StorageOwnerType       `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=file.StorageOwnerType" json:"owner_type,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAvatarRequest) Reset() {
+	*x = RemoveAvatarRequest{}
+	mi := &file_file_file_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAvatarRequest) ProtoMessage() {}
+
+func (x *RemoveAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAvatarRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RemoveAvatarRequest) GetOwnerType() StorageOwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return StorageOwnerType_STORAGE_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *RemoveAvatarRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+// GenerateGroupAvatarRequest generate group avatar request
+type GenerateGroupAvatarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberIds     []string               `protobuf:"bytes,2,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"` // at most 9 are used, in display order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateGroupAvatarRequest) Reset() {
+	*x = GenerateGroupAvatarRequest{}
+	mi := &file_file_file_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateGroupAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateGroupAvatarRequest) ProtoMessage() {}
+
+func (x *GenerateGroupAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateGroupAvatarRequest.ProtoReflect.Descriptor instead.
+func (*GenerateGroupAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GenerateGroupAvatarRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GenerateGroupAvatarRequest) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+// Avatar public avatar URLs
+type Avatar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // largest size, the URL bound to the profile or group
+	Images        []*AvatarImage         `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Avatar) Reset() {
+	*x = Avatar{}
+	mi := &file_file_file_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Avatar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Avatar) ProtoMessage() {}
+
+func (x *Avatar) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Avatar.ProtoReflect.Descriptor instead.
+func (*Avatar) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Avatar) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Avatar) GetImages() []*AvatarImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// AvatarImage one avatar size
+type AvatarImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          int32                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"` // edge of the square image
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvatarImage) Reset() {
+	*x = AvatarImage{}
+	mi := &file_file_file_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvatarImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvatarImage) ProtoMessage() {}
+
+func (x *AvatarImage) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvatarImage.ProtoReflect.Descriptor instead.
+func (*AvatarImage) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AvatarImage) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AvatarImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// ListQuarantinedFilesRequest list quarantined files request
+type ListQuarantinedFilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"` // uploader filter
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedFilesRequest) Reset() {
+	*x = ListQuarantinedFilesRequest{}
+	mi := &file_file_file_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedFilesRequest) ProtoMessage() {}
+
+func (x *ListQuarantinedFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedFilesRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListQuarantinedFilesRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ListQuarantinedFilesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListQuarantinedFilesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListQuarantinedFilesResponse list quarantined files response
+type ListQuarantinedFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*QuarantinedFile     `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedFilesResponse) Reset() {
+	*x = ListQuarantinedFilesResponse{}
+	mi := &file_file_file_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedFilesResponse) ProtoMessage() {}
+
+func (x *ListQuarantinedFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedFilesResponse) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListQuarantinedFilesResponse) GetFiles() []*QuarantinedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ListQuarantinedFilesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetQuarantinedFileRequest get quarantined file request
+type GetQuarantinedFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuarantinedFileRequest) Reset() {
+	*x = GetQuarantinedFileRequest{}
+	mi := &file_file_file_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuarantinedFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuarantinedFileRequest) ProtoMessage() {}
+
+func (x *GetQuarantinedFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuarantinedFileRequest.ProtoReflect.Descriptor instead.
+func (*GetQuarantinedFileRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetQuarantinedFileRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+// ReviewQuarantinedFileRequest release or delete a quarantined file
+type ReviewQuarantinedFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"` // reason recorded with the verdict
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewQuarantinedFileRequest) Reset() {
+	*x = ReviewQuarantinedFileRequest{}
+	mi := &file_file_file_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewQuarantinedFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewQuarantinedFileRequest) ProtoMessage() {}
+
+func (x *ReviewQuarantinedFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewQuarantinedFileRequest.ProtoReflect.Descriptor instead.
+func (*ReviewQuarantinedFileRequest) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReviewQuarantinedFileRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *ReviewQuarantinedFileRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *ReviewQuarantinedFileRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// QuarantinedFile scanned file with its scan state and verdict history
+type QuarantinedFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          *FileInfo              `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	ScanStatus    FileScanStatus         `protobuf:"varint,2,opt,name=scan_status,json=scanStatus,proto3,enum=file.FileScanStatus" json:"scan_status,omitempty"`
+	Scanner       string                 `protobuf:"bytes,3,opt,name=scanner,proto3" json:"scanner,omitempty"`
+	Signature     string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`                  // detected malware, empty unless infected
+	LastError     string                 `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"` // last scanner failure
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ScannedAt     *int64                 `protobuf:"varint,7,opt,name=scanned_at,json=scannedAt,proto3,oneof" json:"scanned_at,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,8,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt    *int64                 `protobuf:"varint,9,opt,name=reviewed_at,json=reviewedAt,proto3,oneof" json:"reviewed_at,omitempty"`
+	Verdicts      []*FileScanVerdict     `protobuf:"bytes,10,rep,name=verdicts,proto3" json:"verdicts,omitempty"` // oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuarantinedFile) Reset() {
+	*x = QuarantinedFile{}
+	mi := &file_file_file_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuarantinedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuarantinedFile) ProtoMessage() {}
+
+func (x *QuarantinedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuarantinedFile.ProtoReflect.Descriptor instead.
+func (*QuarantinedFile) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *QuarantinedFile) GetFile() *FileInfo {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *QuarantinedFile) GetScanStatus() FileScanStatus {
+	if x != nil {
+		return x.ScanStatus
+	}
+	return FileScanStatus_FILE_SCAN_STATUS_UNSPECIFIED
+}
+
+func (x *QuarantinedFile) GetScanner() string {
+	if x != nil {
+		return x.Scanner
+	}
+	return ""
+}
+
+func (x *QuarantinedFile) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *QuarantinedFile) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *QuarantinedFile) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *QuarantinedFile) GetScannedAt() int64 {
+	if x != nil && x.ScannedAt != nil {
+		return *x.ScannedAt
+	}
+	return 0
+}
+
+func (x *QuarantinedFile) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *QuarantinedFile) GetReviewedAt() int64 {
+	if x != nil && x.ReviewedAt != nil {
+		return *x.ReviewedAt
+	}
+	return 0
+}
+
+func (x *QuarantinedFile) GetVerdicts() []*FileScanVerdict {
+	if x != nil {
+		return x.Verdicts
+	}
+	return nil
+}
+
+// FileScanVerdict one scan result or review decision
+type FileScanVerdict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verdict       string                 `protobuf:"bytes,1,opt,name=verdict,proto3" json:"verdict,omitempty"` // clean, infected, error, skipped, released, deleted
+	Scanner       string                 `protobuf:"bytes,2,opt,name=scanner,proto3" json:"scanner,omitempty"`
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`                  // scanner error or review note
+	AdminId       string                 `protobuf:"bytes,5,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"` // empty for scanner verdicts
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileScanVerdict) Reset() {
+	*x = FileScanVerdict{}
+	mi := &file_file_file_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileScanVerdict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileScanVerdict) ProtoMessage() {}
+
+func (x *FileScanVerdict) ProtoReflect() protoreflect.Message {
+	mi := &file_file_file_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileScanVerdict.ProtoReflect.Descriptor instead.
+func (*FileScanVerdict) Descriptor() ([]byte, []int) {
+	return file_file_file_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FileScanVerdict) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *FileScanVerdict) GetScanner() string {
+	if x != nil {
+		return x.Scanner
+	}
+	return ""
+}
+
+func (x *FileScanVerdict) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *FileScanVerdict) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *FileScanVerdict) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *FileScanVerdict) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+var File_file_file_proto protoreflect.FileDescriptor
+
+const file_file_file_proto_rawDesc = "" +
+	"\n" +
+	"\x0ffile/file.proto\x12\x04file\x1a\x13common/common.proto\"\xca\x04\n" +
+	"\bFileInfo\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12+\n" +
 	"\tfile_type\x18\x04 \x01(\x0e2\x0e.file.FileTypeR\bfileType\x12\x1b\n" +
 	"\tfile_size\x18\x05 \x01(\x03R\bfileSize\x12\x1b\n" +
@@ -1079,21 +3339,30 @@ const file_file_file_proto_rawDesc = "" +
 	"\v_expires_atB\v\n" +
 	"\t_metadataB\x0f\n" +
 	"\r_download_urlB\x10\n" +
-	"\x0e_thumbnail_url\"\xf5\x01\n" +
+	"\x0e_thumbnail_url\"\xca\x02\n" +
 	"\x1aGenerateUploadTokenRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1b\n" +
 	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12\x1b\n" +
 	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x12+\n" +
 	"\tfile_type\x18\x05 \x01(\x0e2\x0e.file.FileTypeR\bfileType\x12(\n" +
-	"\rexpires_hours\x18\x06 \x01(\x05H\x00R\fexpiresHours\x88\x01\x01B\x10\n" +
-	"\x0e_expires_hours\"t\n" +
+	"\rexpires_hours\x18\x06 \x01(\x05H\x00R\fexpiresHours\x88\x01\x01\x12\x1b\n" +
+	"\x06sha256\x18\a \x01(\tH\x01R\x06sha256\x88\x01\x01\x12\x1e\n" +
+	"\bgroup_id\x18\b \x01(\tH\x02R\agroupId\x88\x01\x01B\x10\n" +
+	"\x0e_expires_hoursB\t\n" +
+	"\a_sha256B\v\n" +
+	"\t_group_id\"\xba\x02\n" +
 	"\x1bGenerateUploadTokenResponse\x12\x17\n" +
 	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1d\n" +
 	"\n" +
 	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x12\x1d\n" +
 	"\n" +
-	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"I\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x12%\n" +
+	"\x0einstant_upload\x18\x04 \x01(\bR\rinstantUpload\x12[\n" +
+	"\x0eupload_headers\x18\x05 \x03(\v24.file.GenerateUploadTokenResponse.UploadHeadersEntryR\ruploadHeaders\x1a@\n" +
+	"\x12UploadHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"I\n" +
 	"\x15CompleteUploadRequest\x12\x17\n" +
 	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x90\x01\n" +
@@ -1101,13 +3370,29 @@ const file_file_file_proto_rawDesc = "" +
 	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12,\n" +
 	"\x0fexpires_minutes\x18\x03 \x01(\x05H\x00R\x0eexpiresMinutes\x88\x01\x01B\x12\n" +
-	"\x10_expires_minutes\"\x9b\x01\n" +
+	"\x10_expires_minutes\"\xe8\x01\n" +
 	"\x1bGenerateDownloadURLResponse\x12!\n" +
 	"\fdownload_url\x18\x01 \x01(\tR\vdownloadUrl\x12\x1d\n" +
 	"\n" +
 	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12(\n" +
-	"\rthumbnail_url\x18\x03 \x01(\tH\x00R\fthumbnailUrl\x88\x01\x01B\x10\n" +
-	"\x0e_thumbnail_url\"F\n" +
+	"\rthumbnail_url\x18\x03 \x01(\tH\x00R\fthumbnailUrl\x88\x01\x01\x122\n" +
+	"\n" +
+	"thumbnails\x18\x04 \x03(\v2\x12.file.ThumbnailURLR\n" +
+	"thumbnails\x12\x17\n" +
+	"\afile_id\x18\x05 \x01(\tR\x06fileIdB\x10\n" +
+	"\x0e_thumbnail_url\"\x98\x01\n" +
+	" BatchGenerateDownloadURLsRequest\x12\x19\n" +
+	"\bfile_ids\x18\x01 \x03(\tR\afileIds\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12,\n" +
+	"\x0fexpires_minutes\x18\x03 \x01(\x05H\x00R\x0eexpiresMinutes\x88\x01\x01B\x12\n" +
+	"\x10_expires_minutes\"Z\n" +
+	"!BatchGenerateDownloadURLsResponse\x125\n" +
+	"\x04urls\x18\x01 \x03(\v2!.file.GenerateDownloadURLResponseR\x04urls\"b\n" +
+	"\fThumbnailURL\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x05R\x04size\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\"F\n" +
 	"\x12GetFileInfoRequest\x12\x17\n" +
 	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"E\n" +
@@ -1132,34 +3417,235 @@ const file_file_file_proto_rawDesc = "" +
 	"\bfile_ids\x18\x01 \x03(\tR\afileIds\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"@\n" +
 	"\x18BatchGetFileInfoResponse\x12$\n" +
-	"\x05files\x18\x01 \x03(\v2\x0e.file.FileInfoR\x05files*\x8b\x01\n" +
+	"\x05files\x18\x01 \x03(\v2\x0e.file.FileInfoR\x05files\"\xd6\x02\n" +
+	"\x1eInitiateMultipartUploadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12\x1b\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x12+\n" +
+	"\tfile_type\x18\x05 \x01(\x0e2\x0e.file.FileTypeR\bfileType\x12 \n" +
+	"\tpart_size\x18\x06 \x01(\x03H\x00R\bpartSize\x88\x01\x01\x12(\n" +
+	"\rexpires_hours\x18\a \x01(\x05H\x01R\fexpiresHours\x88\x01\x01\x12\x1e\n" +
+	"\bgroup_id\x18\b \x01(\tH\x02R\agroupId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_part_sizeB\x10\n" +
+	"\x0e_expires_hoursB\v\n" +
+	"\t_group_id\"\xb1\x02\n" +
+	"\x0fMultipartUpload\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_size\x18\x04 \x01(\x03R\bfileSize\x12\x1b\n" +
+	"\tpart_size\x18\x05 \x01(\x03R\bpartSize\x12\x1f\n" +
+	"\vtotal_parts\x18\x06 \x01(\x05R\n" +
+	"totalParts\x12%\n" +
+	"\x0euploaded_parts\x18\a \x03(\x05R\ruploadedParts\x12*\n" +
+	"\x06status\x18\b \x01(\x0e2\x12.file.UploadStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\x03R\texpiresAt\"s\n" +
+	"\x18GetPartUploadURLsRequest\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fpart_numbers\x18\x03 \x03(\x05R\vpartNumbers\"O\n" +
+	"\rPartUploadURL\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\"e\n" +
+	"\x19GetPartUploadURLsResponse\x12)\n" +
+	"\x05parts\x18\x01 \x03(\v2\x13.file.PartUploadURLR\x05parts\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\"P\n" +
+	"\x18ListUploadedPartsRequest\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"W\n" +
+	"\fUploadedPart\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"\x9b\x01\n" +
+	"\x19ListUploadedPartsResponse\x12-\n" +
+	"\x06upload\x18\x01 \x01(\v2\x15.file.MultipartUploadR\x06upload\x12(\n" +
+	"\x05parts\x18\x02 \x03(\v2\x12.file.UploadedPartR\x05parts\x12%\n" +
+	"\x0euploaded_bytes\x18\x03 \x01(\x03R\ruploadedBytes\"V\n" +
+	"\x1eCompleteMultipartUploadRequest\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"S\n" +
+	"\x1bAbortMultipartUploadRequest\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"8\n" +
+	"\x1cAbortMultipartUploadResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe9\x01\n" +
+	"\x1dGrantMessageFileAccessRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12+\n" +
+	"\x11conversation_type\x18\x03 \x01(\x05R\x10conversationType\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\tR\btargetId\x12\x1b\n" +
+	"\tsender_id\x18\x05 \x01(\tR\bsenderId\x12\x19\n" +
+	"\bfile_ids\x18\x06 \x03(\tR\afileIds\"v\n" +
+	"\x1eGrantMessageFileAccessResponse\x12(\n" +
+	"\x10granted_file_ids\x18\x01 \x03(\tR\x0egrantedFileIds\x12*\n" +
+	"\x11rejected_file_ids\x18\x02 \x03(\tR\x0frejectedFileIds\"Y\n" +
+	"\x1eRevokeMessageFileAccessRequest\x12\x1f\n" +
+	"\vmessage_ids\x18\x01 \x03(\tR\n" +
+	"messageIds\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\";\n" +
+	"\x1fRevokeMessageFileAccessResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x03R\arevoked\"\xfe\x01\n" +
+	"\fStorageUsage\x125\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x16.file.StorageOwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x03 \x01(\x03R\tusedBytes\x12\x1f\n" +
+	"\vquota_bytes\x18\x04 \x01(\x03R\n" +
+	"quotaBytes\x12\x1d\n" +
+	"\n" +
+	"file_count\x18\x05 \x01(\x05R\tfileCount\x12\x12\n" +
+	"\x04tier\x18\x06 \x01(\tR\x04tier\x12)\n" +
+	"\x10quota_overridden\x18\a \x01(\bR\x0fquotaOverridden\"^\n" +
+	"\x16GetStorageUsageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\bgroup_id\x18\x02 \x01(\tH\x00R\agroupId\x88\x01\x01B\v\n" +
+	"\t_group_id\"o\n" +
+	"\x1bGetOwnerStorageUsageRequest\x125\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x16.file.StorageOwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"\xe3\x01\n" +
+	"\x16SetStorageQuotaRequest\x125\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x16.file.StorageOwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x17\n" +
+	"\x04tier\x18\x03 \x01(\tH\x00R\x04tier\x88\x01\x01\x12$\n" +
+	"\vquota_bytes\x18\x04 \x01(\x03H\x01R\n" +
+	"quotaBytes\x88\x01\x01\x12\x1f\n" +
+	"\vclear_quota\x18\x05 \x01(\bR\n" +
+	"clearQuotaB\a\n" +
+	"\x05_tierB\x0e\n" +
+	"\f_quota_bytes\"\x96\x01\n" +
+	"\x10SetAvatarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x125\n" +
+	"\n" +
+	"owner_type\x18\x03 \x01(\x0e2\x16.file.StorageOwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\"g\n" +
+	"\x13RemoveAvatarRequest\x125\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x16.file.StorageOwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"V\n" +
+	"\x1aGenerateGroupAvatarRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x02 \x03(\tR\tmemberIds\"E\n" +
+	"\x06Avatar\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12)\n" +
+	"\x06images\x18\x02 \x03(\v2\x11.file.AvatarImageR\x06images\"3\n" +
+	"\vAvatarImage\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x05R\x04size\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"x\n" +
+	"\x1bListQuarantinedFilesRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSizeB\n" +
+	"\n" +
+	"\b_user_id\"a\n" +
+	"\x1cListQuarantinedFilesResponse\x12+\n" +
+	"\x05files\x18\x01 \x03(\v2\x15.file.QuarantinedFileR\x05files\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"4\n" +
+	"\x19GetQuarantinedFileRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\"f\n" +
+	"\x1cReviewQuarantinedFileRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\x9c\x03\n" +
+	"\x0fQuarantinedFile\x12\"\n" +
+	"\x04file\x18\x01 \x01(\v2\x0e.file.FileInfoR\x04file\x125\n" +
+	"\vscan_status\x18\x02 \x01(\x0e2\x14.file.FileScanStatusR\n" +
+	"scanStatus\x12\x18\n" +
+	"\ascanner\x18\x03 \x01(\tR\ascanner\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x05 \x01(\tR\tlastError\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\"\n" +
+	"\n" +
+	"scanned_at\x18\a \x01(\x03H\x00R\tscannedAt\x88\x01\x01\x12\x1f\n" +
+	"\vreviewed_by\x18\b \x01(\tR\n" +
+	"reviewedBy\x12$\n" +
+	"\vreviewed_at\x18\t \x01(\x03H\x01R\n" +
+	"reviewedAt\x88\x01\x01\x121\n" +
+	"\bverdicts\x18\n" +
+	" \x03(\v2\x15.file.FileScanVerdictR\bverdictsB\r\n" +
+	"\v_scanned_atB\x0e\n" +
+	"\f_reviewed_at\"\xb5\x01\n" +
+	"\x0fFileScanVerdict\x12\x18\n" +
+	"\averdict\x18\x01 \x01(\tR\averdict\x12\x18\n" +
+	"\ascanner\x18\x02 \x01(\tR\ascanner\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x12\x19\n" +
+	"\badmin_id\x18\x05 \x01(\tR\aadminId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt*\x8b\x01\n" +
 	"\bFileType\x12\x19\n" +
 	"\x15FILE_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fFILE_TYPE_IMAGE\x10\x01\x12\x13\n" +
 	"\x0fFILE_TYPE_VIDEO\x10\x02\x12\x13\n" +
 	"\x0fFILE_TYPE_AUDIO\x10\x03\x12\x12\n" +
 	"\x0eFILE_TYPE_FILE\x10\x04\x12\x11\n" +
-	"\rFILE_TYPE_LOG\x10\x05*Y\n" +
+	"\rFILE_TYPE_LOG\x10\x05*v\n" +
 	"\n" +
 	"FileStatus\x12\x17\n" +
 	"\x13FILE_STATUS_DELETED\x10\x00\x12\x16\n" +
 	"\x12FILE_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
-	"\x16FILE_STATUS_PROCESSING\x10\x02*\x9c\x01\n" +
+	"\x16FILE_STATUS_PROCESSING\x10\x02\x12\x1b\n" +
+	"\x17FILE_STATUS_QUARANTINED\x10\x03*\x9c\x01\n" +
 	"\fUploadStatus\x12\x1d\n" +
 	"\x19UPLOAD_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15UPLOAD_STATUS_PENDING\x10\x01\x12\x1b\n" +
 	"\x17UPLOAD_STATUS_UPLOADING\x10\x02\x12\x1b\n" +
 	"\x17UPLOAD_STATUS_COMPLETED\x10\x03\x12\x18\n" +
-	"\x14UPLOAD_STATUS_FAILED\x10\x042\x9b\x04\n" +
+	"\x14UPLOAD_STATUS_FAILED\x10\x04*q\n" +
+	"\x10StorageOwnerType\x12\"\n" +
+	"\x1eSTORAGE_OWNER_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17STORAGE_OWNER_TYPE_USER\x10\x01\x12\x1c\n" +
+	"\x18STORAGE_OWNER_TYPE_GROUP\x10\x02*\x88\x02\n" +
+	"\x0eFileScanStatus\x12 \n" +
+	"\x1cFILE_SCAN_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18FILE_SCAN_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18FILE_SCAN_STATUS_RUNNING\x10\x02\x12\x1a\n" +
+	"\x16FILE_SCAN_STATUS_CLEAN\x10\x03\x12 \n" +
+	"\x1cFILE_SCAN_STATUS_QUARANTINED\x10\x04\x12\x1d\n" +
+	"\x19FILE_SCAN_STATUS_RELEASED\x10\x05\x12\x1d\n" +
+	"\x19FILE_SCAN_STATUS_REJECTED\x10\x06\x12\x1c\n" +
+	"\x18FILE_SCAN_STATUS_SKIPPED\x10\a2\xd3\x10\n" +
 	"\vFileService\x12Z\n" +
 	"\x13GenerateUploadToken\x12 .file.GenerateUploadTokenRequest\x1a!.file.GenerateUploadTokenResponse\x12=\n" +
 	"\x0eCompleteUpload\x12\x1b.file.CompleteUploadRequest\x1a\x0e.file.FileInfo\x12Z\n" +
-	"\x13GenerateDownloadURL\x12 .file.GenerateDownloadURLRequest\x1a!.file.GenerateDownloadURLResponse\x127\n" +
+	"\x13GenerateDownloadURL\x12 .file.GenerateDownloadURLRequest\x1a!.file.GenerateDownloadURLResponse\x12l\n" +
+	"\x19BatchGenerateDownloadURLs\x12&.file.BatchGenerateDownloadURLsRequest\x1a'.file.BatchGenerateDownloadURLsResponse\x127\n" +
 	"\vGetFileInfo\x12\x18.file.GetFileInfoRequest\x1a\x0e.file.FileInfo\x12?\n" +
 	"\n" +
 	"DeleteFile\x12\x17.file.DeleteFileRequest\x1a\x18.file.DeleteFileResponse\x12H\n" +
 	"\rListUserFiles\x12\x1a.file.ListUserFilesRequest\x1a\x1b.file.ListUserFilesResponse\x12Q\n" +
-	"\x10BatchGetFileInfo\x12\x1d.file.BatchGetFileInfoRequest\x1a\x1e.file.BatchGetFileInfoResponseB/Z-github.com/anychat/server/api/proto/file;fileb\x06proto3"
+	"\x10BatchGetFileInfo\x12\x1d.file.BatchGetFileInfoRequest\x1a\x1e.file.BatchGetFileInfoResponse\x12\\\n" +
+	"\rEraseUserData\x12$.anychat.common.EraseUserDataRequest\x1a%.anychat.common.EraseUserDataResponse\x12V\n" +
+	"\x17InitiateMultipartUpload\x12$.file.InitiateMultipartUploadRequest\x1a\x15.file.MultipartUpload\x12T\n" +
+	"\x11GetPartUploadURLs\x12\x1e.file.GetPartUploadURLsRequest\x1a\x1f.file.GetPartUploadURLsResponse\x12T\n" +
+	"\x11ListUploadedParts\x12\x1e.file.ListUploadedPartsRequest\x1a\x1f.file.ListUploadedPartsResponse\x12O\n" +
+	"\x17CompleteMultipartUpload\x12$.file.CompleteMultipartUploadRequest\x1a\x0e.file.FileInfo\x12]\n" +
+	"\x14AbortMultipartUpload\x12!.file.AbortMultipartUploadRequest\x1a\".file.AbortMultipartUploadResponse\x12c\n" +
+	"\x16GrantMessageFileAccess\x12#.file.GrantMessageFileAccessRequest\x1a$.file.GrantMessageFileAccessResponse\x12f\n" +
+	"\x17RevokeMessageFileAccess\x12$.file.RevokeMessageFileAccessRequest\x1a%.file.RevokeMessageFileAccessResponse\x12C\n" +
+	"\x0fGetStorageUsage\x12\x1c.file.GetStorageUsageRequest\x1a\x12.file.StorageUsage\x12M\n" +
+	"\x14GetOwnerStorageUsage\x12!.file.GetOwnerStorageUsageRequest\x1a\x12.file.StorageUsage\x12C\n" +
+	"\x0fSetStorageQuota\x12\x1c.file.SetStorageQuotaRequest\x1a\x12.file.StorageUsage\x121\n" +
+	"\tSetAvatar\x12\x16.file.SetAvatarRequest\x1a\f.file.Avatar\x12@\n" +
+	"\fRemoveAvatar\x12\x19.file.RemoveAvatarRequest\x1a\x15.anychat.common.Empty\x12E\n" +
+	"\x13GenerateGroupAvatar\x12 .file.GenerateGroupAvatarRequest\x1a\f.file.Avatar\x12]\n" +
+	"\x14ListQuarantinedFiles\x12!.file.ListQuarantinedFilesRequest\x1a\".file.ListQuarantinedFilesResponse\x12L\n" +
+	"\x12GetQuarantinedFile\x12\x1f.file.GetQuarantinedFileRequest\x1a\x15.file.QuarantinedFile\x12S\n" +
+	"\x16ReleaseQuarantinedFile\x12\".file.ReviewQuarantinedFileRequest\x1a\x15.file.QuarantinedFile\x12R\n" +
+	"\x15DeleteQuarantinedFile\x12\".file.ReviewQuarantinedFileRequest\x1a\x15.file.QuarantinedFileB/Z-github.com/anychat/server/api/proto/file;fileb\x06proto3"
 
 var (
 	file_file_file_proto_rawDescOnce sync.Once
@@ -1173,52 +3659,147 @@ func file_file_file_proto_rawDescGZIP() []byte {
 	return file_file_file_proto_rawDescData
 }
 
-var file_file_file_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_file_file_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_file_file_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_file_file_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_file_file_proto_goTypes = []any{
-	(FileType)(0),                       // 0: file.FileType
-	(FileStatus)(0),                     // 1: file.FileStatus
-	(UploadStatus)(0),                   // 2: file.UploadStatus
-	(*FileInfo)(nil),                    // 3: file.FileInfo
-	(*GenerateUploadTokenRequest)(nil),  // 4: file.GenerateUploadTokenRequest
-	(*GenerateUploadTokenResponse)(nil), // 5: file.GenerateUploadTokenResponse
-	(*CompleteUploadRequest)(nil),       // 6: file.CompleteUploadRequest
-	(*GenerateDownloadURLRequest)(nil),  // 7: file.GenerateDownloadURLRequest
-	(*GenerateDownloadURLResponse)(nil), // 8: file.GenerateDownloadURLResponse
-	(*GetFileInfoRequest)(nil),          // 9: file.GetFileInfoRequest
-	(*DeleteFileRequest)(nil),           // 10: file.DeleteFileRequest
-	(*DeleteFileResponse)(nil),          // 11: file.DeleteFileResponse
-	(*ListUserFilesRequest)(nil),        // 12: file.ListUserFilesRequest
-	(*ListUserFilesResponse)(nil),       // 13: file.ListUserFilesResponse
-	(*BatchGetFileInfoRequest)(nil),     // 14: file.BatchGetFileInfoRequest
-	(*BatchGetFileInfoResponse)(nil),    // 15: file.BatchGetFileInfoResponse
+	(FileType)(0),                             // 0: file.FileType
+	(FileStatus)(0),                           // 1: file.FileStatus
+	(UploadStatus)(0),                         // 2: file.UploadStatus
+	(StorageOwnerType)(0),                     // 3: file.StorageOwnerType
+	(FileScanStatus)(0),                       // 4: file.FileScanStatus
+	(*FileInfo)(nil),                          // 5: file.FileInfo
+	(*GenerateUploadTokenRequest)(nil),        // 6: file.GenerateUploadTokenRequest
+	(*GenerateUploadTokenResponse)(nil),       // 7: file.GenerateUploadTokenResponse
+	(*CompleteUploadRequest)(nil),             // 8: file.CompleteUploadRequest
+	(*GenerateDownloadURLRequest)(nil),        // 9: file.GenerateDownloadURLRequest
+	(*GenerateDownloadURLResponse)(nil),       // 10: file.GenerateDownloadURLResponse
+	(*BatchGenerateDownloadURLsRequest)(nil),  // 11: file.BatchGenerateDownloadURLsRequest
+	(*BatchGenerateDownloadURLsResponse)(nil), // 12: file.BatchGenerateDownloadURLsResponse
+	(*ThumbnailURL)(nil),                      // 13: file.ThumbnailURL
+	(*GetFileInfoRequest)(nil),                // 14: file.GetFileInfoRequest
+	(*DeleteFileRequest)(nil),                 // 15: file.DeleteFileRequest
+	(*DeleteFileResponse)(nil),                // 16: file.DeleteFileResponse
+	(*ListUserFilesRequest)(nil),              // 17: file.ListUserFilesRequest
+	(*ListUserFilesResponse)(nil),             // 18: file.ListUserFilesResponse
+	(*BatchGetFileInfoRequest)(nil),           // 19: file.BatchGetFileInfoRequest
+	(*BatchGetFileInfoResponse)(nil),          // 20: file.BatchGetFileInfoResponse
+	(*InitiateMultipartUploadRequest)(nil),    // 21: file.InitiateMultipartUploadRequest
+	(*MultipartUpload)(nil),                   // 22: file.MultipartUpload
+	(*GetPartUploadURLsRequest)(nil),          // 23: file.GetPartUploadURLsRequest
+	(*PartUploadURL)(nil),                     // 24: file.PartUploadURL
+	(*GetPartUploadURLsResponse)(nil),         // 25: file.GetPartUploadURLsResponse
+	(*ListUploadedPartsRequest)(nil),          // 26: file.ListUploadedPartsRequest
+	(*UploadedPart)(nil),                      // 27: file.UploadedPart
+	(*ListUploadedPartsResponse)(nil),         // 28: file.ListUploadedPartsResponse
+	(*CompleteMultipartUploadRequest)(nil),    // 29: file.CompleteMultipartUploadRequest
+	(*AbortMultipartUploadRequest)(nil),       // 30: file.AbortMultipartUploadRequest
+	(*AbortMultipartUploadResponse)(nil),      // 31: file.AbortMultipartUploadResponse
+	(*GrantMessageFileAccessRequest)(nil),     // 32: file.GrantMessageFileAccessRequest
+	(*GrantMessageFileAccessResponse)(nil),    // 33: file.GrantMessageFileAccessResponse
+	(*RevokeMessageFileAccessRequest)(nil),    // 34: file.RevokeMessageFileAccessRequest
+	(*RevokeMessageFileAccessResponse)(nil),   // 35: file.RevokeMessageFileAccessResponse
+	(*StorageUsage)(nil),                      // 36: file.StorageUsage
+	(*GetStorageUsageRequest)(nil),            // 37: file.GetStorageUsageRequest
+	(*GetOwnerStorageUsageRequest)(nil),       // 38: file.GetOwnerStorageUsageRequest
+	(*SetStorageQuotaRequest)(nil),            // 39: file.SetStorageQuotaRequest
+	(*SetAvatarRequest)(nil),                  // 40: file.SetAvatarRequest
+	(*RemoveAvatarRequest)(nil),               // 41: file.RemoveAvatarRequest
+	(*GenerateGroupAvatarRequest)(nil),        // 42: file.GenerateGroupAvatarRequest
+	(*Avatar)(nil),                            // 43: file.Avatar
+	(*AvatarImage)(nil),                       // 44: file.AvatarImage
+	(*ListQuarantinedFilesRequest)(nil),       // 45: file.ListQuarantinedFilesRequest
+	(*ListQuarantinedFilesResponse)(nil),      // 46: file.ListQuarantinedFilesResponse
+	(*GetQuarantinedFileRequest)(nil),         // 47: file.GetQuarantinedFileRequest
+	(*ReviewQuarantinedFileRequest)(nil),      // 48: file.ReviewQuarantinedFileRequest
+	(*QuarantinedFile)(nil),                   // 49: file.QuarantinedFile
+	(*FileScanVerdict)(nil),                   // 50: file.FileScanVerdict
+	nil,                                       // 51: file.GenerateUploadTokenResponse.UploadHeadersEntry
+	(*common.EraseUserDataRequest)(nil),       // 52: anychat.common.EraseUserDataRequest
+	(*common.EraseUserDataResponse)(nil),      // 53: anychat.common.EraseUserDataResponse
+	(*common.Empty)(nil),                      // 54: anychat.common.Empty
 }
 var file_file_file_proto_depIdxs = []int32{
 	0,  // 0: file.FileInfo.file_type:type_name -> file.FileType
 	1,  // 1: file.FileInfo.status:type_name -> file.FileStatus
 	0,  // 2: file.GenerateUploadTokenRequest.file_type:type_name -> file.FileType
-	0,  // 3: file.ListUserFilesRequest.file_type:type_name -> file.FileType
-	3,  // 4: file.ListUserFilesResponse.files:type_name -> file.FileInfo
-	3,  // 5: file.BatchGetFileInfoResponse.files:type_name -> file.FileInfo
-	4,  // 6: file.FileService.GenerateUploadToken:input_type -> file.GenerateUploadTokenRequest
-	6,  // 7: file.FileService.CompleteUpload:input_type -> file.CompleteUploadRequest
-	7,  // 8: file.FileService.GenerateDownloadURL:input_type -> file.GenerateDownloadURLRequest
-	9,  // 9: file.FileService.GetFileInfo:input_type -> file.GetFileInfoRequest
-	10, // 10: file.FileService.DeleteFile:input_type -> file.DeleteFileRequest
-	12, // 11: file.FileService.ListUserFiles:input_type -> file.ListUserFilesRequest
-	14, // 12: file.FileService.BatchGetFileInfo:input_type -> file.BatchGetFileInfoRequest
-	5,  // 13: file.FileService.GenerateUploadToken:output_type -> file.GenerateUploadTokenResponse
-	3,  // 14: file.FileService.CompleteUpload:output_type -> file.FileInfo
-	8,  // 15: file.FileService.GenerateDownloadURL:output_type -> file.GenerateDownloadURLResponse
-	3,  // 16: file.FileService.GetFileInfo:output_type -> file.FileInfo
-	11, // 17: file.FileService.DeleteFile:output_type -> file.DeleteFileResponse
-	13, // 18: file.FileService.ListUserFiles:output_type -> file.ListUserFilesResponse
-	15, // 19: file.FileService.BatchGetFileInfo:output_type -> file.BatchGetFileInfoResponse
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	51, // 3: file.GenerateUploadTokenResponse.upload_headers:type_name -> file.GenerateUploadTokenResponse.UploadHeadersEntry
+	13, // 4: file.GenerateDownloadURLResponse.thumbnails:type_name -> file.ThumbnailURL
+	10, // 5: file.BatchGenerateDownloadURLsResponse.urls:type_name -> file.GenerateDownloadURLResponse
+	0,  // 6: file.ListUserFilesRequest.file_type:type_name -> file.FileType
+	5,  // 7: file.ListUserFilesResponse.files:type_name -> file.FileInfo
+	5,  // 8: file.BatchGetFileInfoResponse.files:type_name -> file.FileInfo
+	0,  // 9: file.InitiateMultipartUploadRequest.file_type:type_name -> file.FileType
+	2,  // 10: file.MultipartUpload.status:type_name -> file.UploadStatus
+	24, // 11: file.GetPartUploadURLsResponse.parts:type_name -> file.PartUploadURL
+	22, // 12: file.ListUploadedPartsResponse.upload:type_name -> file.MultipartUpload
+	27, // 13: file.ListUploadedPartsResponse.parts:type_name -> file.UploadedPart
+	3,  // 14: file.StorageUsage.owner_type:type_name -> file.StorageOwnerType
+	3,  // 15: file.GetOwnerStorageUsageRequest.owner_type:type_name -> file.StorageOwnerType
+	3,  // 16: file.SetStorageQuotaRequest.owner_type:type_name -> file.StorageOwnerType
+	3,  // 17: file.SetAvatarRequest.owner_type:type_name -> file.StorageOwnerType
+	3,  // 18: file.RemoveAvatarRequest.owner_type:type_name -> file.StorageOwnerType
+	44, // 19: file.Avatar.images:type_name -> file.AvatarImage
+	49, // 20: file.ListQuarantinedFilesResponse.files:type_name -> file.QuarantinedFile
+	5,  // 21: file.QuarantinedFile.file:type_name -> file.FileInfo
+	4,  // 22: file.QuarantinedFile.scan_status:type_name -> file.FileScanStatus
+	50, // 23: file.QuarantinedFile.verdicts:type_name -> file.FileScanVerdict
+	6,  // 24: file.FileService.GenerateUploadToken:input_type -> file.GenerateUploadTokenRequest
+	8,  // 25: file.FileService.CompleteUpload:input_type -> file.CompleteUploadRequest
+	9,  // 26: file.FileService.GenerateDownloadURL:input_type -> file.GenerateDownloadURLRequest
+	11, // 27: file.FileService.BatchGenerateDownloadURLs:input_type -> file.BatchGenerateDownloadURLsRequest
+	14, // 28: file.FileService.GetFileInfo:input_type -> file.GetFileInfoRequest
+	15, // 29: file.FileService.DeleteFile:input_type -> file.DeleteFileRequest
+	17, // 30: file.FileService.ListUserFiles:input_type -> file.ListUserFilesRequest
+	19, // 31: file.FileService.BatchGetFileInfo:input_type -> file.BatchGetFileInfoRequest
+	52, // 32: file.FileService.EraseUserData:input_type -> anychat.common.EraseUserDataRequest
+	21, // 33: file.FileService.InitiateMultipartUpload:input_type -> file.InitiateMultipartUploadRequest
+	23, // 34: file.FileService.GetPartUploadURLs:input_type -> file.GetPartUploadURLsRequest
+	26, // 35: file.FileService.ListUploadedParts:input_type -> file.ListUploadedPartsRequest
+	29, // 36: file.FileService.CompleteMultipartUpload:input_type -> file.CompleteMultipartUploadRequest
+	30, // 37: file.FileService.AbortMultipartUpload:input_type -> file.AbortMultipartUploadRequest
+	32, // 38: file.FileService.GrantMessageFileAccess:input_type -> file.GrantMessageFileAccessRequest
+	34, // 39: file.FileService.RevokeMessageFileAccess:input_type -> file.RevokeMessageFileAccessRequest
+	37, // 40: file.FileService.GetStorageUsage:input_type -> file.GetStorageUsageRequest
+	38, // 41: file.FileService.GetOwnerStorageUsage:input_type -> file.GetOwnerStorageUsageRequest
+	39, // 42: file.FileService.SetStorageQuota:input_type -> file.SetStorageQuotaRequest
+	40, // 43: file.FileService.SetAvatar:input_type -> file.SetAvatarRequest
+	41, // 44: file.FileService.RemoveAvatar:input_type -> file.RemoveAvatarRequest
+	42, // 45: file.FileService.GenerateGroupAvatar:input_type -> file.GenerateGroupAvatarRequest
+	45, // 46: file.FileService.ListQuarantinedFiles:input_type -> file.ListQuarantinedFilesRequest
+	47, // 47: file.FileService.GetQuarantinedFile:input_type -> file.GetQuarantinedFileRequest
+	48, // 48: file.FileService.ReleaseQuarantinedFile:input_type -> file.ReviewQuarantinedFileRequest
+	48, // 49: file.FileService.DeleteQuarantinedFile:input_type -> file.ReviewQuarantinedFileRequest
+	7,  // 50: file.FileService.GenerateUploadToken:output_type -> file.GenerateUploadTokenResponse
+	5,  // 51: file.FileService.CompleteUpload:output_type -> file.FileInfo
+	10, // 52: file.FileService.GenerateDownloadURL:output_type -> file.GenerateDownloadURLResponse
+	12, // 53: file.FileService.BatchGenerateDownloadURLs:output_type -> file.BatchGenerateDownloadURLsResponse
+	5,  // 54: file.FileService.GetFileInfo:output_type -> file.FileInfo
+	16, // 55: file.FileService.DeleteFile:output_type -> file.DeleteFileResponse
+	18, // 56: file.FileService.ListUserFiles:output_type -> file.ListUserFilesResponse
+	20, // 57: file.FileService.BatchGetFileInfo:output_type -> file.BatchGetFileInfoResponse
+	53, // 58: file.FileService.EraseUserData:output_type -> anychat.common.EraseUserDataResponse
+	22, // 59: file.FileService.InitiateMultipartUpload:output_type -> file.MultipartUpload
+	25, // 60: file.FileService.GetPartUploadURLs:output_type -> file.GetPartUploadURLsResponse
+	28, // 61: file.FileService.ListUploadedParts:output_type -> file.ListUploadedPartsResponse
+	5,  // 62: file.FileService.CompleteMultipartUpload:output_type -> file.FileInfo
+	31, // 63: file.FileService.AbortMultipartUpload:output_type -> file.AbortMultipartUploadResponse
+	33, // 64: file.FileService.GrantMessageFileAccess:output_type -> file.GrantMessageFileAccessResponse
+	35, // 65: file.FileService.RevokeMessageFileAccess:output_type -> file.RevokeMessageFileAccessResponse
+	36, // 66: file.FileService.GetStorageUsage:output_type -> file.StorageUsage
+	36, // 67: file.FileService.GetOwnerStorageUsage:output_type -> file.StorageUsage
+	36, // 68: file.FileService.SetStorageQuota:output_type -> file.StorageUsage
+	43, // 69: file.FileService.SetAvatar:output_type -> file.Avatar
+	54, // 70: file.FileService.RemoveAvatar:output_type -> anychat.common.Empty
+	43, // 71: file.FileService.GenerateGroupAvatar:output_type -> file.Avatar
+	46, // 72: file.FileService.ListQuarantinedFiles:output_type -> file.ListQuarantinedFilesResponse
+	49, // 73: file.FileService.GetQuarantinedFile:output_type -> file.QuarantinedFile
+	49, // 74: file.FileService.ReleaseQuarantinedFile:output_type -> file.QuarantinedFile
+	49, // 75: file.FileService.DeleteQuarantinedFile:output_type -> file.QuarantinedFile
+	50, // [50:76] is the sub-list for method output_type
+	24, // [24:50] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_file_file_proto_init() }
@@ -1230,14 +3811,20 @@ func file_file_file_proto_init() {
 	file_file_file_proto_msgTypes[1].OneofWrappers = []any{}
 	file_file_file_proto_msgTypes[4].OneofWrappers = []any{}
 	file_file_file_proto_msgTypes[5].OneofWrappers = []any{}
-	file_file_file_proto_msgTypes[9].OneofWrappers = []any{}
+	file_file_file_proto_msgTypes[6].OneofWrappers = []any{}
+	file_file_file_proto_msgTypes[12].OneofWrappers = []any{}
+	file_file_file_proto_msgTypes[16].OneofWrappers = []any{}
+	file_file_file_proto_msgTypes[32].OneofWrappers = []any{}
+	file_file_file_proto_msgTypes[34].OneofWrappers = []any{}
+	file_file_file_proto_msgTypes[40].OneofWrappers = []any{}
+	file_file_file_proto_msgTypes[44].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_file_file_proto_rawDesc), len(file_file_file_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   13,
+			NumEnums:      5,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -8,6 +8,7 @@ package file
 
 import (
 	context "context"
+	common "github.com/anychat/server/api/proto/common"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,35 +20,92 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FileService_GenerateUploadToken_FullMethodName = "/file.FileService/GenerateUploadToken"
-	FileService_CompleteUpload_FullMethodName      = "/file.FileService/CompleteUpload"
-	FileService_GenerateDownloadURL_FullMethodName = "/file.FileService/GenerateDownloadURL"
-	FileService_GetFileInfo_FullMethodName         = "/file.FileService/GetFileInfo"
-	FileService_DeleteFile_FullMethodName          = "/file.FileService/DeleteFile"
-	FileService_ListUserFiles_FullMethodName       = "/file.FileService/ListUserFiles"
-	FileService_BatchGetFileInfo_FullMethodName    = "/file.FileService/BatchGetFileInfo"
+	FileService_GenerateUploadToken_FullMethodName       = "/file.FileService/GenerateUploadToken"
+	FileService_CompleteUpload_FullMethodName            = "/file.FileService/CompleteUpload"
+	FileService_GenerateDownloadURL_FullMethodName       = "/file.FileService/GenerateDownloadURL"
+	FileService_BatchGenerateDownloadURLs_FullMethodName = "/file.FileService/BatchGenerateDownloadURLs"
+	FileService_GetFileInfo_FullMethodName               = "/file.FileService/GetFileInfo"
+	FileService_DeleteFile_FullMethodName                = "/file.FileService/DeleteFile"
+	FileService_ListUserFiles_FullMethodName             = "/file.FileService/ListUserFiles"
+	FileService_BatchGetFileInfo_FullMethodName          = "/file.FileService/BatchGetFileInfo"
+	FileService_EraseUserData_FullMethodName             = "/file.FileService/EraseUserData"
+	FileService_InitiateMultipartUpload_FullMethodName   = "/file.FileService/InitiateMultipartUpload"
+	FileService_GetPartUploadURLs_FullMethodName         = "/file.FileService/GetPartUploadURLs"
+	FileService_ListUploadedParts_FullMethodName         = "/file.FileService/ListUploadedParts"
+	FileService_CompleteMultipartUpload_FullMethodName   = "/file.FileService/CompleteMultipartUpload"
+	FileService_AbortMultipartUpload_FullMethodName      = "/file.FileService/AbortMultipartUpload"
+	FileService_GrantMessageFileAccess_FullMethodName    = "/file.FileService/GrantMessageFileAccess"
+	FileService_RevokeMessageFileAccess_FullMethodName   = "/file.FileService/RevokeMessageFileAccess"
+	FileService_GetStorageUsage_FullMethodName           = "/file.FileService/GetStorageUsage"
+	FileService_GetOwnerStorageUsage_FullMethodName      = "/file.FileService/GetOwnerStorageUsage"
+	FileService_SetStorageQuota_FullMethodName           = "/file.FileService/SetStorageQuota"
+	FileService_SetAvatar_FullMethodName                 = "/file.FileService/SetAvatar"
+	FileService_RemoveAvatar_FullMethodName              = "/file.FileService/RemoveAvatar"
+	FileService_GenerateGroupAvatar_FullMethodName       = "/file.FileService/GenerateGroupAvatar"
+	FileService_ListQuarantinedFiles_FullMethodName      = "/file.FileService/ListQuarantinedFiles"
+	FileService_GetQuarantinedFile_FullMethodName        = "/file.FileService/GetQuarantinedFile"
+	FileService_ReleaseQuarantinedFile_FullMethodName    = "/file.FileService/ReleaseQuarantinedFile"
+	FileService_DeleteQuarantinedFile_FullMethodName     = "/file.FileService/DeleteQuarantinedFile"
 )
 
 // FileServiceClient is the client API for FileService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// FileService 文件服务
+// FileService file service
 type FileServiceClient interface {
-	// GenerateUploadToken 生成上传凭证
+	// GenerateUploadToken generate upload token
 	GenerateUploadToken(ctx context.Context, in *GenerateUploadTokenRequest, opts ...grpc.CallOption) (*GenerateUploadTokenResponse, error)
-	// CompleteUpload 完成上传
+	// CompleteUpload complete upload
 	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*FileInfo, error)
-	// GenerateDownloadURL 生成下载链接
+	// GenerateDownloadURL generate download URL
 	GenerateDownloadURL(ctx context.Context, in *GenerateDownloadURLRequest, opts ...grpc.CallOption) (*GenerateDownloadURLResponse, error)
-	// GetFileInfo 获取文件信息
+	// BatchGenerateDownloadURLs generate download URLs of several files, unreadable files are left out
+	BatchGenerateDownloadURLs(ctx context.Context, in *BatchGenerateDownloadURLsRequest, opts ...grpc.CallOption) (*BatchGenerateDownloadURLsResponse, error)
+	// GetFileInfo get file info
 	GetFileInfo(ctx context.Context, in *GetFileInfoRequest, opts ...grpc.CallOption) (*FileInfo, error)
-	// DeleteFile 删除文件
+	// DeleteFile delete file
 	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
-	// ListUserFiles 列出用户文件
+	// ListUserFiles list user files
 	ListUserFiles(ctx context.Context, in *ListUserFilesRequest, opts ...grpc.CallOption) (*ListUserFilesResponse, error)
-	// BatchGetFileInfo 批量获取文件信息
+	// BatchGetFileInfo batch get file info
 	BatchGetFileInfo(ctx context.Context, in *BatchGetFileInfoRequest, opts ...grpc.CallOption) (*BatchGetFileInfoResponse, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error)
+	// InitiateMultipartUpload start a resumable multipart upload
+	InitiateMultipartUpload(ctx context.Context, in *InitiateMultipartUploadRequest, opts ...grpc.CallOption) (*MultipartUpload, error)
+	// GetPartUploadURLs presign upload URLs for parts
+	GetPartUploadURLs(ctx context.Context, in *GetPartUploadURLsRequest, opts ...grpc.CallOption) (*GetPartUploadURLsResponse, error)
+	// ListUploadedParts list parts already stored, used to resume an interrupted upload
+	ListUploadedParts(ctx context.Context, in *ListUploadedPartsRequest, opts ...grpc.CallOption) (*ListUploadedPartsResponse, error)
+	// CompleteMultipartUpload assemble the parts and activate the file
+	CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*FileInfo, error)
+	// AbortMultipartUpload cancel an upload and free its parts
+	AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error)
+	// GrantMessageFileAccess link the files of a sent message to its conversation (called by message-service)
+	GrantMessageFileAccess(ctx context.Context, in *GrantMessageFileAccessRequest, opts ...grpc.CallOption) (*GrantMessageFileAccessResponse, error)
+	// RevokeMessageFileAccess revoke the grants of recalled or deleted messages (called by message-service)
+	RevokeMessageFileAccess(ctx context.Context, in *RevokeMessageFileAccessRequest, opts ...grpc.CallOption) (*RevokeMessageFileAccessResponse, error)
+	// GetStorageUsage get the storage usage and quota of the user, or of a group the user belongs to
+	GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*StorageUsage, error)
+	// GetOwnerStorageUsage get the storage usage and quota of any user or group (called by admin-service)
+	GetOwnerStorageUsage(ctx context.Context, in *GetOwnerStorageUsageRequest, opts ...grpc.CallOption) (*StorageUsage, error)
+	// SetStorageQuota change the tier or quota override of a user or group (called by admin-service)
+	SetStorageQuota(ctx context.Context, in *SetStorageQuotaRequest, opts ...grpc.CallOption) (*StorageUsage, error)
+	// SetAvatar crop and resize an uploaded image into the avatar of a user or group (called by user-service and group-service)
+	SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...grpc.CallOption) (*Avatar, error)
+	// RemoveAvatar delete the avatar images of a user or group (called by user-service and group-service)
+	RemoveAvatar(ctx context.Context, in *RemoveAvatarRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// GenerateGroupAvatar compose the default avatar of a group from member avatars (called by group-service)
+	GenerateGroupAvatar(ctx context.Context, in *GenerateGroupAvatarRequest, opts ...grpc.CallOption) (*Avatar, error)
+	// ListQuarantinedFiles list files held back by the malware scan (called by admin-service)
+	ListQuarantinedFiles(ctx context.Context, in *ListQuarantinedFilesRequest, opts ...grpc.CallOption) (*ListQuarantinedFilesResponse, error)
+	// GetQuarantinedFile get a scanned file with its verdict history (called by admin-service)
+	GetQuarantinedFile(ctx context.Context, in *GetQuarantinedFileRequest, opts ...grpc.CallOption) (*QuarantinedFile, error)
+	// ReleaseQuarantinedFile let a quarantined file through as a false positive (called by admin-service)
+	ReleaseQuarantinedFile(ctx context.Context, in *ReviewQuarantinedFileRequest, opts ...grpc.CallOption) (*QuarantinedFile, error)
+	// DeleteQuarantinedFile delete a quarantined file for good (called by admin-service)
+	DeleteQuarantinedFile(ctx context.Context, in *ReviewQuarantinedFileRequest, opts ...grpc.CallOption) (*QuarantinedFile, error)
 }
 
 type fileServiceClient struct {
@@ -88,6 +146,16 @@ func (c *fileServiceClient) GenerateDownloadURL(ctx context.Context, in *Generat
 	return out, nil
 }
 
+func (c *fileServiceClient) BatchGenerateDownloadURLs(ctx context.Context, in *BatchGenerateDownloadURLsRequest, opts ...grpc.CallOption) (*BatchGenerateDownloadURLsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGenerateDownloadURLsResponse)
+	err := c.cc.Invoke(ctx, FileService_BatchGenerateDownloadURLs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fileServiceClient) GetFileInfo(ctx context.Context, in *GetFileInfoRequest, opts ...grpc.CallOption) (*FileInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FileInfo)
@@ -128,26 +196,244 @@ func (c *fileServiceClient) BatchGetFileInfo(ctx context.Context, in *BatchGetFi
 	return out, nil
 }
 
+func (c *fileServiceClient) EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.EraseUserDataResponse)
+	err := c.cc.Invoke(ctx, FileService_EraseUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) InitiateMultipartUpload(ctx context.Context, in *InitiateMultipartUploadRequest, opts ...grpc.CallOption) (*MultipartUpload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipartUpload)
+	err := c.cc.Invoke(ctx, FileService_InitiateMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) GetPartUploadURLs(ctx context.Context, in *GetPartUploadURLsRequest, opts ...grpc.CallOption) (*GetPartUploadURLsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPartUploadURLsResponse)
+	err := c.cc.Invoke(ctx, FileService_GetPartUploadURLs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ListUploadedParts(ctx context.Context, in *ListUploadedPartsRequest, opts ...grpc.CallOption) (*ListUploadedPartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUploadedPartsResponse)
+	err := c.cc.Invoke(ctx, FileService_ListUploadedParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*FileInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileInfo)
+	err := c.cc.Invoke(ctx, FileService_CompleteMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, FileService_AbortMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) GrantMessageFileAccess(ctx context.Context, in *GrantMessageFileAccessRequest, opts ...grpc.CallOption) (*GrantMessageFileAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantMessageFileAccessResponse)
+	err := c.cc.Invoke(ctx, FileService_GrantMessageFileAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) RevokeMessageFileAccess(ctx context.Context, in *RevokeMessageFileAccessRequest, opts ...grpc.CallOption) (*RevokeMessageFileAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeMessageFileAccessResponse)
+	err := c.cc.Invoke(ctx, FileService_RevokeMessageFileAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*StorageUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageUsage)
+	err := c.cc.Invoke(ctx, FileService_GetStorageUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) GetOwnerStorageUsage(ctx context.Context, in *GetOwnerStorageUsageRequest, opts ...grpc.CallOption) (*StorageUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageUsage)
+	err := c.cc.Invoke(ctx, FileService_GetOwnerStorageUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) SetStorageQuota(ctx context.Context, in *SetStorageQuotaRequest, opts ...grpc.CallOption) (*StorageUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageUsage)
+	err := c.cc.Invoke(ctx, FileService_SetStorageQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...grpc.CallOption) (*Avatar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Avatar)
+	err := c.cc.Invoke(ctx, FileService_SetAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) RemoveAvatar(ctx context.Context, in *RemoveAvatarRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, FileService_RemoveAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) GenerateGroupAvatar(ctx context.Context, in *GenerateGroupAvatarRequest, opts ...grpc.CallOption) (*Avatar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Avatar)
+	err := c.cc.Invoke(ctx, FileService_GenerateGroupAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ListQuarantinedFiles(ctx context.Context, in *ListQuarantinedFilesRequest, opts ...grpc.CallOption) (*ListQuarantinedFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuarantinedFilesResponse)
+	err := c.cc.Invoke(ctx, FileService_ListQuarantinedFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) GetQuarantinedFile(ctx context.Context, in *GetQuarantinedFileRequest, opts ...grpc.CallOption) (*QuarantinedFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuarantinedFile)
+	err := c.cc.Invoke(ctx, FileService_GetQuarantinedFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ReleaseQuarantinedFile(ctx context.Context, in *ReviewQuarantinedFileRequest, opts ...grpc.CallOption) (*QuarantinedFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuarantinedFile)
+	err := c.cc.Invoke(ctx, FileService_ReleaseQuarantinedFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) DeleteQuarantinedFile(ctx context.Context, in *ReviewQuarantinedFileRequest, opts ...grpc.CallOption) (*QuarantinedFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuarantinedFile)
+	err := c.cc.Invoke(ctx, FileService_DeleteQuarantinedFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileServiceServer is the server API for FileService service.
 // All implementations must embed UnimplementedFileServiceServer
 // for forward compatibility.
 //
-// FileService 文件服务
+// FileService file service
 type FileServiceServer interface {
-	// GenerateUploadToken 生成上传凭证
+	// GenerateUploadToken generate upload token
 	GenerateUploadToken(context.Context, *GenerateUploadTokenRequest) (*GenerateUploadTokenResponse, error)
-	// CompleteUpload 完成上传
+	// CompleteUpload complete upload
 	CompleteUpload(context.Context, *CompleteUploadRequest) (*FileInfo, error)
-	// GenerateDownloadURL 生成下载链接
+	// GenerateDownloadURL generate download URL
 	GenerateDownloadURL(context.Context, *GenerateDownloadURLRequest) (*GenerateDownloadURLResponse, error)
-	// GetFileInfo 获取文件信息
+	// BatchGenerateDownloadURLs generate download URLs of several files, unreadable files are left out
+	BatchGenerateDownloadURLs(context.Context, *BatchGenerateDownloadURLsRequest) (*BatchGenerateDownloadURLsResponse, error)
+	// GetFileInfo get file info
 	GetFileInfo(context.Context, *GetFileInfoRequest) (*FileInfo, error)
-	// DeleteFile 删除文件
+	// DeleteFile delete file
 	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
-	// ListUserFiles 列出用户文件
+	// ListUserFiles list user files
 	ListUserFiles(context.Context, *ListUserFilesRequest) (*ListUserFilesResponse, error)
-	// BatchGetFileInfo 批量获取文件信息
+	// BatchGetFileInfo batch get file info
 	BatchGetFileInfo(context.Context, *BatchGetFileInfoRequest) (*BatchGetFileInfoResponse, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error)
+	// InitiateMultipartUpload start a resumable multipart upload
+	InitiateMultipartUpload(context.Context, *InitiateMultipartUploadRequest) (*MultipartUpload, error)
+	// GetPartUploadURLs presign upload URLs for parts
+	GetPartUploadURLs(context.Context, *GetPartUploadURLsRequest) (*GetPartUploadURLsResponse, error)
+	// ListUploadedParts list parts already stored, used to resume an interrupted upload
+	ListUploadedParts(context.Context, *ListUploadedPartsRequest) (*ListUploadedPartsResponse, error)
+	// CompleteMultipartUpload assemble the parts and activate the file
+	CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*FileInfo, error)
+	// AbortMultipartUpload cancel an upload and free its parts
+	AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error)
+	// GrantMessageFileAccess link the files of a sent message to its conversation (called by message-service)
+	GrantMessageFileAccess(context.Context, *GrantMessageFileAccessRequest) (*GrantMessageFileAccessResponse, error)
+	// RevokeMessageFileAccess revoke the grants of recalled or deleted messages (called by message-service)
+	RevokeMessageFileAccess(context.Context, *RevokeMessageFileAccessRequest) (*RevokeMessageFileAccessResponse, error)
+	// GetStorageUsage get the storage usage and quota of the user, or of a group the user belongs to
+	GetStorageUsage(context.Context, *GetStorageUsageRequest) (*StorageUsage, error)
+	// GetOwnerStorageUsage get the storage usage and quota of any user or group (called by admin-service)
+	GetOwnerStorageUsage(context.Context, *GetOwnerStorageUsageRequest) (*StorageUsage, error)
+	// SetStorageQuota change the tier or quota override of a user or group (called by admin-service)
+	SetStorageQuota(context.Context, *SetStorageQuotaRequest) (*StorageUsage, error)
+	// SetAvatar crop and resize an uploaded image into the avatar of a user or group (called by user-service and group-service)
+	SetAvatar(context.Context, *SetAvatarRequest) (*Avatar, error)
+	// RemoveAvatar delete the avatar images of a user or group (called by user-service and group-service)
+	RemoveAvatar(context.Context, *RemoveAvatarRequest) (*common.Empty, error)
+	// GenerateGroupAvatar compose the default avatar of a group from member avatars (called by group-service)
+	GenerateGroupAvatar(context.Context, *GenerateGroupAvatarRequest) (*Avatar, error)
+	// ListQuarantinedFiles list files held back by the malware scan (called by admin-service)
+	ListQuarantinedFiles(context.Context, *ListQuarantinedFilesRequest) (*ListQuarantinedFilesResponse, error)
+	// GetQuarantinedFile get a scanned file with its verdict history (called by admin-service)
+	GetQuarantinedFile(context.Context, *GetQuarantinedFileRequest) (*QuarantinedFile, error)
+	// ReleaseQuarantinedFile let a quarantined file through as a false positive (called by admin-service)
+	ReleaseQuarantinedFile(context.Context, *ReviewQuarantinedFileRequest) (*QuarantinedFile, error)
+	// DeleteQuarantinedFile delete a quarantined file for good (called by admin-service)
+	DeleteQuarantinedFile(context.Context, *ReviewQuarantinedFileRequest) (*QuarantinedFile, error)
 	mustEmbedUnimplementedFileServiceServer()
 }
 
@@ -167,6 +453,9 @@ func (UnimplementedFileServiceServer) CompleteUpload(context.Context, *CompleteU
 func (UnimplementedFileServiceServer) GenerateDownloadURL(context.Context, *GenerateDownloadURLRequest) (*GenerateDownloadURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GenerateDownloadURL not implemented")
 }
+func (UnimplementedFileServiceServer) BatchGenerateDownloadURLs(context.Context, *BatchGenerateDownloadURLsRequest) (*BatchGenerateDownloadURLsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGenerateDownloadURLs not implemented")
+}
 func (UnimplementedFileServiceServer) GetFileInfo(context.Context, *GetFileInfoRequest) (*FileInfo, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFileInfo not implemented")
 }
@@ -179,6 +468,60 @@ func (UnimplementedFileServiceServer) ListUserFiles(context.Context, *ListUserFi
 func (UnimplementedFileServiceServer) BatchGetFileInfo(context.Context, *BatchGetFileInfoRequest) (*BatchGetFileInfoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BatchGetFileInfo not implemented")
 }
+func (UnimplementedFileServiceServer) EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EraseUserData not implemented")
+}
+func (UnimplementedFileServiceServer) InitiateMultipartUpload(context.Context, *InitiateMultipartUploadRequest) (*MultipartUpload, error) {
+	return nil, status.Error(codes.Unimplemented, "method InitiateMultipartUpload not implemented")
+}
+func (UnimplementedFileServiceServer) GetPartUploadURLs(context.Context, *GetPartUploadURLsRequest) (*GetPartUploadURLsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPartUploadURLs not implemented")
+}
+func (UnimplementedFileServiceServer) ListUploadedParts(context.Context, *ListUploadedPartsRequest) (*ListUploadedPartsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUploadedParts not implemented")
+}
+func (UnimplementedFileServiceServer) CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*FileInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteMultipartUpload not implemented")
+}
+func (UnimplementedFileServiceServer) AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AbortMultipartUpload not implemented")
+}
+func (UnimplementedFileServiceServer) GrantMessageFileAccess(context.Context, *GrantMessageFileAccessRequest) (*GrantMessageFileAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantMessageFileAccess not implemented")
+}
+func (UnimplementedFileServiceServer) RevokeMessageFileAccess(context.Context, *RevokeMessageFileAccessRequest) (*RevokeMessageFileAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeMessageFileAccess not implemented")
+}
+func (UnimplementedFileServiceServer) GetStorageUsage(context.Context, *GetStorageUsageRequest) (*StorageUsage, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
+func (UnimplementedFileServiceServer) GetOwnerStorageUsage(context.Context, *GetOwnerStorageUsageRequest) (*StorageUsage, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOwnerStorageUsage not implemented")
+}
+func (UnimplementedFileServiceServer) SetStorageQuota(context.Context, *SetStorageQuotaRequest) (*StorageUsage, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetStorageQuota not implemented")
+}
+func (UnimplementedFileServiceServer) SetAvatar(context.Context, *SetAvatarRequest) (*Avatar, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAvatar not implemented")
+}
+func (UnimplementedFileServiceServer) RemoveAvatar(context.Context, *RemoveAvatarRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveAvatar not implemented")
+}
+func (UnimplementedFileServiceServer) GenerateGroupAvatar(context.Context, *GenerateGroupAvatarRequest) (*Avatar, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateGroupAvatar not implemented")
+}
+func (UnimplementedFileServiceServer) ListQuarantinedFiles(context.Context, *ListQuarantinedFilesRequest) (*ListQuarantinedFilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQuarantinedFiles not implemented")
+}
+func (UnimplementedFileServiceServer) GetQuarantinedFile(context.Context, *GetQuarantinedFileRequest) (*QuarantinedFile, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQuarantinedFile not implemented")
+}
+func (UnimplementedFileServiceServer) ReleaseQuarantinedFile(context.Context, *ReviewQuarantinedFileRequest) (*QuarantinedFile, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseQuarantinedFile not implemented")
+}
+func (UnimplementedFileServiceServer) DeleteQuarantinedFile(context.Context, *ReviewQuarantinedFileRequest) (*QuarantinedFile, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteQuarantinedFile not implemented")
+}
 func (UnimplementedFileServiceServer) mustEmbedUnimplementedFileServiceServer() {}
 func (UnimplementedFileServiceServer) testEmbeddedByValue()                     {}
 
@@ -254,6 +597,24 @@ func _FileService_GenerateDownloadURL_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_BatchGenerateDownloadURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGenerateDownloadURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).BatchGenerateDownloadURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_BatchGenerateDownloadURLs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).BatchGenerateDownloadURLs(ctx, req.(*BatchGenerateDownloadURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FileService_GetFileInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFileInfoRequest)
 	if err := dec(in); err != nil {
@@ -326,6 +687,330 @@ func _FileService_BatchGetFileInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).EraseUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_EraseUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).EraseUserData(ctx, req.(*common.EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_InitiateMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).InitiateMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_InitiateMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).InitiateMultipartUpload(ctx, req.(*InitiateMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_GetPartUploadURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartUploadURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GetPartUploadURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GetPartUploadURLs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GetPartUploadURLs(ctx, req.(*GetPartUploadURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ListUploadedParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUploadedPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListUploadedParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListUploadedParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListUploadedParts(ctx, req.(*ListUploadedPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_CompleteMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).CompleteMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_CompleteMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).CompleteMultipartUpload(ctx, req.(*CompleteMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_AbortMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).AbortMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_AbortMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).AbortMultipartUpload(ctx, req.(*AbortMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_GrantMessageFileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantMessageFileAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GrantMessageFileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GrantMessageFileAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GrantMessageFileAccess(ctx, req.(*GrantMessageFileAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_RevokeMessageFileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMessageFileAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RevokeMessageFileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RevokeMessageFileAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RevokeMessageFileAccess(ctx, req.(*RevokeMessageFileAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GetStorageUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GetStorageUsage(ctx, req.(*GetStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_GetOwnerStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOwnerStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GetOwnerStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GetOwnerStorageUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GetOwnerStorageUsage(ctx, req.(*GetOwnerStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_SetStorageQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStorageQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).SetStorageQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_SetStorageQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).SetStorageQuota(ctx, req.(*SetStorageQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_SetAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).SetAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_SetAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).SetAvatar(ctx, req.(*SetAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_RemoveAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RemoveAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RemoveAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RemoveAvatar(ctx, req.(*RemoveAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_GenerateGroupAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateGroupAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GenerateGroupAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GenerateGroupAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GenerateGroupAvatar(ctx, req.(*GenerateGroupAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ListQuarantinedFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListQuarantinedFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListQuarantinedFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListQuarantinedFiles(ctx, req.(*ListQuarantinedFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_GetQuarantinedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuarantinedFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GetQuarantinedFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GetQuarantinedFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GetQuarantinedFile(ctx, req.(*GetQuarantinedFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ReleaseQuarantinedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewQuarantinedFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ReleaseQuarantinedFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ReleaseQuarantinedFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ReleaseQuarantinedFile(ctx, req.(*ReviewQuarantinedFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_DeleteQuarantinedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewQuarantinedFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).DeleteQuarantinedFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_DeleteQuarantinedFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).DeleteQuarantinedFile(ctx, req.(*ReviewQuarantinedFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -345,6 +1030,10 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateDownloadURL",
 			Handler:    _FileService_GenerateDownloadURL_Handler,
 		},
+		{
+			MethodName: "BatchGenerateDownloadURLs",
+			Handler:    _FileService_BatchGenerateDownloadURLs_Handler,
+		},
 		{
 			MethodName: "GetFileInfo",
 			Handler:    _FileService_GetFileInfo_Handler,
@@ -361,6 +1050,78 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchGetFileInfo",
 			Handler:    _FileService_BatchGetFileInfo_Handler,
 		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _FileService_EraseUserData_Handler,
+		},
+		{
+			MethodName: "InitiateMultipartUpload",
+			Handler:    _FileService_InitiateMultipartUpload_Handler,
+		},
+		{
+			MethodName: "GetPartUploadURLs",
+			Handler:    _FileService_GetPartUploadURLs_Handler,
+		},
+		{
+			MethodName: "ListUploadedParts",
+			Handler:    _FileService_ListUploadedParts_Handler,
+		},
+		{
+			MethodName: "CompleteMultipartUpload",
+			Handler:    _FileService_CompleteMultipartUpload_Handler,
+		},
+		{
+			MethodName: "AbortMultipartUpload",
+			Handler:    _FileService_AbortMultipartUpload_Handler,
+		},
+		{
+			MethodName: "GrantMessageFileAccess",
+			Handler:    _FileService_GrantMessageFileAccess_Handler,
+		},
+		{
+			MethodName: "RevokeMessageFileAccess",
+			Handler:    _FileService_RevokeMessageFileAccess_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _FileService_GetStorageUsage_Handler,
+		},
+		{
+			MethodName: "GetOwnerStorageUsage",
+			Handler:    _FileService_GetOwnerStorageUsage_Handler,
+		},
+		{
+			MethodName: "SetStorageQuota",
+			Handler:    _FileService_SetStorageQuota_Handler,
+		},
+		{
+			MethodName: "SetAvatar",
+			Handler:    _FileService_SetAvatar_Handler,
+		},
+		{
+			MethodName: "RemoveAvatar",
+			Handler:    _FileService_RemoveAvatar_Handler,
+		},
+		{
+			MethodName: "GenerateGroupAvatar",
+			Handler:    _FileService_GenerateGroupAvatar_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedFiles",
+			Handler:    _FileService_ListQuarantinedFiles_Handler,
+		},
+		{
+			MethodName: "GetQuarantinedFile",
+			Handler:    _FileService_GetQuarantinedFile_Handler,
+		},
+		{
+			MethodName: "ReleaseQuarantinedFile",
+			Handler:    _FileService_ReleaseQuarantinedFile_Handler,
+		},
+		{
+			MethodName: "DeleteQuarantinedFile",
+			Handler:    _FileService_DeleteQuarantinedFile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "file/file.proto",
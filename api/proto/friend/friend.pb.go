@@ -8,9 +8,9 @@ package friendpb
 
 import (
 	common "github.com/anychat/server/api/proto/common"
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -231,14 +231,14 @@ func (FriendRequestQueryType) EnumDescriptor() ([]byte, []int) {
 	return file_friend_friend_proto_rawDescGZIP(), []int{3}
 }
 
-// Friend 好友信息
+// Friend friend info
 type Friend struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Remark        string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UserInfo      *common.UserInfo       `protobuf:"bytes,5,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"` // 用户基本信息（从user-service获取）
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserInfo      *common.UserInfo       `protobuf:"bytes,5,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"` // basic user info (from user-service)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -287,14 +287,14 @@ func (x *Friend) GetRemark() string {
 	return ""
 }
 
-func (x *Friend) GetCreatedAt() *timestamp.Timestamp {
+func (x *Friend) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Friend) GetUpdatedAt() *timestamp.Timestamp {
+func (x *Friend) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
@@ -308,7 +308,7 @@ func (x *Friend) GetUserInfo() *common.UserInfo {
 	return nil
 }
 
-// FriendRequest 好友申请
+// FriendRequest friend request
 type FriendRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -317,8 +317,8 @@ type FriendRequest struct {
 	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	Source        FriendRequestSource    `protobuf:"varint,5,opt,name=source,proto3,enum=anychat.friend.FriendRequestSource" json:"source,omitempty"`
 	Status        FriendRequestStatus    `protobuf:"varint,6,opt,name=status,proto3,enum=anychat.friend.FriendRequestStatus" json:"status,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	FromUserInfo  *common.UserInfo       `protobuf:"bytes,8,opt,name=from_user_info,json=fromUserInfo,proto3" json:"from_user_info,omitempty"` // 申请人信息
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FromUserInfo  *common.UserInfo       `protobuf:"bytes,8,opt,name=from_user_info,json=fromUserInfo,proto3" json:"from_user_info,omitempty"` // requester info
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -395,7 +395,7 @@ func (x *FriendRequest) GetStatus() FriendRequestStatus {
 	return FriendRequestStatus_FRIEND_REQUEST_STATUS_UNSPECIFIED
 }
 
-func (x *FriendRequest) GetCreatedAt() *timestamp.Timestamp {
+func (x *FriendRequest) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
@@ -409,14 +409,14 @@ func (x *FriendRequest) GetFromUserInfo() *common.UserInfo {
 	return nil
 }
 
-// BlacklistItem 黑名单项
+// BlacklistItem blacklist item
 type BlacklistItem struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	BlockedUserId   string                 `protobuf:"bytes,3,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
-	CreatedAt       *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	BlockedUserInfo *common.UserInfo       `protobuf:"bytes,5,opt,name=blocked_user_info,json=blockedUserInfo,proto3" json:"blocked_user_info,omitempty"` // 被拉黑用户信息
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	BlockedUserInfo *common.UserInfo       `protobuf:"bytes,5,opt,name=blocked_user_info,json=blockedUserInfo,proto3" json:"blocked_user_info,omitempty"` // blocked user info
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -472,7 +472,7 @@ func (x *BlacklistItem) GetBlockedUserId() string {
 	return ""
 }
 
-func (x *BlacklistItem) GetCreatedAt() *timestamp.Timestamp {
+func (x *BlacklistItem) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
@@ -486,11 +486,11 @@ func (x *BlacklistItem) GetBlockedUserInfo() *common.UserInfo {
 	return nil
 }
 
-// GetFriendListRequest 获取好友列表请求
+// GetFriendListRequest get friend list request
 type GetFriendListRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	LastUpdateTime *int64                 `protobuf:"varint,2,opt,name=last_update_time,json=lastUpdateTime,proto3,oneof" json:"last_update_time,omitempty"` // 增量同步
+	LastUpdateTime *int64                 `protobuf:"varint,2,opt,name=last_update_time,json=lastUpdateTime,proto3,oneof" json:"last_update_time,omitempty"` // incremental sync
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -539,7 +539,7 @@ func (x *GetFriendListRequest) GetLastUpdateTime() int64 {
 	return 0
 }
 
-// GetFriendListResponse 获取好友列表响应
+// GetFriendListResponse get friend list response
 type GetFriendListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Friends       []*Friend              `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
@@ -592,7 +592,7 @@ func (x *GetFriendListResponse) GetTotal() int64 {
 	return 0
 }
 
-// SendFriendRequestRequest 发送好友申请请求
+// SendFriendRequestRequest send friend request request
 type SendFriendRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FromUserId    string                 `protobuf:"bytes,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
@@ -661,11 +661,11 @@ func (x *SendFriendRequestRequest) GetSource() FriendRequestSource {
 	return FriendRequestSource_FRIEND_REQUEST_SOURCE_UNSPECIFIED
 }
 
-// SendFriendRequestResponse 发送好友申请响应
+// SendFriendRequestResponse send friend request response
 type SendFriendRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	AutoAccepted  bool                   `protobuf:"varint,2,opt,name=auto_accepted,json=autoAccepted,proto3" json:"auto_accepted,omitempty"` // 是否自动通过
+	AutoAccepted  bool                   `protobuf:"varint,2,opt,name=auto_accepted,json=autoAccepted,proto3" json:"auto_accepted,omitempty"` // whether auto-accepted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -714,10 +714,10 @@ func (x *SendFriendRequestResponse) GetAutoAccepted() bool {
 	return false
 }
 
-// HandleFriendRequestRequest 处理好友申请请求
+// HandleFriendRequestRequest handle friend request request
 type HandleFriendRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 当前用户ID（申请接收方）
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // current user ID (request recipient)
 	RequestId     int64                  `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	Action        FriendRequestAction    `protobuf:"varint,3,opt,name=action,proto3,enum=anychat.friend.FriendRequestAction" json:"action,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -775,7 +775,7 @@ func (x *HandleFriendRequestRequest) GetAction() FriendRequestAction {
 	return FriendRequestAction_FRIEND_REQUEST_ACTION_UNSPECIFIED
 }
 
-// GetFriendRequestsRequest 获取好友申请列表请求
+// GetFriendRequestsRequest get friend request list request
 type GetFriendRequestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -828,7 +828,7 @@ func (x *GetFriendRequestsRequest) GetRequestType() FriendRequestQueryType {
 	return FriendRequestQueryType_FRIEND_REQUEST_QUERY_TYPE_UNSPECIFIED
 }
 
-// GetFriendRequestsResponse 获取好友申请列表响应
+// GetFriendRequestsResponse get friend request list response
 type GetFriendRequestsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Requests      []*FriendRequest       `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
@@ -881,7 +881,7 @@ func (x *GetFriendRequestsResponse) GetTotal() int64 {
 	return 0
 }
 
-// DeleteFriendRequest 删除好友请求
+// DeleteFriendRequest delete friend request
 type DeleteFriendRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -934,7 +934,7 @@ func (x *DeleteFriendRequest) GetFriendId() string {
 	return ""
 }
 
-// UpdateRemarkRequest 更新备注请求
+// UpdateRemarkRequest update remark request
 type UpdateRemarkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -995,7 +995,7 @@ func (x *UpdateRemarkRequest) GetRemark() string {
 	return ""
 }
 
-// AddToBlacklistRequest 添加黑名单请求
+// AddToBlacklistRequest add to blacklist request
 type AddToBlacklistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1048,7 +1048,7 @@ func (x *AddToBlacklistRequest) GetBlockedUserId() string {
 	return ""
 }
 
-// RemoveFromBlacklistRequest 移除黑名单请求
+// RemoveFromBlacklistRequest remove from blacklist request
 type RemoveFromBlacklistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1101,7 +1101,7 @@ func (x *RemoveFromBlacklistRequest) GetBlockedUserId() string {
 	return ""
 }
 
-// GetBlacklistRequest 获取黑名单请求
+// GetBlacklistRequest get blacklist request
 type GetBlacklistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1146,7 +1146,7 @@ func (x *GetBlacklistRequest) GetUserId() string {
 	return ""
 }
 
-// GetBlacklistResponse 获取黑名单响应
+// GetBlacklistResponse get blacklist response
 type GetBlacklistResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*BlacklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -1199,7 +1199,7 @@ func (x *GetBlacklistResponse) GetTotal() int64 {
 	return 0
 }
 
-// IsFriendRequest 检查好友关系请求
+// IsFriendRequest check friend relationship request
 type IsFriendRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1252,7 +1252,7 @@ func (x *IsFriendRequest) GetFriendId() string {
 	return ""
 }
 
-// IsFriendResponse 检查好友关系响应
+// IsFriendResponse check friend relationship response
 type IsFriendResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IsFriend      bool                   `protobuf:"varint,1,opt,name=is_friend,json=isFriend,proto3" json:"is_friend,omitempty"`
@@ -1297,7 +1297,7 @@ func (x *IsFriendResponse) GetIsFriend() bool {
 	return false
 }
 
-// IsBlockedRequest 检查黑名单请求
+// IsBlockedRequest check blacklist request
 type IsBlockedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1350,7 +1350,7 @@ func (x *IsBlockedRequest) GetTargetUserId() string {
 	return ""
 }
 
-// IsBlockedResponse 检查黑名单响应
+// IsBlockedResponse check blacklist response
 type IsBlockedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IsBlocked     bool                   `protobuf:"varint,1,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`
@@ -1395,7 +1395,7 @@ func (x *IsBlockedResponse) GetIsBlocked() bool {
 	return false
 }
 
-// BatchCheckFriendRequest 批量检查好友关系请求
+// BatchCheckFriendRequest batch check friend relationship request
 type BatchCheckFriendRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1448,7 +1448,7 @@ func (x *BatchCheckFriendRequest) GetFriendIds() []string {
 	return nil
 }
 
-// BatchCheckFriendResponse 批量检查好友关系响应
+// BatchCheckFriendResponse batch check friend relationship response
 type BatchCheckFriendResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Results       map[string]bool        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // friend_id -> is_friend
@@ -1611,7 +1611,7 @@ const file_friend_friend_proto_rawDesc = "" +
 	"\x16FriendRequestQueryType\x12)\n" +
 	"%FRIEND_REQUEST_QUERY_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
 	"\"FRIEND_REQUEST_QUERY_TYPE_RECEIVED\x10\x01\x12\"\n" +
-	"\x1eFRIEND_REQUEST_QUERY_TYPE_SENT\x10\x022\xc0\b\n" +
+	"\x1eFRIEND_REQUEST_QUERY_TYPE_SENT\x10\x022\x9e\t\n" +
 	"\rFriendService\x12\\\n" +
 	"\rGetFriendList\x12$.anychat.friend.GetFriendListRequest\x1a%.anychat.friend.GetFriendListResponse\x12h\n" +
 	"\x11SendFriendRequest\x12(.anychat.friend.SendFriendRequestRequest\x1a).anychat.friend.SendFriendRequestResponse\x12X\n" +
@@ -1624,7 +1624,8 @@ const file_friend_friend_proto_rawDesc = "" +
 	"\fGetBlacklist\x12#.anychat.friend.GetBlacklistRequest\x1a$.anychat.friend.GetBlacklistResponse\x12M\n" +
 	"\bIsFriend\x12\x1f.anychat.friend.IsFriendRequest\x1a .anychat.friend.IsFriendResponse\x12P\n" +
 	"\tIsBlocked\x12 .anychat.friend.IsBlockedRequest\x1a!.anychat.friend.IsBlockedResponse\x12e\n" +
-	"\x10BatchCheckFriend\x12'.anychat.friend.BatchCheckFriendRequest\x1a(.anychat.friend.BatchCheckFriendResponseB5Z3github.com/anychat/server/api/proto/friend;friendpbb\x06proto3"
+	"\x10BatchCheckFriend\x12'.anychat.friend.BatchCheckFriendRequest\x1a(.anychat.friend.BatchCheckFriendResponse\x12\\\n" +
+	"\rEraseUserData\x12$.anychat.common.EraseUserDataRequest\x1a%.anychat.common.EraseUserDataResponseB5Z3github.com/anychat/server/api/proto/friend;friendpbb\x06proto3"
 
 var (
 	file_friend_friend_proto_rawDescOnce sync.Once
@@ -1641,36 +1642,38 @@ func file_friend_friend_proto_rawDescGZIP() []byte {
 var file_friend_friend_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_friend_friend_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_friend_friend_proto_goTypes = []any{
-	(FriendRequestSource)(0),           // 0: anychat.friend.FriendRequestSource
-	(FriendRequestStatus)(0),           // 1: anychat.friend.FriendRequestStatus
-	(FriendRequestAction)(0),           // 2: anychat.friend.FriendRequestAction
-	(FriendRequestQueryType)(0),        // 3: anychat.friend.FriendRequestQueryType
-	(*Friend)(nil),                     // 4: anychat.friend.Friend
-	(*FriendRequest)(nil),              // 5: anychat.friend.FriendRequest
-	(*BlacklistItem)(nil),              // 6: anychat.friend.BlacklistItem
-	(*GetFriendListRequest)(nil),       // 7: anychat.friend.GetFriendListRequest
-	(*GetFriendListResponse)(nil),      // 8: anychat.friend.GetFriendListResponse
-	(*SendFriendRequestRequest)(nil),   // 9: anychat.friend.SendFriendRequestRequest
-	(*SendFriendRequestResponse)(nil),  // 10: anychat.friend.SendFriendRequestResponse
-	(*HandleFriendRequestRequest)(nil), // 11: anychat.friend.HandleFriendRequestRequest
-	(*GetFriendRequestsRequest)(nil),   // 12: anychat.friend.GetFriendRequestsRequest
-	(*GetFriendRequestsResponse)(nil),  // 13: anychat.friend.GetFriendRequestsResponse
-	(*DeleteFriendRequest)(nil),        // 14: anychat.friend.DeleteFriendRequest
-	(*UpdateRemarkRequest)(nil),        // 15: anychat.friend.UpdateRemarkRequest
-	(*AddToBlacklistRequest)(nil),      // 16: anychat.friend.AddToBlacklistRequest
-	(*RemoveFromBlacklistRequest)(nil), // 17: anychat.friend.RemoveFromBlacklistRequest
-	(*GetBlacklistRequest)(nil),        // 18: anychat.friend.GetBlacklistRequest
-	(*GetBlacklistResponse)(nil),       // 19: anychat.friend.GetBlacklistResponse
-	(*IsFriendRequest)(nil),            // 20: anychat.friend.IsFriendRequest
-	(*IsFriendResponse)(nil),           // 21: anychat.friend.IsFriendResponse
-	(*IsBlockedRequest)(nil),           // 22: anychat.friend.IsBlockedRequest
-	(*IsBlockedResponse)(nil),          // 23: anychat.friend.IsBlockedResponse
-	(*BatchCheckFriendRequest)(nil),    // 24: anychat.friend.BatchCheckFriendRequest
-	(*BatchCheckFriendResponse)(nil),   // 25: anychat.friend.BatchCheckFriendResponse
-	nil,                                // 26: anychat.friend.BatchCheckFriendResponse.ResultsEntry
-	(*timestamp.Timestamp)(nil),        // 27: google.protobuf.Timestamp
-	(*common.UserInfo)(nil),            // 28: anychat.common.UserInfo
-	(*common.Empty)(nil),               // 29: anychat.common.Empty
+	(FriendRequestSource)(0),             // 0: anychat.friend.FriendRequestSource
+	(FriendRequestStatus)(0),             // 1: anychat.friend.FriendRequestStatus
+	(FriendRequestAction)(0),             // 2: anychat.friend.FriendRequestAction
+	(FriendRequestQueryType)(0),          // 3: anychat.friend.FriendRequestQueryType
+	(*Friend)(nil),                       // 4: anychat.friend.Friend
+	(*FriendRequest)(nil),                // 5: anychat.friend.FriendRequest
+	(*BlacklistItem)(nil),                // 6: anychat.friend.BlacklistItem
+	(*GetFriendListRequest)(nil),         // 7: anychat.friend.GetFriendListRequest
+	(*GetFriendListResponse)(nil),        // 8: anychat.friend.GetFriendListResponse
+	(*SendFriendRequestRequest)(nil),     // 9: anychat.friend.SendFriendRequestRequest
+	(*SendFriendRequestResponse)(nil),    // 10: anychat.friend.SendFriendRequestResponse
+	(*HandleFriendRequestRequest)(nil),   // 11: anychat.friend.HandleFriendRequestRequest
+	(*GetFriendRequestsRequest)(nil),     // 12: anychat.friend.GetFriendRequestsRequest
+	(*GetFriendRequestsResponse)(nil),    // 13: anychat.friend.GetFriendRequestsResponse
+	(*DeleteFriendRequest)(nil),          // 14: anychat.friend.DeleteFriendRequest
+	(*UpdateRemarkRequest)(nil),          // 15: anychat.friend.UpdateRemarkRequest
+	(*AddToBlacklistRequest)(nil),        // 16: anychat.friend.AddToBlacklistRequest
+	(*RemoveFromBlacklistRequest)(nil),   // 17: anychat.friend.RemoveFromBlacklistRequest
+	(*GetBlacklistRequest)(nil),          // 18: anychat.friend.GetBlacklistRequest
+	(*GetBlacklistResponse)(nil),         // 19: anychat.friend.GetBlacklistResponse
+	(*IsFriendRequest)(nil),              // 20: anychat.friend.IsFriendRequest
+	(*IsFriendResponse)(nil),             // 21: anychat.friend.IsFriendResponse
+	(*IsBlockedRequest)(nil),             // 22: anychat.friend.IsBlockedRequest
+	(*IsBlockedResponse)(nil),            // 23: anychat.friend.IsBlockedResponse
+	(*BatchCheckFriendRequest)(nil),      // 24: anychat.friend.BatchCheckFriendRequest
+	(*BatchCheckFriendResponse)(nil),     // 25: anychat.friend.BatchCheckFriendResponse
+	nil,                                  // 26: anychat.friend.BatchCheckFriendResponse.ResultsEntry
+	(*timestamppb.Timestamp)(nil),        // 27: google.protobuf.Timestamp
+	(*common.UserInfo)(nil),              // 28: anychat.common.UserInfo
+	(*common.EraseUserDataRequest)(nil),  // 29: anychat.common.EraseUserDataRequest
+	(*common.Empty)(nil),                 // 30: anychat.common.Empty
+	(*common.EraseUserDataResponse)(nil), // 31: anychat.common.EraseUserDataResponse
 }
 var file_friend_friend_proto_depIdxs = []int32{
 	27, // 0: anychat.friend.Friend.created_at:type_name -> google.protobuf.Timestamp
@@ -1701,20 +1704,22 @@ var file_friend_friend_proto_depIdxs = []int32{
 	20, // 25: anychat.friend.FriendService.IsFriend:input_type -> anychat.friend.IsFriendRequest
 	22, // 26: anychat.friend.FriendService.IsBlocked:input_type -> anychat.friend.IsBlockedRequest
 	24, // 27: anychat.friend.FriendService.BatchCheckFriend:input_type -> anychat.friend.BatchCheckFriendRequest
-	8,  // 28: anychat.friend.FriendService.GetFriendList:output_type -> anychat.friend.GetFriendListResponse
-	10, // 29: anychat.friend.FriendService.SendFriendRequest:output_type -> anychat.friend.SendFriendRequestResponse
-	29, // 30: anychat.friend.FriendService.HandleFriendRequest:output_type -> anychat.common.Empty
-	13, // 31: anychat.friend.FriendService.GetFriendRequests:output_type -> anychat.friend.GetFriendRequestsResponse
-	29, // 32: anychat.friend.FriendService.DeleteFriend:output_type -> anychat.common.Empty
-	29, // 33: anychat.friend.FriendService.UpdateRemark:output_type -> anychat.common.Empty
-	29, // 34: anychat.friend.FriendService.AddToBlacklist:output_type -> anychat.common.Empty
-	29, // 35: anychat.friend.FriendService.RemoveFromBlacklist:output_type -> anychat.common.Empty
-	19, // 36: anychat.friend.FriendService.GetBlacklist:output_type -> anychat.friend.GetBlacklistResponse
-	21, // 37: anychat.friend.FriendService.IsFriend:output_type -> anychat.friend.IsFriendResponse
-	23, // 38: anychat.friend.FriendService.IsBlocked:output_type -> anychat.friend.IsBlockedResponse
-	25, // 39: anychat.friend.FriendService.BatchCheckFriend:output_type -> anychat.friend.BatchCheckFriendResponse
-	28, // [28:40] is the sub-list for method output_type
-	16, // [16:28] is the sub-list for method input_type
+	29, // 28: anychat.friend.FriendService.EraseUserData:input_type -> anychat.common.EraseUserDataRequest
+	8,  // 29: anychat.friend.FriendService.GetFriendList:output_type -> anychat.friend.GetFriendListResponse
+	10, // 30: anychat.friend.FriendService.SendFriendRequest:output_type -> anychat.friend.SendFriendRequestResponse
+	30, // 31: anychat.friend.FriendService.HandleFriendRequest:output_type -> anychat.common.Empty
+	13, // 32: anychat.friend.FriendService.GetFriendRequests:output_type -> anychat.friend.GetFriendRequestsResponse
+	30, // 33: anychat.friend.FriendService.DeleteFriend:output_type -> anychat.common.Empty
+	30, // 34: anychat.friend.FriendService.UpdateRemark:output_type -> anychat.common.Empty
+	30, // 35: anychat.friend.FriendService.AddToBlacklist:output_type -> anychat.common.Empty
+	30, // 36: anychat.friend.FriendService.RemoveFromBlacklist:output_type -> anychat.common.Empty
+	19, // 37: anychat.friend.FriendService.GetBlacklist:output_type -> anychat.friend.GetBlacklistResponse
+	21, // 38: anychat.friend.FriendService.IsFriend:output_type -> anychat.friend.IsFriendResponse
+	23, // 39: anychat.friend.FriendService.IsBlocked:output_type -> anychat.friend.IsBlockedResponse
+	25, // 40: anychat.friend.FriendService.BatchCheckFriend:output_type -> anychat.friend.BatchCheckFriendResponse
+	31, // 41: anychat.friend.FriendService.EraseUserData:output_type -> anychat.common.EraseUserDataResponse
+	29, // [29:42] is the sub-list for method output_type
+	16, // [16:29] is the sub-list for method input_type
 	16, // [16:16] is the sub-list for extension type_name
 	16, // [16:16] is the sub-list for extension extendee
 	0,  // [0:16] is the sub-list for field type_name
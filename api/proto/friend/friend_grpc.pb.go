@@ -32,38 +32,41 @@ const (
 	FriendService_IsFriend_FullMethodName            = "/anychat.friend.FriendService/IsFriend"
 	FriendService_IsBlocked_FullMethodName           = "/anychat.friend.FriendService/IsBlocked"
 	FriendService_BatchCheckFriend_FullMethodName    = "/anychat.friend.FriendService/BatchCheckFriend"
+	FriendService_EraseUserData_FullMethodName       = "/anychat.friend.FriendService/EraseUserData"
 )
 
 // FriendServiceClient is the client API for FriendService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// FriendService 好友服务
+// FriendService friend service
 type FriendServiceClient interface {
-	// GetFriendList 获取好友列表
+	// GetFriendList get friend list
 	GetFriendList(ctx context.Context, in *GetFriendListRequest, opts ...grpc.CallOption) (*GetFriendListResponse, error)
-	// SendFriendRequest 发送好友申请
+	// SendFriendRequest send friend request
 	SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error)
-	// HandleFriendRequest 处理好友申请（接受/拒绝）
+	// HandleFriendRequest handle friend request (accept/reject)
 	HandleFriendRequest(ctx context.Context, in *HandleFriendRequestRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// GetFriendRequests 获取好友申请列表
+	// GetFriendRequests get friend request list
 	GetFriendRequests(ctx context.Context, in *GetFriendRequestsRequest, opts ...grpc.CallOption) (*GetFriendRequestsResponse, error)
-	// DeleteFriend 删除好友
+	// DeleteFriend delete friend
 	DeleteFriend(ctx context.Context, in *DeleteFriendRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// UpdateRemark 更新好友备注
+	// UpdateRemark update friend remark
 	UpdateRemark(ctx context.Context, in *UpdateRemarkRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// AddToBlacklist 添加到黑名单
+	// AddToBlacklist add to blacklist
 	AddToBlacklist(ctx context.Context, in *AddToBlacklistRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// RemoveFromBlacklist 从黑名单移除
+	// RemoveFromBlacklist remove from blacklist
 	RemoveFromBlacklist(ctx context.Context, in *RemoveFromBlacklistRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// GetBlacklist 获取黑名单列表
+	// GetBlacklist get blacklist
 	GetBlacklist(ctx context.Context, in *GetBlacklistRequest, opts ...grpc.CallOption) (*GetBlacklistResponse, error)
-	// IsFriend 检查是否是好友（供其他服务调用）
+	// IsFriend check whether users are friends (for other services)
 	IsFriend(ctx context.Context, in *IsFriendRequest, opts ...grpc.CallOption) (*IsFriendResponse, error)
-	// IsBlocked 检查是否被拉黑（供其他服务调用）
+	// IsBlocked check whether blocked (for other services)
 	IsBlocked(ctx context.Context, in *IsBlockedRequest, opts ...grpc.CallOption) (*IsBlockedResponse, error)
-	// BatchCheckFriend 批量检查好友关系
+	// BatchCheckFriend batch check friend relationships
 	BatchCheckFriend(ctx context.Context, in *BatchCheckFriendRequest, opts ...grpc.CallOption) (*BatchCheckFriendResponse, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error)
 }
 
 type friendServiceClient struct {
@@ -194,36 +197,48 @@ func (c *friendServiceClient) BatchCheckFriend(ctx context.Context, in *BatchChe
 	return out, nil
 }
 
+func (c *friendServiceClient) EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.EraseUserDataResponse)
+	err := c.cc.Invoke(ctx, FriendService_EraseUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FriendServiceServer is the server API for FriendService service.
 // All implementations must embed UnimplementedFriendServiceServer
 // for forward compatibility.
 //
-// FriendService 好友服务
+// FriendService friend service
 type FriendServiceServer interface {
-	// GetFriendList 获取好友列表
+	// GetFriendList get friend list
 	GetFriendList(context.Context, *GetFriendListRequest) (*GetFriendListResponse, error)
-	// SendFriendRequest 发送好友申请
+	// SendFriendRequest send friend request
 	SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error)
-	// HandleFriendRequest 处理好友申请（接受/拒绝）
+	// HandleFriendRequest handle friend request (accept/reject)
 	HandleFriendRequest(context.Context, *HandleFriendRequestRequest) (*common.Empty, error)
-	// GetFriendRequests 获取好友申请列表
+	// GetFriendRequests get friend request list
 	GetFriendRequests(context.Context, *GetFriendRequestsRequest) (*GetFriendRequestsResponse, error)
-	// DeleteFriend 删除好友
+	// DeleteFriend delete friend
 	DeleteFriend(context.Context, *DeleteFriendRequest) (*common.Empty, error)
-	// UpdateRemark 更新好友备注
+	// UpdateRemark update friend remark
 	UpdateRemark(context.Context, *UpdateRemarkRequest) (*common.Empty, error)
-	// AddToBlacklist 添加到黑名单
+	// AddToBlacklist add to blacklist
 	AddToBlacklist(context.Context, *AddToBlacklistRequest) (*common.Empty, error)
-	// RemoveFromBlacklist 从黑名单移除
+	// RemoveFromBlacklist remove from blacklist
 	RemoveFromBlacklist(context.Context, *RemoveFromBlacklistRequest) (*common.Empty, error)
-	// GetBlacklist 获取黑名单列表
+	// GetBlacklist get blacklist
 	GetBlacklist(context.Context, *GetBlacklistRequest) (*GetBlacklistResponse, error)
-	// IsFriend 检查是否是好友（供其他服务调用）
+	// IsFriend check whether users are friends (for other services)
 	IsFriend(context.Context, *IsFriendRequest) (*IsFriendResponse, error)
-	// IsBlocked 检查是否被拉黑（供其他服务调用）
+	// IsBlocked check whether blocked (for other services)
 	IsBlocked(context.Context, *IsBlockedRequest) (*IsBlockedResponse, error)
-	// BatchCheckFriend 批量检查好友关系
+	// BatchCheckFriend batch check friend relationships
 	BatchCheckFriend(context.Context, *BatchCheckFriendRequest) (*BatchCheckFriendResponse, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error)
 	mustEmbedUnimplementedFriendServiceServer()
 }
 
@@ -270,6 +285,9 @@ func (UnimplementedFriendServiceServer) IsBlocked(context.Context, *IsBlockedReq
 func (UnimplementedFriendServiceServer) BatchCheckFriend(context.Context, *BatchCheckFriendRequest) (*BatchCheckFriendResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BatchCheckFriend not implemented")
 }
+func (UnimplementedFriendServiceServer) EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EraseUserData not implemented")
+}
 func (UnimplementedFriendServiceServer) mustEmbedUnimplementedFriendServiceServer() {}
 func (UnimplementedFriendServiceServer) testEmbeddedByValue()                       {}
 
@@ -507,6 +525,24 @@ func _FriendService_BatchCheckFriend_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FriendService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).EraseUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_EraseUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).EraseUserData(ctx, req.(*common.EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FriendService_ServiceDesc is the grpc.ServiceDesc for FriendService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -562,6 +598,10 @@ var FriendService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchCheckFriend",
 			Handler:    _FriendService_BatchCheckFriend_Handler,
 		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _FriendService_EraseUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "friend/friend.proto",
@@ -8,9 +8,9 @@ package grouppb
 
 import (
 	common "github.com/anychat/server/api/proto/common"
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -243,7 +243,7 @@ func (MuteType) EnumDescriptor() ([]byte, []int) {
 type GetGroupInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UserId        *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"` // 若传入，则 display_name 会反映该用户设置的群备注
+	UserId        *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"` // if provided, display_name reflects this user's group remark
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -305,9 +305,9 @@ type GetGroupInfoResponse struct {
 	JoinVerify    bool                   `protobuf:"varint,8,opt,name=join_verify,json=joinVerify,proto3" json:"join_verify,omitempty"`
 	IsMuted       bool                   `protobuf:"varint,9,opt,name=is_muted,json=isMuted,proto3" json:"is_muted,omitempty"`
 	Status        int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,14,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // 展示名：群备注优先，无备注则同 name
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,14,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // display name: group remark first, or name if no remark
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -419,14 +419,14 @@ func (x *GetGroupInfoResponse) GetStatus() int32 {
 	return 0
 }
 
-func (x *GetGroupInfoResponse) GetCreatedAt() *timestamp.Timestamp {
+func (x *GetGroupInfoResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *GetGroupInfoResponse) GetUpdatedAt() *timestamp.Timestamp {
+func (x *GetGroupInfoResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
@@ -565,9 +565,9 @@ type GroupMember struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	GroupNickname *string                `protobuf:"bytes,2,opt,name=group_nickname,json=groupNickname,proto3,oneof" json:"group_nickname,omitempty"`
 	Role          GroupRole              `protobuf:"varint,3,opt,name=role,proto3,enum=anychat.group.GroupRole" json:"role,omitempty"` // 1=owner,2=admin,3=member
-	JoinedAt      *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
 	UserInfo      *common.UserInfo       `protobuf:"bytes,6,opt,name=user_info,json=userInfo,proto3,oneof" json:"user_info,omitempty"`
-	MutedUntil    *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=muted_until,json=mutedUntil,proto3,oneof" json:"muted_until,omitempty"`
+	MutedUntil    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=muted_until,json=mutedUntil,proto3,oneof" json:"muted_until,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -623,7 +623,7 @@ func (x *GroupMember) GetRole() GroupRole {
 	return GroupRole_GROUP_ROLE_UNSPECIFIED
 }
 
-func (x *GroupMember) GetJoinedAt() *timestamp.Timestamp {
+func (x *GroupMember) GetJoinedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.JoinedAt
 	}
@@ -637,7 +637,7 @@ func (x *GroupMember) GetUserInfo() *common.UserInfo {
 	return nil
 }
 
-func (x *GroupMember) GetMutedUntil() *timestamp.Timestamp {
+func (x *GroupMember) GetMutedUntil() *timestamppb.Timestamp {
 	if x != nil {
 		return x.MutedUntil
 	}
@@ -697,11 +697,15 @@ func (x *IsMemberRequest) GetUserId() string {
 }
 
 type IsMemberResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsMember      bool                   `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
-	Role          GroupRole              `protobuf:"varint,2,opt,name=role,proto3,enum=anychat.group.GroupRole" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IsMember        bool                   `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	Role            GroupRole              `protobuf:"varint,2,opt,name=role,proto3,enum=anychat.group.GroupRole" json:"role,omitempty"`
+	JoinedAt        int64                  `protobuf:"varint,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`                        // Unix timestamp, 0 when not a member
+	MutedUntil      *int64                 `protobuf:"varint,4,opt,name=muted_until,json=mutedUntil,proto3,oneof" json:"muted_until,omitempty"`            // Unix timestamp, set while the member is muted
+	GroupMuted      bool                   `protobuf:"varint,5,opt,name=group_muted,json=groupMuted,proto3" json:"group_muted,omitempty"`                  // group-wide mute, owners and admins may still send
+	SlowModeSeconds int32                  `protobuf:"varint,6,opt,name=slow_mode_seconds,json=slowModeSeconds,proto3" json:"slow_mode_seconds,omitempty"` // minimum interval between messages of a member, 0 when off
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *IsMemberResponse) Reset() {
@@ -748,6 +752,34 @@ func (x *IsMemberResponse) GetRole() GroupRole {
 	return GroupRole_GROUP_ROLE_UNSPECIFIED
 }
 
+func (x *IsMemberResponse) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+func (x *IsMemberResponse) GetMutedUntil() int64 {
+	if x != nil && x.MutedUntil != nil {
+		return *x.MutedUntil
+	}
+	return 0
+}
+
+func (x *IsMemberResponse) GetGroupMuted() bool {
+	if x != nil {
+		return x.GroupMuted
+	}
+	return false
+}
+
+func (x *IsMemberResponse) GetSlowModeSeconds() int32 {
+	if x != nil {
+		return x.SlowModeSeconds
+	}
+	return 0
+}
+
 type GetUserGroupsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -866,8 +898,8 @@ type GroupInfo struct {
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
 	MemberCount   int32                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
-	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // 展示名：群备注优先，无备注则同 name
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // display name: group remark first, or name if no remark
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -930,7 +962,7 @@ func (x *GroupInfo) GetMemberCount() int32 {
 	return 0
 }
 
-func (x *GroupInfo) GetUpdatedAt() *timestamp.Timestamp {
+func (x *GroupInfo) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
@@ -1019,7 +1051,7 @@ type CreateGroupResponse struct {
 	Avatar        *string                `protobuf:"bytes,3,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
 	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	MemberCount   int32                  `protobuf:"varint,5,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1089,7 +1121,7 @@ func (x *CreateGroupResponse) GetMemberCount() int32 {
 	return 0
 }
 
-func (x *CreateGroupResponse) GetCreatedAt() *timestamp.Timestamp {
+func (x *CreateGroupResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
@@ -1101,7 +1133,7 @@ type UpdateGroupRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Avatar        *string                `protobuf:"bytes,4,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	Avatar        *string                `protobuf:"bytes,4,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"` // only "" to clear it, new avatars go through SetGroupAvatar
 	Announcement  *string                `protobuf:"bytes,5,opt,name=announcement,proto3,oneof" json:"announcement,omitempty"`
 	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -1180,6 +1212,112 @@ func (x *UpdateGroupRequest) GetDescription() string {
 	return ""
 }
 
+// SetGroupAvatarRequest set group avatar request
+type SetGroupAvatarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	FileId        string                 `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"` // image uploaded by the operator through file-service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupAvatarRequest) Reset() {
+	*x = SetGroupAvatarRequest{}
+	mi := &file_group_group_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupAvatarRequest) ProtoMessage() {}
+
+func (x *SetGroupAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupAvatarRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetGroupAvatarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetGroupAvatarRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetGroupAvatarRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+// SetGroupAvatarResponse set group avatar response
+type SetGroupAvatarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Avatar        string                 `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupAvatarResponse) Reset() {
+	*x = SetGroupAvatarResponse{}
+	mi := &file_group_group_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupAvatarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupAvatarResponse) ProtoMessage() {}
+
+func (x *SetGroupAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupAvatarResponse.ProtoReflect.Descriptor instead.
+func (*SetGroupAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetGroupAvatarResponse) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
 type DissolveGroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1190,7 +1328,7 @@ type DissolveGroupRequest struct {
 
 func (x *DissolveGroupRequest) Reset() {
 	*x = DissolveGroupRequest{}
-	mi := &file_group_group_proto_msgTypes[13]
+	mi := &file_group_group_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1202,7 +1340,7 @@ func (x *DissolveGroupRequest) String() string {
 func (*DissolveGroupRequest) ProtoMessage() {}
 
 func (x *DissolveGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[13]
+	mi := &file_group_group_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,7 +1353,7 @@ func (x *DissolveGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DissolveGroupRequest.ProtoReflect.Descriptor instead.
 func (*DissolveGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{13}
+	return file_group_group_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DissolveGroupRequest) GetUserId() string {
@@ -1243,7 +1381,7 @@ type InviteMembersRequest struct {
 
 func (x *InviteMembersRequest) Reset() {
 	*x = InviteMembersRequest{}
-	mi := &file_group_group_proto_msgTypes[14]
+	mi := &file_group_group_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1255,7 +1393,7 @@ func (x *InviteMembersRequest) String() string {
 func (*InviteMembersRequest) ProtoMessage() {}
 
 func (x *InviteMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[14]
+	mi := &file_group_group_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,7 +1406,7 @@ func (x *InviteMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteMembersRequest.ProtoReflect.Descriptor instead.
 func (*InviteMembersRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{14}
+	return file_group_group_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *InviteMembersRequest) GetUserId() string {
@@ -1303,7 +1441,7 @@ type RemoveMemberRequest struct {
 
 func (x *RemoveMemberRequest) Reset() {
 	*x = RemoveMemberRequest{}
-	mi := &file_group_group_proto_msgTypes[15]
+	mi := &file_group_group_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1315,7 +1453,7 @@ func (x *RemoveMemberRequest) String() string {
 func (*RemoveMemberRequest) ProtoMessage() {}
 
 func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[15]
+	mi := &file_group_group_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +1466,7 @@ func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
 func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{15}
+	return file_group_group_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RemoveMemberRequest) GetUserId() string {
@@ -1362,7 +1500,7 @@ type QuitGroupRequest struct {
 
 func (x *QuitGroupRequest) Reset() {
 	*x = QuitGroupRequest{}
-	mi := &file_group_group_proto_msgTypes[16]
+	mi := &file_group_group_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1374,7 +1512,7 @@ func (x *QuitGroupRequest) String() string {
 func (*QuitGroupRequest) ProtoMessage() {}
 
 func (x *QuitGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[16]
+	mi := &file_group_group_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1387,7 +1525,7 @@ func (x *QuitGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuitGroupRequest.ProtoReflect.Descriptor instead.
 func (*QuitGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{16}
+	return file_group_group_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QuitGroupRequest) GetUserId() string {
@@ -1416,7 +1554,7 @@ type UpdateMemberRoleRequest struct {
 
 func (x *UpdateMemberRoleRequest) Reset() {
 	*x = UpdateMemberRoleRequest{}
-	mi := &file_group_group_proto_msgTypes[17]
+	mi := &file_group_group_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1428,7 +1566,7 @@ func (x *UpdateMemberRoleRequest) String() string {
 func (*UpdateMemberRoleRequest) ProtoMessage() {}
 
 func (x *UpdateMemberRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[17]
+	mi := &file_group_group_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1441,7 +1579,7 @@ func (x *UpdateMemberRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMemberRoleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMemberRoleRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{17}
+	return file_group_group_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateMemberRoleRequest) GetUserId() string {
@@ -1483,7 +1621,7 @@ type UpdateMemberNicknameRequest struct {
 
 func (x *UpdateMemberNicknameRequest) Reset() {
 	*x = UpdateMemberNicknameRequest{}
-	mi := &file_group_group_proto_msgTypes[18]
+	mi := &file_group_group_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1495,7 +1633,7 @@ func (x *UpdateMemberNicknameRequest) String() string {
 func (*UpdateMemberNicknameRequest) ProtoMessage() {}
 
 func (x *UpdateMemberNicknameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[18]
+	mi := &file_group_group_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1508,7 +1646,7 @@ func (x *UpdateMemberNicknameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMemberNicknameRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMemberNicknameRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{18}
+	return file_group_group_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateMemberNicknameRequest) GetUserId() string {
@@ -1543,7 +1681,7 @@ type TransferOwnershipRequest struct {
 
 func (x *TransferOwnershipRequest) Reset() {
 	*x = TransferOwnershipRequest{}
-	mi := &file_group_group_proto_msgTypes[19]
+	mi := &file_group_group_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1555,7 +1693,7 @@ func (x *TransferOwnershipRequest) String() string {
 func (*TransferOwnershipRequest) ProtoMessage() {}
 
 func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[19]
+	mi := &file_group_group_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1568,7 +1706,7 @@ func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
 func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{19}
+	return file_group_group_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TransferOwnershipRequest) GetUserId() string {
@@ -1604,7 +1742,7 @@ type JoinGroupRequest struct {
 
 func (x *JoinGroupRequest) Reset() {
 	*x = JoinGroupRequest{}
-	mi := &file_group_group_proto_msgTypes[20]
+	mi := &file_group_group_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1616,7 +1754,7 @@ func (x *JoinGroupRequest) String() string {
 func (*JoinGroupRequest) ProtoMessage() {}
 
 func (x *JoinGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[20]
+	mi := &file_group_group_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1629,7 +1767,7 @@ func (x *JoinGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGroupRequest.ProtoReflect.Descriptor instead.
 func (*JoinGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{20}
+	return file_group_group_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *JoinGroupRequest) GetUserId() string {
@@ -1670,7 +1808,7 @@ type JoinGroupResponse struct {
 
 func (x *JoinGroupResponse) Reset() {
 	*x = JoinGroupResponse{}
-	mi := &file_group_group_proto_msgTypes[21]
+	mi := &file_group_group_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1682,7 +1820,7 @@ func (x *JoinGroupResponse) String() string {
 func (*JoinGroupResponse) ProtoMessage() {}
 
 func (x *JoinGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[21]
+	mi := &file_group_group_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1695,7 +1833,7 @@ func (x *JoinGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGroupResponse.ProtoReflect.Descriptor instead.
 func (*JoinGroupResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{21}
+	return file_group_group_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *JoinGroupResponse) GetNeedVerify() bool {
@@ -1723,7 +1861,7 @@ type HandleJoinRequestRequest struct {
 
 func (x *HandleJoinRequestRequest) Reset() {
 	*x = HandleJoinRequestRequest{}
-	mi := &file_group_group_proto_msgTypes[22]
+	mi := &file_group_group_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1735,7 +1873,7 @@ func (x *HandleJoinRequestRequest) String() string {
 func (*HandleJoinRequestRequest) ProtoMessage() {}
 
 func (x *HandleJoinRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[22]
+	mi := &file_group_group_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1748,7 +1886,7 @@ func (x *HandleJoinRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleJoinRequestRequest.ProtoReflect.Descriptor instead.
 func (*HandleJoinRequestRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{22}
+	return file_group_group_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *HandleJoinRequestRequest) GetUserId() string {
@@ -1783,7 +1921,7 @@ type GetJoinRequestsRequest struct {
 
 func (x *GetJoinRequestsRequest) Reset() {
 	*x = GetJoinRequestsRequest{}
-	mi := &file_group_group_proto_msgTypes[23]
+	mi := &file_group_group_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1795,7 +1933,7 @@ func (x *GetJoinRequestsRequest) String() string {
 func (*GetJoinRequestsRequest) ProtoMessage() {}
 
 func (x *GetJoinRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[23]
+	mi := &file_group_group_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,7 +1946,7 @@ func (x *GetJoinRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJoinRequestsRequest.ProtoReflect.Descriptor instead.
 func (*GetJoinRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{23}
+	return file_group_group_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetJoinRequestsRequest) GetGroupId() string {
@@ -1842,7 +1980,7 @@ type GetJoinRequestsResponse struct {
 
 func (x *GetJoinRequestsResponse) Reset() {
 	*x = GetJoinRequestsResponse{}
-	mi := &file_group_group_proto_msgTypes[24]
+	mi := &file_group_group_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1854,7 +1992,7 @@ func (x *GetJoinRequestsResponse) String() string {
 func (*GetJoinRequestsResponse) ProtoMessage() {}
 
 func (x *GetJoinRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[24]
+	mi := &file_group_group_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1867,7 +2005,7 @@ func (x *GetJoinRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJoinRequestsResponse.ProtoReflect.Descriptor instead.
 func (*GetJoinRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{24}
+	return file_group_group_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetJoinRequestsResponse) GetRequests() []*JoinRequest {
@@ -1892,7 +2030,7 @@ type JoinRequest struct {
 	InviterId     *string                `protobuf:"bytes,4,opt,name=inviter_id,json=inviterId,proto3,oneof" json:"inviter_id,omitempty"`
 	Message       *string                `protobuf:"bytes,5,opt,name=message,proto3,oneof" json:"message,omitempty"`
 	Status        JoinRequestStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=anychat.group.JoinRequestStatus" json:"status,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UserInfo      *common.UserInfo       `protobuf:"bytes,8,opt,name=user_info,json=userInfo,proto3,oneof" json:"user_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1900,7 +2038,7 @@ type JoinRequest struct {
 
 func (x *JoinRequest) Reset() {
 	*x = JoinRequest{}
-	mi := &file_group_group_proto_msgTypes[25]
+	mi := &file_group_group_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1912,7 +2050,7 @@ func (x *JoinRequest) String() string {
 func (*JoinRequest) ProtoMessage() {}
 
 func (x *JoinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[25]
+	mi := &file_group_group_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,7 +2063,7 @@ func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
 func (*JoinRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{25}
+	return file_group_group_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *JoinRequest) GetId() int64 {
@@ -1970,7 +2108,7 @@ func (x *JoinRequest) GetStatus() JoinRequestStatus {
 	return JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED
 }
 
-func (x *JoinRequest) GetCreatedAt() *timestamp.Timestamp {
+func (x *JoinRequest) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
@@ -1995,7 +2133,7 @@ type PinGroupMessageRequest struct {
 
 func (x *PinGroupMessageRequest) Reset() {
 	*x = PinGroupMessageRequest{}
-	mi := &file_group_group_proto_msgTypes[26]
+	mi := &file_group_group_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2007,7 +2145,7 @@ func (x *PinGroupMessageRequest) String() string {
 func (*PinGroupMessageRequest) ProtoMessage() {}
 
 func (x *PinGroupMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[26]
+	mi := &file_group_group_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2020,7 +2158,7 @@ func (x *PinGroupMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PinGroupMessageRequest.ProtoReflect.Descriptor instead.
 func (*PinGroupMessageRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{26}
+	return file_group_group_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *PinGroupMessageRequest) GetUserId() string {
@@ -2055,7 +2193,7 @@ type UnpinGroupMessageRequest struct {
 
 func (x *UnpinGroupMessageRequest) Reset() {
 	*x = UnpinGroupMessageRequest{}
-	mi := &file_group_group_proto_msgTypes[27]
+	mi := &file_group_group_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2067,7 +2205,7 @@ func (x *UnpinGroupMessageRequest) String() string {
 func (*UnpinGroupMessageRequest) ProtoMessage() {}
 
 func (x *UnpinGroupMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[27]
+	mi := &file_group_group_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2080,7 +2218,7 @@ func (x *UnpinGroupMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnpinGroupMessageRequest.ProtoReflect.Descriptor instead.
 func (*UnpinGroupMessageRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{27}
+	return file_group_group_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UnpinGroupMessageRequest) GetUserId() string {
@@ -2114,7 +2252,7 @@ type GetPinnedMessagesRequest struct {
 
 func (x *GetPinnedMessagesRequest) Reset() {
 	*x = GetPinnedMessagesRequest{}
-	mi := &file_group_group_proto_msgTypes[28]
+	mi := &file_group_group_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2126,7 +2264,7 @@ func (x *GetPinnedMessagesRequest) String() string {
 func (*GetPinnedMessagesRequest) ProtoMessage() {}
 
 func (x *GetPinnedMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[28]
+	mi := &file_group_group_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2139,7 +2277,7 @@ func (x *GetPinnedMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPinnedMessagesRequest.ProtoReflect.Descriptor instead.
 func (*GetPinnedMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{28}
+	return file_group_group_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetPinnedMessagesRequest) GetUserId() string {
@@ -2170,7 +2308,7 @@ type PinnedMessage struct {
 
 func (x *PinnedMessage) Reset() {
 	*x = PinnedMessage{}
-	mi := &file_group_group_proto_msgTypes[29]
+	mi := &file_group_group_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2182,7 +2320,7 @@ func (x *PinnedMessage) String() string {
 func (*PinnedMessage) ProtoMessage() {}
 
 func (x *PinnedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[29]
+	mi := &file_group_group_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2195,7 +2333,7 @@ func (x *PinnedMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PinnedMessage.ProtoReflect.Descriptor instead.
 func (*PinnedMessage) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{29}
+	return file_group_group_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PinnedMessage) GetMessageId() string {
@@ -2252,7 +2390,7 @@ type GetPinnedMessagesResponse struct {
 
 func (x *GetPinnedMessagesResponse) Reset() {
 	*x = GetPinnedMessagesResponse{}
-	mi := &file_group_group_proto_msgTypes[30]
+	mi := &file_group_group_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2264,7 +2402,7 @@ func (x *GetPinnedMessagesResponse) String() string {
 func (*GetPinnedMessagesResponse) ProtoMessage() {}
 
 func (x *GetPinnedMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[30]
+	mi := &file_group_group_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2277,7 +2415,7 @@ func (x *GetPinnedMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPinnedMessagesResponse.ProtoReflect.Descriptor instead.
 func (*GetPinnedMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{30}
+	return file_group_group_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetPinnedMessagesResponse) GetMessages() []*PinnedMessage {
@@ -2319,7 +2457,7 @@ type SetGroupMuteRequest struct {
 
 func (x *SetGroupMuteRequest) Reset() {
 	*x = SetGroupMuteRequest{}
-	mi := &file_group_group_proto_msgTypes[31]
+	mi := &file_group_group_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2331,7 +2469,7 @@ func (x *SetGroupMuteRequest) String() string {
 func (*SetGroupMuteRequest) ProtoMessage() {}
 
 func (x *SetGroupMuteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[31]
+	mi := &file_group_group_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2344,7 +2482,7 @@ func (x *SetGroupMuteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetGroupMuteRequest.ProtoReflect.Descriptor instead.
 func (*SetGroupMuteRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{31}
+	return file_group_group_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SetGroupMuteRequest) GetUserId() string {
@@ -2381,7 +2519,7 @@ type MuteMemberRequest struct {
 
 func (x *MuteMemberRequest) Reset() {
 	*x = MuteMemberRequest{}
-	mi := &file_group_group_proto_msgTypes[32]
+	mi := &file_group_group_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2393,7 +2531,7 @@ func (x *MuteMemberRequest) String() string {
 func (*MuteMemberRequest) ProtoMessage() {}
 
 func (x *MuteMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[32]
+	mi := &file_group_group_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2406,7 +2544,7 @@ func (x *MuteMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MuteMemberRequest.ProtoReflect.Descriptor instead.
 func (*MuteMemberRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{32}
+	return file_group_group_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *MuteMemberRequest) GetUserId() string {
@@ -2455,7 +2593,7 @@ type UnmuteMemberRequest struct {
 
 func (x *UnmuteMemberRequest) Reset() {
 	*x = UnmuteMemberRequest{}
-	mi := &file_group_group_proto_msgTypes[33]
+	mi := &file_group_group_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2467,7 +2605,7 @@ func (x *UnmuteMemberRequest) String() string {
 func (*UnmuteMemberRequest) ProtoMessage() {}
 
 func (x *UnmuteMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[33]
+	mi := &file_group_group_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2480,7 +2618,7 @@ func (x *UnmuteMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnmuteMemberRequest.ProtoReflect.Descriptor instead.
 func (*UnmuteMemberRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{33}
+	return file_group_group_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *UnmuteMemberRequest) GetUserId() string {
@@ -2514,14 +2652,15 @@ type UpdateGroupSettingsRequest struct {
 	AllowAddFriend    *bool                  `protobuf:"varint,6,opt,name=allow_add_friend,json=allowAddFriend,proto3,oneof" json:"allow_add_friend,omitempty"`
 	AllowMemberModify *bool                  `protobuf:"varint,7,opt,name=allow_member_modify,json=allowMemberModify,proto3,oneof" json:"allow_member_modify,omitempty"`
 	// Deprecated: Marked as deprecated in group/group.proto.
-	ShowMemberNickname *bool `protobuf:"varint,8,opt,name=show_member_nickname,json=showMemberNickname,proto3,oneof" json:"show_member_nickname,omitempty"`
+	ShowMemberNickname *bool  `protobuf:"varint,8,opt,name=show_member_nickname,json=showMemberNickname,proto3,oneof" json:"show_member_nickname,omitempty"`
+	SlowModeSeconds    *int32 `protobuf:"varint,9,opt,name=slow_mode_seconds,json=slowModeSeconds,proto3,oneof" json:"slow_mode_seconds,omitempty"` // 0 turns slow mode off
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdateGroupSettingsRequest) Reset() {
 	*x = UpdateGroupSettingsRequest{}
-	mi := &file_group_group_proto_msgTypes[34]
+	mi := &file_group_group_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2533,7 +2672,7 @@ func (x *UpdateGroupSettingsRequest) String() string {
 func (*UpdateGroupSettingsRequest) ProtoMessage() {}
 
 func (x *UpdateGroupSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[34]
+	mi := &file_group_group_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2546,7 +2685,7 @@ func (x *UpdateGroupSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateGroupSettingsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateGroupSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{34}
+	return file_group_group_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UpdateGroupSettingsRequest) GetUserId() string {
@@ -2606,6 +2745,13 @@ func (x *UpdateGroupSettingsRequest) GetShowMemberNickname() bool {
 	return false
 }
 
+func (x *UpdateGroupSettingsRequest) GetSlowModeSeconds() int32 {
+	if x != nil && x.SlowModeSeconds != nil {
+		return *x.SlowModeSeconds
+	}
+	return 0
+}
+
 type GetGroupSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
@@ -2615,7 +2761,7 @@ type GetGroupSettingsRequest struct {
 
 func (x *GetGroupSettingsRequest) Reset() {
 	*x = GetGroupSettingsRequest{}
-	mi := &file_group_group_proto_msgTypes[35]
+	mi := &file_group_group_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2627,7 +2773,7 @@ func (x *GetGroupSettingsRequest) String() string {
 func (*GetGroupSettingsRequest) ProtoMessage() {}
 
 func (x *GetGroupSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[35]
+	mi := &file_group_group_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2640,7 +2786,7 @@ func (x *GetGroupSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupSettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{35}
+	return file_group_group_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetGroupSettingsRequest) GetGroupId() string {
@@ -2659,14 +2805,15 @@ type GetGroupSettingsResponse struct {
 	AllowAddFriend    bool                   `protobuf:"varint,5,opt,name=allow_add_friend,json=allowAddFriend,proto3" json:"allow_add_friend,omitempty"`
 	AllowMemberModify bool                   `protobuf:"varint,6,opt,name=allow_member_modify,json=allowMemberModify,proto3" json:"allow_member_modify,omitempty"`
 	// Deprecated: Marked as deprecated in group/group.proto.
-	ShowMemberNickname bool `protobuf:"varint,7,opt,name=show_member_nickname,json=showMemberNickname,proto3" json:"show_member_nickname,omitempty"`
+	ShowMemberNickname bool  `protobuf:"varint,7,opt,name=show_member_nickname,json=showMemberNickname,proto3" json:"show_member_nickname,omitempty"`
+	SlowModeSeconds    int32 `protobuf:"varint,8,opt,name=slow_mode_seconds,json=slowModeSeconds,proto3" json:"slow_mode_seconds,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetGroupSettingsResponse) Reset() {
 	*x = GetGroupSettingsResponse{}
-	mi := &file_group_group_proto_msgTypes[36]
+	mi := &file_group_group_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2678,7 +2825,7 @@ func (x *GetGroupSettingsResponse) String() string {
 func (*GetGroupSettingsResponse) ProtoMessage() {}
 
 func (x *GetGroupSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[36]
+	mi := &file_group_group_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2691,7 +2838,7 @@ func (x *GetGroupSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupSettingsResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{36}
+	return file_group_group_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetGroupSettingsResponse) GetGroupId() string {
@@ -2744,18 +2891,25 @@ func (x *GetGroupSettingsResponse) GetShowMemberNickname() bool {
 	return false
 }
 
+func (x *GetGroupSettingsResponse) GetSlowModeSeconds() int32 {
+	if x != nil {
+		return x.SlowModeSeconds
+	}
+	return 0
+}
+
 type UpdateMemberRemarkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Remark        string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"` // 空字符串表示清空备注
+	Remark        string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"` // empty string means clear remark
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateMemberRemarkRequest) Reset() {
 	*x = UpdateMemberRemarkRequest{}
-	mi := &file_group_group_proto_msgTypes[37]
+	mi := &file_group_group_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2767,7 +2921,7 @@ func (x *UpdateMemberRemarkRequest) String() string {
 func (*UpdateMemberRemarkRequest) ProtoMessage() {}
 
 func (x *UpdateMemberRemarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[37]
+	mi := &file_group_group_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2780,7 +2934,7 @@ func (x *UpdateMemberRemarkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMemberRemarkRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMemberRemarkRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{37}
+	return file_group_group_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UpdateMemberRemarkRequest) GetUserId() string {
@@ -2814,7 +2968,7 @@ type GetGroupQRCodeRequest struct {
 
 func (x *GetGroupQRCodeRequest) Reset() {
 	*x = GetGroupQRCodeRequest{}
-	mi := &file_group_group_proto_msgTypes[38]
+	mi := &file_group_group_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2826,7 +2980,7 @@ func (x *GetGroupQRCodeRequest) String() string {
 func (*GetGroupQRCodeRequest) ProtoMessage() {}
 
 func (x *GetGroupQRCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[38]
+	mi := &file_group_group_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2839,7 +2993,7 @@ func (x *GetGroupQRCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupQRCodeRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupQRCodeRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{38}
+	return file_group_group_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetGroupQRCodeRequest) GetUserId() string {
@@ -2859,15 +3013,15 @@ func (x *GetGroupQRCodeRequest) GetGroupId() string {
 type GetGroupQRCodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	DeepLink      string                 `protobuf:"bytes,2,opt,name=deep_link,json=deepLink,proto3" json:"deep_link,omitempty"`  // 如 anychat://group/join?token=xxx
-	ExpireAt      int64                  `protobuf:"varint,3,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"` // Unix 时间戳（秒）
+	DeepLink      string                 `protobuf:"bytes,2,opt,name=deep_link,json=deepLink,proto3" json:"deep_link,omitempty"`  // e.g. anychat://group/join?token=xxx
+	ExpireAt      int64                  `protobuf:"varint,3,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"` // Unix timestamp (seconds)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetGroupQRCodeResponse) Reset() {
 	*x = GetGroupQRCodeResponse{}
-	mi := &file_group_group_proto_msgTypes[39]
+	mi := &file_group_group_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2879,7 +3033,7 @@ func (x *GetGroupQRCodeResponse) String() string {
 func (*GetGroupQRCodeResponse) ProtoMessage() {}
 
 func (x *GetGroupQRCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[39]
+	mi := &file_group_group_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2892,7 +3046,7 @@ func (x *GetGroupQRCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupQRCodeResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupQRCodeResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{39}
+	return file_group_group_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetGroupQRCodeResponse) GetToken() string {
@@ -2926,7 +3080,7 @@ type RefreshGroupQRCodeRequest struct {
 
 func (x *RefreshGroupQRCodeRequest) Reset() {
 	*x = RefreshGroupQRCodeRequest{}
-	mi := &file_group_group_proto_msgTypes[40]
+	mi := &file_group_group_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2938,7 +3092,7 @@ func (x *RefreshGroupQRCodeRequest) String() string {
 func (*RefreshGroupQRCodeRequest) ProtoMessage() {}
 
 func (x *RefreshGroupQRCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[40]
+	mi := &file_group_group_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2951,7 +3105,7 @@ func (x *RefreshGroupQRCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshGroupQRCodeRequest.ProtoReflect.Descriptor instead.
 func (*RefreshGroupQRCodeRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{40}
+	return file_group_group_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *RefreshGroupQRCodeRequest) GetUserId() string {
@@ -2977,7 +3131,7 @@ type GetGroupPreviewByQRCodeRequest struct {
 
 func (x *GetGroupPreviewByQRCodeRequest) Reset() {
 	*x = GetGroupPreviewByQRCodeRequest{}
-	mi := &file_group_group_proto_msgTypes[41]
+	mi := &file_group_group_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2989,7 +3143,7 @@ func (x *GetGroupPreviewByQRCodeRequest) String() string {
 func (*GetGroupPreviewByQRCodeRequest) ProtoMessage() {}
 
 func (x *GetGroupPreviewByQRCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[41]
+	mi := &file_group_group_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3002,7 +3156,7 @@ func (x *GetGroupPreviewByQRCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupPreviewByQRCodeRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupPreviewByQRCodeRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{41}
+	return file_group_group_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetGroupPreviewByQRCodeRequest) GetToken() string {
@@ -3025,7 +3179,7 @@ type GetGroupPreviewByQRCodeResponse struct {
 
 func (x *GetGroupPreviewByQRCodeResponse) Reset() {
 	*x = GetGroupPreviewByQRCodeResponse{}
-	mi := &file_group_group_proto_msgTypes[42]
+	mi := &file_group_group_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3037,7 +3191,7 @@ func (x *GetGroupPreviewByQRCodeResponse) String() string {
 func (*GetGroupPreviewByQRCodeResponse) ProtoMessage() {}
 
 func (x *GetGroupPreviewByQRCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[42]
+	mi := &file_group_group_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3050,7 +3204,7 @@ func (x *GetGroupPreviewByQRCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupPreviewByQRCodeResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupPreviewByQRCodeResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{42}
+	return file_group_group_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetGroupPreviewByQRCodeResponse) GetGroupId() string {
@@ -3098,7 +3252,7 @@ type JoinGroupByQRCodeRequest struct {
 
 func (x *JoinGroupByQRCodeRequest) Reset() {
 	*x = JoinGroupByQRCodeRequest{}
-	mi := &file_group_group_proto_msgTypes[43]
+	mi := &file_group_group_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3110,7 +3264,7 @@ func (x *JoinGroupByQRCodeRequest) String() string {
 func (*JoinGroupByQRCodeRequest) ProtoMessage() {}
 
 func (x *JoinGroupByQRCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[43]
+	mi := &file_group_group_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3123,7 +3277,7 @@ func (x *JoinGroupByQRCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGroupByQRCodeRequest.ProtoReflect.Descriptor instead.
 func (*JoinGroupByQRCodeRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{43}
+	return file_group_group_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *JoinGroupByQRCodeRequest) GetUserId() string {
@@ -3142,17 +3296,17 @@ func (x *JoinGroupByQRCodeRequest) GetToken() string {
 
 type JoinGroupByQRCodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Joined        bool                   `protobuf:"varint,1,opt,name=joined,proto3" json:"joined,omitempty"` // true=直接加入，false=已提交申请
+	Joined        bool                   `protobuf:"varint,1,opt,name=joined,proto3" json:"joined,omitempty"` // true=joined directly, false=request submitted
 	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	NeedVerify    bool                   `protobuf:"varint,3,opt,name=need_verify,json=needVerify,proto3" json:"need_verify,omitempty"`
-	RequestId     *int64                 `protobuf:"varint,4,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"` // need_verify=true 时有效
+	RequestId     *int64                 `protobuf:"varint,4,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"` // valid when need_verify=true
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JoinGroupByQRCodeResponse) Reset() {
 	*x = JoinGroupByQRCodeResponse{}
-	mi := &file_group_group_proto_msgTypes[44]
+	mi := &file_group_group_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3164,7 +3318,7 @@ func (x *JoinGroupByQRCodeResponse) String() string {
 func (*JoinGroupByQRCodeResponse) ProtoMessage() {}
 
 func (x *JoinGroupByQRCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_proto_msgTypes[44]
+	mi := &file_group_group_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3177,7 +3331,7 @@ func (x *JoinGroupByQRCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGroupByQRCodeResponse.ProtoReflect.Descriptor instead.
 func (*JoinGroupByQRCodeResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_proto_rawDescGZIP(), []int{44}
+	return file_group_group_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *JoinGroupByQRCodeResponse) GetJoined() bool {
@@ -3263,10 +3417,17 @@ const file_group_group_proto_rawDesc = "" +
 	"\f_muted_untilJ\x04\b\x04\x10\x05\"E\n" +
 	"\x0fIsMemberRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"]\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xfd\x01\n" +
 	"\x10IsMemberResponse\x12\x1b\n" +
 	"\tis_member\x18\x01 \x01(\bR\bisMember\x12,\n" +
-	"\x04role\x18\x02 \x01(\x0e2\x18.anychat.group.GroupRoleR\x04role\"s\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x18.anychat.group.GroupRoleR\x04role\x12\x1b\n" +
+	"\tjoined_at\x18\x03 \x01(\x03R\bjoinedAt\x12$\n" +
+	"\vmuted_until\x18\x04 \x01(\x03H\x00R\n" +
+	"mutedUntil\x88\x01\x01\x12\x1f\n" +
+	"\vgroup_muted\x18\x05 \x01(\bR\n" +
+	"groupMuted\x12*\n" +
+	"\x11slow_mode_seconds\x18\x06 \x01(\x05R\x0fslowModeSecondsB\x0e\n" +
+	"\f_muted_until\"s\n" +
 	"\x14GetUserGroupsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
 	"\x10last_update_time\x18\x02 \x01(\x03H\x00R\x0elastUpdateTime\x88\x01\x01B\x13\n" +
@@ -3310,7 +3471,13 @@ const file_group_group_proto_rawDesc = "" +
 	"\x05_nameB\t\n" +
 	"\a_avatarB\x0f\n" +
 	"\r_announcementB\x0e\n" +
-	"\f_description\"J\n" +
+	"\f_description\"d\n" +
+	"\x15SetGroupAvatarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x17\n" +
+	"\afile_id\x18\x03 \x01(\tR\x06fileId\"0\n" +
+	"\x16SetGroupAvatarResponse\x12\x16\n" +
+	"\x06avatar\x18\x01 \x01(\tR\x06avatar\"J\n" +
 	"\x14DissolveGroupRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"k\n" +
@@ -3428,7 +3595,7 @@ const file_group_group_proto_rawDesc = "" +
 	"\x13UnmuteMemberRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12$\n" +
-	"\x0etarget_user_id\x18\x03 \x01(\tR\ftargetUserId\"\x82\x04\n" +
+	"\x0etarget_user_id\x18\x03 \x01(\tR\ftargetUserId\"\xc9\x04\n" +
 	"\x1aUpdateGroupSettingsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12$\n" +
@@ -3438,15 +3605,17 @@ const file_group_group_proto_rawDesc = "" +
 	"\x12allow_view_history\x18\x05 \x01(\bH\x02R\x10allowViewHistory\x88\x01\x01\x12-\n" +
 	"\x10allow_add_friend\x18\x06 \x01(\bH\x03R\x0eallowAddFriend\x88\x01\x01\x123\n" +
 	"\x13allow_member_modify\x18\a \x01(\bH\x04R\x11allowMemberModify\x88\x01\x01\x129\n" +
-	"\x14show_member_nickname\x18\b \x01(\bB\x02\x18\x01H\x05R\x12showMemberNickname\x88\x01\x01B\x0e\n" +
+	"\x14show_member_nickname\x18\b \x01(\bB\x02\x18\x01H\x05R\x12showMemberNickname\x88\x01\x01\x12/\n" +
+	"\x11slow_mode_seconds\x18\t \x01(\x05H\x06R\x0fslowModeSeconds\x88\x01\x01B\x0e\n" +
 	"\f_join_verifyB\x16\n" +
 	"\x14_allow_member_inviteB\x15\n" +
 	"\x13_allow_view_historyB\x13\n" +
 	"\x11_allow_add_friendB\x16\n" +
 	"\x14_allow_member_modifyB\x17\n" +
-	"\x15_show_member_nickname\"4\n" +
+	"\x15_show_member_nicknameB\x14\n" +
+	"\x12_slow_mode_seconds\"4\n" +
 	"\x17GetGroupSettingsRequest\x12\x19\n" +
-	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"\xc4\x02\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"\xf0\x02\n" +
 	"\x18GetGroupSettingsResponse\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
 	"\vjoin_verify\x18\x02 \x01(\bR\n" +
@@ -3455,7 +3624,8 @@ const file_group_group_proto_rawDesc = "" +
 	"\x12allow_view_history\x18\x04 \x01(\bR\x10allowViewHistory\x12(\n" +
 	"\x10allow_add_friend\x18\x05 \x01(\bR\x0eallowAddFriend\x12.\n" +
 	"\x13allow_member_modify\x18\x06 \x01(\bR\x11allowMemberModify\x124\n" +
-	"\x14show_member_nickname\x18\a \x01(\bB\x02\x18\x01R\x12showMemberNickname\"g\n" +
+	"\x14show_member_nickname\x18\a \x01(\bB\x02\x18\x01R\x12showMemberNickname\x12*\n" +
+	"\x11slow_mode_seconds\x18\b \x01(\x05R\x0fslowModeSeconds\"g\n" +
 	"\x19UpdateMemberRemarkRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x16\n" +
@@ -3512,14 +3682,15 @@ const file_group_group_proto_rawDesc = "" +
 	"\bMuteType\x12\x19\n" +
 	"\x15MUTE_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13MUTE_TYPE_PERMANENT\x10\x01\x12\x17\n" +
-	"\x13MUTE_TYPE_TEMPORARY\x10\x022\xed\x13\n" +
+	"\x13MUTE_TYPE_TEMPORARY\x10\x022\xaa\x15\n" +
 	"\fGroupService\x12W\n" +
 	"\fGetGroupInfo\x12\".anychat.group.GetGroupInfoRequest\x1a#.anychat.group.GetGroupInfoResponse\x12`\n" +
 	"\x0fGetGroupMembers\x12%.anychat.group.GetGroupMembersRequest\x1a&.anychat.group.GetGroupMembersResponse\x12K\n" +
 	"\bIsMember\x12\x1e.anychat.group.IsMemberRequest\x1a\x1f.anychat.group.IsMemberResponse\x12Z\n" +
 	"\rGetUserGroups\x12#.anychat.group.GetUserGroupsRequest\x1a$.anychat.group.GetUserGroupsResponse\x12T\n" +
 	"\vCreateGroup\x12!.anychat.group.CreateGroupRequest\x1a\".anychat.group.CreateGroupResponse\x12G\n" +
-	"\vUpdateGroup\x12!.anychat.group.UpdateGroupRequest\x1a\x15.anychat.common.Empty\x12K\n" +
+	"\vUpdateGroup\x12!.anychat.group.UpdateGroupRequest\x1a\x15.anychat.common.Empty\x12]\n" +
+	"\x0eSetGroupAvatar\x12$.anychat.group.SetGroupAvatarRequest\x1a%.anychat.group.SetGroupAvatarResponse\x12K\n" +
 	"\rDissolveGroup\x12#.anychat.group.DissolveGroupRequest\x1a\x15.anychat.common.Empty\x12K\n" +
 	"\rInviteMembers\x12#.anychat.group.InviteMembersRequest\x1a\x15.anychat.common.Empty\x12I\n" +
 	"\fRemoveMember\x12\".anychat.group.RemoveMemberRequest\x1a\x15.anychat.common.Empty\x12C\n" +
@@ -3543,7 +3714,8 @@ const file_group_group_proto_rawDesc = "" +
 	"\x0eGetGroupQRCode\x12$.anychat.group.GetGroupQRCodeRequest\x1a%.anychat.group.GetGroupQRCodeResponse\x12e\n" +
 	"\x12RefreshGroupQRCode\x12(.anychat.group.RefreshGroupQRCodeRequest\x1a%.anychat.group.GetGroupQRCodeResponse\x12x\n" +
 	"\x17GetGroupPreviewByQRCode\x12-.anychat.group.GetGroupPreviewByQRCodeRequest\x1a..anychat.group.GetGroupPreviewByQRCodeResponse\x12f\n" +
-	"\x11JoinGroupByQRCode\x12'.anychat.group.JoinGroupByQRCodeRequest\x1a(.anychat.group.JoinGroupByQRCodeResponseB3Z1github.com/anychat/server/api/proto/group;grouppbb\x06proto3"
+	"\x11JoinGroupByQRCode\x12'.anychat.group.JoinGroupByQRCodeRequest\x1a(.anychat.group.JoinGroupByQRCodeResponse\x12\\\n" +
+	"\rEraseUserData\x12$.anychat.common.EraseUserDataRequest\x1a%.anychat.common.EraseUserDataResponseB3Z1github.com/anychat/server/api/proto/group;grouppbb\x06proto3"
 
 var (
 	file_group_group_proto_rawDescOnce sync.Once
@@ -3558,7 +3730,7 @@ func file_group_group_proto_rawDescGZIP() []byte {
 }
 
 var file_group_group_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_group_group_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_group_group_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_group_group_proto_goTypes = []any{
 	(GroupRole)(0),                          // 0: anychat.group.GroupRole
 	(JoinRequestStatus)(0),                  // 1: anychat.group.JoinRequestStatus
@@ -3577,63 +3749,67 @@ var file_group_group_proto_goTypes = []any{
 	(*CreateGroupRequest)(nil),              // 14: anychat.group.CreateGroupRequest
 	(*CreateGroupResponse)(nil),             // 15: anychat.group.CreateGroupResponse
 	(*UpdateGroupRequest)(nil),              // 16: anychat.group.UpdateGroupRequest
-	(*DissolveGroupRequest)(nil),            // 17: anychat.group.DissolveGroupRequest
-	(*InviteMembersRequest)(nil),            // 18: anychat.group.InviteMembersRequest
-	(*RemoveMemberRequest)(nil),             // 19: anychat.group.RemoveMemberRequest
-	(*QuitGroupRequest)(nil),                // 20: anychat.group.QuitGroupRequest
-	(*UpdateMemberRoleRequest)(nil),         // 21: anychat.group.UpdateMemberRoleRequest
-	(*UpdateMemberNicknameRequest)(nil),     // 22: anychat.group.UpdateMemberNicknameRequest
-	(*TransferOwnershipRequest)(nil),        // 23: anychat.group.TransferOwnershipRequest
-	(*JoinGroupRequest)(nil),                // 24: anychat.group.JoinGroupRequest
-	(*JoinGroupResponse)(nil),               // 25: anychat.group.JoinGroupResponse
-	(*HandleJoinRequestRequest)(nil),        // 26: anychat.group.HandleJoinRequestRequest
-	(*GetJoinRequestsRequest)(nil),          // 27: anychat.group.GetJoinRequestsRequest
-	(*GetJoinRequestsResponse)(nil),         // 28: anychat.group.GetJoinRequestsResponse
-	(*JoinRequest)(nil),                     // 29: anychat.group.JoinRequest
-	(*PinGroupMessageRequest)(nil),          // 30: anychat.group.PinGroupMessageRequest
-	(*UnpinGroupMessageRequest)(nil),        // 31: anychat.group.UnpinGroupMessageRequest
-	(*GetPinnedMessagesRequest)(nil),        // 32: anychat.group.GetPinnedMessagesRequest
-	(*PinnedMessage)(nil),                   // 33: anychat.group.PinnedMessage
-	(*GetPinnedMessagesResponse)(nil),       // 34: anychat.group.GetPinnedMessagesResponse
-	(*SetGroupMuteRequest)(nil),             // 35: anychat.group.SetGroupMuteRequest
-	(*MuteMemberRequest)(nil),               // 36: anychat.group.MuteMemberRequest
-	(*UnmuteMemberRequest)(nil),             // 37: anychat.group.UnmuteMemberRequest
-	(*UpdateGroupSettingsRequest)(nil),      // 38: anychat.group.UpdateGroupSettingsRequest
-	(*GetGroupSettingsRequest)(nil),         // 39: anychat.group.GetGroupSettingsRequest
-	(*GetGroupSettingsResponse)(nil),        // 40: anychat.group.GetGroupSettingsResponse
-	(*UpdateMemberRemarkRequest)(nil),       // 41: anychat.group.UpdateMemberRemarkRequest
-	(*GetGroupQRCodeRequest)(nil),           // 42: anychat.group.GetGroupQRCodeRequest
-	(*GetGroupQRCodeResponse)(nil),          // 43: anychat.group.GetGroupQRCodeResponse
-	(*RefreshGroupQRCodeRequest)(nil),       // 44: anychat.group.RefreshGroupQRCodeRequest
-	(*GetGroupPreviewByQRCodeRequest)(nil),  // 45: anychat.group.GetGroupPreviewByQRCodeRequest
-	(*GetGroupPreviewByQRCodeResponse)(nil), // 46: anychat.group.GetGroupPreviewByQRCodeResponse
-	(*JoinGroupByQRCodeRequest)(nil),        // 47: anychat.group.JoinGroupByQRCodeRequest
-	(*JoinGroupByQRCodeResponse)(nil),       // 48: anychat.group.JoinGroupByQRCodeResponse
-	(*timestamp.Timestamp)(nil),             // 49: google.protobuf.Timestamp
-	(*common.UserInfo)(nil),                 // 50: anychat.common.UserInfo
-	(*common.Empty)(nil),                    // 51: anychat.common.Empty
+	(*SetGroupAvatarRequest)(nil),           // 17: anychat.group.SetGroupAvatarRequest
+	(*SetGroupAvatarResponse)(nil),          // 18: anychat.group.SetGroupAvatarResponse
+	(*DissolveGroupRequest)(nil),            // 19: anychat.group.DissolveGroupRequest
+	(*InviteMembersRequest)(nil),            // 20: anychat.group.InviteMembersRequest
+	(*RemoveMemberRequest)(nil),             // 21: anychat.group.RemoveMemberRequest
+	(*QuitGroupRequest)(nil),                // 22: anychat.group.QuitGroupRequest
+	(*UpdateMemberRoleRequest)(nil),         // 23: anychat.group.UpdateMemberRoleRequest
+	(*UpdateMemberNicknameRequest)(nil),     // 24: anychat.group.UpdateMemberNicknameRequest
+	(*TransferOwnershipRequest)(nil),        // 25: anychat.group.TransferOwnershipRequest
+	(*JoinGroupRequest)(nil),                // 26: anychat.group.JoinGroupRequest
+	(*JoinGroupResponse)(nil),               // 27: anychat.group.JoinGroupResponse
+	(*HandleJoinRequestRequest)(nil),        // 28: anychat.group.HandleJoinRequestRequest
+	(*GetJoinRequestsRequest)(nil),          // 29: anychat.group.GetJoinRequestsRequest
+	(*GetJoinRequestsResponse)(nil),         // 30: anychat.group.GetJoinRequestsResponse
+	(*JoinRequest)(nil),                     // 31: anychat.group.JoinRequest
+	(*PinGroupMessageRequest)(nil),          // 32: anychat.group.PinGroupMessageRequest
+	(*UnpinGroupMessageRequest)(nil),        // 33: anychat.group.UnpinGroupMessageRequest
+	(*GetPinnedMessagesRequest)(nil),        // 34: anychat.group.GetPinnedMessagesRequest
+	(*PinnedMessage)(nil),                   // 35: anychat.group.PinnedMessage
+	(*GetPinnedMessagesResponse)(nil),       // 36: anychat.group.GetPinnedMessagesResponse
+	(*SetGroupMuteRequest)(nil),             // 37: anychat.group.SetGroupMuteRequest
+	(*MuteMemberRequest)(nil),               // 38: anychat.group.MuteMemberRequest
+	(*UnmuteMemberRequest)(nil),             // 39: anychat.group.UnmuteMemberRequest
+	(*UpdateGroupSettingsRequest)(nil),      // 40: anychat.group.UpdateGroupSettingsRequest
+	(*GetGroupSettingsRequest)(nil),         // 41: anychat.group.GetGroupSettingsRequest
+	(*GetGroupSettingsResponse)(nil),        // 42: anychat.group.GetGroupSettingsResponse
+	(*UpdateMemberRemarkRequest)(nil),       // 43: anychat.group.UpdateMemberRemarkRequest
+	(*GetGroupQRCodeRequest)(nil),           // 44: anychat.group.GetGroupQRCodeRequest
+	(*GetGroupQRCodeResponse)(nil),          // 45: anychat.group.GetGroupQRCodeResponse
+	(*RefreshGroupQRCodeRequest)(nil),       // 46: anychat.group.RefreshGroupQRCodeRequest
+	(*GetGroupPreviewByQRCodeRequest)(nil),  // 47: anychat.group.GetGroupPreviewByQRCodeRequest
+	(*GetGroupPreviewByQRCodeResponse)(nil), // 48: anychat.group.GetGroupPreviewByQRCodeResponse
+	(*JoinGroupByQRCodeRequest)(nil),        // 49: anychat.group.JoinGroupByQRCodeRequest
+	(*JoinGroupByQRCodeResponse)(nil),       // 50: anychat.group.JoinGroupByQRCodeResponse
+	(*timestamppb.Timestamp)(nil),           // 51: google.protobuf.Timestamp
+	(*common.UserInfo)(nil),                 // 52: anychat.common.UserInfo
+	(*common.EraseUserDataRequest)(nil),     // 53: anychat.common.EraseUserDataRequest
+	(*common.Empty)(nil),                    // 54: anychat.common.Empty
+	(*common.EraseUserDataResponse)(nil),    // 55: anychat.common.EraseUserDataResponse
 }
 var file_group_group_proto_depIdxs = []int32{
-	49, // 0: anychat.group.GetGroupInfoResponse.created_at:type_name -> google.protobuf.Timestamp
-	49, // 1: anychat.group.GetGroupInfoResponse.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 0: anychat.group.GetGroupInfoResponse.created_at:type_name -> google.protobuf.Timestamp
+	51, // 1: anychat.group.GetGroupInfoResponse.updated_at:type_name -> google.protobuf.Timestamp
 	8,  // 2: anychat.group.GetGroupMembersResponse.members:type_name -> anychat.group.GroupMember
 	0,  // 3: anychat.group.GroupMember.role:type_name -> anychat.group.GroupRole
-	49, // 4: anychat.group.GroupMember.joined_at:type_name -> google.protobuf.Timestamp
-	50, // 5: anychat.group.GroupMember.user_info:type_name -> anychat.common.UserInfo
-	49, // 6: anychat.group.GroupMember.muted_until:type_name -> google.protobuf.Timestamp
+	51, // 4: anychat.group.GroupMember.joined_at:type_name -> google.protobuf.Timestamp
+	52, // 5: anychat.group.GroupMember.user_info:type_name -> anychat.common.UserInfo
+	51, // 6: anychat.group.GroupMember.muted_until:type_name -> google.protobuf.Timestamp
 	0,  // 7: anychat.group.IsMemberResponse.role:type_name -> anychat.group.GroupRole
 	13, // 8: anychat.group.GetUserGroupsResponse.groups:type_name -> anychat.group.GroupInfo
-	49, // 9: anychat.group.GroupInfo.updated_at:type_name -> google.protobuf.Timestamp
-	49, // 10: anychat.group.CreateGroupResponse.created_at:type_name -> google.protobuf.Timestamp
+	51, // 9: anychat.group.GroupInfo.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 10: anychat.group.CreateGroupResponse.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 11: anychat.group.UpdateMemberRoleRequest.role:type_name -> anychat.group.GroupRole
 	1,  // 12: anychat.group.GetJoinRequestsRequest.status:type_name -> anychat.group.JoinRequestStatus
-	29, // 13: anychat.group.GetJoinRequestsResponse.requests:type_name -> anychat.group.JoinRequest
+	31, // 13: anychat.group.GetJoinRequestsResponse.requests:type_name -> anychat.group.JoinRequest
 	1,  // 14: anychat.group.JoinRequest.status:type_name -> anychat.group.JoinRequestStatus
-	49, // 15: anychat.group.JoinRequest.created_at:type_name -> google.protobuf.Timestamp
-	50, // 16: anychat.group.JoinRequest.user_info:type_name -> anychat.common.UserInfo
+	51, // 15: anychat.group.JoinRequest.created_at:type_name -> google.protobuf.Timestamp
+	52, // 16: anychat.group.JoinRequest.user_info:type_name -> anychat.common.UserInfo
 	2,  // 17: anychat.group.PinnedMessage.content_type:type_name -> anychat.group.MessageContentType
-	33, // 18: anychat.group.GetPinnedMessagesResponse.messages:type_name -> anychat.group.PinnedMessage
-	33, // 19: anychat.group.GetPinnedMessagesResponse.top_message:type_name -> anychat.group.PinnedMessage
+	35, // 18: anychat.group.GetPinnedMessagesResponse.messages:type_name -> anychat.group.PinnedMessage
+	35, // 19: anychat.group.GetPinnedMessagesResponse.top_message:type_name -> anychat.group.PinnedMessage
 	3,  // 20: anychat.group.MuteMemberRequest.type:type_name -> anychat.group.MuteType
 	4,  // 21: anychat.group.GroupService.GetGroupInfo:input_type -> anychat.group.GetGroupInfoRequest
 	6,  // 22: anychat.group.GroupService.GetGroupMembers:input_type -> anychat.group.GetGroupMembersRequest
@@ -3641,60 +3817,64 @@ var file_group_group_proto_depIdxs = []int32{
 	11, // 24: anychat.group.GroupService.GetUserGroups:input_type -> anychat.group.GetUserGroupsRequest
 	14, // 25: anychat.group.GroupService.CreateGroup:input_type -> anychat.group.CreateGroupRequest
 	16, // 26: anychat.group.GroupService.UpdateGroup:input_type -> anychat.group.UpdateGroupRequest
-	17, // 27: anychat.group.GroupService.DissolveGroup:input_type -> anychat.group.DissolveGroupRequest
-	18, // 28: anychat.group.GroupService.InviteMembers:input_type -> anychat.group.InviteMembersRequest
-	19, // 29: anychat.group.GroupService.RemoveMember:input_type -> anychat.group.RemoveMemberRequest
-	20, // 30: anychat.group.GroupService.QuitGroup:input_type -> anychat.group.QuitGroupRequest
-	21, // 31: anychat.group.GroupService.UpdateMemberRole:input_type -> anychat.group.UpdateMemberRoleRequest
-	22, // 32: anychat.group.GroupService.UpdateMemberNickname:input_type -> anychat.group.UpdateMemberNicknameRequest
-	23, // 33: anychat.group.GroupService.TransferOwnership:input_type -> anychat.group.TransferOwnershipRequest
-	24, // 34: anychat.group.GroupService.JoinGroup:input_type -> anychat.group.JoinGroupRequest
-	26, // 35: anychat.group.GroupService.HandleJoinRequest:input_type -> anychat.group.HandleJoinRequestRequest
-	27, // 36: anychat.group.GroupService.GetJoinRequests:input_type -> anychat.group.GetJoinRequestsRequest
-	30, // 37: anychat.group.GroupService.PinGroupMessage:input_type -> anychat.group.PinGroupMessageRequest
-	31, // 38: anychat.group.GroupService.UnpinGroupMessage:input_type -> anychat.group.UnpinGroupMessageRequest
-	32, // 39: anychat.group.GroupService.GetPinnedMessages:input_type -> anychat.group.GetPinnedMessagesRequest
-	35, // 40: anychat.group.GroupService.SetGroupMute:input_type -> anychat.group.SetGroupMuteRequest
-	36, // 41: anychat.group.GroupService.MuteMember:input_type -> anychat.group.MuteMemberRequest
-	37, // 42: anychat.group.GroupService.UnmuteMember:input_type -> anychat.group.UnmuteMemberRequest
-	38, // 43: anychat.group.GroupService.UpdateGroupSettings:input_type -> anychat.group.UpdateGroupSettingsRequest
-	39, // 44: anychat.group.GroupService.GetGroupSettings:input_type -> anychat.group.GetGroupSettingsRequest
-	41, // 45: anychat.group.GroupService.UpdateMemberRemark:input_type -> anychat.group.UpdateMemberRemarkRequest
-	42, // 46: anychat.group.GroupService.GetGroupQRCode:input_type -> anychat.group.GetGroupQRCodeRequest
-	44, // 47: anychat.group.GroupService.RefreshGroupQRCode:input_type -> anychat.group.RefreshGroupQRCodeRequest
-	45, // 48: anychat.group.GroupService.GetGroupPreviewByQRCode:input_type -> anychat.group.GetGroupPreviewByQRCodeRequest
-	47, // 49: anychat.group.GroupService.JoinGroupByQRCode:input_type -> anychat.group.JoinGroupByQRCodeRequest
-	5,  // 50: anychat.group.GroupService.GetGroupInfo:output_type -> anychat.group.GetGroupInfoResponse
-	7,  // 51: anychat.group.GroupService.GetGroupMembers:output_type -> anychat.group.GetGroupMembersResponse
-	10, // 52: anychat.group.GroupService.IsMember:output_type -> anychat.group.IsMemberResponse
-	12, // 53: anychat.group.GroupService.GetUserGroups:output_type -> anychat.group.GetUserGroupsResponse
-	15, // 54: anychat.group.GroupService.CreateGroup:output_type -> anychat.group.CreateGroupResponse
-	51, // 55: anychat.group.GroupService.UpdateGroup:output_type -> anychat.common.Empty
-	51, // 56: anychat.group.GroupService.DissolveGroup:output_type -> anychat.common.Empty
-	51, // 57: anychat.group.GroupService.InviteMembers:output_type -> anychat.common.Empty
-	51, // 58: anychat.group.GroupService.RemoveMember:output_type -> anychat.common.Empty
-	51, // 59: anychat.group.GroupService.QuitGroup:output_type -> anychat.common.Empty
-	51, // 60: anychat.group.GroupService.UpdateMemberRole:output_type -> anychat.common.Empty
-	51, // 61: anychat.group.GroupService.UpdateMemberNickname:output_type -> anychat.common.Empty
-	51, // 62: anychat.group.GroupService.TransferOwnership:output_type -> anychat.common.Empty
-	25, // 63: anychat.group.GroupService.JoinGroup:output_type -> anychat.group.JoinGroupResponse
-	51, // 64: anychat.group.GroupService.HandleJoinRequest:output_type -> anychat.common.Empty
-	28, // 65: anychat.group.GroupService.GetJoinRequests:output_type -> anychat.group.GetJoinRequestsResponse
-	51, // 66: anychat.group.GroupService.PinGroupMessage:output_type -> anychat.common.Empty
-	51, // 67: anychat.group.GroupService.UnpinGroupMessage:output_type -> anychat.common.Empty
-	34, // 68: anychat.group.GroupService.GetPinnedMessages:output_type -> anychat.group.GetPinnedMessagesResponse
-	51, // 69: anychat.group.GroupService.SetGroupMute:output_type -> anychat.common.Empty
-	51, // 70: anychat.group.GroupService.MuteMember:output_type -> anychat.common.Empty
-	51, // 71: anychat.group.GroupService.UnmuteMember:output_type -> anychat.common.Empty
-	51, // 72: anychat.group.GroupService.UpdateGroupSettings:output_type -> anychat.common.Empty
-	40, // 73: anychat.group.GroupService.GetGroupSettings:output_type -> anychat.group.GetGroupSettingsResponse
-	51, // 74: anychat.group.GroupService.UpdateMemberRemark:output_type -> anychat.common.Empty
-	43, // 75: anychat.group.GroupService.GetGroupQRCode:output_type -> anychat.group.GetGroupQRCodeResponse
-	43, // 76: anychat.group.GroupService.RefreshGroupQRCode:output_type -> anychat.group.GetGroupQRCodeResponse
-	46, // 77: anychat.group.GroupService.GetGroupPreviewByQRCode:output_type -> anychat.group.GetGroupPreviewByQRCodeResponse
-	48, // 78: anychat.group.GroupService.JoinGroupByQRCode:output_type -> anychat.group.JoinGroupByQRCodeResponse
-	50, // [50:79] is the sub-list for method output_type
-	21, // [21:50] is the sub-list for method input_type
+	17, // 27: anychat.group.GroupService.SetGroupAvatar:input_type -> anychat.group.SetGroupAvatarRequest
+	19, // 28: anychat.group.GroupService.DissolveGroup:input_type -> anychat.group.DissolveGroupRequest
+	20, // 29: anychat.group.GroupService.InviteMembers:input_type -> anychat.group.InviteMembersRequest
+	21, // 30: anychat.group.GroupService.RemoveMember:input_type -> anychat.group.RemoveMemberRequest
+	22, // 31: anychat.group.GroupService.QuitGroup:input_type -> anychat.group.QuitGroupRequest
+	23, // 32: anychat.group.GroupService.UpdateMemberRole:input_type -> anychat.group.UpdateMemberRoleRequest
+	24, // 33: anychat.group.GroupService.UpdateMemberNickname:input_type -> anychat.group.UpdateMemberNicknameRequest
+	25, // 34: anychat.group.GroupService.TransferOwnership:input_type -> anychat.group.TransferOwnershipRequest
+	26, // 35: anychat.group.GroupService.JoinGroup:input_type -> anychat.group.JoinGroupRequest
+	28, // 36: anychat.group.GroupService.HandleJoinRequest:input_type -> anychat.group.HandleJoinRequestRequest
+	29, // 37: anychat.group.GroupService.GetJoinRequests:input_type -> anychat.group.GetJoinRequestsRequest
+	32, // 38: anychat.group.GroupService.PinGroupMessage:input_type -> anychat.group.PinGroupMessageRequest
+	33, // 39: anychat.group.GroupService.UnpinGroupMessage:input_type -> anychat.group.UnpinGroupMessageRequest
+	34, // 40: anychat.group.GroupService.GetPinnedMessages:input_type -> anychat.group.GetPinnedMessagesRequest
+	37, // 41: anychat.group.GroupService.SetGroupMute:input_type -> anychat.group.SetGroupMuteRequest
+	38, // 42: anychat.group.GroupService.MuteMember:input_type -> anychat.group.MuteMemberRequest
+	39, // 43: anychat.group.GroupService.UnmuteMember:input_type -> anychat.group.UnmuteMemberRequest
+	40, // 44: anychat.group.GroupService.UpdateGroupSettings:input_type -> anychat.group.UpdateGroupSettingsRequest
+	41, // 45: anychat.group.GroupService.GetGroupSettings:input_type -> anychat.group.GetGroupSettingsRequest
+	43, // 46: anychat.group.GroupService.UpdateMemberRemark:input_type -> anychat.group.UpdateMemberRemarkRequest
+	44, // 47: anychat.group.GroupService.GetGroupQRCode:input_type -> anychat.group.GetGroupQRCodeRequest
+	46, // 48: anychat.group.GroupService.RefreshGroupQRCode:input_type -> anychat.group.RefreshGroupQRCodeRequest
+	47, // 49: anychat.group.GroupService.GetGroupPreviewByQRCode:input_type -> anychat.group.GetGroupPreviewByQRCodeRequest
+	49, // 50: anychat.group.GroupService.JoinGroupByQRCode:input_type -> anychat.group.JoinGroupByQRCodeRequest
+	53, // 51: anychat.group.GroupService.EraseUserData:input_type -> anychat.common.EraseUserDataRequest
+	5,  // 52: anychat.group.GroupService.GetGroupInfo:output_type -> anychat.group.GetGroupInfoResponse
+	7,  // 53: anychat.group.GroupService.GetGroupMembers:output_type -> anychat.group.GetGroupMembersResponse
+	10, // 54: anychat.group.GroupService.IsMember:output_type -> anychat.group.IsMemberResponse
+	12, // 55: anychat.group.GroupService.GetUserGroups:output_type -> anychat.group.GetUserGroupsResponse
+	15, // 56: anychat.group.GroupService.CreateGroup:output_type -> anychat.group.CreateGroupResponse
+	54, // 57: anychat.group.GroupService.UpdateGroup:output_type -> anychat.common.Empty
+	18, // 58: anychat.group.GroupService.SetGroupAvatar:output_type -> anychat.group.SetGroupAvatarResponse
+	54, // 59: anychat.group.GroupService.DissolveGroup:output_type -> anychat.common.Empty
+	54, // 60: anychat.group.GroupService.InviteMembers:output_type -> anychat.common.Empty
+	54, // 61: anychat.group.GroupService.RemoveMember:output_type -> anychat.common.Empty
+	54, // 62: anychat.group.GroupService.QuitGroup:output_type -> anychat.common.Empty
+	54, // 63: anychat.group.GroupService.UpdateMemberRole:output_type -> anychat.common.Empty
+	54, // 64: anychat.group.GroupService.UpdateMemberNickname:output_type -> anychat.common.Empty
+	54, // 65: anychat.group.GroupService.TransferOwnership:output_type -> anychat.common.Empty
+	27, // 66: anychat.group.GroupService.JoinGroup:output_type -> anychat.group.JoinGroupResponse
+	54, // 67: anychat.group.GroupService.HandleJoinRequest:output_type -> anychat.common.Empty
+	30, // 68: anychat.group.GroupService.GetJoinRequests:output_type -> anychat.group.GetJoinRequestsResponse
+	54, // 69: anychat.group.GroupService.PinGroupMessage:output_type -> anychat.common.Empty
+	54, // 70: anychat.group.GroupService.UnpinGroupMessage:output_type -> anychat.common.Empty
+	36, // 71: anychat.group.GroupService.GetPinnedMessages:output_type -> anychat.group.GetPinnedMessagesResponse
+	54, // 72: anychat.group.GroupService.SetGroupMute:output_type -> anychat.common.Empty
+	54, // 73: anychat.group.GroupService.MuteMember:output_type -> anychat.common.Empty
+	54, // 74: anychat.group.GroupService.UnmuteMember:output_type -> anychat.common.Empty
+	54, // 75: anychat.group.GroupService.UpdateGroupSettings:output_type -> anychat.common.Empty
+	42, // 76: anychat.group.GroupService.GetGroupSettings:output_type -> anychat.group.GetGroupSettingsResponse
+	54, // 77: anychat.group.GroupService.UpdateMemberRemark:output_type -> anychat.common.Empty
+	45, // 78: anychat.group.GroupService.GetGroupQRCode:output_type -> anychat.group.GetGroupQRCodeResponse
+	45, // 79: anychat.group.GroupService.RefreshGroupQRCode:output_type -> anychat.group.GetGroupQRCodeResponse
+	48, // 80: anychat.group.GroupService.GetGroupPreviewByQRCode:output_type -> anychat.group.GetGroupPreviewByQRCodeResponse
+	50, // 81: anychat.group.GroupService.JoinGroupByQRCode:output_type -> anychat.group.JoinGroupByQRCodeResponse
+	55, // 82: anychat.group.GroupService.EraseUserData:output_type -> anychat.common.EraseUserDataResponse
+	52, // [52:83] is the sub-list for method output_type
+	21, // [21:52] is the sub-list for method input_type
 	21, // [21:21] is the sub-list for extension type_name
 	21, // [21:21] is the sub-list for extension extendee
 	0,  // [0:21] is the sub-list for field type_name
@@ -3708,25 +3888,26 @@ func file_group_group_proto_init() {
 	file_group_group_proto_msgTypes[0].OneofWrappers = []any{}
 	file_group_group_proto_msgTypes[2].OneofWrappers = []any{}
 	file_group_group_proto_msgTypes[4].OneofWrappers = []any{}
+	file_group_group_proto_msgTypes[6].OneofWrappers = []any{}
 	file_group_group_proto_msgTypes[7].OneofWrappers = []any{}
 	file_group_group_proto_msgTypes[10].OneofWrappers = []any{}
 	file_group_group_proto_msgTypes[11].OneofWrappers = []any{}
 	file_group_group_proto_msgTypes[12].OneofWrappers = []any{}
-	file_group_group_proto_msgTypes[20].OneofWrappers = []any{}
-	file_group_group_proto_msgTypes[21].OneofWrappers = []any{}
+	file_group_group_proto_msgTypes[22].OneofWrappers = []any{}
 	file_group_group_proto_msgTypes[23].OneofWrappers = []any{}
 	file_group_group_proto_msgTypes[25].OneofWrappers = []any{}
-	file_group_group_proto_msgTypes[29].OneofWrappers = []any{}
-	file_group_group_proto_msgTypes[30].OneofWrappers = []any{}
-	file_group_group_proto_msgTypes[34].OneofWrappers = []any{}
-	file_group_group_proto_msgTypes[44].OneofWrappers = []any{}
+	file_group_group_proto_msgTypes[27].OneofWrappers = []any{}
+	file_group_group_proto_msgTypes[31].OneofWrappers = []any{}
+	file_group_group_proto_msgTypes[32].OneofWrappers = []any{}
+	file_group_group_proto_msgTypes[36].OneofWrappers = []any{}
+	file_group_group_proto_msgTypes[46].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_group_group_proto_rawDesc), len(file_group_group_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   45,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
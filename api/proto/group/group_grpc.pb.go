@@ -26,6 +26,7 @@ const (
 	GroupService_GetUserGroups_FullMethodName           = "/anychat.group.GroupService/GetUserGroups"
 	GroupService_CreateGroup_FullMethodName             = "/anychat.group.GroupService/CreateGroup"
 	GroupService_UpdateGroup_FullMethodName             = "/anychat.group.GroupService/UpdateGroup"
+	GroupService_SetGroupAvatar_FullMethodName          = "/anychat.group.GroupService/SetGroupAvatar"
 	GroupService_DissolveGroup_FullMethodName           = "/anychat.group.GroupService/DissolveGroup"
 	GroupService_InviteMembers_FullMethodName           = "/anychat.group.GroupService/InviteMembers"
 	GroupService_RemoveMember_FullMethodName            = "/anychat.group.GroupService/RemoveMember"
@@ -49,72 +50,77 @@ const (
 	GroupService_RefreshGroupQRCode_FullMethodName      = "/anychat.group.GroupService/RefreshGroupQRCode"
 	GroupService_GetGroupPreviewByQRCode_FullMethodName = "/anychat.group.GroupService/GetGroupPreviewByQRCode"
 	GroupService_JoinGroupByQRCode_FullMethodName       = "/anychat.group.GroupService/JoinGroupByQRCode"
+	GroupService_EraseUserData_FullMethodName           = "/anychat.group.GroupService/EraseUserData"
 )
 
 // GroupServiceClient is the client API for GroupService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// GroupService 群组服务
+// GroupService group service
 type GroupServiceClient interface {
-	// GetGroupInfo 获取群信息（供Message Service调用）
+	// GetGroupInfo get group info (called by Message Service)
 	GetGroupInfo(ctx context.Context, in *GetGroupInfoRequest, opts ...grpc.CallOption) (*GetGroupInfoResponse, error)
-	// GetGroupMembers 获取群成员列表（供Message Service调用）
+	// GetGroupMembers get group member list (called by Message Service)
 	GetGroupMembers(ctx context.Context, in *GetGroupMembersRequest, opts ...grpc.CallOption) (*GetGroupMembersResponse, error)
-	// IsMember 检查是否为群成员（供Message Service调用）
+	// IsMember check whether user is a group member (called by Message Service)
 	IsMember(ctx context.Context, in *IsMemberRequest, opts ...grpc.CallOption) (*IsMemberResponse, error)
-	// GetUserGroups 获取用户加入的群列表（支持增量同步）
+	// GetUserGroups get list of groups joined by user (supports incremental sync)
 	GetUserGroups(ctx context.Context, in *GetUserGroupsRequest, opts ...grpc.CallOption) (*GetUserGroupsResponse, error)
-	// CreateGroup 创建群组
+	// CreateGroup create group
 	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
-	// UpdateGroup 更新群信息
+	// UpdateGroup update group info
 	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// DissolveGroup 解散群组
+	// SetGroupAvatar set the group avatar from an uploaded image (owner and admins)
+	SetGroupAvatar(ctx context.Context, in *SetGroupAvatarRequest, opts ...grpc.CallOption) (*SetGroupAvatarResponse, error)
+	// DissolveGroup dissolve group
 	DissolveGroup(ctx context.Context, in *DissolveGroupRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// InviteMembers 邀请成员
+	// InviteMembers invite members
 	InviteMembers(ctx context.Context, in *InviteMembersRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// RemoveMember 移除成员
+	// RemoveMember remove member
 	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// QuitGroup 退出群组
+	// QuitGroup quit group
 	QuitGroup(ctx context.Context, in *QuitGroupRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// UpdateMemberRole 更新成员角色
+	// UpdateMemberRole update member role
 	UpdateMemberRole(ctx context.Context, in *UpdateMemberRoleRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// UpdateMemberNickname 更新群昵称
+	// UpdateMemberNickname update group nickname
 	UpdateMemberNickname(ctx context.Context, in *UpdateMemberNicknameRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// TransferOwnership 转让群主
+	// TransferOwnership transfer group ownership
 	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// JoinGroup 加入群组（申请或直接加入）
+	// JoinGroup join group (by request or direct join)
 	JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupResponse, error)
-	// HandleJoinRequest 处理入群申请
+	// HandleJoinRequest handle join group request
 	HandleJoinRequest(ctx context.Context, in *HandleJoinRequestRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// GetJoinRequests 获取入群申请列表
+	// GetJoinRequests get join group request list
 	GetJoinRequests(ctx context.Context, in *GetJoinRequestsRequest, opts ...grpc.CallOption) (*GetJoinRequestsResponse, error)
-	// PinGroupMessage 置顶群消息
+	// PinGroupMessage pin group message
 	PinGroupMessage(ctx context.Context, in *PinGroupMessageRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// UnpinGroupMessage 取消置顶群消息
+	// UnpinGroupMessage unpin group message
 	UnpinGroupMessage(ctx context.Context, in *UnpinGroupMessageRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// GetPinnedMessages 获取群置顶消息列表
+	// GetPinnedMessages get pinned group message list
 	GetPinnedMessages(ctx context.Context, in *GetPinnedMessagesRequest, opts ...grpc.CallOption) (*GetPinnedMessagesResponse, error)
-	// SetGroupMute 设置全体禁言
+	// SetGroupMute set global group mute
 	SetGroupMute(ctx context.Context, in *SetGroupMuteRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// MuteMember 禁言成员
+	// MuteMember mute member
 	MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// UnmuteMember 解除禁言
+	// UnmuteMember unmute member
 	UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// UpdateGroupSettings 更新群组设置
+	// UpdateGroupSettings update group settings
 	UpdateGroupSettings(ctx context.Context, in *UpdateGroupSettingsRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// GetGroupSettings 获取群组设置
+	// GetGroupSettings get group settings
 	GetGroupSettings(ctx context.Context, in *GetGroupSettingsRequest, opts ...grpc.CallOption) (*GetGroupSettingsResponse, error)
-	// UpdateMemberRemark 设置/清空群备注（仅对自己可见）
+	// UpdateMemberRemark set/clear group remark (visible only to self)
 	UpdateMemberRemark(ctx context.Context, in *UpdateMemberRemarkRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// GetGroupQRCode 获取群二维码（自动续期或创建）
+	// GetGroupQRCode get group QR code (auto-renew or create)
 	GetGroupQRCode(ctx context.Context, in *GetGroupQRCodeRequest, opts ...grpc.CallOption) (*GetGroupQRCodeResponse, error)
-	// RefreshGroupQRCode 刷新群二维码（使旧码失效）
+	// RefreshGroupQRCode refresh group QR code (invalidate old code)
 	RefreshGroupQRCode(ctx context.Context, in *RefreshGroupQRCodeRequest, opts ...grpc.CallOption) (*GetGroupQRCodeResponse, error)
-	// GetGroupPreviewByQRCode 通过二维码获取群信息预览
+	// GetGroupPreviewByQRCode get group preview by QR code
 	GetGroupPreviewByQRCode(ctx context.Context, in *GetGroupPreviewByQRCodeRequest, opts ...grpc.CallOption) (*GetGroupPreviewByQRCodeResponse, error)
-	// JoinGroupByQRCode 扫码加入群组
+	// JoinGroupByQRCode join group by scanning QR code
 	JoinGroupByQRCode(ctx context.Context, in *JoinGroupByQRCodeRequest, opts ...grpc.CallOption) (*JoinGroupByQRCodeResponse, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error)
 }
 
 type groupServiceClient struct {
@@ -185,6 +191,16 @@ func (c *groupServiceClient) UpdateGroup(ctx context.Context, in *UpdateGroupReq
 	return out, nil
 }
 
+func (c *groupServiceClient) SetGroupAvatar(ctx context.Context, in *SetGroupAvatarRequest, opts ...grpc.CallOption) (*SetGroupAvatarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupAvatarResponse)
+	err := c.cc.Invoke(ctx, GroupService_SetGroupAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupServiceClient) DissolveGroup(ctx context.Context, in *DissolveGroupRequest, opts ...grpc.CallOption) (*common.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(common.Empty)
@@ -415,70 +431,84 @@ func (c *groupServiceClient) JoinGroupByQRCode(ctx context.Context, in *JoinGrou
 	return out, nil
 }
 
+func (c *groupServiceClient) EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.EraseUserDataResponse)
+	err := c.cc.Invoke(ctx, GroupService_EraseUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServiceServer is the server API for GroupService service.
 // All implementations must embed UnimplementedGroupServiceServer
 // for forward compatibility.
 //
-// GroupService 群组服务
+// GroupService group service
 type GroupServiceServer interface {
-	// GetGroupInfo 获取群信息（供Message Service调用）
+	// GetGroupInfo get group info (called by Message Service)
 	GetGroupInfo(context.Context, *GetGroupInfoRequest) (*GetGroupInfoResponse, error)
-	// GetGroupMembers 获取群成员列表（供Message Service调用）
+	// GetGroupMembers get group member list (called by Message Service)
 	GetGroupMembers(context.Context, *GetGroupMembersRequest) (*GetGroupMembersResponse, error)
-	// IsMember 检查是否为群成员（供Message Service调用）
+	// IsMember check whether user is a group member (called by Message Service)
 	IsMember(context.Context, *IsMemberRequest) (*IsMemberResponse, error)
-	// GetUserGroups 获取用户加入的群列表（支持增量同步）
+	// GetUserGroups get list of groups joined by user (supports incremental sync)
 	GetUserGroups(context.Context, *GetUserGroupsRequest) (*GetUserGroupsResponse, error)
-	// CreateGroup 创建群组
+	// CreateGroup create group
 	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
-	// UpdateGroup 更新群信息
+	// UpdateGroup update group info
 	UpdateGroup(context.Context, *UpdateGroupRequest) (*common.Empty, error)
-	// DissolveGroup 解散群组
+	// SetGroupAvatar set the group avatar from an uploaded image (owner and admins)
+	SetGroupAvatar(context.Context, *SetGroupAvatarRequest) (*SetGroupAvatarResponse, error)
+	// DissolveGroup dissolve group
 	DissolveGroup(context.Context, *DissolveGroupRequest) (*common.Empty, error)
-	// InviteMembers 邀请成员
+	// InviteMembers invite members
 	InviteMembers(context.Context, *InviteMembersRequest) (*common.Empty, error)
-	// RemoveMember 移除成员
+	// RemoveMember remove member
 	RemoveMember(context.Context, *RemoveMemberRequest) (*common.Empty, error)
-	// QuitGroup 退出群组
+	// QuitGroup quit group
 	QuitGroup(context.Context, *QuitGroupRequest) (*common.Empty, error)
-	// UpdateMemberRole 更新成员角色
+	// UpdateMemberRole update member role
 	UpdateMemberRole(context.Context, *UpdateMemberRoleRequest) (*common.Empty, error)
-	// UpdateMemberNickname 更新群昵称
+	// UpdateMemberNickname update group nickname
 	UpdateMemberNickname(context.Context, *UpdateMemberNicknameRequest) (*common.Empty, error)
-	// TransferOwnership 转让群主
+	// TransferOwnership transfer group ownership
 	TransferOwnership(context.Context, *TransferOwnershipRequest) (*common.Empty, error)
-	// JoinGroup 加入群组（申请或直接加入）
+	// JoinGroup join group (by request or direct join)
 	JoinGroup(context.Context, *JoinGroupRequest) (*JoinGroupResponse, error)
-	// HandleJoinRequest 处理入群申请
+	// HandleJoinRequest handle join group request
 	HandleJoinRequest(context.Context, *HandleJoinRequestRequest) (*common.Empty, error)
-	// GetJoinRequests 获取入群申请列表
+	// GetJoinRequests get join group request list
 	GetJoinRequests(context.Context, *GetJoinRequestsRequest) (*GetJoinRequestsResponse, error)
-	// PinGroupMessage 置顶群消息
+	// PinGroupMessage pin group message
 	PinGroupMessage(context.Context, *PinGroupMessageRequest) (*common.Empty, error)
-	// UnpinGroupMessage 取消置顶群消息
+	// UnpinGroupMessage unpin group message
 	UnpinGroupMessage(context.Context, *UnpinGroupMessageRequest) (*common.Empty, error)
-	// GetPinnedMessages 获取群置顶消息列表
+	// GetPinnedMessages get pinned group message list
 	GetPinnedMessages(context.Context, *GetPinnedMessagesRequest) (*GetPinnedMessagesResponse, error)
-	// SetGroupMute 设置全体禁言
+	// SetGroupMute set global group mute
 	SetGroupMute(context.Context, *SetGroupMuteRequest) (*common.Empty, error)
-	// MuteMember 禁言成员
+	// MuteMember mute member
 	MuteMember(context.Context, *MuteMemberRequest) (*common.Empty, error)
-	// UnmuteMember 解除禁言
+	// UnmuteMember unmute member
 	UnmuteMember(context.Context, *UnmuteMemberRequest) (*common.Empty, error)
-	// UpdateGroupSettings 更新群组设置
+	// UpdateGroupSettings update group settings
 	UpdateGroupSettings(context.Context, *UpdateGroupSettingsRequest) (*common.Empty, error)
-	// GetGroupSettings 获取群组设置
+	// GetGroupSettings get group settings
 	GetGroupSettings(context.Context, *GetGroupSettingsRequest) (*GetGroupSettingsResponse, error)
-	// UpdateMemberRemark 设置/清空群备注（仅对自己可见）
+	// UpdateMemberRemark set/clear group remark (visible only to self)
 	UpdateMemberRemark(context.Context, *UpdateMemberRemarkRequest) (*common.Empty, error)
-	// GetGroupQRCode 获取群二维码（自动续期或创建）
+	// GetGroupQRCode get group QR code (auto-renew or create)
 	GetGroupQRCode(context.Context, *GetGroupQRCodeRequest) (*GetGroupQRCodeResponse, error)
-	// RefreshGroupQRCode 刷新群二维码（使旧码失效）
+	// RefreshGroupQRCode refresh group QR code (invalidate old code)
 	RefreshGroupQRCode(context.Context, *RefreshGroupQRCodeRequest) (*GetGroupQRCodeResponse, error)
-	// GetGroupPreviewByQRCode 通过二维码获取群信息预览
+	// GetGroupPreviewByQRCode get group preview by QR code
 	GetGroupPreviewByQRCode(context.Context, *GetGroupPreviewByQRCodeRequest) (*GetGroupPreviewByQRCodeResponse, error)
-	// JoinGroupByQRCode 扫码加入群组
+	// JoinGroupByQRCode join group by scanning QR code
 	JoinGroupByQRCode(context.Context, *JoinGroupByQRCodeRequest) (*JoinGroupByQRCodeResponse, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error)
 	mustEmbedUnimplementedGroupServiceServer()
 }
 
@@ -507,6 +537,9 @@ func (UnimplementedGroupServiceServer) CreateGroup(context.Context, *CreateGroup
 func (UnimplementedGroupServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*common.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateGroup not implemented")
 }
+func (UnimplementedGroupServiceServer) SetGroupAvatar(context.Context, *SetGroupAvatarRequest) (*SetGroupAvatarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGroupAvatar not implemented")
+}
 func (UnimplementedGroupServiceServer) DissolveGroup(context.Context, *DissolveGroupRequest) (*common.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DissolveGroup not implemented")
 }
@@ -576,6 +609,9 @@ func (UnimplementedGroupServiceServer) GetGroupPreviewByQRCode(context.Context,
 func (UnimplementedGroupServiceServer) JoinGroupByQRCode(context.Context, *JoinGroupByQRCodeRequest) (*JoinGroupByQRCodeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method JoinGroupByQRCode not implemented")
 }
+func (UnimplementedGroupServiceServer) EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EraseUserData not implemented")
+}
 func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
 func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
 
@@ -705,6 +741,24 @@ func _GroupService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_SetGroupAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).SetGroupAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_SetGroupAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).SetGroupAvatar(ctx, req.(*SetGroupAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GroupService_DissolveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DissolveGroupRequest)
 	if err := dec(in); err != nil {
@@ -1119,6 +1173,24 @@ func _GroupService_JoinGroupByQRCode_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).EraseUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_EraseUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).EraseUserData(ctx, req.(*common.EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1150,6 +1222,10 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroup",
 			Handler:    _GroupService_UpdateGroup_Handler,
 		},
+		{
+			MethodName: "SetGroupAvatar",
+			Handler:    _GroupService_SetGroupAvatar_Handler,
+		},
 		{
 			MethodName: "DissolveGroup",
 			Handler:    _GroupService_DissolveGroup_Handler,
@@ -1242,6 +1318,10 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "JoinGroupByQRCode",
 			Handler:    _GroupService_JoinGroupByQRCode_Handler,
 		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _GroupService_EraseUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "group/group.proto",
@@ -8,9 +8,9 @@ package messagepb
 
 import (
 	common "github.com/anychat/server/api/proto/common"
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -83,6 +83,7 @@ const (
 	ContentType_CONTENT_TYPE_FILE        ContentType = 5
 	ContentType_CONTENT_TYPE_LOCATION    ContentType = 6
 	ContentType_CONTENT_TYPE_CARD        ContentType = 7
+	ContentType_CONTENT_TYPE_SYSTEM      ContentType = 8 // system notice, sent by services only
 )
 
 // Enum value maps for ContentType.
@@ -96,6 +97,7 @@ var (
 		5: "CONTENT_TYPE_FILE",
 		6: "CONTENT_TYPE_LOCATION",
 		7: "CONTENT_TYPE_CARD",
+		8: "CONTENT_TYPE_SYSTEM",
 	}
 	ContentType_value = map[string]int32{
 		"CONTENT_TYPE_UNSPECIFIED": 0,
@@ -106,6 +108,7 @@ var (
 		"CONTENT_TYPE_FILE":        5,
 		"CONTENT_TYPE_LOCATION":    6,
 		"CONTENT_TYPE_CARD":        7,
+		"CONTENT_TYPE_SYSTEM":      8,
 	}
 )
 
@@ -136,7 +139,127 @@ func (ContentType) EnumDescriptor() ([]byte, []int) {
 	return file_message_message_proto_rawDescGZIP(), []int{1}
 }
 
-// Message 消息
+// SystemEvent event of a system notice, clients render a localized text from the event and its params
+type SystemEvent int32
+
+const (
+	SystemEvent_SYSTEM_EVENT_UNSPECIFIED                 SystemEvent = 0
+	SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_JOINED         SystemEvent = 1 // user_ids joined, operator invited or approved them
+	SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_LEFT           SystemEvent = 2 // user_ids quit the group
+	SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_REMOVED        SystemEvent = 3 // user_ids were removed by operator
+	SystemEvent_SYSTEM_EVENT_GROUP_ROLE_CHANGED          SystemEvent = 4 // params: role (admin/member)
+	SystemEvent_SYSTEM_EVENT_GROUP_RENAMED               SystemEvent = 5 // params: name
+	SystemEvent_SYSTEM_EVENT_GROUP_MUTE_CHANGED          SystemEvent = 6 // params: enabled (true/false)
+	SystemEvent_SYSTEM_EVENT_GROUP_OWNERSHIP_TRANSFERRED SystemEvent = 7 // user_ids: the new owner
+)
+
+// Enum value maps for SystemEvent.
+var (
+	SystemEvent_name = map[int32]string{
+		0: "SYSTEM_EVENT_UNSPECIFIED",
+		1: "SYSTEM_EVENT_GROUP_MEMBER_JOINED",
+		2: "SYSTEM_EVENT_GROUP_MEMBER_LEFT",
+		3: "SYSTEM_EVENT_GROUP_MEMBER_REMOVED",
+		4: "SYSTEM_EVENT_GROUP_ROLE_CHANGED",
+		5: "SYSTEM_EVENT_GROUP_RENAMED",
+		6: "SYSTEM_EVENT_GROUP_MUTE_CHANGED",
+		7: "SYSTEM_EVENT_GROUP_OWNERSHIP_TRANSFERRED",
+	}
+	SystemEvent_value = map[string]int32{
+		"SYSTEM_EVENT_UNSPECIFIED":                 0,
+		"SYSTEM_EVENT_GROUP_MEMBER_JOINED":         1,
+		"SYSTEM_EVENT_GROUP_MEMBER_LEFT":           2,
+		"SYSTEM_EVENT_GROUP_MEMBER_REMOVED":        3,
+		"SYSTEM_EVENT_GROUP_ROLE_CHANGED":          4,
+		"SYSTEM_EVENT_GROUP_RENAMED":               5,
+		"SYSTEM_EVENT_GROUP_MUTE_CHANGED":          6,
+		"SYSTEM_EVENT_GROUP_OWNERSHIP_TRANSFERRED": 7,
+	}
+)
+
+func (x SystemEvent) Enum() *SystemEvent {
+	p := new(SystemEvent)
+	*p = x
+	return p
+}
+
+func (x SystemEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SystemEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_message_proto_enumTypes[2].Descriptor()
+}
+
+func (SystemEvent) Type() protoreflect.EnumType {
+	return &file_message_message_proto_enumTypes[2]
+}
+
+func (x SystemEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SystemEvent.Descriptor instead.
+func (SystemEvent) EnumDescriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{2}
+}
+
+type MediaCategory int32
+
+const (
+	MediaCategory_MEDIA_CATEGORY_UNSPECIFIED MediaCategory = 0
+	MediaCategory_MEDIA_CATEGORY_IMAGE       MediaCategory = 1
+	MediaCategory_MEDIA_CATEGORY_VIDEO       MediaCategory = 2
+	MediaCategory_MEDIA_CATEGORY_FILE        MediaCategory = 3
+	MediaCategory_MEDIA_CATEGORY_LINK        MediaCategory = 4 // URL in a text message
+)
+
+// Enum value maps for MediaCategory.
+var (
+	MediaCategory_name = map[int32]string{
+		0: "MEDIA_CATEGORY_UNSPECIFIED",
+		1: "MEDIA_CATEGORY_IMAGE",
+		2: "MEDIA_CATEGORY_VIDEO",
+		3: "MEDIA_CATEGORY_FILE",
+		4: "MEDIA_CATEGORY_LINK",
+	}
+	MediaCategory_value = map[string]int32{
+		"MEDIA_CATEGORY_UNSPECIFIED": 0,
+		"MEDIA_CATEGORY_IMAGE":       1,
+		"MEDIA_CATEGORY_VIDEO":       2,
+		"MEDIA_CATEGORY_FILE":        3,
+		"MEDIA_CATEGORY_LINK":        4,
+	}
+)
+
+func (x MediaCategory) Enum() *MediaCategory {
+	p := new(MediaCategory)
+	*p = x
+	return p
+}
+
+func (x MediaCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MediaCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_message_proto_enumTypes[3].Descriptor()
+}
+
+func (MediaCategory) Type() protoreflect.EnumType {
+	return &file_message_message_proto_enumTypes[3]
+}
+
+func (x MediaCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MediaCategory.Descriptor instead.
+func (MediaCategory) EnumDescriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{3}
+}
+
+// Message message
 type Message struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	MessageId        string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
@@ -148,12 +271,13 @@ type Message struct {
 	Sequence         int64                  `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	ReplyTo          *string                `protobuf:"bytes,8,opt,name=reply_to,json=replyTo,proto3,oneof" json:"reply_to,omitempty"`
 	AtUsers          []string               `protobuf:"bytes,9,rep,name=at_users,json=atUsers,proto3" json:"at_users,omitempty"`
-	Status           int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`                          // 0-正常 1-撤回 2-删除
-	ExpireTime       *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"` // 消息过期时间,为空表示永不过期
-	TargetId         *string                `protobuf:"bytes,14,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"` // single为对方用户ID，group为群ID
-	CreatedAt        *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// 扩展字段（用于客户端显示）
+	Status           int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`                          // 0-normal 1-recalled 2-deleted
+	ExpireTime       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"` // message expiration time; empty means never expires
+	TargetId         *string                `protobuf:"bytes,14,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"` // for single chat: peer user ID; for group chat: group ID
+	Silent           bool                   `protobuf:"varint,15,opt,name=silent,proto3" json:"silent,omitempty"`                          // system notice that is not counted as unread
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// extended fields (for client display)
 	SenderInfo     *common.UserInfo `protobuf:"bytes,20,opt,name=sender_info,json=senderInfo,proto3,oneof" json:"sender_info,omitempty"`
 	ReplyToMessage *Message         `protobuf:"bytes,21,opt,name=reply_to_message,json=replyToMessage,proto3,oneof" json:"reply_to_message,omitempty"`
 	unknownFields  protoimpl.UnknownFields
@@ -260,7 +384,7 @@ func (x *Message) GetStatus() int32 {
 	return 0
 }
 
-func (x *Message) GetExpireTime() *timestamp.Timestamp {
+func (x *Message) GetExpireTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ExpireTime
 	}
@@ -274,14 +398,21 @@ func (x *Message) GetTargetId() string {
 	return ""
 }
 
-func (x *Message) GetCreatedAt() *timestamp.Timestamp {
+func (x *Message) GetSilent() bool {
+	if x != nil {
+		return x.Silent
+	}
+	return false
+}
+
+func (x *Message) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Message) GetUpdatedAt() *timestamp.Timestamp {
+func (x *Message) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
@@ -302,7 +433,7 @@ func (x *Message) GetReplyToMessage() *Message {
 	return nil
 }
 
-// SendMessageRequest 发送消息请求
+// SendMessageRequest send message request
 type SendMessageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	SenderId       string                 `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
@@ -311,7 +442,7 @@ type SendMessageRequest struct {
 	Content        string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"` // JSON string
 	ReplyTo        *string                `protobuf:"bytes,5,opt,name=reply_to,json=replyTo,proto3,oneof" json:"reply_to,omitempty"`
 	AtUsers        []string               `protobuf:"bytes,6,rep,name=at_users,json=atUsers,proto3" json:"at_users,omitempty"`
-	LocalId        string                 `protobuf:"bytes,7,opt,name=local_id,json=localId,proto3" json:"local_id,omitempty"` // 客户端本地ID（用于发送幂等）
+	LocalId        string                 `protobuf:"bytes,7,opt,name=local_id,json=localId,proto3" json:"local_id,omitempty"` // client local ID (for send idempotency)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -395,19 +526,136 @@ func (x *SendMessageRequest) GetLocalId() string {
 	return ""
 }
 
-// SendMessageResponse 发送消息响应
+// SendSystemMessageRequest send system notice request
+type SendSystemMessageRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId   string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // group conversations use the group ID
+	ConversationType ConversationType       `protobuf:"varint,2,opt,name=conversation_type,json=conversationType,proto3,enum=anychat.message.ConversationType" json:"conversation_type,omitempty"`
+	TargetId         string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Event            SystemEvent            `protobuf:"varint,4,opt,name=event,proto3,enum=anychat.message.SystemEvent" json:"event,omitempty"`
+	OperatorId       string                 `protobuf:"bytes,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // user who caused the event
+	UserIds          []string               `protobuf:"bytes,6,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`          // users the event is about
+	Params           map[string]string      `protobuf:"bytes,7,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Silent           bool                   `protobuf:"varint,8,opt,name=silent,proto3" json:"silent,omitempty"`                                // not counted as unread and not pushed
+	RecipientIds     []string               `protobuf:"bytes,9,rep,name=recipient_ids,json=recipientIds,proto3" json:"recipient_ids,omitempty"` // users notified in real time, e.g. the members plus a removed member
+	DedupKey         string                 `protobuf:"bytes,10,opt,name=dedup_key,json=dedupKey,proto3" json:"dedup_key,omitempty"`            // optional, sends with the same key store a single notice
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SendSystemMessageRequest) Reset() {
+	*x = SendSystemMessageRequest{}
+	mi := &file_message_message_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSystemMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSystemMessageRequest) ProtoMessage() {}
+
+func (x *SendSystemMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSystemMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendSystemMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendSystemMessageRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SendSystemMessageRequest) GetConversationType() ConversationType {
+	if x != nil {
+		return x.ConversationType
+	}
+	return ConversationType_CONVERSATION_TYPE_UNSPECIFIED
+}
+
+func (x *SendSystemMessageRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SendSystemMessageRequest) GetEvent() SystemEvent {
+	if x != nil {
+		return x.Event
+	}
+	return SystemEvent_SYSTEM_EVENT_UNSPECIFIED
+}
+
+func (x *SendSystemMessageRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *SendSystemMessageRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *SendSystemMessageRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SendSystemMessageRequest) GetSilent() bool {
+	if x != nil {
+		return x.Silent
+	}
+	return false
+}
+
+func (x *SendSystemMessageRequest) GetRecipientIds() []string {
+	if x != nil {
+		return x.RecipientIds
+	}
+	return nil
+}
+
+func (x *SendSystemMessageRequest) GetDedupKey() string {
+	if x != nil {
+		return x.DedupKey
+	}
+	return ""
+}
+
+// SendMessageResponse send message response
 type SendMessageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 	Sequence      int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SendMessageResponse) Reset() {
 	*x = SendMessageResponse{}
-	mi := &file_message_message_proto_msgTypes[2]
+	mi := &file_message_message_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -419,7 +667,7 @@ func (x *SendMessageResponse) String() string {
 func (*SendMessageResponse) ProtoMessage() {}
 
 func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[2]
+	mi := &file_message_message_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,7 +680,7 @@ func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
 func (*SendMessageResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{2}
+	return file_message_message_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SendMessageResponse) GetMessageId() string {
@@ -449,28 +697,28 @@ func (x *SendMessageResponse) GetSequence() int64 {
 	return 0
 }
 
-func (x *SendMessageResponse) GetTimestamp() *timestamp.Timestamp {
+func (x *SendMessageResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Timestamp
 	}
 	return nil
 }
 
-// GetMessagesRequest 获取消息列表请求
+// GetMessagesRequest get message list request
 type GetMessagesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	StartSeq       *int64                 `protobuf:"varint,2,opt,name=start_seq,json=startSeq,proto3,oneof" json:"start_seq,omitempty"` // 起始序列号
-	EndSeq         *int64                 `protobuf:"varint,3,opt,name=end_seq,json=endSeq,proto3,oneof" json:"end_seq,omitempty"`       // 结束序列号
-	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                             // 数量限制
-	Reverse        bool                   `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`                         // 是否倒序（从新到旧）
+	StartSeq       *int64                 `protobuf:"varint,2,opt,name=start_seq,json=startSeq,proto3,oneof" json:"start_seq,omitempty"` // start sequence number
+	EndSeq         *int64                 `protobuf:"varint,3,opt,name=end_seq,json=endSeq,proto3,oneof" json:"end_seq,omitempty"`       // end sequence number
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                             // count limit
+	Reverse        bool                   `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`                         // reverse order (new to old)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetMessagesRequest) Reset() {
 	*x = GetMessagesRequest{}
-	mi := &file_message_message_proto_msgTypes[3]
+	mi := &file_message_message_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -482,7 +730,7 @@ func (x *GetMessagesRequest) String() string {
 func (*GetMessagesRequest) ProtoMessage() {}
 
 func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[3]
+	mi := &file_message_message_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,7 +743,7 @@ func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
 func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{3}
+	return file_message_message_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetMessagesRequest) GetConversationId() string {
@@ -533,7 +781,7 @@ func (x *GetMessagesRequest) GetReverse() bool {
 	return false
 }
 
-// GetMessagesResponse 获取消息列表响应
+// GetMessagesResponse get message list response
 type GetMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
@@ -545,7 +793,7 @@ type GetMessagesResponse struct {
 
 func (x *GetMessagesResponse) Reset() {
 	*x = GetMessagesResponse{}
-	mi := &file_message_message_proto_msgTypes[4]
+	mi := &file_message_message_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -557,7 +805,7 @@ func (x *GetMessagesResponse) String() string {
 func (*GetMessagesResponse) ProtoMessage() {}
 
 func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[4]
+	mi := &file_message_message_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,7 +818,7 @@ func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
 func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{4}
+	return file_message_message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetMessagesResponse) GetMessages() []*Message {
@@ -594,7 +842,7 @@ func (x *GetMessagesResponse) GetHasMore() bool {
 	return false
 }
 
-// GetMessagesBeforeRequest 获取锚点前消息请求
+// GetMessagesBeforeRequest get messages before anchor request
 type GetMessagesBeforeRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId  string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -606,7 +854,7 @@ type GetMessagesBeforeRequest struct {
 
 func (x *GetMessagesBeforeRequest) Reset() {
 	*x = GetMessagesBeforeRequest{}
-	mi := &file_message_message_proto_msgTypes[5]
+	mi := &file_message_message_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +866,7 @@ func (x *GetMessagesBeforeRequest) String() string {
 func (*GetMessagesBeforeRequest) ProtoMessage() {}
 
 func (x *GetMessagesBeforeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[5]
+	mi := &file_message_message_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +879,7 @@ func (x *GetMessagesBeforeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesBeforeRequest.ProtoReflect.Descriptor instead.
 func (*GetMessagesBeforeRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{5}
+	return file_message_message_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetMessagesBeforeRequest) GetConversationId() string {
@@ -655,7 +903,7 @@ func (x *GetMessagesBeforeRequest) GetLimit() int32 {
 	return 0
 }
 
-// GetMessagesBeforeResponse 获取锚点前消息响应
+// GetMessagesBeforeResponse get messages before anchor response
 type GetMessagesBeforeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AnchorMessage *Message               `protobuf:"bytes,1,opt,name=anchor_message,json=anchorMessage,proto3" json:"anchor_message,omitempty"`
@@ -667,7 +915,7 @@ type GetMessagesBeforeResponse struct {
 
 func (x *GetMessagesBeforeResponse) Reset() {
 	*x = GetMessagesBeforeResponse{}
-	mi := &file_message_message_proto_msgTypes[6]
+	mi := &file_message_message_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -679,7 +927,7 @@ func (x *GetMessagesBeforeResponse) String() string {
 func (*GetMessagesBeforeResponse) ProtoMessage() {}
 
 func (x *GetMessagesBeforeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[6]
+	mi := &file_message_message_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,7 +940,7 @@ func (x *GetMessagesBeforeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesBeforeResponse.ProtoReflect.Descriptor instead.
 func (*GetMessagesBeforeResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{6}
+	return file_message_message_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetMessagesBeforeResponse) GetAnchorMessage() *Message {
@@ -716,7 +964,7 @@ func (x *GetMessagesBeforeResponse) GetHasMore() bool {
 	return false
 }
 
-// GetMessagesAfterRequest 获取锚点后消息请求
+// GetMessagesAfterRequest get messages after anchor request
 type GetMessagesAfterRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId  string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -728,7 +976,7 @@ type GetMessagesAfterRequest struct {
 
 func (x *GetMessagesAfterRequest) Reset() {
 	*x = GetMessagesAfterRequest{}
-	mi := &file_message_message_proto_msgTypes[7]
+	mi := &file_message_message_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +988,7 @@ func (x *GetMessagesAfterRequest) String() string {
 func (*GetMessagesAfterRequest) ProtoMessage() {}
 
 func (x *GetMessagesAfterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[7]
+	mi := &file_message_message_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +1001,7 @@ func (x *GetMessagesAfterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesAfterRequest.ProtoReflect.Descriptor instead.
 func (*GetMessagesAfterRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{7}
+	return file_message_message_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetMessagesAfterRequest) GetConversationId() string {
@@ -777,7 +1025,7 @@ func (x *GetMessagesAfterRequest) GetLimit() int32 {
 	return 0
 }
 
-// GetMessagesAfterResponse 获取锚点后消息响应
+// GetMessagesAfterResponse get messages after anchor response
 type GetMessagesAfterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AnchorMessage *Message               `protobuf:"bytes,1,opt,name=anchor_message,json=anchorMessage,proto3" json:"anchor_message,omitempty"`
@@ -789,7 +1037,7 @@ type GetMessagesAfterResponse struct {
 
 func (x *GetMessagesAfterResponse) Reset() {
 	*x = GetMessagesAfterResponse{}
-	mi := &file_message_message_proto_msgTypes[8]
+	mi := &file_message_message_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +1049,7 @@ func (x *GetMessagesAfterResponse) String() string {
 func (*GetMessagesAfterResponse) ProtoMessage() {}
 
 func (x *GetMessagesAfterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[8]
+	mi := &file_message_message_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1062,7 @@ func (x *GetMessagesAfterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesAfterResponse.ProtoReflect.Descriptor instead.
 func (*GetMessagesAfterResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{8}
+	return file_message_message_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetMessagesAfterResponse) GetAnchorMessage() *Message {
@@ -838,7 +1086,7 @@ func (x *GetMessagesAfterResponse) GetHasMore() bool {
 	return false
 }
 
-// GetMessagesAroundAnchorRequest 获取锚点前后消息窗口请求
+// GetMessagesAroundAnchorRequest get window around anchor request
 type GetMessagesAroundAnchorRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId  string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -852,7 +1100,7 @@ type GetMessagesAroundAnchorRequest struct {
 
 func (x *GetMessagesAroundAnchorRequest) Reset() {
 	*x = GetMessagesAroundAnchorRequest{}
-	mi := &file_message_message_proto_msgTypes[9]
+	mi := &file_message_message_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -864,7 +1112,7 @@ func (x *GetMessagesAroundAnchorRequest) String() string {
 func (*GetMessagesAroundAnchorRequest) ProtoMessage() {}
 
 func (x *GetMessagesAroundAnchorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[9]
+	mi := &file_message_message_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +1125,7 @@ func (x *GetMessagesAroundAnchorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesAroundAnchorRequest.ProtoReflect.Descriptor instead.
 func (*GetMessagesAroundAnchorRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{9}
+	return file_message_message_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetMessagesAroundAnchorRequest) GetConversationId() string {
@@ -915,7 +1163,7 @@ func (x *GetMessagesAroundAnchorRequest) GetIncludeAnchor() bool {
 	return false
 }
 
-// GetMessagesAroundAnchorResponse 获取锚点前后消息窗口响应
+// GetMessagesAroundAnchorResponse get window around anchor response
 type GetMessagesAroundAnchorResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	AnchorMessage  *Message               `protobuf:"bytes,1,opt,name=anchor_message,json=anchorMessage,proto3" json:"anchor_message,omitempty"`
@@ -929,7 +1177,7 @@ type GetMessagesAroundAnchorResponse struct {
 
 func (x *GetMessagesAroundAnchorResponse) Reset() {
 	*x = GetMessagesAroundAnchorResponse{}
-	mi := &file_message_message_proto_msgTypes[10]
+	mi := &file_message_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -941,7 +1189,7 @@ func (x *GetMessagesAroundAnchorResponse) String() string {
 func (*GetMessagesAroundAnchorResponse) ProtoMessage() {}
 
 func (x *GetMessagesAroundAnchorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[10]
+	mi := &file_message_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,7 +1202,7 @@ func (x *GetMessagesAroundAnchorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesAroundAnchorResponse.ProtoReflect.Descriptor instead.
 func (*GetMessagesAroundAnchorResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{10}
+	return file_message_message_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetMessagesAroundAnchorResponse) GetAnchorMessage() *Message {
@@ -992,7 +1240,7 @@ func (x *GetMessagesAroundAnchorResponse) GetHasMoreAfter() bool {
 	return false
 }
 
-// GetFirstUnreadAnchorRequest 获取第一条未读消息锚点请求
+// GetFirstUnreadAnchorRequest get first unread anchor request
 type GetFirstUnreadAnchorRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -1005,7 +1253,7 @@ type GetFirstUnreadAnchorRequest struct {
 
 func (x *GetFirstUnreadAnchorRequest) Reset() {
 	*x = GetFirstUnreadAnchorRequest{}
-	mi := &file_message_message_proto_msgTypes[11]
+	mi := &file_message_message_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1017,7 +1265,7 @@ func (x *GetFirstUnreadAnchorRequest) String() string {
 func (*GetFirstUnreadAnchorRequest) ProtoMessage() {}
 
 func (x *GetFirstUnreadAnchorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[11]
+	mi := &file_message_message_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,7 +1278,7 @@ func (x *GetFirstUnreadAnchorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFirstUnreadAnchorRequest.ProtoReflect.Descriptor instead.
 func (*GetFirstUnreadAnchorRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{11}
+	return file_message_message_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetFirstUnreadAnchorRequest) GetConversationId() string {
@@ -1061,7 +1309,7 @@ func (x *GetFirstUnreadAnchorRequest) GetAfter() int32 {
 	return 0
 }
 
-// GetFirstUnreadAnchorResponse 获取第一条未读消息锚点响应
+// GetFirstUnreadAnchorResponse get first unread anchor response
 type GetFirstUnreadAnchorResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Found          bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
@@ -1076,7 +1324,7 @@ type GetFirstUnreadAnchorResponse struct {
 
 func (x *GetFirstUnreadAnchorResponse) Reset() {
 	*x = GetFirstUnreadAnchorResponse{}
-	mi := &file_message_message_proto_msgTypes[12]
+	mi := &file_message_message_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1088,7 +1336,7 @@ func (x *GetFirstUnreadAnchorResponse) String() string {
 func (*GetFirstUnreadAnchorResponse) ProtoMessage() {}
 
 func (x *GetFirstUnreadAnchorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[12]
+	mi := &file_message_message_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1349,7 @@ func (x *GetFirstUnreadAnchorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFirstUnreadAnchorResponse.ProtoReflect.Descriptor instead.
 func (*GetFirstUnreadAnchorResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{12}
+	return file_message_message_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetFirstUnreadAnchorResponse) GetFound() bool {
@@ -1146,7 +1394,7 @@ func (x *GetFirstUnreadAnchorResponse) GetHasMoreAfter() bool {
 	return false
 }
 
-// GetMessageByIdRequest 根据ID获取消息请求
+// GetMessageByIdRequest get message by ID request
 type GetMessageByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
@@ -1156,7 +1404,7 @@ type GetMessageByIdRequest struct {
 
 func (x *GetMessageByIdRequest) Reset() {
 	*x = GetMessageByIdRequest{}
-	mi := &file_message_message_proto_msgTypes[13]
+	mi := &file_message_message_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1168,7 +1416,7 @@ func (x *GetMessageByIdRequest) String() string {
 func (*GetMessageByIdRequest) ProtoMessage() {}
 
 func (x *GetMessageByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[13]
+	mi := &file_message_message_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +1429,7 @@ func (x *GetMessageByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessageByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetMessageByIdRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{13}
+	return file_message_message_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetMessageByIdRequest) GetMessageId() string {
@@ -1191,17 +1439,17 @@ func (x *GetMessageByIdRequest) GetMessageId() string {
 	return ""
 }
 
-// RecallMessageRequest 撤回消息请求
+// RecallMessageRequest recall message request
 type RecallMessageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 操作用户由调用链路透传的x-user-id元数据提供
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // operator user is provided via x-user-id metadata in the call chain
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RecallMessageRequest) Reset() {
 	*x = RecallMessageRequest{}
-	mi := &file_message_message_proto_msgTypes[14]
+	mi := &file_message_message_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1213,7 +1461,7 @@ func (x *RecallMessageRequest) String() string {
 func (*RecallMessageRequest) ProtoMessage() {}
 
 func (x *RecallMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[14]
+	mi := &file_message_message_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,7 +1474,7 @@ func (x *RecallMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecallMessageRequest.ProtoReflect.Descriptor instead.
 func (*RecallMessageRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{14}
+	return file_message_message_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RecallMessageRequest) GetMessageId() string {
@@ -1236,17 +1484,17 @@ func (x *RecallMessageRequest) GetMessageId() string {
 	return ""
 }
 
-// DeleteMessageRequest 删除消息请求
+// DeleteMessageRequest delete message request
 type DeleteMessageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 删除者由调用链路透传的x-user-id元数据提供
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // deleter user is provided via x-user-id metadata in the call chain
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteMessageRequest) Reset() {
 	*x = DeleteMessageRequest{}
-	mi := &file_message_message_proto_msgTypes[15]
+	mi := &file_message_message_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1258,7 +1506,7 @@ func (x *DeleteMessageRequest) String() string {
 func (*DeleteMessageRequest) ProtoMessage() {}
 
 func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[15]
+	mi := &file_message_message_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,7 +1519,7 @@ func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{15}
+	return file_message_message_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteMessageRequest) GetMessageId() string {
@@ -1281,10 +1529,10 @@ func (x *DeleteMessageRequest) GetMessageId() string {
 	return ""
 }
 
-// MarkAsReadRequest 标记已读请求
+// MarkAsReadRequest mark as read request
 type MarkAsReadRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId    string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 操作用户由调用链路透传的x-user-id元数据提供
+	ConversationId    string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // operator user is provided via x-user-id metadata in the call chain
 	LastReadSeq       int64                  `protobuf:"varint,2,opt,name=last_read_seq,json=lastReadSeq,proto3" json:"last_read_seq,omitempty"`
 	LastReadMessageId *string                `protobuf:"bytes,3,opt,name=last_read_message_id,json=lastReadMessageId,proto3,oneof" json:"last_read_message_id,omitempty"`
 	unknownFields     protoimpl.UnknownFields
@@ -1293,7 +1541,7 @@ type MarkAsReadRequest struct {
 
 func (x *MarkAsReadRequest) Reset() {
 	*x = MarkAsReadRequest{}
-	mi := &file_message_message_proto_msgTypes[16]
+	mi := &file_message_message_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1305,7 +1553,7 @@ func (x *MarkAsReadRequest) String() string {
 func (*MarkAsReadRequest) ProtoMessage() {}
 
 func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[16]
+	mi := &file_message_message_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1318,7 +1566,7 @@ func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkAsReadRequest.ProtoReflect.Descriptor instead.
 func (*MarkAsReadRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{16}
+	return file_message_message_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *MarkAsReadRequest) GetConversationId() string {
@@ -1342,10 +1590,10 @@ func (x *MarkAsReadRequest) GetLastReadMessageId() string {
 	return ""
 }
 
-// MarkMessagesReadRequest 按消息ID批量标记已读请求
+// MarkMessagesReadRequest batch mark as read by message IDs request
 type MarkMessagesReadRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 操作用户由调用链路透传的x-user-id元数据提供
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // operator user is provided via x-user-id metadata in the call chain
 	MessageIds     []string               `protobuf:"bytes,2,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
 	ClientReadAt   *int64                 `protobuf:"varint,3,opt,name=client_read_at,json=clientReadAt,proto3,oneof" json:"client_read_at,omitempty"`
 	IdempotencyKey *string                `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
@@ -1355,7 +1603,7 @@ type MarkMessagesReadRequest struct {
 
 func (x *MarkMessagesReadRequest) Reset() {
 	*x = MarkMessagesReadRequest{}
-	mi := &file_message_message_proto_msgTypes[17]
+	mi := &file_message_message_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1367,7 +1615,7 @@ func (x *MarkMessagesReadRequest) String() string {
 func (*MarkMessagesReadRequest) ProtoMessage() {}
 
 func (x *MarkMessagesReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[17]
+	mi := &file_message_message_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,7 +1628,7 @@ func (x *MarkMessagesReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkMessagesReadRequest.ProtoReflect.Descriptor instead.
 func (*MarkMessagesReadRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{17}
+	return file_message_message_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *MarkMessagesReadRequest) GetConversationId() string {
@@ -1411,7 +1659,7 @@ func (x *MarkMessagesReadRequest) GetIdempotencyKey() string {
 	return ""
 }
 
-// MarkMessagesReadResponse 按消息ID批量标记已读响应
+// MarkMessagesReadResponse batch mark as read response
 type MarkMessagesReadResponse struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	AcceptedIds         []string               `protobuf:"bytes,1,rep,name=accepted_ids,json=acceptedIds,proto3" json:"accepted_ids,omitempty"`
@@ -1423,7 +1671,7 @@ type MarkMessagesReadResponse struct {
 
 func (x *MarkMessagesReadResponse) Reset() {
 	*x = MarkMessagesReadResponse{}
-	mi := &file_message_message_proto_msgTypes[18]
+	mi := &file_message_message_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1435,7 +1683,7 @@ func (x *MarkMessagesReadResponse) String() string {
 func (*MarkMessagesReadResponse) ProtoMessage() {}
 
 func (x *MarkMessagesReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[18]
+	mi := &file_message_message_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,7 +1696,7 @@ func (x *MarkMessagesReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkMessagesReadResponse.ProtoReflect.Descriptor instead.
 func (*MarkMessagesReadResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{18}
+	return file_message_message_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *MarkMessagesReadResponse) GetAcceptedIds() []string {
@@ -1472,7 +1720,7 @@ func (x *MarkMessagesReadResponse) GetAdvancedLastReadSeq() int64 {
 	return 0
 }
 
-// ReadTriggerEvent 阅后即焚阅读触发事件
+// ReadTriggerEvent burn-after-reading trigger event
 type ReadTriggerEvent struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
@@ -1484,7 +1732,7 @@ type ReadTriggerEvent struct {
 
 func (x *ReadTriggerEvent) Reset() {
 	*x = ReadTriggerEvent{}
-	mi := &file_message_message_proto_msgTypes[19]
+	mi := &file_message_message_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1496,7 +1744,7 @@ func (x *ReadTriggerEvent) String() string {
 func (*ReadTriggerEvent) ProtoMessage() {}
 
 func (x *ReadTriggerEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[19]
+	mi := &file_message_message_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1509,7 +1757,7 @@ func (x *ReadTriggerEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadTriggerEvent.ProtoReflect.Descriptor instead.
 func (*ReadTriggerEvent) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{19}
+	return file_message_message_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReadTriggerEvent) GetMessageId() string {
@@ -1533,17 +1781,17 @@ func (x *ReadTriggerEvent) GetIdempotencyKey() string {
 	return ""
 }
 
-// AckReadTriggersRequest 批量上报阅读触发
+// AckReadTriggersRequest batch report read triggers
 type AckReadTriggersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*ReadTriggerEvent    `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // 操作用户由调用链路透传的x-user-id元数据提供
+	Events        []*ReadTriggerEvent    `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // operator user is provided via x-user-id metadata in the call chain
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AckReadTriggersRequest) Reset() {
 	*x = AckReadTriggersRequest{}
-	mi := &file_message_message_proto_msgTypes[20]
+	mi := &file_message_message_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1555,7 +1803,7 @@ func (x *AckReadTriggersRequest) String() string {
 func (*AckReadTriggersRequest) ProtoMessage() {}
 
 func (x *AckReadTriggersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[20]
+	mi := &file_message_message_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1568,7 +1816,7 @@ func (x *AckReadTriggersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AckReadTriggersRequest.ProtoReflect.Descriptor instead.
 func (*AckReadTriggersRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{20}
+	return file_message_message_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AckReadTriggersRequest) GetEvents() []*ReadTriggerEvent {
@@ -1578,7 +1826,7 @@ func (x *AckReadTriggersRequest) GetEvents() []*ReadTriggerEvent {
 	return nil
 }
 
-// AckReadTriggersResponse 批量上报结果
+// AckReadTriggersResponse batch report result
 type AckReadTriggersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SuccessIds    []string               `protobuf:"bytes,1,rep,name=success_ids,json=successIds,proto3" json:"success_ids,omitempty"`
@@ -1589,7 +1837,7 @@ type AckReadTriggersResponse struct {
 
 func (x *AckReadTriggersResponse) Reset() {
 	*x = AckReadTriggersResponse{}
-	mi := &file_message_message_proto_msgTypes[21]
+	mi := &file_message_message_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1601,7 +1849,7 @@ func (x *AckReadTriggersResponse) String() string {
 func (*AckReadTriggersResponse) ProtoMessage() {}
 
 func (x *AckReadTriggersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[21]
+	mi := &file_message_message_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,7 +1862,7 @@ func (x *AckReadTriggersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AckReadTriggersResponse.ProtoReflect.Descriptor instead.
 func (*AckReadTriggersResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{21}
+	return file_message_message_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AckReadTriggersResponse) GetSuccessIds() []string {
@@ -1631,10 +1879,10 @@ func (x *AckReadTriggersResponse) GetIgnoredIds() []string {
 	return nil
 }
 
-// GetUnreadCountRequest 获取未读数请求
+// GetUnreadCountRequest get unread count request
 type GetUnreadCountRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 操作用户由调用链路透传的x-user-id元数据提供
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // operator user is provided via x-user-id metadata in the call chain
 	LastReadSeq    *int64                 `protobuf:"varint,2,opt,name=last_read_seq,json=lastReadSeq,proto3,oneof" json:"last_read_seq,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
@@ -1642,7 +1890,7 @@ type GetUnreadCountRequest struct {
 
 func (x *GetUnreadCountRequest) Reset() {
 	*x = GetUnreadCountRequest{}
-	mi := &file_message_message_proto_msgTypes[22]
+	mi := &file_message_message_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1654,7 +1902,7 @@ func (x *GetUnreadCountRequest) String() string {
 func (*GetUnreadCountRequest) ProtoMessage() {}
 
 func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[22]
+	mi := &file_message_message_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1667,7 +1915,7 @@ func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
 func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{22}
+	return file_message_message_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetUnreadCountRequest) GetConversationId() string {
@@ -1684,7 +1932,7 @@ func (x *GetUnreadCountRequest) GetLastReadSeq() int64 {
 	return 0
 }
 
-// GetUnreadCountResponse 获取未读数响应
+// GetUnreadCountResponse get unread count response
 type GetUnreadCountResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UnreadCount    int64                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
@@ -1696,7 +1944,7 @@ type GetUnreadCountResponse struct {
 
 func (x *GetUnreadCountResponse) Reset() {
 	*x = GetUnreadCountResponse{}
-	mi := &file_message_message_proto_msgTypes[23]
+	mi := &file_message_message_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1708,7 +1956,7 @@ func (x *GetUnreadCountResponse) String() string {
 func (*GetUnreadCountResponse) ProtoMessage() {}
 
 func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[23]
+	mi := &file_message_message_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1721,7 +1969,7 @@ func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUnreadCountResponse.ProtoReflect.Descriptor instead.
 func (*GetUnreadCountResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{23}
+	return file_message_message_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetUnreadCountResponse) GetUnreadCount() int64 {
@@ -1745,17 +1993,17 @@ func (x *GetUnreadCountResponse) GetLastMessage() *Message {
 	return nil
 }
 
-// GetReadReceiptsRequest 获取已读回执请求
+// GetReadReceiptsRequest get read receipts request
 type GetReadReceiptsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 操作用户由调用链路透传的x-user-id元数据提供
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // operator user is provided via x-user-id metadata in the call chain
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetReadReceiptsRequest) Reset() {
 	*x = GetReadReceiptsRequest{}
-	mi := &file_message_message_proto_msgTypes[24]
+	mi := &file_message_message_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1767,7 +2015,7 @@ func (x *GetReadReceiptsRequest) String() string {
 func (*GetReadReceiptsRequest) ProtoMessage() {}
 
 func (x *GetReadReceiptsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[24]
+	mi := &file_message_message_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1780,7 +2028,7 @@ func (x *GetReadReceiptsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReadReceiptsRequest.ProtoReflect.Descriptor instead.
 func (*GetReadReceiptsRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{24}
+	return file_message_message_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetReadReceiptsRequest) GetConversationId() string {
@@ -1790,13 +2038,13 @@ func (x *GetReadReceiptsRequest) GetConversationId() string {
 	return ""
 }
 
-// ReadReceipt 已读回执
+// ReadReceipt read receipt
 type ReadReceipt struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	LastReadSeq       int64                  `protobuf:"varint,2,opt,name=last_read_seq,json=lastReadSeq,proto3" json:"last_read_seq,omitempty"`
 	LastReadMessageId *string                `protobuf:"bytes,3,opt,name=last_read_message_id,json=lastReadMessageId,proto3,oneof" json:"last_read_message_id,omitempty"`
-	ReadAt            *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	ReadAt            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
 	UserInfo          *common.UserInfo       `protobuf:"bytes,5,opt,name=user_info,json=userInfo,proto3,oneof" json:"user_info,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
@@ -1804,7 +2052,7 @@ type ReadReceipt struct {
 
 func (x *ReadReceipt) Reset() {
 	*x = ReadReceipt{}
-	mi := &file_message_message_proto_msgTypes[25]
+	mi := &file_message_message_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1816,7 +2064,7 @@ func (x *ReadReceipt) String() string {
 func (*ReadReceipt) ProtoMessage() {}
 
 func (x *ReadReceipt) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[25]
+	mi := &file_message_message_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1829,7 +2077,7 @@ func (x *ReadReceipt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadReceipt.ProtoReflect.Descriptor instead.
 func (*ReadReceipt) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{25}
+	return file_message_message_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ReadReceipt) GetUserId() string {
@@ -1853,7 +2101,7 @@ func (x *ReadReceipt) GetLastReadMessageId() string {
 	return ""
 }
 
-func (x *ReadReceipt) GetReadAt() *timestamp.Timestamp {
+func (x *ReadReceipt) GetReadAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ReadAt
 	}
@@ -1867,7 +2115,7 @@ func (x *ReadReceipt) GetUserInfo() *common.UserInfo {
 	return nil
 }
 
-// GetReadReceiptsResponse 获取已读回执响应
+// GetReadReceiptsResponse get read receipts response
 type GetReadReceiptsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Receipts      []*ReadReceipt         `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
@@ -1877,7 +2125,7 @@ type GetReadReceiptsResponse struct {
 
 func (x *GetReadReceiptsResponse) Reset() {
 	*x = GetReadReceiptsResponse{}
-	mi := &file_message_message_proto_msgTypes[26]
+	mi := &file_message_message_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1889,7 +2137,7 @@ func (x *GetReadReceiptsResponse) String() string {
 func (*GetReadReceiptsResponse) ProtoMessage() {}
 
 func (x *GetReadReceiptsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[26]
+	mi := &file_message_message_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1902,7 +2150,7 @@ func (x *GetReadReceiptsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReadReceiptsResponse.ProtoReflect.Descriptor instead.
 func (*GetReadReceiptsResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{26}
+	return file_message_message_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetReadReceiptsResponse) GetReceipts() []*ReadReceipt {
@@ -1912,7 +2160,7 @@ func (x *GetReadReceiptsResponse) GetReceipts() []*ReadReceipt {
 	return nil
 }
 
-// GetConversationSequenceRequest 获取会话序列号请求
+// GetConversationSequenceRequest get conversation sequence request
 type GetConversationSequenceRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -1922,7 +2170,7 @@ type GetConversationSequenceRequest struct {
 
 func (x *GetConversationSequenceRequest) Reset() {
 	*x = GetConversationSequenceRequest{}
-	mi := &file_message_message_proto_msgTypes[27]
+	mi := &file_message_message_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1934,7 +2182,7 @@ func (x *GetConversationSequenceRequest) String() string {
 func (*GetConversationSequenceRequest) ProtoMessage() {}
 
 func (x *GetConversationSequenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[27]
+	mi := &file_message_message_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,7 +2195,7 @@ func (x *GetConversationSequenceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationSequenceRequest.ProtoReflect.Descriptor instead.
 func (*GetConversationSequenceRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{27}
+	return file_message_message_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetConversationSequenceRequest) GetConversationId() string {
@@ -1957,7 +2205,7 @@ func (x *GetConversationSequenceRequest) GetConversationId() string {
 	return ""
 }
 
-// GetConversationSequenceResponse 获取会话序列号响应
+// GetConversationSequenceResponse get conversation sequence response
 type GetConversationSequenceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CurrentSeq    int64                  `protobuf:"varint,1,opt,name=current_seq,json=currentSeq,proto3" json:"current_seq,omitempty"`
@@ -1967,7 +2215,7 @@ type GetConversationSequenceResponse struct {
 
 func (x *GetConversationSequenceResponse) Reset() {
 	*x = GetConversationSequenceResponse{}
-	mi := &file_message_message_proto_msgTypes[28]
+	mi := &file_message_message_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1979,7 +2227,7 @@ func (x *GetConversationSequenceResponse) String() string {
 func (*GetConversationSequenceResponse) ProtoMessage() {}
 
 func (x *GetConversationSequenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[28]
+	mi := &file_message_message_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1992,7 +2240,7 @@ func (x *GetConversationSequenceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationSequenceResponse.ProtoReflect.Descriptor instead.
 func (*GetConversationSequenceResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{28}
+	return file_message_message_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetConversationSequenceResponse) GetCurrentSeq() int64 {
@@ -2002,10 +2250,10 @@ func (x *GetConversationSequenceResponse) GetCurrentSeq() int64 {
 	return 0
 }
 
-// SearchMessagesRequest 搜索消息请求
+// SearchMessagesRequest search messages request
 type SearchMessagesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Keyword        string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"` // 操作用户由调用链路透传的x-user-id元数据提供
+	Keyword        string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"` // operator user is provided via x-user-id metadata in the call chain
 	ConversationId *string                `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3,oneof" json:"conversation_id,omitempty"`
 	ContentType    *ContentType           `protobuf:"varint,3,opt,name=content_type,json=contentType,proto3,enum=anychat.message.ContentType,oneof" json:"content_type,omitempty"`
 	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -2016,7 +2264,7 @@ type SearchMessagesRequest struct {
 
 func (x *SearchMessagesRequest) Reset() {
 	*x = SearchMessagesRequest{}
-	mi := &file_message_message_proto_msgTypes[29]
+	mi := &file_message_message_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2028,7 +2276,7 @@ func (x *SearchMessagesRequest) String() string {
 func (*SearchMessagesRequest) ProtoMessage() {}
 
 func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[29]
+	mi := &file_message_message_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2041,7 +2289,7 @@ func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
 func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{29}
+	return file_message_message_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SearchMessagesRequest) GetKeyword() string {
@@ -2079,7 +2327,7 @@ func (x *SearchMessagesRequest) GetOffset() int32 {
 	return 0
 }
 
-// SearchMessagesResponse 搜索消息响应
+// SearchMessagesResponse search messages response
 type SearchMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
@@ -2090,7 +2338,7 @@ type SearchMessagesResponse struct {
 
 func (x *SearchMessagesResponse) Reset() {
 	*x = SearchMessagesResponse{}
-	mi := &file_message_message_proto_msgTypes[30]
+	mi := &file_message_message_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2102,7 +2350,7 @@ func (x *SearchMessagesResponse) String() string {
 func (*SearchMessagesResponse) ProtoMessage() {}
 
 func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[30]
+	mi := &file_message_message_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2115,7 +2363,7 @@ func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
 func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{30}
+	return file_message_message_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SearchMessagesResponse) GetMessages() []*Message {
@@ -2132,7 +2380,254 @@ func (x *SearchMessagesResponse) GetTotal() int64 {
 	return 0
 }
 
-// SendTypingRequest 发送输入状态请求
+// ListConversationMediaRequest list conversation media request
+type ListConversationMediaRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`              // operator user is provided via x-user-id metadata in the call chain
+	Categories     []MediaCategory        `protobuf:"varint,2,rep,packed,name=categories,proto3,enum=anychat.message.MediaCategory" json:"categories,omitempty"` // empty means all categories
+	Cursor         *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`                                              // next_cursor of the previous page, empty starts from the latest
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                     // default 20, max 100
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListConversationMediaRequest) Reset() {
+	*x = ListConversationMediaRequest{}
+	mi := &file_message_message_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationMediaRequest) ProtoMessage() {}
+
+func (x *ListConversationMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationMediaRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationMediaRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListConversationMediaRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ListConversationMediaRequest) GetCategories() []MediaCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListConversationMediaRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListConversationMediaRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ConversationMedia one image, video, file or link of a conversation
+type ConversationMedia struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Sequence      int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Category      MediaCategory          `protobuf:"varint,3,opt,name=category,proto3,enum=anychat.message.MediaCategory" json:"category,omitempty"`
+	SenderId      string                 `protobuf:"bytes,4,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`                                  // JSON content of the message (file name, size, dimensions)
+	FileId        *string                `protobuf:"bytes,6,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`                // image, video and file
+	Url           *string                `protobuf:"bytes,7,opt,name=url,proto3,oneof" json:"url,omitempty"`                                    // link
+	DownloadUrl   *string                `protobuf:"bytes,8,opt,name=download_url,json=downloadUrl,proto3,oneof" json:"download_url,omitempty"` // presigned, empty when the file is no longer available
+	ThumbnailUrl  *string                `protobuf:"bytes,9,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationMedia) Reset() {
+	*x = ConversationMedia{}
+	mi := &file_message_message_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationMedia) ProtoMessage() {}
+
+func (x *ConversationMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationMedia.ProtoReflect.Descriptor instead.
+func (*ConversationMedia) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ConversationMedia) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ConversationMedia) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ConversationMedia) GetCategory() MediaCategory {
+	if x != nil {
+		return x.Category
+	}
+	return MediaCategory_MEDIA_CATEGORY_UNSPECIFIED
+}
+
+func (x *ConversationMedia) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *ConversationMedia) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ConversationMedia) GetFileId() string {
+	if x != nil && x.FileId != nil {
+		return *x.FileId
+	}
+	return ""
+}
+
+func (x *ConversationMedia) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *ConversationMedia) GetDownloadUrl() string {
+	if x != nil && x.DownloadUrl != nil {
+		return *x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ConversationMedia) GetThumbnailUrl() string {
+	if x != nil && x.ThumbnailUrl != nil {
+		return *x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *ConversationMedia) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListConversationMediaResponse list conversation media response
+type ListConversationMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ConversationMedia   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationMediaResponse) Reset() {
+	*x = ListConversationMediaResponse{}
+	mi := &file_message_message_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationMediaResponse) ProtoMessage() {}
+
+func (x *ListConversationMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationMediaResponse.ProtoReflect.Descriptor instead.
+func (*ListConversationMediaResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListConversationMediaResponse) GetItems() []*ConversationMedia {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListConversationMediaResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListConversationMediaResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// SendTypingRequest send typing status request
 type SendTypingRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -2146,7 +2641,7 @@ type SendTypingRequest struct {
 
 func (x *SendTypingRequest) Reset() {
 	*x = SendTypingRequest{}
-	mi := &file_message_message_proto_msgTypes[31]
+	mi := &file_message_message_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2158,7 +2653,7 @@ func (x *SendTypingRequest) String() string {
 func (*SendTypingRequest) ProtoMessage() {}
 
 func (x *SendTypingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[31]
+	mi := &file_message_message_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2171,7 +2666,7 @@ func (x *SendTypingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendTypingRequest.ProtoReflect.Descriptor instead.
 func (*SendTypingRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{31}
+	return file_message_message_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *SendTypingRequest) GetConversationId() string {
@@ -2213,7 +2708,7 @@ var File_message_message_proto protoreflect.FileDescriptor
 
 const file_message_message_proto_rawDesc = "" +
 	"\n" +
-	"\x15message/message.proto\x12\x0fanychat.message\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13common/common.proto\"\xa6\x06\n" +
+	"\x15message/message.proto\x12\x0fanychat.message\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13common/common.proto\"\xbe\x06\n" +
 	"\aMessage\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12'\n" +
@@ -2229,7 +2724,8 @@ const file_message_message_proto_rawDesc = "" +
 	" \x01(\x05R\x06status\x12;\n" +
 	"\vexpire_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"expireTime\x12 \n" +
-	"\ttarget_id\x18\x0e \x01(\tH\x01R\btargetId\x88\x01\x01\x129\n" +
+	"\ttarget_id\x18\x0e \x01(\tH\x01R\btargetId\x88\x01\x01\x12\x16\n" +
+	"\x06silent\x18\x0f \x01(\bR\x06silent\x129\n" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
@@ -2250,7 +2746,23 @@ const file_message_message_proto_rawDesc = "" +
 	"\breply_to\x18\x05 \x01(\tH\x00R\areplyTo\x88\x01\x01\x12\x19\n" +
 	"\bat_users\x18\x06 \x03(\tR\aatUsers\x12\x19\n" +
 	"\blocal_id\x18\a \x01(\tR\alocalIdB\v\n" +
-	"\t_reply_to\"\x8a\x01\n" +
+	"\t_reply_to\"\x84\x04\n" +
+	"\x18SendSystemMessageRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12N\n" +
+	"\x11conversation_type\x18\x02 \x01(\x0e2!.anychat.message.ConversationTypeR\x10conversationType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x122\n" +
+	"\x05event\x18\x04 \x01(\x0e2\x1c.anychat.message.SystemEventR\x05event\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\tR\n" +
+	"operatorId\x12\x19\n" +
+	"\buser_ids\x18\x06 \x03(\tR\auserIds\x12M\n" +
+	"\x06params\x18\a \x03(\v25.anychat.message.SendSystemMessageRequest.ParamsEntryR\x06params\x12\x16\n" +
+	"\x06silent\x18\b \x01(\bR\x06silent\x12#\n" +
+	"\rrecipient_ids\x18\t \x03(\tR\frecipientIds\x12\x1b\n" +
+	"\tdedup_key\x18\n" +
+	" \x01(\tR\bdedupKey\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8a\x01\n" +
 	"\x13SendMessageResponse\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1a\n" +
@@ -2393,7 +2905,39 @@ const file_message_message_proto_rawDesc = "" +
 	"\r_content_type\"d\n" +
 	"\x16SearchMessagesResponse\x124\n" +
 	"\bmessages\x18\x01 \x03(\v2\x18.anychat.message.MessageR\bmessages\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\xdc\x01\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xc5\x01\n" +
+	"\x1cListConversationMediaRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12>\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\x0e2\x1e.anychat.message.MediaCategoryR\n" +
+	"categories\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limitB\t\n" +
+	"\a_cursor\"\xba\x03\n" +
+	"\x11ConversationMedia\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12:\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x1e.anychat.message.MediaCategoryR\bcategory\x12\x1b\n" +
+	"\tsender_id\x18\x04 \x01(\tR\bsenderId\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x1c\n" +
+	"\afile_id\x18\x06 \x01(\tH\x00R\x06fileId\x88\x01\x01\x12\x15\n" +
+	"\x03url\x18\a \x01(\tH\x01R\x03url\x88\x01\x01\x12&\n" +
+	"\fdownload_url\x18\b \x01(\tH\x02R\vdownloadUrl\x88\x01\x01\x12(\n" +
+	"\rthumbnail_url\x18\t \x01(\tH\x03R\fthumbnailUrl\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\n" +
+	"\n" +
+	"\b_file_idB\x06\n" +
+	"\x04_urlB\x0f\n" +
+	"\r_download_urlB\x10\n" +
+	"\x0e_thumbnail_url\"\x95\x01\n" +
+	"\x1dListConversationMediaResponse\x128\n" +
+	"\x05items\x18\x01 \x03(\v2\".anychat.message.ConversationMediaR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\xdc\x01\n" +
 	"\x11SendTypingRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12 \n" +
 	"\ffrom_user_id\x18\x02 \x01(\tR\n" +
@@ -2408,7 +2952,7 @@ const file_message_message_proto_rawDesc = "" +
 	"\x10ConversationType\x12!\n" +
 	"\x1dCONVERSATION_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18CONVERSATION_TYPE_SINGLE\x10\x01\x12\x1b\n" +
-	"\x17CONVERSATION_TYPE_GROUP\x10\x02*\xd3\x01\n" +
+	"\x17CONVERSATION_TYPE_GROUP\x10\x02*\xec\x01\n" +
 	"\vContentType\x12\x1c\n" +
 	"\x18CONTENT_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11CONTENT_TYPE_TEXT\x10\x01\x12\x16\n" +
@@ -2417,9 +2961,26 @@ const file_message_message_proto_rawDesc = "" +
 	"\x12CONTENT_TYPE_AUDIO\x10\x04\x12\x15\n" +
 	"\x11CONTENT_TYPE_FILE\x10\x05\x12\x19\n" +
 	"\x15CONTENT_TYPE_LOCATION\x10\x06\x12\x15\n" +
-	"\x11CONTENT_TYPE_CARD\x10\a2\x89\r\n" +
+	"\x11CONTENT_TYPE_CARD\x10\a\x12\x17\n" +
+	"\x13CONTENT_TYPE_SYSTEM\x10\b*\xb4\x02\n" +
+	"\vSystemEvent\x12\x1c\n" +
+	"\x18SYSTEM_EVENT_UNSPECIFIED\x10\x00\x12$\n" +
+	" SYSTEM_EVENT_GROUP_MEMBER_JOINED\x10\x01\x12\"\n" +
+	"\x1eSYSTEM_EVENT_GROUP_MEMBER_LEFT\x10\x02\x12%\n" +
+	"!SYSTEM_EVENT_GROUP_MEMBER_REMOVED\x10\x03\x12#\n" +
+	"\x1fSYSTEM_EVENT_GROUP_ROLE_CHANGED\x10\x04\x12\x1e\n" +
+	"\x1aSYSTEM_EVENT_GROUP_RENAMED\x10\x05\x12#\n" +
+	"\x1fSYSTEM_EVENT_GROUP_MUTE_CHANGED\x10\x06\x12,\n" +
+	"(SYSTEM_EVENT_GROUP_OWNERSHIP_TRANSFERRED\x10\a*\x95\x01\n" +
+	"\rMediaCategory\x12\x1e\n" +
+	"\x1aMEDIA_CATEGORY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14MEDIA_CATEGORY_IMAGE\x10\x01\x12\x18\n" +
+	"\x14MEDIA_CATEGORY_VIDEO\x10\x02\x12\x17\n" +
+	"\x13MEDIA_CATEGORY_FILE\x10\x03\x12\x17\n" +
+	"\x13MEDIA_CATEGORY_LINK\x10\x042\xc5\x0f\n" +
 	"\x0eMessageService\x12X\n" +
-	"\vSendMessage\x12#.anychat.message.SendMessageRequest\x1a$.anychat.message.SendMessageResponse\x12X\n" +
+	"\vSendMessage\x12#.anychat.message.SendMessageRequest\x1a$.anychat.message.SendMessageResponse\x12d\n" +
+	"\x11SendSystemMessage\x12).anychat.message.SendSystemMessageRequest\x1a$.anychat.message.SendMessageResponse\x12X\n" +
 	"\vGetMessages\x12#.anychat.message.GetMessagesRequest\x1a$.anychat.message.GetMessagesResponse\x12j\n" +
 	"\x11GetMessagesBefore\x12).anychat.message.GetMessagesBeforeRequest\x1a*.anychat.message.GetMessagesBeforeResponse\x12g\n" +
 	"\x10GetMessagesAfter\x12(.anychat.message.GetMessagesAfterRequest\x1a).anychat.message.GetMessagesAfterResponse\x12|\n" +
@@ -2435,9 +2996,11 @@ const file_message_message_proto_rawDesc = "" +
 	"\x0eGetUnreadCount\x12&.anychat.message.GetUnreadCountRequest\x1a'.anychat.message.GetUnreadCountResponse\x12d\n" +
 	"\x0fGetReadReceipts\x12'.anychat.message.GetReadReceiptsRequest\x1a(.anychat.message.GetReadReceiptsResponse\x12|\n" +
 	"\x17GetConversationSequence\x12/.anychat.message.GetConversationSequenceRequest\x1a0.anychat.message.GetConversationSequenceResponse\x12a\n" +
-	"\x0eSearchMessages\x12&.anychat.message.SearchMessagesRequest\x1a'.anychat.message.SearchMessagesResponse\x12G\n" +
+	"\x0eSearchMessages\x12&.anychat.message.SearchMessagesRequest\x1a'.anychat.message.SearchMessagesResponse\x12v\n" +
+	"\x15ListConversationMedia\x12-.anychat.message.ListConversationMediaRequest\x1a..anychat.message.ListConversationMediaResponse\x12G\n" +
 	"\n" +
-	"SendTyping\x12\".anychat.message.SendTypingRequest\x1a\x15.anychat.common.EmptyB7Z5github.com/anychat/server/api/proto/message;messagepbb\x06proto3"
+	"SendTyping\x12\".anychat.message.SendTypingRequest\x1a\x15.anychat.common.Empty\x12\\\n" +
+	"\rEraseUserData\x12$.anychat.common.EraseUserDataRequest\x1a%.anychat.common.EraseUserDataResponseB7Z5github.com/anychat/server/api/proto/message;messagepbb\x06proto3"
 
 var (
 	file_message_message_proto_rawDescOnce sync.Once
@@ -2451,114 +3014,136 @@ func file_message_message_proto_rawDescGZIP() []byte {
 	return file_message_message_proto_rawDescData
 }
 
-var file_message_message_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_message_message_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_message_message_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_message_message_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_message_message_proto_goTypes = []any{
 	(ConversationType)(0),                   // 0: anychat.message.ConversationType
 	(ContentType)(0),                        // 1: anychat.message.ContentType
-	(*Message)(nil),                         // 2: anychat.message.Message
-	(*SendMessageRequest)(nil),              // 3: anychat.message.SendMessageRequest
-	(*SendMessageResponse)(nil),             // 4: anychat.message.SendMessageResponse
-	(*GetMessagesRequest)(nil),              // 5: anychat.message.GetMessagesRequest
-	(*GetMessagesResponse)(nil),             // 6: anychat.message.GetMessagesResponse
-	(*GetMessagesBeforeRequest)(nil),        // 7: anychat.message.GetMessagesBeforeRequest
-	(*GetMessagesBeforeResponse)(nil),       // 8: anychat.message.GetMessagesBeforeResponse
-	(*GetMessagesAfterRequest)(nil),         // 9: anychat.message.GetMessagesAfterRequest
-	(*GetMessagesAfterResponse)(nil),        // 10: anychat.message.GetMessagesAfterResponse
-	(*GetMessagesAroundAnchorRequest)(nil),  // 11: anychat.message.GetMessagesAroundAnchorRequest
-	(*GetMessagesAroundAnchorResponse)(nil), // 12: anychat.message.GetMessagesAroundAnchorResponse
-	(*GetFirstUnreadAnchorRequest)(nil),     // 13: anychat.message.GetFirstUnreadAnchorRequest
-	(*GetFirstUnreadAnchorResponse)(nil),    // 14: anychat.message.GetFirstUnreadAnchorResponse
-	(*GetMessageByIdRequest)(nil),           // 15: anychat.message.GetMessageByIdRequest
-	(*RecallMessageRequest)(nil),            // 16: anychat.message.RecallMessageRequest
-	(*DeleteMessageRequest)(nil),            // 17: anychat.message.DeleteMessageRequest
-	(*MarkAsReadRequest)(nil),               // 18: anychat.message.MarkAsReadRequest
-	(*MarkMessagesReadRequest)(nil),         // 19: anychat.message.MarkMessagesReadRequest
-	(*MarkMessagesReadResponse)(nil),        // 20: anychat.message.MarkMessagesReadResponse
-	(*ReadTriggerEvent)(nil),                // 21: anychat.message.ReadTriggerEvent
-	(*AckReadTriggersRequest)(nil),          // 22: anychat.message.AckReadTriggersRequest
-	(*AckReadTriggersResponse)(nil),         // 23: anychat.message.AckReadTriggersResponse
-	(*GetUnreadCountRequest)(nil),           // 24: anychat.message.GetUnreadCountRequest
-	(*GetUnreadCountResponse)(nil),          // 25: anychat.message.GetUnreadCountResponse
-	(*GetReadReceiptsRequest)(nil),          // 26: anychat.message.GetReadReceiptsRequest
-	(*ReadReceipt)(nil),                     // 27: anychat.message.ReadReceipt
-	(*GetReadReceiptsResponse)(nil),         // 28: anychat.message.GetReadReceiptsResponse
-	(*GetConversationSequenceRequest)(nil),  // 29: anychat.message.GetConversationSequenceRequest
-	(*GetConversationSequenceResponse)(nil), // 30: anychat.message.GetConversationSequenceResponse
-	(*SearchMessagesRequest)(nil),           // 31: anychat.message.SearchMessagesRequest
-	(*SearchMessagesResponse)(nil),          // 32: anychat.message.SearchMessagesResponse
-	(*SendTypingRequest)(nil),               // 33: anychat.message.SendTypingRequest
-	(*timestamp.Timestamp)(nil),             // 34: google.protobuf.Timestamp
-	(*common.UserInfo)(nil),                 // 35: anychat.common.UserInfo
-	(*common.Empty)(nil),                    // 36: anychat.common.Empty
+	(SystemEvent)(0),                        // 2: anychat.message.SystemEvent
+	(MediaCategory)(0),                      // 3: anychat.message.MediaCategory
+	(*Message)(nil),                         // 4: anychat.message.Message
+	(*SendMessageRequest)(nil),              // 5: anychat.message.SendMessageRequest
+	(*SendSystemMessageRequest)(nil),        // 6: anychat.message.SendSystemMessageRequest
+	(*SendMessageResponse)(nil),             // 7: anychat.message.SendMessageResponse
+	(*GetMessagesRequest)(nil),              // 8: anychat.message.GetMessagesRequest
+	(*GetMessagesResponse)(nil),             // 9: anychat.message.GetMessagesResponse
+	(*GetMessagesBeforeRequest)(nil),        // 10: anychat.message.GetMessagesBeforeRequest
+	(*GetMessagesBeforeResponse)(nil),       // 11: anychat.message.GetMessagesBeforeResponse
+	(*GetMessagesAfterRequest)(nil),         // 12: anychat.message.GetMessagesAfterRequest
+	(*GetMessagesAfterResponse)(nil),        // 13: anychat.message.GetMessagesAfterResponse
+	(*GetMessagesAroundAnchorRequest)(nil),  // 14: anychat.message.GetMessagesAroundAnchorRequest
+	(*GetMessagesAroundAnchorResponse)(nil), // 15: anychat.message.GetMessagesAroundAnchorResponse
+	(*GetFirstUnreadAnchorRequest)(nil),     // 16: anychat.message.GetFirstUnreadAnchorRequest
+	(*GetFirstUnreadAnchorResponse)(nil),    // 17: anychat.message.GetFirstUnreadAnchorResponse
+	(*GetMessageByIdRequest)(nil),           // 18: anychat.message.GetMessageByIdRequest
+	(*RecallMessageRequest)(nil),            // 19: anychat.message.RecallMessageRequest
+	(*DeleteMessageRequest)(nil),            // 20: anychat.message.DeleteMessageRequest
+	(*MarkAsReadRequest)(nil),               // 21: anychat.message.MarkAsReadRequest
+	(*MarkMessagesReadRequest)(nil),         // 22: anychat.message.MarkMessagesReadRequest
+	(*MarkMessagesReadResponse)(nil),        // 23: anychat.message.MarkMessagesReadResponse
+	(*ReadTriggerEvent)(nil),                // 24: anychat.message.ReadTriggerEvent
+	(*AckReadTriggersRequest)(nil),          // 25: anychat.message.AckReadTriggersRequest
+	(*AckReadTriggersResponse)(nil),         // 26: anychat.message.AckReadTriggersResponse
+	(*GetUnreadCountRequest)(nil),           // 27: anychat.message.GetUnreadCountRequest
+	(*GetUnreadCountResponse)(nil),          // 28: anychat.message.GetUnreadCountResponse
+	(*GetReadReceiptsRequest)(nil),          // 29: anychat.message.GetReadReceiptsRequest
+	(*ReadReceipt)(nil),                     // 30: anychat.message.ReadReceipt
+	(*GetReadReceiptsResponse)(nil),         // 31: anychat.message.GetReadReceiptsResponse
+	(*GetConversationSequenceRequest)(nil),  // 32: anychat.message.GetConversationSequenceRequest
+	(*GetConversationSequenceResponse)(nil), // 33: anychat.message.GetConversationSequenceResponse
+	(*SearchMessagesRequest)(nil),           // 34: anychat.message.SearchMessagesRequest
+	(*SearchMessagesResponse)(nil),          // 35: anychat.message.SearchMessagesResponse
+	(*ListConversationMediaRequest)(nil),    // 36: anychat.message.ListConversationMediaRequest
+	(*ConversationMedia)(nil),               // 37: anychat.message.ConversationMedia
+	(*ListConversationMediaResponse)(nil),   // 38: anychat.message.ListConversationMediaResponse
+	(*SendTypingRequest)(nil),               // 39: anychat.message.SendTypingRequest
+	nil,                                     // 40: anychat.message.SendSystemMessageRequest.ParamsEntry
+	(*timestamppb.Timestamp)(nil),           // 41: google.protobuf.Timestamp
+	(*common.UserInfo)(nil),                 // 42: anychat.common.UserInfo
+	(*common.EraseUserDataRequest)(nil),     // 43: anychat.common.EraseUserDataRequest
+	(*common.Empty)(nil),                    // 44: anychat.common.Empty
+	(*common.EraseUserDataResponse)(nil),    // 45: anychat.common.EraseUserDataResponse
 }
 var file_message_message_proto_depIdxs = []int32{
 	0,  // 0: anychat.message.Message.conversation_type:type_name -> anychat.message.ConversationType
 	1,  // 1: anychat.message.Message.content_type:type_name -> anychat.message.ContentType
-	34, // 2: anychat.message.Message.expire_time:type_name -> google.protobuf.Timestamp
-	34, // 3: anychat.message.Message.created_at:type_name -> google.protobuf.Timestamp
-	34, // 4: anychat.message.Message.updated_at:type_name -> google.protobuf.Timestamp
-	35, // 5: anychat.message.Message.sender_info:type_name -> anychat.common.UserInfo
-	2,  // 6: anychat.message.Message.reply_to_message:type_name -> anychat.message.Message
+	41, // 2: anychat.message.Message.expire_time:type_name -> google.protobuf.Timestamp
+	41, // 3: anychat.message.Message.created_at:type_name -> google.protobuf.Timestamp
+	41, // 4: anychat.message.Message.updated_at:type_name -> google.protobuf.Timestamp
+	42, // 5: anychat.message.Message.sender_info:type_name -> anychat.common.UserInfo
+	4,  // 6: anychat.message.Message.reply_to_message:type_name -> anychat.message.Message
 	1,  // 7: anychat.message.SendMessageRequest.content_type:type_name -> anychat.message.ContentType
-	34, // 8: anychat.message.SendMessageResponse.timestamp:type_name -> google.protobuf.Timestamp
-	2,  // 9: anychat.message.GetMessagesResponse.messages:type_name -> anychat.message.Message
-	2,  // 10: anychat.message.GetMessagesBeforeResponse.anchor_message:type_name -> anychat.message.Message
-	2,  // 11: anychat.message.GetMessagesBeforeResponse.messages:type_name -> anychat.message.Message
-	2,  // 12: anychat.message.GetMessagesAfterResponse.anchor_message:type_name -> anychat.message.Message
-	2,  // 13: anychat.message.GetMessagesAfterResponse.messages:type_name -> anychat.message.Message
-	2,  // 14: anychat.message.GetMessagesAroundAnchorResponse.anchor_message:type_name -> anychat.message.Message
-	2,  // 15: anychat.message.GetMessagesAroundAnchorResponse.before_messages:type_name -> anychat.message.Message
-	2,  // 16: anychat.message.GetMessagesAroundAnchorResponse.after_messages:type_name -> anychat.message.Message
-	2,  // 17: anychat.message.GetFirstUnreadAnchorResponse.anchor_message:type_name -> anychat.message.Message
-	2,  // 18: anychat.message.GetFirstUnreadAnchorResponse.before_messages:type_name -> anychat.message.Message
-	2,  // 19: anychat.message.GetFirstUnreadAnchorResponse.after_messages:type_name -> anychat.message.Message
-	21, // 20: anychat.message.AckReadTriggersRequest.events:type_name -> anychat.message.ReadTriggerEvent
-	2,  // 21: anychat.message.GetUnreadCountResponse.last_message:type_name -> anychat.message.Message
-	34, // 22: anychat.message.ReadReceipt.read_at:type_name -> google.protobuf.Timestamp
-	35, // 23: anychat.message.ReadReceipt.user_info:type_name -> anychat.common.UserInfo
-	27, // 24: anychat.message.GetReadReceiptsResponse.receipts:type_name -> anychat.message.ReadReceipt
-	1,  // 25: anychat.message.SearchMessagesRequest.content_type:type_name -> anychat.message.ContentType
-	2,  // 26: anychat.message.SearchMessagesResponse.messages:type_name -> anychat.message.Message
-	3,  // 27: anychat.message.MessageService.SendMessage:input_type -> anychat.message.SendMessageRequest
-	5,  // 28: anychat.message.MessageService.GetMessages:input_type -> anychat.message.GetMessagesRequest
-	7,  // 29: anychat.message.MessageService.GetMessagesBefore:input_type -> anychat.message.GetMessagesBeforeRequest
-	9,  // 30: anychat.message.MessageService.GetMessagesAfter:input_type -> anychat.message.GetMessagesAfterRequest
-	11, // 31: anychat.message.MessageService.GetMessagesAroundAnchor:input_type -> anychat.message.GetMessagesAroundAnchorRequest
-	13, // 32: anychat.message.MessageService.GetFirstUnreadAnchor:input_type -> anychat.message.GetFirstUnreadAnchorRequest
-	15, // 33: anychat.message.MessageService.GetMessageById:input_type -> anychat.message.GetMessageByIdRequest
-	16, // 34: anychat.message.MessageService.RecallMessage:input_type -> anychat.message.RecallMessageRequest
-	17, // 35: anychat.message.MessageService.DeleteMessage:input_type -> anychat.message.DeleteMessageRequest
-	18, // 36: anychat.message.MessageService.MarkAsRead:input_type -> anychat.message.MarkAsReadRequest
-	19, // 37: anychat.message.MessageService.MarkMessagesRead:input_type -> anychat.message.MarkMessagesReadRequest
-	22, // 38: anychat.message.MessageService.AckReadTriggers:input_type -> anychat.message.AckReadTriggersRequest
-	24, // 39: anychat.message.MessageService.GetUnreadCount:input_type -> anychat.message.GetUnreadCountRequest
-	26, // 40: anychat.message.MessageService.GetReadReceipts:input_type -> anychat.message.GetReadReceiptsRequest
-	29, // 41: anychat.message.MessageService.GetConversationSequence:input_type -> anychat.message.GetConversationSequenceRequest
-	31, // 42: anychat.message.MessageService.SearchMessages:input_type -> anychat.message.SearchMessagesRequest
-	33, // 43: anychat.message.MessageService.SendTyping:input_type -> anychat.message.SendTypingRequest
-	4,  // 44: anychat.message.MessageService.SendMessage:output_type -> anychat.message.SendMessageResponse
-	6,  // 45: anychat.message.MessageService.GetMessages:output_type -> anychat.message.GetMessagesResponse
-	8,  // 46: anychat.message.MessageService.GetMessagesBefore:output_type -> anychat.message.GetMessagesBeforeResponse
-	10, // 47: anychat.message.MessageService.GetMessagesAfter:output_type -> anychat.message.GetMessagesAfterResponse
-	12, // 48: anychat.message.MessageService.GetMessagesAroundAnchor:output_type -> anychat.message.GetMessagesAroundAnchorResponse
-	14, // 49: anychat.message.MessageService.GetFirstUnreadAnchor:output_type -> anychat.message.GetFirstUnreadAnchorResponse
-	2,  // 50: anychat.message.MessageService.GetMessageById:output_type -> anychat.message.Message
-	36, // 51: anychat.message.MessageService.RecallMessage:output_type -> anychat.common.Empty
-	36, // 52: anychat.message.MessageService.DeleteMessage:output_type -> anychat.common.Empty
-	36, // 53: anychat.message.MessageService.MarkAsRead:output_type -> anychat.common.Empty
-	20, // 54: anychat.message.MessageService.MarkMessagesRead:output_type -> anychat.message.MarkMessagesReadResponse
-	23, // 55: anychat.message.MessageService.AckReadTriggers:output_type -> anychat.message.AckReadTriggersResponse
-	25, // 56: anychat.message.MessageService.GetUnreadCount:output_type -> anychat.message.GetUnreadCountResponse
-	28, // 57: anychat.message.MessageService.GetReadReceipts:output_type -> anychat.message.GetReadReceiptsResponse
-	30, // 58: anychat.message.MessageService.GetConversationSequence:output_type -> anychat.message.GetConversationSequenceResponse
-	32, // 59: anychat.message.MessageService.SearchMessages:output_type -> anychat.message.SearchMessagesResponse
-	36, // 60: anychat.message.MessageService.SendTyping:output_type -> anychat.common.Empty
-	44, // [44:61] is the sub-list for method output_type
-	27, // [27:44] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	0,  // 8: anychat.message.SendSystemMessageRequest.conversation_type:type_name -> anychat.message.ConversationType
+	2,  // 9: anychat.message.SendSystemMessageRequest.event:type_name -> anychat.message.SystemEvent
+	40, // 10: anychat.message.SendSystemMessageRequest.params:type_name -> anychat.message.SendSystemMessageRequest.ParamsEntry
+	41, // 11: anychat.message.SendMessageResponse.timestamp:type_name -> google.protobuf.Timestamp
+	4,  // 12: anychat.message.GetMessagesResponse.messages:type_name -> anychat.message.Message
+	4,  // 13: anychat.message.GetMessagesBeforeResponse.anchor_message:type_name -> anychat.message.Message
+	4,  // 14: anychat.message.GetMessagesBeforeResponse.messages:type_name -> anychat.message.Message
+	4,  // 15: anychat.message.GetMessagesAfterResponse.anchor_message:type_name -> anychat.message.Message
+	4,  // 16: anychat.message.GetMessagesAfterResponse.messages:type_name -> anychat.message.Message
+	4,  // 17: anychat.message.GetMessagesAroundAnchorResponse.anchor_message:type_name -> anychat.message.Message
+	4,  // 18: anychat.message.GetMessagesAroundAnchorResponse.before_messages:type_name -> anychat.message.Message
+	4,  // 19: anychat.message.GetMessagesAroundAnchorResponse.after_messages:type_name -> anychat.message.Message
+	4,  // 20: anychat.message.GetFirstUnreadAnchorResponse.anchor_message:type_name -> anychat.message.Message
+	4,  // 21: anychat.message.GetFirstUnreadAnchorResponse.before_messages:type_name -> anychat.message.Message
+	4,  // 22: anychat.message.GetFirstUnreadAnchorResponse.after_messages:type_name -> anychat.message.Message
+	24, // 23: anychat.message.AckReadTriggersRequest.events:type_name -> anychat.message.ReadTriggerEvent
+	4,  // 24: anychat.message.GetUnreadCountResponse.last_message:type_name -> anychat.message.Message
+	41, // 25: anychat.message.ReadReceipt.read_at:type_name -> google.protobuf.Timestamp
+	42, // 26: anychat.message.ReadReceipt.user_info:type_name -> anychat.common.UserInfo
+	30, // 27: anychat.message.GetReadReceiptsResponse.receipts:type_name -> anychat.message.ReadReceipt
+	1,  // 28: anychat.message.SearchMessagesRequest.content_type:type_name -> anychat.message.ContentType
+	4,  // 29: anychat.message.SearchMessagesResponse.messages:type_name -> anychat.message.Message
+	3,  // 30: anychat.message.ListConversationMediaRequest.categories:type_name -> anychat.message.MediaCategory
+	3,  // 31: anychat.message.ConversationMedia.category:type_name -> anychat.message.MediaCategory
+	41, // 32: anychat.message.ConversationMedia.created_at:type_name -> google.protobuf.Timestamp
+	37, // 33: anychat.message.ListConversationMediaResponse.items:type_name -> anychat.message.ConversationMedia
+	5,  // 34: anychat.message.MessageService.SendMessage:input_type -> anychat.message.SendMessageRequest
+	6,  // 35: anychat.message.MessageService.SendSystemMessage:input_type -> anychat.message.SendSystemMessageRequest
+	8,  // 36: anychat.message.MessageService.GetMessages:input_type -> anychat.message.GetMessagesRequest
+	10, // 37: anychat.message.MessageService.GetMessagesBefore:input_type -> anychat.message.GetMessagesBeforeRequest
+	12, // 38: anychat.message.MessageService.GetMessagesAfter:input_type -> anychat.message.GetMessagesAfterRequest
+	14, // 39: anychat.message.MessageService.GetMessagesAroundAnchor:input_type -> anychat.message.GetMessagesAroundAnchorRequest
+	16, // 40: anychat.message.MessageService.GetFirstUnreadAnchor:input_type -> anychat.message.GetFirstUnreadAnchorRequest
+	18, // 41: anychat.message.MessageService.GetMessageById:input_type -> anychat.message.GetMessageByIdRequest
+	19, // 42: anychat.message.MessageService.RecallMessage:input_type -> anychat.message.RecallMessageRequest
+	20, // 43: anychat.message.MessageService.DeleteMessage:input_type -> anychat.message.DeleteMessageRequest
+	21, // 44: anychat.message.MessageService.MarkAsRead:input_type -> anychat.message.MarkAsReadRequest
+	22, // 45: anychat.message.MessageService.MarkMessagesRead:input_type -> anychat.message.MarkMessagesReadRequest
+	25, // 46: anychat.message.MessageService.AckReadTriggers:input_type -> anychat.message.AckReadTriggersRequest
+	27, // 47: anychat.message.MessageService.GetUnreadCount:input_type -> anychat.message.GetUnreadCountRequest
+	29, // 48: anychat.message.MessageService.GetReadReceipts:input_type -> anychat.message.GetReadReceiptsRequest
+	32, // 49: anychat.message.MessageService.GetConversationSequence:input_type -> anychat.message.GetConversationSequenceRequest
+	34, // 50: anychat.message.MessageService.SearchMessages:input_type -> anychat.message.SearchMessagesRequest
+	36, // 51: anychat.message.MessageService.ListConversationMedia:input_type -> anychat.message.ListConversationMediaRequest
+	39, // 52: anychat.message.MessageService.SendTyping:input_type -> anychat.message.SendTypingRequest
+	43, // 53: anychat.message.MessageService.EraseUserData:input_type -> anychat.common.EraseUserDataRequest
+	7,  // 54: anychat.message.MessageService.SendMessage:output_type -> anychat.message.SendMessageResponse
+	7,  // 55: anychat.message.MessageService.SendSystemMessage:output_type -> anychat.message.SendMessageResponse
+	9,  // 56: anychat.message.MessageService.GetMessages:output_type -> anychat.message.GetMessagesResponse
+	11, // 57: anychat.message.MessageService.GetMessagesBefore:output_type -> anychat.message.GetMessagesBeforeResponse
+	13, // 58: anychat.message.MessageService.GetMessagesAfter:output_type -> anychat.message.GetMessagesAfterResponse
+	15, // 59: anychat.message.MessageService.GetMessagesAroundAnchor:output_type -> anychat.message.GetMessagesAroundAnchorResponse
+	17, // 60: anychat.message.MessageService.GetFirstUnreadAnchor:output_type -> anychat.message.GetFirstUnreadAnchorResponse
+	4,  // 61: anychat.message.MessageService.GetMessageById:output_type -> anychat.message.Message
+	44, // 62: anychat.message.MessageService.RecallMessage:output_type -> anychat.common.Empty
+	44, // 63: anychat.message.MessageService.DeleteMessage:output_type -> anychat.common.Empty
+	44, // 64: anychat.message.MessageService.MarkAsRead:output_type -> anychat.common.Empty
+	23, // 65: anychat.message.MessageService.MarkMessagesRead:output_type -> anychat.message.MarkMessagesReadResponse
+	26, // 66: anychat.message.MessageService.AckReadTriggers:output_type -> anychat.message.AckReadTriggersResponse
+	28, // 67: anychat.message.MessageService.GetUnreadCount:output_type -> anychat.message.GetUnreadCountResponse
+	31, // 68: anychat.message.MessageService.GetReadReceipts:output_type -> anychat.message.GetReadReceiptsResponse
+	33, // 69: anychat.message.MessageService.GetConversationSequence:output_type -> anychat.message.GetConversationSequenceResponse
+	35, // 70: anychat.message.MessageService.SearchMessages:output_type -> anychat.message.SearchMessagesResponse
+	38, // 71: anychat.message.MessageService.ListConversationMedia:output_type -> anychat.message.ListConversationMediaResponse
+	44, // 72: anychat.message.MessageService.SendTyping:output_type -> anychat.common.Empty
+	45, // 73: anychat.message.MessageService.EraseUserData:output_type -> anychat.common.EraseUserDataResponse
+	54, // [54:74] is the sub-list for method output_type
+	34, // [34:54] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_message_message_proto_init() }
@@ -2568,24 +3153,26 @@ func file_message_message_proto_init() {
 	}
 	file_message_message_proto_msgTypes[0].OneofWrappers = []any{}
 	file_message_message_proto_msgTypes[1].OneofWrappers = []any{}
-	file_message_message_proto_msgTypes[3].OneofWrappers = []any{}
-	file_message_message_proto_msgTypes[9].OneofWrappers = []any{}
-	file_message_message_proto_msgTypes[11].OneofWrappers = []any{}
-	file_message_message_proto_msgTypes[16].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[4].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[10].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[12].OneofWrappers = []any{}
 	file_message_message_proto_msgTypes[17].OneofWrappers = []any{}
-	file_message_message_proto_msgTypes[19].OneofWrappers = []any{}
-	file_message_message_proto_msgTypes[22].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[18].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[20].OneofWrappers = []any{}
 	file_message_message_proto_msgTypes[23].OneofWrappers = []any{}
-	file_message_message_proto_msgTypes[25].OneofWrappers = []any{}
-	file_message_message_proto_msgTypes[29].OneofWrappers = []any{}
-	file_message_message_proto_msgTypes[31].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[24].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[26].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[30].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[32].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[33].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_message_message_proto_rawDesc), len(file_message_message_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   32,
+			NumEnums:      4,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
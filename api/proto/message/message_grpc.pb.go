@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	MessageService_SendMessage_FullMethodName             = "/anychat.message.MessageService/SendMessage"
+	MessageService_SendSystemMessage_FullMethodName       = "/anychat.message.MessageService/SendSystemMessage"
 	MessageService_GetMessages_FullMethodName             = "/anychat.message.MessageService/GetMessages"
 	MessageService_GetMessagesBefore_FullMethodName       = "/anychat.message.MessageService/GetMessagesBefore"
 	MessageService_GetMessagesAfter_FullMethodName        = "/anychat.message.MessageService/GetMessagesAfter"
@@ -36,49 +37,57 @@ const (
 	MessageService_GetReadReceipts_FullMethodName         = "/anychat.message.MessageService/GetReadReceipts"
 	MessageService_GetConversationSequence_FullMethodName = "/anychat.message.MessageService/GetConversationSequence"
 	MessageService_SearchMessages_FullMethodName          = "/anychat.message.MessageService/SearchMessages"
+	MessageService_ListConversationMedia_FullMethodName   = "/anychat.message.MessageService/ListConversationMedia"
 	MessageService_SendTyping_FullMethodName              = "/anychat.message.MessageService/SendTyping"
+	MessageService_EraseUserData_FullMethodName           = "/anychat.message.MessageService/EraseUserData"
 )
 
 // MessageServiceClient is the client API for MessageService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// MessageService 消息服务
+// MessageService message service
 type MessageServiceClient interface {
-	// SendMessage 发送消息（单聊/群聊）
+	// SendMessage send message (single/group chat)
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
-	// GetMessages 获取消息列表（历史消息）
+	// SendSystemMessage store a system notice without a human sender (called by other services)
+	SendSystemMessage(ctx context.Context, in *SendSystemMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	// GetMessages get message list (history messages)
 	GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
-	// GetMessagesBefore 获取锚点消息前的历史消息
+	// GetMessagesBefore get history messages before anchor message
 	GetMessagesBefore(ctx context.Context, in *GetMessagesBeforeRequest, opts ...grpc.CallOption) (*GetMessagesBeforeResponse, error)
-	// GetMessagesAfter 获取锚点消息后的消息
+	// GetMessagesAfter get messages after anchor message
 	GetMessagesAfter(ctx context.Context, in *GetMessagesAfterRequest, opts ...grpc.CallOption) (*GetMessagesAfterResponse, error)
-	// GetMessagesAroundAnchor 获取锚点消息前后窗口
+	// GetMessagesAroundAnchor get window around anchor message
 	GetMessagesAroundAnchor(ctx context.Context, in *GetMessagesAroundAnchorRequest, opts ...grpc.CallOption) (*GetMessagesAroundAnchorResponse, error)
-	// GetFirstUnreadAnchor 获取第一条未读消息锚点
+	// GetFirstUnreadAnchor get first unread message anchor
 	GetFirstUnreadAnchor(ctx context.Context, in *GetFirstUnreadAnchorRequest, opts ...grpc.CallOption) (*GetFirstUnreadAnchorResponse, error)
-	// GetMessageById 根据ID获取消息
+	// GetMessageById get message by ID
 	GetMessageById(ctx context.Context, in *GetMessageByIdRequest, opts ...grpc.CallOption) (*Message, error)
-	// RecallMessage 撤回消息
+	// RecallMessage recall message
 	RecallMessage(ctx context.Context, in *RecallMessageRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// DeleteMessage 删除消息
+	// DeleteMessage delete message
 	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// MarkAsRead 标记消息已读
+	// MarkAsRead mark message as read
 	MarkAsRead(ctx context.Context, in *MarkAsReadRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// MarkMessagesRead 按消息ID批量标记已读
+	// MarkMessagesRead batch mark as read by message IDs
 	MarkMessagesRead(ctx context.Context, in *MarkMessagesReadRequest, opts ...grpc.CallOption) (*MarkMessagesReadResponse, error)
-	// AckReadTriggers 阅后即焚阅读触发回执
+	// AckReadTriggers burn-after-reading trigger acknowledgment
 	AckReadTriggers(ctx context.Context, in *AckReadTriggersRequest, opts ...grpc.CallOption) (*AckReadTriggersResponse, error)
-	// GetUnreadCount 获取未读消息数
+	// GetUnreadCount get unread message count
 	GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error)
-	// GetReadReceipts 获取已读回执信息
+	// GetReadReceipts get read receipt info
 	GetReadReceipts(ctx context.Context, in *GetReadReceiptsRequest, opts ...grpc.CallOption) (*GetReadReceiptsResponse, error)
-	// GetConversationSequence 获取会话当前序列号
+	// GetConversationSequence get current conversation sequence
 	GetConversationSequence(ctx context.Context, in *GetConversationSequenceRequest, opts ...grpc.CallOption) (*GetConversationSequenceResponse, error)
-	// SearchMessages 搜索消息
+	// SearchMessages search messages
 	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
-	// SendTyping 发送正在输入状态（单聊）
+	// ListConversationMedia list images, videos, files and links shared in a conversation
+	ListConversationMedia(ctx context.Context, in *ListConversationMediaRequest, opts ...grpc.CallOption) (*ListConversationMediaResponse, error)
+	// SendTyping send typing status (single chat)
 	SendTyping(ctx context.Context, in *SendTypingRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error)
 }
 
 type messageServiceClient struct {
@@ -99,6 +108,16 @@ func (c *messageServiceClient) SendMessage(ctx context.Context, in *SendMessageR
 	return out, nil
 }
 
+func (c *messageServiceClient) SendSystemMessage(ctx context.Context, in *SendSystemMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_SendSystemMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *messageServiceClient) GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMessagesResponse)
@@ -249,6 +268,16 @@ func (c *messageServiceClient) SearchMessages(ctx context.Context, in *SearchMes
 	return out, nil
 }
 
+func (c *messageServiceClient) ListConversationMedia(ctx context.Context, in *ListConversationMediaRequest, opts ...grpc.CallOption) (*ListConversationMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationMediaResponse)
+	err := c.cc.Invoke(ctx, MessageService_ListConversationMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *messageServiceClient) SendTyping(ctx context.Context, in *SendTypingRequest, opts ...grpc.CallOption) (*common.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(common.Empty)
@@ -259,46 +288,62 @@ func (c *messageServiceClient) SendTyping(ctx context.Context, in *SendTypingReq
 	return out, nil
 }
 
+func (c *messageServiceClient) EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.EraseUserDataResponse)
+	err := c.cc.Invoke(ctx, MessageService_EraseUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility.
 //
-// MessageService 消息服务
+// MessageService message service
 type MessageServiceServer interface {
-	// SendMessage 发送消息（单聊/群聊）
+	// SendMessage send message (single/group chat)
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
-	// GetMessages 获取消息列表（历史消息）
+	// SendSystemMessage store a system notice without a human sender (called by other services)
+	SendSystemMessage(context.Context, *SendSystemMessageRequest) (*SendMessageResponse, error)
+	// GetMessages get message list (history messages)
 	GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error)
-	// GetMessagesBefore 获取锚点消息前的历史消息
+	// GetMessagesBefore get history messages before anchor message
 	GetMessagesBefore(context.Context, *GetMessagesBeforeRequest) (*GetMessagesBeforeResponse, error)
-	// GetMessagesAfter 获取锚点消息后的消息
+	// GetMessagesAfter get messages after anchor message
 	GetMessagesAfter(context.Context, *GetMessagesAfterRequest) (*GetMessagesAfterResponse, error)
-	// GetMessagesAroundAnchor 获取锚点消息前后窗口
+	// GetMessagesAroundAnchor get window around anchor message
 	GetMessagesAroundAnchor(context.Context, *GetMessagesAroundAnchorRequest) (*GetMessagesAroundAnchorResponse, error)
-	// GetFirstUnreadAnchor 获取第一条未读消息锚点
+	// GetFirstUnreadAnchor get first unread message anchor
 	GetFirstUnreadAnchor(context.Context, *GetFirstUnreadAnchorRequest) (*GetFirstUnreadAnchorResponse, error)
-	// GetMessageById 根据ID获取消息
+	// GetMessageById get message by ID
 	GetMessageById(context.Context, *GetMessageByIdRequest) (*Message, error)
-	// RecallMessage 撤回消息
+	// RecallMessage recall message
 	RecallMessage(context.Context, *RecallMessageRequest) (*common.Empty, error)
-	// DeleteMessage 删除消息
+	// DeleteMessage delete message
 	DeleteMessage(context.Context, *DeleteMessageRequest) (*common.Empty, error)
-	// MarkAsRead 标记消息已读
+	// MarkAsRead mark message as read
 	MarkAsRead(context.Context, *MarkAsReadRequest) (*common.Empty, error)
-	// MarkMessagesRead 按消息ID批量标记已读
+	// MarkMessagesRead batch mark as read by message IDs
 	MarkMessagesRead(context.Context, *MarkMessagesReadRequest) (*MarkMessagesReadResponse, error)
-	// AckReadTriggers 阅后即焚阅读触发回执
+	// AckReadTriggers burn-after-reading trigger acknowledgment
 	AckReadTriggers(context.Context, *AckReadTriggersRequest) (*AckReadTriggersResponse, error)
-	// GetUnreadCount 获取未读消息数
+	// GetUnreadCount get unread message count
 	GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error)
-	// GetReadReceipts 获取已读回执信息
+	// GetReadReceipts get read receipt info
 	GetReadReceipts(context.Context, *GetReadReceiptsRequest) (*GetReadReceiptsResponse, error)
-	// GetConversationSequence 获取会话当前序列号
+	// GetConversationSequence get current conversation sequence
 	GetConversationSequence(context.Context, *GetConversationSequenceRequest) (*GetConversationSequenceResponse, error)
-	// SearchMessages 搜索消息
+	// SearchMessages search messages
 	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
-	// SendTyping 发送正在输入状态（单聊）
+	// ListConversationMedia list images, videos, files and links shared in a conversation
+	ListConversationMedia(context.Context, *ListConversationMediaRequest) (*ListConversationMediaResponse, error)
+	// SendTyping send typing status (single chat)
 	SendTyping(context.Context, *SendTypingRequest) (*common.Empty, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -312,6 +357,9 @@ type UnimplementedMessageServiceServer struct{}
 func (UnimplementedMessageServiceServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (UnimplementedMessageServiceServer) SendSystemMessage(context.Context, *SendSystemMessageRequest) (*SendMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendSystemMessage not implemented")
+}
 func (UnimplementedMessageServiceServer) GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMessages not implemented")
 }
@@ -357,9 +405,15 @@ func (UnimplementedMessageServiceServer) GetConversationSequence(context.Context
 func (UnimplementedMessageServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SearchMessages not implemented")
 }
+func (UnimplementedMessageServiceServer) ListConversationMedia(context.Context, *ListConversationMediaRequest) (*ListConversationMediaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConversationMedia not implemented")
+}
 func (UnimplementedMessageServiceServer) SendTyping(context.Context, *SendTypingRequest) (*common.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendTyping not implemented")
 }
+func (UnimplementedMessageServiceServer) EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EraseUserData not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 func (UnimplementedMessageServiceServer) testEmbeddedByValue()                        {}
 
@@ -399,6 +453,24 @@ func _MessageService_SendMessage_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_SendSystemMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendSystemMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).SendSystemMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_SendSystemMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).SendSystemMessage(ctx, req.(*SendSystemMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MessageService_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMessagesRequest)
 	if err := dec(in); err != nil {
@@ -669,6 +741,24 @@ func _MessageService_SearchMessages_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_ListConversationMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ListConversationMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ListConversationMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ListConversationMedia(ctx, req.(*ListConversationMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MessageService_SendTyping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendTypingRequest)
 	if err := dec(in); err != nil {
@@ -687,6 +777,24 @@ func _MessageService_SendTyping_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).EraseUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_EraseUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).EraseUserData(ctx, req.(*common.EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -698,6 +806,10 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessage",
 			Handler:    _MessageService_SendMessage_Handler,
 		},
+		{
+			MethodName: "SendSystemMessage",
+			Handler:    _MessageService_SendSystemMessage_Handler,
+		},
 		{
 			MethodName: "GetMessages",
 			Handler:    _MessageService_GetMessages_Handler,
@@ -758,10 +870,18 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchMessages",
 			Handler:    _MessageService_SearchMessages_Handler,
 		},
+		{
+			MethodName: "ListConversationMedia",
+			Handler:    _MessageService_ListConversationMedia_Handler,
+		},
 		{
 			MethodName: "SendTyping",
 			Handler:    _MessageService_SendTyping_Handler,
 		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _MessageService_EraseUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message/message.proto",
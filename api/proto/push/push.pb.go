@@ -21,7 +21,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// PushType 推送类型
+// PushType push type
 type PushType int32
 
 const (
@@ -31,6 +31,7 @@ const (
 	PushType_PUSH_TYPE_FRIEND_REQUEST  PushType = 3
 	PushType_PUSH_TYPE_GROUP_INVITED   PushType = 4
 	PushType_PUSH_TYPE_CALL_INVITE     PushType = 5
+	PushType_PUSH_TYPE_CALL_ENDED      PushType = 6
 )
 
 // Enum value maps for PushType.
@@ -42,6 +43,7 @@ var (
 		3: "PUSH_TYPE_FRIEND_REQUEST",
 		4: "PUSH_TYPE_GROUP_INVITED",
 		5: "PUSH_TYPE_CALL_INVITE",
+		6: "PUSH_TYPE_CALL_ENDED",
 	}
 	PushType_value = map[string]int32{
 		"PUSH_TYPE_UNSPECIFIED":     0,
@@ -50,6 +52,7 @@ var (
 		"PUSH_TYPE_FRIEND_REQUEST":  3,
 		"PUSH_TYPE_GROUP_INVITED":   4,
 		"PUSH_TYPE_CALL_INVITE":     5,
+		"PUSH_TYPE_CALL_ENDED":      6,
 	}
 )
 
@@ -80,18 +83,74 @@ func (PushType) EnumDescriptor() ([]byte, []int) {
 	return file_push_push_proto_rawDescGZIP(), []int{0}
 }
 
-// SendPushRequest 推送请求
+// PushStatus push delivery status
+type PushStatus int32
+
+const (
+	PushStatus_PUSH_STATUS_UNSPECIFIED PushStatus = 0
+	PushStatus_PUSH_STATUS_PENDING     PushStatus = 1
+	PushStatus_PUSH_STATUS_SENT        PushStatus = 2
+	PushStatus_PUSH_STATUS_FAILED      PushStatus = 3
+	PushStatus_PUSH_STATUS_SKIPPED     PushStatus = 4 // user has no registered push device
+)
+
+// Enum value maps for PushStatus.
+var (
+	PushStatus_name = map[int32]string{
+		0: "PUSH_STATUS_UNSPECIFIED",
+		1: "PUSH_STATUS_PENDING",
+		2: "PUSH_STATUS_SENT",
+		3: "PUSH_STATUS_FAILED",
+		4: "PUSH_STATUS_SKIPPED",
+	}
+	PushStatus_value = map[string]int32{
+		"PUSH_STATUS_UNSPECIFIED": 0,
+		"PUSH_STATUS_PENDING":     1,
+		"PUSH_STATUS_SENT":        2,
+		"PUSH_STATUS_FAILED":      3,
+		"PUSH_STATUS_SKIPPED":     4,
+	}
+)
+
+func (x PushStatus) Enum() *PushStatus {
+	p := new(PushStatus)
+	*p = x
+	return p
+}
+
+func (x PushStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PushStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_push_push_proto_enumTypes[1].Descriptor()
+}
+
+func (PushStatus) Type() protoreflect.EnumType {
+	return &file_push_push_proto_enumTypes[1]
+}
+
+func (x PushStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PushStatus.Descriptor instead.
+func (PushStatus) EnumDescriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{1}
+}
+
+// SendPushRequest push request
 type SendPushRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 目标用户 ID 列表
+	// target user ID list
 	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
-	// 推送标题
+	// push title
 	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// 推送正文
+	// push body
 	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	// 推送类型
+	// push type
 	PushType PushType `protobuf:"varint,4,opt,name=push_type,json=pushType,proto3,enum=anychat.push.PushType" json:"push_type,omitempty"`
-	// 附加数据（键值对），客户端根据业务处理
+	// additional data (key-value pairs), handled by client business logic
 	Extras        map[string]string `protobuf:"bytes,5,rep,name=extras,proto3" json:"extras,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -162,14 +221,14 @@ func (x *SendPushRequest) GetExtras() map[string]string {
 	return nil
 }
 
-// SendPushResponse 推送响应
+// SendPushResponse push response
 type SendPushResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 成功推送的设备数
+	// number of devices successfully pushed
 	SuccessCount int32 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
-	// 失败推送的设备数
+	// number of devices failed to push
 	FailureCount int32 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
-	// 极光推送消息 ID
+	// JPush message ID
 	MsgId         string `protobuf:"bytes,3,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -226,6 +285,624 @@ func (x *SendPushResponse) GetMsgId() string {
 	return ""
 }
 
+// PushLog push log record
+type PushLog struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PushType     PushType               `protobuf:"varint,3,opt,name=push_type,json=pushType,proto3,enum=anychat.push.PushType" json:"push_type,omitempty"`
+	Title        string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content      string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	TargetCount  int32                  `protobuf:"varint,6,opt,name=target_count,json=targetCount,proto3" json:"target_count,omitempty"`
+	SuccessCount int32                  `protobuf:"varint,7,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount int32                  `protobuf:"varint,8,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	JpushMsgId   string                 `protobuf:"bytes,9,opt,name=jpush_msg_id,json=jpushMsgId,proto3" json:"jpush_msg_id,omitempty"`
+	Status       PushStatus             `protobuf:"varint,10,opt,name=status,proto3,enum=anychat.push.PushStatus" json:"status,omitempty"`
+	// JPush error code, 0 if none
+	ErrorCode     int32  `protobuf:"varint,11,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMsg      string `protobuf:"bytes,12,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushLog) Reset() {
+	*x = PushLog{}
+	mi := &file_push_push_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushLog) ProtoMessage() {}
+
+func (x *PushLog) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushLog.ProtoReflect.Descriptor instead.
+func (*PushLog) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PushLog) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PushLog) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PushLog) GetPushType() PushType {
+	if x != nil {
+		return x.PushType
+	}
+	return PushType_PUSH_TYPE_UNSPECIFIED
+}
+
+func (x *PushLog) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PushLog) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PushLog) GetTargetCount() int32 {
+	if x != nil {
+		return x.TargetCount
+	}
+	return 0
+}
+
+func (x *PushLog) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *PushLog) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *PushLog) GetJpushMsgId() string {
+	if x != nil {
+		return x.JpushMsgId
+	}
+	return ""
+}
+
+func (x *PushLog) GetStatus() PushStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PushStatus_PUSH_STATUS_UNSPECIFIED
+}
+
+func (x *PushLog) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *PushLog) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *PushLog) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// ListPushLogsRequest list push logs request
+type ListPushLogsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	PushType *PushType              `protobuf:"varint,2,opt,name=push_type,json=pushType,proto3,enum=anychat.push.PushType,oneof" json:"push_type,omitempty"`
+	Status   *PushStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=anychat.push.PushStatus,oneof" json:"status,omitempty"`
+	// time range (unix seconds), start inclusive, end exclusive
+	StartTime     *int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	Page          int32  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPushLogsRequest) Reset() {
+	*x = ListPushLogsRequest{}
+	mi := &file_push_push_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPushLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPushLogsRequest) ProtoMessage() {}
+
+func (x *ListPushLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPushLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListPushLogsRequest) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPushLogsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ListPushLogsRequest) GetPushType() PushType {
+	if x != nil && x.PushType != nil {
+		return *x.PushType
+	}
+	return PushType_PUSH_TYPE_UNSPECIFIED
+}
+
+func (x *ListPushLogsRequest) GetStatus() PushStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return PushStatus_PUSH_STATUS_UNSPECIFIED
+}
+
+func (x *ListPushLogsRequest) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *ListPushLogsRequest) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return 0
+}
+
+func (x *ListPushLogsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPushLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListPushLogsResponse list push logs response
+type ListPushLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*PushLog             `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPushLogsResponse) Reset() {
+	*x = ListPushLogsResponse{}
+	mi := &file_push_push_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPushLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPushLogsResponse) ProtoMessage() {}
+
+func (x *ListPushLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPushLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListPushLogsResponse) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPushLogsResponse) GetLogs() []*PushLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListPushLogsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListPushLogsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPushLogsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// GetPushStatsRequest push stats request
+type GetPushStatsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	PushType *PushType              `protobuf:"varint,2,opt,name=push_type,json=pushType,proto3,enum=anychat.push.PushType,oneof" json:"push_type,omitempty"`
+	// time range (unix seconds), defaults to the last 7 days, at most 90 days
+	StartTime     *int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPushStatsRequest) Reset() {
+	*x = GetPushStatsRequest{}
+	mi := &file_push_push_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushStatsRequest) ProtoMessage() {}
+
+func (x *GetPushStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPushStatsRequest) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPushStatsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *GetPushStatsRequest) GetPushType() PushType {
+	if x != nil && x.PushType != nil {
+		return *x.PushType
+	}
+	return PushType_PUSH_TYPE_UNSPECIFIED
+}
+
+func (x *GetPushStatsRequest) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *GetPushStatsRequest) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return 0
+}
+
+// PushDailyStat push results of one type on one day
+type PushDailyStat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// date, format YYYY-MM-DD
+	Date     string   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	PushType PushType `protobuf:"varint,2,opt,name=push_type,json=pushType,proto3,enum=anychat.push.PushType" json:"push_type,omitempty"`
+	// number of push requests
+	PushCount    int64 `protobuf:"varint,3,opt,name=push_count,json=pushCount,proto3" json:"push_count,omitempty"`
+	SentCount    int64 `protobuf:"varint,4,opt,name=sent_count,json=sentCount,proto3" json:"sent_count,omitempty"`
+	FailedCount  int64 `protobuf:"varint,5,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	SkippedCount int64 `protobuf:"varint,6,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	// number of target devices
+	TargetCount   int64 `protobuf:"varint,7,opt,name=target_count,json=targetCount,proto3" json:"target_count,omitempty"`
+	SuccessCount  int64 `protobuf:"varint,8,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount  int64 `protobuf:"varint,9,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushDailyStat) Reset() {
+	*x = PushDailyStat{}
+	mi := &file_push_push_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushDailyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDailyStat) ProtoMessage() {}
+
+func (x *PushDailyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDailyStat.ProtoReflect.Descriptor instead.
+func (*PushDailyStat) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PushDailyStat) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PushDailyStat) GetPushType() PushType {
+	if x != nil {
+		return x.PushType
+	}
+	return PushType_PUSH_TYPE_UNSPECIFIED
+}
+
+func (x *PushDailyStat) GetPushCount() int64 {
+	if x != nil {
+		return x.PushCount
+	}
+	return 0
+}
+
+func (x *PushDailyStat) GetSentCount() int64 {
+	if x != nil {
+		return x.SentCount
+	}
+	return 0
+}
+
+func (x *PushDailyStat) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *PushDailyStat) GetSkippedCount() int64 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *PushDailyStat) GetTargetCount() int64 {
+	if x != nil {
+		return x.TargetCount
+	}
+	return 0
+}
+
+func (x *PushDailyStat) GetSuccessCount() int64 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *PushDailyStat) GetFailureCount() int64 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+// PushErrorStat failed pushes grouped by provider error
+type PushErrorStat struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ErrorCode int32                  `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// sample error message
+	ErrorMsg      string `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Count         int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushErrorStat) Reset() {
+	*x = PushErrorStat{}
+	mi := &file_push_push_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushErrorStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushErrorStat) ProtoMessage() {}
+
+func (x *PushErrorStat) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushErrorStat.ProtoReflect.Descriptor instead.
+func (*PushErrorStat) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PushErrorStat) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *PushErrorStat) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *PushErrorStat) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetPushStatsResponse push stats response
+type GetPushStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DailyStats    []*PushDailyStat       `protobuf:"bytes,1,rep,name=daily_stats,json=dailyStats,proto3" json:"daily_stats,omitempty"`
+	ErrorStats    []*PushErrorStat       `protobuf:"bytes,2,rep,name=error_stats,json=errorStats,proto3" json:"error_stats,omitempty"`
+	StartTime     int64                  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       int64                  `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPushStatsResponse) Reset() {
+	*x = GetPushStatsResponse{}
+	mi := &file_push_push_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushStatsResponse) ProtoMessage() {}
+
+func (x *GetPushStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPushStatsResponse) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPushStatsResponse) GetDailyStats() []*PushDailyStat {
+	if x != nil {
+		return x.DailyStats
+	}
+	return nil
+}
+
+func (x *GetPushStatsResponse) GetErrorStats() []*PushErrorStat {
+	if x != nil {
+		return x.ErrorStats
+	}
+	return nil
+}
+
+func (x *GetPushStatsResponse) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetPushStatsResponse) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
 var File_push_push_proto protoreflect.FileDescriptor
 
 const file_push_push_proto_rawDesc = "" +
@@ -243,16 +920,102 @@ const file_push_push_proto_rawDesc = "" +
 	"\x10SendPushResponse\x12#\n" +
 	"\rsuccess_count\x18\x01 \x01(\x05R\fsuccessCount\x12#\n" +
 	"\rfailure_count\x18\x02 \x01(\x05R\ffailureCount\x12\x15\n" +
-	"\x06msg_id\x18\x03 \x01(\tR\x05msgId*\xb5\x01\n" +
+	"\x06msg_id\x18\x03 \x01(\tR\x05msgId\"\xb3\x03\n" +
+	"\aPushLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x123\n" +
+	"\tpush_type\x18\x03 \x01(\x0e2\x16.anychat.push.PushTypeR\bpushType\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12!\n" +
+	"\ftarget_count\x18\x06 \x01(\x05R\vtargetCount\x12#\n" +
+	"\rsuccess_count\x18\a \x01(\x05R\fsuccessCount\x12#\n" +
+	"\rfailure_count\x18\b \x01(\x05R\ffailureCount\x12 \n" +
+	"\fjpush_msg_id\x18\t \x01(\tR\n" +
+	"jpushMsgId\x120\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x18.anychat.push.PushStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\v \x01(\x05R\terrorCode\x12\x1b\n" +
+	"\terror_msg\x18\f \x01(\tR\berrorMsg\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\"\xda\x02\n" +
+	"\x13ListPushLogsRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01\x128\n" +
+	"\tpush_type\x18\x02 \x01(\x0e2\x16.anychat.push.PushTypeH\x01R\bpushType\x88\x01\x01\x125\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.anychat.push.PushStatusH\x02R\x06status\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\x03H\x03R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x05 \x01(\x03H\x04R\aendTime\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSizeB\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_push_typeB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"\x88\x01\n" +
+	"\x14ListPushLogsResponse\x12)\n" +
+	"\x04logs\x18\x01 \x03(\v2\x15.anychat.push.PushLogR\x04logs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe7\x01\n" +
+	"\x13GetPushStatsRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01\x128\n" +
+	"\tpush_type\x18\x02 \x01(\x0e2\x16.anychat.push.PushTypeH\x01R\bpushType\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\x03H\x02R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x04 \x01(\x03H\x03R\aendTime\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_push_typeB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"\xcb\x02\n" +
+	"\rPushDailyStat\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x123\n" +
+	"\tpush_type\x18\x02 \x01(\x0e2\x16.anychat.push.PushTypeR\bpushType\x12\x1d\n" +
+	"\n" +
+	"push_count\x18\x03 \x01(\x03R\tpushCount\x12\x1d\n" +
+	"\n" +
+	"sent_count\x18\x04 \x01(\x03R\tsentCount\x12!\n" +
+	"\ffailed_count\x18\x05 \x01(\x03R\vfailedCount\x12#\n" +
+	"\rskipped_count\x18\x06 \x01(\x03R\fskippedCount\x12!\n" +
+	"\ftarget_count\x18\a \x01(\x03R\vtargetCount\x12#\n" +
+	"\rsuccess_count\x18\b \x01(\x03R\fsuccessCount\x12#\n" +
+	"\rfailure_count\x18\t \x01(\x03R\ffailureCount\"a\n" +
+	"\rPushErrorStat\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x01 \x01(\x05R\terrorCode\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\xcc\x01\n" +
+	"\x14GetPushStatsResponse\x12<\n" +
+	"\vdaily_stats\x18\x01 \x03(\v2\x1b.anychat.push.PushDailyStatR\n" +
+	"dailyStats\x12<\n" +
+	"\verror_stats\x18\x02 \x03(\v2\x1b.anychat.push.PushErrorStatR\n" +
+	"errorStats\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\x03R\aendTime*\xcf\x01\n" +
 	"\bPushType\x12\x19\n" +
 	"\x15PUSH_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15PUSH_TYPE_MESSAGE_NEW\x10\x01\x12\x1d\n" +
 	"\x19PUSH_TYPE_MESSAGE_MENTION\x10\x02\x12\x1c\n" +
 	"\x18PUSH_TYPE_FRIEND_REQUEST\x10\x03\x12\x1b\n" +
 	"\x17PUSH_TYPE_GROUP_INVITED\x10\x04\x12\x19\n" +
-	"\x15PUSH_TYPE_CALL_INVITE\x10\x052X\n" +
+	"\x15PUSH_TYPE_CALL_INVITE\x10\x05\x12\x18\n" +
+	"\x14PUSH_TYPE_CALL_ENDED\x10\x06*\x89\x01\n" +
+	"\n" +
+	"PushStatus\x12\x1b\n" +
+	"\x17PUSH_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PUSH_STATUS_PENDING\x10\x01\x12\x14\n" +
+	"\x10PUSH_STATUS_SENT\x10\x02\x12\x16\n" +
+	"\x12PUSH_STATUS_FAILED\x10\x03\x12\x17\n" +
+	"\x13PUSH_STATUS_SKIPPED\x10\x042\x86\x02\n" +
 	"\vPushService\x12I\n" +
-	"\bSendPush\x12\x1d.anychat.push.SendPushRequest\x1a\x1e.anychat.push.SendPushResponseB1Z/github.com/anychat/server/api/proto/push;pushpbb\x06proto3"
+	"\bSendPush\x12\x1d.anychat.push.SendPushRequest\x1a\x1e.anychat.push.SendPushResponse\x12U\n" +
+	"\fListPushLogs\x12!.anychat.push.ListPushLogsRequest\x1a\".anychat.push.ListPushLogsResponse\x12U\n" +
+	"\fGetPushStats\x12!.anychat.push.GetPushStatsRequest\x1a\".anychat.push.GetPushStatsResponseB1Z/github.com/anychat/server/api/proto/push;pushpbb\x06proto3"
 
 var (
 	file_push_push_proto_rawDescOnce sync.Once
@@ -266,24 +1029,45 @@ func file_push_push_proto_rawDescGZIP() []byte {
 	return file_push_push_proto_rawDescData
 }
 
-var file_push_push_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_push_push_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_push_push_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_push_push_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_push_push_proto_goTypes = []any{
-	(PushType)(0),            // 0: anychat.push.PushType
-	(*SendPushRequest)(nil),  // 1: anychat.push.SendPushRequest
-	(*SendPushResponse)(nil), // 2: anychat.push.SendPushResponse
-	nil,                      // 3: anychat.push.SendPushRequest.ExtrasEntry
+	(PushType)(0),                // 0: anychat.push.PushType
+	(PushStatus)(0),              // 1: anychat.push.PushStatus
+	(*SendPushRequest)(nil),      // 2: anychat.push.SendPushRequest
+	(*SendPushResponse)(nil),     // 3: anychat.push.SendPushResponse
+	(*PushLog)(nil),              // 4: anychat.push.PushLog
+	(*ListPushLogsRequest)(nil),  // 5: anychat.push.ListPushLogsRequest
+	(*ListPushLogsResponse)(nil), // 6: anychat.push.ListPushLogsResponse
+	(*GetPushStatsRequest)(nil),  // 7: anychat.push.GetPushStatsRequest
+	(*PushDailyStat)(nil),        // 8: anychat.push.PushDailyStat
+	(*PushErrorStat)(nil),        // 9: anychat.push.PushErrorStat
+	(*GetPushStatsResponse)(nil), // 10: anychat.push.GetPushStatsResponse
+	nil,                          // 11: anychat.push.SendPushRequest.ExtrasEntry
 }
 var file_push_push_proto_depIdxs = []int32{
-	0, // 0: anychat.push.SendPushRequest.push_type:type_name -> anychat.push.PushType
-	3, // 1: anychat.push.SendPushRequest.extras:type_name -> anychat.push.SendPushRequest.ExtrasEntry
-	1, // 2: anychat.push.PushService.SendPush:input_type -> anychat.push.SendPushRequest
-	2, // 3: anychat.push.PushService.SendPush:output_type -> anychat.push.SendPushResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: anychat.push.SendPushRequest.push_type:type_name -> anychat.push.PushType
+	11, // 1: anychat.push.SendPushRequest.extras:type_name -> anychat.push.SendPushRequest.ExtrasEntry
+	0,  // 2: anychat.push.PushLog.push_type:type_name -> anychat.push.PushType
+	1,  // 3: anychat.push.PushLog.status:type_name -> anychat.push.PushStatus
+	0,  // 4: anychat.push.ListPushLogsRequest.push_type:type_name -> anychat.push.PushType
+	1,  // 5: anychat.push.ListPushLogsRequest.status:type_name -> anychat.push.PushStatus
+	4,  // 6: anychat.push.ListPushLogsResponse.logs:type_name -> anychat.push.PushLog
+	0,  // 7: anychat.push.GetPushStatsRequest.push_type:type_name -> anychat.push.PushType
+	0,  // 8: anychat.push.PushDailyStat.push_type:type_name -> anychat.push.PushType
+	8,  // 9: anychat.push.GetPushStatsResponse.daily_stats:type_name -> anychat.push.PushDailyStat
+	9,  // 10: anychat.push.GetPushStatsResponse.error_stats:type_name -> anychat.push.PushErrorStat
+	2,  // 11: anychat.push.PushService.SendPush:input_type -> anychat.push.SendPushRequest
+	5,  // 12: anychat.push.PushService.ListPushLogs:input_type -> anychat.push.ListPushLogsRequest
+	7,  // 13: anychat.push.PushService.GetPushStats:input_type -> anychat.push.GetPushStatsRequest
+	3,  // 14: anychat.push.PushService.SendPush:output_type -> anychat.push.SendPushResponse
+	6,  // 15: anychat.push.PushService.ListPushLogs:output_type -> anychat.push.ListPushLogsResponse
+	10, // 16: anychat.push.PushService.GetPushStats:output_type -> anychat.push.GetPushStatsResponse
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_push_push_proto_init() }
@@ -291,13 +1075,15 @@ func file_push_push_proto_init() {
 	if File_push_push_proto != nil {
 		return
 	}
+	file_push_push_proto_msgTypes[3].OneofWrappers = []any{}
+	file_push_push_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_push_push_proto_rawDesc), len(file_push_push_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,19 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PushService_SendPush_FullMethodName = "/anychat.push.PushService/SendPush"
+	PushService_SendPush_FullMethodName     = "/anychat.push.PushService/SendPush"
+	PushService_ListPushLogs_FullMethodName = "/anychat.push.PushService/ListPushLogs"
+	PushService_GetPushStats_FullMethodName = "/anychat.push.PushService/GetPushStats"
 )
 
 // PushServiceClient is the client API for PushService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// PushService 推送服务
-// 负责向离线用户发送 APNs / FCM 等系统推送通知（基于极光推送 JPush）
+// PushService push service
+// Sends system push notifications such as APNs/FCM to offline users (based on JPush)
 type PushServiceClient interface {
-	// SendPush 向指定用户列表发送推送通知
-	// 供其他服务直接调用，主要入口为 NATS 事件订阅
+	// SendPush send push notifications to a specified user list
+	// Called directly by other services, mainly via NATS event subscriptions
 	SendPush(ctx context.Context, in *SendPushRequest, opts ...grpc.CallOption) (*SendPushResponse, error)
+	// ListPushLogs query push logs, filtered by user, type, status and time range
+	ListPushLogs(ctx context.Context, in *ListPushLogsRequest, opts ...grpc.CallOption) (*ListPushLogsResponse, error)
+	// GetPushStats aggregate push results per type by day, with provider error breakdown
+	GetPushStats(ctx context.Context, in *GetPushStatsRequest, opts ...grpc.CallOption) (*GetPushStatsResponse, error)
 }
 
 type pushServiceClient struct {
@@ -52,16 +58,40 @@ func (c *pushServiceClient) SendPush(ctx context.Context, in *SendPushRequest, o
 	return out, nil
 }
 
+func (c *pushServiceClient) ListPushLogs(ctx context.Context, in *ListPushLogsRequest, opts ...grpc.CallOption) (*ListPushLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPushLogsResponse)
+	err := c.cc.Invoke(ctx, PushService_ListPushLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) GetPushStats(ctx context.Context, in *GetPushStatsRequest, opts ...grpc.CallOption) (*GetPushStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPushStatsResponse)
+	err := c.cc.Invoke(ctx, PushService_GetPushStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PushServiceServer is the server API for PushService service.
 // All implementations must embed UnimplementedPushServiceServer
 // for forward compatibility.
 //
-// PushService 推送服务
-// 负责向离线用户发送 APNs / FCM 等系统推送通知（基于极光推送 JPush）
+// PushService push service
+// Sends system push notifications such as APNs/FCM to offline users (based on JPush)
 type PushServiceServer interface {
-	// SendPush 向指定用户列表发送推送通知
-	// 供其他服务直接调用，主要入口为 NATS 事件订阅
+	// SendPush send push notifications to a specified user list
+	// Called directly by other services, mainly via NATS event subscriptions
 	SendPush(context.Context, *SendPushRequest) (*SendPushResponse, error)
+	// ListPushLogs query push logs, filtered by user, type, status and time range
+	ListPushLogs(context.Context, *ListPushLogsRequest) (*ListPushLogsResponse, error)
+	// GetPushStats aggregate push results per type by day, with provider error breakdown
+	GetPushStats(context.Context, *GetPushStatsRequest) (*GetPushStatsResponse, error)
 	mustEmbedUnimplementedPushServiceServer()
 }
 
@@ -75,6 +105,12 @@ type UnimplementedPushServiceServer struct{}
 func (UnimplementedPushServiceServer) SendPush(context.Context, *SendPushRequest) (*SendPushResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendPush not implemented")
 }
+func (UnimplementedPushServiceServer) ListPushLogs(context.Context, *ListPushLogsRequest) (*ListPushLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPushLogs not implemented")
+}
+func (UnimplementedPushServiceServer) GetPushStats(context.Context, *GetPushStatsRequest) (*GetPushStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPushStats not implemented")
+}
 func (UnimplementedPushServiceServer) mustEmbedUnimplementedPushServiceServer() {}
 func (UnimplementedPushServiceServer) testEmbeddedByValue()                     {}
 
@@ -114,6 +150,42 @@ func _PushService_SendPush_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PushService_ListPushLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPushLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ListPushLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ListPushLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ListPushLogs(ctx, req.(*ListPushLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_GetPushStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).GetPushStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_GetPushStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).GetPushStats(ctx, req.(*GetPushStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PushService_ServiceDesc is the grpc.ServiceDesc for PushService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -125,6 +197,14 @@ var PushService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendPush",
 			Handler:    _PushService_SendPush_Handler,
 		},
+		{
+			MethodName: "ListPushLogs",
+			Handler:    _PushService_ListPushLogs_Handler,
+		},
+		{
+			MethodName: "GetPushStats",
+			Handler:    _PushService_GetPushStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "push/push.proto",
@@ -8,9 +8,9 @@ package userpb
 
 import (
 	common "github.com/anychat/server/api/proto/common"
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -72,7 +72,7 @@ func (PushPlatform) EnumDescriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{0}
 }
 
-// GetProfileRequest 获取个人资料请求
+// GetProfileRequest get profile request
 type GetProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -117,15 +117,68 @@ func (x *GetProfileRequest) GetUserId() string {
 	return ""
 }
 
-// UpdateProfileRequest 更新个人资料请求
+// SetAvatarRequest set avatar request
+type SetAvatarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"` // image uploaded by the user through file-service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAvatarRequest) Reset() {
+	*x = SetAvatarRequest{}
+	mi := &file_user_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAvatarRequest) ProtoMessage() {}
+
+func (x *SetAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAvatarRequest.ProtoReflect.Descriptor instead.
+func (*SetAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetAvatarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetAvatarRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+// UpdateProfileRequest update profile request
 type UpdateProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Nickname      *string                `protobuf:"bytes,2,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
-	Avatar        *string                `protobuf:"bytes,3,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	Avatar        *string                `protobuf:"bytes,3,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"` // only "" to clear it, new avatars go through SetAvatar
 	Signature     *string                `protobuf:"bytes,4,opt,name=signature,proto3,oneof" json:"signature,omitempty"`
-	Gender        *int32                 `protobuf:"varint,5,opt,name=gender,proto3,oneof" json:"gender,omitempty"` // 0:未知 1:男 2:女
-	Birthday      *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
+	Gender        *int32                 `protobuf:"varint,5,opt,name=gender,proto3,oneof" json:"gender,omitempty"` // 0:unknown 1:male 2:female
+	Birthday      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
 	Region        *string                `protobuf:"bytes,7,opt,name=region,proto3,oneof" json:"region,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -133,7 +186,7 @@ type UpdateProfileRequest struct {
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_user_user_proto_msgTypes[1]
+	mi := &file_user_user_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -145,7 +198,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[1]
+	mi := &file_user_user_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,7 +211,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{1}
+	return file_user_user_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UpdateProfileRequest) GetUserId() string {
@@ -196,7 +249,7 @@ func (x *UpdateProfileRequest) GetGender() int32 {
 	return 0
 }
 
-func (x *UpdateProfileRequest) GetBirthday() *timestamp.Timestamp {
+func (x *UpdateProfileRequest) GetBirthday() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Birthday
 	}
@@ -210,7 +263,7 @@ func (x *UpdateProfileRequest) GetRegion() string {
 	return ""
 }
 
-// UserProfileResponse 用户资料响应
+// UserProfileResponse user profile response
 type UserProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -218,19 +271,19 @@ type UserProfileResponse struct {
 	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
 	Signature     string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 	Gender        int32                  `protobuf:"varint,5,opt,name=gender,proto3" json:"gender,omitempty"`
-	Birthday      *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Birthday      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=birthday,proto3" json:"birthday,omitempty"`
 	Region        string                 `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`
 	Phone         *string                `protobuf:"bytes,8,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
 	Email         *string                `protobuf:"bytes,9,opt,name=email,proto3,oneof" json:"email,omitempty"`
 	QrcodeUrl     string                 `protobuf:"bytes,10,opt,name=qrcode_url,json=qrcodeUrl,proto3" json:"qrcode_url,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserProfileResponse) Reset() {
 	*x = UserProfileResponse{}
-	mi := &file_user_user_proto_msgTypes[2]
+	mi := &file_user_user_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -242,7 +295,7 @@ func (x *UserProfileResponse) String() string {
 func (*UserProfileResponse) ProtoMessage() {}
 
 func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[2]
+	mi := &file_user_user_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,7 +308,7 @@ func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserProfileResponse.ProtoReflect.Descriptor instead.
 func (*UserProfileResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{2}
+	return file_user_user_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UserProfileResponse) GetUserId() string {
@@ -293,7 +346,7 @@ func (x *UserProfileResponse) GetGender() int32 {
 	return 0
 }
 
-func (x *UserProfileResponse) GetBirthday() *timestamp.Timestamp {
+func (x *UserProfileResponse) GetBirthday() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Birthday
 	}
@@ -328,25 +381,25 @@ func (x *UserProfileResponse) GetQrcodeUrl() string {
 	return ""
 }
 
-func (x *UserProfileResponse) GetCreatedAt() *timestamp.Timestamp {
+func (x *UserProfileResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-// GetUserInfoRequest 获取用户信息请求
+// GetUserInfoRequest get user info request
 type GetUserInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 查询者ID
-	TargetUserId  string                 `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"` // 目标用户ID
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // requester ID
+	TargetUserId  string                 `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"` // target user ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserInfoRequest) Reset() {
 	*x = GetUserInfoRequest{}
-	mi := &file_user_user_proto_msgTypes[3]
+	mi := &file_user_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +411,7 @@ func (x *GetUserInfoRequest) String() string {
 func (*GetUserInfoRequest) ProtoMessage() {}
 
 func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[3]
+	mi := &file_user_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +424,7 @@ func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetUserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{3}
+	return file_user_user_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetUserInfoRequest) GetUserId() string {
@@ -388,7 +441,7 @@ func (x *GetUserInfoRequest) GetTargetUserId() string {
 	return ""
 }
 
-// UserInfoResponse 用户信息响应
+// UserInfoResponse user info response
 type UserInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -405,7 +458,7 @@ type UserInfoResponse struct {
 
 func (x *UserInfoResponse) Reset() {
 	*x = UserInfoResponse{}
-	mi := &file_user_user_proto_msgTypes[4]
+	mi := &file_user_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -417,7 +470,7 @@ func (x *UserInfoResponse) String() string {
 func (*UserInfoResponse) ProtoMessage() {}
 
 func (x *UserInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[4]
+	mi := &file_user_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +483,7 @@ func (x *UserInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfoResponse.ProtoReflect.Descriptor instead.
 func (*UserInfoResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{4}
+	return file_user_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UserInfoResponse) GetUserId() string {
@@ -489,7 +542,7 @@ func (x *UserInfoResponse) GetIsBlocked() bool {
 	return false
 }
 
-// SearchUsersRequest 搜索用户请求
+// SearchUsersRequest search users request
 type SearchUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
@@ -501,7 +554,7 @@ type SearchUsersRequest struct {
 
 func (x *SearchUsersRequest) Reset() {
 	*x = SearchUsersRequest{}
-	mi := &file_user_user_proto_msgTypes[5]
+	mi := &file_user_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -513,7 +566,7 @@ func (x *SearchUsersRequest) String() string {
 func (*SearchUsersRequest) ProtoMessage() {}
 
 func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[5]
+	mi := &file_user_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -526,7 +579,7 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
 func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{5}
+	return file_user_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SearchUsersRequest) GetKeyword() string {
@@ -550,7 +603,7 @@ func (x *SearchUsersRequest) GetPageSize() int32 {
 	return 0
 }
 
-// SearchUsersResponse 搜索用户响应
+// SearchUsersResponse search users response
 type SearchUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
@@ -561,7 +614,7 @@ type SearchUsersResponse struct {
 
 func (x *SearchUsersResponse) Reset() {
 	*x = SearchUsersResponse{}
-	mi := &file_user_user_proto_msgTypes[6]
+	mi := &file_user_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +626,7 @@ func (x *SearchUsersResponse) String() string {
 func (*SearchUsersResponse) ProtoMessage() {}
 
 func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[6]
+	mi := &file_user_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +639,7 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
 func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{6}
+	return file_user_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SearchUsersResponse) GetTotal() int64 {
@@ -603,7 +656,7 @@ func (x *SearchUsersResponse) GetUsers() []*UserBriefInfo {
 	return nil
 }
 
-// UserBriefInfo 用户简要信息
+// UserBriefInfo brief user info
 type UserBriefInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -616,7 +669,7 @@ type UserBriefInfo struct {
 
 func (x *UserBriefInfo) Reset() {
 	*x = UserBriefInfo{}
-	mi := &file_user_user_proto_msgTypes[7]
+	mi := &file_user_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -628,7 +681,7 @@ func (x *UserBriefInfo) String() string {
 func (*UserBriefInfo) ProtoMessage() {}
 
 func (x *UserBriefInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[7]
+	mi := &file_user_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +694,7 @@ func (x *UserBriefInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserBriefInfo.ProtoReflect.Descriptor instead.
 func (*UserBriefInfo) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{7}
+	return file_user_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UserBriefInfo) GetUserId() string {
@@ -672,7 +725,7 @@ func (x *UserBriefInfo) GetSignature() string {
 	return ""
 }
 
-// GetSettingsRequest 获取用户设置请求
+// GetSettingsRequest get user settings request
 type GetSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -682,7 +735,7 @@ type GetSettingsRequest struct {
 
 func (x *GetSettingsRequest) Reset() {
 	*x = GetSettingsRequest{}
-	mi := &file_user_user_proto_msgTypes[8]
+	mi := &file_user_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -694,7 +747,7 @@ func (x *GetSettingsRequest) String() string {
 func (*GetSettingsRequest) ProtoMessage() {}
 
 func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[8]
+	mi := &file_user_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,7 +760,7 @@ func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{8}
+	return file_user_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetSettingsRequest) GetUserId() string {
@@ -717,7 +770,7 @@ func (x *GetSettingsRequest) GetUserId() string {
 	return ""
 }
 
-// UpdateSettingsRequest 更新用户设置请求
+// UpdateSettingsRequest update user settings request
 type UpdateSettingsRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -735,7 +788,7 @@ type UpdateSettingsRequest struct {
 
 func (x *UpdateSettingsRequest) Reset() {
 	*x = UpdateSettingsRequest{}
-	mi := &file_user_user_proto_msgTypes[9]
+	mi := &file_user_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -747,7 +800,7 @@ func (x *UpdateSettingsRequest) String() string {
 func (*UpdateSettingsRequest) ProtoMessage() {}
 
 func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[9]
+	mi := &file_user_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,7 +813,7 @@ func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{9}
+	return file_user_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateSettingsRequest) GetUserId() string {
@@ -826,7 +879,7 @@ func (x *UpdateSettingsRequest) GetLanguage() string {
 	return ""
 }
 
-// UserSettingsResponse 用户设置响应
+// UserSettingsResponse user settings response
 type UserSettingsResponse struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -844,7 +897,7 @@ type UserSettingsResponse struct {
 
 func (x *UserSettingsResponse) Reset() {
 	*x = UserSettingsResponse{}
-	mi := &file_user_user_proto_msgTypes[10]
+	mi := &file_user_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -856,7 +909,7 @@ func (x *UserSettingsResponse) String() string {
 func (*UserSettingsResponse) ProtoMessage() {}
 
 func (x *UserSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[10]
+	mi := &file_user_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,7 +922,7 @@ func (x *UserSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSettingsResponse.ProtoReflect.Descriptor instead.
 func (*UserSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{10}
+	return file_user_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UserSettingsResponse) GetUserId() string {
@@ -935,7 +988,7 @@ func (x *UserSettingsResponse) GetLanguage() string {
 	return ""
 }
 
-// RefreshQRCodeRequest 刷新二维码请求
+// RefreshQRCodeRequest refresh QR code request
 type RefreshQRCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -945,7 +998,7 @@ type RefreshQRCodeRequest struct {
 
 func (x *RefreshQRCodeRequest) Reset() {
 	*x = RefreshQRCodeRequest{}
-	mi := &file_user_user_proto_msgTypes[11]
+	mi := &file_user_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -957,7 +1010,7 @@ func (x *RefreshQRCodeRequest) String() string {
 func (*RefreshQRCodeRequest) ProtoMessage() {}
 
 func (x *RefreshQRCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[11]
+	mi := &file_user_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,7 +1023,7 @@ func (x *RefreshQRCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshQRCodeRequest.ProtoReflect.Descriptor instead.
 func (*RefreshQRCodeRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{11}
+	return file_user_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RefreshQRCodeRequest) GetUserId() string {
@@ -980,18 +1033,18 @@ func (x *RefreshQRCodeRequest) GetUserId() string {
 	return ""
 }
 
-// QRCodeResponse 二维码响应
+// QRCodeResponse QR code response
 type QRCodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	QrcodeUrl     string                 `protobuf:"bytes,1,opt,name=qrcode_url,json=qrcodeUrl,proto3" json:"qrcode_url,omitempty"`
-	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QRCodeResponse) Reset() {
 	*x = QRCodeResponse{}
-	mi := &file_user_user_proto_msgTypes[12]
+	mi := &file_user_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1003,7 +1056,7 @@ func (x *QRCodeResponse) String() string {
 func (*QRCodeResponse) ProtoMessage() {}
 
 func (x *QRCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[12]
+	mi := &file_user_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,7 +1069,7 @@ func (x *QRCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QRCodeResponse.ProtoReflect.Descriptor instead.
 func (*QRCodeResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{12}
+	return file_user_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QRCodeResponse) GetQrcodeUrl() string {
@@ -1026,14 +1079,14 @@ func (x *QRCodeResponse) GetQrcodeUrl() string {
 	return ""
 }
 
-func (x *QRCodeResponse) GetExpiresAt() *timestamp.Timestamp {
+func (x *QRCodeResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ExpiresAt
 	}
 	return nil
 }
 
-// GetUserByQRCodeRequest 通过二维码获取用户请求
+// GetUserByQRCodeRequest get user by QR code request
 type GetUserByQRCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Qrcode        string                 `protobuf:"bytes,1,opt,name=qrcode,proto3" json:"qrcode,omitempty"`
@@ -1043,7 +1096,7 @@ type GetUserByQRCodeRequest struct {
 
 func (x *GetUserByQRCodeRequest) Reset() {
 	*x = GetUserByQRCodeRequest{}
-	mi := &file_user_user_proto_msgTypes[13]
+	mi := &file_user_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1108,7 @@ func (x *GetUserByQRCodeRequest) String() string {
 func (*GetUserByQRCodeRequest) ProtoMessage() {}
 
 func (x *GetUserByQRCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[13]
+	mi := &file_user_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1121,7 @@ func (x *GetUserByQRCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByQRCodeRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByQRCodeRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{13}
+	return file_user_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetUserByQRCodeRequest) GetQrcode() string {
@@ -1078,7 +1131,7 @@ func (x *GetUserByQRCodeRequest) GetQrcode() string {
 	return ""
 }
 
-// UpdatePushTokenRequest 更新推送Token请求
+// UpdatePushTokenRequest update push token request
 type UpdatePushTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1091,7 +1144,7 @@ type UpdatePushTokenRequest struct {
 
 func (x *UpdatePushTokenRequest) Reset() {
 	*x = UpdatePushTokenRequest{}
-	mi := &file_user_user_proto_msgTypes[14]
+	mi := &file_user_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1103,7 +1156,7 @@ func (x *UpdatePushTokenRequest) String() string {
 func (*UpdatePushTokenRequest) ProtoMessage() {}
 
 func (x *UpdatePushTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[14]
+	mi := &file_user_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,7 +1169,7 @@ func (x *UpdatePushTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePushTokenRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePushTokenRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{14}
+	return file_user_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdatePushTokenRequest) GetUserId() string {
@@ -1158,7 +1211,7 @@ type BindPhoneRequest struct {
 
 func (x *BindPhoneRequest) Reset() {
 	*x = BindPhoneRequest{}
-	mi := &file_user_user_proto_msgTypes[15]
+	mi := &file_user_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1170,7 +1223,7 @@ func (x *BindPhoneRequest) String() string {
 func (*BindPhoneRequest) ProtoMessage() {}
 
 func (x *BindPhoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[15]
+	mi := &file_user_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,7 +1236,7 @@ func (x *BindPhoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BindPhoneRequest.ProtoReflect.Descriptor instead.
 func (*BindPhoneRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{15}
+	return file_user_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *BindPhoneRequest) GetUserId() string {
@@ -1217,7 +1270,7 @@ type BindPhoneResponse struct {
 
 func (x *BindPhoneResponse) Reset() {
 	*x = BindPhoneResponse{}
-	mi := &file_user_user_proto_msgTypes[16]
+	mi := &file_user_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1229,7 +1282,7 @@ func (x *BindPhoneResponse) String() string {
 func (*BindPhoneResponse) ProtoMessage() {}
 
 func (x *BindPhoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[16]
+	mi := &file_user_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1242,7 +1295,7 @@ func (x *BindPhoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BindPhoneResponse.ProtoReflect.Descriptor instead.
 func (*BindPhoneResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{16}
+	return file_user_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BindPhoneResponse) GetPhoneNumber() string {
@@ -1267,13 +1320,14 @@ type ChangePhoneRequest struct {
 	NewVerifyCode  string                 `protobuf:"bytes,4,opt,name=new_verify_code,json=newVerifyCode,proto3" json:"new_verify_code,omitempty"`
 	OldVerifyCode  *string                `protobuf:"bytes,5,opt,name=old_verify_code,json=oldVerifyCode,proto3,oneof" json:"old_verify_code,omitempty"`
 	DeviceId       string                 `protobuf:"bytes,6,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	MfaCode        *string                `protobuf:"bytes,7,opt,name=mfa_code,json=mfaCode,proto3,oneof" json:"mfa_code,omitempty"` // required when MFA is enabled
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ChangePhoneRequest) Reset() {
 	*x = ChangePhoneRequest{}
-	mi := &file_user_user_proto_msgTypes[17]
+	mi := &file_user_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1285,7 +1339,7 @@ func (x *ChangePhoneRequest) String() string {
 func (*ChangePhoneRequest) ProtoMessage() {}
 
 func (x *ChangePhoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[17]
+	mi := &file_user_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +1352,7 @@ func (x *ChangePhoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePhoneRequest.ProtoReflect.Descriptor instead.
 func (*ChangePhoneRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{17}
+	return file_user_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ChangePhoneRequest) GetUserId() string {
@@ -1343,6 +1397,13 @@ func (x *ChangePhoneRequest) GetDeviceId() string {
 	return ""
 }
 
+func (x *ChangePhoneRequest) GetMfaCode() string {
+	if x != nil && x.MfaCode != nil {
+		return *x.MfaCode
+	}
+	return ""
+}
+
 type ChangePhoneResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OldPhoneNumber string                 `protobuf:"bytes,1,opt,name=old_phone_number,json=oldPhoneNumber,proto3" json:"old_phone_number,omitempty"`
@@ -1353,7 +1414,7 @@ type ChangePhoneResponse struct {
 
 func (x *ChangePhoneResponse) Reset() {
 	*x = ChangePhoneResponse{}
-	mi := &file_user_user_proto_msgTypes[18]
+	mi := &file_user_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1365,7 +1426,7 @@ func (x *ChangePhoneResponse) String() string {
 func (*ChangePhoneResponse) ProtoMessage() {}
 
 func (x *ChangePhoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[18]
+	mi := &file_user_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,7 +1439,7 @@ func (x *ChangePhoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePhoneResponse.ProtoReflect.Descriptor instead.
 func (*ChangePhoneResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{18}
+	return file_user_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ChangePhoneResponse) GetOldPhoneNumber() string {
@@ -1406,7 +1467,7 @@ type BindEmailRequest struct {
 
 func (x *BindEmailRequest) Reset() {
 	*x = BindEmailRequest{}
-	mi := &file_user_user_proto_msgTypes[19]
+	mi := &file_user_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1418,7 +1479,7 @@ func (x *BindEmailRequest) String() string {
 func (*BindEmailRequest) ProtoMessage() {}
 
 func (x *BindEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[19]
+	mi := &file_user_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +1492,7 @@ func (x *BindEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BindEmailRequest.ProtoReflect.Descriptor instead.
 func (*BindEmailRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{19}
+	return file_user_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *BindEmailRequest) GetUserId() string {
@@ -1465,7 +1526,7 @@ type BindEmailResponse struct {
 
 func (x *BindEmailResponse) Reset() {
 	*x = BindEmailResponse{}
-	mi := &file_user_user_proto_msgTypes[20]
+	mi := &file_user_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1477,7 +1538,7 @@ func (x *BindEmailResponse) String() string {
 func (*BindEmailResponse) ProtoMessage() {}
 
 func (x *BindEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[20]
+	mi := &file_user_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,7 +1551,7 @@ func (x *BindEmailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BindEmailResponse.ProtoReflect.Descriptor instead.
 func (*BindEmailResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{20}
+	return file_user_user_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *BindEmailResponse) GetEmail() string {
@@ -1515,13 +1576,14 @@ type ChangeEmailRequest struct {
 	NewVerifyCode string                 `protobuf:"bytes,4,opt,name=new_verify_code,json=newVerifyCode,proto3" json:"new_verify_code,omitempty"`
 	OldVerifyCode *string                `protobuf:"bytes,5,opt,name=old_verify_code,json=oldVerifyCode,proto3,oneof" json:"old_verify_code,omitempty"`
 	DeviceId      string                 `protobuf:"bytes,6,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	MfaCode       *string                `protobuf:"bytes,7,opt,name=mfa_code,json=mfaCode,proto3,oneof" json:"mfa_code,omitempty"` // required when MFA is enabled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChangeEmailRequest) Reset() {
 	*x = ChangeEmailRequest{}
-	mi := &file_user_user_proto_msgTypes[21]
+	mi := &file_user_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1533,7 +1595,7 @@ func (x *ChangeEmailRequest) String() string {
 func (*ChangeEmailRequest) ProtoMessage() {}
 
 func (x *ChangeEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[21]
+	mi := &file_user_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,7 +1608,7 @@ func (x *ChangeEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeEmailRequest.ProtoReflect.Descriptor instead.
 func (*ChangeEmailRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{21}
+	return file_user_user_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ChangeEmailRequest) GetUserId() string {
@@ -1591,6 +1653,13 @@ func (x *ChangeEmailRequest) GetDeviceId() string {
 	return ""
 }
 
+func (x *ChangeEmailRequest) GetMfaCode() string {
+	if x != nil && x.MfaCode != nil {
+		return *x.MfaCode
+	}
+	return ""
+}
+
 type ChangeEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OldEmail      string                 `protobuf:"bytes,1,opt,name=old_email,json=oldEmail,proto3" json:"old_email,omitempty"`
@@ -1601,7 +1670,7 @@ type ChangeEmailResponse struct {
 
 func (x *ChangeEmailResponse) Reset() {
 	*x = ChangeEmailResponse{}
-	mi := &file_user_user_proto_msgTypes[22]
+	mi := &file_user_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1613,7 +1682,7 @@ func (x *ChangeEmailResponse) String() string {
 func (*ChangeEmailResponse) ProtoMessage() {}
 
 func (x *ChangeEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[22]
+	mi := &file_user_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,7 +1695,7 @@ func (x *ChangeEmailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeEmailResponse.ProtoReflect.Descriptor instead.
 func (*ChangeEmailResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{22}
+	return file_user_user_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ChangeEmailResponse) GetOldEmail() string {
@@ -1643,7 +1712,7 @@ func (x *ChangeEmailResponse) GetNewEmail() string {
 	return ""
 }
 
-// InitUserDataRequest 初始化用户数据请求
+// InitUserDataRequest initialize user data request
 type InitUserDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1654,7 +1723,7 @@ type InitUserDataRequest struct {
 
 func (x *InitUserDataRequest) Reset() {
 	*x = InitUserDataRequest{}
-	mi := &file_user_user_proto_msgTypes[23]
+	mi := &file_user_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1666,7 +1735,7 @@ func (x *InitUserDataRequest) String() string {
 func (*InitUserDataRequest) ProtoMessage() {}
 
 func (x *InitUserDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[23]
+	mi := &file_user_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,7 +1748,7 @@ func (x *InitUserDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitUserDataRequest.ProtoReflect.Descriptor instead.
 func (*InitUserDataRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{23}
+	return file_user_user_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *InitUserDataRequest) GetUserId() string {
@@ -1702,7 +1771,10 @@ const file_user_user_proto_rawDesc = "" +
 	"\n" +
 	"\x0fuser/user.proto\x12\fanychat.user\x1a\x13common/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\",\n" +
 	"\x11GetProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd0\x02\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"D\n" +
+	"\x10SetAvatarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\"\xd0\x02\n" +
 	"\x14UpdateProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\bnickname\x18\x02 \x01(\tH\x00R\bnickname\x88\x01\x01\x12\x1b\n" +
@@ -1815,15 +1887,17 @@ const file_user_user_proto_rawDesc = "" +
 	"\x11BindPhoneResponse\x12!\n" +
 	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12\x1d\n" +
 	"\n" +
-	"is_primary\x18\x02 \x01(\bR\tisPrimary\"\x87\x02\n" +
+	"is_primary\x18\x02 \x01(\bR\tisPrimary\"\xb4\x02\n" +
 	"\x12ChangePhoneRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12(\n" +
 	"\x10old_phone_number\x18\x02 \x01(\tR\x0eoldPhoneNumber\x12(\n" +
 	"\x10new_phone_number\x18\x03 \x01(\tR\x0enewPhoneNumber\x12&\n" +
 	"\x0fnew_verify_code\x18\x04 \x01(\tR\rnewVerifyCode\x12+\n" +
 	"\x0fold_verify_code\x18\x05 \x01(\tH\x00R\roldVerifyCode\x88\x01\x01\x12\x1b\n" +
-	"\tdevice_id\x18\x06 \x01(\tR\bdeviceIdB\x12\n" +
-	"\x10_old_verify_code\"i\n" +
+	"\tdevice_id\x18\x06 \x01(\tR\bdeviceId\x12\x1e\n" +
+	"\bmfa_code\x18\a \x01(\tH\x01R\amfaCode\x88\x01\x01B\x12\n" +
+	"\x10_old_verify_codeB\v\n" +
+	"\t_mfa_code\"i\n" +
 	"\x13ChangePhoneResponse\x12(\n" +
 	"\x10old_phone_number\x18\x01 \x01(\tR\x0eoldPhoneNumber\x12(\n" +
 	"\x10new_phone_number\x18\x02 \x01(\tR\x0enewPhoneNumber\"b\n" +
@@ -1835,15 +1909,17 @@ const file_user_user_proto_rawDesc = "" +
 	"\x11BindEmailResponse\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1d\n" +
 	"\n" +
-	"is_primary\x18\x02 \x01(\bR\tisPrimary\"\xed\x01\n" +
+	"is_primary\x18\x02 \x01(\bR\tisPrimary\"\x9a\x02\n" +
 	"\x12ChangeEmailRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\told_email\x18\x02 \x01(\tR\boldEmail\x12\x1b\n" +
 	"\tnew_email\x18\x03 \x01(\tR\bnewEmail\x12&\n" +
 	"\x0fnew_verify_code\x18\x04 \x01(\tR\rnewVerifyCode\x12+\n" +
 	"\x0fold_verify_code\x18\x05 \x01(\tH\x00R\roldVerifyCode\x88\x01\x01\x12\x1b\n" +
-	"\tdevice_id\x18\x06 \x01(\tR\bdeviceIdB\x12\n" +
-	"\x10_old_verify_code\"O\n" +
+	"\tdevice_id\x18\x06 \x01(\tR\bdeviceId\x12\x1e\n" +
+	"\bmfa_code\x18\a \x01(\tH\x01R\amfaCode\x88\x01\x01B\x12\n" +
+	"\x10_old_verify_codeB\v\n" +
+	"\t_mfa_code\"O\n" +
 	"\x13ChangeEmailResponse\x12\x1b\n" +
 	"\told_email\x18\x01 \x01(\tR\boldEmail\x12\x1b\n" +
 	"\tnew_email\x18\x02 \x01(\tR\bnewEmail\"J\n" +
@@ -1853,11 +1929,13 @@ const file_user_user_proto_rawDesc = "" +
 	"\fPushPlatform\x12\x1d\n" +
 	"\x19PUSH_PLATFORM_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11PUSH_PLATFORM_IOS\x10\x01\x12\x19\n" +
-	"\x15PUSH_PLATFORM_ANDROID\x10\x022\x96\t\n" +
+	"\x15PUSH_PLATFORM_ANDROID\x10\x022\xc4\n" +
+	"\n" +
 	"\vUserService\x12P\n" +
 	"\n" +
 	"GetProfile\x12\x1f.anychat.user.GetProfileRequest\x1a!.anychat.user.UserProfileResponse\x12V\n" +
-	"\rUpdateProfile\x12\".anychat.user.UpdateProfileRequest\x1a!.anychat.user.UserProfileResponse\x12O\n" +
+	"\rUpdateProfile\x12\".anychat.user.UpdateProfileRequest\x1a!.anychat.user.UserProfileResponse\x12N\n" +
+	"\tSetAvatar\x12\x1e.anychat.user.SetAvatarRequest\x1a!.anychat.user.UserProfileResponse\x12O\n" +
 	"\vGetUserInfo\x12 .anychat.user.GetUserInfoRequest\x1a\x1e.anychat.user.UserInfoResponse\x12R\n" +
 	"\vSearchUsers\x12 .anychat.user.SearchUsersRequest\x1a!.anychat.user.SearchUsersResponse\x12S\n" +
 	"\vGetSettings\x12 .anychat.user.GetSettingsRequest\x1a\".anychat.user.UserSettingsResponse\x12Y\n" +
@@ -1869,7 +1947,8 @@ const file_user_user_proto_rawDesc = "" +
 	"\vChangePhone\x12 .anychat.user.ChangePhoneRequest\x1a!.anychat.user.ChangePhoneResponse\x12L\n" +
 	"\tBindEmail\x12\x1e.anychat.user.BindEmailRequest\x1a\x1f.anychat.user.BindEmailResponse\x12R\n" +
 	"\vChangeEmail\x12 .anychat.user.ChangeEmailRequest\x1a!.anychat.user.ChangeEmailResponse\x12H\n" +
-	"\fInitUserData\x12!.anychat.user.InitUserDataRequest\x1a\x15.anychat.common.EmptyB1Z/github.com/anychat/server/api/proto/user;userpbb\x06proto3"
+	"\fInitUserData\x12!.anychat.user.InitUserDataRequest\x1a\x15.anychat.common.Empty\x12\\\n" +
+	"\rEraseUserData\x12$.anychat.common.EraseUserDataRequest\x1a%.anychat.common.EraseUserDataResponseB1Z/github.com/anychat/server/api/proto/user;userpbb\x06proto3"
 
 var (
 	file_user_user_proto_rawDescOnce sync.Once
@@ -1884,73 +1963,80 @@ func file_user_user_proto_rawDescGZIP() []byte {
 }
 
 var file_user_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_user_user_proto_goTypes = []any{
-	(PushPlatform)(0),              // 0: anychat.user.PushPlatform
-	(*GetProfileRequest)(nil),      // 1: anychat.user.GetProfileRequest
-	(*UpdateProfileRequest)(nil),   // 2: anychat.user.UpdateProfileRequest
-	(*UserProfileResponse)(nil),    // 3: anychat.user.UserProfileResponse
-	(*GetUserInfoRequest)(nil),     // 4: anychat.user.GetUserInfoRequest
-	(*UserInfoResponse)(nil),       // 5: anychat.user.UserInfoResponse
-	(*SearchUsersRequest)(nil),     // 6: anychat.user.SearchUsersRequest
-	(*SearchUsersResponse)(nil),    // 7: anychat.user.SearchUsersResponse
-	(*UserBriefInfo)(nil),          // 8: anychat.user.UserBriefInfo
-	(*GetSettingsRequest)(nil),     // 9: anychat.user.GetSettingsRequest
-	(*UpdateSettingsRequest)(nil),  // 10: anychat.user.UpdateSettingsRequest
-	(*UserSettingsResponse)(nil),   // 11: anychat.user.UserSettingsResponse
-	(*RefreshQRCodeRequest)(nil),   // 12: anychat.user.RefreshQRCodeRequest
-	(*QRCodeResponse)(nil),         // 13: anychat.user.QRCodeResponse
-	(*GetUserByQRCodeRequest)(nil), // 14: anychat.user.GetUserByQRCodeRequest
-	(*UpdatePushTokenRequest)(nil), // 15: anychat.user.UpdatePushTokenRequest
-	(*BindPhoneRequest)(nil),       // 16: anychat.user.BindPhoneRequest
-	(*BindPhoneResponse)(nil),      // 17: anychat.user.BindPhoneResponse
-	(*ChangePhoneRequest)(nil),     // 18: anychat.user.ChangePhoneRequest
-	(*ChangePhoneResponse)(nil),    // 19: anychat.user.ChangePhoneResponse
-	(*BindEmailRequest)(nil),       // 20: anychat.user.BindEmailRequest
-	(*BindEmailResponse)(nil),      // 21: anychat.user.BindEmailResponse
-	(*ChangeEmailRequest)(nil),     // 22: anychat.user.ChangeEmailRequest
-	(*ChangeEmailResponse)(nil),    // 23: anychat.user.ChangeEmailResponse
-	(*InitUserDataRequest)(nil),    // 24: anychat.user.InitUserDataRequest
-	(*timestamp.Timestamp)(nil),    // 25: google.protobuf.Timestamp
-	(*common.Empty)(nil),           // 26: anychat.common.Empty
+	(PushPlatform)(0),                    // 0: anychat.user.PushPlatform
+	(*GetProfileRequest)(nil),            // 1: anychat.user.GetProfileRequest
+	(*SetAvatarRequest)(nil),             // 2: anychat.user.SetAvatarRequest
+	(*UpdateProfileRequest)(nil),         // 3: anychat.user.UpdateProfileRequest
+	(*UserProfileResponse)(nil),          // 4: anychat.user.UserProfileResponse
+	(*GetUserInfoRequest)(nil),           // 5: anychat.user.GetUserInfoRequest
+	(*UserInfoResponse)(nil),             // 6: anychat.user.UserInfoResponse
+	(*SearchUsersRequest)(nil),           // 7: anychat.user.SearchUsersRequest
+	(*SearchUsersResponse)(nil),          // 8: anychat.user.SearchUsersResponse
+	(*UserBriefInfo)(nil),                // 9: anychat.user.UserBriefInfo
+	(*GetSettingsRequest)(nil),           // 10: anychat.user.GetSettingsRequest
+	(*UpdateSettingsRequest)(nil),        // 11: anychat.user.UpdateSettingsRequest
+	(*UserSettingsResponse)(nil),         // 12: anychat.user.UserSettingsResponse
+	(*RefreshQRCodeRequest)(nil),         // 13: anychat.user.RefreshQRCodeRequest
+	(*QRCodeResponse)(nil),               // 14: anychat.user.QRCodeResponse
+	(*GetUserByQRCodeRequest)(nil),       // 15: anychat.user.GetUserByQRCodeRequest
+	(*UpdatePushTokenRequest)(nil),       // 16: anychat.user.UpdatePushTokenRequest
+	(*BindPhoneRequest)(nil),             // 17: anychat.user.BindPhoneRequest
+	(*BindPhoneResponse)(nil),            // 18: anychat.user.BindPhoneResponse
+	(*ChangePhoneRequest)(nil),           // 19: anychat.user.ChangePhoneRequest
+	(*ChangePhoneResponse)(nil),          // 20: anychat.user.ChangePhoneResponse
+	(*BindEmailRequest)(nil),             // 21: anychat.user.BindEmailRequest
+	(*BindEmailResponse)(nil),            // 22: anychat.user.BindEmailResponse
+	(*ChangeEmailRequest)(nil),           // 23: anychat.user.ChangeEmailRequest
+	(*ChangeEmailResponse)(nil),          // 24: anychat.user.ChangeEmailResponse
+	(*InitUserDataRequest)(nil),          // 25: anychat.user.InitUserDataRequest
+	(*timestamppb.Timestamp)(nil),        // 26: google.protobuf.Timestamp
+	(*common.EraseUserDataRequest)(nil),  // 27: anychat.common.EraseUserDataRequest
+	(*common.Empty)(nil),                 // 28: anychat.common.Empty
+	(*common.EraseUserDataResponse)(nil), // 29: anychat.common.EraseUserDataResponse
 }
 var file_user_user_proto_depIdxs = []int32{
-	25, // 0: anychat.user.UpdateProfileRequest.birthday:type_name -> google.protobuf.Timestamp
-	25, // 1: anychat.user.UserProfileResponse.birthday:type_name -> google.protobuf.Timestamp
-	25, // 2: anychat.user.UserProfileResponse.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 3: anychat.user.SearchUsersResponse.users:type_name -> anychat.user.UserBriefInfo
-	25, // 4: anychat.user.QRCodeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	26, // 0: anychat.user.UpdateProfileRequest.birthday:type_name -> google.protobuf.Timestamp
+	26, // 1: anychat.user.UserProfileResponse.birthday:type_name -> google.protobuf.Timestamp
+	26, // 2: anychat.user.UserProfileResponse.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: anychat.user.SearchUsersResponse.users:type_name -> anychat.user.UserBriefInfo
+	26, // 4: anychat.user.QRCodeResponse.expires_at:type_name -> google.protobuf.Timestamp
 	0,  // 5: anychat.user.UpdatePushTokenRequest.platform:type_name -> anychat.user.PushPlatform
 	1,  // 6: anychat.user.UserService.GetProfile:input_type -> anychat.user.GetProfileRequest
-	2,  // 7: anychat.user.UserService.UpdateProfile:input_type -> anychat.user.UpdateProfileRequest
-	4,  // 8: anychat.user.UserService.GetUserInfo:input_type -> anychat.user.GetUserInfoRequest
-	6,  // 9: anychat.user.UserService.SearchUsers:input_type -> anychat.user.SearchUsersRequest
-	9,  // 10: anychat.user.UserService.GetSettings:input_type -> anychat.user.GetSettingsRequest
-	10, // 11: anychat.user.UserService.UpdateSettings:input_type -> anychat.user.UpdateSettingsRequest
-	12, // 12: anychat.user.UserService.RefreshQRCode:input_type -> anychat.user.RefreshQRCodeRequest
-	14, // 13: anychat.user.UserService.GetUserByQRCode:input_type -> anychat.user.GetUserByQRCodeRequest
-	15, // 14: anychat.user.UserService.UpdatePushToken:input_type -> anychat.user.UpdatePushTokenRequest
-	16, // 15: anychat.user.UserService.BindPhone:input_type -> anychat.user.BindPhoneRequest
-	18, // 16: anychat.user.UserService.ChangePhone:input_type -> anychat.user.ChangePhoneRequest
-	20, // 17: anychat.user.UserService.BindEmail:input_type -> anychat.user.BindEmailRequest
-	22, // 18: anychat.user.UserService.ChangeEmail:input_type -> anychat.user.ChangeEmailRequest
-	24, // 19: anychat.user.UserService.InitUserData:input_type -> anychat.user.InitUserDataRequest
-	3,  // 20: anychat.user.UserService.GetProfile:output_type -> anychat.user.UserProfileResponse
-	3,  // 21: anychat.user.UserService.UpdateProfile:output_type -> anychat.user.UserProfileResponse
-	5,  // 22: anychat.user.UserService.GetUserInfo:output_type -> anychat.user.UserInfoResponse
-	7,  // 23: anychat.user.UserService.SearchUsers:output_type -> anychat.user.SearchUsersResponse
-	11, // 24: anychat.user.UserService.GetSettings:output_type -> anychat.user.UserSettingsResponse
-	11, // 25: anychat.user.UserService.UpdateSettings:output_type -> anychat.user.UserSettingsResponse
-	13, // 26: anychat.user.UserService.RefreshQRCode:output_type -> anychat.user.QRCodeResponse
-	5,  // 27: anychat.user.UserService.GetUserByQRCode:output_type -> anychat.user.UserInfoResponse
-	26, // 28: anychat.user.UserService.UpdatePushToken:output_type -> anychat.common.Empty
-	17, // 29: anychat.user.UserService.BindPhone:output_type -> anychat.user.BindPhoneResponse
-	19, // 30: anychat.user.UserService.ChangePhone:output_type -> anychat.user.ChangePhoneResponse
-	21, // 31: anychat.user.UserService.BindEmail:output_type -> anychat.user.BindEmailResponse
-	23, // 32: anychat.user.UserService.ChangeEmail:output_type -> anychat.user.ChangeEmailResponse
-	26, // 33: anychat.user.UserService.InitUserData:output_type -> anychat.common.Empty
-	20, // [20:34] is the sub-list for method output_type
-	6,  // [6:20] is the sub-list for method input_type
+	3,  // 7: anychat.user.UserService.UpdateProfile:input_type -> anychat.user.UpdateProfileRequest
+	2,  // 8: anychat.user.UserService.SetAvatar:input_type -> anychat.user.SetAvatarRequest
+	5,  // 9: anychat.user.UserService.GetUserInfo:input_type -> anychat.user.GetUserInfoRequest
+	7,  // 10: anychat.user.UserService.SearchUsers:input_type -> anychat.user.SearchUsersRequest
+	10, // 11: anychat.user.UserService.GetSettings:input_type -> anychat.user.GetSettingsRequest
+	11, // 12: anychat.user.UserService.UpdateSettings:input_type -> anychat.user.UpdateSettingsRequest
+	13, // 13: anychat.user.UserService.RefreshQRCode:input_type -> anychat.user.RefreshQRCodeRequest
+	15, // 14: anychat.user.UserService.GetUserByQRCode:input_type -> anychat.user.GetUserByQRCodeRequest
+	16, // 15: anychat.user.UserService.UpdatePushToken:input_type -> anychat.user.UpdatePushTokenRequest
+	17, // 16: anychat.user.UserService.BindPhone:input_type -> anychat.user.BindPhoneRequest
+	19, // 17: anychat.user.UserService.ChangePhone:input_type -> anychat.user.ChangePhoneRequest
+	21, // 18: anychat.user.UserService.BindEmail:input_type -> anychat.user.BindEmailRequest
+	23, // 19: anychat.user.UserService.ChangeEmail:input_type -> anychat.user.ChangeEmailRequest
+	25, // 20: anychat.user.UserService.InitUserData:input_type -> anychat.user.InitUserDataRequest
+	27, // 21: anychat.user.UserService.EraseUserData:input_type -> anychat.common.EraseUserDataRequest
+	4,  // 22: anychat.user.UserService.GetProfile:output_type -> anychat.user.UserProfileResponse
+	4,  // 23: anychat.user.UserService.UpdateProfile:output_type -> anychat.user.UserProfileResponse
+	4,  // 24: anychat.user.UserService.SetAvatar:output_type -> anychat.user.UserProfileResponse
+	6,  // 25: anychat.user.UserService.GetUserInfo:output_type -> anychat.user.UserInfoResponse
+	8,  // 26: anychat.user.UserService.SearchUsers:output_type -> anychat.user.SearchUsersResponse
+	12, // 27: anychat.user.UserService.GetSettings:output_type -> anychat.user.UserSettingsResponse
+	12, // 28: anychat.user.UserService.UpdateSettings:output_type -> anychat.user.UserSettingsResponse
+	14, // 29: anychat.user.UserService.RefreshQRCode:output_type -> anychat.user.QRCodeResponse
+	6,  // 30: anychat.user.UserService.GetUserByQRCode:output_type -> anychat.user.UserInfoResponse
+	28, // 31: anychat.user.UserService.UpdatePushToken:output_type -> anychat.common.Empty
+	18, // 32: anychat.user.UserService.BindPhone:output_type -> anychat.user.BindPhoneResponse
+	20, // 33: anychat.user.UserService.ChangePhone:output_type -> anychat.user.ChangePhoneResponse
+	22, // 34: anychat.user.UserService.BindEmail:output_type -> anychat.user.BindEmailResponse
+	24, // 35: anychat.user.UserService.ChangeEmail:output_type -> anychat.user.ChangeEmailResponse
+	28, // 36: anychat.user.UserService.InitUserData:output_type -> anychat.common.Empty
+	29, // 37: anychat.user.UserService.EraseUserData:output_type -> anychat.common.EraseUserDataResponse
+	22, // [22:38] is the sub-list for method output_type
+	6,  // [6:22] is the sub-list for method input_type
 	6,  // [6:6] is the sub-list for extension type_name
 	6,  // [6:6] is the sub-list for extension extendee
 	0,  // [0:6] is the sub-list for field type_name
@@ -1961,18 +2047,18 @@ func file_user_user_proto_init() {
 	if File_user_user_proto != nil {
 		return
 	}
-	file_user_user_proto_msgTypes[1].OneofWrappers = []any{}
 	file_user_user_proto_msgTypes[2].OneofWrappers = []any{}
-	file_user_user_proto_msgTypes[9].OneofWrappers = []any{}
-	file_user_user_proto_msgTypes[17].OneofWrappers = []any{}
-	file_user_user_proto_msgTypes[21].OneofWrappers = []any{}
+	file_user_user_proto_msgTypes[3].OneofWrappers = []any{}
+	file_user_user_proto_msgTypes[10].OneofWrappers = []any{}
+	file_user_user_proto_msgTypes[18].OneofWrappers = []any{}
+	file_user_user_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_user_proto_rawDesc), len(file_user_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   24,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	UserService_GetProfile_FullMethodName      = "/anychat.user.UserService/GetProfile"
 	UserService_UpdateProfile_FullMethodName   = "/anychat.user.UserService/UpdateProfile"
+	UserService_SetAvatar_FullMethodName       = "/anychat.user.UserService/SetAvatar"
 	UserService_GetUserInfo_FullMethodName     = "/anychat.user.UserService/GetUserInfo"
 	UserService_SearchUsers_FullMethodName     = "/anychat.user.UserService/SearchUsers"
 	UserService_GetSettings_FullMethodName     = "/anychat.user.UserService/GetSettings"
@@ -34,42 +35,47 @@ const (
 	UserService_BindEmail_FullMethodName       = "/anychat.user.UserService/BindEmail"
 	UserService_ChangeEmail_FullMethodName     = "/anychat.user.UserService/ChangeEmail"
 	UserService_InitUserData_FullMethodName    = "/anychat.user.UserService/InitUserData"
+	UserService_EraseUserData_FullMethodName   = "/anychat.user.UserService/EraseUserData"
 )
 
 // UserServiceClient is the client API for UserService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// UserService 用户服务
+// UserService user service
 type UserServiceClient interface {
-	// GetProfile 获取个人资料
+	// GetProfile get profile
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*UserProfileResponse, error)
-	// UpdateProfile 更新个人资料
+	// UpdateProfile update profile
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UserProfileResponse, error)
-	// GetUserInfo 获取用户信息（查询其他用户）
+	// SetAvatar set the avatar from an uploaded image
+	SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...grpc.CallOption) (*UserProfileResponse, error)
+	// GetUserInfo get user info (query other users)
 	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*UserInfoResponse, error)
-	// SearchUsers 搜索用户
+	// SearchUsers search users
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
-	// GetSettings 获取用户设置
+	// GetSettings get user settings
 	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*UserSettingsResponse, error)
-	// UpdateSettings 更新用户设置
+	// UpdateSettings update user settings
 	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*UserSettingsResponse, error)
-	// RefreshQRCode 刷新二维码
+	// RefreshQRCode refresh QR code
 	RefreshQRCode(ctx context.Context, in *RefreshQRCodeRequest, opts ...grpc.CallOption) (*QRCodeResponse, error)
-	// GetUserByQRCode 通过二维码获取用户
+	// GetUserByQRCode get user by QR code
 	GetUserByQRCode(ctx context.Context, in *GetUserByQRCodeRequest, opts ...grpc.CallOption) (*UserInfoResponse, error)
-	// UpdatePushToken 更新推送Token
+	// UpdatePushToken update push token
 	UpdatePushToken(ctx context.Context, in *UpdatePushTokenRequest, opts ...grpc.CallOption) (*common.Empty, error)
-	// BindPhone 绑定手机号
+	// BindPhone bind phone number
 	BindPhone(ctx context.Context, in *BindPhoneRequest, opts ...grpc.CallOption) (*BindPhoneResponse, error)
-	// ChangePhone 更换手机号
+	// ChangePhone change phone number
 	ChangePhone(ctx context.Context, in *ChangePhoneRequest, opts ...grpc.CallOption) (*ChangePhoneResponse, error)
-	// BindEmail 绑定邮箱
+	// BindEmail bind email
 	BindEmail(ctx context.Context, in *BindEmailRequest, opts ...grpc.CallOption) (*BindEmailResponse, error)
-	// ChangeEmail 更换邮箱
+	// ChangeEmail change email
 	ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*ChangeEmailResponse, error)
-	// InitUserData 初始化用户数据（供auth-service调用）
+	// InitUserData initialize user data (called by auth-service)
 	InitUserData(ctx context.Context, in *InitUserDataRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error)
 }
 
 type userServiceClient struct {
@@ -100,6 +106,16 @@ func (c *userServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfile
 	return out, nil
 }
 
+func (c *userServiceClient) SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...grpc.CallOption) (*UserProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_SetAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*UserInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserInfoResponse)
@@ -220,40 +236,54 @@ func (c *userServiceClient) InitUserData(ctx context.Context, in *InitUserDataRe
 	return out, nil
 }
 
+func (c *userServiceClient) EraseUserData(ctx context.Context, in *common.EraseUserDataRequest, opts ...grpc.CallOption) (*common.EraseUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.EraseUserDataResponse)
+	err := c.cc.Invoke(ctx, UserService_EraseUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 //
-// UserService 用户服务
+// UserService user service
 type UserServiceServer interface {
-	// GetProfile 获取个人资料
+	// GetProfile get profile
 	GetProfile(context.Context, *GetProfileRequest) (*UserProfileResponse, error)
-	// UpdateProfile 更新个人资料
+	// UpdateProfile update profile
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UserProfileResponse, error)
-	// GetUserInfo 获取用户信息（查询其他用户）
+	// SetAvatar set the avatar from an uploaded image
+	SetAvatar(context.Context, *SetAvatarRequest) (*UserProfileResponse, error)
+	// GetUserInfo get user info (query other users)
 	GetUserInfo(context.Context, *GetUserInfoRequest) (*UserInfoResponse, error)
-	// SearchUsers 搜索用户
+	// SearchUsers search users
 	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
-	// GetSettings 获取用户设置
+	// GetSettings get user settings
 	GetSettings(context.Context, *GetSettingsRequest) (*UserSettingsResponse, error)
-	// UpdateSettings 更新用户设置
+	// UpdateSettings update user settings
 	UpdateSettings(context.Context, *UpdateSettingsRequest) (*UserSettingsResponse, error)
-	// RefreshQRCode 刷新二维码
+	// RefreshQRCode refresh QR code
 	RefreshQRCode(context.Context, *RefreshQRCodeRequest) (*QRCodeResponse, error)
-	// GetUserByQRCode 通过二维码获取用户
+	// GetUserByQRCode get user by QR code
 	GetUserByQRCode(context.Context, *GetUserByQRCodeRequest) (*UserInfoResponse, error)
-	// UpdatePushToken 更新推送Token
+	// UpdatePushToken update push token
 	UpdatePushToken(context.Context, *UpdatePushTokenRequest) (*common.Empty, error)
-	// BindPhone 绑定手机号
+	// BindPhone bind phone number
 	BindPhone(context.Context, *BindPhoneRequest) (*BindPhoneResponse, error)
-	// ChangePhone 更换手机号
+	// ChangePhone change phone number
 	ChangePhone(context.Context, *ChangePhoneRequest) (*ChangePhoneResponse, error)
-	// BindEmail 绑定邮箱
+	// BindEmail bind email
 	BindEmail(context.Context, *BindEmailRequest) (*BindEmailResponse, error)
-	// ChangeEmail 更换邮箱
+	// ChangeEmail change email
 	ChangeEmail(context.Context, *ChangeEmailRequest) (*ChangeEmailResponse, error)
-	// InitUserData 初始化用户数据（供auth-service调用）
+	// InitUserData initialize user data (called by auth-service)
 	InitUserData(context.Context, *InitUserDataRequest) (*common.Empty, error)
+	// EraseUserData erase data of a deleted account (called by auth-service), safe to repeat
+	EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -270,6 +300,9 @@ func (UnimplementedUserServiceServer) GetProfile(context.Context, *GetProfileReq
 func (UnimplementedUserServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UserProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedUserServiceServer) SetAvatar(context.Context, *SetAvatarRequest) (*UserProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAvatar not implemented")
+}
 func (UnimplementedUserServiceServer) GetUserInfo(context.Context, *GetUserInfoRequest) (*UserInfoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserInfo not implemented")
 }
@@ -306,6 +339,9 @@ func (UnimplementedUserServiceServer) ChangeEmail(context.Context, *ChangeEmailR
 func (UnimplementedUserServiceServer) InitUserData(context.Context, *InitUserDataRequest) (*common.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method InitUserData not implemented")
 }
+func (UnimplementedUserServiceServer) EraseUserData(context.Context, *common.EraseUserDataRequest) (*common.EraseUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EraseUserData not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -363,6 +399,24 @@ func _UserService_UpdateProfile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SetAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetAvatar(ctx, req.(*SetAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserInfoRequest)
 	if err := dec(in); err != nil {
@@ -579,6 +633,24 @@ func _UserService_InitUserData_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).EraseUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_EraseUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EraseUserData(ctx, req.(*common.EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -594,6 +666,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _UserService_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "SetAvatar",
+			Handler:    _UserService_SetAvatar_Handler,
+		},
 		{
 			MethodName: "GetUserInfo",
 			Handler:    _UserService_GetUserInfo_Handler,
@@ -642,6 +718,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitUserData",
 			Handler:    _UserService_InitUserData_Handler,
 		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _UserService_EraseUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/user.proto",
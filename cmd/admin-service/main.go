@@ -61,30 +61,39 @@ func main() {
 
 	// Connect to downstream services
 	clientManager, err := adminclient.NewManager(
+		viper.GetString("services.auth.grpc_addr"),
 		viper.GetString("services.user.grpc_addr"),
 		viper.GetString("services.group.grpc_addr"),
 		viper.GetString("services.file.grpc_addr"),
+		viper.GetString("services.push.grpc_addr"),
 	)
 	if err != nil {
 		logger.Fatal("Failed to connect to backend services", zap.Error(err))
 	}
 	defer clientManager.Close()
 
-	// JWT manager (admin-specific separate secret)
-	jwtManager := jwt.NewManager(&jwt.Config{
-		Secret:            viper.GetString("admin.jwt.secret"),
-		AccessTokenExpire: time.Duration(viper.GetInt("admin.jwt.access_token_expire")) * time.Second,
+	// JWT manager: verify-only via cached JWKS. Admin tokens are signed by auth-service (aud=anychat-admin),
+	// admin-service never holds signing keys, so it cannot mint tokens for the user API
+	jwks := jwt.NewJWKSClient(jwt.JWKSClientConfig{
+		URL:      viper.GetString("services.auth.jwks_url"),
+		CacheTTL: time.Duration(viper.GetInt("jwt.jwks.cache_ttl_seconds")) * time.Second,
 	})
+	jwtManager := jwt.NewManager(&jwt.Config{
+		Issuer:       viper.GetString("jwt.issuer"),
+		Audience:     jwt.AudienceAdmin,
+		LegacySecret: viper.GetString("admin.jwt.legacy_secret"),
+	}, nil, jwks)
 
 	// Initialize business services
 	adminSvc := service.NewAdminService(
-		jwtManager,
 		adminRepo,
 		auditRepo,
 		configRepo,
+		clientManager.AuthClient,
 		clientManager.UserClient,
 		clientManager.GroupClient,
 		clientManager.FileClient,
+		clientManager.PushClient,
 	)
 
 	// Initialize gRPC server
@@ -162,11 +171,14 @@ func loadConfig() error {
 	viper.SetDefault("database.postgres.user", "anychat")
 	viper.SetDefault("database.postgres.password", "anychat123")
 	viper.SetDefault("database.postgres.database", "anychat")
+	viper.SetDefault("services.auth.grpc_addr", "localhost:9001")
 	viper.SetDefault("services.user.grpc_addr", "localhost:9002")
 	viper.SetDefault("services.group.grpc_addr", "localhost:9004")
 	viper.SetDefault("services.file.grpc_addr", "localhost:9005")
-	viper.SetDefault("admin.jwt.secret", "admin-secret-change-in-production")
-	viper.SetDefault("admin.jwt.access_token_expire", 28800)
+	viper.SetDefault("services.push.grpc_addr", "localhost:9008")
+	viper.SetDefault("services.auth.jwks_url", "http://localhost:8001/.well-known/jwks.json")
+	viper.SetDefault("jwt.issuer", "anychat")
+	viper.SetDefault("jwt.jwks.cache_ttl_seconds", 300)
 	viper.SetDefault("log.level", "info")
 	viper.SetDefault("log.output", "stdout")
 
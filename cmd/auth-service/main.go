@@ -5,18 +5,27 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
+	"slices"
+	"sort"
 	"strings"
 	"syscall"
 	"time"
 
 	authpb "github.com/anychat/server/api/proto/auth"
+	"github.com/anychat/server/internal/auth/captcha"
 	authclient "github.com/anychat/server/internal/auth/client"
+	"github.com/anychat/server/internal/auth/geoip"
 	authgrpc "github.com/anychat/server/internal/auth/grpc"
+	"github.com/anychat/server/internal/auth/keyring"
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/internal/auth/oidc"
 	"github.com/anychat/server/internal/auth/repository"
 	authsender "github.com/anychat/server/internal/auth/sender"
 	"github.com/anychat/server/internal/auth/service"
+	"github.com/anychat/server/internal/auth/worker"
 	"github.com/anychat/server/pkg/config"
 	"github.com/anychat/server/pkg/database"
 	grpcpkg "github.com/anychat/server/pkg/grpc"
@@ -68,8 +77,19 @@ func main() {
 	defer redisClient.Close()
 	logger.Info("Redis connected successfully")
 
-	// Initialize JWT manager
-	jwtManager := initJWT()
+	// Load signing keys, rotating first so a fresh deployment starts with a key
+	keyRing := keyring.NewKeyRing(repository.NewJWTSigningKeyRepository(db), initKeyRingConfig())
+	if err := keyRing.Rotate(context.Background()); err != nil {
+		logger.Fatal("Failed to load jwt signing keys", zap.Error(err))
+	}
+	keyRotationWorker := worker.NewKeyRotationWorker(keyRing, true,
+		time.Duration(viper.GetInt("jwt.keys.reload_interval_seconds"))*time.Second)
+	keyRotationWorker.StartAsync()
+	logger.Info("KeyRotationWorker started")
+
+	// Initialize JWT managers, admin tokens are signed here too so admin-service never holds the signing keys
+	jwtManager := initJWT(keyRing)
+	adminJWTManager := initAdminJWT(keyRing)
 
 	// Connect to user-service
 	userClient, err := authclient.NewUserClient(viper.GetString("services.user.grpc_addr"))
@@ -78,12 +98,33 @@ func main() {
 	}
 	defer userClient.Close()
 
+	// Connect to the services erasing data of deleted accounts
+	erasureClients, err := initErasureClients()
+	if err != nil {
+		logger.Fatal("Failed to connect to erasure services", zap.Error(err))
+	}
+	erasers := make([]service.UserDataEraser, 0, len(erasureClients))
+	for _, c := range erasureClients {
+		defer c.Close()
+		erasers = append(erasers, c)
+	}
+
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(db)
 	deviceRepo := repository.NewUserDeviceRepository(db)
 	sessionRepo := repository.NewUserSessionRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	qrEventRepo := repository.NewQRLoginEventRepository(db)
+	identityRepo := repository.NewUserIdentityRepository(db)
+	loginHistoryRepo := repository.NewUserLoginHistoryRepository(db)
+	deletionRepo := repository.NewAccountDeletionRepository(db)
+	mfaRepo := repository.NewUserMFARepository(db)
 	verifyCodeRepo := repository.NewVerificationCodeRepository(db)
 	verifyTemplateRepo := repository.NewVerificationTemplateRepository(db)
+	smsSender, err := initVerificationSMSSender()
+	if err != nil {
+		logger.Fatal("Failed to init verification sms sender", zap.Error(err))
+	}
 	emailSender, err := initVerificationEmailSender()
 	if err != nil {
 		logger.Fatal("Failed to init verification email sender", zap.Error(err))
@@ -93,7 +134,7 @@ func main() {
 		verifyCodeRepo,
 		verifyTemplateRepo,
 		redisClient,
-		nil,
+		smsSender,
 		emailSender,
 		service.Config{
 			AppMode:         viper.GetString("server.mode"),
@@ -121,10 +162,57 @@ func main() {
 	notificationPub := notification.NewPublisher(nc)
 
 	// Initialize services
-	authService := service.NewAuthService(userRepo, deviceRepo, sessionRepo, jwtManager, userClient, verifyService, notificationPub)
+	mfaService := service.NewMFAService(mfaRepo, userRepo, redisClient, service.MFAConfig{
+		Issuer:    viper.GetString("auth.mfa.issuer"),
+		SecretKey: viper.GetString("auth.mfa.secret_key"),
+	})
+	loginGuard := service.NewLoginGuard(redisClient, initCaptchaVerifier(), notificationPub, service.LoginGuardConfig{
+		AccountMaxFailures:   viper.GetInt("auth.login_guard.account_max_failures"),
+		IPMaxFailures:        viper.GetInt("auth.login_guard.ip_max_failures"),
+		FailureWindow:        time.Duration(viper.GetInt("auth.login_guard.failure_window_minutes")) * time.Minute,
+		LockoutDuration:      time.Duration(viper.GetInt("auth.login_guard.lockout_minutes")) * time.Minute,
+		DelayAfterFailures:   viper.GetInt("auth.login_guard.delay_after_failures"),
+		BaseDelay:            time.Duration(viper.GetInt("auth.login_guard.base_delay_seconds")) * time.Second,
+		MaxDelay:             time.Duration(viper.GetInt("auth.login_guard.max_delay_seconds")) * time.Second,
+		CaptchaAfterFailures: viper.GetInt("auth.login_guard.captcha_after_failures"),
+	})
+	// Login history and unusual login alerts
+	geoLocator, err := initGeoIPLocator()
+	if err != nil {
+		logger.Fatal("Failed to load GeoIP database", zap.Error(err))
+	}
+	loginMonitor := service.NewLoginMonitor(loginHistoryRepo, geoLocator, emailSender, notificationPub, redisClient, service.LoginMonitorConfig{
+		KnownWindow:    time.Duration(viper.GetInt("auth.unusual_login.known_window_days")) * 24 * time.Hour,
+		ScoreThreshold: viper.GetInt("auth.unusual_login.score_threshold"),
+		ReportURL:      viper.GetString("auth.unusual_login.report_url"),
+		ReportTTL:      time.Duration(viper.GetInt("auth.unusual_login.report_ttl_hours")) * time.Hour,
+	})
+
+	// OIDC identity providers, plus the in-process fake issuer when enabled
+	fakeIssuer := initFakeOIDCIssuer()
+	oidcProviders, err := initOIDCProviders(fakeIssuer)
+	if err != nil {
+		logger.Fatal("Failed to init OIDC providers", zap.Error(err))
+	}
+
+	authService := service.NewAuthService(userRepo, deviceRepo, sessionRepo, refreshTokenRepo, qrEventRepo, identityRepo, deletionRepo, jwtManager, adminJWTManager, userClient, verifyService, mfaService, loginGuard, loginMonitor, oidcProviders, notificationPub, redisClient, service.AuthConfig{
+		CodeLoginAutoRegister: viper.GetBool("auth.code_login.auto_register"),
+		QRLoginTTL:            time.Duration(viper.GetInt("auth.qr_login.ttl_seconds")) * time.Second,
+		SameTypeKick:          initSameTypeKickPolicy(),
+		OIDCStateTTL:          time.Duration(viper.GetInt("auth.oidc.state_ttl_seconds")) * time.Second,
+
+		AccountDeletionGracePeriod: time.Duration(viper.GetInt("auth.account_deletion.grace_period_days")) * 24 * time.Hour,
+	})
+
+	// Erase accounts whose deletion grace period ended
+	accountEraser := service.NewAccountEraser(deletionRepo, userRepo, erasers, notificationPub, viper.GetInt("auth.account_deletion.batch_size"))
+	accountDeletionWorker := worker.NewAccountDeletionWorker(accountEraser,
+		time.Duration(viper.GetInt("auth.account_deletion.erase_interval_seconds"))*time.Second)
+	accountDeletionWorker.StartAsync()
+	logger.Info("AccountDeletionWorker started")
 
 	// Initialize gRPC server
-	grpcServer := initGRPCServer(authService)
+	grpcServer := initGRPCServer(authService, mfaService)
 
 	// Start gRPC server
 	go func() {
@@ -139,13 +227,13 @@ func main() {
 		}
 	}()
 
-	// Initialize simplified HTTP server (health check only)
-	httpServer := initHTTPServer()
+	// Initialize simplified HTTP server (health check, JWKS and the fake OIDC issuer)
+	httpServer := initHTTPServer(keyRing, fakeIssuer)
 
 	// Start HTTP server
 	go func() {
 		addr := fmt.Sprintf(":%d", viper.GetInt("server.http_port"))
-		logger.Info("HTTP server listening (health check only)", zap.String("addr", addr))
+		logger.Info("HTTP server listening", zap.String("addr", addr))
 		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			logger.Fatal("HTTP server failed", zap.Error(err))
 		}
@@ -163,6 +251,9 @@ func main() {
 	// Stop gRPC server
 	grpcServer.GracefulStop()
 
+	keyRotationWorker.Stop()
+	accountDeletionWorker.Stop()
+
 	// Stop HTTP server
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -199,13 +290,53 @@ func loadConfig() error {
 	viper.SetDefault("database.redis.db", 0)
 	viper.SetDefault("database.redis.pool_size", 10)
 	viper.SetDefault("server.mode", "development")
-	viper.SetDefault("jwt.secret", "your-secret-key-change-in-production")
+	viper.SetDefault("jwt.issuer", "anychat")
 	viper.SetDefault("jwt.access_token_expire", 7200)
 	viper.SetDefault("jwt.refresh_token_expire", 604800)
+	viper.SetDefault("admin.jwt.access_token_expire", 28800)
+	viper.SetDefault("jwt.keys.algorithm", "RS256")
+	viper.SetDefault("jwt.keys.encryption_secret", "change-me-for-production")
+	viper.SetDefault("jwt.keys.rotation_interval_hours", 720)
+	viper.SetDefault("jwt.keys.pre_publish_hours", 24)
+	viper.SetDefault("jwt.keys.reload_interval_seconds", 60)
 	viper.SetDefault("log.level", "info")
 	viper.SetDefault("log.output", "stdout")
 	viper.SetDefault("services.auth.grpc_addr", "localhost:9001")
 	viper.SetDefault("services.user.grpc_addr", "localhost:9002")
+	viper.SetDefault("services.friend.grpc_addr", "localhost:9003")
+	viper.SetDefault("services.group.grpc_addr", "localhost:9004")
+	viper.SetDefault("services.message.grpc_addr", "localhost:9005")
+	viper.SetDefault("services.conversation.grpc_addr", "localhost:9006")
+	viper.SetDefault("services.file.grpc_addr", "localhost:9007")
+	viper.SetDefault("auth.code_login.auto_register", false)
+	viper.SetDefault("auth.qr_login.ttl_seconds", 120)
+	for _, deviceType := range sameTypeKickDeviceTypes {
+		viper.SetDefault("auth.device.same_type_kick."+deviceType.String(), true)
+	}
+	viper.SetDefault("auth.unusual_login.known_window_days", 90)
+	viper.SetDefault("auth.unusual_login.score_threshold", 60)
+	viper.SetDefault("auth.unusual_login.report_url", "http://localhost:3000/security/report-login")
+	viper.SetDefault("auth.unusual_login.report_ttl_hours", 168)
+	viper.SetDefault("auth.unusual_login.geoip_file", "")
+	viper.SetDefault("auth.account_deletion.grace_period_days", 14)
+	viper.SetDefault("auth.account_deletion.erase_interval_seconds", 300)
+	viper.SetDefault("auth.account_deletion.batch_size", 20)
+	viper.SetDefault("auth.oidc.state_ttl_seconds", 600)
+	viper.SetDefault("auth.oidc.fake_issuer.enabled", false)
+	viper.SetDefault("auth.oidc.fake_issuer.url", "http://localhost:8001/fake-oidc")
+	viper.SetDefault("auth.oidc.fake_issuer.redirect_url", "http://localhost/oidc/callback")
+	viper.SetDefault("auth.mfa.issuer", "AnyChat")
+	viper.SetDefault("auth.mfa.secret_key", "change-me-for-production")
+	viper.SetDefault("auth.login_guard.account_max_failures", 10)
+	viper.SetDefault("auth.login_guard.ip_max_failures", 100)
+	viper.SetDefault("auth.login_guard.failure_window_minutes", 15)
+	viper.SetDefault("auth.login_guard.lockout_minutes", 30)
+	viper.SetDefault("auth.login_guard.delay_after_failures", 3)
+	viper.SetDefault("auth.login_guard.base_delay_seconds", 1)
+	viper.SetDefault("auth.login_guard.max_delay_seconds", 30)
+	viper.SetDefault("auth.login_guard.captcha_after_failures", 5)
+	viper.SetDefault("auth.login_guard.captcha.provider", "")
+	viper.SetDefault("auth.login_guard.captcha.fake_token", "captcha-pass")
 	viper.SetDefault("verify.code.length", 6)
 	viper.SetDefault("verify.code.expire_seconds", 300)
 	viper.SetDefault("verify.code.max_attempts", 5)
@@ -216,6 +347,12 @@ func loadConfig() error {
 	viper.SetDefault("verify.rate_limit.target_per_day", 10)
 	viper.SetDefault("verify.rate_limit.ip_per_hour", 200)
 	viper.SetDefault("verify.rate_limit.device_per_day", 100)
+	viper.SetDefault("verify.sms.provider", "aliyun")
+	viper.SetDefault("verify.sms.failure_cooldown_seconds", 60)
+	viper.SetDefault("verify.sms.aliyun.sign_name", "AnyChat")
+	viper.SetDefault("verify.sms.tencent.sign_name", "AnyChat")
+	viper.SetDefault("verify.sms.tencent.region", "ap-guangzhou")
+	viper.SetDefault("verify.sms.fake.file", "/tmp/anychat-sms.log")
 	viper.SetDefault("verify.email.port", 587)
 	viper.SetDefault("verify.email.from_name", "AnyChat")
 	viper.SetDefault("verify.email.from_address", "noreply@anychat.com")
@@ -274,6 +411,83 @@ func initRedis() (*pkgredis.Client, error) {
 	})
 }
 
+// initVerificationSMSSender builds the SMS failover chain from verify.sms.provider, a comma separated priority list.
+// Providers without credentials are skipped, nil keeps the local fallback
+func initVerificationSMSSender() (service.SMSSender, error) {
+	var providers []authsender.SMSProvider
+	for _, name := range strings.Split(viper.GetString("verify.sms.provider"), ",") {
+		name = strings.TrimSpace(name)
+		switch name {
+		case "":
+			continue
+		case "aliyun":
+			if strings.TrimSpace(viper.GetString("verify.sms.aliyun.access_key")) == "" {
+				logger.Info("Aliyun SMS provider skipped, access key not configured")
+				continue
+			}
+			provider, err := authsender.NewAliyunSMSSender(authsender.AliyunSMSConfig{
+				AccessKey:    viper.GetString("verify.sms.aliyun.access_key"),
+				AccessSecret: viper.GetString("verify.sms.aliyun.access_secret"),
+				SignName:     viper.GetString("verify.sms.aliyun.sign_name"),
+				TemplateCode: viper.GetString("verify.sms.aliyun.template_code"),
+			})
+			if err != nil {
+				return nil, err
+			}
+			providers = append(providers, provider)
+		case "tencent":
+			if strings.TrimSpace(viper.GetString("verify.sms.tencent.secret_id")) == "" {
+				logger.Info("Tencent SMS provider skipped, secret id not configured")
+				continue
+			}
+			provider, err := authsender.NewTencentSMSSender(authsender.TencentSMSConfig{
+				SecretID:   viper.GetString("verify.sms.tencent.secret_id"),
+				SecretKey:  viper.GetString("verify.sms.tencent.secret_key"),
+				AppID:      viper.GetString("verify.sms.tencent.app_id"),
+				SignName:   viper.GetString("verify.sms.tencent.sign_name"),
+				TemplateID: viper.GetString("verify.sms.tencent.template_id"),
+				Region:     viper.GetString("verify.sms.tencent.region"),
+			})
+			if err != nil {
+				return nil, err
+			}
+			providers = append(providers, provider)
+		case "fake":
+			if viper.GetString("server.mode") == "release" {
+				logger.Fatal("Fake SMS provider must not be used in release mode")
+			}
+			provider, err := authsender.NewFileSMSSender(viper.GetString("verify.sms.fake.file"))
+			if err != nil {
+				return nil, err
+			}
+			logger.Warn("Fake SMS provider enabled, do not use in production",
+				zap.String("file", viper.GetString("verify.sms.fake.file")))
+			providers = append(providers, provider)
+		default:
+			return nil, fmt.Errorf("unsupported sms provider: %s", name)
+		}
+	}
+
+	if len(providers) == 0 {
+		logger.Info("SMS sender disabled; using local fallback for sms verification")
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(providers))
+	for _, provider := range providers {
+		names = append(names, provider.Name())
+	}
+	smsSender, err := authsender.NewFailoverSMSSender(
+		providers,
+		time.Duration(viper.GetInt("verify.sms.failure_cooldown_seconds"))*time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("SMS sender enabled", zap.Strings("providers", names))
+	return smsSender, nil
+}
+
 func initVerificationEmailSender() (service.EmailSender, error) {
 	host := strings.TrimSpace(viper.GetString("verify.email.host"))
 	if host == "" || host == "smtp.example.com" {
@@ -303,13 +517,198 @@ func initVerificationEmailSender() (service.EmailSender, error) {
 	return emailSender, nil
 }
 
+// initGeoIPLocator loads the offline GeoIP database used to locate logins, nil when no file is configured
+func initGeoIPLocator() (geoip.Locator, error) {
+	path := strings.TrimSpace(viper.GetString("auth.unusual_login.geoip_file"))
+	if path == "" {
+		logger.Info("GeoIP database not configured, login locations disabled")
+		return nil, nil
+	}
+	db, err := geoip.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("GeoIP database loaded", zap.String("file", path), zap.Int("ranges", db.Len()))
+	return db, nil
+}
+
+// erasureServices services erasing data of a deleted account, in erasure order
+var erasureServices = []string{"user", "friend", "group", "conversation", "message", "file"}
+
+// initErasureClients connects to the services that erase data of deleted accounts
+func initErasureClients() ([]*authclient.ErasureClient, error) {
+	clients := make([]*authclient.ErasureClient, 0, len(erasureServices))
+	for _, name := range erasureServices {
+		c, err := authclient.NewErasureClient(name, viper.GetString("services."+name+".grpc_addr"))
+		if err != nil {
+			for _, opened := range clients {
+				opened.Close()
+			}
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+	return clients, nil
+}
+
+// initCaptchaVerifier builds the CAPTCHA verifier used by the login guard, nil disables CAPTCHA challenges
+func initCaptchaVerifier() captcha.Verifier {
+	switch provider := viper.GetString("auth.login_guard.captcha.provider"); provider {
+	case "":
+		return nil
+	case "fake":
+		if viper.GetString("server.mode") == "release" {
+			logger.Fatal("Fake CAPTCHA verifier must not be used in release mode")
+		}
+		logger.Warn("Fake CAPTCHA verifier enabled, do not use in production")
+		return captcha.NewFakeVerifier(viper.GetString("auth.login_guard.captcha.fake_token"))
+	default:
+		logger.Fatal("Unsupported CAPTCHA provider", zap.String("provider", provider))
+		return nil
+	}
+}
+
+// oidcProvidersKeyPrefix providers are configured as auth.oidc.providers.<name>.<option>
+const oidcProvidersKeyPrefix = "auth.oidc.providers."
+
+// initOIDCProviders builds the identity providers from auth.oidc.providers, skipping those without issuer or client id
+func initOIDCProviders(fakeIssuer *oidc.FakeIssuer) (*oidc.Registry, error) {
+	var names []string
+	for _, key := range viper.AllKeys() {
+		if !strings.HasPrefix(key, oidcProvidersKeyPrefix) {
+			continue
+		}
+		name := strings.SplitN(strings.TrimPrefix(key, oidcProvidersKeyPrefix), ".", 2)[0]
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var providers []*oidc.Provider
+	for _, name := range names {
+		prefix := oidcProvidersKeyPrefix + name + "."
+		if strings.TrimSpace(viper.GetString(prefix+"issuer")) == "" || strings.TrimSpace(viper.GetString(prefix+"client_id")) == "" {
+			logger.Info("OIDC provider skipped, issuer or client id not configured", zap.String("provider", name))
+			continue
+		}
+		viper.SetDefault(prefix+"auto_register", true)
+		viper.SetDefault(prefix+"link_by_email", true)
+		provider, err := oidc.NewProvider(oidc.ProviderConfig{
+			Name:         name,
+			DisplayName:  viper.GetString(prefix + "display_name"),
+			Issuer:       viper.GetString(prefix + "issuer"),
+			ClientID:     viper.GetString(prefix + "client_id"),
+			ClientSecret: viper.GetString(prefix + "client_secret"),
+			RedirectURLs: splitList(viper.GetString(prefix + "redirect_urls")),
+			Scopes:       strings.Fields(viper.GetString(prefix + "scopes")),
+			AutoRegister: viper.GetBool(prefix + "auto_register"),
+			LinkByEmail:  viper.GetBool(prefix + "link_by_email"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+
+	if fakeIssuer != nil {
+		provider, err := oidc.NewProvider(oidc.ProviderConfig{
+			Name:         "fake",
+			DisplayName:  "Fake OIDC",
+			Issuer:       fakeIssuer.Issuer(),
+			ClientID:     "anychat-fake",
+			RedirectURLs: []string{viper.GetString("auth.oidc.fake_issuer.redirect_url")},
+			AutoRegister: true,
+			LinkByEmail:  true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+
+	for _, provider := range providers {
+		logger.Info("OIDC provider enabled", zap.String("provider", provider.Name()), zap.String("issuer", provider.Config().Issuer))
+	}
+	return oidc.NewRegistry(providers...), nil
+}
+
+// initFakeOIDCIssuer creates the in-process fake OIDC issuer used by integration tests, nil when disabled
+func initFakeOIDCIssuer() *oidc.FakeIssuer {
+	if !viper.GetBool("auth.oidc.fake_issuer.enabled") {
+		return nil
+	}
+	if viper.GetString("server.mode") == "release" {
+		logger.Fatal("Fake OIDC issuer must not be used in release mode")
+	}
+	issuer, err := oidc.NewFakeIssuer(viper.GetString("auth.oidc.fake_issuer.url"))
+	if err != nil {
+		logger.Fatal("Failed to create fake OIDC issuer", zap.Error(err))
+	}
+	logger.Warn("Fake OIDC issuer enabled, do not use in production", zap.String("issuer", issuer.Issuer()))
+	return issuer
+}
+
+// splitList splits a comma separated config value
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// sameTypeKickDeviceTypes device types with a configurable same type kick policy
+var sameTypeKickDeviceTypes = []model.DeviceType{
+	model.DeviceTypeIOS,
+	model.DeviceTypeAndroid,
+	model.DeviceTypeWeb,
+	model.DeviceTypePC,
+	model.DeviceTypeH5,
+}
+
+// initSameTypeKickPolicy reads whether a login signs out other devices of the same type, per device type
+func initSameTypeKickPolicy() map[model.DeviceType]bool {
+	policy := make(map[model.DeviceType]bool, len(sameTypeKickDeviceTypes))
+	for _, deviceType := range sameTypeKickDeviceTypes {
+		policy[deviceType] = viper.GetBool("auth.device.same_type_kick." + deviceType.String())
+	}
+	return policy
+}
+
+// initKeyRingConfig builds signing key ring configuration
+func initKeyRingConfig() keyring.Config {
+	return keyring.Config{
+		Algorithm:        viper.GetString("jwt.keys.algorithm"),
+		EncryptionSecret: viper.GetString("jwt.keys.encryption_secret"),
+		RotationInterval: time.Duration(viper.GetInt("jwt.keys.rotation_interval_hours")) * time.Hour,
+		PrePublish:       time.Duration(viper.GetInt("jwt.keys.pre_publish_hours")) * time.Hour,
+		// a retired key must verify every refresh token it signed, plus one reload for replicas still signing with it
+		RetiredKeyTTL: time.Duration(viper.GetInt("jwt.refresh_token_expire"))*time.Second +
+			time.Duration(viper.GetInt("jwt.keys.reload_interval_seconds"))*time.Second,
+	}
+}
+
 // initJWT initializes JWT manager
-func initJWT() *jwt.Manager {
+func initJWT(keyRing *keyring.KeyRing) *jwt.Manager {
 	return jwt.NewManager(&jwt.Config{
-		Secret:             viper.GetString("jwt.secret"),
+		Issuer:             viper.GetString("jwt.issuer"),
+		Audience:           jwt.AudienceAPI,
 		AccessTokenExpire:  time.Duration(viper.GetInt("jwt.access_token_expire")) * time.Second,
 		RefreshTokenExpire: time.Duration(viper.GetInt("jwt.refresh_token_expire")) * time.Second,
-	})
+		LegacySecret:       viper.GetString("jwt.legacy_secret"),
+	}, keyRing, keyRing)
+}
+
+// initAdminJWT initializes JWT manager of admin console tokens
+func initAdminJWT(keyRing *keyring.KeyRing) *jwt.Manager {
+	return jwt.NewManager(&jwt.Config{
+		Issuer:            viper.GetString("jwt.issuer"),
+		Audience:          jwt.AudienceAdmin,
+		AccessTokenExpire: time.Duration(viper.GetInt("admin.jwt.access_token_expire")) * time.Second,
+	}, keyRing, keyRing)
 }
 
 // connectNATS connects to NATS
@@ -329,7 +728,7 @@ func connectNATS() (*nats.Conn, error) {
 }
 
 // initGRPCServer initializes gRPC server
-func initGRPCServer(authService service.AuthService) *grpc.Server {
+func initGRPCServer(authService service.AuthService, mfaService service.MFAService) *grpc.Server {
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			grpcpkg.RecoveryInterceptor(),
@@ -337,13 +736,13 @@ func initGRPCServer(authService service.AuthService) *grpc.Server {
 		),
 	)
 
-	authpb.RegisterAuthServiceServer(grpcServer, authgrpc.NewAuthServer(authService))
+	authpb.RegisterAuthServiceServer(grpcServer, authgrpc.NewAuthServer(authService, mfaService))
 
 	return grpcServer
 }
 
-// initHTTPServer initializes HTTP server (health check only)
-func initHTTPServer() *http.Server {
+// initHTTPServer initializes HTTP server (health check, JWKS and the fake OIDC issuer)
+func initHTTPServer(keyRing *keyring.KeyRing, fakeIssuer *oidc.FakeIssuer) *http.Server {
 	// Set Gin mode
 	if viper.GetString("server.mode") == "release" {
 		gin.SetMode(gin.ReleaseMode)
@@ -361,6 +760,22 @@ func initHTTPServer() *http.Server {
 		})
 	})
 
+	// Public keys for verifying tokens, includes the next and retired keys
+	r.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, keyRing.JWKS())
+	})
+
+	// Fake OIDC issuer endpoints, served under the path of its issuer URL
+	if fakeIssuer != nil {
+		issuerURL, err := url.Parse(fakeIssuer.Issuer())
+		if err != nil {
+			logger.Fatal("Invalid fake OIDC issuer URL", zap.Error(err))
+		}
+		prefix := strings.TrimRight(issuerURL.Path, "/")
+		r.Any(prefix+"/*path", gin.WrapH(http.StripPrefix(prefix, fakeIssuer.Handler())))
+	}
+
 	return &http.Server{
 		Addr:    fmt.Sprintf(":%d", viper.GetInt("server.http_port")),
 		Handler: r,
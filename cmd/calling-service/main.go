@@ -15,6 +15,7 @@ import (
 	callinggrpc "github.com/anychat/server/internal/calling/grpc"
 	"github.com/anychat/server/internal/calling/repository"
 	"github.com/anychat/server/internal/calling/service"
+	"github.com/anychat/server/internal/calling/worker"
 	"github.com/anychat/server/pkg/config"
 	"github.com/anychat/server/pkg/database"
 	grpcpkg "github.com/anychat/server/pkg/grpc"
@@ -85,8 +86,18 @@ func main() {
 		callRepo,
 		meetingRepo,
 		notificationPub,
+		time.Duration(viper.GetInt("livekit.ring_timeout_seconds"))*time.Second,
 	)
 
+	// Initialize and start ring timeout worker
+	ringTimeoutWorker := worker.NewRingTimeoutWorker(
+		lkSvc,
+		100,
+		5*time.Second,
+	)
+	ringTimeoutWorker.StartAsync()
+	logger.Info("RingTimeoutWorker started")
+
 	// Initialize and start gRPC server
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
@@ -125,6 +136,11 @@ func main() {
 	<-quit
 
 	logger.Info("Shutting down gracefully...")
+
+	// Stop ring timeout worker
+	ringTimeoutWorker.Stop()
+	logger.Info("RingTimeoutWorker stopped")
+
 	grpcServer.GracefulStop()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -156,6 +172,7 @@ func loadConfig() error {
 	viper.SetDefault("livekit.url", "ws://localhost:7880")
 	viper.SetDefault("livekit.api_key", "devkey")
 	viper.SetDefault("livekit.api_secret", "secret")
+	viper.SetDefault("livekit.ring_timeout_seconds", 60)
 	viper.SetDefault("log.level", "info")
 	viper.SetDefault("log.output", "stdout")
 	viper.SetDefault("services.friend.grpc_addr", "localhost:9003")
@@ -7,22 +7,29 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	filepb "github.com/anychat/server/api/proto/file"
+	grouppb "github.com/anychat/server/api/proto/group"
 	filegrpc "github.com/anychat/server/internal/file/grpc"
 	"github.com/anychat/server/internal/file/repository"
+	"github.com/anychat/server/internal/file/scanner"
 	"github.com/anychat/server/internal/file/service"
+	"github.com/anychat/server/internal/file/worker"
 	"github.com/anychat/server/pkg/config"
 	"github.com/anychat/server/pkg/database"
 	grpcpkg "github.com/anychat/server/pkg/grpc"
 	"github.com/anychat/server/pkg/logger"
 	minioclient "github.com/anychat/server/pkg/minio"
+	"github.com/anychat/server/pkg/notification"
 	"github.com/gin-gonic/gin"
+	"github.com/nats-io/nats.go"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/gorm"
 	gormLogger "gorm.io/gorm/logger"
 )
@@ -62,11 +69,122 @@ func main() {
 	}
 	logger.Info("MinIO connected successfully")
 
+	// Connect to group service (membership checks of file access grants)
+	groupConn, groupClient, err := connectGroupService()
+	if err != nil {
+		logger.Fatal("Failed to connect group-service", zap.Error(err))
+	}
+	defer groupConn.Close()
+
+	// Connect to NATS
+	nc, err := connectNATS()
+	if err != nil {
+		logger.Fatal("Failed to connect to NATS", zap.Error(err))
+	}
+	logger.Info("Connected to NATS")
+
+	// Initialize notification publisher
+	notificationPub := notification.NewPublisher(nc)
+
 	// Initialize repositories
 	fileRepo := repository.NewFileRepository(db)
+	uploadRepo := repository.NewFileUploadRepository(db)
+	grantRepo := repository.NewFileGrantRepository(db)
+	jobRepo := repository.NewFileProcessingJobRepository(db)
+	blobRepo := repository.NewFileBlobRepository(db)
+	usageRepo := repository.NewStorageUsageRepository(db)
+	deletionRepo := repository.NewFileObjectDeletionRepository(db)
+	scanRepo := repository.NewFileScanRepository(db)
 
 	// Initialize services
-	fileService := service.NewFileService(fileRepo, minioClient, db)
+	multipartConfig := service.MultipartConfig{
+		PartSize:      viper.GetInt64("file.multipart.part_size_mb") * 1024 * 1024,
+		UploadTTL:     time.Duration(viper.GetInt("file.multipart.upload_ttl_hours")) * time.Hour,
+		PartURLExpire: time.Duration(viper.GetInt("file.multipart.part_url_expire_minutes")) * time.Minute,
+	}
+	processingConfig := service.ProcessingConfig{
+		ThumbnailSizes:       viper.GetIntSlice("file.processing.thumbnail_sizes"),
+		DefaultThumbnailSize: viper.GetInt("file.processing.default_thumbnail_size"),
+		ThumbnailQuality:     viper.GetInt("file.processing.thumbnail_quality"),
+		StripImageMetadata:   viper.GetBool("file.processing.strip_image_metadata"),
+		MaxImageBytes:        viper.GetInt64("file.processing.max_image_mb") * 1024 * 1024,
+		MaxImagePixels:       viper.GetInt("file.processing.max_image_megapixels") * 1000 * 1000,
+		MaxAttempts:          viper.GetInt("file.processing.max_attempts"),
+		RetryBackoff:         time.Duration(viper.GetInt("file.processing.retry_backoff_seconds")) * time.Second,
+		Lease:                time.Duration(viper.GetInt("file.processing.lease_seconds")) * time.Second,
+	}
+	quotaConfig := service.QuotaConfig{
+		DefaultTier: viper.GetString("file.quota.default_tier"),
+		Tiers:       make(map[string]int64),
+		GroupBytes:  viper.GetInt64("file.quota.group_mb") * 1024 * 1024,
+	}
+	for tier := range viper.GetStringMap("file.quota.tiers_mb") {
+		quotaConfig.Tiers[tier] = viper.GetInt64("file.quota.tiers_mb."+tier) * 1024 * 1024
+	}
+	lifecycleConfig := service.LifecycleConfig{
+		ExpiryWarning:        time.Duration(viper.GetInt("file.lifecycle.expiry_warning_seconds")) * time.Second,
+		PendingTTL:           time.Duration(viper.GetInt("file.lifecycle.pending_ttl_seconds")) * time.Second,
+		DeletionRetryBackoff: time.Duration(viper.GetInt("file.lifecycle.deletion_retry_backoff_seconds")) * time.Second,
+		DeletionMaxBackoff:   time.Duration(viper.GetInt("file.lifecycle.deletion_max_backoff_seconds")) * time.Second,
+	}
+	avatarConfig := service.AvatarConfig{
+		PublicBaseURL:  viper.GetString("file.avatar.public_base_url"),
+		Sizes:          viper.GetIntSlice("file.avatar.sizes"),
+		MaxSourceBytes: viper.GetInt64("file.avatar.max_source_mb") * 1024 * 1024,
+		MinSourceEdge:  viper.GetInt("file.avatar.min_source_edge"),
+		Quality:        viper.GetInt("file.avatar.quality"),
+		CompositeSize:  viper.GetInt("file.avatar.composite_size"),
+	}
+	if len(avatarConfig.Sizes) == 0 {
+		avatarConfig.Sizes = service.DefaultAvatarConfig().Sizes
+	}
+	scanConfig := service.ScanConfig{
+		MaxFileBytes: viper.GetInt64("file.scan.max_file_mb") * 1024 * 1024,
+		MaxAttempts:  viper.GetInt("file.scan.max_attempts"),
+		RetryBackoff: time.Duration(viper.GetInt("file.scan.retry_backoff_seconds")) * time.Second,
+		Lease:        time.Duration(viper.GetInt("file.scan.lease_seconds")) * time.Second,
+	}
+	fileScanner, err := initFileScanner()
+	if err != nil {
+		logger.Fatal("Failed to init file scanner", zap.Error(err))
+	}
+	fileService := service.NewFileService(fileRepo, uploadRepo, grantRepo, jobRepo, blobRepo, usageRepo, deletionRepo, scanRepo, minioClient, fileScanner, groupClient, notificationPub, db, multipartConfig, processingConfig, quotaConfig, lifecycleConfig, avatarConfig, scanConfig)
+
+	// Start expired multipart upload cleanup
+	uploadCleanupWorker := worker.NewUploadCleanupWorker(
+		fileService,
+		viper.GetInt("file.multipart.cleanup_batch_size"),
+		time.Duration(viper.GetInt("file.multipart.cleanup_interval_seconds"))*time.Second,
+	)
+	uploadCleanupWorker.StartAsync()
+
+	// Start thumbnail and metadata processing of completed uploads
+	mediaProcessingWorker := worker.NewMediaProcessingWorker(
+		fileService,
+		viper.GetInt("file.processing.batch_size"),
+		time.Duration(viper.GetInt("file.processing.interval_seconds"))*time.Second,
+		processingConfig.Lease,
+	)
+	mediaProcessingWorker.StartAsync()
+
+	// Start malware scanning of completed uploads
+	fileScanWorker := worker.NewFileScanWorker(
+		fileService,
+		viper.GetInt("file.scan.batch_size"),
+		time.Duration(viper.GetInt("file.scan.interval_seconds"))*time.Second,
+		scanConfig.Lease,
+	)
+	if fileScanner != nil {
+		fileScanWorker.StartAsync()
+	}
+
+	// Start file expiry, abandoned upload purge and object deletion retries
+	fileLifecycleWorker := worker.NewFileLifecycleWorker(
+		fileService,
+		viper.GetInt("file.lifecycle.batch_size"),
+		time.Duration(viper.GetInt("file.lifecycle.interval_seconds"))*time.Second,
+	)
+	fileLifecycleWorker.StartAsync()
 
 	// Initialize gRPC server
 	grpcServer := initGRPCServer(fileService)
@@ -105,6 +223,13 @@ func main() {
 
 	logger.Info("Shutting down gracefully...")
 
+	uploadCleanupWorker.Stop()
+	mediaProcessingWorker.Stop()
+	if fileScanner != nil {
+		fileScanWorker.Stop()
+	}
+	fileLifecycleWorker.Stop()
+
 	// Stop gRPC server
 	grpcServer.GracefulStop()
 
@@ -115,6 +240,11 @@ func main() {
 		logger.Error("HTTP server shutdown error", zap.Error(err))
 	}
 
+	// Close NATS connection
+	if nc != nil {
+		nc.Close()
+	}
+
 	// Close database
 	if sqlDB, err := db.DB(); err == nil {
 		sqlDB.Close()
@@ -149,7 +279,50 @@ func loadConfig() error {
 	viper.SetDefault("minio.access_key", "minioadmin")
 	viper.SetDefault("minio.secret_key", "minioadmin")
 	viper.SetDefault("minio.use_ssl", false)
-	viper.SetDefault("minio.buckets", []string{"avatar", "group-avatar", "chat-file"})
+	viper.SetDefault("minio.buckets", []string{"avatar", "group-avatar", "chat-file", "quarantine"})
+	viper.SetDefault("minio.public_buckets", []string{"avatar", "group-avatar"})
+	viper.SetDefault("nats.url", "nats://localhost:4222")
+	viper.SetDefault("services.group.grpc_addr", "localhost:9004")
+	viper.SetDefault("file.multipart.part_size_mb", 8)
+	viper.SetDefault("file.multipart.upload_ttl_hours", 24)
+	viper.SetDefault("file.multipart.part_url_expire_minutes", 60)
+	viper.SetDefault("file.multipart.cleanup_interval_seconds", 600)
+	viper.SetDefault("file.multipart.cleanup_batch_size", 100)
+	viper.SetDefault("file.processing.thumbnail_sizes", []int{160, 480, 1080})
+	viper.SetDefault("file.processing.default_thumbnail_size", 480)
+	viper.SetDefault("file.processing.thumbnail_quality", 80)
+	viper.SetDefault("file.processing.strip_image_metadata", true)
+	viper.SetDefault("file.processing.max_image_mb", 50)
+	viper.SetDefault("file.processing.max_image_megapixels", 50)
+	viper.SetDefault("file.processing.max_attempts", 5)
+	viper.SetDefault("file.processing.retry_backoff_seconds", 30)
+	viper.SetDefault("file.processing.lease_seconds", 300)
+	viper.SetDefault("file.processing.interval_seconds", 5)
+	viper.SetDefault("file.processing.batch_size", 5)
+	viper.SetDefault("file.quota.default_tier", "standard")
+	viper.SetDefault("file.quota.tiers_mb", map[string]int{"standard": 2048, "premium": 20480})
+	viper.SetDefault("file.quota.group_mb", 10240)
+	viper.SetDefault("file.lifecycle.interval_seconds", 60)
+	viper.SetDefault("file.lifecycle.batch_size", 100)
+	viper.SetDefault("file.lifecycle.expiry_warning_seconds", 86400)
+	viper.SetDefault("file.lifecycle.pending_ttl_seconds", 86400)
+	viper.SetDefault("file.lifecycle.deletion_retry_backoff_seconds", 30)
+	viper.SetDefault("file.lifecycle.deletion_max_backoff_seconds", 3600)
+	viper.SetDefault("file.avatar.public_base_url", "http://localhost:9000")
+	viper.SetDefault("file.avatar.sizes", []int{640, 160})
+	viper.SetDefault("file.avatar.max_source_mb", 10)
+	viper.SetDefault("file.avatar.min_source_edge", 64)
+	viper.SetDefault("file.avatar.quality", 85)
+	viper.SetDefault("file.avatar.composite_size", 320)
+	viper.SetDefault("file.scan.scanner", "")
+	viper.SetDefault("file.scan.clamd.address", "localhost:3310")
+	viper.SetDefault("file.scan.clamd.timeout_seconds", 120)
+	viper.SetDefault("file.scan.max_file_mb", 100)
+	viper.SetDefault("file.scan.max_attempts", 5)
+	viper.SetDefault("file.scan.retry_backoff_seconds", 30)
+	viper.SetDefault("file.scan.lease_seconds", 300)
+	viper.SetDefault("file.scan.interval_seconds", 2)
+	viper.SetDefault("file.scan.batch_size", 10)
 
 	// Auto-read environment variables
 	viper.AutomaticEnv()
@@ -166,6 +339,42 @@ func loadConfig() error {
 	return nil
 }
 
+// initFileScanner creates the malware scanner selected by file.scan.scanner, nil turns scanning off
+func initFileScanner() (scanner.Scanner, error) {
+	switch name := strings.TrimSpace(viper.GetString("file.scan.scanner")); name {
+	case "":
+		logger.Info("Malware scanning disabled, uploads are activated without a scan")
+		return nil, nil
+	case "clamd":
+		clamd, err := scanner.NewClamdScanner(scanner.ClamdConfig{
+			Address: viper.GetString("file.scan.clamd.address"),
+			Timeout: time.Duration(viper.GetInt("file.scan.clamd.timeout_seconds")) * time.Second,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// a daemon that is still starting only delays the scans, uploads wait for it
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := clamd.Ping(ctx); err != nil {
+			logger.Warn("clamd is not reachable yet", zap.String("address", viper.GetString("file.scan.clamd.address")), zap.Error(err))
+		}
+		return clamd, nil
+	case "fake":
+		if viper.GetString("server.mode") == "release" {
+			logger.Fatal("Fake file scanner must not be used in release mode")
+		}
+		fake, err := scanner.NewFakeScanner(nil)
+		if err != nil {
+			return nil, err
+		}
+		logger.Warn("Fake file scanner enabled, only the EICAR test file is detected; do not use in production")
+		return fake, nil
+	default:
+		return nil, fmt.Errorf("unsupported file scanner: %s", name)
+	}
+}
+
 // initLogger initializes logger
 func initLogger() error {
 	return logger.Init(&logger.Config{
@@ -198,14 +407,42 @@ func initDatabase() (*gorm.DB, error) {
 // initMinIO initializes MinIO client
 func initMinIO() (*minioclient.Client, error) {
 	return minioclient.NewClient(&minioclient.Config{
-		Endpoint:  viper.GetString("minio.endpoint"),
-		AccessKey: viper.GetString("minio.access_key"),
-		SecretKey: viper.GetString("minio.secret_key"),
-		UseSSL:    viper.GetBool("minio.use_ssl"),
-		Buckets:   viper.GetStringSlice("minio.buckets"),
+		Endpoint:      viper.GetString("minio.endpoint"),
+		AccessKey:     viper.GetString("minio.access_key"),
+		SecretKey:     viper.GetString("minio.secret_key"),
+		UseSSL:        viper.GetBool("minio.use_ssl"),
+		Buckets:       viper.GetStringSlice("minio.buckets"),
+		PublicBuckets: viper.GetStringSlice("minio.public_buckets"),
 	})
 }
 
+// connectNATS connects to NATS
+func connectNATS() (*nats.Conn, error) {
+	natsURL := viper.GetString("nats.url")
+	nc, err := nats.Connect(natsURL,
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			logger.Warn("NATS disconnected", zap.Error(err))
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			logger.Info("NATS reconnected", zap.String("url", nc.ConnectedUrl()))
+		}),
+		nats.ClosedHandler(func(nc *nats.Conn) {
+			logger.Warn("NATS connection closed")
+		}),
+	)
+	return nc, err
+}
+
+// connectGroupService connects to group service
+func connectGroupService() (*grpc.ClientConn, grouppb.GroupServiceClient, error) {
+	addr := viper.GetString("services.group.grpc_addr")
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect group service: %w", err)
+	}
+	return conn, grouppb.NewGroupServiceClient(conn), nil
+}
+
 // initGRPCServer initializes gRPC server
 func initGRPCServer(fileService service.FileService) *grpc.Server {
 	grpcServer := grpc.NewServer(
@@ -156,9 +156,11 @@ func loadConfig() error {
 	viper.SetDefault("services.calling.grpc_addr", "localhost:9009")
 	viper.SetDefault("services.version.grpc_addr", "localhost:9012")
 	viper.SetDefault("nats.url", "nats://localhost:4222")
-	viper.SetDefault("jwt.secret", "your-secret-key-change-in-production")
+	viper.SetDefault("services.auth.jwks_url", "http://localhost:8001/.well-known/jwks.json")
+	viper.SetDefault("jwt.issuer", "anychat")
 	viper.SetDefault("jwt.access_token_expire", 7200)
 	viper.SetDefault("jwt.refresh_token_expire", 604800)
+	viper.SetDefault("jwt.jwks.cache_ttl_seconds", 300)
 	viper.SetDefault("log.level", "info")
 	viper.SetDefault("log.output", "stdout")
 	viper.SetDefault("server.mode", "development")
@@ -197,13 +199,26 @@ func initLogger() error {
 	})
 }
 
-// initJWT initializes JWT manager
+// initJWT initializes a verify-only JWT manager backed by auth-service JWKS
 func initJWT() *jwt.Manager {
+	jwks := jwt.NewJWKSClient(jwt.JWKSClientConfig{
+		URL:      viper.GetString("services.auth.jwks_url"),
+		CacheTTL: time.Duration(viper.GetInt("jwt.jwks.cache_ttl_seconds")) * time.Second,
+	})
+	// warm the cache, keys are fetched on demand if auth-service is not up yet
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := jwks.Refresh(ctx); err != nil {
+		logger.Warn("Failed to fetch JWKS, will retry on first request", zap.Error(err))
+	}
+
 	return jwt.NewManager(&jwt.Config{
-		Secret:             viper.GetString("jwt.secret"),
+		Issuer:             viper.GetString("jwt.issuer"),
+		Audience:           jwt.AudienceAPI,
 		AccessTokenExpire:  time.Duration(viper.GetInt("jwt.access_token_expire")) * time.Second,
 		RefreshTokenExpire: time.Duration(viper.GetInt("jwt.refresh_token_expire")) * time.Second,
-	})
+		LegacySecret:       viper.GetString("jwt.legacy_secret"),
+	}, nil, jwks)
 }
 
 // connectNATS connects to NATS
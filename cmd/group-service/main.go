@@ -10,12 +10,14 @@ import (
 	"syscall"
 	"time"
 
+	filepb "github.com/anychat/server/api/proto/file"
 	grouppb "github.com/anychat/server/api/proto/group"
 	messagepb "github.com/anychat/server/api/proto/message"
 	userpb "github.com/anychat/server/api/proto/user"
 	groupgrpc "github.com/anychat/server/internal/group/grpc"
 	"github.com/anychat/server/internal/group/repository"
 	"github.com/anychat/server/internal/group/service"
+	"github.com/anychat/server/internal/group/worker"
 	"github.com/anychat/server/pkg/config"
 	"github.com/anychat/server/pkg/database"
 	grpcpkg "github.com/anychat/server/pkg/grpc"
@@ -73,6 +75,13 @@ func main() {
 	}
 	logger.Info("Connected to message-service")
 
+	// Connect to file-service
+	fileClient, err := connectFileService()
+	if err != nil {
+		logger.Fatal("Failed to connect to file-service", zap.Error(err))
+	}
+	logger.Info("Connected to file-service")
+
 	// Connect to NATS
 	nc, err := connectNATS()
 	if err != nil {
@@ -92,7 +101,15 @@ func main() {
 	qrcodeRepo := repository.NewGroupQRCodeRepository(db)
 
 	// Initialize services
-	groupService := service.NewGroupService(groupRepo, memberRepo, settingRepo, joinRequestRepo, pinnedRepo, qrcodeRepo, messageClient, userClient, notificationPub, db)
+	groupService := service.NewGroupService(groupRepo, memberRepo, settingRepo, joinRequestRepo, pinnedRepo, qrcodeRepo, messageClient, userClient, fileClient, notificationPub, db)
+
+	// Start composite avatar regeneration of groups whose members changed
+	groupAvatarWorker := worker.NewGroupAvatarWorker(
+		groupService,
+		viper.GetInt("group.avatar.batch_size"),
+		time.Duration(viper.GetInt("group.avatar.interval_seconds"))*time.Second,
+	)
+	groupAvatarWorker.StartAsync()
 
 	// Initialize gRPC server
 	grpcServer := initGRPCServer(groupService)
@@ -131,6 +148,8 @@ func main() {
 
 	logger.Info("Shutting down gracefully...")
 
+	groupAvatarWorker.Stop()
+
 	// Stop gRPC server
 	grpcServer.GracefulStop()
 
@@ -175,6 +194,9 @@ func loadConfig() error {
 	viper.SetDefault("services.user.grpc_addr", "localhost:9002")
 	viper.SetDefault("services.message.grpc_addr", "localhost:9005")
 	viper.SetDefault("services.group.grpc_addr", "localhost:9004")
+	viper.SetDefault("services.file.grpc_addr", "localhost:9007")
+	viper.SetDefault("group.avatar.interval_seconds", 10)
+	viper.SetDefault("group.avatar.batch_size", 50)
 
 	// Auto-read environment variables
 	viper.AutomaticEnv()
@@ -248,6 +270,20 @@ func connectMessageService() (messagepb.MessageServiceClient, error) {
 	return messagepb.NewMessageServiceClient(conn), nil
 }
 
+// connectFileService connects to file-service
+func connectFileService() (filepb.FileServiceClient, error) {
+	addr := viper.GetString("services.file.grpc_addr")
+	conn, err := grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to file service: %w", err)
+	}
+
+	return filepb.NewFileServiceClient(conn), nil
+}
+
 // connectNATS connects to NATS
 func connectNATS() (*nats.Conn, error) {
 	natsURL := viper.GetString("nats.url")
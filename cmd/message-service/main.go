@@ -11,6 +11,7 @@ import (
 	"time"
 
 	conversationpb "github.com/anychat/server/api/proto/conversation"
+	filepb "github.com/anychat/server/api/proto/file"
 	friendpb "github.com/anychat/server/api/proto/friend"
 	grouppb "github.com/anychat/server/api/proto/group"
 	messagepb "github.com/anychat/server/api/proto/message"
@@ -101,12 +102,20 @@ func main() {
 	}
 	defer friendConn.Close()
 
+	fileConn, fileClient, err := connectFileService()
+	if err != nil {
+		logger.Fatal("Failed to connect file-service", zap.Error(err))
+	}
+	defer fileConn.Close()
+
 	// Initialize repositories
 	messageRepo := repository.NewMessageRepository(db)
 	readReceiptRepo := repository.NewReadReceiptRepository(db)
 	sequenceRepo := repository.NewSequenceRepository(db)
 	sendIdempotencyRepo := repository.NewSendIdempotencyRepository(db)
+	mediaRepo := repository.NewMessageMediaRepository(db)
 	typingRepo := repository.NewTypingRepository(redisClient)
+	slowModeRepo := repository.NewSlowModeRepository(redisClient)
 
 	// Initialize services
 	messageService := service.NewMessageService(
@@ -114,7 +123,9 @@ func main() {
 		readReceiptRepo,
 		sequenceRepo,
 		sendIdempotencyRepo,
+		mediaRepo,
 		typingRepo,
+		slowModeRepo,
 		service.TypingConfig{
 			DefaultTTL:   time.Duration(viper.GetInt("typing.default_ttl_seconds")) * time.Second,
 			MinTTL:       time.Duration(viper.GetInt("typing.min_ttl_seconds")) * time.Second,
@@ -124,6 +135,7 @@ func main() {
 		conversationClient,
 		friendClient,
 		groupClient,
+		fileClient,
 		notificationPub,
 		db,
 	)
@@ -131,6 +143,7 @@ func main() {
 	// Initialize and start auto delete worker
 	autoDeleteWorker := worker.NewAutoDeleteWorker(
 		messageRepo,
+		fileClient,
 		notificationPub,
 		1000,
 		1*time.Minute,
@@ -231,6 +244,7 @@ func loadConfig() error {
 	viper.SetDefault("services.conversation.grpc_addr", "localhost:9006")
 	viper.SetDefault("services.group.grpc_addr", "localhost:9004")
 	viper.SetDefault("services.friend.grpc_addr", "localhost:9003")
+	viper.SetDefault("services.file.grpc_addr", "localhost:9007")
 
 	// Auto-read environment variables
 	viper.AutomaticEnv()
@@ -342,6 +356,15 @@ func connectFriendService() (*grpc.ClientConn, friendpb.FriendServiceClient, err
 	return conn, friendpb.NewFriendServiceClient(conn), nil
 }
 
+func connectFileService() (*grpc.ClientConn, filepb.FileServiceClient, error) {
+	addr := viper.GetString("services.file.grpc_addr")
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect file service: %w", err)
+	}
+	return conn, filepb.NewFileServiceClient(conn), nil
+}
+
 // initGRPCServer initializes gRPC server
 func initGRPCServer(messageService service.MessageService) *grpc.Server {
 	grpcServer := grpc.NewServer(
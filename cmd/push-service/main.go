@@ -15,10 +15,12 @@ import (
 	"github.com/anychat/server/internal/push/jpush"
 	"github.com/anychat/server/internal/push/repository"
 	"github.com/anychat/server/internal/push/service"
+	"github.com/anychat/server/internal/push/worker"
 	"github.com/anychat/server/pkg/config"
 	"github.com/anychat/server/pkg/database"
 	grpcpkg "github.com/anychat/server/pkg/grpc"
 	"github.com/anychat/server/pkg/logger"
+	"github.com/anychat/server/pkg/notification"
 	"github.com/gin-gonic/gin"
 	"github.com/nats-io/nats.go"
 	"github.com/spf13/viper"
@@ -31,6 +33,8 @@ import (
 const (
 	serviceName = "push-service"
 	version     = "v1.0.0"
+
+	defaultLogRetentionIntervalMinutes = 60
 )
 
 func main() {
@@ -65,11 +69,39 @@ func main() {
 	jpushClient := jpush.NewClient(
 		viper.GetString("jpush.app_key"),
 		viper.GetString("jpush.master_secret"),
+		viper.GetBool("jpush.apns_production"),
 	)
 
+	// Initialize notification publisher
+	notificationPub := notification.NewPublisher(nc)
+
 	// Initialize repositories and services
 	pushLogRepo := repository.NewPushLogRepository(db)
-	pushSvc := service.NewPushService(jpushClient, pushLogRepo)
+	callPushRecordRepo := repository.NewCallPushRecordRepository(db)
+	pushSvc := service.NewPushService(jpushClient, pushLogRepo, callPushRecordRepo, notificationPub)
+
+	// Initialize and start push log retention worker, days <= 0 keeps push logs forever
+	var logRetentionWorker *worker.LogRetentionWorker
+	retentionDays := viper.GetInt("push.log_retention.days")
+	retentionInterval := viper.GetInt("push.log_retention.interval_minutes")
+	if retentionInterval <= 0 {
+		logger.Warn("Invalid push.log_retention.interval_minutes, using default",
+			zap.Int("configured", retentionInterval),
+			zap.Int("default", defaultLogRetentionIntervalMinutes))
+		retentionInterval = defaultLogRetentionIntervalMinutes
+	}
+	if retentionDays > 0 {
+		logRetentionWorker = worker.NewLogRetentionWorker(
+			pushLogRepo,
+			time.Duration(retentionDays)*24*time.Hour,
+			1000,
+			time.Duration(retentionInterval)*time.Minute,
+		)
+		logRetentionWorker.StartAsync()
+		logger.Info("LogRetentionWorker started")
+	} else {
+		logger.Info("Push log retention disabled", zap.Int("days", retentionDays))
+	}
 
 	// Subscribe to NATS notifications (wildcard matching all user notifications)
 	// Format: notification.{service}.{event}.{userID}
@@ -118,6 +150,13 @@ func main() {
 	<-quit
 
 	logger.Info("Shutting down gracefully...")
+
+	// Stop push log retention worker
+	if logRetentionWorker != nil {
+		logRetentionWorker.Stop()
+		logger.Info("LogRetentionWorker stopped")
+	}
+
 	grpcServer.GracefulStop()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -149,6 +188,9 @@ func loadConfig() error {
 	viper.SetDefault("nats.url", "nats://localhost:4222")
 	viper.SetDefault("jpush.app_key", "")
 	viper.SetDefault("jpush.master_secret", "")
+	viper.SetDefault("jpush.apns_production", false)
+	viper.SetDefault("push.log_retention.days", 90)
+	viper.SetDefault("push.log_retention.interval_minutes", 60)
 	viper.SetDefault("log.level", "info")
 	viper.SetDefault("log.output", "stdout")
 
@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	filepb "github.com/anychat/server/api/proto/file"
 	friendpb "github.com/anychat/server/api/proto/friend"
 	userpb "github.com/anychat/server/api/proto/user"
 	authrepository "github.com/anychat/server/internal/auth/repository"
@@ -74,6 +75,7 @@ func main() {
 	authUserRepo := authrepository.NewUserRepository(db)
 	authSessionRepo := authrepository.NewUserSessionRepository(db)
 	verifyCodeRepo := authrepository.NewVerificationCodeRepository(db)
+	mfaRepo := authrepository.NewUserMFARepository(db)
 
 	verifyService := authservice.NewVerificationService(
 		verifyCodeRepo,
@@ -96,12 +98,23 @@ func main() {
 		},
 	)
 
+	mfaService := authservice.NewMFAService(mfaRepo, authUserRepo, redisClient, authservice.MFAConfig{
+		Issuer:    viper.GetString("auth.mfa.issuer"),
+		SecretKey: viper.GetString("auth.mfa.secret_key"),
+	})
+
 	friendConn, friendClient, err := connectFriendService()
 	if err != nil {
 		logger.Fatal("Failed to connect friend-service", zap.Error(err))
 	}
 	defer friendConn.Close()
 
+	fileConn, fileClient, err := connectFileService()
+	if err != nil {
+		logger.Fatal("Failed to connect file-service", zap.Error(err))
+	}
+	defer fileConn.Close()
+
 	// Initialize services
 	userService := service.NewUserService(
 		profileRepo,
@@ -109,9 +122,11 @@ func main() {
 		qrcodeRepo,
 		pushTokenRepo,
 		friendClient,
+		fileClient,
 		authUserRepo,
 		authSessionRepo,
 		verifyService,
+		mfaService,
 	)
 
 	// Initialize gRPC server
@@ -189,7 +204,6 @@ func loadConfig() error {
 	viper.SetDefault("database.redis.password", "")
 	viper.SetDefault("database.redis.db", 0)
 	viper.SetDefault("database.redis.pool_size", 10)
-	viper.SetDefault("jwt.secret", "your-secret-key-change-in-production")
 	viper.SetDefault("jwt.access_token_expire", 7200)
 	viper.SetDefault("jwt.refresh_token_expire", 604800)
 	viper.SetDefault("log.level", "info")
@@ -197,11 +211,14 @@ func loadConfig() error {
 	viper.SetDefault("services.auth.grpc_addr", "localhost:9001")
 	viper.SetDefault("services.user.grpc_addr", "localhost:9002")
 	viper.SetDefault("services.friend.grpc_addr", "localhost:9003")
+	viper.SetDefault("services.file.grpc_addr", "localhost:9007")
 	viper.SetDefault("server.mode", "development")
 	viper.SetDefault("verify.code.length", 6)
 	viper.SetDefault("verify.code.expire_seconds", 300)
 	viper.SetDefault("verify.code.max_attempts", 5)
 	viper.SetDefault("verify.code.hash_secret", "change-me-for-production")
+	viper.SetDefault("auth.mfa.issuer", "AnyChat")
+	viper.SetDefault("auth.mfa.secret_key", "change-me-for-production")
 	viper.SetDefault("verify.code.debug_fixed_code", "123456")
 	viper.SetDefault("verify.code.allow_dev_bypass", true)
 	viper.SetDefault("verify.rate_limit.target_per_minute", 1)
@@ -272,6 +289,15 @@ func connectFriendService() (*grpc.ClientConn, friendpb.FriendServiceClient, err
 	return conn, friendpb.NewFriendServiceClient(conn), nil
 }
 
+func connectFileService() (*grpc.ClientConn, filepb.FileServiceClient, error) {
+	addr := viper.GetString("services.file.grpc_addr")
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect file service: %w", err)
+	}
+	return conn, filepb.NewFileServiceClient(conn), nil
+}
+
 // initGRPCServer initializes gRPC server
 func initGRPCServer(userService service.UserService) *grpc.Server {
 	grpcServer := grpc.NewServer(
@@ -371,27 +371,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/groups/{groupId}": {
+        "/admin/files/quarantine": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "uploads the malware scan flagged as infected or could not scan, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "admin-group-management"
+                    "admin-file-quarantine"
                 ],
-                "summary": "get group details",
+                "summary": "list quarantined files",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "groupId",
-                        "in": "path",
-                        "required": true
+                        "description": "uploader ID",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -414,25 +426,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/files/quarantine/{fileId}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "scan state of a file with every scanner verdict and review decision",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "admin-group-management"
+                    "admin-file-quarantine"
                 ],
-                "summary": "dissolve group",
+                "summary": "get quarantined file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "groupId",
+                        "description": "file ID",
+                        "name": "fileId",
                         "in": "path",
                         "required": true
                     }
@@ -441,26 +456,57 @@ const docTemplate = `{
                     "200": {
                         "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/admin/stats/overview": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "confirm the verdict: the file record and its object in the quarantine bucket are deleted",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "admin-statistics"
+                    "admin-file-quarantine"
+                ],
+                "summary": "delete quarantined file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "file ID",
+                        "name": "fileId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "review note",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.reviewQuarantinedFileRequest"
+                        }
+                    }
                 ],
-                "summary": "system statistics overview",
                 "responses": {
                     "200": {
                         "description": "success",
@@ -483,39 +529,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/users": {
-            "get": {
+        "/admin/files/quarantine/{fileId}/release": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "admin search/list system users",
+                "description": "treat the verdict as a false positive: the file is moved back and activated like a clean upload",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "admin-user-management"
+                    "admin-file-quarantine"
                 ],
-                "summary": "query user list",
+                "summary": "release quarantined file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "search keyword",
-                        "name": "keyword",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
+                        "description": "file ID",
+                        "name": "fileId",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "review note",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.reviewQuarantinedFileRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -540,7 +586,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/users/{userId}": {
+        "/admin/groups/{groupId}": {
             "get": {
                 "security": [
                     {
@@ -551,14 +597,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "admin-user-management"
+                    "admin-group-management"
                 ],
-                "summary": "get user details",
+                "summary": "get group details",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "user ID",
-                        "name": "userId",
+                        "description": "group ID",
+                        "name": "groupId",
                         "in": "path",
                         "required": true
                     }
@@ -583,40 +629,27 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/admin/users/{userId}/ban": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "admin-user-management"
+                    "admin-group-management"
                 ],
-                "summary": "ban user",
+                "summary": "dissolve group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "user ID",
-                        "name": "userId",
+                        "description": "group ID",
+                        "name": "groupId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "ban reason",
-                        "name": "request",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/handler.banUserRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -629,66 +662,131 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/users/{userId}/unban": {
-            "post": {
+        "/admin/push/logs": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "answer \"why didn't user X get notified\", newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "admin-user-management"
+                    "admin-push"
                 ],
-                "summary": "unban user",
+                "summary": "query push logs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "user ID",
+                        "description": "user ID filter",
                         "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "push type filter",
+                        "name": "pushType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "status filter: 1-pending 2-sent 3-failed 4-skipped",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "start time (unix seconds)",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "end time (unix seconds)",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/auth/login": {
-            "post": {
-                "description": "User login via account and password",
-                "consumes": [
-                    "application/json"
+        "/admin/push/stats": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "per-type success/failure aggregates by day and provider error breakdown, defaults to the last 7 days",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin-push"
                 ],
-                "summary": "user login",
+                "summary": "push statistics",
                 "parameters": [
                     {
-                        "description": "login info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "user ID filter",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "push type filter",
+                        "name": "pushType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "start time (unix seconds)",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "end time (unix seconds), range at most 90 days",
+                        "name": "endTime",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "login success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -698,151 +796,104 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/internal_gateway_handler.AuthResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
                             ]
                         }
-                    },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "incorrect account or password",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
                     }
                 }
             }
         },
-        "/auth/logout": {
-            "post": {
+        "/admin/stats/overview": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "User logout, invalidate token for current device",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
-                ],
-                "summary": "user logout",
-                "parameters": [
-                    {
-                        "description": "logout info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.LogoutRequest"
-                        }
-                    }
+                    "admin-statistics"
                 ],
+                "summary": "system statistics overview",
                 "responses": {
                     "200": {
-                        "description": "logout success",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
+                        "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/auth/password/change": {
-            "post": {
+        "/admin/storage/groups/{groupId}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "User changes login password",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "used bytes, file count and effective quota of the group's shared files",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin-storage"
                 ],
-                "summary": "change password",
+                "summary": "get group storage usage",
                 "parameters": [
                     {
-                        "description": "change password info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.ChangePasswordRequest"
-                        }
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "groupId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "change success",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "unauthorized or wrong original password",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
+                        "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/auth/password/reset": {
-            "post": {
-                "description": "User forgets password, reset via verification code",
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "override the quota of the group's shared files, tier is not accepted",
                 "consumes": [
                     "application/json"
                 ],
@@ -850,75 +901,76 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin-storage"
                 ],
-                "summary": "reset password",
+                "summary": "set group storage quota",
                 "parameters": [
                     {
-                        "description": "reset password info",
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "groupId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "quota",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.ResetPasswordRequest"
+                            "$ref": "#/definitions/handler.setStorageQuotaRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "reset success",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "verification code error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
+                        "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/auth/refresh": {
-            "post": {
-                "description": "Use refresh token to get new access token",
-                "consumes": [
-                    "application/json"
+        "/admin/storage/users/{userId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "used bytes, file count, tier and effective quota of a user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin-storage"
                 ],
-                "summary": "refresh access token",
+                "summary": "get user storage usage",
                 "parameters": [
                     {
-                        "description": "refresh token",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.RefreshTokenRequest"
-                        }
+                        "type": "string",
+                        "description": "user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "refresh success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -928,37 +980,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/internal_gateway_handler.AuthResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
                             ]
                         }
-                    },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "invalid refresh token",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
                     }
                 }
-            }
-        },
-        "/auth/register": {
-            "post": {
-                "description": "User registers new account via phone or email",
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "change the tier of a user or override its quota",
                 "consumes": [
                     "application/json"
                 ],
@@ -966,23 +1003,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin-storage"
                 ],
-                "summary": "user registration",
+                "summary": "set user storage quota",
                 "parameters": [
                     {
-                        "description": "registration info",
+                        "type": "string",
+                        "description": "user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "quota",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.RegisterRequest"
+                            "$ref": "#/definitions/handler.setStorageQuotaRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "registration success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -992,61 +1036,54 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/internal_gateway_handler.AuthResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
                             ]
                         }
-                    },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "409": {
-                        "description": "user already exists",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
                     }
                 }
             }
         },
-        "/auth/send-code": {
-            "post": {
-                "description": "Send SMS/email verification code for registration, password recovery or binding scenarios",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
+        "/admin/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "admin search/list system users",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin-user-management"
                 ],
-                "summary": "send verification code",
+                "summary": "query user list",
                 "parameters": [
                     {
-                        "description": "verification code request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.SendCodeRequest"
-                        }
+                        "type": "string",
+                        "description": "search keyword",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "send success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -1056,64 +1093,37 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/internal_gateway_handler.SendCodeResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
                             ]
                         }
-                    },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "429": {
-                        "description": "too many requests",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
                     }
                 }
             }
         },
-        "/calling/calls": {
+        "/admin/users/{userId}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get current user's call history",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
+                    "admin-user-management"
                 ],
-                "summary": "call logs",
+                "summary": "get user details",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "page number (default 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size (default 20)",
-                        "name": "pageSize",
-                        "in": "query"
+                        "type": "string",
+                        "description": "user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1134,28 +1144,17 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
                     }
                 }
-            },
+            }
+        },
+        "/admin/users/{userId}/ban": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Initiate audio/video call to specified user, returns Calling Room name and JWT Token",
                 "consumes": [
                     "application/json"
                 ],
@@ -1163,53 +1162,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
+                    "admin-user-management"
                 ],
-                "summary": "initiate call",
+                "summary": "ban user",
                 "parameters": [
                     {
-                        "description": "call request",
+                        "type": "string",
+                        "description": "user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ban reason",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.initiateCallRequest"
+                            "$ref": "#/definitions/handler.banUserRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "call initiated successfully",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
+                        "description": "success",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -1217,29 +1192,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/calling/calls/{callId}": {
-            "get": {
+        "/admin/users/{userId}/unban": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed info of specified call session",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
+                    "admin-user-management"
                 ],
-                "summary": "get call details",
+                "summary": "unban user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "call ID",
-                        "name": "callId",
+                        "description": "user ID",
+                        "name": "userId",
                         "in": "path",
                         "required": true
                     }
@@ -1247,36 +1218,6 @@ const docTemplate = `{
                 "responses": {
                     "200": {
                         "description": "success",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "call not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -1284,54 +1225,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/calling/calls/{callId}/end": {
+        "/admin/users/{userId}/unlock": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Caller or callee ends the call",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "clear the temporary lockout and failure counters after repeated failed password logins",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
+                    "admin-user-management"
                 ],
-                "summary": "end call",
+                "summary": "lift login lockout",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "call ID",
-                        "name": "callId",
+                        "description": "user ID",
+                        "name": "userId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "end success",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "call not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "server error",
+                        "description": "success",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -1339,14 +1259,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/calling/calls/{callId}/join": {
+        "/auth/account/delete": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Callee accepts call invitation, returns Calling Room name and JWT Token",
+                "description": "Re-verifies the user (password, or a delete_account verification code for accounts without a password, plus the MFA code when enabled) and schedules the account for erasure after the grace period. All devices are signed out; logging in again during the grace period cancels the deletion",
                 "consumes": [
                     "application/json"
                 ],
@@ -1354,21 +1274,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
+                    "auth"
                 ],
-                "summary": "answer call",
+                "summary": "delete my account",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "call ID",
-                        "name": "callId",
-                        "in": "path",
-                        "required": true
+                        "description": "re-verification",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.DeleteAccountRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "answer success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -1378,7 +1300,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.AccountDeletionResponse"
                                         }
                                     }
                                 }
@@ -1386,19 +1308,25 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error or wrong verification code",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
-                        "description": "unauthorized",
+                        "description": "wrong password",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "404": {
-                        "description": "call not found",
+                    "403": {
+                        "description": "MFA code required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "deletion already scheduled",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -1412,38 +1340,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/calling/calls/{callId}/reject": {
-            "post": {
+        "/auth/account/deletion": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Callee rejects call invitation",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Latest deletion request of the current user and the services whose data is already erased",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
-                ],
-                "summary": "reject call",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "call ID",
-                        "name": "callId",
-                        "in": "path",
-                        "required": true
-                    }
+                    "auth"
                 ],
+                "summary": "get my account deletion status",
                 "responses": {
                     "200": {
-                        "description": "reject success",
+                        "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.AccountDeletionResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -1453,7 +1381,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "call not found",
+                        "description": "no deletion request",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -1467,38 +1395,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/calling/meetings": {
+        "/auth/devices": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get list of currently active meeting rooms",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Devices the current user has logged in on, with client version, last login IP, last activity and whether each still has a live session",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
-                ],
-                "summary": "meeting room list",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page number (default 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size (default 20)",
-                        "name": "pageSize",
-                        "in": "query"
-                    }
+                    "auth"
                 ],
+                "summary": "list my devices",
                 "responses": {
                     "200": {
                         "description": "success",
@@ -1511,7 +1422,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.ListDevicesResponse"
                                         }
                                     }
                                 }
@@ -1531,56 +1442,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/devices/logout-others": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create new audio/video meeting room, returns meeting info and Calling Token",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Signs out every device except the one making the request",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
-                ],
-                "summary": "create meeting room",
-                "parameters": [
-                    {
-                        "description": "meeting room info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.createMeetingRequest"
-                        }
-                    }
+                    "auth"
                 ],
+                "summary": "sign out all other devices",
                 "responses": {
                     "200": {
-                        "description": "create success",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "400": {
-                        "description": "parameter error",
+                        "description": "success",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -1600,29 +1481,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/calling/meetings/{roomId}": {
-            "get": {
+        "/auth/devices/{deviceId}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed info of specified meeting room",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Revokes the device session, sends auth.force_logout to it and closes its WebSocket connection",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
+                    "auth"
                 ],
-                "summary": "get meeting room",
+                "summary": "sign out a device",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "meeting room ID",
-                        "name": "roomId",
+                        "description": "device ID",
+                        "name": "deviceId",
                         "in": "path",
                         "required": true
                     }
@@ -1631,19 +1509,7 @@ const docTemplate = `{
                     "200": {
                         "description": "success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
@@ -1653,7 +1519,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "meeting room not found",
+                        "description": "device not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -1667,14 +1533,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/calling/meetings/{roomId}/end": {
-            "post": {
+        "/auth/devices/{deviceId}/name": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creator ends meeting room, all participants will be removed after room closes",
+                "description": "Sets a display name for one of the current user's devices",
                 "consumes": [
                     "application/json"
                 ],
@@ -1682,16 +1548,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
+                    "auth"
                 ],
-                "summary": "end meeting room",
+                "summary": "rename a device",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "meeting room ID",
-                        "name": "roomId",
+                        "description": "device ID",
+                        "name": "deviceId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "new name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.RenameDeviceRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1701,20 +1576,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "400": {
+                        "description": "parameter error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "no permission",
+                    "401": {
+                        "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "404": {
-                        "description": "meeting room not found",
+                        "description": "device not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -1728,44 +1603,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/calling/meetings/{roomId}/join": {
-            "post": {
+        "/auth/identities": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Join specified meeting room, returns Calling Token",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Identity providers linked to the current user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Calling"
-                ],
-                "summary": "join meeting room",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "meeting room ID",
-                        "name": "roomId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "meeting room password (if any)",
-                        "name": "request",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.joinMeetingRequest"
-                        }
-                    }
+                    "auth"
                 ],
+                "summary": "list linked identities",
                 "responses": {
                     "200": {
-                        "description": "join success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -1775,37 +1630,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.ListIdentitiesResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
                     "401": {
                         "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "wrong password",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "meeting room not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -1815,14 +1652,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations": {
-            "get": {
+        "/auth/identities/{provider}": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get current user's conversation list, supports incremental sync (via updatedBefore parameter)",
+                "description": "Completes a link authorization and attaches the provider identity to the current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1830,22 +1667,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "get conversation list",
+                "summary": "link an identity",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "return count (default 20, max 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "identity provider name",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "format": "int64",
-                        "description": "Unix timestamp, only return conversations updated before this time (incremental sync)",
-                        "name": "updatedBefore",
-                        "in": "query"
+                        "description": "callback parameters",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.LinkIdentityRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1860,15 +1700,27 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.IdentityInfo"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "parameter error or invalid state",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "401": {
-                        "description": "unauthorized",
+                        "description": "unauthorized or identity provider authentication failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "identity already linked",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -1880,43 +1732,35 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/conversations/unread/total": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get total unread message count for all conversations of current user (muted conversations excluded)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Removes a linked identity. Refused when it is the only way left to sign in",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
+                ],
+                "summary": "unlink an identity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "identity provider name",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "get total unread count",
                 "responses": {
                     "200": {
                         "description": "success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
@@ -1925,6 +1769,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "403": {
+                        "description": "last sign-in method",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "identity not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -1934,14 +1790,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}": {
-            "get": {
+        "/auth/identities/{provider}/authorize": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get details of specified conversation",
+                "description": "Same as OIDC login authorize, but the state is bound to the current user and can only be completed by POST /auth/identities/{provider}",
                 "consumes": [
                     "application/json"
                 ],
@@ -1949,16 +1805,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "get single conversation",
+                "summary": "start linking an identity",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
+                        "description": "identity provider name",
+                        "name": "provider",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "redirect URI",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.BeginOIDCLoginRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1973,13 +1837,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.BeginOIDCLoginResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "redirect URI not registered",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "401": {
                         "description": "unauthorized",
                         "schema": {
@@ -1987,7 +1857,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "conversation not found",
+                        "description": "identity provider not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -1999,14 +1869,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Delete specified conversation (doesn't affect messages, only removes from conversation list)",
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "User login via account and password",
                 "consumes": [
                     "application/json"
                 ],
@@ -2014,27 +1881,59 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "delete conversation",
+                "summary": "user login",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
+                        "description": "login info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.LoginRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "login success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.AuthResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
-                        "description": "unauthorized",
+                        "description": "incorrect account or password",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "CAPTCHA required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "too many failed attempts or account locked",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2048,53 +1947,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/auto_delete": {
-            "put": {
+        "/auth/login-history": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Set conversation auto delete duration, 0 means cancel",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Recent logins and rejected password attempts, newest first, with device, IP, location and whether the login was flagged as unusual",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "set auto delete",
+                "summary": "list my login history",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "auto delete duration (seconds)",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.setAutoDeleteRequest"
-                        }
+                        "type": "integer",
+                        "description": "max entries, default and max 100",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.ListLoginHistoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -2112,14 +2004,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/burn": {
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Set conversation burn after reading duration, 0 means cancel",
+        "/auth/login/code": {
+            "post": {
+                "description": "Passwordless login via SMS/email verification code (purpose=2). Unknown phone numbers may be auto-registered when enabled by server config",
                 "consumes": [
                     "application/json"
                 ],
@@ -2127,42 +2014,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "set burn after reading",
+                "summary": "verification code login",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "burn after reading duration (seconds)",
+                        "description": "login info",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.setBurnAfterReadingRequest"
+                            "$ref": "#/definitions/internal_gateway_handler.LoginByCodeRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "login success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.AuthResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error or incorrect verification code",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "404": {
+                        "description": "user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "too many verification attempts",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2176,14 +2074,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/messages/after": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Query messages after an anchor_message_id in a conversation",
+        "/auth/login/mfa": {
+            "post": {
+                "description": "When login returns mfa_required, submit the mfa_token with a TOTP or recovery code to obtain tokens. The token expires after 5 minutes or 5 wrong codes",
                 "consumes": [
                     "application/json"
                 ],
@@ -2191,35 +2084,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "auth"
                 ],
-                "summary": "get messages after anchor",
+                "summary": "complete login with second factor",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "anchor message ID",
-                        "name": "anchor_message_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "limit (default 20, max 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "MFA challenge",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.VerifyLoginMFARequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "login success",
                         "schema": {
                             "allOf": [
                                 {
@@ -2229,7 +2110,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.AuthResponse"
                                         }
                                     }
                                 }
@@ -2237,13 +2118,19 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error or wrong code",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
-                        "description": "unauthorized",
+                        "description": "mfa_token invalid or expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "too many failed attempts",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2257,14 +2144,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/messages/around-anchor": {
-            "get": {
+        "/auth/logout": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Query messages before and after anchor_message_id in one request",
+                "description": "User logout, invalidate token for current device",
                 "consumes": [
                     "application/json"
                 ],
@@ -2272,62 +2159,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "auth"
                 ],
-                "summary": "get messages around anchor",
+                "summary": "user logout",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "anchor message ID",
-                        "name": "anchor_message_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "messages before anchor (default 20, max 100)",
-                        "name": "before",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "messages after anchor (default 20, max 100)",
-                        "name": "after",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "include anchor message (default true)",
-                        "name": "include_anchor",
-                        "in": "query"
+                        "description": "logout info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.LogoutRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "logout success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "400": {
@@ -2351,47 +2201,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/messages/before": {
+        "/auth/mfa": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Query messages before an anchor_message_id in a conversation",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns whether TOTP two-factor authentication is enabled and how many recovery codes are left",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "message"
-                ],
-                "summary": "get messages before anchor",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "anchor message ID",
-                        "name": "anchor_message_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "limit (default 20, max 100)",
-                        "name": "limit",
-                        "in": "query"
-                    }
+                    "auth"
                 ],
+                "summary": "get MFA status",
                 "responses": {
                     "200": {
                         "description": "success",
@@ -2404,19 +2228,13 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.MFAStatusResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
                     "401": {
                         "description": "unauthorized",
                         "schema": {
@@ -2432,14 +2250,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/messages/first-unread-anchor": {
-            "get": {
+        "/auth/mfa/disable": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Query first unread message anchor, optionally returning context windows",
+                "description": "Disables TOTP two-factor authentication and deletes all recovery codes. Requires the account password and a TOTP or recovery code",
                 "consumes": [
                     "application/json"
                 ],
@@ -2447,65 +2265,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "auth"
                 ],
-                "summary": "get first unread anchor",
+                "summary": "disable MFA",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "whether to include before/after context",
-                        "name": "with_context",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "messages before anchor when with_context=true (default 20, max 100)",
-                        "name": "before",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "messages after anchor when with_context=true (default 20, max 100)",
-                        "name": "after",
-                        "in": "query"
+                        "description": "re-authentication",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.MFAReauthRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error, wrong code or MFA not enabled",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
-                        "description": "unauthorized",
+                        "description": "unauthorized or wrong password",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "too many failed attempts",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2519,42 +2313,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/messages/read": {
+        "/auth/mfa/enroll": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Used in scrolling list scenarios, batch report visible message IDs and advance conversation read cursor",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Generates a new TOTP secret. Render otpauth_uri as a QR code for authenticator apps, then confirm with the first code. Calling again replaces the pending secret",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
-                ],
-                "summary": "mark read by message ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "message read list",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.markMessagesReadRequest"
-                        }
-                    }
+                    "auth"
                 ],
+                "summary": "start TOTP enrollment",
                 "responses": {
                     "200": {
                         "description": "success",
@@ -2567,21 +2340,21 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.BeginMFAEnrollmentResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "parameter error",
+                    "401": {
+                        "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "409": {
+                        "description": "MFA already enabled",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2595,14 +2368,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/messages/read-receipts": {
-            "get": {
+        "/auth/mfa/enroll/confirm": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Return last read sequence for members in conversation",
+                "description": "Enables MFA after verifying the first TOTP code. Recovery codes are returned only once and must be saved by the user",
                 "consumes": [
                     "application/json"
                 ],
@@ -2610,16 +2383,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "get message read receipts",
+                "summary": "confirm TOTP enrollment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
+                        "description": "TOTP code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.ConfirmMFAEnrollmentRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2634,7 +2409,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.MFARecoveryCodesResponse"
                                         }
                                     }
                                 }
@@ -2642,7 +2417,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error or wrong code",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2653,6 +2428,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "409": {
+                        "description": "MFA already enabled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "too many failed attempts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -2662,14 +2449,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/messages/sequence": {
-            "get": {
+        "/auth/mfa/recovery-codes": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get latest message sequence in conversation",
+                "description": "Invalidates all existing recovery codes and returns a new set. Requires the account password and a TOTP or recovery code",
                 "consumes": [
                     "application/json"
                 ],
@@ -2677,16 +2464,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "get conversation message sequence",
+                "summary": "regenerate recovery codes",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
+                        "description": "re-authentication",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.MFAReauthRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2701,7 +2490,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.MFARecoveryCodesResponse"
                                         }
                                     }
                                 }
@@ -2709,13 +2498,19 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error, wrong code or MFA not enabled",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
-                        "description": "unauthorized",
+                        "description": "unauthorized or wrong password",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "too many failed attempts",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2729,38 +2524,72 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/messages/unread-count": {
+        "/auth/oidc/providers": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Query unread message count for current user in specified conversation",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "OIDC identity providers the client can offer for single sign-on",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "get conversation unread count",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
+                "summary": "list identity providers",
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.ListOIDCProvidersResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/{provider}/authorize": {
+            "post": {
+                "description": "Creates an authorization request (authorization code + PKCE). Open authorization_url in a browser, the provider redirects back to redirect_uri with code and state",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "start OIDC login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "identity provider name",
+                        "name": "provider",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "format": "int64",
-                        "description": "optional, client read sequence",
-                        "name": "last_read_seq",
-                        "in": "query"
+                        "description": "redirect URI",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.BeginOIDCLoginRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2775,7 +2604,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.BeginOIDCLoginResponse"
                                         }
                                     }
                                 }
@@ -2783,13 +2612,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "redirect URI not registered",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "404": {
+                        "description": "identity provider not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2803,14 +2632,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/mute": {
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Enable or disable mute for specified conversation",
+        "/auth/oidc/{provider}/callback": {
+            "post": {
+                "description": "Exchanges the authorization code and signs in the user the identity belongs to. An unknown identity is linked to the account with the same verified email, or a new account is created when the provider allows it. May return mfa_required like password login",
                 "consumes": [
                     "application/json"
                 ],
@@ -2818,42 +2642,60 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "set conversation mute",
+                "summary": "OIDC login callback",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
+                        "description": "identity provider name",
+                        "name": "provider",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "mute status",
+                        "description": "callback parameters and device info",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.setMutedRequest"
+                            "$ref": "#/definitions/internal_gateway_handler.CompleteOIDCLoginRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "login success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.AuthResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error or invalid state",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
-                        "description": "unauthorized",
+                        "description": "identity provider authentication failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "identity not linked to any account",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2867,14 +2709,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/pin": {
-            "put": {
+        "/auth/password/change": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Pin or unpin specified conversation",
+                "description": "User changes login password",
                 "consumes": [
                     "application/json"
                 ],
@@ -2882,30 +2724,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "set conversation pin",
+                "summary": "change password",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "pin status",
+                        "description": "change password info",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.setPinnedRequest"
+                            "$ref": "#/definitions/internal_gateway_handler.ChangePasswordRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "change success",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2917,7 +2752,13 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "unauthorized",
+                        "description": "unauthorized or wrong original password",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "MFA code required",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2931,14 +2772,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/conversations/{conversationId}/read-all": {
+        "/auth/password/reset": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Clear unread count for specified conversation and advance message read cursor to latest sequence",
+                "description": "User forgets password, reset via verification code",
                 "consumes": [
                     "application/json"
                 ],
@@ -2946,27 +2782,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "conversation"
+                    "auth"
                 ],
-                "summary": "mark conversation as read",
+                "summary": "reset password",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "conversation ID",
-                        "name": "conversationId",
-                        "in": "path",
-                        "required": true
+                        "description": "reset password info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.ResetPasswordRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "reset success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
-                        "description": "unauthorized",
+                        "description": "verification code error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -2980,14 +2824,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/files": {
-            "get": {
+        "/auth/qr-login/cancel": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Paginate list of current user's files, supports filtering by type",
+                "description": "Mobile client that scanned the ticket rejects the login",
                 "consumes": [
                     "application/json"
                 ],
@@ -2995,52 +2839,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "file"
+                    "auth"
                 ],
-                "summary": "list user files",
+                "summary": "cancel QR login",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "file type (1-image/2-video/3-audio/4-file/5-log)",
-                        "name": "fileType",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "page size",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "ticket",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.QRLoginActionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error or ticket not scanned by this device",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3051,6 +2872,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "404": {
+                        "description": "ticket expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -3060,14 +2887,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/files/upload-token": {
+        "/auth/qr-login/confirm": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Generate presigned upload URL, client uses this URL to upload directly to MinIO",
+                "description": "Mobile client that scanned the ticket confirms login; the waiting desktop receives tokens on its next poll",
                 "consumes": [
                     "application/json"
                 ],
@@ -3075,17 +2902,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "file"
+                    "auth"
                 ],
-                "summary": "generate file upload token",
+                "summary": "confirm QR login",
                 "parameters": [
                     {
-                        "description": "upload request",
+                        "description": "ticket",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/internal_gateway_handler.QRLoginActionRequest"
                         }
                     }
                 ],
@@ -3093,23 +2920,11 @@ const docTemplate = `{
                     "200": {
                         "description": "success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error or ticket not scanned by this device",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3120,6 +2935,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "404": {
+                        "description": "ticket expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -3129,14 +2950,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/files/{fileId}": {
-            "get": {
+        "/auth/qr-login/scan": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get file metadata info",
+                "description": "Logged-in mobile client reports the scanned ticket and receives the desktop device info to show on the confirmation screen",
                 "consumes": [
                     "application/json"
                 ],
@@ -3144,16 +2965,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "file"
+                    "auth"
                 ],
-                "summary": "get file info",
+                "summary": "scan QR login ticket",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "file ID",
-                        "name": "fileId",
-                        "in": "path",
-                        "required": true
+                        "description": "ticket",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.QRLoginActionRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -3168,7 +2991,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.QRLoginScanResponse"
                                         }
                                     }
                                 }
@@ -3176,7 +2999,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error or ticket already scanned",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3188,13 +3011,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "no permission",
+                        "description": "not a mobile device",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "404": {
-                        "description": "file not found",
+                        "description": "ticket expired",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3206,38 +3029,70 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Delete file (including object in MinIO)",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/auth/qr-login/status": {
+            "get": {
+                "description": "Long-polls until the ticket status differs from last_status or wait_seconds elapses. Returns login tokens once confirmed",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "file"
+                    "auth"
                 ],
-                "summary": "delete file",
+                "summary": "poll QR login status",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "file ID",
-                        "name": "fileId",
-                        "in": "path",
+                        "description": "ticket",
+                        "name": "ticket",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "device ID that created the ticket",
+                        "name": "device_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "poll secret returned when the ticket was created",
+                        "name": "poll_secret",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "status already known by the client",
+                        "name": "last_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "long-poll wait seconds, max 30",
+                        "name": "wait_seconds",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.QRLoginStatusResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3246,24 +3101,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "403": {
-                        "description": "no permission",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "file not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -3273,14 +3110,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/files/{fileId}/complete": {
+        "/auth/qr-login/ticket": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "After client finishes upload, notify server to activate file",
+                "description": "Web/PC/H5 client requests a short-lived ticket and renders qr_content as a QR code",
                 "consumes": [
                     "application/json"
                 ],
@@ -3288,16 +3120,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "file"
+                    "auth"
                 ],
-                "summary": "complete file upload",
+                "summary": "create QR login ticket",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "file ID",
-                        "name": "fileId",
-                        "in": "path",
-                        "required": true
+                        "description": "desktop device info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.CreateQRLoginRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -3312,7 +3146,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.QRLoginTicketResponse"
                                         }
                                     }
                                 }
@@ -3325,18 +3159,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "file not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -3346,14 +3168,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/files/{fileId}/download": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Generate presigned download URL, client uses this URL to download directly from MinIO",
+        "/auth/refresh": {
+            "post": {
+                "description": "Use refresh token to get new access token",
                 "consumes": [
                     "application/json"
                 ],
@@ -3361,28 +3178,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "file"
+                    "auth"
                 ],
-                "summary": "generate file download URL",
+                "summary": "refresh access token",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "file ID",
-                        "name": "fileId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 60,
-                        "description": "URL expiration (minutes)",
-                        "name": "expiresMinutes",
-                        "in": "query"
+                        "description": "refresh token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.RefreshTokenRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "refresh success",
                         "schema": {
                             "allOf": [
                                 {
@@ -3392,7 +3204,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.AuthResponse"
                                         }
                                     }
                                 }
@@ -3406,19 +3218,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "403": {
-                        "description": "no permission",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "file not found",
+                        "description": "invalid refresh token",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3432,14 +3232,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get all friends of current user, supports incremental sync",
+        "/auth/register": {
+            "post": {
+                "description": "User registers new account via phone or email",
                 "consumes": [
                     "application/json"
                 ],
@@ -3447,21 +3242,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "friend"
+                    "auth"
                 ],
-                "summary": "get friend list",
+                "summary": "user registration",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "format": "int64",
-                        "description": "last update timestamp (incremental sync)",
-                        "name": "lastUpdateTime",
-                        "in": "query"
+                        "description": "registration info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.RegisterRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "registration success",
                         "schema": {
                             "allOf": [
                                 {
@@ -3471,15 +3268,21 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.AuthResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "user already exists",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3493,14 +3296,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/blacklist": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get current user's blacklist",
+        "/auth/send-code": {
+            "post": {
+                "description": "Send SMS/email verification code for registration, password recovery or binding scenarios",
                 "consumes": [
                     "application/json"
                 ],
@@ -3508,12 +3306,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "friend"
+                    "auth"
+                ],
+                "summary": "send verification code",
+                "parameters": [
+                    {
+                        "description": "verification code request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.SendCodeRequest"
+                        }
+                    }
                 ],
-                "summary": "get blacklist",
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "send success",
                         "schema": {
                             "allOf": [
                                 {
@@ -3523,15 +3332,21 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/internal_gateway_handler.SendCodeResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3543,14 +3358,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/unusual-login/report": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Add specified user to blacklist",
+                "description": "Called from the \"this wasn't me\" link of an unusual login email: signs out the device that logged in and requires a password reset before the next password login. Each link works once",
                 "consumes": [
                     "application/json"
                 ],
@@ -3558,17 +3370,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "friend"
+                    "auth"
                 ],
-                "summary": "add to blacklist",
+                "summary": "report an unusual login",
                 "parameters": [
                     {
-                        "description": "user ID",
+                        "description": "report token from the email link",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/internal_gateway_handler.ReportUnusualLoginRequest"
                         }
                     }
                 ],
@@ -3580,13 +3392,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "unauthorized",
+                        "description": "link expired or already used",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3600,14 +3406,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/blacklist/{id}": {
-            "delete": {
+        "/calling/calls": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove specified user from blacklist",
+                "description": "Get current user's call history",
                 "consumes": [
                     "application/json"
                 ],
@@ -3615,23 +3421,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "friend"
+                    "Calling"
                 ],
-                "summary": "remove from blacklist",
+                "summary": "call logs",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "blocked user ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size (default 20)",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -3647,16 +3470,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/friends/requests": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get friend request list by numeric request_type",
+                "description": "Initiate audio/video call to specified user, returns Calling Room name and JWT Token",
                 "consumes": [
                     "application/json"
                 ],
@@ -3664,21 +3485,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "friend"
+                    "Calling"
                 ],
-                "summary": "get friend request list",
+                "summary": "initiate call",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "request type: 1-received 2-sent",
-                        "name": "request_type",
-                        "in": "query"
+                        "description": "call request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.initiateCallRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "call initiated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -3695,6 +3518,12 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "401": {
                         "description": "unauthorized",
                         "schema": {
@@ -3708,14 +3537,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/calling/calls/{callId}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Send friend request to specified user",
+                "description": "Get detailed info of specified call session",
                 "consumes": [
                     "application/json"
                 ],
@@ -3723,18 +3554,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "friend"
+                    "Calling"
                 ],
-                "summary": "send friend request",
+                "summary": "get call details",
                 "parameters": [
                     {
-                        "description": "request info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object"
-                        }
+                        "type": "string",
+                        "description": "call ID",
+                        "name": "callId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3756,14 +3585,14 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "parameter error",
+                    "401": {
+                        "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "404": {
+                        "description": "call not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3777,14 +3606,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/requests/{id}": {
-            "put": {
+        "/calling/calls/{callId}/end": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Handle friend request with numeric action",
+                "description": "Caller or callee ends the call",
                 "consumes": [
                     "application/json"
                 ],
@@ -3792,42 +3621,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "friend"
+                    "Calling"
                 ],
-                "summary": "handle friend request",
+                "summary": "end call",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "request ID",
-                        "name": "id",
+                        "type": "string",
+                        "description": "call ID",
+                        "name": "callId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "handle action: 1-accept 2-reject",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "end success",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "400": {
-                        "description": "parameter error",
+                    "401": {
+                        "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "404": {
+                        "description": "call not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3841,14 +3661,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/{id}": {
-            "delete": {
+        "/calling/calls/{callId}/join": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete specified friend",
+                "description": "Callee accepts call invitation, returns Calling Room name and JWT Token",
                 "consumes": [
                     "application/json"
                 ],
@@ -3856,21 +3676,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "friend"
+                    "Calling"
                 ],
-                "summary": "delete friend",
+                "summary": "answer call",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "friend user ID",
-                        "name": "id",
+                        "description": "call ID",
+                        "name": "callId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "answer success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3881,6 +3719,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "404": {
+                        "description": "call not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -3890,14 +3734,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/{id}/remark": {
-            "put": {
+        "/calling/calls/{callId}/reject": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update remark name for specified friend",
+                "description": "Callee rejects call invitation",
                 "consumes": [
                     "application/json"
                 ],
@@ -3905,42 +3749,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "friend"
+                    "Calling"
                 ],
-                "summary": "update friend remark",
+                "summary": "reject call",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "friend user ID",
-                        "name": "id",
+                        "description": "call ID",
+                        "name": "callId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "remark info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "reject success",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "400": {
-                        "description": "parameter error",
+                    "401": {
+                        "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "404": {
+                        "description": "call not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -3954,14 +3789,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups": {
+        "/calling/meetings": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all groups the current user has joined",
+                "description": "Get list of currently active meeting rooms",
                 "consumes": [
                     "application/json"
                 ],
@@ -3969,20 +3804,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "Calling"
                 ],
-                "summary": "get my groups",
+                "summary": "meeting room list",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "last update timestamp (incremental sync)",
-                        "name": "lastUpdateTime",
+                        "description": "page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size (default 20)",
+                        "name": "pageSize",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -3992,7 +3833,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupListResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -4004,6 +3845,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
                     }
                 }
             },
@@ -4013,7 +3860,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new group, requires at least 2 members (including creator)",
+                "description": "Create new audio/video meeting room, returns meeting info and Calling Token",
                 "consumes": [
                     "application/json"
                 ],
@@ -4021,17 +3868,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "Calling"
                 ],
-                "summary": "create group",
+                "summary": "create meeting room",
                 "parameters": [
                     {
-                        "description": "group info",
+                        "description": "meeting room info",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.CreateGroupRequest"
+                            "$ref": "#/definitions/internal_gateway_handler.createMeetingRequest"
                         }
                     }
                 ],
@@ -4047,7 +3894,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -4075,14 +3922,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/join-by-qrcode": {
-            "post": {
+        "/calling/meetings/{roomId}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Join group via QR code token, join directly or submit request based on group settings",
+                "description": "Get detailed info of specified meeting room",
                 "consumes": [
                     "application/json"
                 ],
@@ -4090,28 +3937,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "Calling"
                 ],
-                "summary": "join group via QR code",
+                "summary": "get meeting room",
                 "parameters": [
                     {
-                        "description": "QR code token",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "properties": {
-                                "token": {
-                                    "type": "string"
-                                }
-                            }
-                        }
+                        "type": "string",
+                        "description": "meeting room ID",
+                        "name": "roomId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -4121,71 +3961,27 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.JoinGroupByQRCodeResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "QR code invalid or expired",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
                     "401": {
                         "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
-                    }
-                }
-            }
-        },
-        "/groups/preview": {
-            "get": {
-                "description": "Get group name, avatar, member count and join verification via QR code token",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "group"
-                ],
-                "summary": "group preview",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "QR code token",
-                        "name": "token",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "404": {
+                        "description": "meeting room not found",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupQRCodePreviewResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "400": {
-                        "description": "invalid parameter or QR code expired",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -4193,14 +3989,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}": {
-            "get": {
+        "/calling/meetings/{roomId}/end": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed info of specified group",
+                "description": "Creator ends meeting room, all participants will be removed after room closes",
                 "consumes": [
                     "application/json"
                 ],
@@ -4208,35 +4004,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "Calling"
                 ],
-                "summary": "get group info",
+                "summary": "end meeting room",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
+                        "description": "meeting room ID",
+                        "name": "roomId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
@@ -4245,21 +4029,35 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "404": {
-                        "description": "group not found",
+                        "description": "meeting room not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/calling/meetings/{roomId}/join": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update group name, avatar, announcement etc (requires admin permission)",
+                "description": "Join specified meeting room, returns Calling Token",
                 "consumes": [
                     "application/json"
                 ],
@@ -4267,32 +4065,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "Calling"
                 ],
-                "summary": "update group info",
+                "summary": "join meeting room",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
+                        "description": "meeting room ID",
+                        "name": "roomId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "update info",
+                        "description": "meeting room password (if any)",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UpdateGroupRequest"
+                            "$ref": "#/definitions/internal_gateway_handler.joinMeetingRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "update success",
+                        "description": "join success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -4308,54 +4117,19 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "no permission",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Dissolve group (owner only)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "group"
-                ],
-                "summary": "dissolve group",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "dissolve success",
+                        "description": "wrong password",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "404": {
+                        "description": "meeting room not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "no permission",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -4363,14 +4137,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/join": {
-            "post": {
+        "/conversations": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request to join specified group",
+                "description": "Get current user's conversation list, supports incremental sync (via updatedBefore parameter)",
                 "consumes": [
                     "application/json"
                 ],
@@ -4378,30 +4152,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "conversation"
                 ],
-                "summary": "join group",
+                "summary": "get conversation list",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "return count (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
-                        "description": "join request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.JoinGroupRequest"
-                        }
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Unix timestamp, only return conversations updated before this time (incremental sync)",
+                        "name": "updatedBefore",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "request success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -4411,21 +4182,21 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.JoinGroupResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "parameter error",
+                    "401": {
+                        "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -4433,14 +4204,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/members": {
+        "/conversations/unread/total": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get members of specified group, supports pagination",
+                "description": "Get total unread message count for all conversations of current user (muted conversations excluded)",
                 "consumes": [
                     "application/json"
                 ],
@@ -4448,35 +4219,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
-                ],
-                "summary": "get group members",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "page size",
-                        "name": "pageSize",
-                        "in": "query"
-                    }
+                    "conversation"
                 ],
+                "summary": "get total unread count",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -4486,7 +4234,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupMemberListResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -4499,21 +4247,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "404": {
-                        "description": "group not found",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/conversations/{conversationId}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Invite users to join group",
+                "description": "Get details of specified conversation",
                 "consumes": [
                     "application/json"
                 ],
@@ -4521,57 +4271,64 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "conversation"
                 ],
-                "summary": "invite members",
+                "summary": "get single conversation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "invite info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.InviteMembersRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "invite success",
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "400": {
-                        "description": "parameter error",
+                    "404": {
+                        "description": "conversation not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     }
                 }
-            }
-        },
-        "/groups/{id}/members/{userId}": {
+            },
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove member from group (requires admin permission)",
+                "description": "Delete specified conversation (doesn't affect messages, only removes from conversation list)",
                 "consumes": [
                     "application/json"
                 ],
@@ -4579,28 +4336,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "conversation"
                 ],
-                "summary": "remove member",
+                "summary": "delete conversation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "user ID to remove",
-                        "name": "userId",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "remove success",
+                        "description": "success",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -4611,8 +4361,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "no permission",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -4620,14 +4370,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/members/{userId}/role": {
+        "/conversations/{conversationId}/auto_delete": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Set or remove group admin (owner only)",
+                "description": "Set conversation auto delete duration, 0 means cancel",
                 "consumes": [
                     "application/json"
                 ],
@@ -4635,37 +4385,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "conversation"
                 ],
-                "summary": "update member role",
+                "summary": "set auto delete",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "user ID",
-                        "name": "userId",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "role info",
+                        "description": "auto delete duration (seconds)",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UpdateMemberRoleRequest"
+                            "$ref": "#/definitions/internal_gateway_handler.setAutoDeleteRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "update success",
+                        "description": "success",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -4682,8 +4425,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "no permission",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -4691,14 +4434,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/nickname": {
+        "/conversations/{conversationId}/burn": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Set your nickname in the group",
+                "description": "Set conversation burn after reading duration, 0 means cancel",
                 "consumes": [
                     "application/json"
                 ],
@@ -4706,30 +4449,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "conversation"
                 ],
-                "summary": "update member nickname",
+                "summary": "set burn after reading",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "nickname info",
+                        "description": "burn after reading duration (seconds)",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UpdateMemberNicknameRequest"
+                            "$ref": "#/definitions/internal_gateway_handler.setBurnAfterReadingRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "update success",
+                        "description": "success",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -4745,18 +4488,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
                     }
                 }
             }
         },
-        "/groups/{id}/qrcode": {
+        "/conversations/{conversationId}/media": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get current valid group QR code, auto create/renew if not exists or expiring soon (all members)",
+                "description": "Images, videos, files and links shared in a conversation, newest first, with download and thumbnail URLs",
                 "consumes": [
                     "application/json"
                 ],
@@ -4764,21 +4513,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "message"
                 ],
-                "summary": "get group QR code",
+                "summary": "list conversation media",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated categories (1-image/2-video/3-file/4-link), empty for all",
+                        "name": "categories",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -4788,13 +4556,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupQRCodeResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "401": {
                         "description": "unauthorized",
                         "schema": {
@@ -4806,18 +4580,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
                     }
                 }
             }
         },
-        "/groups/{id}/qrcode/refresh": {
-            "post": {
+        "/conversations/{conversationId}/messages/after": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Refresh group QR code, invalidate old one and generate new one (owner/admin only)",
+                "description": "Query messages after an anchor_message_id in a conversation",
                 "consumes": [
                     "application/json"
                 ],
@@ -4825,21 +4605,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "message"
                 ],
-                "summary": "refresh group QR code",
+                "summary": "get messages after anchor",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "anchor message ID",
+                        "name": "anchor_message_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "limit (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -4849,21 +4643,27 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupQRCodeResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "401": {
                         "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "no permission",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -4871,14 +4671,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/quit": {
-            "post": {
+        "/conversations/{conversationId}/messages/around-anchor": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Quit specified group",
+                "description": "Query messages before and after anchor_message_id in one request",
                 "consumes": [
                     "application/json"
                 ],
@@ -4886,75 +4686,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "message"
                 ],
-                "summary": "quit group",
+                "summary": "get messages around anchor",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "quit success",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
                     },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/groups/{id}/remark": {
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Set a remark for the group that only you can see, pass empty string to clear",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "group"
-                ],
-                "summary": "set group remark",
-                "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "anchor message ID",
+                        "name": "anchor_message_id",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "remark info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UpdateMemberRemarkRequest"
-                        }
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "messages before anchor (default 20, max 100)",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "messages after anchor (default 20, max 100)",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include anchor message (default true)",
+                        "name": "include_anchor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "set success",
+                        "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -4969,8 +4756,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "not a group member",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -4978,14 +4765,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/requests": {
+        "/conversations/{conversationId}/messages/before": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get join requests for specified group (requires admin permission)",
+                "description": "Query messages before an anchor_message_id in a conversation",
                 "consumes": [
                     "application/json"
                 ],
@@ -4993,27 +4780,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "message"
                 ],
-                "summary": "get join requests",
+                "summary": "get messages before anchor",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "anchor message ID",
+                        "name": "anchor_message_id",
+                        "in": "query",
+                        "required": true
+                    },
                     {
                         "type": "integer",
-                        "description": "request status: 1=pending,2=accepted,3=rejected",
-                        "name": "status",
+                        "format": "int32",
+                        "description": "limit (default 20, max 100)",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -5023,21 +4818,27 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.JoinRequestListResponse"
+                                            "type": "object"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "401": {
                         "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "no permission",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -5045,14 +4846,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/requests/{requestId}": {
-            "put": {
+        "/conversations/{conversationId}/messages/first-unread-anchor": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Accept or reject join request (requires admin permission)",
+                "description": "Query first unread message anchor, optionally returning context windows",
                 "consumes": [
                     "application/json"
                 ],
@@ -5060,39 +4861,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "message"
                 ],
-                "summary": "handle join request",
+                "summary": "get first unread anchor",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "boolean",
+                        "description": "whether to include before/after context",
+                        "name": "with_context",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "request ID",
-                        "name": "requestId",
-                        "in": "path",
-                        "required": true
+                        "format": "int32",
+                        "description": "messages before anchor when with_context=true (default 20, max 100)",
+                        "name": "before",
+                        "in": "query"
                     },
                     {
-                        "description": "handle info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.HandleJoinRequestRequest"
-                        }
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "messages after anchor when with_context=true (default 20, max 100)",
+                        "name": "after",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "handle success",
+                        "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -5107,8 +4924,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "no permission",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -5116,14 +4933,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/transfer": {
+        "/conversations/{conversationId}/messages/read": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Transfer group owner to another member (owner only)",
+                "description": "Used in scrolling list scenarios, batch report visible message IDs and advance conversation read cursor",
                 "consumes": [
                     "application/json"
                 ],
@@ -5131,32 +4948,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "conversation"
                 ],
-                "summary": "transfer ownership",
+                "summary": "mark read by message ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "transfer info",
+                        "description": "message read list",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.TransferOwnershipRequest"
+                            "$ref": "#/definitions/internal_gateway_handler.markMessagesReadRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "transfer success",
+                        "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -5171,8 +5000,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "no permission",
+                    "500": {
+                        "description": "server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -5180,14 +5009,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/messages": {
-            "post": {
+        "/conversations/{conversationId}/messages/read-receipts": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Send conversation message via HTTP (supports idempotent local_id)",
+                "description": "Return last read sequence for members in conversation",
                 "consumes": [
                     "application/json"
                 ],
@@ -5195,18 +5024,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "conversation"
                 ],
-                "summary": "send message",
+                "summary": "get message read receipts",
                 "parameters": [
                     {
-                        "description": "message content",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.sendMessageRequest"
-                        }
+                        "type": "string",
+                        "description": "conversation ID",
+                        "name": "conversationId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5249,14 +5076,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/messages/read-triggers": {
-            "post": {
+        "/conversations/{conversationId}/messages/sequence": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Client batch reports message read trigger events, server starts burn timer accordingly",
+                "description": "Get latest message sequence in conversation",
                 "consumes": [
                     "application/json"
                 ],
@@ -5264,18 +5091,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "conversation"
                 ],
-                "summary": "burn after reading read trigger acknowledgment",
+                "summary": "get conversation message sequence",
                 "parameters": [
                     {
-                        "description": "read trigger events",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.ackReadTriggersRequest"
-                        }
+                        "type": "string",
+                        "description": "conversation ID",
+                        "name": "conversationId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5318,14 +5143,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/messages/recall": {
-            "post": {
+        "/conversations/{conversationId}/messages/unread-count": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Recall specified message, can only recall own messages within 2 minutes",
+                "description": "Query unread message count for current user in specified conversation",
                 "consumes": [
                     "application/json"
                 ],
@@ -5333,25 +5158,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "conversation"
                 ],
-                "summary": "recall message",
+                "summary": "get conversation unread count",
                 "parameters": [
                     {
-                        "description": "message ID",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.recallMessageRequest"
-                        }
+                        "type": "string",
+                        "description": "conversation ID",
+                        "name": "conversationId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "optional, client read sequence",
+                        "name": "last_read_seq",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -5366,18 +5208,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "no permission or timeout",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "message not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -5387,14 +5217,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/messages/search": {
-            "get": {
+        "/conversations/{conversationId}/mute": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Search messages by keyword and conversation scope",
+                "description": "Enable or disable mute for specified conversation",
                 "consumes": [
                     "application/json"
                 ],
@@ -5402,62 +5232,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "conversation"
                 ],
-                "summary": "search messages",
+                "summary": "set conversation mute",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "keyword",
-                        "name": "keyword",
-                        "in": "query",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "conversation ID",
-                        "name": "conversation_id",
-                        "in": "query",
+                        "name": "conversationId",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "message type (1-text/2-image/3-video/4-audio/5-file/6-location/7-card)",
-                        "name": "content_type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "page size (default 20, max 100)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "offset",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "mute status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.setMutedRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "400": {
@@ -5481,14 +5281,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/messages/{messageId}": {
-            "get": {
+        "/conversations/{conversationId}/pin": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get single message by message ID",
+                "description": "Pin or unpin specified conversation",
                 "consumes": [
                     "application/json"
                 ],
@@ -5496,35 +5296,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "conversation"
                 ],
-                "summary": "get message detail",
+                "summary": "set conversation pin",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "message ID",
-                        "name": "messageId",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "pin status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.setPinnedRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "400": {
@@ -5539,12 +5336,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "404": {
-                        "description": "message not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -5552,14 +5343,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/conversations/{conversationId}/read-all": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete specified message, can only delete own messages",
+                "description": "Clear unread count for specified conversation and advance message read cursor to latest sequence",
                 "consumes": [
                     "application/json"
                 ],
@@ -5567,14 +5360,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "conversation"
                 ],
-                "summary": "delete message",
+                "summary": "mark conversation as read",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "message ID",
-                        "name": "messageId",
+                        "description": "conversation ID",
+                        "name": "conversationId",
                         "in": "path",
                         "required": true
                     }
@@ -5592,18 +5385,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "403": {
-                        "description": "no permission",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "message not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -5613,14 +5394,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/sync": {
-            "post": {
+        "/files": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Called when client logs in or resumes from background. Returns friends, groups, conversation changes since lastSyncTime, and offline messages for each conversation. lastSyncTime=0 means full sync.",
+                "description": "Paginate list of current user's files, supports filtering by type",
                 "consumes": [
                     "application/json"
                 ],
@@ -5628,23 +5409,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sync"
+                    "file"
                 ],
-                "summary": "data sync",
+                "summary": "list user files",
                 "parameters": [
                     {
-                        "description": "sync request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.syncRequest"
-                        }
+                        "type": "integer",
+                        "description": "file type (1-image/2-video/3-audio/4-file/5-log)",
+                        "name": "fileType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "page size",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "sync success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -5682,14 +5474,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/sync/messages": {
+        "/files/multipart": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Pull offline messages based on the latest known sequence number for each conversation",
+                "description": "Start a resumable upload for large files. The file is split into parts of part_size bytes (the last part may be smaller), each part is uploaded to its own presigned URL",
                 "consumes": [
                     "application/json"
                 ],
@@ -5697,29 +5489,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sync"
+                    "file"
                 ],
-                "summary": "message sync",
+                "summary": "start a multipart upload",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "max messages per conversation (default 50)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "description": "message sync request",
+                        "description": "file_name, file_size, mime_type, file_type, optional part_size (bytes, 5MB-100MB), expires_hours and group_id (count against the group's quota)",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.syncMessagesRequest"
+                            "type": "object"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "upload session",
                         "schema": {
                             "allOf": [
                                 {
@@ -5748,50 +5534,62 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "500": {
-                        "description": "server error",
+                    "403": {
+                        "description": "not a member of the group",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
-                    }
-                }
+                    },
+                    "429": {
+                        "description": "storage quota exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
             }
         },
-        "/users/me": {
-            "get": {
+        "/files/multipart/{uploadId}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed profile of currently logged in user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Cancel the upload and free the uploaded parts",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "file"
+                ],
+                "summary": "abort a multipart upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "upload ID",
+                        "name": "uploadId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "get personal profile",
                 "responses": {
                     "200": {
-                        "description": "get success",
+                        "description": "success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/internal_gateway_handler.UserProfile"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "upload already completed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
@@ -5800,6 +5598,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "404": {
+                        "description": "upload not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -5807,38 +5611,35 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/files/multipart/{uploadId}/complete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update personal profile of currently logged in user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Assemble the uploaded parts into the file and activate it. All parts must be uploaded and add up to file_size",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "file"
                 ],
-                "summary": "update personal profile",
+                "summary": "complete a multipart upload",
                 "parameters": [
                     {
-                        "description": "profile info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.UpdateProfileRequest"
-                        }
+                        "type": "string",
+                        "description": "upload ID",
+                        "name": "uploadId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "update success",
+                        "description": "file info",
                         "schema": {
                             "allOf": [
                                 {
@@ -5848,7 +5649,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/internal_gateway_handler.UserProfile"
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -5856,7 +5657,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parts missing or upload closed",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -5867,6 +5668,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "404": {
+                        "description": "upload not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -5876,14 +5683,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/email/bind": {
+        "/files/multipart/{uploadId}/part-urls": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Bind email for currently logged in user",
+                "description": "Presign PUT URLs for up to 100 parts. Upload each part body to its URL; URLs can be requested again when they expire",
                 "consumes": [
                     "application/json"
                 ],
@@ -5891,23 +5698,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "file"
                 ],
-                "summary": "bind email",
+                "summary": "get part upload URLs",
                 "parameters": [
                     {
-                        "description": "bind email info",
+                        "type": "string",
+                        "description": "upload ID",
+                        "name": "uploadId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "part_numbers: 1-based part numbers",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.BindEmailRequest"
+                            "type": "object"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "bind success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -5925,7 +5739,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "parameter error or upload closed",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -5936,8 +5750,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "409": {
-                        "description": "email already in use",
+                    "404": {
+                        "description": "upload not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -5951,38 +5765,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/email/change": {
-            "post": {
+        "/files/multipart/{uploadId}/parts": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Change bound email for currently logged in user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List the parts already stored, so an interrupted upload can resume with the missing parts",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "file"
                 ],
-                "summary": "change email",
+                "summary": "list uploaded parts",
                 "parameters": [
                     {
-                        "description": "change email info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.ChangeEmailRequest"
-                        }
+                        "type": "string",
+                        "description": "upload ID",
+                        "name": "uploadId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "change success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -6000,7 +5809,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "parameter error",
+                        "description": "upload closed",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -6011,8 +5820,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "409": {
-                        "description": "email already in use",
+                    "404": {
+                        "description": "upload not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -6026,38 +5835,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/phone/bind": {
-            "post": {
+        "/files/storage-usage": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Bind phone number for currently logged in user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Total size of active files counted against the current user's quota, or against a group the user belongs to. quota_bytes 0 means unlimited; client logs do not count",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "file"
                 ],
-                "summary": "bind phone",
+                "summary": "get storage usage",
                 "parameters": [
                     {
-                        "description": "bind phone info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.BindPhoneRequest"
-                        }
+                        "type": "string",
+                        "description": "group ID, usage of the group instead of the user",
+                        "name": "group_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "bind success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -6074,20 +5877,14 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "parameter error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                        }
-                    },
                     "401": {
                         "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "409": {
-                        "description": "phone number already in use",
+                    "403": {
+                        "description": "not a member of the group",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -6101,14 +5898,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/phone/change": {
+        "/files/upload-token": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Change bound phone number for currently logged in user",
+                "description": "Generate presigned upload URL, client uses this URL to upload directly to MinIO. The signature covers file_size and mime_type: the PUT must send the returned upload_headers unchanged. With sha256 set, content already stored is reused: instant_upload is true, the file is active and no upload is needed; otherwise the hash is verified on completion. The file counts against the uploader's storage quota, or the group's with group_id set",
                 "consumes": [
                     "application/json"
                 ],
@@ -6116,23 +5913,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "file"
                 ],
-                "summary": "change phone",
+                "summary": "generate file upload token",
                 "parameters": [
                     {
-                        "description": "change phone info",
+                        "description": "upload request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.ChangePhoneRequest"
+                            "type": "object"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "change success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -6161,8 +5958,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "409": {
-                        "description": "phone number already in use",
+                    "403": {
+                        "description": "not a member of the group",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "storage quota exceeded",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -6176,14 +5979,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/push-token": {
-            "post": {
+        "/files/{fileId}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update device push notification token",
+                "description": "Get file metadata info. Available to the uploader and to participants of conversations the file was sent to",
                 "consumes": [
                     "application/json"
                 ],
@@ -6191,25 +5994,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "file"
                 ],
-                "summary": "update push token",
+                "summary": "get file info",
                 "parameters": [
                     {
-                        "description": "push token info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.UpdatePushTokenRequest"
-                        }
+                        "type": "string",
+                        "description": "file ID",
+                        "name": "fileId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "update success",
+                        "description": "success",
                         "schema": {
-                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -6224,6 +6037,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "file not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -6231,16 +6056,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/me/settings": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get current user's preference settings",
+                "description": "Delete file (including object in MinIO)",
                 "consumes": [
                     "application/json"
                 ],
@@ -6248,26 +6071,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "file"
+                ],
+                "summary": "delete file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "file ID",
+                        "name": "fileId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "get user settings",
                 "responses": {
                     "200": {
-                        "description": "get success",
+                        "description": "success",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/internal_gateway_handler.UserSettings"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
                     "401": {
@@ -6276,6 +6102,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "file not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -6283,14 +6121,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/files/{fileId}/complete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update current user's preference settings",
+                "description": "After client finishes upload, notify server to activate file. The stored size is checked against the declared size and the limit of the file type, and the magic bytes against file_type/mime_type; a mismatching object is deleted (70103/70115) and the detected MIME type is stored. Thumbnails, metadata and EXIF stripping run asynchronously, file.upload_completed is pushed when done",
                 "consumes": [
                     "application/json"
                 ],
@@ -6298,23 +6138,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "file"
                 ],
-                "summary": "update user settings",
+                "summary": "complete file upload",
                 "parameters": [
                     {
-                        "description": "settings info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_gateway_handler.UpdateSettingsRequest"
-                        }
+                        "type": "string",
+                        "description": "file ID",
+                        "name": "fileId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "update success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -6324,7 +6162,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/internal_gateway_handler.UserSettings"
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -6343,6 +6181,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "404": {
+                        "description": "file not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -6352,14 +6196,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/search": {
+        "/files/{fileId}/download": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Search users by keyword (supports nickname, phone, user ID)",
+                "description": "Generate presigned download URL, client uses this URL to download directly from MinIO. Available to the uploader and to participants of conversations the file was sent to. Images also get a URL for every generated thumbnail size",
                 "consumes": [
                     "application/json"
                 ],
@@ -6367,35 +6211,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "file"
                 ],
-                "summary": "search users",
+                "summary": "generate file download URL",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "search keyword",
-                        "name": "keyword",
-                        "in": "query",
+                        "description": "file ID",
+                        "name": "fileId",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "page size",
-                        "name": "pageSize",
+                        "default": 60,
+                        "description": "URL expiration (minutes)",
+                        "name": "expiresMinutes",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "search success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -6405,7 +6242,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/internal_gateway_handler.UserSearchResult"
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -6424,6 +6261,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "file not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -6433,14 +6282,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}": {
+        "/friends": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get public info of specified user",
+                "description": "Get all friends of current user, supports incremental sync",
                 "consumes": [
                     "application/json"
                 ],
@@ -6448,21 +6297,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "friend"
                 ],
-                "summary": "get user info",
+                "summary": "get friend list",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "user ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "last update timestamp (incremental sync)",
+                        "name": "lastUpdateTime",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "get success",
+                        "description": "success",
                         "schema": {
                             "allOf": [
                                 {
@@ -6472,7 +6321,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/internal_gateway_handler.UserSearchItem"
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -6485,8 +6334,54 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
                     },
-                    "404": {
-                        "description": "user not found",
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/friends/blacklist": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get current user's blacklist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "friend"
+                ],
+                "summary": "get blacklist",
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
                         }
@@ -6498,717 +6393,4324 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/ws": {
-            "get": {
-                "description": "Client maintains long connection via WebSocket to receive real-time notifications and message pushes. Since WebSocket protocol doesn't support custom headers, JWT token is passed via URL query parameter.",
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add specified user to blacklist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "realtime"
+                    "friend"
                 ],
-                "summary": "establish WebSocket long connection",
+                "summary": "add to blacklist",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "JWT access token",
-                        "name": "token",
-                        "in": "query",
-                        "required": true
+                        "description": "user ID",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
                     }
                 ],
-                "responses": {}
-            }
-        }
-    },
-    "definitions": {
-        "github_com_anychat_server_internal_group_dto.CreateGroupRequest": {
-            "type": "object",
-            "required": [
-                "member_ids",
-                "name"
-            ],
-            "properties": {
-                "avatar": {
-                    "type": "string",
-                    "example": "https://example.com/avatar.jpg"
-                },
-                "member_ids": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "type": "string"
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
                     },
-                    "example": [
-                        "user-123",
-                        "user-456"
-                    ]
-                },
-                "name": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1,
-                    "example": "Tech Discussion Group"
-                }
-            }
-        },
-        "github_com_anychat_server_internal_group_dto.GroupListResponse": {
-            "type": "object",
-            "properties": {
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/friends/blacklist/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove specified user from blacklist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "friend"
+                ],
+                "summary": "remove from blacklist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "blocked user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/friends/requests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get friend request list by numeric request_type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "friend"
+                ],
+                "summary": "get friend request list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "request type: 1-received 2-sent",
+                        "name": "request_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send friend request to specified user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "friend"
+                ],
+                "summary": "send friend request",
+                "parameters": [
+                    {
+                        "description": "request info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/friends/requests/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Handle friend request with numeric action",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "friend"
+                ],
+                "summary": "handle friend request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "handle action: 1-accept 2-reject",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/friends/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete specified friend",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "friend"
+                ],
+                "summary": "delete friend",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "friend user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/friends/{id}/remark": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update remark name for specified friend",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "friend"
+                ],
+                "summary": "update friend remark",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "friend user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "remark info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all groups the current user has joined",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "get my groups",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "last update timestamp (incremental sync)",
+                        "name": "lastUpdateTime",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new group, requires at least 2 members (including creator)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "create group",
+                "parameters": [
+                    {
+                        "description": "group info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.CreateGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "create success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/join-by-qrcode": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Join group via QR code token, join directly or submit request based on group settings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "join group via QR code",
+                "parameters": [
+                    {
+                        "description": "QR code token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "token": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.JoinGroupByQRCodeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "QR code invalid or expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/preview": {
+            "get": {
+                "description": "Get group name, avatar, member count and join verification via QR code token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "group preview",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "QR code token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupQRCodePreviewResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "invalid parameter or QR code expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get detailed info of specified group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "get group info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "group not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update group name, announcement etc (requires admin permission). The avatar can only be cleared here",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "update group info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "update info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UpdateGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "update success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Dissolve group (owner only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "dissolve group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dissolve success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/avatar": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Crop an uploaded image to a square, resize it to the standard avatar sizes and bind it to the group (owner and admins).\nGroups without an uploaded avatar show a composite of the first nine members' avatars",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "set group avatar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "uploaded image",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.SetGroupAvatarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "avatar set",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "avatar": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error or invalid image",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/join": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request to join specified group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "join group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "join request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.JoinGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "request success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.JoinGroupResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/members": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get members of specified group, supports pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "get group members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "page size",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupMemberListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "group not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invite users to join group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "invite members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "invite info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.InviteMembersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "invite success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/members/{userId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove member from group (requires admin permission)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "remove member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "user ID to remove",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "remove success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/members/{userId}/role": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set or remove group admin (owner only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "update member role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "role info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UpdateMemberRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "update success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/nickname": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set your nickname in the group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "update member nickname",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "nickname info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UpdateMemberNicknameRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "update success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/qrcode": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get current valid group QR code, auto create/renew if not exists or expiring soon (all members)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "get group QR code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupQRCodeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "not a group member",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/qrcode/refresh": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Refresh group QR code, invalidate old one and generate new one (owner/admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "refresh group QR code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupQRCodeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/quit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Quit specified group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "quit group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "quit success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/remark": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set a remark for the group that only you can see, pass empty string to clear",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "set group remark",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "remark info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UpdateMemberRemarkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "set success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "not a group member",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/requests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get join requests for specified group (requires admin permission)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "get join requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "request status: 1=pending,2=accepted,3=rejected",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.JoinRequestListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/requests/{requestId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Accept or reject join request (requires admin permission)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "handle join request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "request ID",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "handle info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.HandleJoinRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "handle success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/transfer": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Transfer group owner to another member (owner only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "transfer ownership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "transfer info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.TransferOwnershipRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "transfer success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send conversation message via HTTP (supports idempotent local_id)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "send message",
+                "parameters": [
+                    {
+                        "description": "message content",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.sendMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages/read-triggers": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Client batch reports message read trigger events, server starts burn timer accordingly",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "burn after reading read trigger acknowledgment",
+                "parameters": [
+                    {
+                        "description": "read trigger events",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.ackReadTriggersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages/recall": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Recall specified message, can only recall own messages within 2 minutes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "recall message",
+                "parameters": [
+                    {
+                        "description": "message ID",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.recallMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission or timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "message not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Search messages by keyword and conversation scope",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "search messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "keyword",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "conversation ID",
+                        "name": "conversation_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "message type (1-text/2-image/3-video/4-audio/5-file/6-location/7-card)",
+                        "name": "content_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages/{messageId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get single message by message ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "get message detail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "message ID",
+                        "name": "messageId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "message not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete specified message, can only delete own messages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "delete message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "message ID",
+                        "name": "messageId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "no permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "message not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sync": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Called when client logs in or resumes from background. Returns friends, groups, conversation changes since lastSyncTime, and offline messages for each conversation. lastSyncTime=0 means full sync.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sync"
+                ],
+                "summary": "data sync",
+                "parameters": [
+                    {
+                        "description": "sync request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.syncRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "sync success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/sync/messages": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Pull offline messages based on the latest known sequence number for each conversation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sync"
+                ],
+                "summary": "message sync",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "max messages per conversation (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "description": "message sync request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.syncMessagesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get detailed profile of currently logged in user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "get personal profile",
+                "responses": {
+                    "200": {
+                        "description": "get success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.UserProfile"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update personal profile of currently logged in user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "update personal profile",
+                "parameters": [
+                    {
+                        "description": "profile info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.UpdateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "update success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.UserProfile"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/avatar": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Crop an uploaded image to a square, resize it to the standard avatar sizes and bind it to the profile.\nThe image must be uploaded by the current user, JPEG, PNG or GIF, at least 64x64",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "set avatar",
+                "parameters": [
+                    {
+                        "description": "uploaded image",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.SetAvatarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "avatar set",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.UserProfile"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error or invalid image",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/email/bind": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bind email for currently logged in user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "bind email",
+                "parameters": [
+                    {
+                        "description": "bind email info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.BindEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "bind success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "email already in use",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/email/change": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change bound email for currently logged in user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "change email",
+                "parameters": [
+                    {
+                        "description": "change email info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.ChangeEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "change success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "MFA code required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "email already in use",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/phone/bind": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bind phone number for currently logged in user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "bind phone",
+                "parameters": [
+                    {
+                        "description": "bind phone info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.BindPhoneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "bind success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "phone number already in use",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/phone/change": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change bound phone number for currently logged in user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "change phone",
+                "parameters": [
+                    {
+                        "description": "change phone info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.ChangePhoneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "change success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "MFA code required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "phone number already in use",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/push-token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update device push notification token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "update push token",
+                "parameters": [
+                    {
+                        "description": "push token info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.UpdatePushTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "update success",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/settings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get current user's preference settings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "get user settings",
+                "responses": {
+                    "200": {
+                        "description": "get success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.UserSettings"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update current user's preference settings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "update user settings",
+                "parameters": [
+                    {
+                        "description": "settings info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_gateway_handler.UpdateSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "update success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.UserSettings"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Search users by keyword (supports nickname, phone, user ID)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "search users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "search keyword",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "page size",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "search success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.UserSearchResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "parameter error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get public info of specified user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "get user info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "get success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/internal_gateway_handler.UserSearchItem"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_anychat_server_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws": {
+            "get": {
+                "description": "Client maintains long connection via WebSocket to receive real-time notifications and message pushes. Since WebSocket protocol doesn't support custom headers, JWT token is passed via URL query parameter.",
+                "tags": [
+                    "realtime"
+                ],
+                "summary": "establish WebSocket long connection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWT access token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {}
+            }
+        }
+    },
+    "definitions": {
+        "github_com_anychat_server_internal_group_dto.CreateGroupRequest": {
+            "type": "object",
+            "required": [
+                "member_ids",
+                "name"
+            ],
+            "properties": {
+                "avatar": {
+                    "description": "must be empty, upload one with PUT /groups/{id}/avatar",
+                    "type": "string",
+                    "example": ""
+                },
+                "member_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "user-123",
+                        "user-456"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Tech Discussion Group"
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.GroupListResponse": {
+            "type": "object",
+            "properties": {
                 "groups": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupResponse"
+                        "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "update_time": {
+                    "type": "integer",
+                    "example": 1609459200
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.GroupMemberListResponse": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupMemberResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.GroupMemberResponse": {
+            "type": "object",
+            "properties": {
+                "group_nickname": {
+                    "type": "string",
+                    "example": "Group nickname"
+                },
+                "is_muted": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "joined_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "muted_until": {
+                    "type": "string",
+                    "example": "2024-01-01T01:00:00Z"
+                },
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_model.GroupRole"
+                        }
+                    ],
+                    "example": 3
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user-123"
+                },
+                "user_info": {
+                    "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UserInfo"
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.GroupQRCodePreviewResponse": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "example": "https://example.com/group.png"
+                },
+                "group_id": {
+                    "type": "string",
+                    "example": "group-123"
+                },
+                "member_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Product Team"
+                },
+                "need_verify": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.GroupQRCodeResponse": {
+            "type": "object",
+            "properties": {
+                "deep_link": {
+                    "type": "string",
+                    "example": "anychat://join/group?token=550e8400-e29b-41d4-a716-446655440000"
+                },
+                "expire_at": {
+                    "description": "Unix timestamp in seconds",
+                    "type": "integer",
+                    "example": 1754006400
+                },
+                "token": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.GroupResponse": {
+            "type": "object",
+            "properties": {
+                "announcement": {
+                    "type": "string",
+                    "example": "Welcome to join"
+                },
+                "avatar": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Group description"
+                },
+                "display_name": {
+                    "description": "Remark takes priority, falls back to Name",
+                    "type": "string",
+                    "example": "Product Discussion Group"
+                },
+                "group_id": {
+                    "type": "string",
+                    "example": "group-123"
+                },
+                "is_muted": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "join_verify": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "max_members": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "member_count": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "my_role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_model.GroupRole"
+                        }
+                    ],
+                    "example": 3
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tech Discussion Group"
+                },
+                "owner_id": {
+                    "type": "string",
+                    "example": "user-123"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.HandleJoinRequestRequest": {
+            "type": "object",
+            "properties": {
+                "accept": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.InviteMembersRequest": {
+            "type": "object",
+            "required": [
+                "user_ids"
+            ],
+            "properties": {
+                "user_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "user-123",
+                        "user-456"
+                    ]
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.JoinGroupByQRCodeResponse": {
+            "type": "object",
+            "properties": {
+                "group_id": {
+                    "type": "string",
+                    "example": "group-123"
+                },
+                "joined": {
+                    "description": "true=direct join, false=request submitted",
+                    "type": "boolean",
+                    "example": true
+                },
+                "need_verify": {
+                    "description": "whether approval is needed",
+                    "type": "boolean",
+                    "example": false
+                },
+                "request_id": {
+                    "type": "integer",
+                    "example": 123
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.JoinGroupRequest": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Hello, I would like to join the group"
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.JoinGroupResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Request submitted, waiting for approval"
+                },
+                "need_verify": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "request_id": {
+                    "type": "integer",
+                    "example": 123
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.JoinRequestListResponse": {
+            "type": "object",
+            "properties": {
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.JoinRequestResponse"
                     }
                 },
-                "total": {
-                    "type": "integer",
-                    "example": 5
+                "total": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.JoinRequestResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "group_id": {
+                    "type": "string",
+                    "example": "group-123"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "inviter_id": {
+                    "type": "string",
+                    "example": "user-456"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Hello, I would like to join"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_anychat_server_internal_group_model.JoinRequestStatus"
+                        }
+                    ],
+                    "example": 1
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user-123"
                 },
-                "update_time": {
-                    "type": "integer",
-                    "example": 1609459200
+                "user_info": {
+                    "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UserInfo"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.GroupMemberListResponse": {
+        "github_com_anychat_server_internal_group_dto.SetGroupAvatarRequest": {
             "type": "object",
+            "required": [
+                "file_id"
+            ],
             "properties": {
-                "members": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.GroupMemberResponse"
-                    }
-                },
-                "page": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "total": {
-                    "type": "integer",
-                    "example": 10
+                "file_id": {
+                    "description": "image uploaded through the file API",
+                    "type": "string",
+                    "example": "file-123"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.GroupMemberResponse": {
+        "github_com_anychat_server_internal_group_dto.TransferOwnershipRequest": {
             "type": "object",
+            "required": [
+                "new_owner_id"
+            ],
             "properties": {
-                "group_nickname": {
+                "new_owner_id": {
                     "type": "string",
-                    "example": "Group nickname"
-                },
-                "is_muted": {
-                    "type": "boolean",
-                    "example": false
+                    "example": "user-456"
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.UpdateGroupRequest": {
+            "type": "object",
+            "properties": {
+                "announcement": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Group announcement content"
                 },
-                "joined_at": {
+                "avatar": {
+                    "description": "only \"\" to clear it (owner and admins)",
                     "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                    "example": ""
                 },
-                "muted_until": {
+                "description": {
                     "type": "string",
-                    "example": "2024-01-01T01:00:00Z"
+                    "maxLength": 1000,
+                    "example": "Group description content"
                 },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "New group name"
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.UpdateMemberNicknameRequest": {
+            "type": "object",
+            "required": [
+                "nickname"
+            ],
+            "properties": {
+                "nickname": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Group nickname"
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.UpdateMemberRemarkRequest": {
+            "type": "object",
+            "properties": {
+                "remark": {
+                    "description": "Empty string clears remark",
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "Product Discussion Group"
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_dto.UpdateMemberRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
                 "role": {
+                    "enum": [
+                        2,
+                        3
+                    ],
                     "allOf": [
                         {
                             "$ref": "#/definitions/github_com_anychat_server_internal_group_model.GroupRole"
                         }
                     ],
-                    "example": 3
-                },
-                "user_id": {
-                    "type": "string",
-                    "example": "user-123"
-                },
-                "user_info": {
-                    "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UserInfo"
+                    "example": 2
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.GroupQRCodePreviewResponse": {
+        "github_com_anychat_server_internal_group_dto.UserInfo": {
             "type": "object",
             "properties": {
                 "avatar": {
                     "type": "string",
-                    "example": "https://example.com/group.png"
+                    "example": "https://example.com/avatar.jpg"
                 },
-                "group_id": {
+                "bio": {
                     "type": "string",
-                    "example": "group-123"
+                    "example": "Personal signature"
                 },
-                "member_count": {
+                "gender": {
                     "type": "integer",
-                    "example": 42
+                    "example": 1
                 },
-                "name": {
+                "nickname": {
                     "type": "string",
-                    "example": "Product Team"
+                    "example": "Zhang San"
                 },
-                "need_verify": {
-                    "type": "boolean",
-                    "example": true
+                "user_id": {
+                    "type": "string",
+                    "example": "user-123"
+                }
+            }
+        },
+        "github_com_anychat_server_internal_group_model.GroupRole": {
+            "type": "integer",
+            "format": "int32",
+            "enum": [
+                0,
+                1,
+                2,
+                3
+            ],
+            "x-enum-varnames": [
+                "GroupRoleUnknown",
+                "GroupRoleOwner",
+                "GroupRoleAdmin",
+                "GroupRoleMember"
+            ]
+        },
+        "github_com_anychat_server_internal_group_model.JoinRequestStatus": {
+            "type": "integer",
+            "format": "int32",
+            "enum": [
+                0,
+                1,
+                2,
+                3
+            ],
+            "x-enum-varnames": [
+                "JoinRequestStatusUnknown",
+                "JoinRequestStatusPending",
+                "JoinRequestStatusAccepted",
+                "JoinRequestStatusRejected"
+            ]
+        },
+        "github_com_anychat_server_pkg_response.Response": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.banUserRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.createAdminRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.loginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.reviewQuarantinedFileRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "description": "reason recorded with the verdict",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.setStorageQuotaRequest": {
+            "type": "object",
+            "properties": {
+                "clear_quota": {
+                    "description": "drop the override",
+                    "type": "boolean"
+                },
+                "quota_bytes": {
+                    "description": "override, 0 means unlimited",
+                    "type": "integer"
+                },
+                "tier": {
+                    "description": "users only, empty resets to the default tier",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.updateAdminStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.updateConfigRequest": {
+            "type": "object",
+            "required": [
+                "value"
+            ],
+            "properties": {
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.GroupQRCodeResponse": {
+        "internal_gateway_handler.AccountDeletionResponse": {
             "type": "object",
             "properties": {
-                "deep_link": {
-                    "type": "string",
-                    "example": "anychat://join/group?token=550e8400-e29b-41d4-a716-446655440000"
+                "cancelled_at": {
+                    "type": "integer",
+                    "example": 0
                 },
-                "expire_at": {
-                    "description": "Unix timestamp in seconds",
+                "completed_at": {
                     "type": "integer",
-                    "example": 1754006400
+                    "example": 0
                 },
-                "token": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                "completed_steps": {
+                    "description": "services already erased: user, friend, group, conversation, message, file, auth",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "erase_after": {
+                    "type": "integer",
+                    "example": 1701209600
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "requested_at": {
+                    "type": "integer",
+                    "example": 1700000000
+                },
+                "status": {
+                    "description": "1-pending 2-erasing 3-completed 4-cancelled",
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.GroupResponse": {
+        "internal_gateway_handler.AuthResponse": {
             "type": "object",
             "properties": {
-                "announcement": {
-                    "type": "string",
-                    "example": "Welcome to join"
-                },
-                "avatar": {
-                    "type": "string",
-                    "example": "https://example.com/avatar.jpg"
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "Group description"
-                },
-                "display_name": {
-                    "description": "Remark takes priority, falls back to Name",
+                "access_token": {
                     "type": "string",
-                    "example": "Product Discussion Group"
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
                 },
-                "group_id": {
-                    "type": "string",
-                    "example": "group-123"
+                "expires_in": {
+                    "type": "integer",
+                    "example": 7200
                 },
-                "is_muted": {
+                "mfa_required": {
+                    "description": "second factor required, call /auth/login/mfa with mfa_token",
                     "type": "boolean",
                     "example": false
                 },
-                "join_verify": {
-                    "type": "boolean",
-                    "example": true
-                },
-                "max_members": {
-                    "type": "integer",
-                    "example": 500
-                },
-                "member_count": {
-                    "type": "integer",
-                    "example": 10
-                },
-                "my_role": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_model.GroupRole"
-                        }
-                    ],
-                    "example": 3
-                },
-                "name": {
+                "mfa_token": {
                     "type": "string",
-                    "example": "Tech Discussion Group"
+                    "example": ""
                 },
-                "owner_id": {
+                "refresh_token": {
                     "type": "string",
-                    "example": "user-123"
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
                 },
-                "updated_at": {
+                "user": {
+                    "$ref": "#/definitions/internal_gateway_handler.UserInfo"
+                },
+                "user_id": {
                     "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                    "example": "user-123"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.HandleJoinRequestRequest": {
+        "internal_gateway_handler.BeginMFAEnrollmentResponse": {
             "type": "object",
             "properties": {
-                "accept": {
-                    "type": "boolean",
-                    "example": true
+                "otpauth_uri": {
+                    "type": "string",
+                    "example": "otpauth://totp/AnyChat:user%40example.com?algorithm=SHA1\u0026digits=6\u0026issuer=AnyChat\u0026period=30\u0026secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.InviteMembersRequest": {
+        "internal_gateway_handler.BeginOIDCLoginRequest": {
             "type": "object",
-            "required": [
-                "user_ids"
-            ],
             "properties": {
-                "user_ids": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "user-123",
-                        "user-456"
-                    ]
+                "redirect_uri": {
+                    "description": "must be registered for the provider, empty uses the default",
+                    "type": "string",
+                    "example": "https://app.anychat.im/oidc/callback"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.JoinGroupByQRCodeResponse": {
+        "internal_gateway_handler.BeginOIDCLoginResponse": {
             "type": "object",
             "properties": {
-                "group_id": {
+                "authorization_url": {
                     "type": "string",
-                    "example": "group-123"
-                },
-                "joined": {
-                    "description": "true=direct join, false=request submitted",
-                    "type": "boolean",
-                    "example": true
-                },
-                "need_verify": {
-                    "description": "whether approval is needed",
-                    "type": "boolean",
-                    "example": false
+                    "example": "https://sso.example.com/oauth/v2/authorize?client_id=..."
                 },
-                "request_id": {
+                "expires_in": {
                     "type": "integer",
-                    "example": 123
-                }
-            }
-        },
-        "github_com_anychat_server_internal_group_dto.JoinGroupRequest": {
-            "type": "object",
-            "properties": {
-                "message": {
+                    "example": 600
+                },
+                "state": {
                     "type": "string",
-                    "maxLength": 200,
-                    "example": "Hello, I would like to join the group"
+                    "example": "Jx3ZbH0kq2cT9yV6fW1sNQ"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.JoinGroupResponse": {
+        "internal_gateway_handler.BindEmailRequest": {
             "type": "object",
+            "required": [
+                "email",
+                "verify_code"
+            ],
             "properties": {
-                "message": {
+                "email": {
                     "type": "string",
-                    "example": "Request submitted, waiting for approval"
-                },
-                "need_verify": {
-                    "type": "boolean",
-                    "example": true
+                    "example": "user@example.com"
                 },
-                "request_id": {
-                    "type": "integer",
-                    "example": 123
+                "verify_code": {
+                    "type": "string",
+                    "example": "123456"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.JoinRequestListResponse": {
+        "internal_gateway_handler.BindPhoneRequest": {
             "type": "object",
+            "required": [
+                "phone_number",
+                "verify_code"
+            ],
             "properties": {
-                "requests": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.JoinRequestResponse"
-                    }
+                "phone_number": {
+                    "type": "string",
+                    "example": "13800138000"
                 },
-                "total": {
-                    "type": "integer",
-                    "example": 3
+                "verify_code": {
+                    "type": "string",
+                    "example": "123456"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.JoinRequestResponse": {
+        "internal_gateway_handler.ChangeEmailRequest": {
             "type": "object",
+            "required": [
+                "new_email",
+                "new_verify_code",
+                "old_email"
+            ],
             "properties": {
-                "created_at": {
+                "mfa_code": {
+                    "description": "required when MFA is enabled",
                     "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                    "example": "654321"
                 },
-                "group_id": {
+                "new_email": {
                     "type": "string",
-                    "example": "group-123"
-                },
-                "id": {
-                    "type": "integer",
-                    "example": 123
+                    "example": "new@example.com"
                 },
-                "inviter_id": {
+                "new_verify_code": {
                     "type": "string",
-                    "example": "user-456"
+                    "example": "123456"
                 },
-                "message": {
+                "old_email": {
                     "type": "string",
-                    "example": "Hello, I would like to join"
-                },
-                "status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_model.JoinRequestStatus"
-                        }
-                    ],
-                    "example": 1
+                    "example": "old@example.com"
                 },
-                "user_id": {
+                "old_verify_code": {
                     "type": "string",
-                    "example": "user-123"
-                },
-                "user_info": {
-                    "$ref": "#/definitions/github_com_anychat_server_internal_group_dto.UserInfo"
+                    "example": "123456"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.TransferOwnershipRequest": {
+        "internal_gateway_handler.ChangePasswordRequest": {
             "type": "object",
             "required": [
-                "new_owner_id"
+                "device_id",
+                "new_password",
+                "old_password"
             ],
             "properties": {
-                "new_owner_id": {
+                "device_id": {
                     "type": "string",
-                    "example": "user-456"
+                    "example": "device-uuid-123"
+                },
+                "mfa_code": {
+                    "description": "required when MFA is enabled",
+                    "type": "string",
+                    "example": "654321"
+                },
+                "new_password": {
+                    "type": "string",
+                    "example": "newpass123"
+                },
+                "old_password": {
+                    "type": "string",
+                    "example": "oldpass123"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.UpdateGroupRequest": {
+        "internal_gateway_handler.ChangePhoneRequest": {
             "type": "object",
+            "required": [
+                "new_phone_number",
+                "new_verify_code",
+                "old_phone_number"
+            ],
             "properties": {
-                "announcement": {
+                "mfa_code": {
+                    "description": "required when MFA is enabled",
                     "type": "string",
-                    "maxLength": 1000,
-                    "example": "Group announcement content"
+                    "example": "654321"
+                },
+                "new_phone_number": {
+                    "type": "string",
+                    "example": "13900139000"
                 },
-                "avatar": {
+                "new_verify_code": {
                     "type": "string",
-                    "example": "https://example.com/new-avatar.jpg"
+                    "example": "123456"
                 },
-                "description": {
+                "old_phone_number": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "example": "Group description content"
+                    "example": "13800138000"
                 },
-                "name": {
+                "old_verify_code": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1,
-                    "example": "New group name"
+                    "example": "123456"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.UpdateMemberNicknameRequest": {
+        "internal_gateway_handler.CompleteOIDCLoginRequest": {
             "type": "object",
             "required": [
-                "nickname"
+                "client_version",
+                "code",
+                "device_id",
+                "device_type",
+                "state"
             ],
             "properties": {
+                "client_version": {
+                    "type": "string",
+                    "example": "1.0.0"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "wVqk8n2HbJ"
+                },
+                "device_id": {
+                    "type": "string",
+                    "example": "device-uuid-123"
+                },
+                "device_type": {
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2,
+                        3,
+                        4,
+                        5
+                    ],
+                    "example": 3
+                },
                 "nickname": {
+                    "description": "used only when a new account is created",
                     "type": "string",
-                    "maxLength": 50,
-                    "example": "Group nickname"
+                    "example": "张三"
+                },
+                "state": {
+                    "type": "string",
+                    "example": "Jx3ZbH0kq2cT9yV6fW1sNQ"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.UpdateMemberRemarkRequest": {
+        "internal_gateway_handler.ConfirmMFAEnrollmentRequest": {
             "type": "object",
+            "required": [
+                "code"
+            ],
             "properties": {
-                "remark": {
-                    "description": "Empty string clears remark",
+                "code": {
                     "type": "string",
-                    "maxLength": 20,
-                    "example": "Product Discussion Group"
+                    "example": "654321"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.UpdateMemberRoleRequest": {
+        "internal_gateway_handler.CreateQRLoginRequest": {
             "type": "object",
             "required": [
-                "role"
+                "client_version",
+                "device_id",
+                "device_type"
             ],
             "properties": {
-                "role": {
+                "client_version": {
+                    "type": "string",
+                    "example": "1.0.0"
+                },
+                "device_id": {
+                    "type": "string",
+                    "example": "web-device-uuid-123"
+                },
+                "device_type": {
+                    "type": "integer",
                     "enum": [
-                        2,
-                        3
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_anychat_server_internal_group_model.GroupRole"
-                        }
+                        3,
+                        4,
+                        5
                     ],
-                    "example": 2
+                    "example": 3
                 }
             }
         },
-        "github_com_anychat_server_internal_group_dto.UserInfo": {
+        "internal_gateway_handler.DeleteAccountRequest": {
             "type": "object",
             "properties": {
-                "avatar": {
+                "mfa_code": {
+                    "description": "required when two-factor authentication is enabled",
                     "type": "string",
-                    "example": "https://example.com/avatar.jpg"
+                    "example": "123456"
                 },
-                "bio": {
+                "password": {
+                    "description": "required when the account has a password",
                     "type": "string",
-                    "example": "Personal signature"
-                },
-                "gender": {
-                    "type": "integer",
-                    "example": 1
+                    "example": "OldPass123"
                 },
-                "nickname": {
+                "reason": {
+                    "description": "optional, at most 256 characters",
                     "type": "string",
-                    "example": "Zhang San"
+                    "maxLength": 256,
+                    "example": "no longer used"
                 },
-                "user_id": {
+                "verify_code": {
+                    "description": "purpose 8 (delete_account) code for accounts without a password",
                     "type": "string",
-                    "example": "user-123"
+                    "example": "123456"
                 }
             }
         },
-        "github_com_anychat_server_internal_group_model.GroupRole": {
-            "type": "integer",
-            "format": "int32",
-            "enum": [
-                0,
-                1,
-                2,
-                3
-            ],
-            "x-enum-varnames": [
-                "GroupRoleUnknown",
-                "GroupRoleOwner",
-                "GroupRoleAdmin",
-                "GroupRoleMember"
-            ]
-        },
-        "github_com_anychat_server_internal_group_model.JoinRequestStatus": {
-            "type": "integer",
-            "format": "int32",
-            "enum": [
-                0,
-                1,
-                2,
-                3
-            ],
-            "x-enum-varnames": [
-                "JoinRequestStatusUnknown",
-                "JoinRequestStatusPending",
-                "JoinRequestStatusAccepted",
-                "JoinRequestStatusRejected"
-            ]
-        },
-        "github_com_anychat_server_pkg_response.Response": {
+        "internal_gateway_handler.DeviceInfo": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer"
+                "client_version": {
+                    "type": "string",
+                    "example": "1.0.0"
                 },
-                "data": {},
-                "message": {
-                    "type": "string"
-                }
-            }
-        },
-        "handler.banUserRequest": {
-            "type": "object",
-            "properties": {
-                "reason": {
-                    "type": "string"
-                }
-            }
-        },
-        "handler.createAdminRequest": {
-            "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
-            "properties": {
-                "password": {
-                    "type": "string"
+                "device_id": {
+                    "type": "string",
+                    "example": "device-uuid-123"
                 },
-                "role": {
-                    "type": "integer"
+                "device_type": {
+                    "description": "1-ios 2-android 3-web 4-pc 5-h5",
+                    "type": "integer",
+                    "example": 1
                 },
-                "username": {
-                    "type": "string"
+                "is_current": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "last_active_at": {
+                    "type": "integer",
+                    "example": 1700003600
+                },
+                "last_login_at": {
+                    "type": "integer",
+                    "example": 1700000000
+                },
+                "last_login_ip": {
+                    "type": "string",
+                    "example": "203.0.113.10"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "My iPhone"
+                },
+                "signed_in": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "handler.loginRequest": {
+        "internal_gateway_handler.IdentityInfo": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
-                    "type": "string"
+                "display_name": {
+                    "type": "string",
+                    "example": "ZITADEL"
                 },
-                "username": {
-                    "type": "string"
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "last_login_at": {
+                    "type": "integer",
+                    "example": 1700003600
+                },
+                "linked_at": {
+                    "type": "integer",
+                    "example": 1700000000
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "zitadel"
                 }
             }
         },
-        "handler.updateAdminStatusRequest": {
+        "internal_gateway_handler.LinkIdentityRequest": {
             "type": "object",
             "required": [
-                "status"
+                "code",
+                "state"
             ],
             "properties": {
-                "status": {
-                    "type": "integer"
+                "code": {
+                    "type": "string",
+                    "example": "wVqk8n2HbJ"
+                },
+                "state": {
+                    "type": "string",
+                    "example": "Jx3ZbH0kq2cT9yV6fW1sNQ"
                 }
             }
         },
-        "handler.updateConfigRequest": {
+        "internal_gateway_handler.ListDevicesResponse": {
             "type": "object",
-            "required": [
-                "value"
-            ],
             "properties": {
-                "value": {
-                    "type": "string"
+                "devices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_gateway_handler.DeviceInfo"
+                    }
                 }
             }
         },
-        "internal_gateway_handler.AuthResponse": {
+        "internal_gateway_handler.ListIdentitiesResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
-                },
-                "expires_in": {
-                    "type": "integer",
-                    "example": 7200
-                },
-                "refresh_token": {
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
-                },
-                "user": {
-                    "$ref": "#/definitions/internal_gateway_handler.UserInfo"
-                },
-                "user_id": {
-                    "type": "string",
-                    "example": "user-123"
+                "identities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_gateway_handler.IdentityInfo"
+                    }
                 }
             }
         },
-        "internal_gateway_handler.BindEmailRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "verify_code"
-            ],
-            "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "user@example.com"
-                },
-                "verify_code": {
-                    "type": "string",
-                    "example": "123456"
+        "internal_gateway_handler.ListLoginHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_gateway_handler.LoginHistoryEntry"
+                    }
                 }
             }
         },
-        "internal_gateway_handler.BindPhoneRequest": {
+        "internal_gateway_handler.ListOIDCProvidersResponse": {
             "type": "object",
-            "required": [
-                "phone_number",
-                "verify_code"
-            ],
             "properties": {
-                "phone_number": {
-                    "type": "string",
-                    "example": "13800138000"
-                },
-                "verify_code": {
-                    "type": "string",
-                    "example": "123456"
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_gateway_handler.OIDCProviderInfo"
+                    }
                 }
             }
         },
-        "internal_gateway_handler.ChangeEmailRequest": {
+        "internal_gateway_handler.LoginByCodeRequest": {
             "type": "object",
             "required": [
-                "new_email",
-                "new_verify_code",
-                "old_email"
+                "client_version",
+                "device_id",
+                "device_type",
+                "target",
+                "target_type",
+                "verify_code"
             ],
             "properties": {
-                "new_email": {
+                "client_version": {
                     "type": "string",
-                    "example": "new@example.com"
+                    "example": "1.0.0"
                 },
-                "new_verify_code": {
+                "device_id": {
                     "type": "string",
-                    "example": "123456"
+                    "example": "device-uuid-123"
                 },
-                "old_email": {
+                "device_type": {
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2,
+                        3,
+                        4,
+                        5
+                    ],
+                    "example": 1
+                },
+                "nickname": {
                     "type": "string",
-                    "example": "old@example.com"
+                    "example": "张三"
                 },
-                "old_verify_code": {
+                "target": {
+                    "type": "string",
+                    "example": "13800138000"
+                },
+                "target_type": {
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2
+                    ],
+                    "example": 1
+                },
+                "verify_code": {
                     "type": "string",
                     "example": "123456"
                 }
             }
         },
-        "internal_gateway_handler.ChangePasswordRequest": {
+        "internal_gateway_handler.LoginHistoryEntry": {
             "type": "object",
-            "required": [
-                "device_id",
-                "new_password",
-                "old_password"
-            ],
             "properties": {
+                "created_at": {
+                    "type": "integer",
+                    "example": 1700000000
+                },
                 "device_id": {
                     "type": "string",
                     "example": "device-uuid-123"
                 },
-                "new_password": {
-                    "type": "string",
-                    "example": "newpass123"
+                "device_type": {
+                    "description": "1-ios 2-android 3-web 4-pc 5-h5",
+                    "type": "integer",
+                    "example": 1
                 },
-                "old_password": {
-                    "type": "string",
-                    "example": "oldpass123"
-                }
-            }
-        },
-        "internal_gateway_handler.ChangePhoneRequest": {
-            "type": "object",
-            "required": [
-                "new_phone_number",
-                "new_verify_code",
-                "old_phone_number"
-            ],
-            "properties": {
-                "new_phone_number": {
+                "id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "ip_address": {
                     "type": "string",
-                    "example": "13900139000"
+                    "example": "203.0.113.10"
                 },
-                "new_verify_code": {
+                "location": {
                     "type": "string",
-                    "example": "123456"
+                    "example": "CN Shanghai"
                 },
-                "old_phone_number": {
+                "login_method": {
+                    "description": "password, code, qr, oidc",
                     "type": "string",
-                    "example": "13800138000"
+                    "example": "password"
                 },
-                "old_verify_code": {
+                "reported": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "result": {
+                    "description": "1-success 2-wrong password 3-blocked",
+                    "type": "integer",
+                    "example": 1
+                },
+                "risk_reasons": {
+                    "description": "new_device, new_network, new_country",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unusual": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "user_agent": {
                     "type": "string",
-                    "example": "123456"
+                    "example": "AnyChat/1.0.0 (iOS 17.0)"
                 }
             }
         },
@@ -7226,6 +10728,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "13800138000"
                 },
+                "captcha_token": {
+                    "description": "required after repeated failures (error 10121)",
+                    "type": "string"
+                },
                 "client_version": {
                     "type": "string",
                     "example": "1.0.0"
@@ -7266,6 +10772,133 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_gateway_handler.MFAReauthRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "description": "TOTP or recovery code",
+                    "type": "string",
+                    "example": "654321"
+                },
+                "password": {
+                    "description": "may be empty for accounts without password",
+                    "type": "string",
+                    "example": "password123"
+                }
+            }
+        },
+        "internal_gateway_handler.MFARecoveryCodesResponse": {
+            "type": "object",
+            "properties": {
+                "recovery_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "k7m2p-x9q4r",
+                        "a3c5e-h8j2n"
+                    ]
+                }
+            }
+        },
+        "internal_gateway_handler.MFAStatusResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "remaining_recovery_codes": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "internal_gateway_handler.OIDCProviderInfo": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string",
+                    "example": "ZITADEL"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "zitadel"
+                }
+            }
+        },
+        "internal_gateway_handler.QRLoginActionRequest": {
+            "type": "object",
+            "required": [
+                "ticket"
+            ],
+            "properties": {
+                "ticket": {
+                    "type": "string",
+                    "example": "9f2c4e0b7a1d4c3e8b6a5f4e3d2c1b0a"
+                }
+            }
+        },
+        "internal_gateway_handler.QRLoginScanResponse": {
+            "type": "object",
+            "properties": {
+                "client_version": {
+                    "type": "string",
+                    "example": "1.0.0"
+                },
+                "device_type": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "expires_in": {
+                    "type": "integer",
+                    "example": 95
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "203.0.113.10"
+                }
+            }
+        },
+        "internal_gateway_handler.QRLoginStatusResponse": {
+            "type": "object",
+            "properties": {
+                "login": {
+                    "$ref": "#/definitions/internal_gateway_handler.AuthResponse"
+                },
+                "status": {
+                    "description": "1-pending 2-scanned 3-confirmed 4-cancelled 5-expired",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "internal_gateway_handler.QRLoginTicketResponse": {
+            "type": "object",
+            "properties": {
+                "expires_in": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "poll_secret": {
+                    "description": "keep private, required when polling status",
+                    "type": "string",
+                    "example": "3b9f0c1e5a7d4f2b8c6e0a9d1f3b5c7e9a2d4f6b8c0e1a3d5f7b9c2e4a6d8f0b"
+                },
+                "qr_content": {
+                    "type": "string",
+                    "example": "anychat://qr-login?ticket=9f2c4e0b7a1d4c3e8b6a5f4e3d2c1b0a"
+                },
+                "ticket": {
+                    "type": "string",
+                    "example": "9f2c4e0b7a1d4c3e8b6a5f4e3d2c1b0a"
+                }
+            }
+        },
         "internal_gateway_handler.RefreshTokenRequest": {
             "type": "object",
             "required": [
@@ -7329,6 +10962,31 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_gateway_handler.RenameDeviceRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "My iPhone"
+                }
+            }
+        },
+        "internal_gateway_handler.ReportUnusualLoginRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "Jk3xQ9..."
+                }
+            }
+        },
         "internal_gateway_handler.ResetPasswordRequest": {
             "type": "object",
             "required": [
@@ -7372,7 +11030,8 @@ const docTemplate = `{
                         4,
                         5,
                         6,
-                        7
+                        7,
+                        8
                     ],
                     "example": 1
                 },
@@ -7403,12 +11062,26 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_gateway_handler.SetAvatarRequest": {
+            "type": "object",
+            "required": [
+                "file_id"
+            ],
+            "properties": {
+                "file_id": {
+                    "description": "image uploaded through the file API",
+                    "type": "string",
+                    "example": "file-123"
+                }
+            }
+        },
         "internal_gateway_handler.UpdateProfileRequest": {
             "type": "object",
             "properties": {
                 "avatar": {
+                    "description": "only \"\" to clear it, use PUT /users/me/avatar to set one",
                     "type": "string",
-                    "example": "https://example.com/avatar.jpg"
+                    "example": ""
                 },
                 "birthday": {
                     "type": "string",
@@ -7651,6 +11324,24 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_gateway_handler.VerifyLoginMFARequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "mfa_token"
+            ],
+            "properties": {
+                "code": {
+                    "description": "TOTP or recovery code",
+                    "type": "string",
+                    "example": "654321"
+                },
+                "mfa_token": {
+                    "type": "string",
+                    "example": "3f9a0c1e2b4d5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e"
+                }
+            }
+        },
         "internal_gateway_handler.ackReadTriggersRequest": {
             "type": "object",
             "required": [
@@ -3,8 +3,10 @@ package client
 import (
 	"fmt"
 
+	authpb "github.com/anychat/server/api/proto/auth"
 	filepb "github.com/anychat/server/api/proto/file"
 	grouppb "github.com/anychat/server/api/proto/group"
+	pushpb "github.com/anychat/server/api/proto/push"
 	userpb "github.com/anychat/server/api/proto/user"
 	"github.com/anychat/server/pkg/logger"
 	"go.uber.org/zap"
@@ -14,24 +16,36 @@ import (
 
 // Manager downstream gRPC client manager
 type Manager struct {
+	authConn    *grpc.ClientConn
 	userConn    *grpc.ClientConn
 	groupConn   *grpc.ClientConn
 	fileConn    *grpc.ClientConn
+	pushConn    *grpc.ClientConn
+	AuthClient  authpb.AuthServiceClient
 	UserClient  userpb.UserServiceClient
 	GroupClient grouppb.GroupServiceClient
 	FileClient  filepb.FileServiceClient
+	PushClient  pushpb.PushServiceClient
 }
 
 // NewManager creates client manager
-func NewManager(userAddr, groupAddr, fileAddr string) (*Manager, error) {
+func NewManager(authAddr, userAddr, groupAddr, fileAddr, pushAddr string) (*Manager, error) {
+	authConn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to auth-service: %w", err)
+	}
+	logger.Info("Admin: connected to auth-service", zap.String("addr", authAddr))
+
 	userConn, err := grpc.NewClient(userAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
+		authConn.Close()
 		return nil, fmt.Errorf("failed to connect to user-service: %w", err)
 	}
 	logger.Info("Admin: connected to user-service", zap.String("addr", userAddr))
 
 	groupConn, err := grpc.NewClient(groupAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
+		authConn.Close()
 		userConn.Close()
 		return nil, fmt.Errorf("failed to connect to group-service: %w", err)
 	}
@@ -39,24 +53,42 @@ func NewManager(userAddr, groupAddr, fileAddr string) (*Manager, error) {
 
 	fileConn, err := grpc.NewClient(fileAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
+		authConn.Close()
 		userConn.Close()
 		groupConn.Close()
 		return nil, fmt.Errorf("failed to connect to file-service: %w", err)
 	}
 	logger.Info("Admin: connected to file-service", zap.String("addr", fileAddr))
 
+	pushConn, err := grpc.NewClient(pushAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		authConn.Close()
+		userConn.Close()
+		groupConn.Close()
+		fileConn.Close()
+		return nil, fmt.Errorf("failed to connect to push-service: %w", err)
+	}
+	logger.Info("Admin: connected to push-service", zap.String("addr", pushAddr))
+
 	return &Manager{
+		authConn:    authConn,
 		userConn:    userConn,
 		groupConn:   groupConn,
 		fileConn:    fileConn,
+		pushConn:    pushConn,
+		AuthClient:  authpb.NewAuthServiceClient(authConn),
 		UserClient:  userpb.NewUserServiceClient(userConn),
 		GroupClient: grouppb.NewGroupServiceClient(groupConn),
 		FileClient:  filepb.NewFileServiceClient(fileConn),
+		PushClient:  pushpb.NewPushServiceClient(pushConn),
 	}, nil
 }
 
 // Close closes all connections
 func (m *Manager) Close() {
+	if m.authConn != nil {
+		m.authConn.Close()
+	}
 	if m.userConn != nil {
 		m.userConn.Close()
 	}
@@ -66,4 +98,7 @@ func (m *Manager) Close() {
 	if m.fileConn != nil {
 		m.fileConn.Close()
 	}
+	if m.pushConn != nil {
+		m.pushConn.Close()
+	}
 }
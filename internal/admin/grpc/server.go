@@ -44,3 +44,13 @@ func (s *Server) UnbanUser(ctx context.Context, req *adminpb.UnbanUserRequest) (
 	}
 	return &commonpb.Empty{}, nil
 }
+
+func (s *Server) UnlockUser(ctx context.Context, req *adminpb.UnlockUserRequest) (*commonpb.Empty, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := s.svc.UnlockUser(ctx, "", req.UserId); err != nil {
+		return nil, err
+	}
+	return &commonpb.Empty{}, nil
+}
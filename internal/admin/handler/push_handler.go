@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"strconv"
+
+	pushpb "github.com/anychat/server/api/proto/push"
+	"github.com/anychat/server/internal/admin/service"
+	"github.com/anychat/server/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminPushHandler push analytics handler
+type AdminPushHandler struct {
+	svc service.AdminService
+}
+
+func NewAdminPushHandler(svc service.AdminService) *AdminPushHandler {
+	return &AdminPushHandler{svc: svc}
+}
+
+// ListPushLogs query push logs
+// @Summary      query push logs
+// @Description  answer "why didn't user X get notified", newest first
+// @Tags         admin-push
+// @Security     BearerAuth
+// @Produce      json
+// @Param        userId     query  string  false  "user ID filter"
+// @Param        pushType   query  int     false  "push type filter"
+// @Param        status     query  int     false  "status filter: 1-pending 2-sent 3-failed 4-skipped"
+// @Param        startTime  query  int     false  "start time (unix seconds)"
+// @Param        endTime    query  int     false  "end time (unix seconds)"
+// @Param        page       query  int     false  "page number"
+// @Param        pageSize   query  int     false  "page size"
+// @Success      200  {object}  response.Response{data=object}  "success"
+// @Router       /admin/push/logs [get]
+func (h *AdminPushHandler) ListPushLogs(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	req := &pushpb.ListPushLogsRequest{
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}
+	if userID := c.Query("userId"); userID != "" {
+		req.UserId = &userID
+	}
+	if v, ok := queryInt64(c, "pushType"); ok {
+		pushType := pushpb.PushType(v)
+		req.PushType = &pushType
+	}
+	if v, ok := queryInt64(c, "status"); ok {
+		status := pushpb.PushStatus(v)
+		req.Status = &status
+	}
+	if v, ok := queryInt64(c, "startTime"); ok {
+		req.StartTime = &v
+	}
+	if v, ok := queryInt64(c, "endTime"); ok {
+		req.EndTime = &v
+	}
+
+	resp, err := h.svc.ListPushLogs(c.Request.Context(), req)
+	if err != nil {
+		response.Error(c, 500, err.Error())
+		return
+	}
+	response.Success(c, gin.H{"logs": resp.Logs, "total": resp.Total, "page": resp.Page, "pageSize": resp.PageSize})
+}
+
+// GetPushStats push statistics
+// @Summary      push statistics
+// @Description  per-type success/failure aggregates by day and provider error breakdown, defaults to the last 7 days
+// @Tags         admin-push
+// @Security     BearerAuth
+// @Produce      json
+// @Param        userId     query  string  false  "user ID filter"
+// @Param        pushType   query  int     false  "push type filter"
+// @Param        startTime  query  int     false  "start time (unix seconds)"
+// @Param        endTime    query  int     false  "end time (unix seconds), range at most 90 days"
+// @Success      200  {object}  response.Response{data=object}  "success"
+// @Router       /admin/push/stats [get]
+func (h *AdminPushHandler) GetPushStats(c *gin.Context) {
+	req := &pushpb.GetPushStatsRequest{}
+	if userID := c.Query("userId"); userID != "" {
+		req.UserId = &userID
+	}
+	if v, ok := queryInt64(c, "pushType"); ok {
+		pushType := pushpb.PushType(v)
+		req.PushType = &pushType
+	}
+	if v, ok := queryInt64(c, "startTime"); ok {
+		req.StartTime = &v
+	}
+	if v, ok := queryInt64(c, "endTime"); ok {
+		req.EndTime = &v
+	}
+
+	resp, err := h.svc.GetPushStats(c.Request.Context(), req)
+	if err != nil {
+		response.Error(c, 500, err.Error())
+		return
+	}
+	response.Success(c, resp)
+}
+
+// queryInt64 parses optional integer query parameter
+func queryInt64(c *gin.Context, key string) (int64, bool) {
+	raw := c.Query(key)
+	if raw == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
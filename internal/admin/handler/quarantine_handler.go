@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/anychat/server/internal/admin/service"
+	"github.com/anychat/server/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminQuarantineHandler review of uploads held back by the malware scan
+type AdminQuarantineHandler struct {
+	svc service.AdminService
+}
+
+func NewAdminQuarantineHandler(svc service.AdminService) *AdminQuarantineHandler {
+	return &AdminQuarantineHandler{svc: svc}
+}
+
+type reviewQuarantinedFileRequest struct {
+	Note string `json:"note"` // reason recorded with the verdict
+}
+
+// ListQuarantinedFiles list quarantined files
+// @Summary      list quarantined files
+// @Description  uploads the malware scan flagged as infected or could not scan, newest first
+// @Tags         admin-file-quarantine
+// @Security     BearerAuth
+// @Produce      json
+// @Param        userId    query  string  false  "uploader ID"
+// @Param        page      query  int     false  "page number"
+// @Param        pageSize  query  int     false  "page size"
+// @Success      200  {object}  response.Response{data=object}  "success"
+// @Router       /admin/files/quarantine [get]
+func (h *AdminQuarantineHandler) ListQuarantinedFiles(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	resp, err := h.svc.ListQuarantinedFiles(c.Request.Context(), c.Query("userId"), page, pageSize)
+	if err != nil {
+		writeStorageError(c, err)
+		return
+	}
+	response.Success(c, gin.H{"files": resp.Files, "total": resp.Total, "page": page, "pageSize": pageSize})
+}
+
+// GetQuarantinedFile get quarantined file
+// @Summary      get quarantined file
+// @Description  scan state of a file with every scanner verdict and review decision
+// @Tags         admin-file-quarantine
+// @Security     BearerAuth
+// @Produce      json
+// @Param        fileId  path  string  true  "file ID"
+// @Success      200  {object}  response.Response{data=object}  "success"
+// @Router       /admin/files/quarantine/{fileId} [get]
+func (h *AdminQuarantineHandler) GetQuarantinedFile(c *gin.Context) {
+	file, err := h.svc.GetQuarantinedFile(c.Request.Context(), c.Param("fileId"))
+	if err != nil {
+		writeStorageError(c, err)
+		return
+	}
+	response.Success(c, file)
+}
+
+// ReleaseQuarantinedFile release quarantined file
+// @Summary      release quarantined file
+// @Description  treat the verdict as a false positive: the file is moved back and activated like a clean upload
+// @Tags         admin-file-quarantine
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        fileId   path  string                        true   "file ID"
+// @Param        request  body  reviewQuarantinedFileRequest  false  "review note"
+// @Success      200  {object}  response.Response{data=object}  "success"
+// @Router       /admin/files/quarantine/{fileId}/release [post]
+func (h *AdminQuarantineHandler) ReleaseQuarantinedFile(c *gin.Context) {
+	var req reviewQuarantinedFileRequest
+	_ = c.ShouldBindJSON(&req)
+
+	file, err := h.svc.ReleaseQuarantinedFile(c.Request.Context(), getAdminID(c), c.Param("fileId"), req.Note)
+	if err != nil {
+		writeStorageError(c, err)
+		return
+	}
+	response.Success(c, file)
+}
+
+// DeleteQuarantinedFile delete quarantined file
+// @Summary      delete quarantined file
+// @Description  confirm the verdict: the file record and its object in the quarantine bucket are deleted
+// @Tags         admin-file-quarantine
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        fileId   path  string                        true   "file ID"
+// @Param        request  body  reviewQuarantinedFileRequest  false  "review note"
+// @Success      200  {object}  response.Response{data=object}  "success"
+// @Router       /admin/files/quarantine/{fileId} [delete]
+func (h *AdminQuarantineHandler) DeleteQuarantinedFile(c *gin.Context) {
+	var req reviewQuarantinedFileRequest
+	_ = c.ShouldBindJSON(&req)
+
+	file, err := h.svc.DeleteQuarantinedFile(c.Request.Context(), getAdminID(c), c.Param("fileId"), req.Note)
+	if err != nil {
+		writeStorageError(c, err)
+		return
+	}
+	response.Success(c, file)
+}
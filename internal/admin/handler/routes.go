@@ -23,6 +23,9 @@ func RegisterRoutes(r *gin.Engine, svc service.AdminService, jwtManager *jwt.Man
 	configHandler := NewAdminConfigHandler(svc)
 	adminMgmtHandler := NewAdminManageHandler(svc)
 	logHandler := NewLogHandler(svc)
+	pushHandler := NewAdminPushHandler(svc)
+	storageHandler := NewAdminStorageHandler(svc)
+	quarantineHandler := NewAdminQuarantineHandler(svc)
 
 	api := r.Group("/api/admin")
 	{
@@ -42,6 +45,7 @@ func RegisterRoutes(r *gin.Engine, svc service.AdminService, jwtManager *jwt.Man
 				users.GET("/:userId", userHandler.GetUser)
 				users.POST("/:userId/ban", userHandler.BanUser)
 				users.POST("/:userId/unban", userHandler.UnbanUser)
+				users.POST("/:userId/unlock", userHandler.UnlockUser)
 			}
 
 			// Group management
@@ -81,6 +85,31 @@ func RegisterRoutes(r *gin.Engine, svc service.AdminService, jwtManager *jwt.Man
 				logs.GET("", logHandler.ListLogs)
 				logs.GET("/:logId/download", logHandler.DownloadLog)
 			}
+
+			// Push analytics
+			push := auth.Group("/push")
+			{
+				push.GET("/logs", pushHandler.ListPushLogs)
+				push.GET("/stats", pushHandler.GetPushStats)
+			}
+
+			// Storage quotas
+			storage := auth.Group("/storage")
+			{
+				storage.GET("/users/:userId", storageHandler.GetUserStorage)
+				storage.PUT("/users/:userId", storageHandler.SetUserQuota)
+				storage.GET("/groups/:groupId", storageHandler.GetGroupStorage)
+				storage.PUT("/groups/:groupId", storageHandler.SetGroupQuota)
+			}
+
+			// Quarantined uploads
+			quarantine := auth.Group("/files/quarantine")
+			{
+				quarantine.GET("", quarantineHandler.ListQuarantinedFiles)
+				quarantine.GET("/:fileId", quarantineHandler.GetQuarantinedFile)
+				quarantine.POST("/:fileId/release", quarantineHandler.ReleaseQuarantinedFile)
+				quarantine.DELETE("/:fileId", quarantineHandler.DeleteQuarantinedFile)
+			}
 		}
 	}
 
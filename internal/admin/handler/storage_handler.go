@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"net/http"
+
+	filepb "github.com/anychat/server/api/proto/file"
+	"github.com/anychat/server/internal/admin/service"
+	"github.com/anychat/server/pkg/response"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminStorageHandler storage quota handler
+type AdminStorageHandler struct {
+	svc service.AdminService
+}
+
+func NewAdminStorageHandler(svc service.AdminService) *AdminStorageHandler {
+	return &AdminStorageHandler{svc: svc}
+}
+
+type setStorageQuotaRequest struct {
+	Tier       *string `json:"tier"`        // users only, empty resets to the default tier
+	QuotaBytes *int64  `json:"quota_bytes"` // override, 0 means unlimited
+	ClearQuota bool    `json:"clear_quota"` // drop the override
+}
+
+// GetUserStorage get user storage usage
+// @Summary      get user storage usage
+// @Description  used bytes, file count, tier and effective quota of a user
+// @Tags         admin-storage
+// @Security     BearerAuth
+// @Produce      json
+// @Param        userId  path  string  true  "user ID"
+// @Success      200  {object}  response.Response{data=object}  "success"
+// @Router       /admin/storage/users/{userId} [get]
+func (h *AdminStorageHandler) GetUserStorage(c *gin.Context) {
+	h.getStorage(c, filepb.StorageOwnerType_STORAGE_OWNER_TYPE_USER, c.Param("userId"))
+}
+
+// SetUserQuota set user storage quota
+// @Summary      set user storage quota
+// @Description  change the tier of a user or override its quota
+// @Tags         admin-storage
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        userId   path  string                  true  "user ID"
+// @Param        request  body  setStorageQuotaRequest  true  "quota"
+// @Success      200  {object}  response.Response{data=object}  "success"
+// @Router       /admin/storage/users/{userId} [put]
+func (h *AdminStorageHandler) SetUserQuota(c *gin.Context) {
+	h.setQuota(c, filepb.StorageOwnerType_STORAGE_OWNER_TYPE_USER, c.Param("userId"))
+}
+
+// GetGroupStorage get group storage usage
+// @Summary      get group storage usage
+// @Description  used bytes, file count and effective quota of the group's shared files
+// @Tags         admin-storage
+// @Security     BearerAuth
+// @Produce      json
+// @Param        groupId  path  string  true  "group ID"
+// @Success      200  {object}  response.Response{data=object}  "success"
+// @Router       /admin/storage/groups/{groupId} [get]
+func (h *AdminStorageHandler) GetGroupStorage(c *gin.Context) {
+	h.getStorage(c, filepb.StorageOwnerType_STORAGE_OWNER_TYPE_GROUP, c.Param("groupId"))
+}
+
+// SetGroupQuota set group storage quota
+// @Summary      set group storage quota
+// @Description  override the quota of the group's shared files, tier is not accepted
+// @Tags         admin-storage
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        groupId  path  string                  true  "group ID"
+// @Param        request  body  setStorageQuotaRequest  true  "quota"
+// @Success      200  {object}  response.Response{data=object}  "success"
+// @Router       /admin/storage/groups/{groupId} [put]
+func (h *AdminStorageHandler) SetGroupQuota(c *gin.Context) {
+	h.setQuota(c, filepb.StorageOwnerType_STORAGE_OWNER_TYPE_GROUP, c.Param("groupId"))
+}
+
+func (h *AdminStorageHandler) getStorage(c *gin.Context, ownerType filepb.StorageOwnerType, ownerID string) {
+	usage, err := h.svc.GetStorageUsage(c.Request.Context(), ownerType, ownerID)
+	if err != nil {
+		writeStorageError(c, err)
+		return
+	}
+	response.Success(c, usage)
+}
+
+func (h *AdminStorageHandler) setQuota(c *gin.Context, ownerType filepb.StorageOwnerType, ownerID string) {
+	var req setStorageQuotaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Tier == nil && req.QuotaBytes == nil && !req.ClearQuota {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "tier, quota_bytes or clear_quota is required"})
+		return
+	}
+
+	usage, err := h.svc.SetStorageQuota(c.Request.Context(), getAdminID(c), &filepb.SetStorageQuotaRequest{
+		OwnerType:  ownerType,
+		OwnerId:    ownerID,
+		Tier:       req.Tier,
+		QuotaBytes: req.QuotaBytes,
+		ClearQuota: req.ClearQuota,
+	})
+	if err != nil {
+		writeStorageError(c, err)
+		return
+	}
+	response.Success(c, usage)
+}
+
+func writeStorageError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": status.Convert(err).Message()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
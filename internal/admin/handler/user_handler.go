@@ -7,6 +7,8 @@ import (
 	"github.com/anychat/server/internal/admin/service"
 	"github.com/anychat/server/pkg/response"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // AdminUserMgmtHandler admin user management handler
@@ -105,3 +107,27 @@ func (h *AdminUserMgmtHandler) UnbanUser(c *gin.Context) {
 	}
 	response.Success(c, nil)
 }
+
+// UnlockUser lift login lockout
+// @Summary      lift login lockout
+// @Description  clear the temporary lockout and failure counters after repeated failed password logins
+// @Tags         admin-user-management
+// @Security     BearerAuth
+// @Produce      json
+// @Param        userId  path  string  true  "user ID"
+// @Success      200  {object}  response.Response  "success"
+// @Router       /admin/users/{userId}/unlock [post]
+func (h *AdminUserMgmtHandler) UnlockUser(c *gin.Context) {
+	adminID := getAdminID(c)
+	userID := c.Param("userId")
+
+	if err := h.svc.UnlockUser(c.Request.Context(), adminID, userID); err != nil {
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	response.Success(c, nil)
+}
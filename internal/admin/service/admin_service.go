@@ -7,13 +7,14 @@ import (
 	"time"
 
 	adminpb "github.com/anychat/server/api/proto/admin"
+	authpb "github.com/anychat/server/api/proto/auth"
 	filepb "github.com/anychat/server/api/proto/file"
 	grouppb "github.com/anychat/server/api/proto/group"
+	pushpb "github.com/anychat/server/api/proto/push"
 	userpb "github.com/anychat/server/api/proto/user"
 	"github.com/anychat/server/internal/admin/model"
 	"github.com/anychat/server/internal/admin/repository"
 	"github.com/anychat/server/pkg/crypto"
-	"github.com/anychat/server/pkg/jwt"
 	"github.com/anychat/server/pkg/logger"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -35,6 +36,7 @@ type AdminService interface {
 	GetUser(ctx context.Context, userID string) (*userpb.UserInfoResponse, error)
 	BanUser(ctx context.Context, adminID, userID, reason string) error
 	UnbanUser(ctx context.Context, adminID, userID string) error
+	UnlockUser(ctx context.Context, adminID, userID string) error
 
 	// Group management (via gRPC)
 	GetGroup(ctx context.Context, groupID string) (*grouppb.GetGroupInfoResponse, error)
@@ -53,36 +55,53 @@ type AdminService interface {
 	// Client logs
 	ListLogFiles(ctx context.Context, userID string, page, pageSize int) ([]*filepb.FileInfo, int64, error)
 	GetLogDownloadURL(ctx context.Context, fileID string, expiresMinutes int32) (string, int64, error)
+
+	// Storage quotas (via gRPC)
+	GetStorageUsage(ctx context.Context, ownerType filepb.StorageOwnerType, ownerID string) (*filepb.StorageUsage, error)
+	SetStorageQuota(ctx context.Context, adminID string, req *filepb.SetStorageQuotaRequest) (*filepb.StorageUsage, error)
+
+	// Quarantined uploads (via gRPC)
+	ListQuarantinedFiles(ctx context.Context, userID string, page, pageSize int) (*filepb.ListQuarantinedFilesResponse, error)
+	GetQuarantinedFile(ctx context.Context, fileID string) (*filepb.QuarantinedFile, error)
+	ReleaseQuarantinedFile(ctx context.Context, adminID, fileID, note string) (*filepb.QuarantinedFile, error)
+	DeleteQuarantinedFile(ctx context.Context, adminID, fileID, note string) (*filepb.QuarantinedFile, error)
+
+	// Push analytics (via gRPC)
+	ListPushLogs(ctx context.Context, req *pushpb.ListPushLogsRequest) (*pushpb.ListPushLogsResponse, error)
+	GetPushStats(ctx context.Context, req *pushpb.GetPushStatsRequest) (*pushpb.GetPushStatsResponse, error)
 }
 
 type adminServiceImpl struct {
-	jwtManager  *jwt.Manager
 	adminRepo   repository.AdminUserRepository
 	auditRepo   repository.AuditLogRepository
 	configRepo  repository.SystemConfigRepository
+	authClient  authpb.AuthServiceClient
 	userClient  userpb.UserServiceClient
 	groupClient grouppb.GroupServiceClient
 	fileClient  filepb.FileServiceClient
+	pushClient  pushpb.PushServiceClient
 }
 
 // NewAdminService creates admin service
 func NewAdminService(
-	jwtManager *jwt.Manager,
 	adminRepo repository.AdminUserRepository,
 	auditRepo repository.AuditLogRepository,
 	configRepo repository.SystemConfigRepository,
+	authClient authpb.AuthServiceClient,
 	userClient userpb.UserServiceClient,
 	groupClient grouppb.GroupServiceClient,
 	fileClient filepb.FileServiceClient,
+	pushClient pushpb.PushServiceClient,
 ) AdminService {
 	return &adminServiceImpl{
-		jwtManager:  jwtManager,
 		adminRepo:   adminRepo,
 		auditRepo:   auditRepo,
 		configRepo:  configRepo,
+		authClient:  authClient,
 		userClient:  userClient,
 		groupClient: groupClient,
 		fileClient:  fileClient,
+		pushClient:  pushClient,
 	}
 }
 
@@ -97,10 +116,15 @@ func (s *adminServiceImpl) Login(ctx context.Context, username, password, ip str
 	if !crypto.CheckPassword(password, admin.PasswordHash) {
 		return "", nil, fmt.Errorf("invalid credentials")
 	}
-	token, err := s.jwtManager.GenerateAccessToken(admin.ID, "", int16(admin.Role))
+	// signed by auth-service, admin-service only verifies tokens
+	tokenResp, err := s.authClient.IssueAdminToken(ctx, &authpb.IssueAdminTokenRequest{
+		AdminId: admin.ID,
+		Role:    int32(admin.Role),
+	})
 	if err != nil {
 		return "", nil, fmt.Errorf("generate token failed: %w", err)
 	}
+	token := tokenResp.AccessToken
 	_ = s.adminRepo.UpdateLastLogin(admin.ID)
 	s.writeAuditLog(admin.ID, "auth.login", "admin_user", admin.ID, ip, nil)
 	return token, admin, nil
@@ -193,6 +217,15 @@ func (s *adminServiceImpl) UnbanUser(_ context.Context, adminID, userID string)
 	return nil
 }
 
+func (s *adminServiceImpl) UnlockUser(ctx context.Context, adminID, userID string) error {
+	if _, err := s.authClient.UnlockAccount(ctx, &authpb.UnlockAccountRequest{UserId: userID}); err != nil {
+		return err
+	}
+	s.writeAuditLog(adminID, "user.unlock", "user", userID, "", nil)
+	logger.Info("Admin unlocked user login", zap.String("adminId", adminID), zap.String("userId", userID))
+	return nil
+}
+
 func (s *adminServiceImpl) GetGroup(ctx context.Context, groupID string) (*grouppb.GetGroupInfoResponse, error) {
 	return s.groupClient.GetGroupInfo(ctx, &grouppb.GetGroupInfoRequest{GroupId: groupID})
 }
@@ -275,6 +308,108 @@ func (s *adminServiceImpl) GetLogDownloadURL(ctx context.Context, fileID string,
 	return resp.DownloadUrl, resp.ExpiresIn, nil
 }
 
+func (s *adminServiceImpl) GetStorageUsage(ctx context.Context, ownerType filepb.StorageOwnerType, ownerID string) (*filepb.StorageUsage, error) {
+	return s.fileClient.GetOwnerStorageUsage(ctx, &filepb.GetOwnerStorageUsageRequest{
+		OwnerType: ownerType,
+		OwnerId:   ownerID,
+	})
+}
+
+func (s *adminServiceImpl) SetStorageQuota(ctx context.Context, adminID string, req *filepb.SetStorageQuotaRequest) (*filepb.StorageUsage, error) {
+	usage, err := s.fileClient.SetStorageQuota(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]string{"tier": usage.Tier, "quotaBytes": fmt.Sprintf("%d", usage.QuotaBytes)}
+	if req.ClearQuota {
+		details["clearQuota"] = "true"
+	}
+	resourceType := "user"
+	if req.OwnerType == filepb.StorageOwnerType_STORAGE_OWNER_TYPE_GROUP {
+		resourceType = "group"
+	}
+	s.writeAuditLog(adminID, "storage.quota.update", resourceType, req.OwnerId, "", details)
+	logger.Info("Admin updated storage quota",
+		zap.String("adminId", adminID),
+		zap.String("ownerType", resourceType),
+		zap.String("ownerId", req.OwnerId))
+	return usage, nil
+}
+
+func (s *adminServiceImpl) ListQuarantinedFiles(ctx context.Context, userID string, page, pageSize int) (*filepb.ListQuarantinedFilesResponse, error) {
+	req := &filepb.ListQuarantinedFilesRequest{Page: int32(page), PageSize: int32(pageSize)}
+	if userID != "" {
+		req.UserId = &userID
+	}
+	return s.fileClient.ListQuarantinedFiles(ctx, req)
+}
+
+func (s *adminServiceImpl) GetQuarantinedFile(ctx context.Context, fileID string) (*filepb.QuarantinedFile, error) {
+	return s.fileClient.GetQuarantinedFile(ctx, &filepb.GetQuarantinedFileRequest{FileId: fileID})
+}
+
+func (s *adminServiceImpl) ReleaseQuarantinedFile(ctx context.Context, adminID, fileID, note string) (*filepb.QuarantinedFile, error) {
+	file, err := s.fileClient.ReleaseQuarantinedFile(ctx, &filepb.ReviewQuarantinedFileRequest{
+		FileId:  fileID,
+		AdminId: adminID,
+		Note:    note,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.writeAuditLog(adminID, "file.quarantine.release", "file", fileID, "", quarantineAuditDetails(file, note))
+	logger.Info("Admin released quarantined file",
+		zap.String("adminId", adminID),
+		zap.String("fileId", fileID))
+	return file, nil
+}
+
+func (s *adminServiceImpl) DeleteQuarantinedFile(ctx context.Context, adminID, fileID, note string) (*filepb.QuarantinedFile, error) {
+	file, err := s.fileClient.DeleteQuarantinedFile(ctx, &filepb.ReviewQuarantinedFileRequest{
+		FileId:  fileID,
+		AdminId: adminID,
+		Note:    note,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.writeAuditLog(adminID, "file.quarantine.delete", "file", fileID, "", quarantineAuditDetails(file, note))
+	logger.Info("Admin deleted quarantined file",
+		zap.String("adminId", adminID),
+		zap.String("fileId", fileID))
+	return file, nil
+}
+
+// quarantineAuditDetails what the reviewed file was flagged for, kept with the decision
+func quarantineAuditDetails(file *filepb.QuarantinedFile, note string) map[string]string {
+	details := map[string]string{"signature": file.Signature, "scanner": file.Scanner}
+	if file.File != nil {
+		details["uploader"] = file.File.UserId
+		details["fileName"] = file.File.FileName
+	}
+	if note != "" {
+		details["note"] = note
+	}
+	return details
+}
+
+func (s *adminServiceImpl) ListPushLogs(ctx context.Context, req *pushpb.ListPushLogsRequest) (*pushpb.ListPushLogsResponse, error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	return s.pushClient.ListPushLogs(ctx, req)
+}
+
+func (s *adminServiceImpl) GetPushStats(ctx context.Context, req *pushpb.GetPushStatsRequest) (*pushpb.GetPushStatsResponse, error) {
+	return s.pushClient.GetPushStats(ctx, req)
+}
+
 func (s *adminServiceImpl) writeAuditLog(adminID, action, resourceType, resourceID, ip string, details interface{}) {
 	log := &model.AuditLog{
 		AdminID:      adminID,
@@ -0,0 +1,27 @@
+package captcha
+
+import (
+	"context"
+	"crypto/subtle"
+)
+
+// Verifier verifies a CAPTCHA token solved by the client.
+// Implementations wrap a provider such as reCAPTCHA, hCaptcha or Turnstile
+type Verifier interface {
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+// FakeVerifier accepts a single fixed token, for development and API tests
+type FakeVerifier struct {
+	token string
+}
+
+// NewFakeVerifier creates fake verifier accepting token
+func NewFakeVerifier(token string) *FakeVerifier {
+	return &FakeVerifier{token: token}
+}
+
+// Verify reports whether token matches the configured token
+func (v *FakeVerifier) Verify(_ context.Context, token, _ string) (bool, error) {
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(v.token)) == 1, nil
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	commonpb "github.com/anychat/server/api/proto/common"
+	conversationpb "github.com/anychat/server/api/proto/conversation"
+	filepb "github.com/anychat/server/api/proto/file"
+	friendpb "github.com/anychat/server/api/proto/friend"
+	grouppb "github.com/anychat/server/api/proto/group"
+	messagepb "github.com/anychat/server/api/proto/message"
+	userpb "github.com/anychat/server/api/proto/user"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// userDataEraser the EraseUserData method every service client shares
+type userDataEraser interface {
+	EraseUserData(ctx context.Context, in *commonpb.EraseUserDataRequest, opts ...grpc.CallOption) (*commonpb.EraseUserDataResponse, error)
+}
+
+// ErasureClient erases the data of a deleted account kept by one service
+type ErasureClient struct {
+	service string
+	conn    *grpc.ClientConn
+	client  userDataEraser
+}
+
+// NewErasureClient creates erasure client, service is one of user, friend, group, conversation, message, file
+func NewErasureClient(service, addr string) (*ErasureClient, error) {
+	conn, err := grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s service: %w", service, err)
+	}
+
+	var client userDataEraser
+	switch service {
+	case "user":
+		client = userpb.NewUserServiceClient(conn)
+	case "friend":
+		client = friendpb.NewFriendServiceClient(conn)
+	case "group":
+		client = grouppb.NewGroupServiceClient(conn)
+	case "conversation":
+		client = conversationpb.NewConversationServiceClient(conn)
+	case "message":
+		client = messagepb.NewMessageServiceClient(conn)
+	case "file":
+		client = filepb.NewFileServiceClient(conn)
+	default:
+		conn.Close()
+		return nil, fmt.Errorf("unknown erasure service: %s", service)
+	}
+
+	logger.Info("Connected to "+service+"-service", zap.String("addr", addr))
+
+	return &ErasureClient{service: service, conn: conn, client: client}, nil
+}
+
+// Service returns the service name
+func (c *ErasureClient) Service() string {
+	return c.service
+}
+
+// EraseUserData erases the user's data, returns erased row counts by kind
+func (c *ErasureClient) EraseUserData(ctx context.Context, userID string) (map[string]int64, error) {
+	resp, err := c.client.EraseUserData(ctx, &commonpb.EraseUserDataRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to erase %s data: %w", c.service, err)
+	}
+	return resp.Erased, nil
+}
+
+// Close closes connection
+func (c *ErasureClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
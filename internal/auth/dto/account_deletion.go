@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+)
+
+// DeleteAccountRequest delete account request, the user proves identity again before deletion is scheduled
+type DeleteAccountRequest struct {
+	Password   string `json:"password"`    // required when the account has a password
+	VerifyCode string `json:"verify_code"` // delete_account code for accounts without a password
+	MFACode    string `json:"mfa_code"`    // required when MFA is enabled
+	Reason     string `json:"reason" binding:"max=256"`
+}
+
+// AccountDeletionInfo account deletion request and its progress
+type AccountDeletionInfo struct {
+	ID             int64                       `json:"id"`
+	Status         model.AccountDeletionStatus `json:"status"` // 1-pending 2-erasing 3-completed 4-cancelled
+	RequestedAt    time.Time                   `json:"requested_at"`
+	EraseAfter     time.Time                   `json:"erase_after"`
+	CancelledAt    *time.Time                  `json:"cancelled_at,omitempty"`
+	CompletedAt    *time.Time                  `json:"completed_at,omitempty"`
+	CompletedSteps []string                    `json:"completed_steps"`
+}
@@ -45,6 +45,21 @@ type LoginRequest struct {
 	DeviceID      string           `json:"device_id" binding:"required"`
 	ClientVersion string           `json:"client_version" binding:"required"`
 	IpAddress     string           `json:"ip_address"`
+	UserAgent     string           `json:"user_agent"`
+	CaptchaToken  string           `json:"captcha_token"` // required once failures cross the CAPTCHA threshold
+}
+
+// LoginByCodeRequest verification code login request
+type LoginByCodeRequest struct {
+	Target        string                       `json:"target" binding:"required"`
+	TargetType    model.VerificationTargetType `json:"target_type" binding:"required"`
+	VerifyCode    string                       `json:"verify_code" binding:"required"`
+	DeviceType    model.DeviceType             `json:"device_type" binding:"required"`
+	DeviceID      string                       `json:"device_id" binding:"required"`
+	ClientVersion string                       `json:"client_version" binding:"required"`
+	IpAddress     string                       `json:"ip_address"`
+	UserAgent     string                       `json:"user_agent"`
+	Nickname      string                       `json:"nickname"`
 }
 
 // LoginResponse login response
@@ -54,6 +69,9 @@ type LoginResponse struct {
 	RefreshToken string    `json:"refresh_token"`
 	ExpiresIn    int64     `json:"expires_in"` // seconds
 	User         *UserInfo `json:"user"`
+	// MFARequired is set instead of tokens when the account has two-factor authentication enabled
+	MFARequired bool   `json:"mfa_required,omitempty"`
+	MFAToken    string `json:"mfa_token,omitempty"`
 }
 
 // UserInfo user info
@@ -77,11 +95,18 @@ type RefreshTokenResponse struct {
 	ExpiresIn    int64  `json:"expires_in"` // seconds
 }
 
+// AdminTokenResponse admin console access token
+type AdminTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"` // seconds
+}
+
 // ChangePasswordRequest change password request
 type ChangePasswordRequest struct {
 	OldPassword string `json:"old_password" binding:"required"`
 	NewPassword string `json:"new_password" binding:"required,min=8,max=32"`
 	DeviceID    string `json:"device_id" binding:"required"`
+	MFACode     string `json:"mfa_code"` // required when two-factor authentication is enabled
 }
 
 // ResetPasswordRequest reset password request
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+)
+
+// DeviceInfo device of the current user
+type DeviceInfo struct {
+	DeviceID      string           `json:"device_id"`
+	DeviceType    model.DeviceType `json:"device_type"`
+	Name          string           `json:"name"`
+	ClientVersion string           `json:"client_version"`
+	LastLoginAt   *time.Time       `json:"last_login_at"`
+	LastLoginIP   string           `json:"last_login_ip"`
+	LastActiveAt  *time.Time       `json:"last_active_at"`
+	IsCurrent     bool             `json:"is_current"` // the device making the request
+	SignedIn      bool             `json:"signed_in"`  // has a live session
+}
+
+// ListDevicesResponse device list response
+type ListDevicesResponse struct {
+	Devices []*DeviceInfo `json:"devices"`
+}
+
+// RenameDeviceRequest rename device request
+type RenameDeviceRequest struct {
+	DeviceID string `json:"device_id" binding:"required"`
+	Name     string `json:"name" binding:"required,max=64"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+)
+
+// LoginHistoryEntry one login attempt of the current user
+type LoginHistoryEntry struct {
+	ID          int64             `json:"id"`
+	DeviceID    string            `json:"device_id"`
+	DeviceType  model.DeviceType  `json:"device_type"`
+	LoginMethod string            `json:"login_method"` // password, code, qr, oidc
+	IPAddress   string            `json:"ip_address"`
+	Location    string            `json:"location"` // empty without a GeoIP database
+	UserAgent   string            `json:"user_agent"`
+	Result      model.LoginResult `json:"result"` // 1-success 2-failed 3-blocked
+	Unusual     bool              `json:"unusual"`
+	RiskReasons []string          `json:"risk_reasons"`
+	Reported    bool              `json:"reported"` // reported as "this wasn't me"
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+// ListLoginHistoryResponse login history response
+type ListLoginHistoryResponse struct {
+	Entries []*LoginHistoryEntry `json:"entries"`
+}
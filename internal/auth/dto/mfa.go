@@ -0,0 +1,30 @@
+package dto
+
+// BeginMFAEnrollmentResponse TOTP enrollment response
+type BeginMFAEnrollmentResponse struct {
+	Secret     string `json:"secret"`      // base32 secret for manual entry
+	OtpauthURI string `json:"otpauth_uri"` // otpauth:// URI rendered as QR code
+}
+
+// MFARecoveryCodesResponse recovery codes response, codes are only returned once
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// MFAStatusResponse MFA status response
+type MFAStatusResponse struct {
+	Enabled                bool  `json:"enabled"`
+	RemainingRecoveryCodes int64 `json:"remaining_recovery_codes"`
+}
+
+// MFAReauthRequest re-authentication for disabling MFA or regenerating recovery codes
+type MFAReauthRequest struct {
+	Password string `json:"password"`
+	Code     string `json:"code" binding:"required"` // TOTP or recovery code
+}
+
+// VerifyLoginMFARequest complete login MFA challenge request
+type VerifyLoginMFARequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"` // TOTP or recovery code
+}
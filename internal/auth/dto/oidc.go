@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+)
+
+// OIDCProviderInfo configured identity provider
+type OIDCProviderInfo struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+// ListOIDCProvidersResponse identity provider list response
+type ListOIDCProvidersResponse struct {
+	Providers []*OIDCProviderInfo `json:"providers"`
+}
+
+// BeginOIDCLoginRequest start an authorization at an identity provider
+type BeginOIDCLoginRequest struct {
+	Provider    string `json:"provider"`
+	RedirectURI string `json:"redirect_uri"` // must be registered for the provider, empty uses the default
+	UserID      string `json:"-"`            // set when an authenticated user links the identity
+}
+
+// BeginOIDCLoginResponse authorization to open in a browser
+type BeginOIDCLoginResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+	ExpiresIn        int64  `json:"expires_in"` // seconds the state stays valid
+}
+
+// CompleteOIDCLoginRequest finish sign-in with the code the provider redirected back with
+type CompleteOIDCLoginRequest struct {
+	Provider      string           `json:"provider"`
+	Code          string           `json:"code" binding:"required"`
+	State         string           `json:"state" binding:"required"`
+	DeviceType    model.DeviceType `json:"device_type" binding:"required"`
+	DeviceID      string           `json:"device_id" binding:"required"`
+	ClientVersion string           `json:"client_version" binding:"required"`
+	IpAddress     string           `json:"ip_address"`
+	UserAgent     string           `json:"user_agent"`
+	Nickname      string           `json:"nickname"` // used only when a new account is created
+}
+
+// LinkIdentityRequest link the identity returned for a link authorization
+type LinkIdentityRequest struct {
+	Provider string `json:"provider"`
+	Code     string `json:"code" binding:"required"`
+	State    string `json:"state" binding:"required"`
+}
+
+// IdentityInfo identity linked to the current user
+type IdentityInfo struct {
+	Provider    string     `json:"provider"`
+	DisplayName string     `json:"display_name"` // provider display name
+	Email       string     `json:"email"`
+	LinkedAt    time.Time  `json:"linked_at"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+}
+
+// ListIdentitiesResponse linked identity list response
+type ListIdentitiesResponse struct {
+	Identities []*IdentityInfo `json:"identities"`
+}
@@ -0,0 +1,51 @@
+package dto
+
+import "github.com/anychat/server/internal/auth/model"
+
+// CreateQRLoginRequest create QR login ticket request (desktop side)
+type CreateQRLoginRequest struct {
+	DeviceType    model.DeviceType `json:"device_type" binding:"required"`
+	DeviceID      string           `json:"device_id" binding:"required"`
+	ClientVersion string           `json:"client_version" binding:"required"`
+	IpAddress     string           `json:"ip_address"`
+	UserAgent     string           `json:"user_agent"`
+}
+
+// CreateQRLoginResponse create QR login ticket response
+type CreateQRLoginResponse struct {
+	Ticket     string `json:"ticket"`
+	QRContent  string `json:"qr_content"`
+	ExpiresIn  int64  `json:"expires_in"`  // seconds
+	PollSecret string `json:"poll_secret"` // only known by the device that created the ticket
+}
+
+// GetQRLoginStatusRequest poll QR login status request (desktop side)
+type GetQRLoginStatusRequest struct {
+	Ticket      string              `json:"ticket" binding:"required"`
+	DeviceID    string              `json:"device_id" binding:"required"`
+	PollSecret  string              `json:"poll_secret" binding:"required"`
+	LastStatus  model.QRLoginStatus `json:"last_status"`
+	WaitSeconds int                 `json:"wait_seconds"`
+}
+
+// GetQRLoginStatusResponse poll QR login status response
+type GetQRLoginStatusResponse struct {
+	Status model.QRLoginStatus `json:"status"`
+	Login  *LoginResponse      `json:"login,omitempty"` // set once status is confirmed
+}
+
+// QRLoginActionRequest scan/confirm/cancel request (mobile side)
+type QRLoginActionRequest struct {
+	Ticket     string           `json:"ticket" binding:"required"`
+	DeviceID   string           `json:"device_id"`
+	DeviceType model.DeviceType `json:"device_type"`
+	IpAddress  string           `json:"ip_address"`
+}
+
+// ScanQRLoginResponse scan QR login response, describes the desktop waiting for confirmation
+type ScanQRLoginResponse struct {
+	DeviceType    model.DeviceType `json:"device_type"`
+	ClientVersion string           `json:"client_version"`
+	IpAddress     string           `json:"ip_address"`
+	ExpiresIn     int64            `json:"expires_in"`
+}
@@ -0,0 +1,125 @@
+package geoip
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Location where an IP address is registered, fields are empty when the database does not provide them
+type Location struct {
+	Country string
+	Region  string
+	City    string
+}
+
+// Locator resolves IP addresses to locations
+type Locator interface {
+	Lookup(ip string) (Location, bool)
+}
+
+// Database offline GeoIP database held in memory.
+// It is loaded from a CSV file of IP ranges: start_ip,end_ip,country[,region[,city]], IPv4 or IPv6.
+// The DB-IP "IP to Country Lite" CSV can be used as is; blank lines, # comments and a header row are skipped
+type Database struct {
+	ranges []ipRange
+}
+
+// ipRange inclusive address range
+type ipRange struct {
+	start    netip.Addr
+	end      netip.Addr
+	location Location
+}
+
+// Open loads the database file
+func Open(path string) (*Database, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Load(file)
+}
+
+// Load reads a CSV database
+func Load(r io.Reader) (*Database, error) {
+	reader := csv.NewReader(bufio.NewReader(r))
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	var ranges []ipRange
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("geoip line %d: %w", line, err)
+		}
+		if len(record) < 3 {
+			return nil, fmt.Errorf("geoip line %d: expected start_ip,end_ip,country", line)
+		}
+
+		start, startErr := netip.ParseAddr(strings.TrimSpace(record[0]))
+		end, endErr := netip.ParseAddr(strings.TrimSpace(record[1]))
+		if startErr != nil || endErr != nil {
+			// header row
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("geoip line %d: invalid IP range", line)
+		}
+		start, end = start.Unmap(), end.Unmap()
+		if start.BitLen() != end.BitLen() || end.Less(start) {
+			return nil, fmt.Errorf("geoip line %d: invalid IP range", line)
+		}
+
+		location := Location{Country: strings.TrimSpace(record[2])}
+		if len(record) > 3 {
+			location.Region = strings.TrimSpace(record[3])
+		}
+		if len(record) > 4 {
+			location.City = strings.TrimSpace(record[4])
+		}
+		ranges = append(ranges, ipRange{start: start, end: end, location: location})
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].start.Less(ranges[j].start)
+	})
+	return &Database{ranges: ranges}, nil
+}
+
+// Len returns the number of ranges
+func (d *Database) Len() int {
+	return len(d.ranges)
+}
+
+// Lookup finds the range containing ip
+func (d *Database) Lookup(ip string) (Location, bool) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return Location{}, false
+	}
+	addr = addr.Unmap()
+
+	// last range starting at or before addr
+	i := sort.Search(len(d.ranges), func(i int) bool {
+		return addr.Less(d.ranges[i].start)
+	}) - 1
+	if i < 0 {
+		return Location{}, false
+	}
+	r := d.ranges[i]
+	if r.start.BitLen() != addr.BitLen() || r.end.Less(addr) {
+		return Location{}, false
+	}
+	return r.location, true
+}
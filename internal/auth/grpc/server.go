@@ -17,12 +17,14 @@ import (
 type AuthServer struct {
 	authpb.UnimplementedAuthServiceServer
 	authService service.AuthService
+	mfaService  service.MFAService
 }
 
 // NewAuthServer creates auth gRPC server
-func NewAuthServer(authService service.AuthService) *AuthServer {
+func NewAuthServer(authService service.AuthService, mfaService service.MFAService) *AuthServer {
 	return &AuthServer{
 		authService: authService,
+		mfaService:  mfaService,
 	}
 }
 
@@ -111,6 +113,8 @@ func (s *AuthServer) Login(ctx context.Context, req *authpb.LoginRequest) (*auth
 		DeviceID:      req.DeviceId,
 		ClientVersion: req.ClientVersion,
 		IpAddress:     req.IpAddress,
+		UserAgent:     req.UserAgent,
+		CaptchaToken:  req.GetCaptchaToken(),
 	}
 
 	// call service layer
@@ -119,12 +123,53 @@ func (s *AuthServer) Login(ctx context.Context, req *authpb.LoginRequest) (*auth
 		return nil, convertError(err)
 	}
 
-	// DTO -> Proto conversion
+	return toLoginResponse(resp), nil
+}
+
+// LoginByCode passwordless login with a verification code
+func (s *AuthServer) LoginByCode(ctx context.Context, req *authpb.LoginByCodeRequest) (*authpb.LoginResponse, error) {
+	deviceType := model.DeviceType(req.DeviceType)
+	if !deviceType.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid device_type")
+	}
+	targetType := model.VerificationTargetType(req.TargetType)
+	if !targetType.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid target_type")
+	}
+
+	// Proto -> DTO conversion
+	dtoReq := &dto.LoginByCodeRequest{
+		Target:        req.Target,
+		TargetType:    targetType,
+		VerifyCode:    req.VerifyCode,
+		DeviceType:    deviceType,
+		DeviceID:      req.DeviceId,
+		ClientVersion: req.ClientVersion,
+		IpAddress:     req.IpAddress,
+		UserAgent:     req.UserAgent,
+	}
+	if req.Nickname != nil {
+		dtoReq.Nickname = *req.Nickname
+	}
+
+	// call service layer
+	resp, err := s.authService.LoginByCode(ctx, dtoReq)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toLoginResponse(resp), nil
+}
+
+// toLoginResponse converts login DTO to proto
+func toLoginResponse(resp *dto.LoginResponse) *authpb.LoginResponse {
 	pbResp := &authpb.LoginResponse{
 		UserId:       resp.UserID,
 		AccessToken:  resp.AccessToken,
 		RefreshToken: resp.RefreshToken,
 		ExpiresIn:    resp.ExpiresIn,
+		MfaRequired:  resp.MFARequired,
+		MfaToken:     resp.MFAToken,
 	}
 
 	if resp.User != nil {
@@ -141,7 +186,7 @@ func (s *AuthServer) Login(ctx context.Context, req *authpb.LoginRequest) (*auth
 		}
 	}
 
-	return pbResp, nil
+	return pbResp
 }
 
 // Logout user logout
@@ -188,6 +233,7 @@ func (s *AuthServer) ChangePassword(ctx context.Context, req *authpb.ChangePassw
 		OldPassword: req.OldPassword,
 		NewPassword: req.NewPassword,
 		DeviceID:    req.DeviceId,
+		MFACode:     req.GetMfaCode(),
 	}
 
 	// call service layer
@@ -234,6 +280,500 @@ func (s *AuthServer) ValidateToken(ctx context.Context, req *authpb.ValidateToke
 	}, nil
 }
 
+// IssueAdminToken signs admin console access token
+func (s *AuthServer) IssueAdminToken(ctx context.Context, req *authpb.IssueAdminTokenRequest) (*authpb.IssueAdminTokenResponse, error) {
+	resp, err := s.authService.IssueAdminToken(ctx, req.AdminId, int16(req.Role))
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &authpb.IssueAdminTokenResponse{
+		AccessToken: resp.AccessToken,
+		ExpiresIn:   resp.ExpiresIn,
+	}, nil
+}
+
+// CreateQRLoginTicket creates QR login ticket
+func (s *AuthServer) CreateQRLoginTicket(ctx context.Context, req *authpb.CreateQRLoginTicketRequest) (*authpb.CreateQRLoginTicketResponse, error) {
+	deviceType := model.DeviceType(req.DeviceType)
+	if !deviceType.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid device_type")
+	}
+
+	resp, err := s.authService.CreateQRLoginTicket(ctx, &dto.CreateQRLoginRequest{
+		DeviceType:    deviceType,
+		DeviceID:      req.DeviceId,
+		ClientVersion: req.ClientVersion,
+		IpAddress:     req.IpAddress,
+		UserAgent:     req.UserAgent,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &authpb.CreateQRLoginTicketResponse{
+		Ticket:     resp.Ticket,
+		QrContent:  resp.QRContent,
+		ExpiresIn:  resp.ExpiresIn,
+		PollSecret: resp.PollSecret,
+	}, nil
+}
+
+// GetQRLoginStatus long-polls QR login status
+func (s *AuthServer) GetQRLoginStatus(ctx context.Context, req *authpb.GetQRLoginStatusRequest) (*authpb.GetQRLoginStatusResponse, error) {
+	resp, err := s.authService.GetQRLoginStatus(ctx, &dto.GetQRLoginStatusRequest{
+		Ticket:      req.Ticket,
+		DeviceID:    req.DeviceId,
+		PollSecret:  req.PollSecret,
+		LastStatus:  model.QRLoginStatus(req.LastStatus),
+		WaitSeconds: int(req.WaitSeconds),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	pbResp := &authpb.GetQRLoginStatusResponse{
+		Status: authpb.QRLoginStatus(resp.Status),
+	}
+	if resp.Login != nil {
+		pbResp.Login = toLoginResponse(resp.Login)
+	}
+	return pbResp, nil
+}
+
+// ScanQRLogin marks QR login ticket scanned
+func (s *AuthServer) ScanQRLogin(ctx context.Context, req *authpb.QRLoginActionRequest) (*authpb.ScanQRLoginResponse, error) {
+	resp, err := s.authService.ScanQRLogin(ctx, req.UserId, toQRLoginActionRequest(req))
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &authpb.ScanQRLoginResponse{
+		DeviceType:    authpb.DeviceType(resp.DeviceType),
+		ClientVersion: resp.ClientVersion,
+		IpAddress:     resp.IpAddress,
+		ExpiresIn:     resp.ExpiresIn,
+	}, nil
+}
+
+// ConfirmQRLogin confirms QR login
+func (s *AuthServer) ConfirmQRLogin(ctx context.Context, req *authpb.QRLoginActionRequest) (*commonpb.Empty, error) {
+	if err := s.authService.ConfirmQRLogin(ctx, req.UserId, toQRLoginActionRequest(req)); err != nil {
+		return nil, convertError(err)
+	}
+	return &commonpb.Empty{}, nil
+}
+
+// CancelQRLogin rejects QR login
+func (s *AuthServer) CancelQRLogin(ctx context.Context, req *authpb.QRLoginActionRequest) (*commonpb.Empty, error) {
+	if err := s.authService.CancelQRLogin(ctx, req.UserId, toQRLoginActionRequest(req)); err != nil {
+		return nil, convertError(err)
+	}
+	return &commonpb.Empty{}, nil
+}
+
+func toQRLoginActionRequest(req *authpb.QRLoginActionRequest) *dto.QRLoginActionRequest {
+	return &dto.QRLoginActionRequest{
+		Ticket:     req.Ticket,
+		DeviceID:   req.DeviceId,
+		DeviceType: model.DeviceType(req.DeviceType),
+		IpAddress:  req.IpAddress,
+	}
+}
+
+// VerifyLoginMFA completes login MFA challenge
+func (s *AuthServer) VerifyLoginMFA(ctx context.Context, req *authpb.VerifyLoginMFARequest) (*authpb.LoginResponse, error) {
+	resp, err := s.authService.VerifyLoginMFA(ctx, &dto.VerifyLoginMFARequest{
+		MFAToken: req.MfaToken,
+		Code:     req.Code,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toLoginResponse(resp), nil
+}
+
+// GetMFAStatus gets MFA status
+func (s *AuthServer) GetMFAStatus(ctx context.Context, req *authpb.MFAUserRequest) (*authpb.MFAStatusResponse, error) {
+	resp, err := s.mfaService.GetStatus(ctx, req.UserId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &authpb.MFAStatusResponse{
+		Enabled:                resp.Enabled,
+		RemainingRecoveryCodes: resp.RemainingRecoveryCodes,
+	}, nil
+}
+
+// BeginMFAEnrollment starts TOTP enrollment
+func (s *AuthServer) BeginMFAEnrollment(ctx context.Context, req *authpb.MFAUserRequest) (*authpb.BeginMFAEnrollmentResponse, error) {
+	resp, err := s.mfaService.BeginEnrollment(ctx, req.UserId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &authpb.BeginMFAEnrollmentResponse{
+		Secret:     resp.Secret,
+		OtpauthUri: resp.OtpauthURI,
+	}, nil
+}
+
+// ConfirmMFAEnrollment enables MFA
+func (s *AuthServer) ConfirmMFAEnrollment(ctx context.Context, req *authpb.ConfirmMFAEnrollmentRequest) (*authpb.MFARecoveryCodesResponse, error) {
+	resp, err := s.mfaService.ConfirmEnrollment(ctx, req.UserId, req.Code)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &authpb.MFARecoveryCodesResponse{RecoveryCodes: resp.RecoveryCodes}, nil
+}
+
+// DisableMFA disables MFA
+func (s *AuthServer) DisableMFA(ctx context.Context, req *authpb.MFAReauthRequest) (*commonpb.Empty, error) {
+	if err := s.mfaService.Disable(ctx, req.UserId, toMFAReauthRequest(req)); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &commonpb.Empty{}, nil
+}
+
+// RegenerateRecoveryCodes replaces recovery codes
+func (s *AuthServer) RegenerateRecoveryCodes(ctx context.Context, req *authpb.MFAReauthRequest) (*authpb.MFARecoveryCodesResponse, error) {
+	resp, err := s.mfaService.RegenerateRecoveryCodes(ctx, req.UserId, toMFAReauthRequest(req))
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &authpb.MFARecoveryCodesResponse{RecoveryCodes: resp.RecoveryCodes}, nil
+}
+
+// UnlockAccount lift a login lockout
+func (s *AuthServer) UnlockAccount(ctx context.Context, req *authpb.UnlockAccountRequest) (*commonpb.Empty, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.authService.UnlockAccount(ctx, req.UserId); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &commonpb.Empty{}, nil
+}
+
+// ListDevices lists devices of the user
+func (s *AuthServer) ListDevices(ctx context.Context, req *authpb.ListDevicesRequest) (*authpb.ListDevicesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	resp, err := s.authService.ListDevices(ctx, req.UserId, req.CurrentDeviceId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	devices := make([]*authpb.DeviceInfo, 0, len(resp.Devices))
+	for _, device := range resp.Devices {
+		info := &authpb.DeviceInfo{
+			DeviceId:      device.DeviceID,
+			DeviceType:    authpb.DeviceType(device.DeviceType),
+			Name:          device.Name,
+			ClientVersion: device.ClientVersion,
+			LastLoginIp:   device.LastLoginIP,
+			IsCurrent:     device.IsCurrent,
+			SignedIn:      device.SignedIn,
+		}
+		if device.LastLoginAt != nil {
+			info.LastLoginAt = device.LastLoginAt.Unix()
+		}
+		if device.LastActiveAt != nil {
+			info.LastActiveAt = device.LastActiveAt.Unix()
+		}
+		devices = append(devices, info)
+	}
+
+	return &authpb.ListDevicesResponse{Devices: devices}, nil
+}
+
+// LogoutDevice signs out one device
+func (s *AuthServer) LogoutDevice(ctx context.Context, req *authpb.DeviceRequest) (*commonpb.Empty, error) {
+	if req.UserId == "" || req.DeviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and device_id are required")
+	}
+
+	if err := s.authService.LogoutDevice(ctx, req.UserId, req.DeviceId); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &commonpb.Empty{}, nil
+}
+
+// LogoutOtherDevices signs out every device except the current one
+func (s *AuthServer) LogoutOtherDevices(ctx context.Context, req *authpb.DeviceRequest) (*commonpb.Empty, error) {
+	if req.UserId == "" || req.DeviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and device_id are required")
+	}
+
+	if err := s.authService.LogoutOtherDevices(ctx, req.UserId, req.DeviceId); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &commonpb.Empty{}, nil
+}
+
+// RenameDevice renames a device
+func (s *AuthServer) RenameDevice(ctx context.Context, req *authpb.RenameDeviceRequest) (*commonpb.Empty, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.authService.RenameDevice(ctx, req.UserId, &dto.RenameDeviceRequest{
+		DeviceID: req.DeviceId,
+		Name:     req.Name,
+	}); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &commonpb.Empty{}, nil
+}
+
+func toMFAReauthRequest(req *authpb.MFAReauthRequest) *dto.MFAReauthRequest {
+	return &dto.MFAReauthRequest{
+		Password: req.Password,
+		Code:     req.Code,
+	}
+}
+
+// ListOIDCProviders lists configured identity providers
+func (s *AuthServer) ListOIDCProviders(ctx context.Context, req *commonpb.Empty) (*authpb.ListOIDCProvidersResponse, error) {
+	resp := s.authService.ListOIDCProviders(ctx)
+
+	providers := make([]*authpb.OIDCProviderInfo, 0, len(resp.Providers))
+	for _, provider := range resp.Providers {
+		providers = append(providers, &authpb.OIDCProviderInfo{
+			Name:        provider.Name,
+			DisplayName: provider.DisplayName,
+		})
+	}
+
+	return &authpb.ListOIDCProvidersResponse{Providers: providers}, nil
+}
+
+// BeginOIDCLogin starts an OIDC authorization
+func (s *AuthServer) BeginOIDCLogin(ctx context.Context, req *authpb.BeginOIDCLoginRequest) (*authpb.BeginOIDCLoginResponse, error) {
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	resp, err := s.authService.BeginOIDCLogin(ctx, &dto.BeginOIDCLoginRequest{
+		Provider:    req.Provider,
+		RedirectURI: req.RedirectUri,
+		UserID:      req.UserId,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &authpb.BeginOIDCLoginResponse{
+		AuthorizationUrl: resp.AuthorizationURL,
+		State:            resp.State,
+		ExpiresIn:        resp.ExpiresIn,
+	}, nil
+}
+
+// CompleteOIDCLogin signs in with an OIDC authorization code
+func (s *AuthServer) CompleteOIDCLogin(ctx context.Context, req *authpb.CompleteOIDCLoginRequest) (*authpb.LoginResponse, error) {
+	deviceType := model.DeviceType(req.DeviceType)
+	if !deviceType.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid device_type")
+	}
+	if req.Provider == "" || req.Code == "" || req.State == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider, code and state are required")
+	}
+
+	dtoReq := &dto.CompleteOIDCLoginRequest{
+		Provider:      req.Provider,
+		Code:          req.Code,
+		State:         req.State,
+		DeviceType:    deviceType,
+		DeviceID:      req.DeviceId,
+		ClientVersion: req.ClientVersion,
+		IpAddress:     req.IpAddress,
+		UserAgent:     req.UserAgent,
+	}
+	if req.Nickname != nil {
+		dtoReq.Nickname = *req.Nickname
+	}
+
+	resp, err := s.authService.CompleteOIDCLogin(ctx, dtoReq)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toLoginResponse(resp), nil
+}
+
+// LinkIdentity links an OIDC identity to the user
+func (s *AuthServer) LinkIdentity(ctx context.Context, req *authpb.LinkIdentityRequest) (*authpb.IdentityInfo, error) {
+	if req.UserId == "" || req.Provider == "" || req.Code == "" || req.State == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id, provider, code and state are required")
+	}
+
+	resp, err := s.authService.LinkIdentity(ctx, req.UserId, &dto.LinkIdentityRequest{
+		Provider: req.Provider,
+		Code:     req.Code,
+		State:    req.State,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toIdentityInfo(resp), nil
+}
+
+// ListIdentities lists identities linked to the user
+func (s *AuthServer) ListIdentities(ctx context.Context, req *authpb.ListIdentitiesRequest) (*authpb.ListIdentitiesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	resp, err := s.authService.ListIdentities(ctx, req.UserId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	identities := make([]*authpb.IdentityInfo, 0, len(resp.Identities))
+	for _, identity := range resp.Identities {
+		identities = append(identities, toIdentityInfo(identity))
+	}
+
+	return &authpb.ListIdentitiesResponse{Identities: identities}, nil
+}
+
+// UnlinkIdentity unlinks an identity from the user
+func (s *AuthServer) UnlinkIdentity(ctx context.Context, req *authpb.UnlinkIdentityRequest) (*commonpb.Empty, error) {
+	if req.UserId == "" || req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and provider are required")
+	}
+
+	if err := s.authService.UnlinkIdentity(ctx, req.UserId, req.Provider); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &commonpb.Empty{}, nil
+}
+
+// ListLoginHistory lists recent login attempts of the user
+func (s *AuthServer) ListLoginHistory(ctx context.Context, req *authpb.ListLoginHistoryRequest) (*authpb.ListLoginHistoryResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	resp, err := s.authService.ListLoginHistory(ctx, req.UserId, int(req.Limit))
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	entries := make([]*authpb.LoginHistoryEntry, 0, len(resp.Entries))
+	for _, entry := range resp.Entries {
+		entries = append(entries, &authpb.LoginHistoryEntry{
+			Id:          entry.ID,
+			DeviceId:    entry.DeviceID,
+			DeviceType:  authpb.DeviceType(entry.DeviceType),
+			LoginMethod: entry.LoginMethod,
+			IpAddress:   entry.IPAddress,
+			Location:    entry.Location,
+			UserAgent:   entry.UserAgent,
+			Result:      authpb.LoginResult(entry.Result),
+			Unusual:     entry.Unusual,
+			RiskReasons: entry.RiskReasons,
+			Reported:    entry.Reported,
+			CreatedAt:   entry.CreatedAt.Unix(),
+		})
+	}
+
+	return &authpb.ListLoginHistoryResponse{Entries: entries}, nil
+}
+
+// ReportUnusualLogin signs out the device of a reported unusual login
+func (s *AuthServer) ReportUnusualLogin(ctx context.Context, req *authpb.ReportUnusualLoginRequest) (*commonpb.Empty, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	if err := s.authService.ReportUnusualLogin(ctx, req.Token); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &commonpb.Empty{}, nil
+}
+
+// DeleteAccount schedules the account for erasure after the grace period
+func (s *AuthServer) DeleteAccount(ctx context.Context, req *authpb.DeleteAccountRequest) (*authpb.AccountDeletionInfo, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	resp, err := s.authService.DeleteAccount(ctx, req.UserId, &dto.DeleteAccountRequest{
+		Password:   req.Password,
+		VerifyCode: req.VerifyCode,
+		MFACode:    req.MfaCode,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toAccountDeletionInfo(resp), nil
+}
+
+// GetAccountDeletion returns the latest deletion request of the user
+func (s *AuthServer) GetAccountDeletion(ctx context.Context, req *authpb.GetAccountDeletionRequest) (*authpb.AccountDeletionInfo, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	resp, err := s.authService.GetAccountDeletion(ctx, req.UserId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toAccountDeletionInfo(resp), nil
+}
+
+// toAccountDeletionInfo converts account deletion DTO to proto
+func toAccountDeletionInfo(deletion *dto.AccountDeletionInfo) *authpb.AccountDeletionInfo {
+	info := &authpb.AccountDeletionInfo{
+		Id:             deletion.ID,
+		Status:         authpb.AccountDeletionStatus(deletion.Status),
+		RequestedAt:    deletion.RequestedAt.Unix(),
+		EraseAfter:     deletion.EraseAfter.Unix(),
+		CompletedSteps: deletion.CompletedSteps,
+	}
+	if deletion.CancelledAt != nil {
+		info.CancelledAt = deletion.CancelledAt.Unix()
+	}
+	if deletion.CompletedAt != nil {
+		info.CompletedAt = deletion.CompletedAt.Unix()
+	}
+	return info
+}
+
+// toIdentityInfo converts linked identity DTO to proto
+func toIdentityInfo(identity *dto.IdentityInfo) *authpb.IdentityInfo {
+	info := &authpb.IdentityInfo{
+		Provider:    identity.Provider,
+		DisplayName: identity.DisplayName,
+		Email:       identity.Email,
+		LinkedAt:    identity.LinkedAt.Unix(),
+	}
+	if identity.LastLoginAt != nil {
+		info.LastLoginAt = identity.LastLoginAt.Unix()
+	}
+	return info
+}
+
 // convertError converts business errors to gRPC errors
 func convertError(err error) error {
 	if bizErr, ok := err.(*errors.Business); ok {
@@ -262,8 +802,46 @@ func convertError(err error) error {
 			errors.CodeSendLimitReached,
 			errors.CodeVerifyAttemptsExceeded:
 			return status.Error(codes.ResourceExhausted, bizErr.Message)
-		case errors.CodeRefreshTokenInvalid, errors.CodeRefreshTokenExpired:
+		case errors.CodeRefreshTokenInvalid, errors.CodeRefreshTokenExpired, errors.CodeRefreshTokenReused:
+			return status.Error(codes.Unauthenticated, bizErr.Message)
+		case errors.CodeForbidden:
+			return status.Error(codes.PermissionDenied, bizErr.Message)
+		case errors.CodeQRLoginExpired:
+			return status.Error(codes.NotFound, bizErr.Message)
+		case errors.CodeQRLoginInvalid:
+			return status.Error(codes.InvalidArgument, bizErr.Message)
+		case errors.CodeMFARequired:
+			return status.Error(codes.PermissionDenied, bizErr.Message)
+		case errors.CodeMFACodeError, errors.CodeMFANotEnabled:
+			return status.Error(codes.InvalidArgument, bizErr.Message)
+		case errors.CodeMFAAlreadyEnabled:
+			return status.Error(codes.AlreadyExists, bizErr.Message)
+		case errors.CodeMFAChallengeInvalid:
+			return status.Error(codes.Unauthenticated, bizErr.Message)
+		case errors.CodeAccountLocked, errors.CodeLoginThrottled:
+			return status.Error(codes.ResourceExhausted, bizErr.Message)
+		case errors.CodeCaptchaRequired:
+			return status.Error(codes.PermissionDenied, bizErr.Message)
+		case errors.CodeCaptchaInvalid:
+			return status.Error(codes.InvalidArgument, bizErr.Message)
+		case errors.CodeDeviceNotFound:
+			return status.Error(codes.NotFound, bizErr.Message)
+		case errors.CodeOIDCProviderNotFound, errors.CodeIdentityNotLinked, errors.CodeIdentityNotFound:
+			return status.Error(codes.NotFound, bizErr.Message)
+		case errors.CodeOIDCStateInvalid:
+			return status.Error(codes.InvalidArgument, bizErr.Message)
+		case errors.CodeOIDCAuthFailed:
 			return status.Error(codes.Unauthenticated, bizErr.Message)
+		case errors.CodeIdentityAlreadyLinked:
+			return status.Error(codes.AlreadyExists, bizErr.Message)
+		case errors.CodeLastSignInMethod, errors.CodePasswordResetRequired:
+			return status.Error(codes.PermissionDenied, bizErr.Message)
+		case errors.CodeLoginReportInvalid:
+			return status.Error(codes.InvalidArgument, bizErr.Message)
+		case errors.CodeAccountDeletionExists:
+			return status.Error(codes.AlreadyExists, bizErr.Message)
+		case errors.CodeAccountDeletionNone:
+			return status.Error(codes.NotFound, bizErr.Message)
 		default:
 			return status.Error(codes.Internal, bizErr.Message)
 		}
@@ -0,0 +1,252 @@
+package keyring
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/internal/auth/repository"
+	"github.com/anychat/server/pkg/crypto"
+	"github.com/anychat/server/pkg/jwt"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Config key ring configuration
+type Config struct {
+	Algorithm        string        // algorithm of newly generated keys, RS256 or EdDSA
+	EncryptionSecret string        // encrypts private keys at rest
+	RotationInterval time.Duration // how long a key signs before it is replaced
+	PrePublish       time.Duration // how long the next key sits in JWKS before it signs, must exceed verifier cache TTL
+	RetiredKeyTTL    time.Duration // how long a retired key keeps verifying, must cover the longest token lifetime
+}
+
+// KeyRing DB-backed set of JWT signing keys shared by every service that issues tokens.
+// It implements jwt.Signer and jwt.KeyResolver
+type KeyRing struct {
+	repo   repository.JWTSigningKeyRepository
+	config Config
+
+	mu      sync.RWMutex
+	signing *jwt.SigningKey
+	keys    map[string]*jwt.VerificationKey
+}
+
+// NewKeyRing creates key ring, call Reload before use
+func NewKeyRing(repo repository.JWTSigningKeyRepository, config Config) *KeyRing {
+	if config.Algorithm == "" {
+		config.Algorithm = jwt.AlgorithmRS256
+	}
+	return &KeyRing{
+		repo:   repo,
+		config: config,
+		keys:   make(map[string]*jwt.VerificationKey),
+	}
+}
+
+// SigningKey returns the active signing key
+func (k *KeyRing) SigningKey() (*jwt.SigningKey, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if k.signing == nil {
+		return nil, fmt.Errorf("no active jwt signing key")
+	}
+	return k.signing, nil
+}
+
+// VerificationKey returns the public key for kid
+func (k *KeyRing) VerificationKey(kid string) (*jwt.VerificationKey, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key: %s", kid)
+	}
+	return key, nil
+}
+
+// JWKS returns the public key set, including next and retired keys
+func (k *KeyRing) JWKS() jwt.JWKS {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	set := jwt.JWKS{Keys: make([]jwt.JWK, 0, len(k.keys))}
+	for _, key := range k.keys {
+		jwk, err := key.ToJWK()
+		if err != nil {
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// Reload loads keys from the database
+func (k *KeyRing) Reload(ctx context.Context) error {
+	records, err := k.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var signing *jwt.SigningKey
+	keys := make(map[string]*jwt.VerificationKey, len(records))
+	for _, record := range records {
+		publicKey, err := jwt.ParsePublicKeyPEM(record.PublicKey)
+		if err != nil {
+			logger.Error("Invalid jwt public key", zap.String("kid", record.Kid), zap.Error(err))
+			continue
+		}
+		keys[record.Kid] = &jwt.VerificationKey{ID: record.Kid, Algorithm: record.Algorithm, PublicKey: publicKey}
+
+		// records are newest first, the newest active key signs
+		if record.Status == model.SigningKeyStatusActive && signing == nil {
+			pem, err := crypto.DecryptString(k.config.EncryptionSecret, record.PrivateKeyEncrypted)
+			if err != nil {
+				return fmt.Errorf("decrypt jwt signing key %s: %w", record.Kid, err)
+			}
+			privateKey, err := jwt.ParsePrivateKeyPEM(pem)
+			if err != nil {
+				return fmt.Errorf("parse jwt signing key %s: %w", record.Kid, err)
+			}
+			signing = &jwt.SigningKey{ID: record.Kid, Algorithm: record.Algorithm, PrivateKey: privateKey}
+		}
+	}
+
+	k.mu.Lock()
+	k.signing = signing
+	k.keys = keys
+	k.mu.Unlock()
+	return nil
+}
+
+// Rotate advances the key lifecycle and reloads: bootstraps the first key, pre-publishes the next key,
+// promotes it once due and drops retired keys that no longer verify any live token
+func (k *KeyRing) Rotate(ctx context.Context) error {
+	_, err := k.repo.WithRotationLock(ctx, func(repo repository.JWTSigningKeyRepository) error {
+		return k.rotate(ctx, repo, time.Now())
+	})
+	if err != nil {
+		return err
+	}
+	return k.Reload(ctx)
+}
+
+// rotate runs one rotation step under the rotation lock
+func (k *KeyRing) rotate(ctx context.Context, repo repository.JWTSigningKeyRepository, now time.Time) error {
+	records, err := repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var active, next *model.JWTSigningKey
+	for _, record := range records {
+		switch record.Status {
+		case model.SigningKeyStatusActive:
+			if active == nil {
+				active = record
+			}
+		case model.SigningKeyStatusNext:
+			if next == nil {
+				next = record
+			}
+		}
+	}
+
+	switch {
+	case active == nil && next != nil:
+		// nothing signs yet, no verifier can hold tokens from an older key
+		if err := k.promote(ctx, repo, next, nil, now); err != nil {
+			return err
+		}
+	case active == nil:
+		if _, err := k.createKey(ctx, repo, model.SigningKeyStatusActive, now); err != nil {
+			return err
+		}
+		logger.Info("Created initial jwt signing key")
+	default:
+		due := now
+		if active.ActivatedAt != nil {
+			due = active.ActivatedAt.Add(k.config.RotationInterval)
+		}
+		if next == nil && !now.Before(due.Add(-k.config.PrePublish)) {
+			if next, err = k.createKey(ctx, repo, model.SigningKeyStatusNext, now); err != nil {
+				return err
+			}
+			logger.Info("Published next jwt signing key", zap.String("kid", next.Kid))
+		}
+		// the next key must have been published long enough for verifier caches to have it
+		if next != nil && !now.Before(due) && !now.Before(next.CreatedAt.Add(k.config.PrePublish)) {
+			if err := k.promote(ctx, repo, next, active, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	deleted, err := repo.DeleteExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		logger.Info("Deleted expired jwt signing keys", zap.Int64("count", deleted))
+	}
+	return nil
+}
+
+// promote makes next the signing key and retires the previous one
+func (k *KeyRing) promote(ctx context.Context, repo repository.JWTSigningKeyRepository, next, active *model.JWTSigningKey, now time.Time) error {
+	if active != nil {
+		expiresAt := now.Add(k.config.RetiredKeyTTL)
+		active.Status = model.SigningKeyStatusRetired
+		active.RetiredAt = &now
+		active.ExpiresAt = &expiresAt
+		if err := repo.Update(ctx, active); err != nil {
+			return err
+		}
+	}
+
+	next.Status = model.SigningKeyStatusActive
+	next.ActivatedAt = &now
+	if err := repo.Update(ctx, next); err != nil {
+		return err
+	}
+	logger.Info("Rotated jwt signing key", zap.String("kid", next.Kid))
+	return nil
+}
+
+// createKey generates and stores a new key
+func (k *KeyRing) createKey(ctx context.Context, repo repository.JWTSigningKeyRepository, status model.SigningKeyStatus, now time.Time) (*model.JWTSigningKey, error) {
+	key, err := jwt.GenerateSigningKey(uuid.NewString(), k.config.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	privatePEM, err := jwt.MarshalPrivateKeyPEM(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := crypto.EncryptString(k.config.EncryptionSecret, privatePEM)
+	if err != nil {
+		return nil, err
+	}
+	publicPEM, err := jwt.MarshalPublicKeyPEM(key.PrivateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	record := &model.JWTSigningKey{
+		Kid:                 key.ID,
+		Algorithm:           key.Algorithm,
+		PrivateKeyEncrypted: encrypted,
+		PublicKey:           publicPEM,
+		Status:              status,
+		CreatedAt:           now,
+	}
+	if status == model.SigningKeyStatusActive {
+		record.ActivatedAt = &now
+	}
+	if err := repo.Create(ctx, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
@@ -0,0 +1,64 @@
+package model
+
+import "time"
+
+// AccountDeletionStatus account deletion status
+type AccountDeletionStatus int16
+
+const (
+	AccountDeletionPending   AccountDeletionStatus = 1 // in the grace period, a login cancels it
+	AccountDeletionErasing   AccountDeletionStatus = 2 // grace period over, erasure steps are running
+	AccountDeletionCompleted AccountDeletionStatus = 3
+	AccountDeletionCancelled AccountDeletionStatus = 4
+)
+
+// Account deletion audit actions
+const (
+	DeletionActionRequested     = "requested"
+	DeletionActionCancelled     = "cancelled"
+	DeletionActionStepCompleted = "step_completed"
+	DeletionActionStepFailed    = "step_failed"
+	DeletionActionCompleted     = "completed"
+)
+
+// AccountDeletion self-service account deletion request
+type AccountDeletion struct {
+	ID          int64                 `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID      string                `gorm:"column:user_id;not null" json:"userId"`
+	Status      AccountDeletionStatus `gorm:"column:status;not null;default:1" json:"status"`
+	Reason      string                `gorm:"column:reason" json:"reason"`
+	RequestedAt time.Time             `gorm:"column:requested_at;not null" json:"requestedAt"`
+	EraseAfter  time.Time             `gorm:"column:erase_after;not null" json:"eraseAfter"` // end of the grace period
+	CancelledAt *time.Time            `gorm:"column:cancelled_at" json:"cancelledAt"`
+	CompletedAt *time.Time            `gorm:"column:completed_at" json:"completedAt"`
+	Attempts    int                   `gorm:"column:attempts;not null;default:0" json:"attempts"` // failed erasure runs
+	LastError   string                `gorm:"column:last_error" json:"lastError"`
+	CreatedAt   time.Time             `gorm:"column:created_at" json:"createdAt"`
+	UpdatedAt   time.Time             `gorm:"column:updated_at" json:"updatedAt"`
+}
+
+// TableName returns table name
+func (AccountDeletion) TableName() string {
+	return "account_deletions"
+}
+
+// IsOpen reports whether the deletion is still pending or erasing
+func (d *AccountDeletion) IsOpen() bool {
+	return d.Status == AccountDeletionPending || d.Status == AccountDeletionErasing
+}
+
+// AccountDeletionLog audit trail entry of an account deletion
+type AccountDeletionLog struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	DeletionID int64     `gorm:"column:deletion_id;not null" json:"deletionId"`
+	UserID     string    `gorm:"column:user_id;not null" json:"userId"`
+	Action     string    `gorm:"column:action;not null" json:"action"`
+	Service    string    `gorm:"column:service" json:"service"` // erasure step, empty for request level actions
+	Detail     string    `gorm:"column:detail" json:"detail"`   // JSON
+	CreatedAt  time.Time `gorm:"column:created_at" json:"createdAt"`
+}
+
+// TableName returns table name
+func (AccountDeletionLog) TableName() string {
+	return "account_deletion_logs"
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+)
+
+// SigningKeyStatus signing key lifecycle status
+type SigningKeyStatus string
+
+const (
+	SigningKeyStatusNext    SigningKeyStatus = "next"    // published in JWKS ahead of use so verifier caches pick it up
+	SigningKeyStatusActive  SigningKeyStatus = "active"  // signs new tokens
+	SigningKeyStatusRetired SigningKeyStatus = "retired" // verifies tokens issued before rotation
+)
+
+// JWTSigningKey JWT signing key
+type JWTSigningKey struct {
+	Kid                 string           `gorm:"column:kid;primaryKey" json:"kid"`
+	Algorithm           string           `gorm:"column:algorithm;not null" json:"algorithm"`
+	PrivateKeyEncrypted string           `gorm:"column:private_key_encrypted;not null" json:"-"`
+	PublicKey           string           `gorm:"column:public_key;not null" json:"publicKey"`
+	Status              SigningKeyStatus `gorm:"column:status;not null" json:"status"`
+	ActivatedAt         *time.Time       `gorm:"column:activated_at" json:"activatedAt,omitempty"`
+	RetiredAt           *time.Time       `gorm:"column:retired_at" json:"retiredAt,omitempty"`
+	ExpiresAt           *time.Time       `gorm:"column:expires_at" json:"expiresAt,omitempty"`
+	CreatedAt           time.Time        `gorm:"column:created_at" json:"createdAt"`
+}
+
+// TableName returns table name
+func (JWTSigningKey) TableName() string {
+	return "jwt_signing_keys"
+}
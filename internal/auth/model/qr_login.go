@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+// QRLoginStatus QR login ticket status
+type QRLoginStatus int16
+
+const (
+	QRLoginStatusUnknown   QRLoginStatus = 0
+	QRLoginStatusPending   QRLoginStatus = 1 // waiting for scan
+	QRLoginStatusScanned   QRLoginStatus = 2 // scanned by mobile, waiting for confirmation
+	QRLoginStatusConfirmed QRLoginStatus = 3 // confirmed by mobile, tokens not yet issued
+	QRLoginStatusCancelled QRLoginStatus = 4 // rejected by mobile
+	QRLoginStatusExpired   QRLoginStatus = 5 // ticket expired
+	QRLoginStatusConsumed  QRLoginStatus = 6 // tokens issued to desktop (internal)
+)
+
+var qrLoginStatusValueToString = map[QRLoginStatus]string{
+	QRLoginStatusPending:   "pending",
+	QRLoginStatusScanned:   "scanned",
+	QRLoginStatusConfirmed: "confirmed",
+	QRLoginStatusCancelled: "cancelled",
+	QRLoginStatusExpired:   "expired",
+	QRLoginStatusConsumed:  "consumed",
+}
+
+func (s QRLoginStatus) String() string {
+	if v, ok := qrLoginStatusValueToString[s]; ok {
+		return v
+	}
+	return "unknown"
+}
+
+// QR login audit events
+const (
+	QRLoginEventCreated   = "created"
+	QRLoginEventScanned   = "scanned"
+	QRLoginEventConfirmed = "confirmed"
+	QRLoginEventCancelled = "cancelled"
+	QRLoginEventExpired   = "expired"
+	QRLoginEventLoggedIn  = "logged_in"
+)
+
+// QRLoginEvent QR login audit record
+type QRLoginEvent struct {
+	ID         int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Ticket     string     `gorm:"column:ticket;not null" json:"ticket"`
+	Event      string     `gorm:"column:event;not null" json:"event"`
+	UserID     string     `gorm:"column:user_id" json:"userId"`
+	DeviceID   string     `gorm:"column:device_id;not null" json:"deviceId"`
+	DeviceType DeviceType `gorm:"column:device_type;type:smallint;not null" json:"deviceType"`
+	IPAddress  string     `gorm:"column:ip_address" json:"ipAddress"`
+	CreatedAt  time.Time  `gorm:"column:created_at" json:"createdAt"`
+}
+
+// TableName returns table name
+func (QRLoginEvent) TableName() string {
+	return "qr_login_events"
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+)
+
+// RefreshToken issued refresh token, tokens rotated from the same login share a family.
+// Rows live as long as the owning session, so a family is revoked by deleting it
+type RefreshToken struct {
+	ID        int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	FamilyID  string     `gorm:"column:family_id;not null" json:"familyId"`
+	UserID    string     `gorm:"column:user_id;not null" json:"userId"`
+	DeviceID  string     `gorm:"column:device_id;not null" json:"deviceId"`
+	TokenHash string     `gorm:"column:token_hash;not null" json:"-"` // SHA-256 of the token
+	ExpiresAt time.Time  `gorm:"column:expires_at;not null" json:"expiresAt"`
+	RotatedAt *time.Time `gorm:"column:rotated_at" json:"rotatedAt,omitempty"` // set once exchanged for a new token
+	CreatedAt time.Time  `gorm:"column:created_at" json:"createdAt"`
+}
+
+// TableName returns table name
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+// IsExpired checks if refresh token is expired
+func (t *RefreshToken) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
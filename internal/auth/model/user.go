@@ -8,14 +8,15 @@ import (
 
 // User user model
 type User struct {
-	ID           string         `gorm:"column:id;primaryKey" json:"id"`
-	Phone        *string        `gorm:"column:phone;unique" json:"phone"`
-	Email        *string        `gorm:"column:email;unique" json:"email"`
-	PasswordHash string         `gorm:"column:password_hash;not null" json:"-"`
-	Status       int            `gorm:"column:status;not null;default:1" json:"status"` // 1-normal, 2-disabled
-	CreatedAt    time.Time      `gorm:"column:created_at" json:"createdAt"`
-	UpdatedAt    time.Time      `gorm:"column:updated_at" json:"updatedAt"`
-	DeletedAt    gorm.DeletedAt `gorm:"column:deleted_at;index" json:"-"`
+	ID                    string         `gorm:"column:id;primaryKey" json:"id"`
+	Phone                 *string        `gorm:"column:phone;unique" json:"phone"`
+	Email                 *string        `gorm:"column:email;unique" json:"email"`
+	PasswordHash          string         `gorm:"column:password_hash;not null" json:"-"`
+	Status                int            `gorm:"column:status;not null;default:1" json:"status"`                                     // 1-normal, 2-disabled
+	PasswordResetRequired bool           `gorm:"column:password_reset_required;not null;default:false" json:"passwordResetRequired"` // set by a reported unusual login, password login is refused until reset
+	CreatedAt             time.Time      `gorm:"column:created_at" json:"createdAt"`
+	UpdatedAt             time.Time      `gorm:"column:updated_at" json:"updatedAt"`
+	DeletedAt             gorm.DeletedAt `gorm:"column:deleted_at;index" json:"-"`
 }
 
 // TableName returns table name
@@ -60,9 +60,11 @@ type UserDevice struct {
 	UserID        string     `gorm:"column:user_id;not null" json:"userId"`
 	DeviceID      string     `gorm:"column:device_id;not null" json:"deviceId"`
 	DeviceType    DeviceType `gorm:"column:device_type;type:smallint;not null" json:"deviceType"`
+	Name          string     `gorm:"column:name" json:"name"` // user-defined name, empty until renamed
 	ClientVersion string     `gorm:"column:client_version" json:"clientVersion"`
 	LastLoginAt   *time.Time `gorm:"column:last_login_at" json:"lastLoginAt"`
 	LastLoginIP   string     `gorm:"column:last_login_ip" json:"lastLoginIp"`
+	LastActiveAt  *time.Time `gorm:"column:last_active_at" json:"lastActiveAt"` // last login or token refresh
 	CreatedAt     time.Time  `gorm:"column:created_at" json:"createdAt"`
 	UpdatedAt     time.Time  `gorm:"column:updated_at" json:"updatedAt"`
 }
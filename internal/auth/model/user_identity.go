@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// UserIdentity external OIDC identity linked to a user
+type UserIdentity struct {
+	ID          int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID      string     `gorm:"column:user_id;not null" json:"userId"`
+	Provider    string     `gorm:"column:provider;not null" json:"provider"`
+	Subject     string     `gorm:"column:subject;not null" json:"subject"` // sub claim, unique per provider
+	Email       string     `gorm:"column:email" json:"email"`
+	DisplayName string     `gorm:"column:display_name" json:"displayName"`
+	LastLoginAt *time.Time `gorm:"column:last_login_at" json:"lastLoginAt"`
+	CreatedAt   time.Time  `gorm:"column:created_at" json:"createdAt"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at" json:"updatedAt"`
+}
+
+// TableName returns table name
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// LoginResult login attempt outcome
+type LoginResult int16
+
+const (
+	LoginResultSuccess LoginResult = 1 // signed in
+	LoginResultFailed  LoginResult = 2 // wrong password
+	LoginResultBlocked LoginResult = 3 // rejected by lockout, delay or CAPTCHA, or a password reset is required
+)
+
+// Login methods
+const (
+	LoginMethodPassword = "password"
+	LoginMethodCode     = "code"
+	LoginMethodQR       = "qr"
+	LoginMethodOIDC     = "oidc"
+)
+
+// Reasons a login is scored as unusual
+const (
+	RiskReasonNewDevice  = "new_device"
+	RiskReasonNewNetwork = "new_network"
+	RiskReasonNewCountry = "new_country"
+)
+
+// UserLoginHistory one login attempt of a user
+type UserLoginHistory struct {
+	ID          int64       `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID      string      `gorm:"column:user_id;not null" json:"userId"`
+	DeviceID    string      `gorm:"column:device_id" json:"deviceId"`
+	DeviceType  DeviceType  `gorm:"column:device_type" json:"deviceType"`
+	LoginMethod string      `gorm:"column:login_method;not null" json:"loginMethod"`
+	IPAddress   string      `gorm:"column:ip_address" json:"ipAddress"`
+	IPPrefix    string      `gorm:"column:ip_prefix" json:"ipPrefix"` // network the IP belongs to, /24 or /48
+	Country     string      `gorm:"column:country" json:"country"`
+	Region      string      `gorm:"column:region" json:"region"`
+	City        string      `gorm:"column:city" json:"city"`
+	UserAgent   string      `gorm:"column:user_agent" json:"userAgent"`
+	Result      LoginResult `gorm:"column:result;not null" json:"result"`
+	RiskScore   int         `gorm:"column:risk_score;not null;default:0" json:"riskScore"`
+	RiskReasons string      `gorm:"column:risk_reasons" json:"riskReasons"` // comma separated RiskReason values
+	Unusual     bool        `gorm:"column:unusual;not null;default:false" json:"unusual"`
+	ReportedAt  *time.Time  `gorm:"column:reported_at" json:"reportedAt"`
+	CreatedAt   time.Time   `gorm:"column:created_at" json:"createdAt"`
+}
+
+// TableName returns table name
+func (UserLoginHistory) TableName() string {
+	return "user_login_history"
+}
+
+// Location human readable location, empty when unknown
+func (h *UserLoginHistory) Location() string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{h.City, h.Region, h.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Reasons returns risk reasons as a list
+func (h *UserLoginHistory) Reasons() []string {
+	if h.RiskReasons == "" {
+		return nil
+	}
+	return strings.Split(h.RiskReasons, ",")
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// UserMFA user TOTP two-factor authentication settings
+type UserMFA struct {
+	UserID          string     `gorm:"column:user_id;primaryKey" json:"userId"`
+	SecretEncrypted string     `gorm:"column:secret_encrypted;not null" json:"-"`
+	Enabled         bool       `gorm:"column:enabled;not null;default:false" json:"enabled"`
+	EnabledAt       *time.Time `gorm:"column:enabled_at" json:"enabledAt"`
+	LastUsedStep    int64      `gorm:"column:last_used_step;not null;default:0" json:"-"` // last accepted TOTP time step, rejects replays
+	CreatedAt       time.Time  `gorm:"column:created_at" json:"createdAt"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at" json:"updatedAt"`
+}
+
+// TableName returns table name
+func (UserMFA) TableName() string {
+	return "user_mfa"
+}
+
+// UserRecoveryCode one-time MFA recovery code
+type UserRecoveryCode struct {
+	ID        int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID    string     `gorm:"column:user_id;not null" json:"userId"`
+	CodeHash  string     `gorm:"column:code_hash;not null" json:"-"`
+	UsedAt    *time.Time `gorm:"column:used_at" json:"usedAt"`
+	CreatedAt time.Time  `gorm:"column:created_at" json:"createdAt"`
+}
+
+// TableName returns table name
+func (UserRecoveryCode) TableName() string {
+	return "user_mfa_recovery_codes"
+}
@@ -9,8 +9,9 @@ type UserSession struct {
 	ID                    int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
 	UserID                string    `gorm:"column:user_id;not null" json:"userId"`
 	DeviceID              string    `gorm:"column:device_id;not null" json:"deviceId"`
-	AccessToken           string    `gorm:"column:access_token;not null" json:"accessToken"`
-	RefreshToken          string    `gorm:"column:refresh_token;not null" json:"refreshToken"`
+	FamilyID              string    `gorm:"column:family_id;not null" json:"familyId"`   // refresh token family of this session
+	AccessTokenHash       string    `gorm:"column:access_token_hash;not null" json:"-"`  // SHA-256 of current access token
+	RefreshTokenHash      string    `gorm:"column:refresh_token_hash;not null" json:"-"` // SHA-256 of current refresh token
 	AccessTokenExpiresAt  time.Time `gorm:"column:access_token_expires_at;not null" json:"accessTokenExpiresAt"`
 	RefreshTokenExpiresAt time.Time `gorm:"column:refresh_token_expires_at;not null" json:"refreshTokenExpiresAt"`
 	CreatedAt             time.Time `gorm:"column:created_at" json:"createdAt"`
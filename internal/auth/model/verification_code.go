@@ -54,6 +54,7 @@ const (
 	PurposeChangePhone   VerificationPurpose = 5
 	PurposeBindEmail     VerificationPurpose = 6
 	PurposeChangeEmail   VerificationPurpose = 7
+	PurposeDeleteAccount VerificationPurpose = 8
 )
 
 var purposeValueToString = map[VerificationPurpose]string{
@@ -64,6 +65,7 @@ var purposeValueToString = map[VerificationPurpose]string{
 	PurposeChangePhone:   "change_phone",
 	PurposeBindEmail:     "bind_email",
 	PurposeChangeEmail:   "change_email",
+	PurposeDeleteAccount: "delete_account",
 }
 
 func (p VerificationPurpose) String() string {
@@ -74,7 +76,7 @@ func (p VerificationPurpose) String() string {
 }
 
 func (p VerificationPurpose) IsValid() bool {
-	return p >= PurposeRegister && p <= PurposeChangeEmail
+	return p >= PurposeRegister && p <= PurposeDeleteAccount
 }
 
 func ParseVerificationPurpose(value string) (VerificationPurpose, error) {
@@ -93,6 +95,8 @@ func ParseVerificationPurpose(value string) (VerificationPurpose, error) {
 		return PurposeBindEmail, nil
 	case "change_email":
 		return PurposeChangeEmail, nil
+	case "delete_account":
+		return PurposeDeleteAccount, nil
 	default:
 		return PurposeUnknown, fmt.Errorf("unsupported verification purpose: %s", value)
 	}
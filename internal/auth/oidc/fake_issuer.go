@@ -0,0 +1,217 @@
+package oidc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	pkgjwt "github.com/anychat/server/pkg/jwt"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	fakeIssuerCodeTTL  = time.Minute
+	fakeIssuerTokenTTL = 5 * time.Minute
+)
+
+// FakeIssuer in-process OpenID Connect provider for development and integration tests.
+// The authorize endpoint signs in whoever is named by login_hint without asking and redirects straight back
+// with a code; the token endpoint checks the PKCE verifier and returns an ID token signed by its own key.
+// Client secrets are not checked, never enable it in production
+type FakeIssuer struct {
+	issuer string
+	key    *pkgjwt.SigningKey
+
+	mu    sync.Mutex
+	codes map[string]*fakeAuthorization
+}
+
+// fakeAuthorization an issued authorization code
+type fakeAuthorization struct {
+	clientID      string
+	redirectURI   string
+	codeChallenge string
+	nonce         string
+	email         string
+	emailVerified bool
+	name          string
+	expiresAt     time.Time
+}
+
+// NewFakeIssuer creates a fake issuer served at issuerURL, e.g. http://localhost:8001/fake-oidc
+func NewFakeIssuer(issuerURL string) (*FakeIssuer, error) {
+	key, err := pkgjwt.GenerateSigningKey("fake-oidc", pkgjwt.AlgorithmRS256)
+	if err != nil {
+		return nil, err
+	}
+	return &FakeIssuer{
+		issuer: strings.TrimRight(issuerURL, "/"),
+		key:    key,
+		codes:  make(map[string]*fakeAuthorization),
+	}, nil
+}
+
+// Issuer returns issuer URL
+func (f *FakeIssuer) Issuer() string {
+	return f.issuer
+}
+
+// Handler serves discovery, JWKS, authorize and token endpoints, mount it with the issuer path stripped
+func (f *FakeIssuer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, f.handleDiscovery)
+	mux.HandleFunc("/jwks", f.handleJWKS)
+	mux.HandleFunc("/authorize", f.handleAuthorize)
+	mux.HandleFunc("/token", f.handleToken)
+	return mux
+}
+
+func (f *FakeIssuer) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                f.issuer,
+		"authorization_endpoint":                f.issuer + "/authorize",
+		"token_endpoint":                        f.issuer + "/token",
+		"jwks_uri":                              f.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{pkgjwt.AlgorithmRS256},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (f *FakeIssuer) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	jwk, err := f.key.Public().ToJWK()
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, pkgjwt.JWKS{Keys: []pkgjwt.JWK{jwk}})
+}
+
+// handleAuthorize query: the usual authorization request plus login_hint (email of the fake user),
+// optional email_verified=false and name
+func (f *FakeIssuer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectURI := query.Get("redirect_uri")
+	target, err := url.Parse(redirectURI)
+	if redirectURI == "" || err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	if query.Get("response_type") != "code" || query.Get("client_id") == "" ||
+		query.Get("code_challenge") == "" || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+	email := strings.ToLower(strings.TrimSpace(query.Get("login_hint")))
+	if email == "" {
+		http.Error(w, "login_hint is required", http.StatusBadRequest)
+		return
+	}
+
+	code, err := randomToken(24)
+	if err != nil {
+		http.Error(w, "server error", http.StatusInternalServerError)
+		return
+	}
+	name := query.Get("name")
+	if name == "" {
+		name = strings.SplitN(email, "@", 2)[0]
+	}
+	f.mu.Lock()
+	for c, auth := range f.codes {
+		if time.Now().After(auth.expiresAt) {
+			delete(f.codes, c)
+		}
+	}
+	f.codes[code] = &fakeAuthorization{
+		clientID:      query.Get("client_id"),
+		redirectURI:   redirectURI,
+		codeChallenge: query.Get("code_challenge"),
+		nonce:         query.Get("nonce"),
+		email:         email,
+		emailVerified: query.Get("email_verified") != "false",
+		name:          name,
+		expiresAt:     time.Now().Add(fakeIssuerCodeTTL),
+	}
+	f.mu.Unlock()
+
+	params := target.Query()
+	params.Set("code", code)
+	params.Set("state", query.Get("state"))
+	target.RawQuery = params.Encode()
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+func (f *FakeIssuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+	clientID, _, ok := r.BasicAuth()
+	if ok {
+		clientID, _ = url.QueryUnescape(clientID)
+	} else {
+		clientID = r.PostForm.Get("client_id")
+	}
+
+	// codes are single use
+	code := r.PostForm.Get("code")
+	f.mu.Lock()
+	auth := f.codes[code]
+	delete(f.codes, code)
+	f.mu.Unlock()
+
+	if auth == nil || time.Now().After(auth.expiresAt) ||
+		auth.clientID != clientID ||
+		auth.redirectURI != r.PostForm.Get("redirect_uri") ||
+		codeChallenge(r.PostForm.Get("code_verifier")) != auth.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	sum := sha256.Sum256([]byte(auth.email))
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            f.issuer,
+		"sub":            "fake-" + hex.EncodeToString(sum[:8]),
+		"aud":            clientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(fakeIssuerTokenTTL).Unix(),
+		"nonce":          auth.nonce,
+		"email":          auth.email,
+		"email_verified": auth.emailVerified,
+		"name":           auth.name,
+	})
+	token.Header["kid"] = f.key.ID
+	idToken, err := token.SignedString(f.key.PrivateKey)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	accessToken, _ := randomToken(24)
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   int(fakeIssuerTokenTTL.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,412 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	pkgjwt "github.com/anychat/server/pkg/jwt"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	discoveryPath     = "/.well-known/openid-configuration"
+	discoveryCacheTTL = time.Hour
+	idTokenLeeway     = time.Minute
+)
+
+// supportedAlgorithms ID token signature algorithms this relying party verifies
+var supportedAlgorithms = []string{"RS256", "ES256", "EdDSA"}
+
+// ProviderConfig one OpenID Connect identity provider
+type ProviderConfig struct {
+	Name         string // key used in URLs and user_identities.provider
+	DisplayName  string // shown on the login button
+	Issuer       string // must match the iss of the discovery document and ID tokens
+	ClientID     string
+	ClientSecret string   // empty for public clients, PKCE still protects the code
+	RedirectURLs []string // redirect URIs registered at the provider, the first one is the default
+	Scopes       []string // default openid email profile
+	AutoRegister bool     // create an account for an identity that is not linked yet
+	LinkByEmail  bool     // link an unknown identity to the account with the same verified email
+	HTTPTimeout  time.Duration
+}
+
+// Provider OpenID Connect relying party for one provider: authorization code flow with PKCE,
+// ID token verification against the discovery document and its JWKS
+type Provider struct {
+	config ProviderConfig
+	client *http.Client
+
+	mu        sync.Mutex
+	discovery *discoveryDocument
+	fetchedAt time.Time
+	jwks      *pkgjwt.JWKSClient
+}
+
+// discoveryDocument members of the provider metadata used by the relying party
+type discoveryDocument struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+// AuthRequest a started authorization, everything but URL must be kept until the callback
+type AuthRequest struct {
+	URL          string
+	State        string
+	Nonce        string
+	CodeVerifier string
+	RedirectURI  string
+}
+
+// Identity verified claims of an ID token
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
+// idTokenClaims ID token claims
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string      `json:"nonce"`
+	AuthorizedParty   string      `json:"azp"`
+	Email             string      `json:"email"`
+	EmailVerified     interface{} `json:"email_verified"` // some providers send "true" as a string
+	Name              string      `json:"name"`
+	PreferredUsername string      `json:"preferred_username"`
+	Picture           string      `json:"picture"`
+}
+
+// NewProvider creates a provider, the discovery document is fetched on first use
+func NewProvider(config ProviderConfig) (*Provider, error) {
+	config.Name = strings.TrimSpace(config.Name)
+	config.Issuer = strings.TrimRight(strings.TrimSpace(config.Issuer), "/")
+	if config.Name == "" {
+		return nil, fmt.Errorf("oidc provider name is required")
+	}
+	if config.Issuer == "" || config.ClientID == "" {
+		return nil, fmt.Errorf("oidc provider %s: issuer and client id are required", config.Name)
+	}
+	if len(config.RedirectURLs) == 0 {
+		return nil, fmt.Errorf("oidc provider %s: at least one redirect url is required", config.Name)
+	}
+	if config.DisplayName == "" {
+		config.DisplayName = config.Name
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	if !slices.Contains(config.Scopes, "openid") {
+		config.Scopes = append([]string{"openid"}, config.Scopes...)
+	}
+	if config.HTTPTimeout == 0 {
+		config.HTTPTimeout = 10 * time.Second
+	}
+	return &Provider{
+		config: config,
+		client: &http.Client{Timeout: config.HTTPTimeout},
+	}, nil
+}
+
+// Name returns provider name
+func (p *Provider) Name() string {
+	return p.config.Name
+}
+
+// Config returns provider configuration
+func (p *Provider) Config() ProviderConfig {
+	return p.config
+}
+
+// ResolveRedirectURI returns the requested redirect URI if it is registered, or the default one
+func (p *Provider) ResolveRedirectURI(requested string) (string, error) {
+	if requested == "" {
+		return p.config.RedirectURLs[0], nil
+	}
+	if !slices.Contains(p.config.RedirectURLs, requested) {
+		return "", fmt.Errorf("redirect uri is not registered for provider %s", p.config.Name)
+	}
+	return requested, nil
+}
+
+// AuthCodeURL starts an authorization: fresh state, nonce and PKCE verifier (S256)
+func (p *Provider) AuthCodeURL(ctx context.Context, redirectURI string) (*AuthRequest, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := randomToken(24)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomToken(24)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := randomToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.config.ClientID},
+		"redirect_uri":          {redirectURI},
+		"scope":                 {strings.Join(p.config.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+	separator := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return &AuthRequest{
+		URL:          doc.AuthorizationEndpoint + separator + query.Encode(),
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		RedirectURI:  redirectURI,
+	}, nil
+}
+
+// Exchange redeems an authorization code and verifies the returned ID token
+func (p *Provider) Exchange(ctx context.Context, code, redirectURI, codeVerifier, nonce string) (*Identity, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {codeVerifier},
+	}
+	basicAuth := p.config.ClientSecret != "" && p.usesBasicAuth(doc)
+	if !basicAuth {
+		form.Set("client_id", p.config.ClientID)
+		if p.config.ClientSecret != "" {
+			form.Set("client_secret", p.config.ClientSecret)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if basicAuth {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read token response failed: %w", err)
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decode token response failed (status %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || result.Error != "" {
+		return nil, fmt.Errorf("token request rejected (status %d): %s %s", resp.StatusCode, result.Error, result.ErrorDescription)
+	}
+	if result.IDToken == "" {
+		return nil, fmt.Errorf("token response has no id_token")
+	}
+
+	return p.VerifyIDToken(ctx, result.IDToken, nonce)
+}
+
+// VerifyIDToken checks signature, issuer, audience, expiry and nonce of an ID token
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*Identity, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jwks := p.jwksClient()
+
+	algorithms := allowedAlgorithms(doc)
+	claims := &idTokenClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := jwks.VerificationKey(kid)
+		if err != nil {
+			return nil, err
+		}
+		// a key that names its algorithm only verifies that algorithm
+		if key.Algorithm != "" && key.Algorithm != token.Method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key.PublicKey, nil
+	},
+		jwt.WithValidMethods(algorithms),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(idTokenLeeway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+
+	if claims.ExpiresAt == nil {
+		return nil, fmt.Errorf("invalid id token: missing exp")
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("invalid id token: missing sub")
+	}
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("invalid id token: nonce mismatch")
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.config.ClientID {
+		return nil, fmt.Errorf("invalid id token: azp mismatch")
+	}
+
+	name := claims.Name
+	if name == "" {
+		name = claims.PreferredUsername
+	}
+	return &Identity{
+		Subject:       claims.Subject,
+		Email:         strings.ToLower(strings.TrimSpace(claims.Email)),
+		EmailVerified: isTrue(claims.EmailVerified),
+		Name:          name,
+		Picture:       claims.Picture,
+	}, nil
+}
+
+// discover returns the cached discovery document, refetching it once an hour.
+// A stale document is kept when the provider is unreachable
+func (p *Provider) discover(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil && time.Since(p.fetchedAt) < discoveryCacheTTL {
+		return p.discovery, nil
+	}
+
+	doc, err := p.fetchDiscovery(ctx)
+	if err != nil {
+		if p.discovery != nil {
+			return p.discovery, nil
+		}
+		return nil, err
+	}
+	if p.discovery == nil || p.discovery.JWKSURI != doc.JWKSURI {
+		p.jwks = pkgjwt.NewJWKSClient(pkgjwt.JWKSClientConfig{URL: doc.JWKSURI, HTTPTimeout: p.config.HTTPTimeout})
+	}
+	p.discovery = doc
+	p.fetchedAt = time.Now()
+	return doc, nil
+}
+
+func (p *Provider) fetchDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.Issuer+discoveryPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch oidc discovery failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch oidc discovery failed: status %d", resp.StatusCode)
+	}
+
+	var doc discoveryDocument
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decode oidc discovery failed: %w", err)
+	}
+	// the issuer in the document must be exactly the configured one (OIDC Discovery 4.3)
+	if strings.TrimRight(doc.Issuer, "/") != p.config.Issuer {
+		return nil, fmt.Errorf("oidc discovery issuer mismatch: %s", doc.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc discovery document is incomplete")
+	}
+	return &doc, nil
+}
+
+func (p *Provider) jwksClient() *pkgjwt.JWKSClient {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.jwks
+}
+
+// usesBasicAuth client_secret_basic is the default unless the provider only supports client_secret_post
+func (p *Provider) usesBasicAuth(doc *discoveryDocument) bool {
+	methods := doc.TokenEndpointAuthMethodsSupported
+	return slices.Contains(methods, "client_secret_basic") || !slices.Contains(methods, "client_secret_post")
+}
+
+// allowedAlgorithms algorithms the provider advertises that we can verify, RS256 when it advertises none
+func allowedAlgorithms(doc *discoveryDocument) []string {
+	if len(doc.IDTokenSigningAlgValuesSupported) == 0 {
+		return []string{"RS256"}
+	}
+	algorithms := make([]string, 0, len(supportedAlgorithms))
+	for _, alg := range doc.IDTokenSigningAlgValuesSupported {
+		if slices.Contains(supportedAlgorithms, alg) {
+			algorithms = append(algorithms, alg)
+		}
+	}
+	return algorithms
+}
+
+// codeChallenge PKCE S256 challenge of a verifier
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func isTrue(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return strings.EqualFold(v, "true")
+	default:
+		return false
+	}
+}
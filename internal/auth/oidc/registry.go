@@ -0,0 +1,34 @@
+package oidc
+
+// Registry configured providers in display order
+type Registry struct {
+	providers []*Provider
+	byName    map[string]*Provider
+}
+
+// NewRegistry creates a provider registry
+func NewRegistry(providers ...*Provider) *Registry {
+	r := &Registry{byName: make(map[string]*Provider, len(providers))}
+	for _, provider := range providers {
+		r.providers = append(r.providers, provider)
+		r.byName[provider.Name()] = provider
+	}
+	return r
+}
+
+// Get returns the provider with the given name
+func (r *Registry) Get(name string) (*Provider, bool) {
+	if r == nil {
+		return nil, false
+	}
+	provider, ok := r.byName[name]
+	return provider, ok
+}
+
+// List returns all providers
+func (r *Registry) List() []*Provider {
+	if r == nil {
+		return nil
+	}
+	return r.providers
+}
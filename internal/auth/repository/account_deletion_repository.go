@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+	"gorm.io/gorm"
+)
+
+// AccountDeletionRepository account deletion repository interface
+type AccountDeletionRepository interface {
+	Create(ctx context.Context, deletion *model.AccountDeletion) error
+	// GetOpenByUserID gets the pending or erasing deletion of the user
+	GetOpenByUserID(ctx context.Context, userID string) (*model.AccountDeletion, error)
+	GetLatestByUserID(ctx context.Context, userID string) (*model.AccountDeletion, error)
+	// Cancel cancels a pending deletion, returns false once erasure has started
+	Cancel(ctx context.Context, id int64) (bool, error)
+	// ListDue lists open deletions whose grace period ended, oldest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*model.AccountDeletion, error)
+	// MarkErasing moves a pending deletion to erasing, returns false if it was cancelled meanwhile
+	MarkErasing(ctx context.Context, id int64) (bool, error)
+	RecordFailure(ctx context.Context, id int64, lastError string) error
+	MarkCompleted(ctx context.Context, id int64) error
+	AddLog(ctx context.Context, log *model.AccountDeletionLog) error
+	// ListCompletedSteps lists services whose erasure step already succeeded
+	ListCompletedSteps(ctx context.Context, deletionID int64) ([]string, error)
+	// EraseAuthData deletes sessions, devices, identities, MFA and login history of the user,
+	// clears its phone, email and password and soft deletes the user
+	EraseAuthData(ctx context.Context, userID string) (map[string]int64, error)
+}
+
+// accountDeletionRepositoryImpl account deletion repository implementation
+type accountDeletionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAccountDeletionRepository creates account deletion repository
+func NewAccountDeletionRepository(db *gorm.DB) AccountDeletionRepository {
+	return &accountDeletionRepositoryImpl{db: db}
+}
+
+// Create creates deletion request
+func (r *accountDeletionRepositoryImpl) Create(ctx context.Context, deletion *model.AccountDeletion) error {
+	return r.db.WithContext(ctx).Create(deletion).Error
+}
+
+// GetOpenByUserID gets the open deletion of the user
+func (r *accountDeletionRepositoryImpl) GetOpenByUserID(ctx context.Context, userID string) (*model.AccountDeletion, error) {
+	var deletion model.AccountDeletion
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status IN ?", userID, []model.AccountDeletionStatus{model.AccountDeletionPending, model.AccountDeletionErasing}).
+		First(&deletion).Error
+	if err != nil {
+		return nil, err
+	}
+	return &deletion, nil
+}
+
+// GetLatestByUserID gets the latest deletion of the user
+func (r *accountDeletionRepositoryImpl) GetLatestByUserID(ctx context.Context, userID string) (*model.AccountDeletion, error) {
+	var deletion model.AccountDeletion
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id DESC").
+		First(&deletion).Error
+	if err != nil {
+		return nil, err
+	}
+	return &deletion, nil
+}
+
+// Cancel cancels a pending deletion
+func (r *accountDeletionRepositoryImpl) Cancel(ctx context.Context, id int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.AccountDeletion{}).
+		Where("id = ? AND status = ?", id, model.AccountDeletionPending).
+		Updates(map[string]interface{}{
+			"status":       model.AccountDeletionCancelled,
+			"cancelled_at": time.Now(),
+			"updated_at":   time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListDue lists deletions ready for erasure
+func (r *accountDeletionRepositoryImpl) ListDue(ctx context.Context, now time.Time, limit int) ([]*model.AccountDeletion, error) {
+	var deletions []*model.AccountDeletion
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND erase_after <= ?", []model.AccountDeletionStatus{model.AccountDeletionPending, model.AccountDeletionErasing}, now).
+		Order("erase_after ASC, id ASC").
+		Limit(limit).
+		Find(&deletions).Error
+	return deletions, err
+}
+
+// MarkErasing starts erasure of a pending deletion
+func (r *accountDeletionRepositoryImpl) MarkErasing(ctx context.Context, id int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.AccountDeletion{}).
+		Where("id = ? AND status IN ?", id, []model.AccountDeletionStatus{model.AccountDeletionPending, model.AccountDeletionErasing}).
+		Updates(map[string]interface{}{
+			"status":     model.AccountDeletionErasing,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// RecordFailure records a failed erasure run, the next run retries the remaining steps
+func (r *accountDeletionRepositoryImpl) RecordFailure(ctx context.Context, id int64, lastError string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.AccountDeletion{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": lastError,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// MarkCompleted marks deletion completed
+func (r *accountDeletionRepositoryImpl) MarkCompleted(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).
+		Model(&model.AccountDeletion{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       model.AccountDeletionCompleted,
+			"completed_at": time.Now(),
+			"last_error":   "",
+			"updated_at":   time.Now(),
+		}).Error
+}
+
+// AddLog appends an audit entry
+func (r *accountDeletionRepositoryImpl) AddLog(ctx context.Context, log *model.AccountDeletionLog) error {
+	return r.db.WithContext(ctx).Create(log).Error
+}
+
+// ListCompletedSteps lists erased services of a deletion
+func (r *accountDeletionRepositoryImpl) ListCompletedSteps(ctx context.Context, deletionID int64) ([]string, error) {
+	var steps []string
+	err := r.db.WithContext(ctx).
+		Model(&model.AccountDeletionLog{}).
+		Where("deletion_id = ? AND action = ?", deletionID, model.DeletionActionStepCompleted).
+		Order("id ASC").
+		Pluck("service", &steps).Error
+	return steps, err
+}
+
+// EraseAuthData erases auth data of the user in one transaction
+func (r *accountDeletionRepositoryImpl) EraseAuthData(ctx context.Context, userID string) (map[string]int64, error) {
+	erased := make(map[string]int64, 8)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for key, row := range map[string]interface{}{
+			"sessions":       &model.UserSession{},
+			"refresh_tokens": &model.RefreshToken{},
+			"devices":        &model.UserDevice{},
+			"identities":     &model.UserIdentity{},
+			"mfa":            &model.UserMFA{},
+			"recovery_codes": &model.UserRecoveryCode{},
+			"login_history":  &model.UserLoginHistory{},
+		} {
+			result := tx.Where("user_id = ?", userID).Delete(row)
+			if result.Error != nil {
+				return result.Error
+			}
+			erased[key] = result.RowsAffected
+		}
+
+		// phone and email become free for new accounts, the soft deleted row keeps the user ID reserved
+		result := tx.Model(&model.User{}).
+			Where("id = ?", userID).
+			Updates(map[string]interface{}{
+				"phone":         nil,
+				"email":         nil,
+				"password_hash": "",
+				"status":        model.UserStatusDisabled,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result = tx.Where("id = ?", userID).Delete(&model.User{}); result.Error != nil {
+			return result.Error
+		}
+		erased["users"] = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return erased, nil
+}
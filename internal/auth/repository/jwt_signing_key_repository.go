@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+	"gorm.io/gorm"
+)
+
+// jwtKeyRotationLockID advisory lock held while rotating keys, so only one replica rotates at a time
+const jwtKeyRotationLockID = 727001
+
+// JWTSigningKeyRepository JWT signing key repository interface
+type JWTSigningKeyRepository interface {
+	Create(ctx context.Context, key *model.JWTSigningKey) error
+	List(ctx context.Context) ([]*model.JWTSigningKey, error)
+	Update(ctx context.Context, key *model.JWTSigningKey) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+	// WithRotationLock runs fn in a transaction holding the rotation lock, returns false without running fn if another replica holds it
+	WithRotationLock(ctx context.Context, fn func(repo JWTSigningKeyRepository) error) (bool, error)
+}
+
+// jwtSigningKeyRepositoryImpl JWT signing key repository implementation
+type jwtSigningKeyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewJWTSigningKeyRepository creates JWT signing key repository
+func NewJWTSigningKeyRepository(db *gorm.DB) JWTSigningKeyRepository {
+	return &jwtSigningKeyRepositoryImpl{db: db}
+}
+
+// Create creates signing key
+func (r *jwtSigningKeyRepositoryImpl) Create(ctx context.Context, key *model.JWTSigningKey) error {
+	return r.db.WithContext(ctx).Create(key).Error
+}
+
+// List lists all signing keys, newest first
+func (r *jwtSigningKeyRepositoryImpl) List(ctx context.Context) ([]*model.JWTSigningKey, error) {
+	var keys []*model.JWTSigningKey
+	err := r.db.WithContext(ctx).
+		Order("created_at DESC").
+		Find(&keys).Error
+	return keys, err
+}
+
+// Update updates signing key
+func (r *jwtSigningKeyRepositoryImpl) Update(ctx context.Context, key *model.JWTSigningKey) error {
+	return r.db.WithContext(ctx).Save(key).Error
+}
+
+// DeleteExpired deletes retired keys past their expiry
+func (r *jwtSigningKeyRepositoryImpl) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at < ?", model.SigningKeyStatusRetired, now).
+		Delete(&model.JWTSigningKey{})
+	return result.RowsAffected, result.Error
+}
+
+// WithRotationLock runs fn holding a transaction-scoped advisory lock
+func (r *jwtSigningKeyRepositoryImpl) WithRotationLock(ctx context.Context, fn func(repo JWTSigningKeyRepository) error) (bool, error) {
+	locked := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", jwtKeyRotationLockID).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		return fn(&jwtSigningKeyRepositoryImpl{db: tx})
+	})
+	return locked, err
+}
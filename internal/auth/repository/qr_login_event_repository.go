@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/anychat/server/internal/auth/model"
+	"gorm.io/gorm"
+)
+
+// QRLoginEventRepository QR login audit repository interface
+type QRLoginEventRepository interface {
+	Create(ctx context.Context, event *model.QRLoginEvent) error
+}
+
+// qrLoginEventRepositoryImpl QR login audit repository implementation
+type qrLoginEventRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewQRLoginEventRepository creates QR login audit repository
+func NewQRLoginEventRepository(db *gorm.DB) QRLoginEventRepository {
+	return &qrLoginEventRepositoryImpl{db: db}
+}
+
+// Create creates audit record
+func (r *qrLoginEventRepositoryImpl) Create(ctx context.Context, event *model.QRLoginEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
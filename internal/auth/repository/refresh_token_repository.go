@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+	"gorm.io/gorm"
+)
+
+// RefreshTokenRepository refresh token history repository interface
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *model.RefreshToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+	// MarkRotated marks token as exchanged, returns false if it was already rotated
+	MarkRotated(ctx context.Context, id int64) (bool, error)
+	DeleteExpiredByFamilyID(ctx context.Context, familyID string) error
+}
+
+// refreshTokenRepositoryImpl refresh token repository implementation
+type refreshTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository creates refresh token repository
+func NewRefreshTokenRepository(db *gorm.DB) RefreshTokenRepository {
+	return &refreshTokenRepositoryImpl{db: db}
+}
+
+// Create creates refresh token record
+func (r *refreshTokenRepositoryImpl) Create(ctx context.Context, token *model.RefreshToken) error {
+	return r.db.WithContext(ctx).Create(token).Error
+}
+
+// GetByTokenHash gets refresh token by hash
+func (r *refreshTokenRepositoryImpl) GetByTokenHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	var token model.RefreshToken
+	err := r.db.WithContext(ctx).
+		Where("token_hash = ?", tokenHash).
+		First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// MarkRotated marks token as exchanged
+func (r *refreshTokenRepositoryImpl) MarkRotated(ctx context.Context, id int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.RefreshToken{}).
+		Where("id = ? AND rotated_at IS NULL", id).
+		Update("rotated_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteExpiredByFamilyID prunes expired tokens of a family, they fail JWT validation before reuse detection anyway
+func (r *refreshTokenRepositoryImpl) DeleteExpiredByFamilyID(ctx context.Context, familyID string) error {
+	return r.db.WithContext(ctx).
+		Where("family_id = ? AND expires_at < ?", familyID, time.Now()).
+		Delete(&model.RefreshToken{}).Error
+}
@@ -14,7 +14,9 @@ type UserDeviceRepository interface {
 	GetByUserID(ctx context.Context, userID string) ([]*model.UserDevice, error)
 	GetByUserIDAndDeviceType(ctx context.Context, userID string, deviceType model.DeviceType) ([]*model.UserDevice, error)
 	Update(ctx context.Context, device *model.UserDevice) error
-	UpdateLastLogin(ctx context.Context, userID, deviceID, ip string) error
+	UpdateLastLogin(ctx context.Context, userID, deviceID, ip, clientVersion string) error
+	UpdateLastActive(ctx context.Context, userID, deviceID string) error
+	UpdateName(ctx context.Context, userID, deviceID, name string) error
 }
 
 // userDeviceRepositoryImpl user device repository implementation
@@ -76,12 +78,30 @@ func (r *userDeviceRepositoryImpl) Update(ctx context.Context, device *model.Use
 }
 
 // UpdateLastLogin updates last login info
-func (r *userDeviceRepositoryImpl) UpdateLastLogin(ctx context.Context, userID, deviceID, ip string) error {
+func (r *userDeviceRepositoryImpl) UpdateLastLogin(ctx context.Context, userID, deviceID, ip, clientVersion string) error {
 	return r.db.WithContext(ctx).
 		Model(&model.UserDevice{}).
 		Where("user_id = ? AND device_id = ?", userID, deviceID).
 		Updates(map[string]interface{}{
-			"last_login_at": gorm.Expr("NOW()"),
-			"last_login_ip": ip,
+			"last_login_at":  gorm.Expr("NOW()"),
+			"last_active_at": gorm.Expr("NOW()"),
+			"last_login_ip":  ip,
+			"client_version": clientVersion,
 		}).Error
 }
+
+// UpdateLastActive records activity of a signed-in device (token refresh)
+func (r *userDeviceRepositoryImpl) UpdateLastActive(ctx context.Context, userID, deviceID string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.UserDevice{}).
+		Where("user_id = ? AND device_id = ?", userID, deviceID).
+		Update("last_active_at", gorm.Expr("NOW()")).Error
+}
+
+// UpdateName renames a device
+func (r *userDeviceRepositoryImpl) UpdateName(ctx context.Context, userID, deviceID, name string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.UserDevice{}).
+		Where("user_id = ? AND device_id = ?", userID, deviceID).
+		Update("name", name).Error
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+	"gorm.io/gorm"
+)
+
+// UserIdentityRepository linked OIDC identity repository interface
+type UserIdentityRepository interface {
+	Create(ctx context.Context, identity *model.UserIdentity) error
+	// CreateWithUser creates a new user together with its first identity
+	CreateWithUser(ctx context.Context, user *model.User, identity *model.UserIdentity) error
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*model.UserIdentity, error)
+	GetByUserIDAndProvider(ctx context.Context, userID, provider string) (*model.UserIdentity, error)
+	ListByUserID(ctx context.Context, userID string) ([]*model.UserIdentity, error)
+	UpdateLastLogin(ctx context.Context, id int64) error
+	Delete(ctx context.Context, userID, provider string) error
+}
+
+// userIdentityRepositoryImpl linked OIDC identity repository implementation
+type userIdentityRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewUserIdentityRepository creates linked identity repository
+func NewUserIdentityRepository(db *gorm.DB) UserIdentityRepository {
+	return &userIdentityRepositoryImpl{db: db}
+}
+
+// Create links an identity
+func (r *userIdentityRepositoryImpl) Create(ctx context.Context, identity *model.UserIdentity) error {
+	return r.db.WithContext(ctx).Create(identity).Error
+}
+
+// CreateWithUser creates user and identity in one transaction, so a lost race on the identity leaves no orphan user
+func (r *userIdentityRepositoryImpl) CreateWithUser(ctx context.Context, user *model.User, identity *model.UserIdentity) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		identity.UserID = user.ID
+		return tx.Create(identity).Error
+	})
+}
+
+// GetByProviderSubject gets identity by provider and subject
+func (r *userIdentityRepositoryImpl) GetByProviderSubject(ctx context.Context, provider, subject string) (*model.UserIdentity, error) {
+	var identity model.UserIdentity
+	err := r.db.WithContext(ctx).
+		Where("provider = ? AND subject = ?", provider, subject).
+		First(&identity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// GetByUserIDAndProvider gets the identity a user linked for a provider
+func (r *userIdentityRepositoryImpl) GetByUserIDAndProvider(ctx context.Context, userID, provider string) (*model.UserIdentity, error) {
+	var identity model.UserIdentity
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND provider = ?", userID, provider).
+		First(&identity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// ListByUserID lists identities linked to a user
+func (r *userIdentityRepositoryImpl) ListByUserID(ctx context.Context, userID string) ([]*model.UserIdentity, error) {
+	var identities []*model.UserIdentity
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&identities).Error
+	return identities, err
+}
+
+// UpdateLastLogin records a sign-in through the identity
+func (r *userIdentityRepositoryImpl) UpdateLastLogin(ctx context.Context, id int64) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).
+		Model(&model.UserIdentity{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"last_login_at": now,
+			"updated_at":    now,
+		}).Error
+}
+
+// Delete unlinks the identity of a provider
+func (r *userIdentityRepositoryImpl) Delete(ctx context.Context, userID, provider string) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND provider = ?", userID, provider).
+		Delete(&model.UserIdentity{}).Error
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+	"gorm.io/gorm"
+)
+
+// UserLoginHistoryRepository login history repository interface
+type UserLoginHistoryRepository interface {
+	Create(ctx context.Context, history *model.UserLoginHistory) error
+	GetByID(ctx context.Context, id int64) (*model.UserLoginHistory, error)
+	// ListByUserID returns the latest entries first
+	ListByUserID(ctx context.Context, userID string, limit int) ([]*model.UserLoginHistory, error)
+	// CountSuccessSince counts successful logins since the given time
+	CountSuccessSince(ctx context.Context, userID string, since time.Time) (int64, error)
+	// HasSuccessFromPrefix reports whether the user signed in from the network since the given time
+	HasSuccessFromPrefix(ctx context.Context, userID, ipPrefix string, since time.Time) (bool, error)
+	// HasSuccessFromCountry reports whether the user signed in from the country since the given time
+	HasSuccessFromCountry(ctx context.Context, userID, country string, since time.Time) (bool, error)
+	MarkReported(ctx context.Context, id int64) error
+}
+
+// userLoginHistoryRepositoryImpl login history repository implementation
+type userLoginHistoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewUserLoginHistoryRepository creates login history repository
+func NewUserLoginHistoryRepository(db *gorm.DB) UserLoginHistoryRepository {
+	return &userLoginHistoryRepositoryImpl{db: db}
+}
+
+// Create records a login attempt
+func (r *userLoginHistoryRepositoryImpl) Create(ctx context.Context, history *model.UserLoginHistory) error {
+	return r.db.WithContext(ctx).Create(history).Error
+}
+
+// GetByID gets entry by ID
+func (r *userLoginHistoryRepositoryImpl) GetByID(ctx context.Context, id int64) (*model.UserLoginHistory, error) {
+	var history model.UserLoginHistory
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+// ListByUserID lists the latest entries of a user
+func (r *userLoginHistoryRepositoryImpl) ListByUserID(ctx context.Context, userID string, limit int) ([]*model.UserLoginHistory, error) {
+	var entries []*model.UserLoginHistory
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&entries).Error
+	return entries, err
+}
+
+// CountSuccessSince counts successful logins since the given time
+func (r *userLoginHistoryRepositoryImpl) CountSuccessSince(ctx context.Context, userID string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.UserLoginHistory{}).
+		Where("user_id = ? AND result = ? AND created_at >= ?", userID, model.LoginResultSuccess, since).
+		Count(&count).Error
+	return count, err
+}
+
+// HasSuccessFromPrefix reports whether the user signed in from the network since the given time
+func (r *userLoginHistoryRepositoryImpl) HasSuccessFromPrefix(ctx context.Context, userID, ipPrefix string, since time.Time) (bool, error) {
+	return r.existsSuccess(ctx, userID, since, "ip_prefix = ?", ipPrefix)
+}
+
+// HasSuccessFromCountry reports whether the user signed in from the country since the given time
+func (r *userLoginHistoryRepositoryImpl) HasSuccessFromCountry(ctx context.Context, userID, country string, since time.Time) (bool, error) {
+	return r.existsSuccess(ctx, userID, since, "country = ?", country)
+}
+
+// MarkReported records that the user reported the login as not theirs
+func (r *userLoginHistoryRepositoryImpl) MarkReported(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).
+		Model(&model.UserLoginHistory{}).
+		Where("id = ?", id).
+		Update("reported_at", time.Now()).Error
+}
+
+func (r *userLoginHistoryRepositoryImpl) existsSuccess(ctx context.Context, userID string, since time.Time, query string, arg interface{}) (bool, error) {
+	var ids []int64
+	err := r.db.WithContext(ctx).
+		Model(&model.UserLoginHistory{}).
+		Where("user_id = ? AND result = ? AND created_at >= ?", userID, model.LoginResultSuccess, since).
+		Where(query, arg).
+		Limit(1).
+		Pluck("id", &ids).Error
+	return len(ids) > 0, err
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserMFARepository user MFA repository interface
+type UserMFARepository interface {
+	GetByUserID(ctx context.Context, userID string) (*model.UserMFA, error)
+	SavePending(ctx context.Context, userID, secretEncrypted string) error
+	Enable(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error
+	UpdateLastUsedStep(ctx context.Context, userID string, step int64) (bool, error)
+	Delete(ctx context.Context, userID string) error
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID string) (int64, error)
+}
+
+// userMFARepositoryImpl user MFA repository implementation
+type userMFARepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewUserMFARepository creates user MFA repository
+func NewUserMFARepository(db *gorm.DB) UserMFARepository {
+	return &userMFARepositoryImpl{db: db}
+}
+
+// GetByUserID gets MFA settings by user ID
+func (r *userMFARepositoryImpl) GetByUserID(ctx context.Context, userID string) (*model.UserMFA, error) {
+	var mfa model.UserMFA
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&mfa).Error
+	if err != nil {
+		return nil, err
+	}
+	return &mfa, nil
+}
+
+// SavePending stores a not yet confirmed secret, replacing any previous pending enrollment
+func (r *userMFARepositoryImpl) SavePending(ctx context.Context, userID, secretEncrypted string) error {
+	mfa := &model.UserMFA{
+		UserID:          userID,
+		SecretEncrypted: secretEncrypted,
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"secret_encrypted": secretEncrypted, "enabled": false, "enabled_at": nil, "last_used_step": 0, "updated_at": time.Now()}),
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Eq{Column: clause.Column{Table: "user_mfa", Name: "enabled"}, Value: false}}},
+	}).Create(mfa).Error
+}
+
+// Enable enables MFA and stores the initial recovery codes
+func (r *userMFARepositoryImpl) Enable(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&model.UserMFA{}).
+			Where("user_id = ? AND enabled = ?", userID, false).
+			Updates(map[string]interface{}{
+				"enabled":        true,
+				"enabled_at":     now,
+				"last_used_step": step,
+				"updated_at":     now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return replaceRecoveryCodes(tx, userID, recoveryCodeHashes)
+	})
+}
+
+// UpdateLastUsedStep advances the last accepted time step, returns false if step was already used
+func (r *userMFARepositoryImpl) UpdateLastUsedStep(ctx context.Context, userID string, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.UserMFA{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Updates(map[string]interface{}{
+			"last_used_step": step,
+			"updated_at":     time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Delete removes MFA settings and recovery codes
+func (r *userMFARepositoryImpl) Delete(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&model.UserRecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&model.UserMFA{}).Error
+	})
+}
+
+// ReplaceRecoveryCodes invalidates existing recovery codes and stores new ones
+func (r *userMFARepositoryImpl) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, codeHashes)
+	})
+}
+
+// UseRecoveryCode marks an unused recovery code as used, returns false if no unused code matches
+func (r *userMFARepositoryImpl) UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CountUnusedRecoveryCodes counts remaining recovery codes
+func (r *userMFARepositoryImpl) CountUnusedRecoveryCodes(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.UserRecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID string, codeHashes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&model.UserRecoveryCode{}).Error; err != nil {
+		return err
+	}
+	if len(codeHashes) == 0 {
+		return nil
+	}
+	codes := make([]*model.UserRecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, &model.UserRecoveryCode{UserID: userID, CodeHash: hash})
+	}
+	return tx.Create(&codes).Error
+}
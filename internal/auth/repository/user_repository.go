@@ -19,6 +19,7 @@ type UserRepository interface {
 	UpdateEmail(ctx context.Context, userID string, email *string) error
 	UpdatePassword(ctx context.Context, userID, passwordHash string) error
 	UpdateStatus(ctx context.Context, userID string, status int) error
+	SetPasswordResetRequired(ctx context.Context, userID string, required bool) error
 }
 
 // userRepositoryImpl user repository implementation
@@ -114,3 +115,11 @@ func (r *userRepositoryImpl) UpdateStatus(ctx context.Context, userID string, st
 		Where("id = ?", userID).
 		Update("status", status).Error
 }
+
+// SetPasswordResetRequired sets or clears the password reset requirement
+func (r *userRepositoryImpl) SetPasswordResetRequired(ctx context.Context, userID string, required bool) error {
+	return r.db.WithContext(ctx).
+		Model(&model.User{}).
+		Where("id = ?", userID).
+		Update("password_reset_required", required).Error
+}
@@ -10,10 +10,12 @@ import (
 // UserSessionRepository user session repository interface
 type UserSessionRepository interface {
 	Create(ctx context.Context, session *model.UserSession) error
-	GetByAccessToken(ctx context.Context, accessToken string) (*model.UserSession, error)
-	GetByRefreshToken(ctx context.Context, refreshToken string) (*model.UserSession, error)
+	GetByAccessTokenHash(ctx context.Context, accessTokenHash string) (*model.UserSession, error)
+	GetByFamilyID(ctx context.Context, familyID string) (*model.UserSession, error)
 	GetByUserIDAndDeviceID(ctx context.Context, userID, deviceID string) (*model.UserSession, error)
+	GetByUserID(ctx context.Context, userID string) ([]*model.UserSession, error)
 	Update(ctx context.Context, session *model.UserSession) error
+	DeleteByFamilyID(ctx context.Context, familyID string) error
 	DeleteByUserIDAndDeviceID(ctx context.Context, userID, deviceID string) error
 	DeleteByUserID(ctx context.Context, userID string) error
 	DeleteByUserIDExceptDeviceID(ctx context.Context, userID, deviceID string) error
@@ -34,11 +36,11 @@ func (r *userSessionRepositoryImpl) Create(ctx context.Context, session *model.U
 	return r.db.WithContext(ctx).Create(session).Error
 }
 
-// GetByAccessToken gets session by access token
-func (r *userSessionRepositoryImpl) GetByAccessToken(ctx context.Context, accessToken string) (*model.UserSession, error) {
+// GetByAccessTokenHash gets session by access token hash
+func (r *userSessionRepositoryImpl) GetByAccessTokenHash(ctx context.Context, accessTokenHash string) (*model.UserSession, error) {
 	var session model.UserSession
 	err := r.db.WithContext(ctx).
-		Where("access_token = ?", accessToken).
+		Where("access_token_hash = ?", accessTokenHash).
 		First(&session).Error
 	if err != nil {
 		return nil, err
@@ -46,11 +48,11 @@ func (r *userSessionRepositoryImpl) GetByAccessToken(ctx context.Context, access
 	return &session, nil
 }
 
-// GetByRefreshToken gets session by refresh token
-func (r *userSessionRepositoryImpl) GetByRefreshToken(ctx context.Context, refreshToken string) (*model.UserSession, error) {
+// GetByFamilyID gets session owning a refresh token family
+func (r *userSessionRepositoryImpl) GetByFamilyID(ctx context.Context, familyID string) (*model.UserSession, error) {
 	var session model.UserSession
 	err := r.db.WithContext(ctx).
-		Where("refresh_token = ?", refreshToken).
+		Where("family_id = ?", familyID).
 		First(&session).Error
 	if err != nil {
 		return nil, err
@@ -70,30 +72,59 @@ func (r *userSessionRepositoryImpl) GetByUserIDAndDeviceID(ctx context.Context,
 	return &session, nil
 }
 
+// GetByUserID gets all sessions for user
+func (r *userSessionRepositoryImpl) GetByUserID(ctx context.Context, userID string) ([]*model.UserSession, error) {
+	var sessions []*model.UserSession
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Find(&sessions).Error
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
 // Update updates session
 func (r *userSessionRepositoryImpl) Update(ctx context.Context, session *model.UserSession) error {
 	return r.db.WithContext(ctx).Save(session).Error
 }
 
+// DeleteByFamilyID deletes the session owning a refresh token family
+func (r *userSessionRepositoryImpl) DeleteByFamilyID(ctx context.Context, familyID string) error {
+	return r.deleteWithTokens(ctx, "family_id = ?", familyID)
+}
+
 // DeleteByUserIDAndDeviceID deletes session for specified device
 func (r *userSessionRepositoryImpl) DeleteByUserIDAndDeviceID(ctx context.Context, userID, deviceID string) error {
-	return r.db.WithContext(ctx).
-		Where("user_id = ? AND device_id = ?", userID, deviceID).
-		Delete(&model.UserSession{}).Error
+	return r.deleteWithTokens(ctx, "user_id = ? AND device_id = ?", userID, deviceID)
 }
 
 // DeleteByUserID deletes all sessions for user
 func (r *userSessionRepositoryImpl) DeleteByUserID(ctx context.Context, userID string) error {
-	return r.db.WithContext(ctx).
-		Where("user_id = ?", userID).
-		Delete(&model.UserSession{}).Error
+	return r.deleteWithTokens(ctx, "user_id = ?", userID)
 }
 
 // DeleteByUserIDExceptDeviceID deletes all sessions except current device
 func (r *userSessionRepositoryImpl) DeleteByUserIDExceptDeviceID(ctx context.Context, userID, deviceID string) error {
-	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
-	if deviceID != "" {
-		query = query.Where("device_id != ?", deviceID)
+	if deviceID == "" {
+		return r.deleteWithTokens(ctx, "user_id = ?", userID)
 	}
-	return query.Delete(&model.UserSession{}).Error
+	return r.deleteWithTokens(ctx, "user_id = ? AND device_id != ?", userID, deviceID)
+}
+
+// deleteWithTokens deletes matching sessions together with their refresh token families
+func (r *userSessionRepositoryImpl) deleteWithTokens(ctx context.Context, query string, args ...interface{}) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var familyIDs []string
+		if err := tx.Model(&model.UserSession{}).Where(query, args...).Pluck("family_id", &familyIDs).Error; err != nil {
+			return err
+		}
+		if len(familyIDs) == 0 {
+			return nil
+		}
+		if err := tx.Where("family_id IN ?", familyIDs).Delete(&model.RefreshToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("family_id IN ?", familyIDs).Delete(&model.UserSession{}).Error
+	})
 }
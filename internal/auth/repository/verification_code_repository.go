@@ -14,6 +14,7 @@ type VerificationCodeRepository interface {
 	GetLatestByTarget(ctx context.Context, target string, targetType model.VerificationTargetType, purpose model.VerificationPurpose) (*model.VerificationCode, error)
 	UpdateStatus(ctx context.Context, codeID string, status model.VerificationCodeStatus) error
 	UpdateVerifiedAt(ctx context.Context, codeID string, verifiedAt time.Time) error
+	UpdateProvider(ctx context.Context, codeID, provider, providerMessageID string) error
 	IncrementAttempts(ctx context.Context, codeID string) error
 	Delete(ctx context.Context, codeID string) error
 	DeleteExpired(ctx context.Context) (int64, error)
@@ -72,6 +73,16 @@ func (r *verificationCodeRepositoryImpl) UpdateVerifiedAt(ctx context.Context, c
 		}).Error
 }
 
+func (r *verificationCodeRepositoryImpl) UpdateProvider(ctx context.Context, codeID, provider, providerMessageID string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.VerificationCode{}).
+		Where("code_id = ?", codeID).
+		Updates(map[string]interface{}{
+			"provider":            provider,
+			"provider_message_id": providerMessageID,
+		}).Error
+}
+
 func (r *verificationCodeRepositoryImpl) IncrementAttempts(ctx context.Context, codeID string) error {
 	return r.db.WithContext(ctx).
 		Model(&model.VerificationCode{}).
@@ -0,0 +1,74 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileSMSSender fake provider that appends every message to a JSON lines file instead of sending it.
+// Integration tests read verification codes from the file; never use it in production
+type FileSMSSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+// FileSMSRecord one line of the fake SMS file
+type FileSMSRecord struct {
+	MessageID  string    `json:"message_id"`
+	To         string    `json:"to"`
+	TemplateID string    `json:"template_id"`
+	Code       string    `json:"code"`
+	SentAt     time.Time `json:"sent_at"`
+}
+
+// NewFileSMSSender creates fake SMS provider writing to path
+func NewFileSMSSender(path string) (*FileSMSSender, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, fmt.Errorf("fake sms file path is required")
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open fake sms file failed: %w", err)
+	}
+	_ = f.Close()
+	return &FileSMSSender{path: path}, nil
+}
+
+// Name returns provider name
+func (s *FileSMSSender) Name() string {
+	return "fake"
+}
+
+// Send appends the message to the file
+func (s *FileSMSSender) Send(ctx context.Context, to, templateID, code string) (string, error) {
+	record := FileSMSRecord{
+		MessageID:  fmt.Sprintf("fake_%d", time.Now().UnixNano()),
+		To:         to,
+		TemplateID: templateID,
+		Code:       code,
+		SentAt:     time.Now().UTC(),
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return "", fmt.Errorf("open fake sms file failed: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return "", fmt.Errorf("write fake sms file failed: %w", err)
+	}
+	return record.MessageID, nil
+}
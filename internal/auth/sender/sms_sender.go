@@ -0,0 +1,98 @@
+package sender
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// SMSProvider one SMS gateway vendor
+type SMSProvider interface {
+	// Name provider name recorded on verification codes
+	Name() string
+	// Send sends a verification code and returns the vendor message ID
+	Send(ctx context.Context, to, templateID, code string) (string, error)
+}
+
+// FailoverSMSSender sends through providers in priority order, falling through to the next one on failure.
+// A provider that just failed is tried after the healthy ones until its cooldown ends
+type FailoverSMSSender struct {
+	providers []SMSProvider
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failedAt map[string]time.Time
+}
+
+// NewFailoverSMSSender creates a failover chain, providers are listed by priority
+func NewFailoverSMSSender(providers []SMSProvider, cooldown time.Duration) (*FailoverSMSSender, error) {
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("at least one sms provider is required")
+	}
+	if cooldown <= 0 {
+		cooldown = time.Minute
+	}
+	return &FailoverSMSSender{
+		providers: providers,
+		cooldown:  cooldown,
+		failedAt:  make(map[string]time.Time),
+	}, nil
+}
+
+// Send sends the code and returns the provider that delivered it with its message ID
+func (s *FailoverSMSSender) Send(ctx context.Context, to, templateID, code string) (string, string, error) {
+	var errs []string
+	for _, provider := range s.ordered() {
+		messageID, err := provider.Send(ctx, to, templateID, code)
+		if err == nil {
+			s.markHealthy(provider.Name())
+			return provider.Name(), messageID, nil
+		}
+
+		s.markFailed(provider.Name())
+		logger.Warn("SMS provider failed, trying next",
+			zap.String("provider", provider.Name()),
+			zap.Error(err))
+		errs = append(errs, fmt.Sprintf("%s: %v", provider.Name(), err))
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return "", "", fmt.Errorf("all sms providers failed: %s", strings.Join(errs, "; "))
+}
+
+// ordered returns healthy providers first, each group keeping priority order
+func (s *FailoverSMSSender) ordered() []SMSProvider {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	healthy := make([]SMSProvider, 0, len(s.providers))
+	cooling := make([]SMSProvider, 0)
+	for _, provider := range s.providers {
+		if failedAt, ok := s.failedAt[provider.Name()]; ok && now.Sub(failedAt) < s.cooldown {
+			cooling = append(cooling, provider)
+			continue
+		}
+		healthy = append(healthy, provider)
+	}
+	return append(healthy, cooling...)
+}
+
+func (s *FailoverSMSSender) markFailed(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failedAt[name] = time.Now()
+}
+
+func (s *FailoverSMSSender) markHealthy(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.failedAt, name)
+}
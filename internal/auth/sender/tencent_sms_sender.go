@@ -0,0 +1,181 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	tencentSMSHost    = "sms.tencentcloudapi.com"
+	tencentSMSService = "sms"
+	tencentSMSVersion = "2021-01-11"
+)
+
+// TencentSMSConfig Tencent Cloud SMS configuration
+type TencentSMSConfig struct {
+	SecretID   string
+	SecretKey  string
+	AppID      string // SmsSdkAppId
+	SignName   string
+	TemplateID string
+	Region     string
+	Host       string
+	Timeout    time.Duration
+}
+
+// TencentSMSSender sends SMS through Tencent Cloud SendSms (API 3.0, TC3-HMAC-SHA256 signature).
+// Template IDs are per vendor, so the configured TemplateID is always used
+type TencentSMSSender struct {
+	config TencentSMSConfig
+	client *http.Client
+}
+
+// NewTencentSMSSender creates Tencent Cloud SMS provider
+func NewTencentSMSSender(config TencentSMSConfig) (*TencentSMSSender, error) {
+	config.SecretID = strings.TrimSpace(config.SecretID)
+	config.AppID = strings.TrimSpace(config.AppID)
+	if config.SecretID == "" || config.SecretKey == "" {
+		return nil, fmt.Errorf("tencent sms secret id and key are required")
+	}
+	if config.AppID == "" || config.TemplateID == "" {
+		return nil, fmt.Errorf("tencent sms app id and template id are required")
+	}
+	if config.Region == "" {
+		config.Region = "ap-guangzhou"
+	}
+	if config.Host == "" {
+		config.Host = tencentSMSHost
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+	return &TencentSMSSender{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+// Name returns provider name
+func (s *TencentSMSSender) Name() string {
+	return "tencent"
+}
+
+// Send sends the code as the only template parameter, returns SerialNo
+func (s *TencentSMSSender) Send(ctx context.Context, to, templateID, code string) (string, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"PhoneNumberSet":   []string{tencentPhoneNumber(to)},
+		"SmsSdkAppId":      s.config.AppID,
+		"SignName":         s.config.SignName,
+		"TemplateId":       s.config.TemplateID,
+		"TemplateParamSet": []string{code},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+s.config.Host+"/", bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Host", s.config.Host)
+	req.Header.Set("X-TC-Action", "SendSms")
+	req.Header.Set("X-TC-Version", tencentSMSVersion)
+	req.Header.Set("X-TC-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-TC-Region", s.config.Region)
+	req.Header.Set("Authorization", s.authorization(payload, timestamp))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("tencent sms request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Response struct {
+			Error *struct {
+				Code    string `json:"Code"`
+				Message string `json:"Message"`
+			} `json:"Error"`
+			SendStatusSet []struct {
+				SerialNo string `json:"SerialNo"`
+				Code     string `json:"Code"`
+				Message  string `json:"Message"`
+			} `json:"SendStatusSet"`
+			RequestID string `json:"RequestId"`
+		} `json:"Response"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("tencent sms response decode failed (status %d): %w", resp.StatusCode, err)
+	}
+	if e := result.Response.Error; e != nil {
+		return "", fmt.Errorf("tencent sms rejected: %s %s (request %s)", e.Code, e.Message, result.Response.RequestID)
+	}
+	if len(result.Response.SendStatusSet) == 0 {
+		return "", fmt.Errorf("tencent sms returned no send status (request %s)", result.Response.RequestID)
+	}
+	status := result.Response.SendStatusSet[0]
+	if status.Code != "Ok" {
+		return "", fmt.Errorf("tencent sms rejected: %s %s (request %s)", status.Code, status.Message, result.Response.RequestID)
+	}
+	return status.SerialNo, nil
+}
+
+// authorization builds the TC3-HMAC-SHA256 Authorization header
+func (s *TencentSMSSender) authorization(payload []byte, timestamp int64) string {
+	date := time.Unix(timestamp, 0).UTC().Format("2006-01-02")
+	signedHeaders := "content-type;host"
+	canonicalRequest := strings.Join([]string{
+		http.MethodPost,
+		"/",
+		"",
+		"content-type:application/json; charset=utf-8\nhost:" + s.config.Host + "\n",
+		signedHeaders,
+		sha256Hex(payload),
+	}, "\n")
+
+	credentialScope := date + "/" + tencentSMSService + "/tc3_request"
+	stringToSign := strings.Join([]string{
+		"TC3-HMAC-SHA256",
+		strconv.FormatInt(timestamp, 10),
+		credentialScope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	secretDate := hmacSHA256([]byte("TC3"+s.config.SecretKey), date)
+	secretService := hmacSHA256(secretDate, tencentSMSService)
+	secretSigning := hmacSHA256(secretService, "tc3_request")
+	signature := hex.EncodeToString(hmacSHA256(secretSigning, stringToSign))
+
+	return fmt.Sprintf("TC3-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.SecretID, credentialScope, signedHeaders, signature)
+}
+
+// tencentPhoneNumber converts a mainland number to E.164
+func tencentPhoneNumber(phone string) string {
+	if strings.HasPrefix(phone, "+") {
+		return phone
+	}
+	return "+86" + phone
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
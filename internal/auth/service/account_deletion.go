@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/anychat/server/internal/auth/dto"
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/internal/auth/repository"
+	"github.com/anychat/server/pkg/crypto"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/anychat/server/pkg/notification"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	forceLogoutReasonAccountDeletion = "account_deletion"
+	maxDeletionReasonLength          = 256
+)
+
+// DeleteAccount re-verifies the user and schedules the account for erasure once the grace period ends.
+// All devices are signed out, logging in again during the grace period cancels the deletion
+func (s *authServiceImpl) DeleteAccount(ctx context.Context, userID string, req *dto.DeleteAccountRequest) (*dto.AccountDeletionInfo, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeUserNotFound, "")
+		}
+		return nil, err
+	}
+
+	if err := s.verifyAccountOwner(ctx, user, req); err != nil {
+		return nil, err
+	}
+	if s.mfaSvc != nil {
+		if err := s.mfaSvc.RequireIfEnabled(ctx, userID, req.MFACode); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.deletionRepo.GetOpenByUserID(ctx, userID); err == nil {
+		return nil, errors.NewBusiness(errors.CodeAccountDeletionExists, "")
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	// the column limit counts characters, cutting bytes could split a multi-byte character
+	reason := strings.TrimSpace(req.Reason)
+	if runes := []rune(reason); len(runes) > maxDeletionReasonLength {
+		reason = string(runes[:maxDeletionReasonLength])
+	}
+	now := time.Now()
+	deletion := &model.AccountDeletion{
+		UserID:      userID,
+		Status:      model.AccountDeletionPending,
+		Reason:      reason,
+		RequestedAt: now,
+		EraseAfter:  now.Add(s.config.AccountDeletionGracePeriod),
+	}
+	if err := s.deletionRepo.Create(ctx, deletion); err != nil {
+		return nil, err
+	}
+	s.logDeletion(ctx, deletion, model.DeletionActionRequested, "", map[string]interface{}{
+		"reason":      reason,
+		"erase_after": deletion.EraseAfter.Unix(),
+	})
+	s.publishDeletionNotification(deletion, notification.TypeAuthAccountDeletionRequested)
+
+	logger.Info("Account deletion requested",
+		zap.String("userID", userID),
+		zap.Int64("deletionID", deletion.ID),
+		zap.Time("eraseAfter", deletion.EraseAfter))
+
+	if err := s.forceLogoutAllDevices(ctx, userID, forceLogoutReasonAccountDeletion); err != nil {
+		logger.Warn("Failed to sign out devices after account deletion request", zap.Error(err), zap.String("userID", userID))
+	}
+
+	return toAccountDeletionInfo(deletion, nil), nil
+}
+
+// GetAccountDeletion returns the latest deletion request of the user and the services already erased
+func (s *authServiceImpl) GetAccountDeletion(ctx context.Context, userID string) (*dto.AccountDeletionInfo, error) {
+	deletion, err := s.deletionRepo.GetLatestByUserID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeAccountDeletionNone, "")
+		}
+		return nil, err
+	}
+
+	steps, err := s.deletionRepo.ListCompletedSteps(ctx, deletion.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toAccountDeletionInfo(deletion, steps), nil
+}
+
+// verifyAccountOwner checks the password, or for accounts without one a delete_account code sent to the bound phone or email
+func (s *authServiceImpl) verifyAccountOwner(ctx context.Context, user *model.User, req *dto.DeleteAccountRequest) error {
+	if user.PasswordHash != "" {
+		if req.Password == "" {
+			return errors.NewBusiness(errors.CodeParamError, "password is required")
+		}
+		if !crypto.CheckPassword(req.Password, user.PasswordHash) {
+			return errors.NewBusiness(errors.CodePasswordError, "")
+		}
+		return nil
+	}
+
+	var target string
+	var targetType model.VerificationTargetType
+	switch {
+	case user.Phone != nil && *user.Phone != "":
+		target, targetType = *user.Phone, model.TargetTypeSMS
+	case user.Email != nil && *user.Email != "":
+		target, targetType = *user.Email, model.TargetTypeEmail
+	default:
+		return errors.NewBusiness(errors.CodeParamError, "set a password or bind a phone number before deleting the account")
+	}
+	if req.VerifyCode == "" {
+		return errors.NewBusiness(errors.CodeParamError, "verify_code is required")
+	}
+	if s.verifySvc == nil {
+		return errors.NewBusiness(errors.CodeInternalError, "verification service not initialized")
+	}
+	_, err := s.verifySvc.VerifyCode(ctx, &dto.VerifyCodeRequest{
+		Target:     target,
+		TargetType: targetType,
+		Code:       req.VerifyCode,
+		Purpose:    model.PurposeDeleteAccount,
+	})
+	return err
+}
+
+// cancelAccountDeletion cancels a pending deletion when the user signs in during the grace period
+func (s *authServiceImpl) cancelAccountDeletion(ctx context.Context, userID string) {
+	deletion, err := s.deletionRepo.GetOpenByUserID(ctx, userID)
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			logger.Warn("Failed to get account deletion", zap.Error(err), zap.String("userID", userID))
+		}
+		return
+	}
+
+	cancelled, err := s.deletionRepo.Cancel(ctx, deletion.ID)
+	if err != nil || !cancelled {
+		if err != nil {
+			logger.Warn("Failed to cancel account deletion", zap.Error(err), zap.String("userID", userID))
+		}
+		return
+	}
+	deletion.Status = model.AccountDeletionCancelled
+	s.logDeletion(ctx, deletion, model.DeletionActionCancelled, "", map[string]interface{}{"by": "login"})
+	s.publishDeletionNotification(deletion, notification.TypeAuthAccountDeletionCancelled)
+
+	logger.Info("Account deletion cancelled by login",
+		zap.String("userID", userID),
+		zap.Int64("deletionID", deletion.ID))
+}
+
+// logDeletion appends to the audit trail, failures are only logged
+func (s *authServiceImpl) logDeletion(ctx context.Context, deletion *model.AccountDeletion, action, service string, detail map[string]interface{}) {
+	if err := addDeletionLog(ctx, s.deletionRepo, deletion, action, service, detail); err != nil {
+		logger.Warn("Failed to write account deletion log", zap.Error(err), zap.Int64("deletionID", deletion.ID))
+	}
+}
+
+func (s *authServiceImpl) publishDeletionNotification(deletion *model.AccountDeletion, notificationType string) {
+	if s.notificationPub == nil {
+		return
+	}
+	notif := notification.NewNotification(notificationType, deletion.UserID, notification.PriorityHigh)
+	notif.Payload = map[string]interface{}{
+		"deletion_id": deletion.ID,
+		"erase_after": deletion.EraseAfter.Unix(),
+	}
+	if err := s.notificationPub.PublishToUser(deletion.UserID, notif); err != nil {
+		logger.Warn("Failed to publish account deletion notification", zap.Error(err))
+	}
+}
+
+func toAccountDeletionInfo(deletion *model.AccountDeletion, steps []string) *dto.AccountDeletionInfo {
+	if steps == nil {
+		steps = []string{}
+	}
+	return &dto.AccountDeletionInfo{
+		ID:             deletion.ID,
+		Status:         deletion.Status,
+		RequestedAt:    deletion.RequestedAt,
+		EraseAfter:     deletion.EraseAfter,
+		CancelledAt:    deletion.CancelledAt,
+		CompletedAt:    deletion.CompletedAt,
+		CompletedSteps: steps,
+	}
+}
+
+// addDeletionLog writes an audit entry with detail encoded as JSON
+func addDeletionLog(ctx context.Context, repo repository.AccountDeletionRepository, deletion *model.AccountDeletion, action, service string, detail map[string]interface{}) error {
+	entry := &model.AccountDeletionLog{
+		DeletionID: deletion.ID,
+		UserID:     deletion.UserID,
+		Action:     action,
+		Service:    service,
+	}
+	if len(detail) > 0 {
+		data, err := json.Marshal(detail)
+		if err != nil {
+			return err
+		}
+		entry.Detail = string(data)
+	}
+	return repo.AddLog(ctx, entry)
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/internal/auth/repository"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/anychat/server/pkg/notification"
+	"go.uber.org/zap"
+)
+
+// ErasureStepAuth name of the last erasure step, run locally by auth-service
+const ErasureStepAuth = "auth"
+
+// UserDataEraser erases what one service keeps about a deleted account, calling it again must be harmless
+type UserDataEraser interface {
+	Service() string
+	EraseUserData(ctx context.Context, userID string) (map[string]int64, error)
+}
+
+// AccountEraser erases accounts whose deletion grace period ended.
+// Each service is one step, run in order and recorded in the audit trail; a failed step stops the run and the next
+// run resumes from it, so a deletion completes once every service has succeeded
+type AccountEraser interface {
+	// EraseDue erases due accounts, returns how many deletions completed
+	EraseDue(ctx context.Context) (int, error)
+}
+
+type accountEraserImpl struct {
+	deletionRepo    repository.AccountDeletionRepository
+	userRepo        repository.UserRepository
+	steps           []UserDataEraser
+	notificationPub notification.Publisher
+	batchSize       int
+}
+
+// NewAccountEraser creates account eraser, erasers run in the given order followed by the auth step
+func NewAccountEraser(
+	deletionRepo repository.AccountDeletionRepository,
+	userRepo repository.UserRepository,
+	erasers []UserDataEraser,
+	notificationPub notification.Publisher,
+	batchSize int,
+) AccountEraser {
+	if batchSize <= 0 {
+		batchSize = 20
+	}
+	steps := make([]UserDataEraser, 0, len(erasers)+1)
+	steps = append(steps, erasers...)
+	steps = append(steps, &authDataEraser{deletionRepo: deletionRepo})
+	return &accountEraserImpl{
+		deletionRepo:    deletionRepo,
+		userRepo:        userRepo,
+		steps:           steps,
+		notificationPub: notificationPub,
+		batchSize:       batchSize,
+	}
+}
+
+// EraseDue erases deletions whose grace period ended, a failed deletion does not hold back the others
+func (e *accountEraserImpl) EraseDue(ctx context.Context) (int, error) {
+	deletions, err := e.deletionRepo.ListDue(ctx, time.Now(), e.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for _, deletion := range deletions {
+		if err := e.erase(ctx, deletion); err != nil {
+			logger.Warn("Account erasure incomplete, will retry",
+				zap.String("userID", deletion.UserID),
+				zap.Int64("deletionID", deletion.ID),
+				zap.Int("attempts", deletion.Attempts+1),
+				zap.Error(err))
+			if err := e.deletionRepo.RecordFailure(ctx, deletion.ID, truncateError(err)); err != nil {
+				logger.Warn("Failed to record account erasure failure", zap.Error(err))
+			}
+			continue
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+// erase runs the steps not completed yet
+func (e *accountEraserImpl) erase(ctx context.Context, deletion *model.AccountDeletion) error {
+	started, err := e.deletionRepo.MarkErasing(ctx, deletion.ID)
+	if err != nil {
+		return err
+	}
+	if !started {
+		// cancelled by a login between listing and now
+		return nil
+	}
+	if deletion.Status == model.AccountDeletionPending {
+		// no more logins, the grace period is over
+		if err := e.userRepo.UpdateStatus(ctx, deletion.UserID, model.UserStatusDisabled); err != nil {
+			return err
+		}
+		logger.Info("Account erasure started", zap.String("userID", deletion.UserID), zap.Int64("deletionID", deletion.ID))
+	}
+
+	completedSteps, err := e.deletionRepo.ListCompletedSteps(ctx, deletion.ID)
+	if err != nil {
+		return err
+	}
+	done := make(map[string]bool, len(completedSteps))
+	for _, step := range completedSteps {
+		done[step] = true
+	}
+
+	for _, step := range e.steps {
+		if done[step.Service()] {
+			continue
+		}
+		erased, err := step.EraseUserData(ctx, deletion.UserID)
+		if err != nil {
+			e.log(ctx, deletion, model.DeletionActionStepFailed, step.Service(), map[string]interface{}{"error": err.Error()})
+			return fmt.Errorf("%s: %w", step.Service(), err)
+		}
+		e.log(ctx, deletion, model.DeletionActionStepCompleted, step.Service(), map[string]interface{}{"erased": erased})
+		e.publish(deletion, notification.TypeAuthAccountErasureStep, map[string]interface{}{
+			"deletion_id": deletion.ID,
+			"service":     step.Service(),
+			"erased":      erased,
+		})
+	}
+
+	if err := e.deletionRepo.MarkCompleted(ctx, deletion.ID); err != nil {
+		return err
+	}
+	e.log(ctx, deletion, model.DeletionActionCompleted, "", nil)
+	e.publish(deletion, notification.TypeAuthAccountErased, map[string]interface{}{
+		"deletion_id": deletion.ID,
+	})
+
+	logger.Info("Account erased", zap.String("userID", deletion.UserID), zap.Int64("deletionID", deletion.ID))
+	return nil
+}
+
+func (e *accountEraserImpl) log(ctx context.Context, deletion *model.AccountDeletion, action, service string, detail map[string]interface{}) {
+	if err := addDeletionLog(ctx, e.deletionRepo, deletion, action, service, detail); err != nil {
+		logger.Warn("Failed to write account deletion log", zap.Error(err), zap.Int64("deletionID", deletion.ID))
+	}
+}
+
+func (e *accountEraserImpl) publish(deletion *model.AccountDeletion, notificationType string, payload map[string]interface{}) {
+	if e.notificationPub == nil {
+		return
+	}
+	notif := notification.NewNotification(notificationType, deletion.UserID, notification.PriorityNormal)
+	notif.Payload = payload
+	if err := e.notificationPub.PublishToUser(deletion.UserID, notif); err != nil {
+		logger.Warn("Failed to publish account erasure notification", zap.Error(err))
+	}
+}
+
+// authDataEraser erases sessions, devices, identities, MFA, login history and the user row
+type authDataEraser struct {
+	deletionRepo repository.AccountDeletionRepository
+}
+
+func (a *authDataEraser) Service() string {
+	return ErasureStepAuth
+}
+
+func (a *authDataEraser) EraseUserData(ctx context.Context, userID string) (map[string]int64, error) {
+	return a.deletionRepo.EraseAuthData(ctx, userID)
+}
+
+// truncateError keeps the error within the last_error column
+func truncateError(err error) string {
+	msg := err.Error()
+	if len(msg) > 512 {
+		msg = msg[:512]
+	}
+	return msg
+}
@@ -9,12 +9,14 @@ import (
 	"github.com/anychat/server/internal/auth/client"
 	"github.com/anychat/server/internal/auth/dto"
 	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/internal/auth/oidc"
 	"github.com/anychat/server/internal/auth/repository"
 	"github.com/anychat/server/pkg/crypto"
 	"github.com/anychat/server/pkg/errors"
 	"github.com/anychat/server/pkg/jwt"
 	"github.com/anychat/server/pkg/logger"
 	"github.com/anychat/server/pkg/notification"
+	pkgredis "github.com/anychat/server/pkg/redis"
 	"github.com/anychat/server/pkg/validator"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -26,22 +28,84 @@ type AuthService interface {
 	SendVerificationCode(ctx context.Context, req *dto.SendVerificationCodeRequest) (*dto.SendVerificationCodeResponse, error)
 	Register(ctx context.Context, req *dto.RegisterRequest) (*dto.RegisterResponse, error)
 	Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginResponse, error)
+	LoginByCode(ctx context.Context, req *dto.LoginByCodeRequest) (*dto.LoginResponse, error)
 	Logout(ctx context.Context, userID string, req *dto.LogoutRequest) error
 	RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error)
 	ChangePassword(ctx context.Context, userID string, req *dto.ChangePasswordRequest) error
 	ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error
 	ValidateToken(ctx context.Context, token string) (*jwt.Claims, error)
+	IssueAdminToken(ctx context.Context, adminID string, role int16) (*dto.AdminTokenResponse, error)
+
+	// QR login
+	CreateQRLoginTicket(ctx context.Context, req *dto.CreateQRLoginRequest) (*dto.CreateQRLoginResponse, error)
+	GetQRLoginStatus(ctx context.Context, req *dto.GetQRLoginStatusRequest) (*dto.GetQRLoginStatusResponse, error)
+	ScanQRLogin(ctx context.Context, userID string, req *dto.QRLoginActionRequest) (*dto.ScanQRLoginResponse, error)
+	ConfirmQRLogin(ctx context.Context, userID string, req *dto.QRLoginActionRequest) error
+	CancelQRLogin(ctx context.Context, userID string, req *dto.QRLoginActionRequest) error
+
+	// MFA login
+	VerifyLoginMFA(ctx context.Context, req *dto.VerifyLoginMFARequest) (*dto.LoginResponse, error)
+
+	// UnlockAccount lifts a login lockout (admin)
+	UnlockAccount(ctx context.Context, userID string) error
+
+	// Device management
+	ListDevices(ctx context.Context, userID, currentDeviceID string) (*dto.ListDevicesResponse, error)
+	LogoutDevice(ctx context.Context, userID, deviceID string) error
+	LogoutOtherDevices(ctx context.Context, userID, currentDeviceID string) error
+	RenameDevice(ctx context.Context, userID string, req *dto.RenameDeviceRequest) error
+
+	// OIDC single sign-on and linked identities
+	ListOIDCProviders(ctx context.Context) *dto.ListOIDCProvidersResponse
+	BeginOIDCLogin(ctx context.Context, req *dto.BeginOIDCLoginRequest) (*dto.BeginOIDCLoginResponse, error)
+	CompleteOIDCLogin(ctx context.Context, req *dto.CompleteOIDCLoginRequest) (*dto.LoginResponse, error)
+	LinkIdentity(ctx context.Context, userID string, req *dto.LinkIdentityRequest) (*dto.IdentityInfo, error)
+	ListIdentities(ctx context.Context, userID string) (*dto.ListIdentitiesResponse, error)
+	UnlinkIdentity(ctx context.Context, userID, provider string) error
+
+	// Login history and unusual login reports
+	ListLoginHistory(ctx context.Context, userID string, limit int) (*dto.ListLoginHistoryResponse, error)
+	ReportUnusualLogin(ctx context.Context, token string) error
+
+	// Account deletion
+	DeleteAccount(ctx context.Context, userID string, req *dto.DeleteAccountRequest) (*dto.AccountDeletionInfo, error)
+	GetAccountDeletion(ctx context.Context, userID string) (*dto.AccountDeletionInfo, error)
 }
 
 // authServiceImpl authentication service implementation
 type authServiceImpl struct {
-	userRepo        repository.UserRepository
-	deviceRepo      repository.UserDeviceRepository
-	sessionRepo     repository.UserSessionRepository
-	jwtManager      *jwt.Manager
-	userClient      *client.UserClient
-	verifySvc       VerificationService
-	notificationPub notification.Publisher
+	userRepo         repository.UserRepository
+	deviceRepo       repository.UserDeviceRepository
+	sessionRepo      repository.UserSessionRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	qrEventRepo      repository.QRLoginEventRepository
+	identityRepo     repository.UserIdentityRepository
+	deletionRepo     repository.AccountDeletionRepository
+	jwtManager       *jwt.Manager
+	adminJWTManager  *jwt.Manager
+	userClient       *client.UserClient
+	verifySvc        VerificationService
+	mfaSvc           MFAService
+	loginGuard       LoginGuard
+	loginMonitor     LoginMonitor
+	oidcProviders    *oidc.Registry
+	notificationPub  notification.Publisher
+	cache            *pkgredis.Client
+	config           AuthConfig
+}
+
+// AuthConfig authentication behaviour switches
+type AuthConfig struct {
+	// CodeLoginAutoRegister creates an account when an unknown phone number logs in with a valid code
+	CodeLoginAutoRegister bool
+	// QRLoginTTL lifetime of a QR login ticket
+	QRLoginTTL time.Duration
+	// SameTypeKick whether a new login signs out other devices of the same type, missing types default to true
+	SameTypeKick map[model.DeviceType]bool
+	// OIDCStateTTL how long an OIDC authorization may take before its callback is rejected
+	OIDCStateTTL time.Duration
+	// AccountDeletionGracePeriod time between a deletion request and erasure, logging in meanwhile cancels it
+	AccountDeletionGracePeriod time.Duration
 }
 
 // NewAuthService creates authentication service
@@ -49,19 +113,41 @@ func NewAuthService(
 	userRepo repository.UserRepository,
 	deviceRepo repository.UserDeviceRepository,
 	sessionRepo repository.UserSessionRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	qrEventRepo repository.QRLoginEventRepository,
+	identityRepo repository.UserIdentityRepository,
+	deletionRepo repository.AccountDeletionRepository,
 	jwtManager *jwt.Manager,
+	adminJWTManager *jwt.Manager,
 	userClient *client.UserClient,
 	verifySvc VerificationService,
+	mfaSvc MFAService,
+	loginGuard LoginGuard,
+	loginMonitor LoginMonitor,
+	oidcProviders *oidc.Registry,
 	notificationPub notification.Publisher,
+	cache *pkgredis.Client,
+	config AuthConfig,
 ) AuthService {
 	return &authServiceImpl{
-		userRepo:        userRepo,
-		deviceRepo:      deviceRepo,
-		sessionRepo:     sessionRepo,
-		jwtManager:      jwtManager,
-		userClient:      userClient,
-		verifySvc:       verifySvc,
-		notificationPub: notificationPub,
+		userRepo:         userRepo,
+		deviceRepo:       deviceRepo,
+		sessionRepo:      sessionRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		qrEventRepo:      qrEventRepo,
+		identityRepo:     identityRepo,
+		deletionRepo:     deletionRepo,
+		jwtManager:       jwtManager,
+		adminJWTManager:  adminJWTManager,
+		userClient:       userClient,
+		verifySvc:        verifySvc,
+		mfaSvc:           mfaSvc,
+		loginGuard:       loginGuard,
+		loginMonitor:     loginMonitor,
+		oidcProviders:    oidcProviders,
+		notificationPub:  notificationPub,
+		cache:            cache,
+		config:           config,
 	}
 }
 
@@ -172,40 +258,24 @@ func (s *authServiceImpl) Register(ctx context.Context, req *dto.RegisterRequest
 
 	// create device record
 	device := &model.UserDevice{
-		UserID:     userID,
-		DeviceID:   req.DeviceID,
-		DeviceType: req.DeviceType,
+		UserID:        userID,
+		DeviceID:      req.DeviceID,
+		DeviceType:    req.DeviceType,
+		ClientVersion: req.ClientVersion,
 	}
 	now := time.Now()
 	device.LastLoginAt = &now
+	device.LastActiveAt = &now
 	if err := s.deviceRepo.Create(ctx, device); err != nil {
 		return nil, err
 	}
 
-	// generate tokens
-	accessToken, err := s.jwtManager.GenerateAccessToken(userID, req.DeviceID, int16(req.DeviceType))
+	// issue tokens
+	tokens, err := s.startSession(ctx, userID, req.DeviceID, req.DeviceType)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := s.jwtManager.GenerateRefreshToken(userID, req.DeviceID, int16(req.DeviceType))
-	if err != nil {
-		return nil, err
-	}
-
-	// save session
-	session := &model.UserSession{
-		UserID:                userID,
-		DeviceID:              req.DeviceID,
-		AccessToken:           accessToken,
-		RefreshToken:          refreshToken,
-		AccessTokenExpiresAt:  time.Now().Add(2 * time.Hour),
-		RefreshTokenExpiresAt: time.Now().Add(7 * 24 * time.Hour),
-	}
-	if err := s.sessionRepo.Create(ctx, session); err != nil {
-		return nil, err
-	}
-
 	// call user-service to initialize user data
 	if s.userClient != nil {
 		if err := s.userClient.InitUserData(ctx, userID, req.Nickname); err != nil {
@@ -216,9 +286,9 @@ func (s *authServiceImpl) Register(ctx context.Context, req *dto.RegisterRequest
 
 	return &dto.RegisterResponse{
 		UserID:       userID,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    7200, // 2 hours
+		AccessToken:  tokens.accessToken,
+		RefreshToken: tokens.refreshToken,
+		ExpiresIn:    tokens.expiresIn,
 	}, nil
 }
 
@@ -231,96 +301,234 @@ func (s *authServiceImpl) Login(ctx context.Context, req *dto.LoginRequest) (*dt
 
 	// find user
 	user, err := s.userRepo.GetByAccount(ctx, req.Account)
-	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, errors.NewBusiness(errors.CodeUserNotFound, "")
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	userID := ""
+	if user != nil {
+		userID = user.ID
+	}
+	login := loginDevice{
+		deviceType:    req.DeviceType,
+		deviceID:      req.DeviceID,
+		clientVersion: req.ClientVersion,
+		ipAddress:     req.IpAddress,
+		userAgent:     req.UserAgent,
+		method:        model.LoginMethodPassword,
+	}
+
+	// brute-force protection: lockout, progressive delay, CAPTCHA
+	if err := s.loginGuard.Check(ctx, userID, req.IpAddress, req.CaptchaToken); err != nil {
+		if user != nil {
+			s.loginMonitor.RecordFailure(ctx, login.attempt(user.ID), model.LoginResultBlocked)
 		}
 		return nil, err
 	}
+	if user == nil {
+		s.loginGuard.RecordFailure(ctx, "", req.IpAddress)
+		return nil, errors.NewBusiness(errors.CodeUserNotFound, "")
+	}
 
 	// verify password
 	if !crypto.CheckPassword(req.Password, user.PasswordHash) {
+		s.loginGuard.RecordFailure(ctx, user.ID, req.IpAddress)
+		s.loginMonitor.RecordFailure(ctx, login.attempt(user.ID), model.LoginResultFailed)
 		return nil, errors.NewBusiness(errors.CodePasswordError, "")
 	}
+	s.loginGuard.RecordSuccess(ctx, user.ID)
 
 	// check user status
 	if !user.IsActive() {
 		return nil, errors.NewBusiness(errors.CodeAccountDisabled, "")
 	}
 
-	// handle same type device login, force logout old devices
-	if err := s.handleSameTypeDeviceKick(ctx, user.ID, req.DeviceID, req.DeviceType); err != nil {
-		logger.Warn("Failed to handle same type device kick", zap.Error(err))
+	// the password may be known to whoever caused a reported unusual login
+	if user.PasswordResetRequired {
+		s.loginMonitor.RecordFailure(ctx, login.attempt(user.ID), model.LoginResultBlocked)
+		return nil, errors.NewBusiness(errors.CodePasswordResetRequired, "")
 	}
 
-	// update or create device record
-	device, err := s.deviceRepo.GetByUserIDAndDeviceID(ctx, user.ID, req.DeviceID)
-	if err != nil {
+	return s.completeLogin(ctx, user, login)
+}
+
+// UnlockAccount lifts a login lockout
+func (s *authServiceImpl) UnlockAccount(ctx context.Context, userID string) error {
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
 		if err == gorm.ErrRecordNotFound {
-			device = &model.UserDevice{
-				UserID:      user.ID,
-				DeviceID:    req.DeviceID,
-				DeviceType:  req.DeviceType,
-				LastLoginIP: req.IpAddress,
-			}
-			now := time.Now()
-			device.LastLoginAt = &now
-			if err := s.deviceRepo.Create(ctx, device); err != nil {
-				return nil, err
-			}
-		} else {
-			return nil, err
+			return errors.NewBusiness(errors.CodeUserNotFound, "")
 		}
+		return err
+	}
+	if err := s.loginGuard.Unlock(ctx, userID); err != nil {
+		return err
+	}
+	logger.Info("Login lockout cleared", zap.String("userID", userID))
+	return nil
+}
+
+// LoginByCode passwordless login with an SMS/email verification code
+func (s *authServiceImpl) LoginByCode(ctx context.Context, req *dto.LoginByCodeRequest) (*dto.LoginResponse, error) {
+	// validate device type
+	if !req.DeviceType.IsValid() {
+		return nil, errors.NewBusiness(errors.CodeParamError, "invalid device type")
+	}
+	if !req.TargetType.IsValid() {
+		return nil, errors.NewBusiness(errors.CodeParamError, "invalid target type")
+	}
+
+	if s.verifySvc == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "verification service not initialized")
+	}
+
+	target := strings.TrimSpace(req.Target)
+	if req.TargetType == model.TargetTypeEmail {
+		target = strings.ToLower(target)
+	}
+
+	// verify verification code
+	if _, err := s.verifySvc.VerifyCode(ctx, &dto.VerifyCodeRequest{
+		Target:     target,
+		TargetType: req.TargetType,
+		Code:       req.VerifyCode,
+		Purpose:    model.PurposeLogin,
+	}); err != nil {
+		return nil, err
+	}
+
+	// find user
+	var user *model.User
+	var err error
+	if req.TargetType == model.TargetTypeSMS {
+		user, err = s.userRepo.GetByPhone(ctx, target)
 	} else {
-		if err := s.deviceRepo.UpdateLastLogin(ctx, user.ID, req.DeviceID, req.IpAddress); err != nil {
+		user, err = s.userRepo.GetByEmail(ctx, target)
+	}
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			return nil, err
+		}
+		if req.TargetType != model.TargetTypeSMS || !s.config.CodeLoginAutoRegister {
+			return nil, errors.NewBusiness(errors.CodeUserNotFound, "")
+		}
+		user, err = s.registerByPhone(ctx, target, req.Nickname)
+		if err != nil {
 			return nil, err
 		}
 	}
 
-	// generate tokens
-	accessToken, err := s.jwtManager.GenerateAccessToken(user.ID, req.DeviceID, int16(req.DeviceType))
-	if err != nil {
-		return nil, err
+	// check user status
+	if !user.IsActive() {
+		return nil, errors.NewBusiness(errors.CodeAccountDisabled, "")
 	}
 
-	refreshToken, err := s.jwtManager.GenerateRefreshToken(user.ID, req.DeviceID, int16(req.DeviceType))
-	if err != nil {
+	return s.completeLogin(ctx, user, loginDevice{
+		deviceType:    req.DeviceType,
+		deviceID:      req.DeviceID,
+		clientVersion: req.ClientVersion,
+		ipAddress:     req.IpAddress,
+		userAgent:     req.UserAgent,
+		method:        model.LoginMethodCode,
+	})
+}
+
+// registerByPhone creates a password-less account for a phone number verified by code login
+func (s *authServiceImpl) registerByPhone(ctx context.Context, phone, nickname string) (*model.User, error) {
+	user := &model.User{
+		ID:     uuid.New().String(),
+		Phone:  &phone,
+		Status: model.UserStatusNormal,
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
 		return nil, err
 	}
 
-	// update or create session
-	session, err := s.sessionRepo.GetByUserIDAndDeviceID(ctx, user.ID, req.DeviceID)
+	// call user-service to initialize user data
+	if s.userClient != nil {
+		if err := s.userClient.InitUserData(ctx, user.ID, nickname); err != nil {
+			// init failure should not block login, just log error
+			logger.Error("Failed to init user data", zap.Error(err), zap.String("userID", user.ID))
+		}
+	}
+
+	logger.Info("User auto-registered by code login", zap.String("userID", user.ID))
+	return user, nil
+}
+
+// loginDevice device a login session is issued for
+type loginDevice struct {
+	deviceType    model.DeviceType
+	deviceID      string
+	clientVersion string
+	ipAddress     string
+	userAgent     string
+	method        string // model.LoginMethod*
+}
+
+// attempt describes the login for the login monitor
+func (l loginDevice) attempt(userID string) LoginAttempt {
+	return LoginAttempt{
+		UserID:     userID,
+		DeviceID:   l.deviceID,
+		DeviceType: l.deviceType,
+		Method:     l.method,
+		IPAddress:  l.ipAddress,
+		UserAgent:  l.userAgent,
+	}
+}
+
+// issueLoginSession kicks same type devices, records the device and issues a new session for a verified user
+func (s *authServiceImpl) issueLoginSession(ctx context.Context, user *model.User, login loginDevice) (*dto.LoginResponse, error) {
+	// handle same type device login, force logout old devices
+	if s.kicksSameType(login.deviceType) {
+		if err := s.handleSameTypeDeviceKick(ctx, user.ID, login.deviceID, login.deviceType); err != nil {
+			logger.Warn("Failed to handle same type device kick", zap.Error(err))
+		}
+	}
+
+	// update or create device record
+	_, err := s.deviceRepo.GetByUserIDAndDeviceID(ctx, user.ID, login.deviceID)
+	knownDevice := err == nil
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			session = &model.UserSession{
-				UserID:                user.ID,
-				DeviceID:              req.DeviceID,
-				AccessToken:           accessToken,
-				RefreshToken:          refreshToken,
-				AccessTokenExpiresAt:  time.Now().Add(2 * time.Hour),
-				RefreshTokenExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+			device := &model.UserDevice{
+				UserID:        user.ID,
+				DeviceID:      login.deviceID,
+				DeviceType:    login.deviceType,
+				ClientVersion: login.clientVersion,
+				LastLoginIP:   login.ipAddress,
 			}
-			if err := s.sessionRepo.Create(ctx, session); err != nil {
+			now := time.Now()
+			device.LastLoginAt = &now
+			device.LastActiveAt = &now
+			if err := s.deviceRepo.Create(ctx, device); err != nil {
 				return nil, err
 			}
 		} else {
 			return nil, err
 		}
 	} else {
-		session.AccessToken = accessToken
-		session.RefreshToken = refreshToken
-		session.AccessTokenExpiresAt = time.Now().Add(2 * time.Hour)
-		session.RefreshTokenExpiresAt = time.Now().Add(7 * 24 * time.Hour)
-		if err := s.sessionRepo.Update(ctx, session); err != nil {
+		if err := s.deviceRepo.UpdateLastLogin(ctx, user.ID, login.deviceID, login.ipAddress, login.clientVersion); err != nil {
 			return nil, err
 		}
 	}
 
+	// issue tokens
+	tokens, err := s.startSession(ctx, user.ID, login.deviceID, login.deviceType)
+	if err != nil {
+		return nil, err
+	}
+
+	// history and unusual login alert
+	s.loginMonitor.RecordSuccess(ctx, user, login.attempt(user.ID), knownDevice)
+
+	// signing in during the grace period keeps the account
+	s.cancelAccountDeletion(ctx, user.ID)
+
 	return &dto.LoginResponse{
 		UserID:       user.ID,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    7200,
+		AccessToken:  tokens.accessToken,
+		RefreshToken: tokens.refreshToken,
+		ExpiresIn:    tokens.expiresIn,
 		User: &dto.UserInfo{
 			UserID: user.ID,
 			Phone:  user.Phone,
@@ -340,31 +548,20 @@ func (s *authServiceImpl) handleSameTypeDeviceKick(ctx context.Context, userID,
 		if device.DeviceID == deviceID {
 			continue
 		}
-
-		if err := s.sessionRepo.DeleteByUserIDAndDeviceID(ctx, userID, device.DeviceID); err != nil {
+		if err := s.revokeDevice(ctx, device, "new_device_login"); err != nil {
 			logger.Warn("Failed to delete old session", zap.Error(err), zap.String("deviceID", device.DeviceID))
 		}
-
-		if s.notificationPub != nil {
-			notif := notification.NewNotification(
-				notification.TypeAuthForceLogout,
-				userID,
-				notification.PriorityHigh,
-			)
-			notif.Payload = map[string]interface{}{
-				"device_id":   device.DeviceID,
-				"device_type": device.DeviceType.String(),
-				"reason":      "new_device_login",
-			}
-			if err := s.notificationPub.PublishToUser(userID, notif); err != nil {
-				logger.Warn("Failed to publish force logout notification", zap.Error(err))
-			}
-		}
 	}
 
 	return nil
 }
 
+// kicksSameType reports whether a login of this device type signs out other devices of the same type
+func (s *authServiceImpl) kicksSameType(deviceType model.DeviceType) bool {
+	kick, ok := s.config.SameTypeKick[deviceType]
+	return !ok || kick
+}
+
 // forceLogoutOtherDevices forces logout of other devices
 func (s *authServiceImpl) forceLogoutOtherDevices(ctx context.Context, userID, excludeDeviceID, reason string) error {
 	devices, err := s.deviceRepo.GetByUserID(ctx, userID)
@@ -376,26 +573,8 @@ func (s *authServiceImpl) forceLogoutOtherDevices(ctx context.Context, userID, e
 		if device.DeviceID == excludeDeviceID {
 			continue
 		}
-
-		if err := s.sessionRepo.DeleteByUserIDAndDeviceID(ctx, userID, device.DeviceID); err != nil {
+		if err := s.revokeDevice(ctx, device, reason); err != nil {
 			logger.Warn("Failed to delete session", zap.Error(err), zap.String("deviceID", device.DeviceID))
-			continue
-		}
-
-		if s.notificationPub != nil {
-			notif := notification.NewNotification(
-				notification.TypeAuthForceLogout,
-				userID,
-				notification.PriorityHigh,
-			)
-			notif.Payload = map[string]interface{}{
-				"device_id":   device.DeviceID,
-				"device_type": device.DeviceType.String(),
-				"reason":      reason,
-			}
-			if err := s.notificationPub.PublishToUser(userID, notif); err != nil {
-				logger.Warn("Failed to publish force logout notification", zap.Error(err))
-			}
 		}
 	}
 
@@ -408,55 +587,6 @@ func (s *authServiceImpl) Logout(ctx context.Context, userID string, req *dto.Lo
 	return s.sessionRepo.DeleteByUserIDAndDeviceID(ctx, userID, req.DeviceID)
 }
 
-// RefreshToken refresh token
-func (s *authServiceImpl) RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error) {
-	// validate refresh token
-	claims, err := s.jwtManager.ValidateRefreshToken(req.RefreshToken)
-	if err != nil {
-		return nil, errors.NewBusiness(errors.CodeRefreshTokenInvalid, "")
-	}
-
-	// find session
-	session, err := s.sessionRepo.GetByRefreshToken(ctx, req.RefreshToken)
-	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, errors.NewBusiness(errors.CodeRefreshTokenInvalid, "")
-		}
-		return nil, err
-	}
-
-	// check expiration
-	if session.IsRefreshTokenExpired() {
-		return nil, errors.NewBusiness(errors.CodeRefreshTokenExpired, "")
-	}
-
-	// generate new tokens
-	accessToken, err := s.jwtManager.GenerateAccessToken(claims.UserID, claims.DeviceID, claims.DeviceType)
-	if err != nil {
-		return nil, err
-	}
-
-	refreshToken, err := s.jwtManager.GenerateRefreshToken(claims.UserID, claims.DeviceID, claims.DeviceType)
-	if err != nil {
-		return nil, err
-	}
-
-	// update session
-	session.AccessToken = accessToken
-	session.RefreshToken = refreshToken
-	session.AccessTokenExpiresAt = time.Now().Add(2 * time.Hour)
-	session.RefreshTokenExpiresAt = time.Now().Add(7 * 24 * time.Hour)
-	if err := s.sessionRepo.Update(ctx, session); err != nil {
-		return nil, err
-	}
-
-	return &dto.RefreshTokenResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    7200,
-	}, nil
-}
-
 // ChangePassword change password
 func (s *authServiceImpl) ChangePassword(ctx context.Context, userID string, req *dto.ChangePasswordRequest) error {
 	// get user
@@ -470,6 +600,18 @@ func (s *authServiceImpl) ChangePassword(ctx context.Context, userID string, req
 		return errors.NewBusiness(errors.CodePasswordError, "incorrect old password")
 	}
 
+	// after a reported unusual login only a reset proves the user, the old password may be known to someone else
+	if user.PasswordResetRequired {
+		return errors.NewBusiness(errors.CodePasswordResetRequired, "")
+	}
+
+	// require second factor when enabled
+	if s.mfaSvc != nil {
+		if err := s.mfaSvc.RequireIfEnabled(ctx, userID, req.MFACode); err != nil {
+			return err
+		}
+	}
+
 	// validate new password strength
 	if !crypto.ValidatePasswordStrength(req.NewPassword) {
 		return errors.NewBusiness(errors.CodePasswordWeak, "")
@@ -534,6 +676,11 @@ func (s *authServiceImpl) ResetPassword(ctx context.Context, req *dto.ResetPassw
 	if err != nil {
 		return err
 	}
+	if user.PasswordResetRequired {
+		if err := s.userRepo.SetPasswordResetRequired(ctx, user.ID, false); err != nil {
+			return err
+		}
+	}
 
 	// invalidate all user sessions (force logout)
 	return s.forceLogoutAllDevices(ctx, user.ID, "password_reset")
@@ -547,28 +694,35 @@ func (s *authServiceImpl) forceLogoutAllDevices(ctx context.Context, userID, rea
 	}
 
 	for _, device := range devices {
-		if err := s.sessionRepo.DeleteByUserIDAndDeviceID(ctx, userID, device.DeviceID); err != nil {
+		if err := s.revokeDevice(ctx, device, reason); err != nil {
 			logger.Warn("Failed to delete session", zap.Error(err), zap.String("deviceID", device.DeviceID))
-			continue
 		}
+	}
 
-		if s.notificationPub != nil {
-			notif := notification.NewNotification(
-				notification.TypeAuthForceLogout,
-				userID,
-				notification.PriorityHigh,
-			)
-			notif.Payload = map[string]interface{}{
-				"device_id":   device.DeviceID,
-				"device_type": device.DeviceType.String(),
-				"reason":      reason,
-			}
-			if err := s.notificationPub.PublishToUser(userID, notif); err != nil {
-				logger.Warn("Failed to publish force logout notification", zap.Error(err))
-			}
-		}
+	return nil
+}
+
+// revokeDevice ends the device session and tells the device to sign out, the gateway also closes its WebSocket
+func (s *authServiceImpl) revokeDevice(ctx context.Context, device *model.UserDevice, reason string) error {
+	if err := s.sessionRepo.DeleteByUserIDAndDeviceID(ctx, device.UserID, device.DeviceID); err != nil {
+		return err
 	}
 
+	if s.notificationPub != nil {
+		notif := notification.NewNotification(
+			notification.TypeAuthForceLogout,
+			device.UserID,
+			notification.PriorityHigh,
+		)
+		notif.Payload = map[string]interface{}{
+			"device_id":   device.DeviceID,
+			"device_type": device.DeviceType.String(),
+			"reason":      reason,
+		}
+		if err := s.notificationPub.PublishToUser(device.UserID, notif); err != nil {
+			logger.Warn("Failed to publish force logout notification", zap.Error(err))
+		}
+	}
 	return nil
 }
 
@@ -581,6 +735,26 @@ func (s *authServiceImpl) ValidateToken(ctx context.Context, token string) (*jwt
 	return claims, nil
 }
 
+// IssueAdminToken signs an admin console access token with the shared key ring.
+// The admin audience keeps it out of the user API, admin-service authenticates the admin and verifies through JWKS
+func (s *authServiceImpl) IssueAdminToken(ctx context.Context, adminID string, role int16) (*dto.AdminTokenResponse, error) {
+	if adminID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "admin_id required")
+	}
+	if s.adminJWTManager == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "admin token signing is not configured")
+	}
+
+	token, err := s.adminJWTManager.GenerateAccessToken(adminID, "", role)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.AdminTokenResponse{
+		AccessToken: token,
+		ExpiresIn:   int64(s.adminJWTManager.AccessTokenExpire().Seconds()),
+	}, nil
+}
+
 func (s *authServiceImpl) resolveVerificationTarget(req *dto.RegisterRequest) (string, model.VerificationTargetType) {
 	if req.PhoneNumber != "" {
 		return req.PhoneNumber, model.TargetTypeSMS
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/anychat/server/internal/auth/dto"
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// deviceNameMaxLength max runes of a device name
+	deviceNameMaxLength = 64
+
+	forceLogoutReasonSignedOut = "signed_out_by_user"
+)
+
+// ListDevices lists devices the user has logged in on, most recent login first
+func (s *authServiceImpl) ListDevices(ctx context.Context, userID, currentDeviceID string) (*dto.ListDevicesResponse, error) {
+	devices, err := s.deviceRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.sessionRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	signedIn := make(map[string]bool, len(sessions))
+	for _, session := range sessions {
+		if now.Before(session.RefreshTokenExpiresAt) {
+			signedIn[session.DeviceID] = true
+		}
+	}
+
+	items := make([]*dto.DeviceInfo, 0, len(devices))
+	for _, device := range devices {
+		lastActive := device.LastActiveAt
+		if lastActive == nil {
+			lastActive = device.LastLoginAt
+		}
+		items = append(items, &dto.DeviceInfo{
+			DeviceID:      device.DeviceID,
+			DeviceType:    device.DeviceType,
+			Name:          device.Name,
+			ClientVersion: device.ClientVersion,
+			LastLoginAt:   device.LastLoginAt,
+			LastLoginIP:   device.LastLoginIP,
+			LastActiveAt:  lastActive,
+			IsCurrent:     device.DeviceID == currentDeviceID,
+			SignedIn:      signedIn[device.DeviceID],
+		})
+	}
+
+	return &dto.ListDevicesResponse{Devices: items}, nil
+}
+
+// LogoutDevice signs out one of the user's devices
+func (s *authServiceImpl) LogoutDevice(ctx context.Context, userID, deviceID string) error {
+	device, err := s.getDevice(ctx, userID, deviceID)
+	if err != nil {
+		return err
+	}
+	if err := s.revokeDevice(ctx, device, forceLogoutReasonSignedOut); err != nil {
+		return err
+	}
+
+	logger.Info("Device signed out by user", zap.String("userID", userID), zap.String("deviceID", deviceID))
+	return nil
+}
+
+// LogoutOtherDevices signs out every device except the current one
+func (s *authServiceImpl) LogoutOtherDevices(ctx context.Context, userID, currentDeviceID string) error {
+	if currentDeviceID == "" {
+		return errors.NewBusiness(errors.CodeParamError, "current device_id is required")
+	}
+	if err := s.forceLogoutOtherDevices(ctx, userID, currentDeviceID, forceLogoutReasonSignedOut); err != nil {
+		return err
+	}
+
+	logger.Info("Other devices signed out by user", zap.String("userID", userID), zap.String("deviceID", currentDeviceID))
+	return nil
+}
+
+// RenameDevice sets a user-defined device name
+func (s *authServiceImpl) RenameDevice(ctx context.Context, userID string, req *dto.RenameDeviceRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > deviceNameMaxLength {
+		return errors.NewBusiness(errors.CodeParamError, "device name must be 1-64 characters")
+	}
+	if _, err := s.getDevice(ctx, userID, req.DeviceID); err != nil {
+		return err
+	}
+	return s.deviceRepo.UpdateName(ctx, userID, req.DeviceID, name)
+}
+
+// getDevice gets a device of the user
+func (s *authServiceImpl) getDevice(ctx context.Context, userID, deviceID string) (*model.UserDevice, error) {
+	if deviceID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "device_id is required")
+	}
+	device, err := s.deviceRepo.GetByUserIDAndDeviceID(ctx, userID, deviceID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeDeviceNotFound, "")
+		}
+		return nil, err
+	}
+	return device, nil
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/anychat/server/internal/auth/captcha"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/anychat/server/pkg/notification"
+	pkgredis "github.com/anychat/server/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// LoginGuard password login brute-force protection.
+// Failures are counted per account and per IP; repeated failures add a growing delay,
+// then require a CAPTCHA, and finally lock the account or IP for a while
+type LoginGuard interface {
+	// Check rejects an attempt while the account or IP is locked, throttled or owes a CAPTCHA.
+	// userID is empty when the account does not exist
+	Check(ctx context.Context, userID, ip, captchaToken string) error
+	RecordFailure(ctx context.Context, userID, ip string)
+	// RecordSuccess resets the account counters, IP counters are kept so one valid account cannot clear them
+	RecordSuccess(ctx context.Context, userID string)
+	// Unlock clears lockout and counters of an account, used by admins
+	Unlock(ctx context.Context, userID string) error
+}
+
+// LoginGuardConfig login guard config
+type LoginGuardConfig struct {
+	AccountMaxFailures   int           // failures within window that lock the account
+	IPMaxFailures        int           // failures within window that lock the IP
+	FailureWindow        time.Duration // failure counting window
+	LockoutDuration      time.Duration // how long a lock lasts
+	DelayAfterFailures   int           // account failures before progressive delay starts
+	BaseDelay            time.Duration // first delay, doubled on every further failure
+	MaxDelay             time.Duration // delay cap
+	CaptchaAfterFailures int           // account or IP failures before a CAPTCHA is required, needs a verifier
+}
+
+type loginGuardImpl struct {
+	cache           *pkgredis.Client
+	captcha         captcha.Verifier
+	notificationPub notification.Publisher
+	config          LoginGuardConfig
+}
+
+// NewLoginGuard creates login guard, captchaVerifier may be nil to disable CAPTCHA challenges
+func NewLoginGuard(
+	cache *pkgredis.Client,
+	captchaVerifier captcha.Verifier,
+	notificationPub notification.Publisher,
+	config LoginGuardConfig,
+) LoginGuard {
+	if config.AccountMaxFailures == 0 {
+		config.AccountMaxFailures = 10
+	}
+	if config.IPMaxFailures == 0 {
+		config.IPMaxFailures = 100
+	}
+	if config.FailureWindow == 0 {
+		config.FailureWindow = 15 * time.Minute
+	}
+	if config.LockoutDuration == 0 {
+		config.LockoutDuration = 30 * time.Minute
+	}
+	if config.DelayAfterFailures == 0 {
+		config.DelayAfterFailures = 3
+	}
+	if config.BaseDelay == 0 {
+		config.BaseDelay = time.Second
+	}
+	if config.MaxDelay == 0 {
+		config.MaxDelay = 30 * time.Second
+	}
+	if config.CaptchaAfterFailures == 0 {
+		config.CaptchaAfterFailures = 5
+	}
+	return &loginGuardImpl{
+		cache:           cache,
+		captcha:         captchaVerifier,
+		notificationPub: notificationPub,
+		config:          config,
+	}
+}
+
+// Check rejects an attempt that is not allowed yet
+func (g *loginGuardImpl) Check(ctx context.Context, userID, ip, captchaToken string) error {
+	if g.cache == nil {
+		return nil
+	}
+
+	if ip != "" {
+		if ttl := g.ttl(ctx, loginIPLockKey(ip)); ttl > 0 {
+			return errors.NewBusiness(errors.CodeLoginThrottled,
+				fmt.Sprintf("Too many failed login attempts from this network, please try again in %s", formatRetry(ttl)))
+		}
+	}
+	if userID != "" {
+		if ttl := g.ttl(ctx, loginLockKey(userID)); ttl > 0 {
+			return errors.NewBusiness(errors.CodeAccountLocked,
+				fmt.Sprintf("Account temporarily locked, please try again in %s", formatRetry(ttl)))
+		}
+		if ttl := g.ttl(ctx, loginDelayKey(userID)); ttl > 0 {
+			return errors.NewBusiness(errors.CodeLoginThrottled,
+				fmt.Sprintf("Too many failed login attempts, please try again in %s", formatRetry(ttl)))
+		}
+	}
+
+	if g.captcha == nil || !g.captchaRequired(ctx, userID, ip) {
+		return nil
+	}
+	if captchaToken == "" {
+		return errors.NewBusiness(errors.CodeCaptchaRequired, "")
+	}
+	ok, err := g.captcha.Verify(ctx, captchaToken, ip)
+	if err != nil {
+		logger.Warn("CAPTCHA verification failed", zap.Error(err))
+		return errors.NewBusiness(errors.CodeCaptchaInvalid, "")
+	}
+	if !ok {
+		return errors.NewBusiness(errors.CodeCaptchaInvalid, "")
+	}
+	return nil
+}
+
+// RecordFailure counts a failed attempt and applies delay or lockout
+func (g *loginGuardImpl) RecordFailure(ctx context.Context, userID, ip string) {
+	if g.cache == nil {
+		return
+	}
+
+	if ip != "" {
+		if count := g.incr(ctx, loginIPFailureKey(ip)); count >= int64(g.config.IPMaxFailures) {
+			_ = g.cache.Set(ctx, loginIPLockKey(ip), "1", g.config.LockoutDuration)
+			_ = g.cache.Del(ctx, loginIPFailureKey(ip))
+			logger.Warn("Login locked for IP", zap.String("ip", ip), zap.Int64("failures", count))
+		}
+	}
+
+	if userID == "" {
+		return
+	}
+	count := g.incr(ctx, loginFailureKey(userID))
+	if count >= int64(g.config.AccountMaxFailures) {
+		g.lock(ctx, userID, ip, count)
+		return
+	}
+	if count >= int64(g.config.DelayAfterFailures) {
+		_ = g.cache.Set(ctx, loginDelayKey(userID), "1", g.delay(count))
+	}
+}
+
+// RecordSuccess resets account counters
+func (g *loginGuardImpl) RecordSuccess(ctx context.Context, userID string) {
+	if g.cache == nil {
+		return
+	}
+	_ = g.cache.Del(ctx, loginFailureKey(userID), loginDelayKey(userID))
+}
+
+// Unlock clears account lockout
+func (g *loginGuardImpl) Unlock(ctx context.Context, userID string) error {
+	if g.cache == nil {
+		return nil
+	}
+	return g.cache.Del(ctx, loginLockKey(userID), loginFailureKey(userID), loginDelayKey(userID))
+}
+
+// lock locks the account and tells its owner
+func (g *loginGuardImpl) lock(ctx context.Context, userID, ip string, failures int64) {
+	if err := g.cache.Set(ctx, loginLockKey(userID), "1", g.config.LockoutDuration); err != nil {
+		logger.Error("Failed to lock account", zap.Error(err), zap.String("userID", userID))
+		return
+	}
+	_ = g.cache.Del(ctx, loginFailureKey(userID), loginDelayKey(userID))
+	logger.Warn("Account locked after repeated login failures",
+		zap.String("userID", userID),
+		zap.String("ip", ip),
+		zap.Int64("failures", failures))
+
+	if g.notificationPub == nil {
+		return
+	}
+	notif := notification.NewNotification(
+		notification.TypeAuthAccountLocked,
+		userID,
+		notification.PriorityHigh,
+	)
+	notif.Payload = map[string]interface{}{
+		"reason":       "login_failures",
+		"failures":     failures,
+		"ip_address":   ip,
+		"locked_until": time.Now().Add(g.config.LockoutDuration).Unix(),
+	}
+	if err := g.notificationPub.PublishToUser(userID, notif); err != nil {
+		logger.Warn("Failed to publish account locked notification", zap.Error(err))
+	}
+}
+
+// captchaRequired reports whether account or IP failures reached the CAPTCHA threshold
+func (g *loginGuardImpl) captchaRequired(ctx context.Context, userID, ip string) bool {
+	threshold := int64(g.config.CaptchaAfterFailures)
+	if userID != "" && g.count(ctx, loginFailureKey(userID)) >= threshold {
+		return true
+	}
+	return ip != "" && g.count(ctx, loginIPFailureKey(ip)) >= threshold
+}
+
+// delay returns the wait after count failures: BaseDelay doubled per failure past the threshold, capped at MaxDelay
+func (g *loginGuardImpl) delay(count int64) time.Duration {
+	d := g.config.BaseDelay
+	for i := int64(g.config.DelayAfterFailures); i < count && d < g.config.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > g.config.MaxDelay {
+		d = g.config.MaxDelay
+	}
+	return d
+}
+
+func (g *loginGuardImpl) incr(ctx context.Context, key string) int64 {
+	count, err := g.cache.Incr(ctx, key)
+	if err != nil {
+		logger.Warn("Failed to record login failure", zap.Error(err))
+		return 0
+	}
+	if count == 1 {
+		_ = g.cache.Expire(ctx, key, g.config.FailureWindow)
+	}
+	return count
+}
+
+func (g *loginGuardImpl) count(ctx context.Context, key string) int64 {
+	value, err := g.cache.Get(ctx, key)
+	if err != nil {
+		return 0
+	}
+	count, _ := parseInt64(value)
+	return count
+}
+
+func (g *loginGuardImpl) ttl(ctx context.Context, key string) time.Duration {
+	ttl, err := g.cache.GetClient().TTL(ctx, key).Result()
+	if err != nil {
+		return 0
+	}
+	return ttl
+}
+
+// formatRetry rounds a wait up to whole seconds
+func formatRetry(d time.Duration) string {
+	return (d + time.Second - 1).Truncate(time.Second).String()
+}
+
+func loginFailureKey(userID string) string {
+	return "auth:login:fail:" + userID
+}
+
+func loginIPFailureKey(ip string) string {
+	return "auth:login:fail:ip:" + ip
+}
+
+func loginDelayKey(userID string) string {
+	return "auth:login:delay:" + userID
+}
+
+func loginLockKey(userID string) string {
+	return "auth:login:lock:" + userID
+}
+
+func loginIPLockKey(ip string) string {
+	return "auth:login:lock:ip:" + ip
+}
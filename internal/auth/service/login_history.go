@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	"github.com/anychat/server/internal/auth/dto"
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const forceLogoutReasonUnusualLogin = "unusual_login_reported"
+
+// ListLoginHistory lists recent logins and rejected password attempts, newest first
+func (s *authServiceImpl) ListLoginHistory(ctx context.Context, userID string, limit int) (*dto.ListLoginHistoryResponse, error) {
+	return s.loginMonitor.ListHistory(ctx, userID, limit)
+}
+
+// ReportUnusualLogin handles "this wasn't me" from an unusual login email: the device that signed in is signed out
+// and password login is refused until the password is reset with a verification code
+func (s *authServiceImpl) ReportUnusualLogin(ctx context.Context, token string) error {
+	entry, err := s.loginMonitor.ConsumeReport(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.SetPasswordResetRequired(ctx, entry.UserID, true); err != nil {
+		return err
+	}
+
+	device, err := s.deviceRepo.GetByUserIDAndDeviceID(ctx, entry.UserID, entry.DeviceID)
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			return err
+		}
+		device = &model.UserDevice{UserID: entry.UserID, DeviceID: entry.DeviceID, DeviceType: entry.DeviceType}
+	}
+	if err := s.revokeDevice(ctx, device, forceLogoutReasonUnusualLogin); err != nil {
+		return err
+	}
+
+	logger.Info("Unusual login reported by user",
+		zap.String("userID", entry.UserID),
+		zap.String("deviceID", entry.DeviceID),
+		zap.Int64("historyID", entry.ID))
+	return nil
+}
@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anychat/server/internal/auth/dto"
+	"github.com/anychat/server/internal/auth/geoip"
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/internal/auth/repository"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/anychat/server/pkg/notification"
+	pkgredis "github.com/anychat/server/pkg/redis"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+const (
+	loginReportKeyPrefix = "auth:login_report:"
+	maxUserAgentLength   = 512
+	maxLoginHistoryLimit = 100
+
+	// risk weights, a login is unusual once the sum reaches the configured threshold
+	riskWeightNewDevice  = 40
+	riskWeightNewNetwork = 30
+	riskWeightNewCountry = 50
+)
+
+// LoginAttempt a login attempt as seen by the login monitor
+type LoginAttempt struct {
+	UserID     string
+	DeviceID   string
+	DeviceType model.DeviceType
+	Method     string // model.LoginMethod*
+	IPAddress  string
+	UserAgent  string
+}
+
+// LoginMonitor keeps the login history and warns users about sign-ins from unfamiliar places.
+// A successful login is scored against the devices the user signed in with before, the networks (IP prefixes)
+// of recent successful logins and, with a GeoIP database, their countries. An unusual login is announced to the
+// user's other devices and by email with a "this wasn't me" link
+type LoginMonitor interface {
+	// RecordSuccess records a granted login, knownDevice reports whether the device had signed in to the account before
+	RecordSuccess(ctx context.Context, user *model.User, attempt LoginAttempt, knownDevice bool)
+	// RecordFailure records a rejected password attempt on an existing account
+	RecordFailure(ctx context.Context, attempt LoginAttempt, result model.LoginResult)
+	ListHistory(ctx context.Context, userID string, limit int) (*dto.ListLoginHistoryResponse, error)
+	// ConsumeReport resolves a "this wasn't me" token to its login, a token can be used once
+	ConsumeReport(ctx context.Context, token string) (*model.UserLoginHistory, error)
+}
+
+// LoginMonitorConfig login monitor config
+type LoginMonitorConfig struct {
+	KnownWindow    time.Duration // networks and countries of successful logins within the window are known
+	ScoreThreshold int           // risk score at which a login is unusual
+	ReportURL      string        // page opened by the email link, the token is appended as ?token=, empty omits the link
+	ReportTTL      time.Duration // how long a "this wasn't me" link works
+}
+
+type loginMonitorImpl struct {
+	historyRepo     repository.UserLoginHistoryRepository
+	locator         geoip.Locator
+	emailSender     EmailSender
+	notificationPub notification.Publisher
+	cache           *pkgredis.Client
+	config          LoginMonitorConfig
+}
+
+// NewLoginMonitor creates login monitor, locator and emailSender may be nil
+func NewLoginMonitor(
+	historyRepo repository.UserLoginHistoryRepository,
+	locator geoip.Locator,
+	emailSender EmailSender,
+	notificationPub notification.Publisher,
+	cache *pkgredis.Client,
+	config LoginMonitorConfig,
+) LoginMonitor {
+	if config.KnownWindow == 0 {
+		config.KnownWindow = 90 * 24 * time.Hour
+	}
+	if config.ScoreThreshold == 0 {
+		config.ScoreThreshold = 60
+	}
+	if config.ReportTTL == 0 {
+		config.ReportTTL = 7 * 24 * time.Hour
+	}
+	return &loginMonitorImpl{
+		historyRepo:     historyRepo,
+		locator:         locator,
+		emailSender:     emailSender,
+		notificationPub: notificationPub,
+		cache:           cache,
+		config:          config,
+	}
+}
+
+// RecordSuccess scores and records a granted login, then alerts the user when it is unusual.
+// History is best effort and never fails the login
+func (m *loginMonitorImpl) RecordSuccess(ctx context.Context, user *model.User, attempt LoginAttempt, knownDevice bool) {
+	entry := m.newEntry(attempt, model.LoginResultSuccess)
+	if err := m.assess(ctx, entry, knownDevice); err != nil {
+		logger.Warn("Failed to assess login risk", zap.Error(err), zap.String("userID", attempt.UserID))
+	}
+	if err := m.historyRepo.Create(ctx, entry); err != nil {
+		logger.Warn("Failed to record login history", zap.Error(err), zap.String("userID", attempt.UserID))
+		return
+	}
+	if entry.Unusual {
+		logger.Info("Unusual login detected",
+			zap.String("userID", entry.UserID),
+			zap.String("deviceID", entry.DeviceID),
+			zap.String("reasons", entry.RiskReasons))
+		m.alert(ctx, user, entry, knownDevice)
+	}
+}
+
+// RecordFailure records a rejected attempt
+func (m *loginMonitorImpl) RecordFailure(ctx context.Context, attempt LoginAttempt, result model.LoginResult) {
+	if err := m.historyRepo.Create(ctx, m.newEntry(attempt, result)); err != nil {
+		logger.Warn("Failed to record login history", zap.Error(err), zap.String("userID", attempt.UserID))
+	}
+}
+
+// ListHistory lists the latest login attempts
+func (m *loginMonitorImpl) ListHistory(ctx context.Context, userID string, limit int) (*dto.ListLoginHistoryResponse, error) {
+	if limit <= 0 || limit > maxLoginHistoryLimit {
+		limit = maxLoginHistoryLimit
+	}
+	entries, err := m.historyRepo.ListByUserID(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.ListLoginHistoryResponse{Entries: make([]*dto.LoginHistoryEntry, 0, len(entries))}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &dto.LoginHistoryEntry{
+			ID:          entry.ID,
+			DeviceID:    entry.DeviceID,
+			DeviceType:  entry.DeviceType,
+			LoginMethod: entry.LoginMethod,
+			IPAddress:   entry.IPAddress,
+			Location:    entry.Location(),
+			UserAgent:   entry.UserAgent,
+			Result:      entry.Result,
+			Unusual:     entry.Unusual,
+			RiskReasons: entry.Reasons(),
+			Reported:    entry.ReportedAt != nil,
+			CreatedAt:   entry.CreatedAt,
+		})
+	}
+	return resp, nil
+}
+
+// ConsumeReport loads and deletes a report token in one step
+func (m *loginMonitorImpl) ConsumeReport(ctx context.Context, token string) (*model.UserLoginHistory, error) {
+	if token == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "token required")
+	}
+	if m.cache == nil {
+		return nil, errors.NewBusiness(errors.CodeLoginReportInvalid, "")
+	}
+
+	key := loginReportKey(token)
+	var value *redis.StringCmd
+	if _, err := m.cache.GetClient().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		value = pipe.Get(ctx, key)
+		pipe.Del(ctx, key)
+		return nil
+	}); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	id, err := strconv.ParseInt(value.Val(), 10, 64)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeLoginReportInvalid, "")
+	}
+	entry, err := m.historyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.historyRepo.MarkReported(ctx, id); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// newEntry builds a history entry with network and location of the client IP
+func (m *loginMonitorImpl) newEntry(attempt LoginAttempt, result model.LoginResult) *model.UserLoginHistory {
+	entry := &model.UserLoginHistory{
+		UserID:      attempt.UserID,
+		DeviceID:    attempt.DeviceID,
+		DeviceType:  attempt.DeviceType,
+		LoginMethod: attempt.Method,
+		IPAddress:   attempt.IPAddress,
+		IPPrefix:    ipNetworkPrefix(attempt.IPAddress),
+		UserAgent:   truncateRunes(attempt.UserAgent, maxUserAgentLength),
+		Result:      result,
+	}
+	if m.locator != nil {
+		if location, ok := m.locator.Lookup(attempt.IPAddress); ok {
+			entry.Country = location.Country
+			entry.Region = location.Region
+			entry.City = location.City
+		}
+	}
+	return entry
+}
+
+// assess scores the login against the user's recent successful logins.
+// Without any recent successful login there is nothing to compare with, the login is recorded as the baseline
+func (m *loginMonitorImpl) assess(ctx context.Context, entry *model.UserLoginHistory, knownDevice bool) error {
+	since := time.Now().Add(-m.config.KnownWindow)
+	count, err := m.historyRepo.CountSuccessSince(ctx, entry.UserID, since)
+	if err != nil || count == 0 {
+		return err
+	}
+
+	var reasons []string
+	score := 0
+	if !knownDevice {
+		reasons = append(reasons, model.RiskReasonNewDevice)
+		score += riskWeightNewDevice
+	}
+	if entry.IPPrefix != "" {
+		known, err := m.historyRepo.HasSuccessFromPrefix(ctx, entry.UserID, entry.IPPrefix, since)
+		if err != nil {
+			return err
+		}
+		if !known {
+			reasons = append(reasons, model.RiskReasonNewNetwork)
+			score += riskWeightNewNetwork
+		}
+	}
+	if entry.Country != "" {
+		known, err := m.historyRepo.HasSuccessFromCountry(ctx, entry.UserID, entry.Country, since)
+		if err != nil {
+			return err
+		}
+		if !known {
+			reasons = append(reasons, model.RiskReasonNewCountry)
+			score += riskWeightNewCountry
+		}
+	}
+
+	entry.RiskScore = score
+	entry.RiskReasons = strings.Join(reasons, ",")
+	entry.Unusual = score >= m.config.ScoreThreshold
+	return nil
+}
+
+// alert notifies the user's other devices and mails a "this wasn't me" link
+func (m *loginMonitorImpl) alert(ctx context.Context, user *model.User, entry *model.UserLoginHistory, knownDevice bool) {
+	if m.notificationPub != nil {
+		notif := notification.NewNotification(
+			notification.TypeAuthUnusualLogin,
+			entry.UserID,
+			notification.PriorityHigh,
+		)
+		// the gateway delivers it to every device except the one that signed in
+		notif.Payload = map[string]interface{}{
+			"history_id":     entry.ID,
+			"device_id":      entry.DeviceID,
+			"device_type":    entry.DeviceType.String(),
+			"login_method":   entry.LoginMethod,
+			"login_ip":       entry.IPAddress,
+			"login_location": entry.Location(),
+			"login_time":     entry.CreatedAt.Unix(),
+			"reasons":        entry.Reasons(),
+			"is_trusted":     knownDevice,
+		}
+		if err := m.notificationPub.PublishToUser(entry.UserID, notif); err != nil {
+			logger.Warn("Failed to publish unusual login notification", zap.Error(err))
+		}
+	}
+
+	if m.emailSender == nil || user.Email == nil || *user.Email == "" {
+		return
+	}
+	reportLink := ""
+	if m.config.ReportURL != "" && m.cache != nil {
+		token, err := newLoginReportToken()
+		if err == nil {
+			err = m.cache.Set(ctx, loginReportKey(token), strconv.FormatInt(entry.ID, 10), m.config.ReportTTL)
+		}
+		if err != nil {
+			logger.Warn("Failed to create login report token", zap.Error(err))
+		} else {
+			reportLink = m.config.ReportURL + reportURLSeparator(m.config.ReportURL) + "token=" + url.QueryEscape(token)
+		}
+	}
+
+	// SMTP may take seconds, the login must not wait for it
+	to, subject, content := *user.Email, "AnyChat: new sign-in to your account", unusualLoginEmail(entry, reportLink)
+	go func() {
+		if err := m.emailSender.Send(to, subject, content); err != nil {
+			logger.Warn("Failed to send unusual login email", zap.Error(err), zap.String("userID", entry.UserID))
+		}
+	}()
+}
+
+// unusualLoginEmail email body of an unusual login alert
+func unusualLoginEmail(entry *model.UserLoginHistory, reportLink string) string {
+	var b strings.Builder
+	b.WriteString("Your AnyChat account was just signed in from a device or place it has not been used from recently.\n\n")
+	fmt.Fprintf(&b, "Time: %s\n", entry.CreatedAt.UTC().Format("2006-01-02 15:04:05 MST"))
+	fmt.Fprintf(&b, "Device: %s\n", entry.DeviceType.String())
+	if entry.UserAgent != "" {
+		fmt.Fprintf(&b, "Browser/App: %s\n", entry.UserAgent)
+	}
+	fmt.Fprintf(&b, "IP address: %s\n", entry.IPAddress)
+	if location := entry.Location(); location != "" {
+		fmt.Fprintf(&b, "Location: %s\n", location)
+	}
+	b.WriteString("\nIf this was you, you can ignore this email.\n")
+	if reportLink != "" {
+		fmt.Fprintf(&b, "If this wasn't you, open the link below to sign that device out, then reset your password:\n%s\n", reportLink)
+	} else {
+		b.WriteString("If this wasn't you, sign that device out in Settings > Devices and reset your password.\n")
+	}
+	return b.String()
+}
+
+// ipNetworkPrefix network of an IP: /24 for IPv4, /48 for IPv6, empty when the IP is invalid
+func ipNetworkPrefix(ip string) string {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return ""
+	}
+	return prefix.String()
+}
+
+func reportURLSeparator(reportURL string) string {
+	if strings.Contains(reportURL, "?") {
+		return "&"
+	}
+	return "?"
+}
+
+func loginReportKey(token string) string {
+	return loginReportKeyPrefix + token
+}
+
+func newLoginReportToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/anychat/server/internal/auth/dto"
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	mfaChallengeKeyPrefix   = "auth:mfa:challenge:"
+	mfaChallengeTTL         = 5 * time.Minute
+	mfaChallengeMaxAttempts = 5
+)
+
+// completeLogin issues a session directly, or a pending MFA challenge when the user has MFA enabled
+func (s *authServiceImpl) completeLogin(ctx context.Context, user *model.User, login loginDevice) (*dto.LoginResponse, error) {
+	if s.mfaSvc == nil {
+		return s.issueLoginSession(ctx, user, login)
+	}
+
+	enabled, err := s.mfaSvc.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return s.issueLoginSession(ctx, user, login)
+	}
+
+	if s.cache == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "MFA challenge cache is not configured")
+	}
+
+	token, err := newMFAChallengeToken()
+	if err != nil {
+		return nil, err
+	}
+	key := mfaChallengeKey(token)
+	if err := s.cache.HSet(ctx, key,
+		"user_id", user.ID,
+		"device_type", strconv.Itoa(int(login.deviceType)),
+		"device_id", login.deviceID,
+		"client_version", login.clientVersion,
+		"ip_address", login.ipAddress,
+		"user_agent", login.userAgent,
+		"login_method", login.method,
+		"attempts", "0",
+	); err != nil {
+		return nil, err
+	}
+	if err := s.cache.Expire(ctx, key, mfaChallengeTTL); err != nil {
+		return nil, err
+	}
+
+	return &dto.LoginResponse{
+		UserID:      user.ID,
+		MFARequired: true,
+		MFAToken:    token,
+	}, nil
+}
+
+// VerifyLoginMFA completes a pending login with a TOTP or recovery code
+func (s *authServiceImpl) VerifyLoginMFA(ctx context.Context, req *dto.VerifyLoginMFARequest) (*dto.LoginResponse, error) {
+	if s.mfaSvc == nil || s.cache == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "MFA is not configured")
+	}
+
+	key := mfaChallengeKey(req.MFAToken)
+	fields, err := s.cache.HGetAll(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 || fields["user_id"] == "" {
+		return nil, errors.NewBusiness(errors.CodeMFAChallengeInvalid, "")
+	}
+
+	// cap attempts per challenge, the user must log in again once exhausted
+	attempts, err := s.cache.GetClient().HIncrBy(ctx, key, "attempts", 1).Result()
+	if err != nil {
+		return nil, err
+	}
+	if attempts > mfaChallengeMaxAttempts {
+		_ = s.cache.Del(ctx, key)
+		return nil, errors.NewBusiness(errors.CodeMFAChallengeInvalid, "")
+	}
+
+	userID := fields["user_id"]
+	if err := s.mfaSvc.Verify(ctx, userID, req.Code); err != nil {
+		return nil, err
+	}
+
+	// challenge is single-use, only the caller that deletes it proceeds
+	deleted, err := s.cache.GetClient().Del(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, errors.NewBusiness(errors.CodeMFAChallengeInvalid, "")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeUserNotFound, "")
+		}
+		return nil, err
+	}
+	if !user.IsActive() {
+		return nil, errors.NewBusiness(errors.CodeAccountDisabled, "")
+	}
+
+	deviceType, _ := strconv.Atoi(fields["device_type"])
+	logger.Info("Login MFA verified", zap.String("userID", userID), zap.String("deviceID", fields["device_id"]))
+	return s.issueLoginSession(ctx, user, loginDevice{
+		deviceType:    model.DeviceType(deviceType),
+		deviceID:      fields["device_id"],
+		clientVersion: fields["client_version"],
+		ipAddress:     fields["ip_address"],
+		userAgent:     fields["user_agent"],
+		method:        fields["login_method"],
+	})
+}
+
+func mfaChallengeKey(token string) string {
+	return mfaChallengeKeyPrefix + token
+}
+
+func newMFAChallengeToken() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
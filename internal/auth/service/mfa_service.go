@@ -0,0 +1,408 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/anychat/server/internal/auth/dto"
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/internal/auth/repository"
+	"github.com/anychat/server/pkg/crypto"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	pkgredis "github.com/anychat/server/pkg/redis"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	recoveryCodeHalfLen  = 5
+)
+
+// MFAService TOTP two-factor authentication service
+type MFAService interface {
+	IsEnabled(ctx context.Context, userID string) (bool, error)
+	GetStatus(ctx context.Context, userID string) (*dto.MFAStatusResponse, error)
+	BeginEnrollment(ctx context.Context, userID string) (*dto.BeginMFAEnrollmentResponse, error)
+	ConfirmEnrollment(ctx context.Context, userID, code string) (*dto.MFARecoveryCodesResponse, error)
+	// Verify accepts a TOTP code or an unused recovery code
+	Verify(ctx context.Context, userID, code string) error
+	// RequireIfEnabled verifies code only when the user has MFA enabled, used to guard sensitive operations
+	RequireIfEnabled(ctx context.Context, userID, code string) error
+	Disable(ctx context.Context, userID string, req *dto.MFAReauthRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, userID string, req *dto.MFAReauthRequest) (*dto.MFARecoveryCodesResponse, error)
+}
+
+// MFAConfig MFA service config
+type MFAConfig struct {
+	Issuer            string        // otpauth issuer shown in authenticator apps
+	SecretKey         string        // encrypts TOTP secrets and keys recovery code hashes
+	RecoveryCodeCount int           // recovery codes per generation
+	Skew              int           // accepted TOTP steps before/after the current one
+	MaxFailures       int           // failed verifications allowed per window
+	FailureWindow     time.Duration // failure counting window
+}
+
+type mfaServiceImpl struct {
+	mfaRepo  repository.UserMFARepository
+	userRepo repository.UserRepository
+	cache    *pkgredis.Client
+	config   MFAConfig
+}
+
+// NewMFAService creates MFA service
+func NewMFAService(
+	mfaRepo repository.UserMFARepository,
+	userRepo repository.UserRepository,
+	cache *pkgredis.Client,
+	config MFAConfig,
+) MFAService {
+	if config.Issuer == "" {
+		config.Issuer = "AnyChat"
+	}
+	if config.RecoveryCodeCount == 0 {
+		config.RecoveryCodeCount = 10
+	}
+	if config.Skew == 0 {
+		config.Skew = 1
+	}
+	if config.MaxFailures == 0 {
+		config.MaxFailures = 5
+	}
+	if config.FailureWindow == 0 {
+		config.FailureWindow = 15 * time.Minute
+	}
+
+	return &mfaServiceImpl{
+		mfaRepo:  mfaRepo,
+		userRepo: userRepo,
+		cache:    cache,
+		config:   config,
+	}
+}
+
+// IsEnabled checks whether user has MFA enabled
+func (s *mfaServiceImpl) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	mfa, err := s.mfaRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return mfa.Enabled, nil
+}
+
+// GetStatus gets MFA status
+func (s *mfaServiceImpl) GetStatus(ctx context.Context, userID string) (*dto.MFAStatusResponse, error) {
+	enabled, err := s.IsEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.MFAStatusResponse{Enabled: enabled}
+	if enabled {
+		remaining, err := s.mfaRepo.CountUnusedRecoveryCodes(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		resp.RemainingRecoveryCodes = remaining
+	}
+	return resp, nil
+}
+
+// BeginEnrollment generates a new pending TOTP secret
+func (s *mfaServiceImpl) BeginEnrollment(ctx context.Context, userID string) (*dto.BeginMFAEnrollmentResponse, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := s.IsEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		return nil, errors.NewBusiness(errors.CodeMFAAlreadyEnabled, "")
+	}
+
+	secret, err := crypto.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := crypto.EncryptString(s.config.SecretKey, secret)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfaRepo.SavePending(ctx, userID, encrypted); err != nil {
+		return nil, err
+	}
+
+	return &dto.BeginMFAEnrollmentResponse{
+		Secret:     secret,
+		OtpauthURI: crypto.TOTPURI(s.config.Issuer, accountLabel(user), secret),
+	}, nil
+}
+
+// ConfirmEnrollment enables MFA after the first code is verified and returns the initial recovery codes
+func (s *mfaServiceImpl) ConfirmEnrollment(ctx context.Context, userID, code string) (*dto.MFARecoveryCodesResponse, error) {
+	mfa, err := s.mfaRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeParamError, "MFA enrollment not started")
+		}
+		return nil, err
+	}
+	if mfa.Enabled {
+		return nil, errors.NewBusiness(errors.CodeMFAAlreadyEnabled, "")
+	}
+
+	if err := s.checkFailures(ctx, userID); err != nil {
+		return nil, err
+	}
+	step, ok, err := s.validateTOTP(mfa, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		s.recordFailure(ctx, userID)
+		return nil, errors.NewBusiness(errors.CodeMFACodeError, "")
+	}
+
+	codes, hashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfaRepo.Enable(ctx, userID, step, hashes); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeMFAAlreadyEnabled, "")
+		}
+		return nil, err
+	}
+	s.clearFailures(ctx, userID)
+
+	logger.Info("MFA enabled", zap.String("userID", userID))
+	return &dto.MFARecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// Verify verifies a TOTP code or consumes a recovery code
+func (s *mfaServiceImpl) Verify(ctx context.Context, userID, code string) error {
+	mfa, err := s.mfaRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.NewBusiness(errors.CodeMFANotEnabled, "")
+		}
+		return err
+	}
+	if !mfa.Enabled {
+		return errors.NewBusiness(errors.CodeMFANotEnabled, "")
+	}
+
+	if err := s.checkFailures(ctx, userID); err != nil {
+		return err
+	}
+
+	ok, err := s.verifyEnabled(ctx, mfa, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.recordFailure(ctx, userID)
+		return errors.NewBusiness(errors.CodeMFACodeError, "")
+	}
+
+	s.clearFailures(ctx, userID)
+	return nil
+}
+
+// RequireIfEnabled verifies code when MFA is enabled, does nothing otherwise
+func (s *mfaServiceImpl) RequireIfEnabled(ctx context.Context, userID, code string) error {
+	enabled, err := s.IsEnabled(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+	if strings.TrimSpace(code) == "" {
+		return errors.NewBusiness(errors.CodeMFARequired, "")
+	}
+	return s.Verify(ctx, userID, code)
+}
+
+// Disable disables MFA after password and MFA re-authentication
+func (s *mfaServiceImpl) Disable(ctx context.Context, userID string, req *dto.MFAReauthRequest) error {
+	if err := s.reauthenticate(ctx, userID, req); err != nil {
+		return err
+	}
+	if err := s.mfaRepo.Delete(ctx, userID); err != nil {
+		return err
+	}
+
+	logger.Info("MFA disabled", zap.String("userID", userID))
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes after password and MFA re-authentication
+func (s *mfaServiceImpl) RegenerateRecoveryCodes(ctx context.Context, userID string, req *dto.MFAReauthRequest) (*dto.MFARecoveryCodesResponse, error) {
+	if err := s.reauthenticate(ctx, userID, req); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfaRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	return &dto.MFARecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// reauthenticate checks the account password (when one is set) and a current MFA code
+func (s *mfaServiceImpl) reauthenticate(ctx context.Context, userID string, req *dto.MFAReauthRequest) error {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.PasswordHash != "" && !crypto.CheckPassword(req.Password, user.PasswordHash) {
+		return errors.NewBusiness(errors.CodePasswordError, "")
+	}
+	return s.Verify(ctx, userID, req.Code)
+}
+
+// verifyEnabled checks code as TOTP first, then as recovery code
+func (s *mfaServiceImpl) verifyEnabled(ctx context.Context, mfa *model.UserMFA, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if len(code) == crypto.TOTPDigits {
+		step, ok, err := s.validateTOTP(mfa, code)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			// each time step can only be used once
+			return s.mfaRepo.UpdateLastUsedStep(ctx, mfa.UserID, step)
+		}
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	if normalized == "" {
+		return false, nil
+	}
+	used, err := s.mfaRepo.UseRecoveryCode(ctx, mfa.UserID, s.hashRecoveryCode(normalized))
+	if err != nil {
+		return false, err
+	}
+	if used {
+		logger.Info("MFA recovery code used", zap.String("userID", mfa.UserID))
+	}
+	return used, nil
+}
+
+func (s *mfaServiceImpl) validateTOTP(mfa *model.UserMFA, code string) (int64, bool, error) {
+	secret, err := crypto.DecryptString(s.config.SecretKey, mfa.SecretEncrypted)
+	if err != nil {
+		return 0, false, fmt.Errorf("decrypt totp secret: %w", err)
+	}
+	step, ok := crypto.ValidateTOTP(secret, code, time.Now(), s.config.Skew)
+	return step, ok, nil
+}
+
+func (s *mfaServiceImpl) generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, s.config.RecoveryCodeCount)
+	hashes := make([]string, 0, s.config.RecoveryCodeCount)
+	for i := 0; i < s.config.RecoveryCodeCount; i++ {
+		raw := make([]byte, recoveryCodeHalfLen*2)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		for j := range raw {
+			raw[j] = recoveryCodeAlphabet[int(raw[j])%len(recoveryCodeAlphabet)]
+		}
+		code := string(raw[:recoveryCodeHalfLen]) + "-" + string(raw[recoveryCodeHalfLen:])
+		codes = append(codes, code)
+		hashes = append(hashes, s.hashRecoveryCode(normalizeRecoveryCode(code)))
+	}
+	return codes, hashes, nil
+}
+
+func (s *mfaServiceImpl) hashRecoveryCode(code string) string {
+	mac := hmac.New(sha256.New, []byte(s.config.SecretKey))
+	mac.Write([]byte(code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *mfaServiceImpl) checkFailures(ctx context.Context, userID string) error {
+	if s.cache == nil {
+		return nil
+	}
+	value, err := s.cache.Get(ctx, mfaFailureKey(userID))
+	if err != nil {
+		return nil
+	}
+	count, _ := parseInt64(value)
+	if count >= int64(s.config.MaxFailures) {
+		return errors.NewBusiness(errors.CodeVerifyAttemptsExceeded, "Too many failed attempts, please try again later")
+	}
+	return nil
+}
+
+func (s *mfaServiceImpl) recordFailure(ctx context.Context, userID string) {
+	if s.cache == nil {
+		return
+	}
+	key := mfaFailureKey(userID)
+	count, err := s.cache.Incr(ctx, key)
+	if err != nil {
+		logger.Warn("Failed to record MFA failure", zap.Error(err))
+		return
+	}
+	if count == 1 {
+		_ = s.cache.Expire(ctx, key, s.config.FailureWindow)
+	}
+}
+
+func (s *mfaServiceImpl) clearFailures(ctx context.Context, userID string) {
+	if s.cache == nil {
+		return
+	}
+	_ = s.cache.Del(ctx, mfaFailureKey(userID))
+}
+
+func (s *mfaServiceImpl) getUser(ctx context.Context, userID string) (*model.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeUserNotFound, "")
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func mfaFailureKey(userID string) string {
+	return "auth:mfa:fail:" + userID
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// accountLabel picks the account name shown in authenticator apps
+func accountLabel(user *model.User) string {
+	if user.Email != nil && *user.Email != "" {
+		return *user.Email
+	}
+	if user.Phone != nil && *user.Phone != "" {
+		return *user.Phone
+	}
+	return user.ID
+}
@@ -0,0 +1,425 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/anychat/server/internal/auth/dto"
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/internal/auth/oidc"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	oidcStateKeyPrefix  = "auth:oidc:state:"
+	defaultOIDCStateTTL = 10 * time.Minute
+)
+
+// oidcState authorization in progress, stored in Redis until the callback consumes it
+type oidcState struct {
+	provider     string
+	nonce        string
+	codeVerifier string
+	redirectURI  string
+	userID       string // set for link authorizations
+}
+
+// ListOIDCProviders lists configured identity providers
+func (s *authServiceImpl) ListOIDCProviders(ctx context.Context) *dto.ListOIDCProvidersResponse {
+	resp := &dto.ListOIDCProvidersResponse{Providers: make([]*dto.OIDCProviderInfo, 0)}
+	for _, provider := range s.oidcProviders.List() {
+		resp.Providers = append(resp.Providers, &dto.OIDCProviderInfo{
+			Name:        provider.Name(),
+			DisplayName: provider.Config().DisplayName,
+		})
+	}
+	return resp
+}
+
+// BeginOIDCLogin starts an authorization code flow with PKCE, req.UserID binds it to a user for linking
+func (s *authServiceImpl) BeginOIDCLogin(ctx context.Context, req *dto.BeginOIDCLoginRequest) (*dto.BeginOIDCLoginResponse, error) {
+	provider, err := s.getOIDCProvider(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if s.cache == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "OIDC state cache is not configured")
+	}
+
+	redirectURI, err := provider.ResolveRedirectURI(req.RedirectURI)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeParamError, err.Error())
+	}
+	authReq, err := provider.AuthCodeURL(ctx, redirectURI)
+	if err != nil {
+		logger.Error("Failed to start OIDC authorization", zap.Error(err), zap.String("provider", provider.Name()))
+		return nil, errors.NewBusiness(errors.CodeOIDCAuthFailed, "")
+	}
+
+	ttl := s.oidcStateTTL()
+	key := oidcStateKey(authReq.State)
+	if err := s.cache.HSet(ctx, key,
+		"provider", provider.Name(),
+		"nonce", authReq.Nonce,
+		"code_verifier", authReq.CodeVerifier,
+		"redirect_uri", authReq.RedirectURI,
+		"user_id", req.UserID,
+	); err != nil {
+		return nil, err
+	}
+	if err := s.cache.Expire(ctx, key, ttl); err != nil {
+		return nil, err
+	}
+
+	return &dto.BeginOIDCLoginResponse{
+		AuthorizationURL: authReq.URL,
+		State:            authReq.State,
+		ExpiresIn:        int64(ttl.Seconds()),
+	}, nil
+}
+
+// CompleteOIDCLogin signs in with the code from the provider callback.
+// The identity resolves to its linked user, else to the user with the same verified email, else to a new account
+func (s *authServiceImpl) CompleteOIDCLogin(ctx context.Context, req *dto.CompleteOIDCLoginRequest) (*dto.LoginResponse, error) {
+	if !req.DeviceType.IsValid() {
+		return nil, errors.NewBusiness(errors.CodeParamError, "invalid device type")
+	}
+	provider, err := s.getOIDCProvider(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.consumeOIDCState(ctx, req.State, provider.Name())
+	if err != nil {
+		return nil, err
+	}
+	// a link authorization must not sign anyone in
+	if state.userID != "" {
+		return nil, errors.NewBusiness(errors.CodeOIDCStateInvalid, "")
+	}
+
+	identity, err := s.exchangeOIDCCode(ctx, provider, req.Code, state)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.resolveOIDCUser(ctx, provider, identity, req.Nickname)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive() {
+		return nil, errors.NewBusiness(errors.CodeAccountDisabled, "")
+	}
+
+	return s.completeLogin(ctx, user, loginDevice{
+		deviceType:    req.DeviceType,
+		deviceID:      req.DeviceID,
+		clientVersion: req.ClientVersion,
+		ipAddress:     req.IpAddress,
+		userAgent:     req.UserAgent,
+		method:        model.LoginMethodOIDC,
+	})
+}
+
+// LinkIdentity links the identity of a link authorization started by the same user
+func (s *authServiceImpl) LinkIdentity(ctx context.Context, userID string, req *dto.LinkIdentityRequest) (*dto.IdentityInfo, error) {
+	provider, err := s.getOIDCProvider(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.consumeOIDCState(ctx, req.State, provider.Name())
+	if err != nil {
+		return nil, err
+	}
+	if state.userID == "" || state.userID != userID {
+		return nil, errors.NewBusiness(errors.CodeOIDCStateInvalid, "")
+	}
+
+	identity, err := s.exchangeOIDCCode(ctx, provider, req.Code, state)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.identityRepo.GetByProviderSubject(ctx, provider.Name(), identity.Subject)
+	if err == nil {
+		if existing.UserID != userID {
+			return nil, errors.NewBusiness(errors.CodeIdentityAlreadyLinked, "")
+		}
+		return s.toIdentityInfo(existing), nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	// one identity per provider and user
+	if _, err := s.identityRepo.GetByUserIDAndProvider(ctx, userID, provider.Name()); err == nil {
+		return nil, errors.NewBusiness(errors.CodeIdentityAlreadyLinked, "another account of this provider is already linked")
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	linked := newUserIdentity(userID, provider.Name(), identity)
+	if err := s.identityRepo.Create(ctx, linked); err != nil {
+		if isDuplicateKey(err) {
+			return nil, errors.NewBusiness(errors.CodeIdentityAlreadyLinked, "")
+		}
+		return nil, err
+	}
+
+	logger.Info("OIDC identity linked", zap.String("userID", userID), zap.String("provider", provider.Name()))
+	return s.toIdentityInfo(linked), nil
+}
+
+// ListIdentities lists identities linked to the user
+func (s *authServiceImpl) ListIdentities(ctx context.Context, userID string) (*dto.ListIdentitiesResponse, error) {
+	identities, err := s.identityRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.ListIdentitiesResponse{Identities: make([]*dto.IdentityInfo, 0, len(identities))}
+	for _, identity := range identities {
+		resp.Identities = append(resp.Identities, s.toIdentityInfo(identity))
+	}
+	return resp, nil
+}
+
+// UnlinkIdentity removes a linked identity, unless it is the only way left to sign in
+func (s *authServiceImpl) UnlinkIdentity(ctx context.Context, userID, providerName string) error {
+	if _, err := s.identityRepo.GetByUserIDAndProvider(ctx, userID, providerName); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.NewBusiness(errors.CodeIdentityNotFound, "")
+		}
+		return err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.NewBusiness(errors.CodeUserNotFound, "")
+		}
+		return err
+	}
+	// password, phone code or email code login keep the account reachable
+	if user.PasswordHash == "" && user.Phone == nil && user.Email == nil {
+		identities, err := s.identityRepo.ListByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if len(identities) <= 1 {
+			return errors.NewBusiness(errors.CodeLastSignInMethod, "")
+		}
+	}
+
+	if err := s.identityRepo.Delete(ctx, userID, providerName); err != nil {
+		return err
+	}
+	logger.Info("OIDC identity unlinked", zap.String("userID", userID), zap.String("provider", providerName))
+	return nil
+}
+
+// resolveOIDCUser finds or creates the user an identity signs in as
+func (s *authServiceImpl) resolveOIDCUser(ctx context.Context, provider *oidc.Provider, identity *oidc.Identity, nickname string) (*model.User, error) {
+	config := provider.Config()
+
+	linked, err := s.identityRepo.GetByProviderSubject(ctx, provider.Name(), identity.Subject)
+	if err == nil {
+		user, err := s.userRepo.GetByID(ctx, linked.UserID)
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, errors.NewBusiness(errors.CodeUserNotFound, "")
+			}
+			return nil, err
+		}
+		if err := s.identityRepo.UpdateLastLogin(ctx, linked.ID); err != nil {
+			logger.Warn("Failed to update identity last login", zap.Error(err))
+		}
+		return user, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	// only a provider-verified email proves the identity owns the existing account
+	var emailUser *model.User
+	if identity.EmailVerified && identity.Email != "" {
+		emailUser, err = s.userRepo.GetByEmail(ctx, identity.Email)
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return nil, err
+		}
+	}
+	if emailUser != nil && config.LinkByEmail {
+		return s.linkOIDCIdentityByEmail(ctx, emailUser, provider.Name(), identity)
+	}
+
+	if !config.AutoRegister {
+		return nil, errors.NewBusiness(errors.CodeIdentityNotLinked, "")
+	}
+	// the email stays with the existing account when linking by email is off
+	email := ""
+	if identity.EmailVerified && emailUser == nil {
+		email = identity.Email
+	}
+	return s.registerByOIDC(ctx, provider.Name(), identity, email, nickname)
+}
+
+// linkOIDCIdentityByEmail links a new identity to the account owning its verified email
+func (s *authServiceImpl) linkOIDCIdentityByEmail(ctx context.Context, user *model.User, providerName string, identity *oidc.Identity) (*model.User, error) {
+	if _, err := s.identityRepo.GetByUserIDAndProvider(ctx, user.ID, providerName); err == nil {
+		// the account already has a different identity of this provider
+		return nil, errors.NewBusiness(errors.CodeIdentityAlreadyLinked, "another account of this provider is already linked")
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	linked := newUserIdentity(user.ID, providerName, identity)
+	now := time.Now()
+	linked.LastLoginAt = &now
+	if err := s.identityRepo.Create(ctx, linked); err != nil {
+		if isDuplicateKey(err) {
+			return nil, errors.NewBusiness(errors.CodeIdentityAlreadyLinked, "")
+		}
+		return nil, err
+	}
+
+	logger.Info("OIDC identity linked by verified email", zap.String("userID", user.ID), zap.String("provider", providerName))
+	return user, nil
+}
+
+// registerByOIDC creates a password-less account for a new identity
+func (s *authServiceImpl) registerByOIDC(ctx context.Context, providerName string, identity *oidc.Identity, email, nickname string) (*model.User, error) {
+	user := &model.User{
+		ID:     uuid.New().String(),
+		Status: model.UserStatusNormal,
+	}
+	if email != "" {
+		user.Email = &email
+	}
+	linked := newUserIdentity(user.ID, providerName, identity)
+	now := time.Now()
+	linked.LastLoginAt = &now
+	if err := s.identityRepo.CreateWithUser(ctx, user, linked); err != nil {
+		if isDuplicateKey(err) {
+			// a concurrent callback for the same identity won
+			return nil, errors.NewBusiness(errors.CodeIdentityAlreadyLinked, "")
+		}
+		return nil, err
+	}
+
+	if nickname == "" {
+		nickname = identity.Name
+	}
+	if s.userClient != nil {
+		if err := s.userClient.InitUserData(ctx, user.ID, nickname); err != nil {
+			// init failure should not block login, just log error
+			logger.Error("Failed to init user data", zap.Error(err), zap.String("userID", user.ID))
+		}
+	}
+
+	logger.Info("User registered by OIDC login", zap.String("userID", user.ID), zap.String("provider", providerName))
+	return user, nil
+}
+
+// exchangeOIDCCode redeems the code, any provider or token error is reported as a failed sign-in
+func (s *authServiceImpl) exchangeOIDCCode(ctx context.Context, provider *oidc.Provider, code string, state *oidcState) (*oidc.Identity, error) {
+	identity, err := provider.Exchange(ctx, code, state.redirectURI, state.codeVerifier, state.nonce)
+	if err != nil {
+		logger.Warn("OIDC code exchange failed", zap.Error(err), zap.String("provider", provider.Name()))
+		return nil, errors.NewBusiness(errors.CodeOIDCAuthFailed, "")
+	}
+	return identity, nil
+}
+
+// consumeOIDCState loads and deletes a state in one step, so a callback can only be completed once
+func (s *authServiceImpl) consumeOIDCState(ctx context.Context, state, providerName string) (*oidcState, error) {
+	if state == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "state required")
+	}
+	if s.cache == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "OIDC state cache is not configured")
+	}
+
+	key := oidcStateKey(state)
+	var fields *redis.StringStringMapCmd
+	if _, err := s.cache.GetClient().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		fields = pipe.HGetAll(ctx, key)
+		pipe.Del(ctx, key)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	values := fields.Val()
+	if len(values) == 0 || values["provider"] != providerName {
+		return nil, errors.NewBusiness(errors.CodeOIDCStateInvalid, "")
+	}
+	return &oidcState{
+		provider:     values["provider"],
+		nonce:        values["nonce"],
+		codeVerifier: values["code_verifier"],
+		redirectURI:  values["redirect_uri"],
+		userID:       values["user_id"],
+	}, nil
+}
+
+func (s *authServiceImpl) getOIDCProvider(name string) (*oidc.Provider, error) {
+	provider, ok := s.oidcProviders.Get(name)
+	if !ok {
+		return nil, errors.NewBusiness(errors.CodeOIDCProviderNotFound, "")
+	}
+	return provider, nil
+}
+
+func (s *authServiceImpl) toIdentityInfo(identity *model.UserIdentity) *dto.IdentityInfo {
+	displayName := identity.Provider
+	if provider, ok := s.oidcProviders.Get(identity.Provider); ok {
+		displayName = provider.Config().DisplayName
+	}
+	return &dto.IdentityInfo{
+		Provider:    identity.Provider,
+		DisplayName: displayName,
+		Email:       identity.Email,
+		LinkedAt:    identity.CreatedAt,
+		LastLoginAt: identity.LastLoginAt,
+	}
+}
+
+func (s *authServiceImpl) oidcStateTTL() time.Duration {
+	if s.config.OIDCStateTTL > 0 {
+		return s.config.OIDCStateTTL
+	}
+	return defaultOIDCStateTTL
+}
+
+func newUserIdentity(userID, providerName string, identity *oidc.Identity) *model.UserIdentity {
+	return &model.UserIdentity{
+		UserID:      userID,
+		Provider:    providerName,
+		Subject:     identity.Subject,
+		Email:       truncateRunes(identity.Email, 100),
+		DisplayName: truncateRunes(identity.Name, 100),
+	}
+}
+
+func truncateRunes(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[:max])
+}
+
+func oidcStateKey(state string) string {
+	return oidcStateKeyPrefix + state
+}
+
+// isDuplicateKey reports a unique constraint violation
+func isDuplicateKey(err error) bool {
+	return err == gorm.ErrDuplicatedKey || strings.Contains(err.Error(), "SQLSTATE 23505")
+}
@@ -0,0 +1,395 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/anychat/server/internal/auth/dto"
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/pkg/crypto"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	qrLoginKeyPrefix    = "auth:qrlogin:"
+	qrLoginContentURI   = "anychat://qr-login?ticket="
+	defaultQRLoginTTL   = 120 * time.Second
+	qrLoginKeyGrace     = 60 * time.Second // keeps expired tickets around so pollers can observe the final state
+	qrLoginPollInterval = 500 * time.Millisecond
+	qrLoginMaxWait      = 30 * time.Second
+	qrLoginSecretLength = 64
+)
+
+// qrLoginTransitionScript moves a ticket from ARGV[1] status to new field values atomically.
+// Returns -1 if the ticket does not exist, 0 if the current status does not match, 1 on success.
+var qrLoginTransitionScript = redis.NewScript(`
+local cur = redis.call('HGET', KEYS[1], 'status')
+if not cur then
+	return -1
+end
+if cur ~= ARGV[1] then
+	return 0
+end
+for i = 2, #ARGV, 2 do
+	redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1])
+end
+return 1
+`)
+
+// qrLoginTicket QR login ticket state stored in Redis
+type qrLoginTicket struct {
+	status          model.QRLoginStatus
+	deviceID        string
+	pollSecretHash  string
+	deviceType      model.DeviceType
+	clientVersion   string
+	ipAddress       string
+	userAgent       string
+	userID          string
+	scannerDeviceID string
+	expiresAt       time.Time
+}
+
+func (t *qrLoginTicket) isExpired() bool {
+	if t.status != model.QRLoginStatusPending && t.status != model.QRLoginStatusScanned {
+		return false
+	}
+	return time.Now().After(t.expiresAt)
+}
+
+// CreateQRLoginTicket creates a QR login ticket for a desktop device
+func (s *authServiceImpl) CreateQRLoginTicket(ctx context.Context, req *dto.CreateQRLoginRequest) (*dto.CreateQRLoginResponse, error) {
+	if !isQRLoginDesktop(req.DeviceType) {
+		return nil, errors.NewBusiness(errors.CodeParamError, "QR login is only available for web, pc and h5 devices")
+	}
+	if req.DeviceID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "device_id required")
+	}
+	if s.cache == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "QR login cache is not configured")
+	}
+
+	ticket, err := newQRLoginTicketID()
+	if err != nil {
+		return nil, err
+	}
+	// the ticket is visible to anyone who sees the QR code, polling also needs a secret only the desktop holds
+	pollSecret, err := crypto.GenerateRandomString(qrLoginSecretLength)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := s.qrLoginTTL()
+	expiresAt := time.Now().Add(ttl)
+	key := qrLoginKey(ticket)
+	if err := s.cache.HSet(ctx, key,
+		"status", strconv.Itoa(int(model.QRLoginStatusPending)),
+		"device_id", req.DeviceID,
+		"poll_secret_hash", crypto.HashToken(pollSecret),
+		"device_type", strconv.Itoa(int(req.DeviceType)),
+		"client_version", req.ClientVersion,
+		"ip_address", req.IpAddress,
+		"user_agent", req.UserAgent,
+		"expires_at", strconv.FormatInt(expiresAt.Unix(), 10),
+	); err != nil {
+		return nil, err
+	}
+	if err := s.cache.Expire(ctx, key, ttl+qrLoginKeyGrace); err != nil {
+		return nil, err
+	}
+
+	s.recordQRLoginEvent(ctx, ticket, model.QRLoginEventCreated, "", req.DeviceID, req.DeviceType, req.IpAddress)
+
+	return &dto.CreateQRLoginResponse{
+		Ticket:     ticket,
+		QRContent:  qrLoginContentURI + ticket,
+		ExpiresIn:  int64(ttl.Seconds()),
+		PollSecret: pollSecret,
+	}, nil
+}
+
+// GetQRLoginStatus long-polls ticket status for the desktop device that created it.
+// Once the ticket is confirmed, tokens are issued for the desktop device and the ticket is consumed.
+func (s *authServiceImpl) GetQRLoginStatus(ctx context.Context, req *dto.GetQRLoginStatusRequest) (*dto.GetQRLoginStatusResponse, error) {
+	if s.cache == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "QR login cache is not configured")
+	}
+	if req.PollSecret == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "poll_secret required")
+	}
+	pollSecretHash := crypto.HashToken(req.PollSecret)
+
+	wait := time.Duration(req.WaitSeconds) * time.Second
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > qrLoginMaxWait {
+		wait = qrLoginMaxWait
+	}
+	deadline := time.Now().Add(wait)
+
+	for {
+		t, err := s.loadQRLoginTicket(ctx, req.Ticket)
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			return &dto.GetQRLoginStatusResponse{Status: model.QRLoginStatusExpired}, nil
+		}
+		if subtle.ConstantTimeCompare([]byte(t.pollSecretHash), []byte(pollSecretHash)) != 1 || t.deviceID != req.DeviceID {
+			return nil, errors.NewBusiness(errors.CodeQRLoginInvalid, "ticket belongs to another device")
+		}
+
+		if t.isExpired() {
+			s.expireQRLoginTicket(ctx, req.Ticket, t)
+			t.status = model.QRLoginStatusExpired
+		}
+
+		switch t.status {
+		case model.QRLoginStatusConfirmed:
+			return s.completeQRLogin(ctx, req.Ticket, t)
+		case model.QRLoginStatusConsumed:
+			return nil, errors.NewBusiness(errors.CodeQRLoginInvalid, "ticket already used")
+		}
+
+		if t.status != req.LastStatus || !time.Now().Before(deadline) {
+			return &dto.GetQRLoginStatusResponse{Status: t.status}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(qrLoginPollInterval):
+		}
+	}
+}
+
+// ScanQRLogin marks a ticket as scanned by a logged-in mobile device
+func (s *authServiceImpl) ScanQRLogin(ctx context.Context, userID string, req *dto.QRLoginActionRequest) (*dto.ScanQRLoginResponse, error) {
+	if req.DeviceType != model.DeviceTypeIOS && req.DeviceType != model.DeviceTypeAndroid {
+		return nil, errors.NewBusiness(errors.CodeForbidden, "QR login must be confirmed from a mobile device")
+	}
+
+	t, err := s.loadActiveQRLoginTicket(ctx, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	// repeated scan from the same device is idempotent
+	alreadyScanned := t.status == model.QRLoginStatusScanned && t.userID == userID && t.scannerDeviceID == req.DeviceID
+	if !alreadyScanned {
+		ok, err := s.transitionQRLogin(ctx, req.Ticket, model.QRLoginStatusPending,
+			"status", strconv.Itoa(int(model.QRLoginStatusScanned)),
+			"user_id", userID,
+			"scanner_device_id", req.DeviceID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.NewBusiness(errors.CodeQRLoginInvalid, "ticket already scanned")
+		}
+		s.recordQRLoginEvent(ctx, req.Ticket, model.QRLoginEventScanned, userID, req.DeviceID, req.DeviceType, req.IpAddress)
+	}
+
+	return &dto.ScanQRLoginResponse{
+		DeviceType:    t.deviceType,
+		ClientVersion: t.clientVersion,
+		IpAddress:     t.ipAddress,
+		ExpiresIn:     int64(time.Until(t.expiresAt).Seconds()),
+	}, nil
+}
+
+// ConfirmQRLogin confirms a scanned ticket, the waiting desktop receives tokens on its next poll
+func (s *authServiceImpl) ConfirmQRLogin(ctx context.Context, userID string, req *dto.QRLoginActionRequest) error {
+	return s.finishQRLoginScan(ctx, userID, req, model.QRLoginStatusConfirmed, model.QRLoginEventConfirmed)
+}
+
+// CancelQRLogin rejects a scanned ticket
+func (s *authServiceImpl) CancelQRLogin(ctx context.Context, userID string, req *dto.QRLoginActionRequest) error {
+	return s.finishQRLoginScan(ctx, userID, req, model.QRLoginStatusCancelled, model.QRLoginEventCancelled)
+}
+
+// finishQRLoginScan moves a ticket out of scanned state on behalf of the device that scanned it
+func (s *authServiceImpl) finishQRLoginScan(ctx context.Context, userID string, req *dto.QRLoginActionRequest, to model.QRLoginStatus, event string) error {
+	t, err := s.loadActiveQRLoginTicket(ctx, req.Ticket)
+	if err != nil {
+		return err
+	}
+	if t.status != model.QRLoginStatusScanned || t.userID != userID || t.scannerDeviceID != req.DeviceID {
+		return errors.NewBusiness(errors.CodeQRLoginInvalid, "ticket is not scanned by this device")
+	}
+
+	ok, err := s.transitionQRLogin(ctx, req.Ticket, model.QRLoginStatusScanned, "status", strconv.Itoa(int(to)))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.NewBusiness(errors.CodeQRLoginInvalid, "")
+	}
+
+	s.recordQRLoginEvent(ctx, req.Ticket, event, userID, req.DeviceID, req.DeviceType, req.IpAddress)
+	return nil
+}
+
+// completeQRLogin consumes a confirmed ticket and issues a session for the desktop device
+func (s *authServiceImpl) completeQRLogin(ctx context.Context, ticket string, t *qrLoginTicket) (*dto.GetQRLoginStatusResponse, error) {
+	ok, err := s.transitionQRLogin(ctx, ticket, model.QRLoginStatusConfirmed, "status", strconv.Itoa(int(model.QRLoginStatusConsumed)))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.NewBusiness(errors.CodeQRLoginInvalid, "ticket already used")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, t.userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeUserNotFound, "")
+		}
+		return nil, err
+	}
+	if !user.IsActive() {
+		return nil, errors.NewBusiness(errors.CodeAccountDisabled, "")
+	}
+
+	resp, err := s.issueLoginSession(ctx, user, loginDevice{
+		deviceType:    t.deviceType,
+		deviceID:      t.deviceID,
+		clientVersion: t.clientVersion,
+		ipAddress:     t.ipAddress,
+		userAgent:     t.userAgent,
+		method:        model.LoginMethodQR,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cache.Del(ctx, qrLoginKey(ticket)); err != nil {
+		logger.Warn("Failed to delete QR login ticket", zap.Error(err))
+	}
+	s.recordQRLoginEvent(ctx, ticket, model.QRLoginEventLoggedIn, user.ID, t.deviceID, t.deviceType, t.ipAddress)
+
+	return &dto.GetQRLoginStatusResponse{
+		Status: model.QRLoginStatusConfirmed,
+		Login:  resp,
+	}, nil
+}
+
+// loadActiveQRLoginTicket loads a ticket that can still be acted on by the mobile side
+func (s *authServiceImpl) loadActiveQRLoginTicket(ctx context.Context, ticket string) (*qrLoginTicket, error) {
+	if s.cache == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "QR login cache is not configured")
+	}
+
+	t, err := s.loadQRLoginTicket(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.status == model.QRLoginStatusExpired {
+		return nil, errors.NewBusiness(errors.CodeQRLoginExpired, "")
+	}
+	if t.isExpired() {
+		s.expireQRLoginTicket(ctx, ticket, t)
+		return nil, errors.NewBusiness(errors.CodeQRLoginExpired, "")
+	}
+	return t, nil
+}
+
+// expireQRLoginTicket marks a timed out ticket expired, only the caller winning the transition writes the audit record
+func (s *authServiceImpl) expireQRLoginTicket(ctx context.Context, ticket string, t *qrLoginTicket) {
+	ok, err := s.transitionQRLogin(ctx, ticket, t.status, "status", strconv.Itoa(int(model.QRLoginStatusExpired)))
+	if err != nil {
+		logger.Warn("Failed to expire QR login ticket", zap.Error(err))
+		return
+	}
+	if ok {
+		s.recordQRLoginEvent(ctx, ticket, model.QRLoginEventExpired, t.userID, t.deviceID, t.deviceType, t.ipAddress)
+	}
+}
+
+func (s *authServiceImpl) loadQRLoginTicket(ctx context.Context, ticket string) (*qrLoginTicket, error) {
+	if ticket == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "ticket required")
+	}
+
+	fields, err := s.cache.HGetAll(ctx, qrLoginKey(ticket))
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	status, _ := strconv.Atoi(fields["status"])
+	deviceType, _ := strconv.Atoi(fields["device_type"])
+	expiresAt, _ := strconv.ParseInt(fields["expires_at"], 10, 64)
+	return &qrLoginTicket{
+		status:          model.QRLoginStatus(status),
+		deviceID:        fields["device_id"],
+		pollSecretHash:  fields["poll_secret_hash"],
+		deviceType:      model.DeviceType(deviceType),
+		clientVersion:   fields["client_version"],
+		ipAddress:       fields["ip_address"],
+		userAgent:       fields["user_agent"],
+		userID:          fields["user_id"],
+		scannerDeviceID: fields["scanner_device_id"],
+		expiresAt:       time.Unix(expiresAt, 0),
+	}, nil
+}
+
+func (s *authServiceImpl) transitionQRLogin(ctx context.Context, ticket string, from model.QRLoginStatus, fields ...interface{}) (bool, error) {
+	args := append([]interface{}{strconv.Itoa(int(from))}, fields...)
+	result, err := qrLoginTransitionScript.Run(ctx, s.cache.GetClient(), []string{qrLoginKey(ticket)}, args...).Int()
+	if err != nil {
+		return false, err
+	}
+	return result == 1, nil
+}
+
+func (s *authServiceImpl) recordQRLoginEvent(ctx context.Context, ticket, event, userID, deviceID string, deviceType model.DeviceType, ip string) {
+	if s.qrEventRepo == nil {
+		return
+	}
+	if err := s.qrEventRepo.Create(ctx, &model.QRLoginEvent{
+		Ticket:     ticket,
+		Event:      event,
+		UserID:     userID,
+		DeviceID:   deviceID,
+		DeviceType: deviceType,
+		IPAddress:  ip,
+	}); err != nil {
+		logger.Warn("Failed to record QR login event", zap.Error(err), zap.String("event", event))
+	}
+}
+
+func (s *authServiceImpl) qrLoginTTL() time.Duration {
+	if s.config.QRLoginTTL > 0 {
+		return s.config.QRLoginTTL
+	}
+	return defaultQRLoginTTL
+}
+
+func isQRLoginDesktop(deviceType model.DeviceType) bool {
+	return deviceType == model.DeviceTypeWeb || deviceType == model.DeviceTypePC || deviceType == model.DeviceTypeH5
+}
+
+func qrLoginKey(ticket string) string {
+	return qrLoginKeyPrefix + ticket
+}
+
+func newQRLoginTicketID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
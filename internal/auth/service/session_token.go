@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/auth/dto"
+	"github.com/anychat/server/internal/auth/model"
+	"github.com/anychat/server/pkg/crypto"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/anychat/server/pkg/notification"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// sessionTokens tokens issued for a session
+type sessionTokens struct {
+	accessToken  string
+	refreshToken string
+	expiresIn    int64
+}
+
+// startSession replaces the device's session with a new one that starts a fresh refresh token family
+func (s *authServiceImpl) startSession(ctx context.Context, userID, deviceID string, deviceType model.DeviceType) (*sessionTokens, error) {
+	// a new login ends the previous family of this device
+	if err := s.sessionRepo.DeleteByUserIDAndDeviceID(ctx, userID, deviceID); err != nil {
+		return nil, err
+	}
+
+	familyID := uuid.NewString()
+	tokens, refresh, err := s.generateSessionTokens(userID, deviceID, deviceType, familyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenRepo.Create(ctx, refresh); err != nil {
+		return nil, err
+	}
+
+	session := &model.UserSession{
+		UserID:                userID,
+		DeviceID:              deviceID,
+		FamilyID:              familyID,
+		AccessTokenHash:       crypto.HashToken(tokens.accessToken),
+		RefreshTokenHash:      refresh.TokenHash,
+		AccessTokenExpiresAt:  time.Now().Add(s.jwtManager.AccessTokenExpire()),
+		RefreshTokenExpiresAt: refresh.ExpiresAt,
+	}
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// RefreshToken rotates refresh token, presenting an already rotated token revokes the whole family
+func (s *authServiceImpl) RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error) {
+	// validate refresh token
+	claims, err := s.jwtManager.ValidateRefreshToken(req.RefreshToken)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeRefreshTokenInvalid, "")
+	}
+
+	// find token record
+	current, err := s.refreshTokenRepo.GetByTokenHash(ctx, crypto.HashToken(req.RefreshToken))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeRefreshTokenInvalid, "")
+		}
+		return nil, err
+	}
+
+	// family ended by logout, kick or a newer login
+	session, err := s.sessionRepo.GetByFamilyID(ctx, current.FamilyID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeRefreshTokenInvalid, "")
+		}
+		return nil, err
+	}
+
+	if current.RotatedAt != nil {
+		s.revokeReusedFamily(ctx, current)
+		return nil, errors.NewBusiness(errors.CodeRefreshTokenReused, "")
+	}
+
+	// check expiration
+	if current.IsExpired() || session.IsRefreshTokenExpired() {
+		return nil, errors.NewBusiness(errors.CodeRefreshTokenExpired, "")
+	}
+
+	// only one caller can rotate a token, the loser is treated as reuse
+	rotated, err := s.refreshTokenRepo.MarkRotated(ctx, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		s.revokeReusedFamily(ctx, current)
+		return nil, errors.NewBusiness(errors.CodeRefreshTokenReused, "")
+	}
+
+	tokens, next, err := s.generateSessionTokens(claims.UserID, claims.DeviceID, model.DeviceType(claims.DeviceType), current.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenRepo.Create(ctx, next); err != nil {
+		return nil, err
+	}
+
+	// update session
+	session.AccessTokenHash = crypto.HashToken(tokens.accessToken)
+	session.RefreshTokenHash = next.TokenHash
+	session.AccessTokenExpiresAt = time.Now().Add(s.jwtManager.AccessTokenExpire())
+	session.RefreshTokenExpiresAt = next.ExpiresAt
+	if err := s.sessionRepo.Update(ctx, session); err != nil {
+		return nil, err
+	}
+
+	if err := s.refreshTokenRepo.DeleteExpiredByFamilyID(ctx, current.FamilyID); err != nil {
+		logger.Warn("Failed to prune expired refresh tokens", zap.Error(err), zap.String("familyID", current.FamilyID))
+	}
+	if err := s.deviceRepo.UpdateLastActive(ctx, session.UserID, session.DeviceID); err != nil {
+		logger.Warn("Failed to update device last active time", zap.Error(err), zap.String("deviceID", session.DeviceID))
+	}
+
+	return &dto.RefreshTokenResponse{
+		AccessToken:  tokens.accessToken,
+		RefreshToken: tokens.refreshToken,
+		ExpiresIn:    tokens.expiresIn,
+	}, nil
+}
+
+// generateSessionTokens signs an access/refresh token pair and builds the refresh token record
+func (s *authServiceImpl) generateSessionTokens(userID, deviceID string, deviceType model.DeviceType, familyID string) (*sessionTokens, *model.RefreshToken, error) {
+	accessToken, err := s.jwtManager.GenerateAccessToken(userID, deviceID, int16(deviceType))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshToken, err := s.jwtManager.GenerateRefreshToken(userID, deviceID, int16(deviceType))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens := &sessionTokens{
+		accessToken:  accessToken,
+		refreshToken: refreshToken,
+		expiresIn:    int64(s.jwtManager.AccessTokenExpire().Seconds()),
+	}
+	record := &model.RefreshToken{
+		FamilyID:  familyID,
+		UserID:    userID,
+		DeviceID:  deviceID,
+		TokenHash: crypto.HashToken(refreshToken),
+		ExpiresAt: time.Now().Add(s.jwtManager.RefreshTokenExpire()),
+	}
+	return tokens, record, nil
+}
+
+// revokeReusedFamily ends a session whose rotated refresh token was presented again, the token is assumed stolen
+func (s *authServiceImpl) revokeReusedFamily(ctx context.Context, token *model.RefreshToken) {
+	logger.Warn("Refresh token reuse detected, revoking family",
+		zap.String("userID", token.UserID),
+		zap.String("deviceID", token.DeviceID),
+		zap.String("familyID", token.FamilyID))
+
+	if err := s.sessionRepo.DeleteByFamilyID(ctx, token.FamilyID); err != nil {
+		logger.Error("Failed to revoke refresh token family", zap.Error(err), zap.String("familyID", token.FamilyID))
+		return
+	}
+
+	if s.notificationPub != nil {
+		notif := notification.NewNotification(
+			notification.TypeAuthForceLogout,
+			token.UserID,
+			notification.PriorityHigh,
+		)
+		notif.Payload = map[string]interface{}{
+			"device_id": token.DeviceID,
+			"reason":    "refresh_token_reused",
+		}
+		if err := s.notificationPub.PublishToUser(token.UserID, notif); err != nil {
+			logger.Warn("Failed to publish force logout notification", zap.Error(err))
+		}
+	}
+}
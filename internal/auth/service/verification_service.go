@@ -51,8 +51,9 @@ type Config struct {
 	AllowDevBypass  bool
 }
 
+// SMSSender sends SMS codes, returns the provider that delivered the message and its message ID
 type SMSSender interface {
-	Send(to, templateID, code string) error
+	Send(ctx context.Context, to, templateID, code string) (provider, messageID string, err error)
 }
 
 type EmailSender interface {
@@ -163,12 +164,18 @@ func (s *verifyServiceImpl) SendCode(ctx context.Context, req *dto.SendCodeReque
 		return nil, pkgerrors.NewBusiness(pkgerrors.CodeInternalError, "")
 	}
 
-	if err := s.dispatchCode(ctx, target, req.TargetType, req.Purpose, code); err != nil {
+	provider, messageID, err := s.dispatchCode(ctx, target, req.TargetType, req.Purpose, code)
+	if err != nil {
 		_ = s.cache.Del(ctx, cacheKey)
 		s.rollbackRateLimits(ctx, rollbackKeys)
 		_ = s.codeRepo.UpdateStatus(ctx, codeID, model.CodeStatusCancelled)
 		return nil, err
 	}
+	if provider != "" {
+		if err := s.codeRepo.UpdateProvider(ctx, codeID, provider, messageID); err != nil {
+			logger.Warn("failed to record verification code provider", zap.Error(err), zap.String("codeID", codeID))
+		}
+	}
 
 	return &dto.SendCodeResponse{
 		CodeID:    codeID,
@@ -273,7 +280,8 @@ func (s *verifyServiceImpl) CheckCodeStatus(ctx context.Context, codeID string)
 	}, nil
 }
 
-func (s *verifyServiceImpl) dispatchCode(ctx context.Context, target string, targetType model.VerificationTargetType, purpose model.VerificationPurpose, code string) error {
+// dispatchCode delivers the code and returns the provider and its message ID, both empty when nothing was sent
+func (s *verifyServiceImpl) dispatchCode(ctx context.Context, target string, targetType model.VerificationTargetType, purpose model.VerificationPurpose, code string) (string, string, error) {
 	if !s.isReleaseMode() {
 		logger.Info(
 			"verification code generated for local environment",
@@ -304,30 +312,31 @@ func (s *verifyServiceImpl) dispatchCode(ctx context.Context, target string, tar
 	case model.TargetTypeSMS:
 		if s.smsSender == nil {
 			if !s.isReleaseMode() {
-				return nil
+				return "", "", nil
 			}
-			return pkgerrors.NewBusiness(pkgerrors.CodeSMSServiceError, "")
+			return "", "", pkgerrors.NewBusiness(pkgerrors.CodeSMSServiceError, "")
 		}
-		if err := s.smsSender.Send(target, templateID, code); err != nil {
+		provider, messageID, err := s.smsSender.Send(ctx, target, templateID, code)
+		if err != nil {
 			logger.Error("failed to send sms verification code", zap.Error(err))
-			return pkgerrors.NewBusiness(pkgerrors.CodeSMSServiceError, "")
+			return "", "", pkgerrors.NewBusiness(pkgerrors.CodeSMSServiceError, "")
 		}
+		return provider, messageID, nil
 	case model.TargetTypeEmail:
 		if s.emailSender == nil {
 			if !s.isReleaseMode() {
-				return nil
+				return "", "", nil
 			}
-			return pkgerrors.NewBusiness(pkgerrors.CodeEmailServiceError, "")
+			return "", "", pkgerrors.NewBusiness(pkgerrors.CodeEmailServiceError, "")
 		}
 		if err := s.emailSender.Send(target, emailSubject, emailContent); err != nil {
 			logger.Error("failed to send email verification code", zap.Error(err))
-			return pkgerrors.NewBusiness(pkgerrors.CodeEmailServiceError, "")
+			return "", "", pkgerrors.NewBusiness(pkgerrors.CodeEmailServiceError, "")
 		}
+		return "smtp", "", nil
 	default:
-		return pkgerrors.NewBusiness(pkgerrors.CodeTargetFormatInvalid, "")
+		return "", "", pkgerrors.NewBusiness(pkgerrors.CodeTargetFormatInvalid, "")
 	}
-
-	return nil
 }
 
 func (s *verifyServiceImpl) cancelPreviousCode(ctx context.Context, target string, targetType model.VerificationTargetType, purpose model.VerificationPurpose) error {
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/auth/service"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// AccountDeletionWorker periodically erases accounts whose deletion grace period ended
+type AccountDeletionWorker struct {
+	eraser   service.AccountEraser
+	interval time.Duration
+	stopCh   chan struct{}
+}
+
+func NewAccountDeletionWorker(eraser service.AccountEraser, interval time.Duration) *AccountDeletionWorker {
+	return &AccountDeletionWorker{
+		eraser:   eraser,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+func (w *AccountDeletionWorker) Start() {
+	logger.Info("AccountDeletionWorker starting", zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			logger.Info("AccountDeletionWorker stopped")
+			return
+		case <-ticker.C:
+			w.tick()
+		}
+	}
+}
+
+func (w *AccountDeletionWorker) Stop() {
+	close(w.stopCh)
+}
+
+func (w *AccountDeletionWorker) tick() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	completed, err := w.eraser.EraseDue(ctx)
+	if err != nil {
+		logger.Error("Failed to erase deleted accounts", zap.Error(err))
+		return
+	}
+	if completed > 0 {
+		logger.Info("Deleted accounts erased", zap.Int("count", completed))
+	}
+}
+
+func (w *AccountDeletionWorker) StartAsync() {
+	go w.Start()
+}
@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/auth/keyring"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// KeyRotationWorker periodically rotates JWT signing keys and reloads them from the database.
+// Services that only sign with the shared keys run it with rotate disabled to pick up rotations
+type KeyRotationWorker struct {
+	keyRing  *keyring.KeyRing
+	rotate   bool
+	interval time.Duration
+	stopCh   chan struct{}
+}
+
+func NewKeyRotationWorker(keyRing *keyring.KeyRing, rotate bool, interval time.Duration) *KeyRotationWorker {
+	return &KeyRotationWorker{
+		keyRing:  keyRing,
+		rotate:   rotate,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+func (w *KeyRotationWorker) Start() {
+	logger.Info("KeyRotationWorker starting",
+		zap.Bool("rotate", w.rotate),
+		zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			logger.Info("KeyRotationWorker stopped")
+			return
+		case <-ticker.C:
+			w.tick()
+		}
+	}
+}
+
+func (w *KeyRotationWorker) Stop() {
+	close(w.stopCh)
+}
+
+func (w *KeyRotationWorker) tick() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if w.rotate {
+		if err := w.keyRing.Rotate(ctx); err != nil {
+			logger.Error("Failed to rotate jwt signing keys", zap.Error(err))
+		}
+		return
+	}
+	if err := w.keyRing.Reload(ctx); err != nil {
+		logger.Error("Failed to reload jwt signing keys", zap.Error(err))
+	}
+}
+
+func (w *KeyRotationWorker) StartAsync() {
+	go w.Start()
+}
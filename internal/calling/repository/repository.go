@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/anychat/server/internal/calling/model"
 	"gorm.io/gorm"
 )
@@ -11,6 +13,10 @@ type CallRepository interface {
 	GetCallSession(callID string) (*model.CallSession, error)
 	UpdateCallSession(session *model.CallSession) error
 	ListCallLogs(userID string, page, pageSize int) ([]*model.CallSession, int64, error)
+	ListRingingCallsBefore(before time.Time, limit int) ([]*model.CallSession, error)
+	EndRingingCall(callID string, newStatus model.CallStatus, endedAt time.Time) (bool, error)
+	ConnectRingingCall(callID string, connectedAt time.Time) (bool, error)
+	EndConnectedCall(callID string, endedAt time.Time, duration int) (bool, error)
 }
 
 type callRepository struct {
@@ -63,6 +69,59 @@ func (r *callRepository) ListCallLogs(userID string, page, pageSize int) ([]*mod
 	return sessions, total, nil
 }
 
+// ListRingingCallsBefore lists calls still ringing that were started before the given time
+func (r *callRepository) ListRingingCallsBefore(before time.Time, limit int) ([]*model.CallSession, error) {
+	var sessions []*model.CallSession
+	err := r.db.Where("status = ? AND started_at < ?", model.CallStatusRinging, before).
+		Order("started_at ASC").
+		Limit(limit).
+		Find(&sessions).Error
+	return sessions, err
+}
+
+// EndRingingCall moves a call out of the ringing state, returns false if it was no longer ringing
+func (r *callRepository) EndRingingCall(callID string, newStatus model.CallStatus, endedAt time.Time) (bool, error) {
+	result := r.db.Model(&model.CallSession{}).
+		Where("call_id = ? AND status = ?", callID, model.CallStatusRinging).
+		Updates(map[string]interface{}{
+			"status":   newStatus,
+			"ended_at": endedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ConnectRingingCall marks a ringing call as connected, returns false if it was no longer ringing
+func (r *callRepository) ConnectRingingCall(callID string, connectedAt time.Time) (bool, error) {
+	result := r.db.Model(&model.CallSession{}).
+		Where("call_id = ? AND status = ?", callID, model.CallStatusRinging).
+		Updates(map[string]interface{}{
+			"status":       model.CallStatusConnected,
+			"connected_at": connectedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// EndConnectedCall marks a connected call as ended, returns false if it was no longer connected
+func (r *callRepository) EndConnectedCall(callID string, endedAt time.Time, duration int) (bool, error) {
+	result := r.db.Model(&model.CallSession{}).
+		Where("call_id = ? AND status = ?", callID, model.CallStatusConnected).
+		Updates(map[string]interface{}{
+			"status":   model.CallStatusEnded,
+			"ended_at": endedAt,
+			"duration": duration,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
 // MeetingRepository is the meeting room repository
 type MeetingRepository interface {
 	CreateMeeting(meeting *model.MeetingRoom) error
@@ -37,6 +37,8 @@ type CallingService interface {
 	EndMeeting(ctx context.Context, roomID, creatorID string) error
 	GetMeeting(ctx context.Context, roomID string) (*callingpb.MeetingRoom, error)
 	ListMeetings(ctx context.Context, page, pageSize int) (*callingpb.ListMeetingsResponse, error)
+	// ExpireRingingCalls marks calls ringing longer than the ring timeout as missed
+	ExpireRingingCalls(ctx context.Context, limit int) (int, error)
 }
 
 type callingServiceImpl struct {
@@ -48,6 +50,7 @@ type callingServiceImpl struct {
 	callRepo        repository.CallRepository
 	meetingRepo     repository.MeetingRepository
 	notificationPub notification.Publisher
+	ringTimeout     time.Duration
 }
 
 // NewCallingService creates an audio/video service
@@ -57,6 +60,7 @@ func NewCallingService(
 	callRepo repository.CallRepository,
 	meetingRepo repository.MeetingRepository,
 	notificationPub notification.Publisher,
+	ringTimeout time.Duration,
 ) CallingService {
 	roomClient := lksdk.NewRoomServiceClient(serverURL, apiKey, apiSecret)
 	return &callingServiceImpl{
@@ -68,6 +72,7 @@ func NewCallingService(
 		callRepo:        callRepo,
 		meetingRepo:     meetingRepo,
 		notificationPub: notificationPub,
+		ringTimeout:     ringTimeout,
 	}
 }
 
@@ -133,7 +138,9 @@ func (s *callingServiceImpl) InitiateCall(ctx context.Context, callerID, calleeI
 	notif := notification.NewNotification(notification.TypeLiveKitCallInvite, callerID, notification.PriorityHigh).
 		AddPayloadField("call_id", callID).
 		AddPayloadField("caller_id", callerID).
-		AddPayloadField("call_type", int32(callType))
+		AddPayloadField("call_type", int32(callType)).
+		AddPayloadField("ring_timeout", int64(s.ringTimeout.Seconds())).
+		AddPayloadField("expires_at", session.StartedAt.Add(s.ringTimeout).Unix())
 	if err := s.notificationPub.PublishToUser(calleeID, notif); err != nil {
 		logger.Warn("InitiateCall: notify callee failed", zap.Error(err))
 	}
@@ -163,13 +170,18 @@ func (s *callingServiceImpl) JoinCall(ctx context.Context, callID, userID string
 		return nil, status.Errorf(codes.Internal, "generate token: %v", err)
 	}
 
-	// Update session status
+	// Update session status, the ring timeout worker or a cancel may have ended the call meanwhile
 	now := time.Now()
+	connected, err := s.callRepo.ConnectRingingCall(callID, now)
+	if err != nil {
+		logger.Error("JoinCall: update session failed", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "update session: %v", err)
+	}
+	if !connected {
+		return nil, status.Error(codes.FailedPrecondition, "call is no longer ringing")
+	}
 	session.Status = model.CallStatusConnected
 	session.ConnectedAt = &now
-	if err := s.callRepo.UpdateCallSession(session); err != nil {
-		logger.Warn("JoinCall: update session failed", zap.Error(err))
-	}
 
 	// Notify caller
 	notif := notification.NewNotification(notification.TypeLiveKitCallStatus, userID, notification.PriorityHigh).
@@ -178,6 +190,7 @@ func (s *callingServiceImpl) JoinCall(ctx context.Context, callID, userID string
 	if err := s.notificationPub.PublishToUser(session.CallerID, notif); err != nil {
 		logger.Warn("JoinCall: notify caller failed", zap.Error(err))
 	}
+	s.notifyCallEnded(session)
 
 	return &callingpb.JoinCallResponse{
 		RoomName: session.RoomName,
@@ -197,12 +210,18 @@ func (s *callingServiceImpl) RejectCall(ctx context.Context, callID, userID stri
 		return status.Error(codes.PermissionDenied, "not the callee of this call")
 	}
 
+	// the ring timeout worker or a cancel may have ended the call meanwhile
 	now := time.Now()
+	rejected, err := s.callRepo.EndRingingCall(callID, model.CallStatusRejected, now)
+	if err != nil {
+		logger.Error("RejectCall: update session failed", zap.Error(err))
+		return status.Errorf(codes.Internal, "update session: %v", err)
+	}
+	if !rejected {
+		return status.Error(codes.FailedPrecondition, "call is no longer ringing")
+	}
 	session.Status = model.CallStatusRejected
 	session.EndedAt = &now
-	if err := s.callRepo.UpdateCallSession(session); err != nil {
-		logger.Warn("RejectCall: update session failed", zap.Error(err))
-	}
 
 	// Delete Room (no need to wait)
 	go s.deleteRoom(session.RoomName)
@@ -214,6 +233,7 @@ func (s *callingServiceImpl) RejectCall(ctx context.Context, callID, userID stri
 	if err := s.notificationPub.PublishToUser(session.CallerID, notif); err != nil {
 		logger.Warn("RejectCall: notify caller failed", zap.Error(err))
 	}
+	s.notifyCallEnded(session)
 	return nil
 }
 
@@ -225,23 +245,33 @@ func (s *callingServiceImpl) EndCall(ctx context.Context, callID, userID string)
 	if session.CallerID != userID && session.CalleeID != userID {
 		return status.Error(codes.PermissionDenied, "not a participant of this call")
 	}
-	if session.Status == model.CallStatusEnded || session.Status == model.CallStatusRejected {
+	switch session.Status {
+	case model.CallStatusEnded, model.CallStatusRejected, model.CallStatusMissed, model.CallStatusCancelled:
 		return nil
 	}
 
 	now := time.Now()
 	newStatus := model.CallStatusEnded
+	var ended bool
 	if session.Status == model.CallStatusRinging {
 		newStatus = model.CallStatusCancelled
+		ended, err = s.callRepo.EndRingingCall(callID, newStatus, now)
+	} else {
+		if session.ConnectedAt != nil {
+			session.Duration = int(now.Sub(*session.ConnectedAt).Seconds())
+		}
+		ended, err = s.callRepo.EndConnectedCall(callID, now, session.Duration)
 	}
-	session.Status = newStatus
-	session.EndedAt = &now
-	if session.ConnectedAt != nil {
-		session.Duration = int(now.Sub(*session.ConnectedAt).Seconds())
+	if err != nil {
+		logger.Error("EndCall: update session failed", zap.Error(err))
+		return status.Errorf(codes.Internal, "update session: %v", err)
 	}
-	if err := s.callRepo.UpdateCallSession(session); err != nil {
-		logger.Warn("EndCall: update session failed", zap.Error(err))
+	if !ended {
+		// answered, rejected, timed out or hung up by the peer in the meantime
+		return nil
 	}
+	session.Status = newStatus
+	session.EndedAt = &now
 
 	go s.deleteRoom(session.RoomName)
 
@@ -256,6 +286,9 @@ func (s *callingServiceImpl) EndCall(ctx context.Context, callID, userID string)
 	if err := s.notificationPub.PublishToUser(targetID, notif); err != nil {
 		logger.Warn("EndCall: notify peer failed", zap.Error(err))
 	}
+	if newStatus == model.CallStatusCancelled {
+		s.notifyCallEnded(session)
+	}
 	return nil
 }
 
@@ -282,6 +315,43 @@ func (s *callingServiceImpl) ListCallLogs(ctx context.Context, userID string, pa
 	return &callingpb.ListCallLogsResponse{Sessions: pbSessions, Total: total}, nil
 }
 
+// ExpireRingingCalls marks calls that nobody answered within the ring timeout as missed
+func (s *callingServiceImpl) ExpireRingingCalls(ctx context.Context, limit int) (int, error) {
+	sessions, err := s.callRepo.ListRingingCallsBefore(time.Now().Add(-s.ringTimeout), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, session := range sessions {
+		now := time.Now()
+		ok, err := s.callRepo.EndRingingCall(session.CallID, model.CallStatusMissed, now)
+		if err != nil {
+			logger.Warn("ExpireRingingCalls: update session failed",
+				zap.String("callID", session.CallID), zap.Error(err))
+			continue
+		}
+		if !ok {
+			// answered, rejected or cancelled in the meantime
+			continue
+		}
+		expired++
+		session.Status = model.CallStatusMissed
+		session.EndedAt = &now
+
+		go s.deleteRoom(session.RoomName)
+
+		notif := notification.NewNotification(notification.TypeLiveKitCallStatus, "", notification.PriorityHigh).
+			AddPayloadField("call_id", session.CallID).
+			AddPayloadField("status", int32(model.CallStatusMissed))
+		if err := s.notificationPub.PublishToUser(session.CallerID, notif); err != nil {
+			logger.Warn("ExpireRingingCalls: notify caller failed", zap.Error(err))
+		}
+		s.notifyCallEnded(session)
+	}
+	return expired, nil
+}
+
 // ── Meeting room related ────────────────────────────────────────────
 
 func (s *callingServiceImpl) CreateMeeting(ctx context.Context, creatorID, title, password string, maxParticipants int) (*callingpb.CreateMeetingResponse, error) {
@@ -410,6 +480,19 @@ func (s *callingServiceImpl) generateToken(roomName, identity string, isAdmin bo
 	return at.ToJWT()
 }
 
+// notifyCallEnded tells all devices of callee that the call is no longer ringing,
+// so the incoming call UI and pending call pushes can be dismissed
+func (s *callingServiceImpl) notifyCallEnded(session *model.CallSession) {
+	notif := notification.NewNotification(notification.TypeLiveKitCallEnded, session.CallerID, notification.PriorityHigh).
+		AddPayloadField("call_id", session.CallID).
+		AddPayloadField("caller_id", session.CallerID).
+		AddPayloadField("status", int32(session.Status))
+	if err := s.notificationPub.PublishToUser(session.CalleeID, notif); err != nil {
+		logger.Warn("notifyCallEnded: notify callee failed",
+			zap.String("callID", session.CallID), zap.Error(err))
+	}
+}
+
 func (s *callingServiceImpl) deleteRoom(roomName string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
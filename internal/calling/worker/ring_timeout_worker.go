@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/calling/service"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// RingTimeoutWorker periodically marks unanswered calls as missed
+type RingTimeoutWorker struct {
+	callingService service.CallingService
+	batchSize      int
+	interval       time.Duration
+	stopCh         chan struct{}
+}
+
+func NewRingTimeoutWorker(
+	callingService service.CallingService,
+	batchSize int,
+	interval time.Duration,
+) *RingTimeoutWorker {
+	return &RingTimeoutWorker{
+		callingService: callingService,
+		batchSize:      batchSize,
+		interval:       interval,
+		stopCh:         make(chan struct{}),
+	}
+}
+
+func (w *RingTimeoutWorker) Start() {
+	logger.Info("RingTimeoutWorker starting", zap.Int("batchSize", w.batchSize), zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			logger.Info("RingTimeoutWorker stopped")
+			return
+		case <-ticker.C:
+			w.expire()
+		}
+	}
+}
+
+func (w *RingTimeoutWorker) Stop() {
+	close(w.stopCh)
+}
+
+func (w *RingTimeoutWorker) expire() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		default:
+		}
+
+		count, err := w.callingService.ExpireRingingCalls(ctx, w.batchSize)
+		if err != nil {
+			logger.Error("Failed to expire ringing calls", zap.Error(err))
+			return
+		}
+		if count > 0 {
+			logger.Info("Expired ringing calls", zap.Int("count", count))
+		}
+		if count < w.batchSize {
+			return
+		}
+	}
+}
+
+func (w *RingTimeoutWorker) StartAsync() {
+	go w.Start()
+}
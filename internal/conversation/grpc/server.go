@@ -158,3 +158,16 @@ func (s *Server) SetAutoDelete(ctx context.Context, req *conversationpb.SetAutoD
 	}
 	return &commonpb.Empty{}, nil
 }
+
+// EraseUserData deletes the conversations of a deleted account (called by auth-service)
+func (s *Server) EraseUserData(ctx context.Context, req *commonpb.EraseUserDataRequest) (*commonpb.EraseUserDataResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	erased, err := s.conversationService.EraseUserData(ctx, req.UserId)
+	if err != nil {
+		logger.Error("EraseUserData failed", zap.String("userID", req.UserId), zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &commonpb.EraseUserDataResponse{Erased: erased}, nil
+}
@@ -35,6 +35,8 @@ type ConversationRepository interface {
 	IncrUnread(ctx context.Context, userID, conversationID string, count int32) error
 	// SumUnread counts user's total unread count
 	SumUnread(ctx context.Context, userID string) (int32, error)
+	// DeleteByUser deletes all conversations of the user
+	DeleteByUser(ctx context.Context, userID string) (int64, error)
 	// WithTx uses transaction
 	WithTx(tx *gorm.DB) ConversationRepository
 }
@@ -111,6 +113,14 @@ func (r *conversationRepositoryImpl) Delete(ctx context.Context, userID, convers
 		Delete(&model.Conversation{}).Error
 }
 
+// DeleteByUser deletes all conversations of the user
+func (r *conversationRepositoryImpl) DeleteByUser(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.Conversation{})
+	return result.RowsAffected, result.Error
+}
+
 // SetPinned sets pinned status
 func (r *conversationRepositoryImpl) SetPinned(ctx context.Context, userID, conversationID string, pinned bool, pinTime *time.Time) error {
 	updates := map[string]interface{}{
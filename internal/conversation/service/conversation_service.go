@@ -29,6 +29,7 @@ type ConversationService interface {
 	ClearUnread(ctx context.Context, userID, conversationID string) error
 	GetTotalUnread(ctx context.Context, userID string) (int32, error)
 	IncrUnread(ctx context.Context, userID, conversationID string, count int32) error
+	EraseUserData(ctx context.Context, userID string) (map[string]int64, error)
 }
 
 // conversationServiceImpl is the implementation of conversation service
@@ -314,3 +315,13 @@ func toProtoConversation(s *model.Conversation) *conversationpb.Conversation {
 	}
 	return pb
 }
+
+// EraseUserData deletes the conversation list of a deleted account.
+// Conversations other users have with the account are theirs and are kept
+func (s *conversationServiceImpl) EraseUserData(ctx context.Context, userID string) (map[string]int64, error) {
+	count, err := s.conversationRepo.DeleteByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete conversations: %w", err)
+	}
+	return map[string]int64{"conversations": count}, nil
+}
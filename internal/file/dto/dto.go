@@ -7,13 +7,18 @@ type GenerateUploadTokenRequest struct {
 	MimeType     string `json:"mime_type" binding:"required" example:"image/jpeg"`
 	FileType     int32  `json:"file_type" binding:"required,oneof=1 2 3 4 5" example:"1"`
 	ExpiresHours *int32 `json:"expires_hours,omitempty" example:"0"`
+	SHA256       string `json:"sha256,omitempty" binding:"omitempty,len=64,hexadecimal" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	GroupID      string `json:"group_id,omitempty" example:"group-123"`
 }
 
 // GenerateUploadTokenResponse generate upload token response
 type GenerateUploadTokenResponse struct {
-	FileID    string `json:"file_id" example:"file-123"`
-	UploadURL string `json:"upload_url" example:"https://minio:9000/..."`
-	ExpiresIn int64  `json:"expires_in" example:"3600"`
+	FileID        string `json:"file_id" example:"file-123"`
+	UploadURL     string `json:"upload_url" example:"https://minio:9000/..."`
+	ExpiresIn     int64  `json:"expires_in" example:"3600"`
+	InstantUpload bool   `json:"instant_upload" example:"false"`
+	// UploadHeaders headers covered by the upload URL signature, the PUT must send them unchanged
+	UploadHeaders map[string]string `json:"upload_headers,omitempty"`
 }
 
 // CompleteUploadRequest complete upload request
@@ -48,9 +53,19 @@ type GenerateDownloadURLRequest struct {
 
 // GenerateDownloadURLResponse generate download URL response
 type GenerateDownloadURLResponse struct {
-	DownloadURL  string `json:"download_url" example:"https://minio:9000/..."`
-	ExpiresIn    int64  `json:"expires_in" example:"3600"`
-	ThumbnailURL string `json:"thumbnail_url,omitempty" example:"https://minio:9000/..."`
+	FileID       string              `json:"file_id,omitempty" example:"file-123"`
+	DownloadURL  string              `json:"download_url" example:"https://minio:9000/..."`
+	ExpiresIn    int64               `json:"expires_in" example:"3600"`
+	ThumbnailURL string              `json:"thumbnail_url,omitempty" example:"https://minio:9000/..."`
+	Thumbnails   []*ThumbnailURLInfo `json:"thumbnails,omitempty"`
+}
+
+// ThumbnailURLInfo download URL of one thumbnail size
+type ThumbnailURLInfo struct {
+	Size   int32  `json:"size" example:"480"` // longest edge
+	Width  int32  `json:"width" example:"480"`
+	Height int32  `json:"height" example:"360"`
+	URL    string `json:"url" example:"https://minio:9000/..."`
 }
 
 // ListFilesRequest list files request
@@ -77,3 +92,146 @@ type DeleteFileRequest struct {
 type DeleteFileResponse struct {
 	Success bool `json:"success" example:"true"`
 }
+
+// InitiateMultipartUploadRequest initiate multipart upload request
+type InitiateMultipartUploadRequest struct {
+	FileName     string `json:"file_name" binding:"required" example:"video.mp4"`
+	FileSize     int64  `json:"file_size" binding:"required,gt=0" example:"524288000"`
+	MimeType     string `json:"mime_type" binding:"required" example:"video/mp4"`
+	FileType     int32  `json:"file_type" binding:"required,oneof=1 2 3 4 5" example:"2"`
+	PartSize     int64  `json:"part_size,omitempty" example:"8388608"`
+	ExpiresHours *int32 `json:"expires_hours,omitempty" example:"0"`
+	GroupID      string `json:"group_id,omitempty" example:"group-123"`
+}
+
+// MultipartUploadResponse multipart upload session
+type MultipartUploadResponse struct {
+	UploadID      string  `json:"upload_id" example:"upload-123"`
+	FileID        string  `json:"file_id" example:"file-123"`
+	FileName      string  `json:"file_name" example:"video.mp4"`
+	FileSize      int64   `json:"file_size" example:"524288000"`
+	PartSize      int64   `json:"part_size" example:"8388608"`
+	TotalParts    int32   `json:"total_parts" example:"63"`
+	UploadedParts []int32 `json:"uploaded_parts"`
+	Status        int32   `json:"status" example:"1"`
+	ExpiresAt     int64   `json:"expires_at" example:"1705401600"`
+}
+
+// PartUploadURL presigned URL of one part
+type PartUploadURL struct {
+	PartNumber int32  `json:"part_number" example:"1"`
+	UploadURL  string `json:"upload_url" example:"https://minio:9000/..."`
+}
+
+// PartUploadURLsResponse presigned part URLs response
+type PartUploadURLsResponse struct {
+	Parts     []*PartUploadURL `json:"parts"`
+	ExpiresIn int64            `json:"expires_in" example:"3600"`
+}
+
+// UploadedPart part already stored
+type UploadedPart struct {
+	PartNumber int32  `json:"part_number" example:"1"`
+	Size       int64  `json:"size" example:"8388608"`
+	ETag       string `json:"etag" example:"d41d8cd98f00b204e9800998ecf8427e"`
+}
+
+// UploadedPartsResponse uploaded parts response
+type UploadedPartsResponse struct {
+	Upload        *MultipartUploadResponse `json:"upload"`
+	Parts         []*UploadedPart          `json:"parts"`
+	UploadedBytes int64                    `json:"uploaded_bytes" example:"16777216"`
+}
+
+// GrantMessageFileAccessRequest links the files of a message to its conversation (internal use)
+type GrantMessageFileAccessRequest struct {
+	MessageID        string
+	ConversationID   string
+	ConversationType int32 // 1-single 2-group
+	TargetID         string
+	SenderID         string
+	FileIDs          []string
+}
+
+// GrantMessageFileAccessResponse grant result
+type GrantMessageFileAccessResponse struct {
+	GrantedFileIDs  []string
+	RejectedFileIDs []string // not found, not active, or not readable by the sender
+}
+
+// StorageUsageResponse storage usage and quota of a user or group
+type StorageUsageResponse struct {
+	OwnerType       int32  `json:"owner_type" example:"1"`
+	OwnerID         string `json:"owner_id" example:"user-123"`
+	UsedBytes       int64  `json:"used_bytes" example:"524288000"`
+	QuotaBytes      int64  `json:"quota_bytes" example:"2147483648"` // 0 means unlimited
+	FileCount       int32  `json:"file_count" example:"42"`
+	Tier            string `json:"tier,omitempty" example:"standard"`
+	QuotaOverridden bool   `json:"quota_overridden" example:"false"`
+}
+
+// SetStorageQuotaRequest set storage quota request (admin)
+type SetStorageQuotaRequest struct {
+	OwnerType  int32
+	OwnerID    string
+	Tier       *string // users only, empty string resets to the default tier
+	QuotaBytes *int64  // override, 0 means unlimited
+	ClearQuota bool    // drop the override and use the tier or group default again
+}
+
+// SetAvatarRequest set avatar request
+type SetAvatarRequest struct {
+	UserID    string // uploader of the source image
+	FileID    string
+	OwnerType int32
+	OwnerID   string // user ID or group ID, permission is checked by the caller
+}
+
+// AvatarResponse public avatar URLs
+type AvatarResponse struct {
+	URL    string                 `json:"url" example:"http://localhost:9000/avatar/user-123/640.jpg?v=3f2a9c1d0b7e4a65"` // largest size
+	Images []*AvatarImageResponse `json:"images"`
+}
+
+// AvatarImageResponse one avatar size
+type AvatarImageResponse struct {
+	Size int32  `json:"size" example:"160"`
+	URL  string `json:"url" example:"http://localhost:9000/avatar/user-123/160.jpg?v=3f2a9c1d0b7e4a65"`
+}
+
+// ReviewQuarantinedFileRequest release or delete a quarantined file (admin)
+type ReviewQuarantinedFileRequest struct {
+	FileID  string
+	AdminID string
+	Note    string // reason recorded with the verdict
+}
+
+// QuarantinedFileResponse scanned file with its scan state and verdict history
+type QuarantinedFileResponse struct {
+	File       *FileInfoResponse          `json:"file"`
+	ScanStatus int32                      `json:"scan_status" example:"4"`
+	Scanner    string                     `json:"scanner,omitempty" example:"clamd"`
+	Signature  string                     `json:"signature,omitempty" example:"Win.Test.EICAR_HDB-1"`
+	LastError  string                     `json:"last_error,omitempty"`
+	Attempts   int32                      `json:"attempts" example:"1"`
+	ScannedAt  *int64                     `json:"scanned_at,omitempty" example:"1705315200"`
+	ReviewedBy string                     `json:"reviewed_by,omitempty" example:"admin-1"`
+	ReviewedAt *int64                     `json:"reviewed_at,omitempty" example:"1705318800"`
+	Verdicts   []*FileScanVerdictResponse `json:"verdicts"`
+}
+
+// FileScanVerdictResponse one scan result or review decision
+type FileScanVerdictResponse struct {
+	Verdict   string `json:"verdict" example:"infected"`
+	Scanner   string `json:"scanner,omitempty" example:"clamd"`
+	Signature string `json:"signature,omitempty" example:"Win.Test.EICAR_HDB-1"`
+	Detail    string `json:"detail,omitempty"`
+	AdminID   string `json:"admin_id,omitempty"`
+	CreatedAt int64  `json:"created_at" example:"1705315200"`
+}
+
+// ListQuarantinedFilesResponse quarantined files, newest verdict first
+type ListQuarantinedFilesResponse struct {
+	Files []*QuarantinedFileResponse `json:"files"`
+	Total int64                      `json:"total" example:"3"`
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 
+	commonpb "github.com/anychat/server/api/proto/common"
 	filepb "github.com/anychat/server/api/proto/file"
 	"github.com/anychat/server/internal/file/dto"
 	"github.com/anychat/server/internal/file/model"
@@ -34,6 +35,8 @@ func (s *FileServer) GenerateUploadToken(ctx context.Context, req *filepb.Genera
 		MimeType:     req.MimeType,
 		FileType:     int32(req.FileType),
 		ExpiresHours: req.ExpiresHours,
+		SHA256:       req.GetSha256(),
+		GroupID:      req.GetGroupId(),
 	}
 
 	resp, err := s.fileService.GenerateUploadToken(ctx, req.UserId, dtoReq)
@@ -42,9 +45,11 @@ func (s *FileServer) GenerateUploadToken(ctx context.Context, req *filepb.Genera
 	}
 
 	return &filepb.GenerateUploadTokenResponse{
-		FileId:    resp.FileID,
-		UploadUrl: resp.UploadURL,
-		ExpiresIn: resp.ExpiresIn,
+		FileId:        resp.FileID,
+		UploadUrl:     resp.UploadURL,
+		ExpiresIn:     resp.ExpiresIn,
+		InstantUpload: resp.InstantUpload,
+		UploadHeaders: resp.UploadHeaders,
 	}, nil
 }
 
@@ -65,7 +70,29 @@ func (s *FileServer) GenerateDownloadURL(ctx context.Context, req *filepb.Genera
 		return nil, convertError(err)
 	}
 
+	return toProtoDownloadURL(resp), nil
+}
+
+// BatchGenerateDownloadURLs generates download URLs of several files
+func (s *FileServer) BatchGenerateDownloadURLs(ctx context.Context, req *filepb.BatchGenerateDownloadURLsRequest) (*filepb.BatchGenerateDownloadURLsResponse, error) {
+	resp, err := s.fileService.BatchGenerateDownloadURLs(ctx, req.FileIds, req.UserId, req.ExpiresMinutes)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	urls := make([]*filepb.GenerateDownloadURLResponse, 0, len(resp))
+	for _, url := range resp {
+		urls = append(urls, toProtoDownloadURL(url))
+	}
+
+	return &filepb.BatchGenerateDownloadURLsResponse{
+		Urls: urls,
+	}, nil
+}
+
+func toProtoDownloadURL(resp *dto.GenerateDownloadURLResponse) *filepb.GenerateDownloadURLResponse {
 	pbResp := &filepb.GenerateDownloadURLResponse{
+		FileId:      resp.FileID,
 		DownloadUrl: resp.DownloadURL,
 		ExpiresIn:   resp.ExpiresIn,
 	}
@@ -73,8 +100,15 @@ func (s *FileServer) GenerateDownloadURL(ctx context.Context, req *filepb.Genera
 	if resp.ThumbnailURL != "" {
 		pbResp.ThumbnailUrl = &resp.ThumbnailURL
 	}
-
-	return pbResp, nil
+	for _, thumbnail := range resp.Thumbnails {
+		pbResp.Thumbnails = append(pbResp.Thumbnails, &filepb.ThumbnailURL{
+			Size:   thumbnail.Size,
+			Width:  thumbnail.Width,
+			Height: thumbnail.Height,
+			Url:    thumbnail.URL,
+		})
+	}
+	return pbResp
 }
 
 // GetFileInfo gets file info
@@ -141,6 +175,324 @@ func (s *FileServer) BatchGetFileInfo(ctx context.Context, req *filepb.BatchGetF
 	}, nil
 }
 
+// EraseUserData deletes all files of a deleted account (called by auth-service)
+func (s *FileServer) EraseUserData(ctx context.Context, req *commonpb.EraseUserDataRequest) (*commonpb.EraseUserDataResponse, error) {
+	erased, err := s.fileService.EraseUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &commonpb.EraseUserDataResponse{Erased: erased}, nil
+}
+
+// InitiateMultipartUpload starts a resumable multipart upload
+func (s *FileServer) InitiateMultipartUpload(ctx context.Context, req *filepb.InitiateMultipartUploadRequest) (*filepb.MultipartUpload, error) {
+	dtoReq := &dto.InitiateMultipartUploadRequest{
+		FileName:     req.FileName,
+		FileSize:     req.FileSize,
+		MimeType:     req.MimeType,
+		FileType:     int32(req.FileType),
+		PartSize:     req.GetPartSize(),
+		ExpiresHours: req.ExpiresHours,
+		GroupID:      req.GetGroupId(),
+	}
+
+	resp, err := s.fileService.InitiateMultipartUpload(ctx, req.UserId, dtoReq)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toProtoMultipartUpload(resp), nil
+}
+
+// GetPartUploadURLs presigns upload URLs for parts
+func (s *FileServer) GetPartUploadURLs(ctx context.Context, req *filepb.GetPartUploadURLsRequest) (*filepb.GetPartUploadURLsResponse, error) {
+	resp, err := s.fileService.GetPartUploadURLs(ctx, req.UploadId, req.UserId, req.PartNumbers)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	parts := make([]*filepb.PartUploadURL, 0, len(resp.Parts))
+	for _, part := range resp.Parts {
+		parts = append(parts, &filepb.PartUploadURL{
+			PartNumber: part.PartNumber,
+			UploadUrl:  part.UploadURL,
+		})
+	}
+
+	return &filepb.GetPartUploadURLsResponse{
+		Parts:     parts,
+		ExpiresIn: resp.ExpiresIn,
+	}, nil
+}
+
+// ListUploadedParts lists parts already stored
+func (s *FileServer) ListUploadedParts(ctx context.Context, req *filepb.ListUploadedPartsRequest) (*filepb.ListUploadedPartsResponse, error) {
+	resp, err := s.fileService.ListUploadedParts(ctx, req.UploadId, req.UserId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	parts := make([]*filepb.UploadedPart, 0, len(resp.Parts))
+	for _, part := range resp.Parts {
+		parts = append(parts, &filepb.UploadedPart{
+			PartNumber: part.PartNumber,
+			Size:       part.Size,
+			Etag:       part.ETag,
+		})
+	}
+
+	return &filepb.ListUploadedPartsResponse{
+		Upload:        toProtoMultipartUpload(resp.Upload),
+		Parts:         parts,
+		UploadedBytes: resp.UploadedBytes,
+	}, nil
+}
+
+// CompleteMultipartUpload assembles the parts and activates the file
+func (s *FileServer) CompleteMultipartUpload(ctx context.Context, req *filepb.CompleteMultipartUploadRequest) (*filepb.FileInfo, error) {
+	resp, err := s.fileService.CompleteMultipartUpload(ctx, req.UploadId, req.UserId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toProtoFileInfo(resp), nil
+}
+
+// AbortMultipartUpload cancels an upload
+func (s *FileServer) AbortMultipartUpload(ctx context.Context, req *filepb.AbortMultipartUploadRequest) (*filepb.AbortMultipartUploadResponse, error) {
+	if err := s.fileService.AbortMultipartUpload(ctx, req.UploadId, req.UserId); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &filepb.AbortMultipartUploadResponse{
+		Success: true,
+	}, nil
+}
+
+// GrantMessageFileAccess links the files of a sent message to its conversation
+func (s *FileServer) GrantMessageFileAccess(ctx context.Context, req *filepb.GrantMessageFileAccessRequest) (*filepb.GrantMessageFileAccessResponse, error) {
+	resp, err := s.fileService.GrantMessageFileAccess(ctx, &dto.GrantMessageFileAccessRequest{
+		MessageID:        req.MessageId,
+		ConversationID:   req.ConversationId,
+		ConversationType: req.ConversationType,
+		TargetID:         req.TargetId,
+		SenderID:         req.SenderId,
+		FileIDs:          req.FileIds,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &filepb.GrantMessageFileAccessResponse{
+		GrantedFileIds:  resp.GrantedFileIDs,
+		RejectedFileIds: resp.RejectedFileIDs,
+	}, nil
+}
+
+// RevokeMessageFileAccess revokes the grants of recalled or deleted messages
+func (s *FileServer) RevokeMessageFileAccess(ctx context.Context, req *filepb.RevokeMessageFileAccessRequest) (*filepb.RevokeMessageFileAccessResponse, error) {
+	revoked, err := s.fileService.RevokeMessageFileAccess(ctx, req.MessageIds, req.Reason)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &filepb.RevokeMessageFileAccessResponse{
+		Revoked: revoked,
+	}, nil
+}
+
+// GetStorageUsage gets the storage usage and quota of the user or of one of the user's groups
+func (s *FileServer) GetStorageUsage(ctx context.Context, req *filepb.GetStorageUsageRequest) (*filepb.StorageUsage, error) {
+	resp, err := s.fileService.GetStorageUsage(ctx, req.UserId, req.GetGroupId())
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toProtoStorageUsage(resp), nil
+}
+
+// GetOwnerStorageUsage gets the storage usage and quota of any user or group
+func (s *FileServer) GetOwnerStorageUsage(ctx context.Context, req *filepb.GetOwnerStorageUsageRequest) (*filepb.StorageUsage, error) {
+	resp, err := s.fileService.GetOwnerStorageUsage(ctx, model.StorageOwnerType(req.OwnerType), req.OwnerId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toProtoStorageUsage(resp), nil
+}
+
+// SetStorageQuota changes the tier or quota override of a user or group
+func (s *FileServer) SetStorageQuota(ctx context.Context, req *filepb.SetStorageQuotaRequest) (*filepb.StorageUsage, error) {
+	resp, err := s.fileService.SetStorageQuota(ctx, &dto.SetStorageQuotaRequest{
+		OwnerType:  int32(req.OwnerType),
+		OwnerID:    req.OwnerId,
+		Tier:       req.Tier,
+		QuotaBytes: req.QuotaBytes,
+		ClearQuota: req.ClearQuota,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toProtoStorageUsage(resp), nil
+}
+
+// SetAvatar crops and resizes an uploaded image into the avatar of a user or group
+func (s *FileServer) SetAvatar(ctx context.Context, req *filepb.SetAvatarRequest) (*filepb.Avatar, error) {
+	resp, err := s.fileService.SetAvatar(ctx, &dto.SetAvatarRequest{
+		UserID:    req.UserId,
+		FileID:    req.FileId,
+		OwnerType: int32(req.OwnerType),
+		OwnerID:   req.OwnerId,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toProtoAvatar(resp), nil
+}
+
+// RemoveAvatar deletes the avatar images of a user or group
+func (s *FileServer) RemoveAvatar(ctx context.Context, req *filepb.RemoveAvatarRequest) (*commonpb.Empty, error) {
+	if err := s.fileService.RemoveAvatar(ctx, model.StorageOwnerType(req.OwnerType), req.OwnerId); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &commonpb.Empty{}, nil
+}
+
+// GenerateGroupAvatar composes the default avatar of a group from member avatars
+func (s *FileServer) GenerateGroupAvatar(ctx context.Context, req *filepb.GenerateGroupAvatarRequest) (*filepb.Avatar, error) {
+	resp, err := s.fileService.GenerateGroupAvatar(ctx, req.GroupId, req.MemberIds)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toProtoAvatar(resp), nil
+}
+
+// ListQuarantinedFiles lists files held back by the malware scan
+func (s *FileServer) ListQuarantinedFiles(ctx context.Context, req *filepb.ListQuarantinedFilesRequest) (*filepb.ListQuarantinedFilesResponse, error) {
+	resp, err := s.fileService.ListQuarantinedFiles(ctx, req.GetUserId(), int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	files := make([]*filepb.QuarantinedFile, 0, len(resp.Files))
+	for _, file := range resp.Files {
+		files = append(files, toProtoQuarantinedFile(file))
+	}
+	return &filepb.ListQuarantinedFilesResponse{Files: files, Total: resp.Total}, nil
+}
+
+// GetQuarantinedFile gets a scanned file with its verdict history
+func (s *FileServer) GetQuarantinedFile(ctx context.Context, req *filepb.GetQuarantinedFileRequest) (*filepb.QuarantinedFile, error) {
+	resp, err := s.fileService.GetQuarantinedFile(ctx, req.FileId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toProtoQuarantinedFile(resp), nil
+}
+
+// ReleaseQuarantinedFile lets a quarantined file through
+func (s *FileServer) ReleaseQuarantinedFile(ctx context.Context, req *filepb.ReviewQuarantinedFileRequest) (*filepb.QuarantinedFile, error) {
+	resp, err := s.fileService.ReleaseQuarantinedFile(ctx, &dto.ReviewQuarantinedFileRequest{
+		FileID:  req.FileId,
+		AdminID: req.AdminId,
+		Note:    req.Note,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toProtoQuarantinedFile(resp), nil
+}
+
+// DeleteQuarantinedFile deletes a quarantined file
+func (s *FileServer) DeleteQuarantinedFile(ctx context.Context, req *filepb.ReviewQuarantinedFileRequest) (*filepb.QuarantinedFile, error) {
+	resp, err := s.fileService.DeleteQuarantinedFile(ctx, &dto.ReviewQuarantinedFileRequest{
+		FileID:  req.FileId,
+		AdminID: req.AdminId,
+		Note:    req.Note,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return toProtoQuarantinedFile(resp), nil
+}
+
+// toProtoQuarantinedFile converts to proto QuarantinedFile
+func toProtoQuarantinedFile(file *dto.QuarantinedFileResponse) *filepb.QuarantinedFile {
+	verdicts := make([]*filepb.FileScanVerdict, 0, len(file.Verdicts))
+	for _, verdict := range file.Verdicts {
+		verdicts = append(verdicts, &filepb.FileScanVerdict{
+			Verdict:   verdict.Verdict,
+			Scanner:   verdict.Scanner,
+			Signature: verdict.Signature,
+			Detail:    verdict.Detail,
+			AdminId:   verdict.AdminID,
+			CreatedAt: verdict.CreatedAt,
+		})
+	}
+	return &filepb.QuarantinedFile{
+		File:       toProtoFileInfo(file.File),
+		ScanStatus: filepb.FileScanStatus(file.ScanStatus),
+		Scanner:    file.Scanner,
+		Signature:  file.Signature,
+		LastError:  file.LastError,
+		Attempts:   file.Attempts,
+		ScannedAt:  file.ScannedAt,
+		ReviewedBy: file.ReviewedBy,
+		ReviewedAt: file.ReviewedAt,
+		Verdicts:   verdicts,
+	}
+}
+
+// toProtoAvatar converts to proto Avatar
+func toProtoAvatar(avatar *dto.AvatarResponse) *filepb.Avatar {
+	images := make([]*filepb.AvatarImage, 0, len(avatar.Images))
+	for _, image := range avatar.Images {
+		images = append(images, &filepb.AvatarImage{
+			Size: image.Size,
+			Url:  image.URL,
+		})
+	}
+	return &filepb.Avatar{
+		Url:    avatar.URL,
+		Images: images,
+	}
+}
+
+// toProtoStorageUsage converts to proto StorageUsage
+func toProtoStorageUsage(usage *dto.StorageUsageResponse) *filepb.StorageUsage {
+	return &filepb.StorageUsage{
+		OwnerType:       filepb.StorageOwnerType(usage.OwnerType),
+		OwnerId:         usage.OwnerID,
+		UsedBytes:       usage.UsedBytes,
+		QuotaBytes:      usage.QuotaBytes,
+		FileCount:       usage.FileCount,
+		Tier:            usage.Tier,
+		QuotaOverridden: usage.QuotaOverridden,
+	}
+}
+
+// toProtoMultipartUpload converts to proto MultipartUpload
+func toProtoMultipartUpload(upload *dto.MultipartUploadResponse) *filepb.MultipartUpload {
+	return &filepb.MultipartUpload{
+		UploadId:      upload.UploadID,
+		FileId:        upload.FileID,
+		FileName:      upload.FileName,
+		FileSize:      upload.FileSize,
+		PartSize:      upload.PartSize,
+		TotalParts:    upload.TotalParts,
+		UploadedParts: upload.UploadedParts,
+		Status:        filepb.UploadStatus(upload.Status),
+		ExpiresAt:     upload.ExpiresAt,
+	}
+}
+
 // toProtoFileInfo converts to proto FileInfo
 func toProtoFileInfo(file *dto.FileInfoResponse) *filepb.FileInfo {
 	pbFile := &filepb.FileInfo{
@@ -182,16 +534,20 @@ func toProtoFileInfo(file *dto.FileInfoResponse) *filepb.FileInfo {
 func convertError(err error) error {
 	if bizErr, ok := err.(*errors.Business); ok {
 		switch bizErr.Code {
-		case errors.CodeFileNotFound:
+		case errors.CodeFileNotFound, errors.CodeUploadNotFound:
 			return status.Error(codes.NotFound, bizErr.Message)
-		case errors.CodeFileAccessDenied:
+		case errors.CodeFileAccessDenied, errors.CodeFileQuarantined:
 			return status.Error(codes.PermissionDenied, bizErr.Message)
-		case errors.CodeFileSizeExceeded, errors.CodeFileTypeNotAllowed, errors.CodeInvalidFileID:
+		case errors.CodeParamError, errors.CodeFileSizeExceeded, errors.CodeFileTypeNotAllowed, errors.CodeInvalidFileID,
+			errors.CodeUploadIncomplete, errors.CodeUploadClosed, errors.CodeFileHashMismatch, errors.CodeFileContentMismatch,
+			errors.CodeInvalidAvatarImage:
 			return status.Error(codes.InvalidArgument, bizErr.Message)
 		case errors.CodeFileUploadFailed:
 			return status.Error(codes.Internal, bizErr.Message)
 		case errors.CodeFileExpired:
 			return status.Error(codes.FailedPrecondition, bizErr.Message)
+		case errors.CodeStorageQuotaExceeded:
+			return status.Error(codes.ResourceExhausted, bizErr.Message)
 		default:
 			return status.Error(codes.Internal, bizErr.Message)
 		}
@@ -0,0 +1,344 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+// maxMovieBoxSize largest moov box read into memory, larger ones are treated as malformed
+const maxMovieBoxSize = 16 * 1024 * 1024
+
+// mp3SyncSearch bytes searched for the first MP3 frame after the ID3 tag
+const mp3SyncSearch = 64 * 1024
+
+// AVInfo audio or video properties read from the container headers
+type AVInfo struct {
+	Width    int // video only, displayed size with the track rotation applied
+	Height   int
+	Duration time.Duration
+	Format   string // mp4, mov, m4a, wav, mp3
+}
+
+// ProbeAV reads duration and dimensions from MP4/MOV/M4A, WAV and MP3 headers.
+// Only the header ranges are read, so r can be a remote object
+func ProbeAV(r io.ReaderAt, size int64) (*AVInfo, error) {
+	head := make([]byte, 12)
+	if _, err := r.ReadAt(head, 0); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, ErrUnsupported
+		}
+		return nil, err
+	}
+
+	switch {
+	case string(head[4:8]) == "ftyp":
+		return probeMP4(r, size)
+	case string(head[0:4]) == "RIFF" && string(head[8:12]) == "WAVE":
+		return probeWAV(r, size)
+	case string(head[0:3]) == "ID3" || (head[0] == 0xFF && head[1]&0xE0 == 0xE0):
+		return probeMP3(r, size)
+	default:
+		return nil, ErrUnsupported
+	}
+}
+
+// isoBox ISO base media file format box
+type isoBox struct {
+	boxType string
+	offset  int64 // payload offset
+	size    int64 // payload size
+}
+
+// readBoxHeader reads the box header at offset
+func readBoxHeader(r io.ReaderAt, offset, end int64) (isoBox, error) {
+	header := make([]byte, 16)
+	if _, err := r.ReadAt(header[:8], offset); err != nil {
+		return isoBox{}, err
+	}
+	size := int64(binary.BigEndian.Uint32(header))
+	box := isoBox{boxType: string(header[4:8]), offset: offset + 8}
+	switch size {
+	case 0: // extends to the end of the file
+		size = end - offset
+	case 1: // 64-bit size follows the type
+		if _, err := r.ReadAt(header[8:16], offset+8); err != nil {
+			return isoBox{}, err
+		}
+		size = int64(binary.BigEndian.Uint64(header[8:]))
+		box.offset += 8
+	}
+	box.size = size - (box.offset - offset)
+	if box.size < 0 || offset+size > end {
+		return isoBox{}, errors.New("media: malformed box")
+	}
+	return box, nil
+}
+
+// probeMP4 reads the movie header and the first video track header
+func probeMP4(r io.ReaderAt, size int64) (*AVInfo, error) {
+	info := &AVInfo{Format: "mp4"}
+	var moov *isoBox
+	for offset := int64(0); offset+8 <= size; {
+		box, err := readBoxHeader(r, offset, size)
+		if err != nil {
+			return nil, err
+		}
+		if box.boxType == "ftyp" && box.size >= 4 {
+			brand := make([]byte, 4)
+			if _, err := r.ReadAt(brand, box.offset); err != nil {
+				return nil, err
+			}
+			switch string(brand) {
+			case "qt  ":
+				info.Format = "mov"
+			case "M4A ", "M4B ":
+				info.Format = "m4a"
+			}
+		}
+		if box.boxType == "moov" {
+			moov = &box
+			break
+		}
+		offset = box.offset + box.size
+	}
+	if moov == nil || moov.size > maxMovieBoxSize {
+		return nil, errors.New("media: movie header not found")
+	}
+
+	data := make([]byte, moov.size)
+	if _, err := r.ReadAt(data, moov.offset); err != nil {
+		return nil, err
+	}
+	for _, box := range childBoxes(data) {
+		switch box.boxType {
+		case "mvhd":
+			info.Duration = movieDuration(box.data)
+		case "trak":
+			if info.Width > 0 {
+				continue
+			}
+			if width, height, ok := videoTrackSize(box.data); ok {
+				info.Width, info.Height = width, height
+			}
+		}
+	}
+	return info, nil
+}
+
+// memBox box inside an in-memory buffer
+type memBox struct {
+	boxType string
+	data    []byte
+}
+
+// childBoxes splits a container payload into its child boxes, stopping at the first malformed one
+func childBoxes(data []byte) []memBox {
+	var boxes []memBox
+	for pos := 0; pos+8 <= len(data); {
+		size := int(binary.BigEndian.Uint32(data[pos:]))
+		header := 8
+		if size == 1 && pos+16 <= len(data) {
+			size = int(binary.BigEndian.Uint64(data[pos+8:]))
+			header = 16
+		} else if size == 0 {
+			size = len(data) - pos
+		}
+		if size < header || pos+size > len(data) {
+			break
+		}
+		boxes = append(boxes, memBox{boxType: string(data[pos+4 : pos+8]), data: data[pos+header : pos+size]})
+		pos += size
+	}
+	return boxes
+}
+
+// movieDuration reads the duration of the mvhd payload
+func movieDuration(mvhd []byte) time.Duration {
+	var timescale, duration uint64
+	switch {
+	case len(mvhd) >= 20 && mvhd[0] == 0:
+		timescale = uint64(binary.BigEndian.Uint32(mvhd[12:]))
+		duration = uint64(binary.BigEndian.Uint32(mvhd[16:]))
+	case len(mvhd) >= 32 && mvhd[0] == 1:
+		timescale = uint64(binary.BigEndian.Uint32(mvhd[20:]))
+		duration = binary.BigEndian.Uint64(mvhd[24:])
+	}
+	if timescale == 0 || duration == 0 || duration == 0xFFFFFFFF {
+		return 0
+	}
+	return time.Duration(float64(duration) / float64(timescale) * float64(time.Second))
+}
+
+// videoTrackSize reads the display size of a video track, false for other tracks
+func videoTrackSize(trak []byte) (int, int, bool) {
+	var tkhd []byte
+	isVideo := false
+	for _, box := range childBoxes(trak) {
+		switch box.boxType {
+		case "tkhd":
+			tkhd = box.data
+		case "mdia":
+			for _, child := range childBoxes(box.data) {
+				if child.boxType == "hdlr" && len(child.data) >= 12 && string(child.data[8:12]) == "vide" {
+					isVideo = true
+				}
+			}
+		}
+	}
+	if !isVideo || tkhd == nil {
+		return 0, 0, false
+	}
+
+	// matrix and 16.16 fixed point width/height follow the version dependent timestamps
+	matrix := 40
+	if tkhd[0] == 1 {
+		matrix = 52
+	}
+	if len(tkhd) < matrix+44 {
+		return 0, 0, false
+	}
+	width := int(binary.BigEndian.Uint32(tkhd[matrix+36:]) >> 16)
+	height := int(binary.BigEndian.Uint32(tkhd[matrix+40:]) >> 16)
+
+	// a zero scale factor in the matrix means the track is rotated by 90 or 270 degrees
+	if binary.BigEndian.Uint32(tkhd[matrix:]) == 0 && binary.BigEndian.Uint32(tkhd[matrix+4:]) != 0 {
+		width, height = height, width
+	}
+	return width, height, width > 0 && height > 0
+}
+
+// probeWAV reads the format and data chunk sizes of a RIFF/WAVE file
+func probeWAV(r io.ReaderAt, size int64) (*AVInfo, error) {
+	var byteRate uint32
+	header := make([]byte, 16)
+	for offset := int64(12); offset+8 <= size; {
+		if _, err := r.ReadAt(header[:8], offset); err != nil {
+			return nil, err
+		}
+		chunkSize := int64(binary.LittleEndian.Uint32(header[4:8]))
+		switch string(header[:4]) {
+		case "fmt ":
+			if chunkSize < 16 {
+				return nil, errors.New("media: malformed WAV format chunk")
+			}
+			if _, err := r.ReadAt(header, offset+8); err != nil {
+				return nil, err
+			}
+			byteRate = binary.LittleEndian.Uint32(header[8:12])
+		case "data":
+			if byteRate == 0 {
+				return nil, errors.New("media: WAV data before format chunk")
+			}
+			// streamed recordings may leave the size unset or larger than the file
+			if chunkSize == 0 || offset+8+chunkSize > size {
+				chunkSize = size - offset - 8
+			}
+			return &AVInfo{
+				Format:   "wav",
+				Duration: time.Duration(float64(chunkSize) / float64(byteRate) * float64(time.Second)),
+			}, nil
+		}
+		offset += 8 + chunkSize + chunkSize%2
+	}
+	return nil, errors.New("media: WAV data chunk not found")
+}
+
+// mp3 tables for MPEG audio layer III, indexed by the header fields
+var (
+	mp3BitratesV1 = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+	mp3BitratesV2 = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+	mp3Rates      = map[byte][3]int{
+		3: {44100, 48000, 32000}, // MPEG 1
+		2: {22050, 24000, 16000}, // MPEG 2
+		0: {11025, 12000, 8000},  // MPEG 2.5
+	}
+)
+
+// probeMP3 reads the duration from the Xing/Info or VBRI frame count, or estimates it from the bitrate
+func probeMP3(r io.ReaderAt, size int64) (*AVInfo, error) {
+	start := int64(0)
+	id3 := make([]byte, 10)
+	if _, err := r.ReadAt(id3, 0); err != nil {
+		return nil, err
+	}
+	if string(id3[:3]) == "ID3" {
+		// tag size is a 28-bit syncsafe integer, a footer adds 10 bytes
+		tagSize := int64(id3[6]&0x7F)<<21 | int64(id3[7]&0x7F)<<14 | int64(id3[8]&0x7F)<<7 | int64(id3[9]&0x7F)
+		start = 10 + tagSize
+		if id3[5]&0x10 != 0 {
+			start += 10
+		}
+	}
+
+	buf := make([]byte, min(int64(mp3SyncSearch), size-start))
+	if len(buf) < 4 {
+		return nil, ErrUnsupported
+	}
+	n, err := r.ReadAt(buf, start)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	buf = buf[:n]
+
+	for i := 0; i+4 <= len(buf); i++ {
+		if buf[i] != 0xFF || buf[i+1]&0xE0 != 0xE0 {
+			continue
+		}
+		version := (buf[i+1] >> 3) & 0x03
+		layer := (buf[i+1] >> 1) & 0x03
+		bitrateIndex := buf[i+2] >> 4
+		rateIndex := (buf[i+2] >> 2) & 0x03
+		rates, ok := mp3Rates[version]
+		if !ok || layer != 1 || rateIndex == 3 || bitrateIndex == 0 || bitrateIndex == 15 {
+			continue
+		}
+
+		sampleRate := rates[rateIndex]
+		bitrate := mp3BitratesV2[bitrateIndex]
+		samplesPerFrame := 576
+		sideInfo := 17
+		if buf[i+3]>>6 == 3 { // mono
+			sideInfo = 9
+		}
+		if version == 3 {
+			bitrate = mp3BitratesV1[bitrateIndex]
+			samplesPerFrame = 1152
+			sideInfo = 32
+			if buf[i+3]>>6 == 3 {
+				sideInfo = 17
+			}
+		}
+
+		info := &AVInfo{Format: "mp3"}
+		if frames := mp3FrameCount(buf[i:], sideInfo); frames > 0 {
+			info.Duration = time.Duration(float64(frames) * float64(samplesPerFrame) / float64(sampleRate) * float64(time.Second))
+		} else {
+			audioBytes := size - start - int64(i)
+			info.Duration = time.Duration(float64(audioBytes) * 8 / float64(bitrate*1000) * float64(time.Second))
+		}
+		return info, nil
+	}
+	return nil, ErrUnsupported
+}
+
+// mp3FrameCount reads the frame count of a Xing/Info or VBRI header in the first frame, 0 when absent
+func mp3FrameCount(frame []byte, sideInfo int) uint32 {
+	xing := 4 + sideInfo
+	if len(frame) >= xing+12 {
+		tag := frame[xing : xing+4]
+		if bytes.Equal(tag, []byte("Xing")) || bytes.Equal(tag, []byte("Info")) {
+			if binary.BigEndian.Uint32(frame[xing+4:])&0x01 != 0 {
+				return binary.BigEndian.Uint32(frame[xing+8:])
+			}
+			return 0
+		}
+	}
+	// VBRI always follows 32 bytes of side information
+	if len(frame) >= 36+18 && bytes.Equal(frame[36:40], []byte("VBRI")) {
+		return binary.BigEndian.Uint32(frame[36+14:])
+	}
+	return 0
+}
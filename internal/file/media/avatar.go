@@ -0,0 +1,98 @@
+package media
+
+import (
+	"hash/fnv"
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// compositeGap pixels between the tiles of a composite, relative to a 100 pixel canvas
+const compositeGap = 2
+
+// placeholderColors backgrounds of members without an avatar, picked by a hash of the member ID
+var placeholderColors = []color.RGBA{
+	{0x5B, 0x8F, 0xF9, 0xFF},
+	{0x5A, 0xD8, 0xA6, 0xFF},
+	{0xF6, 0xBD, 0x16, 0xFF},
+	{0xE8, 0x68, 0x4A, 0xFF},
+	{0x6D, 0xC8, 0xEC, 0xFF},
+	{0x92, 0x70, 0xCA, 0xFF},
+	{0xFF, 0x9D, 0x4D, 0xFF},
+	{0x26, 0x9A, 0x99, 0xFF},
+}
+
+// SquareCrop turns the image upright and cuts the largest centered square out of it.
+// Transparent areas are flattened onto white, so the result can be encoded as JPEG
+func SquareCrop(img image.Image, orientation int) *image.RGBA {
+	upright := toRGBA(ApplyOrientation(flatten(img), orientation))
+	w, h := upright.Rect.Dx(), upright.Rect.Dy()
+	edge := min(w, h)
+	x0, y0 := (w-edge)/2, (h-edge)/2
+	return toRGBA(upright.SubImage(image.Rect(x0, y0, x0+edge, y0+edge)))
+}
+
+// ScaleSquare scales a square image to edge x edge, smaller images are kept as they are
+func ScaleSquare(square *image.RGBA, edge int) *image.RGBA {
+	if square.Rect.Dx() <= edge {
+		return square
+	}
+	return resize(square, edge, edge)
+}
+
+// Placeholder a plain square tile whose color is derived from seed
+func Placeholder(seed string, edge int) *image.RGBA {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(seed))
+	tile := image.NewRGBA(image.Rect(0, 0, edge, edge))
+	draw.Draw(tile, tile.Rect, image.NewUniform(placeholderColors[h.Sum32()%uint32(len(placeholderColors))]), image.Point{}, draw.Src)
+	return tile
+}
+
+// Composite lays out up to nine square tiles on a light grey canvas: one tile fills it, up to four go in a
+// 2x2 grid and more in a 3x3 grid. A short first row is centered
+func Composite(tiles []*image.RGBA, edge int) *image.RGBA {
+	canvas := image.NewRGBA(image.Rect(0, 0, edge, edge))
+	draw.Draw(canvas, canvas.Rect, image.NewUniform(color.RGBA{0xDD, 0xDE, 0xE0, 0xFF}), image.Point{}, draw.Src)
+	if len(tiles) > 9 {
+		tiles = tiles[:9]
+	}
+	n := len(tiles)
+	if n == 0 {
+		return canvas
+	}
+
+	cols := 3
+	switch {
+	case n == 1:
+		cols = 1
+	case n <= 4:
+		cols = 2
+	}
+	rows := (n + cols - 1) / cols
+	gap := max(1, edge*compositeGap/100)
+	if n == 1 {
+		gap = 0
+	}
+	tile := (edge - gap*(cols+1)) / cols
+	top := (edge - rows*tile - (rows-1)*gap) / 2
+
+	// the first row holds the remainder, e.g. 5 members are laid out as 2 + 3
+	first := n - (rows-1)*cols
+	i := 0
+	for row := 0; row < rows; row++ {
+		count := cols
+		if row == 0 {
+			count = first
+		}
+		left := (edge - count*tile - (count-1)*gap) / 2
+		y := top + row*(tile+gap)
+		for c := 0; c < count; c++ {
+			x := left + c*(tile+gap)
+			scaled := resize(tiles[i], tile, tile)
+			draw.Draw(canvas, image.Rect(x, y, x+tile, y+tile), scaled, image.Point{}, draw.Src)
+			i++
+		}
+	}
+	return canvas
+}
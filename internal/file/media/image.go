@@ -0,0 +1,207 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // registers GIF with image.Decode
+	"image/jpeg"
+	_ "image/png" // registers PNG with image.Decode
+)
+
+// ErrUnsupported the content is not in a format this package can read
+var ErrUnsupported = errors.New("media: unsupported format")
+
+// ImageInfo decoded image header. Width and Height are the displayed size, with the EXIF orientation applied
+type ImageInfo struct {
+	Width       int
+	Height      int
+	Format      string // jpeg, png, gif
+	Orientation int    // EXIF orientation 1-8, 1 when absent
+}
+
+// DecodeImageConfig reads the image header without decoding the pixels
+func DecodeImageConfig(data []byte) (ImageInfo, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return ImageInfo{}, ErrUnsupported
+		}
+		return ImageInfo{}, err
+	}
+
+	info := ImageInfo{Width: cfg.Width, Height: cfg.Height, Format: format, Orientation: 1}
+	if format == "jpeg" {
+		info.Orientation = JPEGOrientation(data)
+	}
+	if info.Orientation >= 5 {
+		info.Width, info.Height = info.Height, info.Width
+	}
+	return info, nil
+}
+
+// DecodeImage decodes the image, the first frame for animated GIFs
+func DecodeImage(data []byte) (image.Image, ImageInfo, error) {
+	info, err := DecodeImageConfig(data)
+	if err != nil {
+		return nil, info, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, info, err
+	}
+	return img, info, nil
+}
+
+// Thumbnail scales the image to fit within maxEdge on its longer displayed side and applies the orientation.
+// Transparent areas are flattened onto white, so the result can be encoded as JPEG
+func Thumbnail(img image.Image, orientation, maxEdge int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if orientation >= 5 {
+		w, h = h, w
+	}
+	dw, dh := fitWithin(w, h, maxEdge)
+	if orientation >= 5 {
+		dw, dh = dh, dw
+	}
+	return ApplyOrientation(resize(flatten(img), dw, dh), orientation)
+}
+
+// EncodeJPEG encodes the image as baseline JPEG, which carries no EXIF
+func EncodeJPEG(img image.Image, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// StripImageMetadata removes EXIF (including GPS), XMP and text metadata from the original image.
+// JPEG segments and PNG chunks are dropped without re-encoding; a rotated JPEG is re-encoded upright at
+// the given quality instead, since dropping its orientation tag would display it sideways.
+// changed is false when there was nothing to remove
+func StripImageMetadata(data []byte, img image.Image, info ImageInfo, quality int) (stripped []byte, changed bool, err error) {
+	switch info.Format {
+	case "jpeg":
+		if info.Orientation > 1 {
+			stripped, err = EncodeJPEG(ApplyOrientation(img, info.Orientation), quality)
+			return stripped, err == nil, err
+		}
+		return stripJPEGMetadata(data)
+	case "png":
+		return stripPNGMetadata(data)
+	default:
+		return data, false, nil
+	}
+}
+
+// ApplyOrientation turns an image stored with the given EXIF orientation upright
+func ApplyOrientation(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	src := toRGBA(img)
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored horizontally
+				dx, dy = w-1-x, y
+			case 3: // rotated 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				dx, dy = x, h-1-y
+			case 5: // transposed
+				dx, dy = y, x
+			case 6: // rotated 90 clockwise
+				dx, dy = h-1-y, x
+			case 7: // transversed
+				dx, dy = h-1-y, w-1-x
+			case 8: // rotated 90 counter-clockwise
+				dx, dy = y, w-1-x
+			}
+			si := y*src.Stride + x*4
+			di := dy*dst.Stride + dx*4
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+	return dst
+}
+
+// fitWithin scales w x h down to fit within maxEdge, keeping the aspect ratio
+func fitWithin(w, h, maxEdge int) (int, int) {
+	if w <= maxEdge && h <= maxEdge {
+		return w, h
+	}
+	if w >= h {
+		return maxEdge, max(1, h*maxEdge/w)
+	}
+	return max(1, w*maxEdge/h), maxEdge
+}
+
+// flatten draws the image onto a white background
+func flatten(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Rect, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Rect, img, b.Min, draw.Over)
+	return dst
+}
+
+// toRGBA converts the image to RGBA with its origin at 0,0
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Rect, img, b.Min, draw.Src)
+	return dst
+}
+
+// resize downscales by area averaging: every destination pixel is the mean of the source box it covers
+func resize(src *image.RGBA, dw, dh int) *image.RGBA {
+	sw, sh := src.Rect.Dx(), src.Rect.Dy()
+	if dw == sw && dh == sh {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for dy := 0; dy < dh; dy++ {
+		sy0 := dy * sh / dh
+		sy1 := max(sy0+1, (dy+1)*sh/dh)
+		for dx := 0; dx < dw; dx++ {
+			sx0 := dx * sw / dw
+			sx1 := max(sx0+1, (dx+1)*sw/dw)
+
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := sx0; sx < sx1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += uint64(p[0])
+					g += uint64(p[1])
+					b += uint64(p[2])
+					a += uint64(p[3])
+					n++
+				}
+			}
+			di := dy*dst.Stride + dx*4
+			dst.Pix[di] = uint8(r / n)
+			dst.Pix[di+1] = uint8(g / n)
+			dst.Pix[di+2] = uint8(b / n)
+			dst.Pix[di+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,172 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// JPEG markers
+const (
+	markerSOI  = 0xD8
+	markerSOS  = 0xDA
+	markerAPP1 = 0xE1 // EXIF, XMP
+	markerAPPD = 0xED // Photoshop IRB, IPTC
+)
+
+// exifOrientationTag TIFF tag holding the EXIF orientation
+const exifOrientationTag = 0x0112
+
+// pngSignature first bytes of every PNG file
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// pngMetadataChunks ancillary PNG chunks that carry EXIF, text or timestamps
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"tIME": true,
+}
+
+var errMalformedJPEG = errors.New("media: malformed JPEG")
+
+// JPEGOrientation reads the EXIF orientation of a JPEG, 1 when there is none
+func JPEGOrientation(data []byte) int {
+	orientation := 1
+	_, _ = walkJPEGSegments(data, func(marker byte, segment []byte) bool {
+		if marker != markerAPP1 || !bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return true
+		}
+		if value := tiffOrientation(segment[6:]); value >= 1 && value <= 8 {
+			orientation = value
+		}
+		return false
+	})
+	return orientation
+}
+
+// tiffOrientation reads the orientation tag from IFD0 of a TIFF structure
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 0
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:]) == exifOrientationTag {
+			// SHORT value stored in the first two bytes of the value field
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+	return 0
+}
+
+// stripJPEGMetadata drops APP1 and APP13 segments, keeping JFIF, ICC profiles and Adobe markers that decoding needs
+func stripJPEGMetadata(data []byte) ([]byte, bool, error) {
+	out := make([]byte, 0, len(data))
+	out = append(out, 0xFF, markerSOI)
+	changed := false
+	sos, err := walkJPEGSegments(data, func(marker byte, segment []byte) bool {
+		if marker == markerAPP1 || marker == markerAPPD {
+			changed = true
+			return true
+		}
+		out = append(out, 0xFF, marker, byte((len(segment)+2)>>8), byte(len(segment)+2))
+		out = append(out, segment...)
+		return true
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if !changed {
+		return data, false, nil
+	}
+
+	// scan data and everything after it is copied as is
+	return append(out, data[sos:]...), true, nil
+}
+
+// walkJPEGSegments calls fn for every marker segment before the first scan until fn returns false.
+// segment is the payload without the marker and length bytes. Returns the offset of the first
+// scan marker, 0 when fn stopped the walk
+func walkJPEGSegments(data []byte, fn func(marker byte, segment []byte) bool) (int, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != markerSOI {
+		return 0, errMalformedJPEG
+	}
+	pos := 2
+	for {
+		// markers may be preceded by fill bytes
+		for pos < len(data) && data[pos] == 0xFF && pos+1 < len(data) && data[pos+1] == 0xFF {
+			pos++
+		}
+		if pos+4 > len(data) || data[pos] != 0xFF {
+			return 0, errMalformedJPEG
+		}
+		marker := data[pos+1]
+		if marker == markerSOS {
+			return pos, nil
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return 0, errMalformedJPEG
+		}
+		if !fn(marker, data[pos+4:pos+2+length]) {
+			return 0, nil
+		}
+		pos += 2 + length
+	}
+}
+
+// stripPNGMetadata drops EXIF, text and time chunks, the remaining chunks keep their CRCs
+func stripPNGMetadata(data []byte) ([]byte, bool, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, false, errors.New("media: malformed PNG")
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, pngSignature...)
+	changed := false
+	pos := len(pngSignature)
+	for pos < len(data) {
+		if pos+12 > len(data) {
+			return nil, false, errors.New("media: truncated PNG chunk")
+		}
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		end := pos + 12 + length
+		if length < 0 || end > len(data) {
+			return nil, false, errors.New("media: truncated PNG chunk")
+		}
+		chunkType := string(data[pos+4 : pos+8])
+		if pngMetadataChunks[chunkType] {
+			changed = true
+		} else {
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+		if chunkType == "IEND" {
+			break
+		}
+	}
+	if !changed {
+		return data, false, nil
+	}
+	return out, true, nil
+}
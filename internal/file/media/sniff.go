@@ -0,0 +1,86 @@
+package media
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+)
+
+// SniffLength bytes of the content needed by DetectMIME
+const SniffLength = 512
+
+// ftypBrands major brands of ISO base media files that are not plain MP4 video
+var ftypBrands = map[string]string{
+	"heic": "image/heic",
+	"heix": "image/heic",
+	"hevc": "image/heic",
+	"heim": "image/heic",
+	"heis": "image/heic",
+	"hevm": "image/heic",
+	"hevs": "image/heic",
+	"mif1": "image/heif",
+	"msf1": "image/heif",
+	"avif": "image/avif",
+	"avis": "image/avif",
+	"qt  ": "video/quicktime",
+	"M4A ": "audio/mp4",
+	"M4B ": "audio/mp4",
+	"3gp4": "video/3gpp",
+	"3gp5": "video/3gpp",
+	"3gp6": "video/3gpp",
+	"3g2a": "video/3gpp2",
+}
+
+// magicSignatures formats the standard library does not recognise, checked before it
+var magicSignatures = []struct {
+	prefix []byte
+	mime   string
+}{
+	{[]byte("#!AMR"), "audio/amr"},
+	{[]byte("fLaC"), "audio/flac"},
+	{[]byte("MZ"), "application/vnd.microsoft.portable-executable"},
+	{[]byte("\x7fELF"), "application/x-executable"},
+	{[]byte("\xcf\xfa\xed\xfe"), "application/x-mach-binary"},
+	{[]byte("\xca\xfe\xba\xbe"), "application/x-mach-binary"},
+}
+
+// DetectMIME detects the MIME type from the first SniffLength bytes of the content, without parameters.
+// Unknown binary content is application/octet-stream
+func DetectMIME(head []byte) string {
+	if len(head) > SniffLength {
+		head = head[:SniffLength]
+	}
+
+	if len(head) >= 12 && string(head[4:8]) == "ftyp" {
+		if mime, ok := ftypBrands[string(head[8:12])]; ok {
+			return mime
+		}
+		return "video/mp4"
+	}
+	if len(head) >= 12 && string(head[0:4]) == "RIFF" && string(head[8:12]) == "AVI " {
+		return "video/x-msvideo"
+	}
+	for _, signature := range magicSignatures {
+		if bytes.HasPrefix(head, signature.prefix) {
+			return signature.mime
+		}
+	}
+	// MPEG audio without an ID3 tag starts with a frame sync, ADTS AAC with its own sync word
+	if len(head) >= 2 && head[0] == 0xFF {
+		switch {
+		case head[1]&0xF6 == 0xF0:
+			return "audio/aac"
+		case head[1]&0xE0 == 0xE0 && head[1]&0x06 != 0:
+			return "audio/mpeg"
+		}
+	}
+
+	mime := http.DetectContentType(head)
+	if i := strings.IndexByte(mime, ';'); i >= 0 {
+		mime = strings.TrimSpace(mime[:i])
+	}
+	if mime == "audio/wave" {
+		return "audio/wav"
+	}
+	return mime
+}
@@ -31,14 +31,28 @@ type File struct {
 	CreatedAt     time.Time    `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
 	ExpiresAt     *time.Time   `gorm:"column:expires_at"`
 	Metadata      FileMetadata `gorm:"column:metadata;type:jsonb"`
+	SHA256        string       `gorm:"column:sha256"`   // declared by the client, verified when the upload completes
+	BlobID        *int64       `gorm:"column:blob_id"`  // shared stored object, nil for files uploaded without a hash
+	GroupID       string       `gorm:"column:group_id"` // group whose quota the file counts against, empty for the uploader's
+	// ExpiryWarnedAt when the uploader was told the file expires soon
+	ExpiryWarnedAt *time.Time `gorm:"column:expiry_warned_at"`
 }
 
-// FileMetadata file extended metadata
+// FileMetadata file extended metadata, filled in by media processing
 type FileMetadata struct {
-	Width    int    `json:"width,omitempty"`
-	Height   int    `json:"height,omitempty"`
-	Duration int    `json:"duration,omitempty"`
-	Format   string `json:"format,omitempty"`
+	Width      int         `json:"width,omitempty"`
+	Height     int         `json:"height,omitempty"`
+	Duration   int         `json:"duration,omitempty"` // seconds
+	Format     string      `json:"format,omitempty"`
+	Thumbnails []Thumbnail `json:"thumbnails,omitempty"`
+}
+
+// Thumbnail scaled JPEG copy of an image stored next to the original
+type Thumbnail struct {
+	Size   int    `json:"size"` // configured longest edge
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Path   string `json:"path"`
 }
 
 // Scan implements sql.Scanner interface
@@ -58,12 +72,33 @@ func (m *FileMetadata) Scan(value interface{}) error {
 
 // Value implements driver.Valuer interface
 func (m FileMetadata) Value() (driver.Value, error) {
-	if m.Width == 0 && m.Height == 0 && m.Duration == 0 && m.Format == "" {
+	if m.IsEmpty() {
 		return nil, nil
 	}
 	return json.Marshal(m)
 }
 
+// IsEmpty reports whether nothing is known about the file content
+func (m FileMetadata) IsEmpty() bool {
+	return m.Width == 0 && m.Height == 0 && m.Duration == 0 && m.Format == "" && len(m.Thumbnails) == 0
+}
+
+// ObjectPaths returns the storage paths of the original and all its thumbnails
+func (f *File) ObjectPaths() []string {
+	paths := []string{f.StoragePath}
+	seen := map[string]bool{f.StoragePath: true}
+	for _, thumbnail := range f.Metadata.Thumbnails {
+		if !seen[thumbnail.Path] {
+			seen[thumbnail.Path] = true
+			paths = append(paths, thumbnail.Path)
+		}
+	}
+	if f.ThumbnailPath != "" && !seen[f.ThumbnailPath] {
+		paths = append(paths, f.ThumbnailPath)
+	}
+	return paths
+}
+
 // TableName returns table name
 func (File) TableName() string {
 	return "files"
@@ -71,23 +106,33 @@ func (File) TableName() string {
 
 // FileUpload file upload tracking model (for chunked upload)
 type FileUpload struct {
-	ID             int64        `gorm:"column:id;primaryKey;autoIncrement"`
-	UploadID       string       `gorm:"column:upload_id;not null;uniqueIndex"`
-	UserID         string       `gorm:"column:user_id;not null"`
-	FileName       string       `gorm:"column:file_name;not null"`
-	FileSize       int64        `gorm:"column:file_size;not null"`
-	ChunkSize      int64        `gorm:"column:chunk_size;not null"`
-	UploadedChunks ChunkInfo    `gorm:"column:uploaded_chunks;type:jsonb"`
-	Status         UploadStatus `gorm:"column:status;type:smallint;default:1"`
-	CreatedAt      time.Time    `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
-	UpdatedAt      time.Time    `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP"`
-	CompletedAt    *time.Time
-	ExpiresAt      time.Time `gorm:"column:expires_at;not null"`
+	ID              int64        `gorm:"column:id;primaryKey;autoIncrement"`
+	UploadID        string       `gorm:"column:upload_id;not null;uniqueIndex"`
+	UserID          string       `gorm:"column:user_id;not null"`
+	FileID          string       `gorm:"column:file_id"` // file record created when the upload starts, active once completed
+	FileName        string       `gorm:"column:file_name;not null"`
+	FileSize        int64        `gorm:"column:file_size;not null"`
+	ChunkSize       int64        `gorm:"column:chunk_size;not null"`
+	TotalParts      int          `gorm:"column:total_parts;not null;default:0"`
+	BucketName      string       `gorm:"column:bucket_name"`
+	StoragePath     string       `gorm:"column:storage_path"`      // MinIO object key
+	StorageUploadID string       `gorm:"column:storage_upload_id"` // MinIO multipart upload ID
+	UploadedChunks  ChunkInfo    `gorm:"column:uploaded_chunks;type:jsonb"`
+	Status          UploadStatus `gorm:"column:status;type:smallint;default:1"`
+	CreatedAt       time.Time    `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt       time.Time    `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP"`
+	CompletedAt     *time.Time   `gorm:"column:completed_at"`
+	ExpiresAt       time.Time    `gorm:"column:expires_at;not null"`
+}
+
+// IsOpen reports whether parts can still be uploaded
+func (u *FileUpload) IsOpen() bool {
+	return u.Status == UploadStatusPending || u.Status == UploadStatusUploading
 }
 
 // ChunkInfo chunk information
 type ChunkInfo struct {
-	Chunks []int `json:"chunks"`
+	Chunks []int `json:"chunks"` // uploaded part numbers, as last listed from storage
 }
 
 // Scan implements sql.Scanner interface
@@ -117,9 +162,10 @@ func (FileUpload) TableName() string {
 
 // file status constants
 const (
-	FileStatusDeleted    FileStatus = 0
-	FileStatusActive     FileStatus = 1
-	FileStatusProcessing FileStatus = 2
+	FileStatusDeleted     FileStatus = 0
+	FileStatusActive      FileStatus = 1
+	FileStatusProcessing  FileStatus = 2 // upload not completed, or waiting for the malware scan
+	FileStatusQuarantined FileStatus = 3 // flagged by the malware scan, moved to the quarantine bucket
 )
 
 // file type constants
@@ -137,6 +183,7 @@ const (
 	BucketAvatar      = "avatar"
 	BucketGroupAvatar = "group-avatar"
 	BucketChatFile    = "chat-file"
+	BucketQuarantine  = "quarantine"
 )
 
 // file size limit constants (bytes)
@@ -153,5 +200,13 @@ const (
 	UploadStatusPending   UploadStatus = 1
 	UploadStatusUploading UploadStatus = 2
 	UploadStatusCompleted UploadStatus = 3
-	UploadStatusFailed    UploadStatus = 4
+	UploadStatusFailed    UploadStatus = 4 // aborted by the user or expired
+)
+
+// multipart upload limits, parts other than the last must be at least 5MB in S3/MinIO
+const (
+	MinPartSize     = 5 * 1024 * 1024
+	MaxPartSize     = 100 * 1024 * 1024
+	DefaultPartSize = 8 * 1024 * 1024
+	MaxUploadParts  = 10000
 )
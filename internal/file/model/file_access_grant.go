@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+// GrantConversationType conversation type of a grant
+type GrantConversationType int16
+
+const (
+	GrantConversationSingle GrantConversationType = 1
+	GrantConversationGroup  GrantConversationType = 2
+)
+
+// revoke reasons
+const (
+	GrantRevokeRecalled    = "recalled"
+	GrantRevokeDeleted     = "deleted"
+	GrantRevokeAutoDeleted = "auto_deleted"
+)
+
+// FileAccessGrant links a file to a message that references it, participants of the conversation may read the file
+type FileAccessGrant struct {
+	ID               int64                 `gorm:"column:id;primaryKey;autoIncrement"`
+	FileID           string                `gorm:"column:file_id;not null"`
+	MessageID        string                `gorm:"column:message_id;not null"`
+	ConversationID   string                `gorm:"column:conversation_id;not null"`
+	ConversationType GrantConversationType `gorm:"column:conversation_type;type:smallint;not null"`
+	TargetID         string                `gorm:"column:target_id;not null"` // peer user ID or group ID
+	SenderID         string                `gorm:"column:sender_id;not null"`
+	CreatedAt        time.Time             `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+	RevokedAt        *time.Time            `gorm:"column:revoked_at"`
+	RevokeReason     string                `gorm:"column:revoke_reason"`
+}
+
+// TableName returns table name
+func (FileAccessGrant) TableName() string {
+	return "file_access_grants"
+}
+
+// FileAccessGrantRecipient group member at the time a file message was sent
+type FileAccessGrantRecipient struct {
+	GrantID int64  `gorm:"column:grant_id;primaryKey"`
+	UserID  string `gorm:"column:user_id;primaryKey"`
+}
+
+// TableName returns table name
+func (FileAccessGrantRecipient) TableName() string {
+	return "file_access_grant_recipients"
+}
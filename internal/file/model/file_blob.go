@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// BlobStatus stored object status
+type BlobStatus int16
+
+const (
+	BlobStatusDeleted BlobStatus = 0 // last reference released, the object is removed
+	BlobStatusActive  BlobStatus = 1
+)
+
+// FileBlob stored object identified by the SHA-256 and size of its uploaded content.
+// Files uploaded with the same content share the blob and count as its references
+type FileBlob struct {
+	ID          int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	SHA256      string     `gorm:"column:sha256;not null"` // lowercase hex of the uploaded bytes
+	FileSize    int64      `gorm:"column:file_size;not null"`
+	BucketName  string     `gorm:"column:bucket_name;not null"`
+	StoragePath string     `gorm:"column:storage_path;not null"`
+	RefCount    int        `gorm:"column:ref_count;not null;default:0"`
+	Status      BlobStatus `gorm:"column:status;type:smallint;not null;default:1"`
+	CreatedAt   time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns table name
+func (FileBlob) TableName() string {
+	return "file_blobs"
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// FileObjectDeletion MinIO object waiting to be removed, recorded in the transaction that made it
+// unreferenced so a storage outage or a crash never leaves it behind
+type FileObjectDeletion struct {
+	ID            int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	BucketName    string    `gorm:"column:bucket_name;not null"`
+	ObjectName    string    `gorm:"column:object_name;not null"`
+	Attempts      int       `gorm:"column:attempts;not null;default:0"`
+	NextAttemptAt time.Time `gorm:"column:next_attempt_at;not null"` // also the lease of a claimed row
+	LastError     string    `gorm:"column:last_error"`
+	CreatedAt     time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns table name
+func (FileObjectDeletion) TableName() string {
+	return "file_object_deletions"
+}
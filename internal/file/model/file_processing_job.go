@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// ProcessingJobStatus media processing job status
+type ProcessingJobStatus int16
+
+const (
+	ProcessingJobPending   ProcessingJobStatus = 1
+	ProcessingJobRunning   ProcessingJobStatus = 2
+	ProcessingJobCompleted ProcessingJobStatus = 3
+	ProcessingJobFailed    ProcessingJobStatus = 4 // attempts exhausted or the content cannot be decoded
+)
+
+// FileProcessingJob thumbnail, metadata and EXIF stripping job of an uploaded file
+type FileProcessingJob struct {
+	ID          int64               `gorm:"column:id;primaryKey;autoIncrement"`
+	FileID      string              `gorm:"column:file_id;not null;uniqueIndex"`
+	Status      ProcessingJobStatus `gorm:"column:status;type:smallint;not null;default:1"`
+	Attempts    int                 `gorm:"column:attempts;not null;default:0"`
+	NextRunAt   time.Time           `gorm:"column:next_run_at;not null"`
+	LockedUntil *time.Time          `gorm:"column:locked_until"` // lease of a running job
+	LastError   string              `gorm:"column:last_error"`
+	CreatedAt   time.Time           `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time           `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP"`
+	CompletedAt *time.Time          `gorm:"column:completed_at"`
+}
+
+// TableName returns table name
+func (FileProcessingJob) TableName() string {
+	return "file_processing_jobs"
+}
@@ -0,0 +1,68 @@
+package model
+
+import "time"
+
+// FileScanStatus malware scan status
+type FileScanStatus int16
+
+const (
+	FileScanPending     FileScanStatus = 1
+	FileScanRunning     FileScanStatus = 2
+	FileScanClean       FileScanStatus = 3
+	FileScanQuarantined FileScanStatus = 4 // infected, or the scanner kept failing; waits for review
+	FileScanReleased    FileScanStatus = 5 // quarantined file an administrator let through
+	FileScanRejected    FileScanStatus = 6 // quarantined file an administrator deleted
+	FileScanSkipped     FileScanStatus = 7 // larger than the scan limit, or deleted before it was scanned
+)
+
+// scan verdicts recorded in file_scan_verdicts
+const (
+	ScanVerdictClean    = "clean"
+	ScanVerdictInfected = "infected"
+	ScanVerdictError    = "error" // attempts used up without a result, quarantined for review
+	ScanVerdictSkipped  = "skipped"
+	ScanVerdictReleased = "released"
+	ScanVerdictDeleted  = "deleted"
+)
+
+// FileScan malware scan of a completed upload, the file stays pending until it is clean
+type FileScan struct {
+	ID           int64          `gorm:"column:id;primaryKey;autoIncrement"`
+	FileID       string         `gorm:"column:file_id;not null;uniqueIndex"`
+	UserID       string         `gorm:"column:user_id;not null"`
+	Status       FileScanStatus `gorm:"column:status;type:smallint;not null;default:1"`
+	Attempts     int            `gorm:"column:attempts;not null;default:0"`
+	NextRunAt    time.Time      `gorm:"column:next_run_at;not null"`
+	LockedUntil  *time.Time     `gorm:"column:locked_until"` // lease of a running scan
+	LastError    string         `gorm:"column:last_error"`
+	Scanner      string         `gorm:"column:scanner"`
+	Signature    string         `gorm:"column:signature"`     // detected malware, empty unless infected
+	SourceBucket string         `gorm:"column:source_bucket"` // bucket a released file is moved back to
+	ScannedAt    *time.Time     `gorm:"column:scanned_at"`
+	ReviewedBy   string         `gorm:"column:reviewed_by"`
+	ReviewedAt   *time.Time     `gorm:"column:reviewed_at"`
+	CreatedAt    time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt    time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns table name
+func (FileScan) TableName() string {
+	return "file_scans"
+}
+
+// FileScanVerdict one scan result or review decision, never updated
+type FileScanVerdict struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	FileID    string    `gorm:"column:file_id;not null"`
+	Verdict   string    `gorm:"column:verdict;not null"`
+	Scanner   string    `gorm:"column:scanner"`
+	Signature string    `gorm:"column:signature"`
+	Detail    string    `gorm:"column:detail"`   // scanner error or review note
+	AdminID   string    `gorm:"column:admin_id"` // empty for scanner verdicts
+	CreatedAt time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns table name
+func (FileScanVerdict) TableName() string {
+	return "file_scan_verdicts"
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// StorageOwnerType owner of a storage quota
+type StorageOwnerType int16
+
+const (
+	StorageOwnerUser  StorageOwnerType = 1
+	StorageOwnerGroup StorageOwnerType = 2
+)
+
+// StorageUsage bytes of active files counted against a user or group, and its quota settings
+type StorageUsage struct {
+	OwnerType  StorageOwnerType `gorm:"column:owner_type;type:smallint;primaryKey"`
+	OwnerID    string           `gorm:"column:owner_id;primaryKey"`
+	UsedBytes  int64            `gorm:"column:used_bytes;not null;default:0"`
+	FileCount  int              `gorm:"column:file_count;not null;default:0"`
+	Tier       string           `gorm:"column:tier;not null;default:''"` // users only, empty for the default tier
+	QuotaBytes *int64           `gorm:"column:quota_bytes"`              // administrator override, 0 means unlimited
+	UpdatedAt  time.Time        `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns table name
+func (StorageUsage) TableName() string {
+	return "storage_usage"
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FileBlobRepository content-addressed blob repository interface
+type FileBlobRepository interface {
+	// GetActive gets the active blob with the hash and size
+	GetActive(ctx context.Context, sha256 string, fileSize int64) (*model.FileBlob, error)
+
+	// Create creates an active blob, returns false if an active blob with the same hash and size already exists
+	Create(ctx context.Context, blob *model.FileBlob) (bool, error)
+
+	// AddRef adds a reference to an active blob, returns false if the blob was released meanwhile
+	AddRef(ctx context.Context, id int64) (bool, error)
+
+	// Release drops a reference and returns the remaining count, the blob is marked deleted when it reaches 0
+	Release(ctx context.Context, id int64) (int, error)
+
+	// WithTx uses transaction
+	WithTx(tx *gorm.DB) FileBlobRepository
+}
+
+// fileBlobRepositoryImpl content-addressed blob repository implementation
+type fileBlobRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewFileBlobRepository creates content-addressed blob repository
+func NewFileBlobRepository(db *gorm.DB) FileBlobRepository {
+	return &fileBlobRepositoryImpl{db: db}
+}
+
+// GetActive gets the active blob with the hash and size
+func (r *fileBlobRepositoryImpl) GetActive(ctx context.Context, sha256 string, fileSize int64) (*model.FileBlob, error) {
+	var blob model.FileBlob
+	err := r.db.WithContext(ctx).
+		Where("sha256 = ? AND file_size = ? AND status = ?", sha256, fileSize, model.BlobStatusActive).
+		First(&blob).Error
+	if err != nil {
+		return nil, err
+	}
+	return &blob, nil
+}
+
+// Create creates an active blob
+func (r *fileBlobRepositoryImpl) Create(ctx context.Context, blob *model.FileBlob) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "sha256"}, {Name: "file_size"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Eq{Column: "status", Value: model.BlobStatusActive}}},
+			DoNothing:   true,
+		}).
+		Create(blob)
+	return result.RowsAffected > 0, result.Error
+}
+
+// AddRef adds a reference to an active blob
+func (r *fileBlobRepositoryImpl) AddRef(ctx context.Context, id int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.FileBlob{}).
+		Where("id = ? AND status = ? AND ref_count > 0", id, model.BlobStatusActive).
+		Updates(map[string]interface{}{
+			"ref_count":  gorm.Expr("ref_count + 1"),
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Release drops a reference
+func (r *fileBlobRepositoryImpl) Release(ctx context.Context, id int64) (int, error) {
+	var remaining []int
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE file_blobs
+		SET ref_count = ref_count - 1,
+			status = CASE WHEN ref_count <= 1 THEN ? ELSE status END,
+			updated_at = ?
+		WHERE id = ? AND ref_count > 0
+		RETURNING ref_count`,
+		model.BlobStatusDeleted, time.Now(), id,
+	).Scan(&remaining).Error
+	if err != nil || len(remaining) == 0 {
+		return 0, err
+	}
+	return remaining[0], nil
+}
+
+// WithTx uses transaction
+func (r *fileBlobRepositoryImpl) WithTx(tx *gorm.DB) FileBlobRepository {
+	return &fileBlobRepositoryImpl{db: tx}
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// recipientInsertBatch recipients inserted per statement
+const recipientInsertBatch = 500
+
+// FileGrantRepository file access grant repository interface
+type FileGrantRepository interface {
+	// Create creates a grant, returns false if the file is already linked to the message
+	Create(ctx context.Context, grant *model.FileAccessGrant) (bool, error)
+
+	// AddRecipients records the group members of a grant
+	AddRecipients(ctx context.Context, grantID int64, userIDs []string) error
+
+	// HasDirectGrant checks whether an active grant names the user: sender, single chat peer or group recipient
+	HasDirectGrant(ctx context.Context, fileID, userID string) (bool, error)
+
+	// ListActiveGroupGrants lists active group chat grants of a file
+	ListActiveGroupGrants(ctx context.Context, fileID string) ([]*model.FileAccessGrant, error)
+
+	// RevokeByMessageIDs revokes the grants of messages
+	RevokeByMessageIDs(ctx context.Context, messageIDs []string, reason string) (int64, error)
+
+	// DeleteByUserID removes grants sent by the user and the user's recipient records
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
+
+	// DeleteByFileIDs removes grants of files
+	DeleteByFileIDs(ctx context.Context, fileIDs []string) error
+
+	// WithTx uses transaction
+	WithTx(tx *gorm.DB) FileGrantRepository
+}
+
+// fileGrantRepositoryImpl file access grant repository implementation
+type fileGrantRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewFileGrantRepository creates file access grant repository
+func NewFileGrantRepository(db *gorm.DB) FileGrantRepository {
+	return &fileGrantRepositoryImpl{db: db}
+}
+
+// Create creates a grant, returns false if the file is already linked to the message
+func (r *fileGrantRepositoryImpl) Create(ctx context.Context, grant *model.FileAccessGrant) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "file_id"}, {Name: "message_id"}},
+			DoNothing: true,
+		}).
+		Create(grant)
+	return result.RowsAffected > 0, result.Error
+}
+
+// AddRecipients records the group members of a grant
+func (r *fileGrantRepositoryImpl) AddRecipients(ctx context.Context, grantID int64, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	recipients := make([]*model.FileAccessGrantRecipient, 0, len(userIDs))
+	for _, userID := range userIDs {
+		recipients = append(recipients, &model.FileAccessGrantRecipient{GrantID: grantID, UserID: userID})
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(recipients, recipientInsertBatch).Error
+}
+
+// HasDirectGrant checks whether an active grant names the user: sender, single chat peer or group recipient
+func (r *fileGrantRepositoryImpl) HasDirectGrant(ctx context.Context, fileID, userID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.FileAccessGrant{}).
+		Where("file_id = ? AND revoked_at IS NULL", fileID).
+		Where(r.db.
+			Where("sender_id = ?", userID).
+			Or("conversation_type = ? AND target_id = ?", model.GrantConversationSingle, userID).
+			Or("EXISTS (SELECT 1 FROM file_access_grant_recipients r WHERE r.grant_id = file_access_grants.id AND r.user_id = ?)", userID)).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ListActiveGroupGrants lists active group chat grants of a file
+func (r *fileGrantRepositoryImpl) ListActiveGroupGrants(ctx context.Context, fileID string) ([]*model.FileAccessGrant, error) {
+	var grants []*model.FileAccessGrant
+	err := r.db.WithContext(ctx).
+		Where("file_id = ? AND conversation_type = ? AND revoked_at IS NULL", fileID, model.GrantConversationGroup).
+		Order("created_at ASC").
+		Find(&grants).Error
+	return grants, err
+}
+
+// RevokeByMessageIDs revokes the grants of messages
+func (r *fileGrantRepositoryImpl) RevokeByMessageIDs(ctx context.Context, messageIDs []string, reason string) (int64, error) {
+	if len(messageIDs) == 0 {
+		return 0, nil
+	}
+	result := r.db.WithContext(ctx).
+		Model(&model.FileAccessGrant{}).
+		Where("message_id IN ? AND revoked_at IS NULL", messageIDs).
+		Updates(map[string]interface{}{
+			"revoked_at":    time.Now(),
+			"revoke_reason": reason,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// DeleteByUserID removes grants sent by the user and the user's recipient records
+func (r *fileGrantRepositoryImpl) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.FileAccessGrantRecipient{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	deleted := result.RowsAffected
+
+	// recipient records of these grants are removed by the foreign key cascade
+	result = r.db.WithContext(ctx).
+		Where("sender_id = ?", userID).
+		Delete(&model.FileAccessGrant{})
+	return deleted + result.RowsAffected, result.Error
+}
+
+// DeleteByFileIDs removes grants of files
+func (r *fileGrantRepositoryImpl) DeleteByFileIDs(ctx context.Context, fileIDs []string) error {
+	if len(fileIDs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Where("file_id IN ?", fileIDs).
+		Delete(&model.FileAccessGrant{}).Error
+}
+
+// WithTx uses transaction
+func (r *fileGrantRepositoryImpl) WithTx(tx *gorm.DB) FileGrantRepository {
+	return &fileGrantRepositoryImpl{db: tx}
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/model"
+	"gorm.io/gorm"
+)
+
+// FileObjectDeletionRepository object deletion outbox repository interface
+type FileObjectDeletionRepository interface {
+	// Create records objects to remove
+	Create(ctx context.Context, deletions []*model.FileObjectDeletion) error
+
+	// ClaimDue moves due deletions to now+lease and returns them. Concurrent workers never claim the same row
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.FileObjectDeletion, error)
+
+	// Delete removes a deletion whose object is gone
+	Delete(ctx context.Context, id int64) error
+
+	// MarkRetry records a failed attempt and schedules the next one
+	MarkRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+
+	// WithTx uses transaction
+	WithTx(tx *gorm.DB) FileObjectDeletionRepository
+}
+
+// fileObjectDeletionRepositoryImpl object deletion outbox repository implementation
+type fileObjectDeletionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewFileObjectDeletionRepository creates object deletion outbox repository
+func NewFileObjectDeletionRepository(db *gorm.DB) FileObjectDeletionRepository {
+	return &fileObjectDeletionRepositoryImpl{db: db}
+}
+
+// Create records objects to remove
+func (r *fileObjectDeletionRepositoryImpl) Create(ctx context.Context, deletions []*model.FileObjectDeletion) error {
+	if len(deletions) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&deletions).Error
+}
+
+// ClaimDue claims due deletions, rows locked by another worker are skipped
+func (r *fileObjectDeletionRepositoryImpl) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.FileObjectDeletion, error) {
+	var deletions []*model.FileObjectDeletion
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE file_object_deletions
+		SET attempts = attempts + 1, next_attempt_at = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM file_object_deletions
+			WHERE next_attempt_at <= ?
+			ORDER BY next_attempt_at ASC, id ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, now, limit,
+	).Scan(&deletions).Error
+	return deletions, err
+}
+
+// Delete removes a deletion whose object is gone
+func (r *fileObjectDeletionRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&model.FileObjectDeletion{}).Error
+}
+
+// MarkRetry records a failed attempt and schedules the next one
+func (r *fileObjectDeletionRepositoryImpl) MarkRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.FileObjectDeletion{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      lastError,
+			"updated_at":      time.Now(),
+		}).Error
+}
+
+// WithTx uses transaction
+func (r *fileObjectDeletionRepositoryImpl) WithTx(tx *gorm.DB) FileObjectDeletionRepository {
+	return &fileObjectDeletionRepositoryImpl{db: tx}
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FileProcessingJobRepository media processing job repository interface
+type FileProcessingJobRepository interface {
+	// Enqueue adds a pending job for the file, a file has at most one job
+	Enqueue(ctx context.Context, fileID string) error
+
+	// ClaimDue marks due pending jobs and running jobs with an expired lease as running until now+lease
+	// and returns them. Concurrent workers never claim the same job
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.FileProcessingJob, error)
+
+	// MarkCompleted marks a job completed
+	MarkCompleted(ctx context.Context, id int64) error
+
+	// MarkRetry puts a job back to pending until nextRunAt
+	MarkRetry(ctx context.Context, id int64, nextRunAt time.Time, lastError string) error
+
+	// MarkFailed marks a job failed, it is not retried
+	MarkFailed(ctx context.Context, id int64, lastError string) error
+
+	// DeleteByFileIDs removes the jobs of the files
+	DeleteByFileIDs(ctx context.Context, fileIDs []string) error
+
+	// WithTx uses transaction
+	WithTx(tx *gorm.DB) FileProcessingJobRepository
+}
+
+// fileProcessingJobRepositoryImpl media processing job repository implementation
+type fileProcessingJobRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewFileProcessingJobRepository creates media processing job repository
+func NewFileProcessingJobRepository(db *gorm.DB) FileProcessingJobRepository {
+	return &fileProcessingJobRepositoryImpl{db: db}
+}
+
+// Enqueue adds a pending job for the file
+func (r *fileProcessingJobRepositoryImpl) Enqueue(ctx context.Context, fileID string) error {
+	now := time.Now()
+	job := &model.FileProcessingJob{
+		FileID:    fileID,
+		Status:    model.ProcessingJobPending,
+		NextRunAt: now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "file_id"}}, DoNothing: true}).
+		Create(job).Error
+}
+
+// ClaimDue claims due jobs, rows locked by another worker are skipped
+func (r *fileProcessingJobRepositoryImpl) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.FileProcessingJob, error) {
+	var jobs []*model.FileProcessingJob
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE file_processing_jobs
+		SET status = ?, attempts = attempts + 1, locked_until = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM file_processing_jobs
+			WHERE (status = ? AND next_run_at <= ?) OR (status = ? AND locked_until < ?)
+			ORDER BY next_run_at ASC, id ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		model.ProcessingJobRunning, now.Add(lease), now,
+		model.ProcessingJobPending, now, model.ProcessingJobRunning, now,
+		limit,
+	).Scan(&jobs).Error
+	return jobs, err
+}
+
+// MarkCompleted marks a job completed
+func (r *fileProcessingJobRepositoryImpl) MarkCompleted(ctx context.Context, id int64) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).
+		Model(&model.FileProcessingJob{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       model.ProcessingJobCompleted,
+			"locked_until": nil,
+			"last_error":   "",
+			"completed_at": now,
+			"updated_at":   now,
+		}).Error
+}
+
+// MarkRetry puts a job back to pending
+func (r *fileProcessingJobRepositoryImpl) MarkRetry(ctx context.Context, id int64, nextRunAt time.Time, lastError string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.FileProcessingJob{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       model.ProcessingJobPending,
+			"next_run_at":  nextRunAt,
+			"locked_until": nil,
+			"last_error":   lastError,
+			"updated_at":   time.Now(),
+		}).Error
+}
+
+// MarkFailed marks a job failed
+func (r *fileProcessingJobRepositoryImpl) MarkFailed(ctx context.Context, id int64, lastError string) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).
+		Model(&model.FileProcessingJob{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       model.ProcessingJobFailed,
+			"locked_until": nil,
+			"last_error":   lastError,
+			"completed_at": now,
+			"updated_at":   now,
+		}).Error
+}
+
+// DeleteByFileIDs removes the jobs of the files
+func (r *fileProcessingJobRepositoryImpl) DeleteByFileIDs(ctx context.Context, fileIDs []string) error {
+	if len(fileIDs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Where("file_id IN ?", fileIDs).
+		Delete(&model.FileProcessingJob{}).Error
+}
+
+// WithTx uses transaction
+func (r *fileProcessingJobRepositoryImpl) WithTx(tx *gorm.DB) FileProcessingJobRepository {
+	return &fileProcessingJobRepositoryImpl{db: tx}
+}
@@ -31,11 +31,42 @@ type FileRepository interface {
 	// UpdateStatus updates file status
 	UpdateStatus(ctx context.Context, fileID string, status model.FileStatus) error
 
-	// Delete soft deletes file
-	Delete(ctx context.Context, fileID string) error
+	// CompareAndSetStatus changes the status only while it is still from, returns false otherwise
+	CompareAndSetStatus(ctx context.Context, fileID string, from, to model.FileStatus) (bool, error)
 
-	// DeleteExpired cleans up expired files
-	DeleteExpired(ctx context.Context) error
+	// UpdateMimeType updates the MIME type detected from the content
+	UpdateMimeType(ctx context.Context, fileID, mimeType string) error
+
+	// UpdateProcessingResult stores the media processing result of an active file, returns false if the file is no longer active
+	UpdateProcessingResult(ctx context.Context, fileID string, fileSize int64, thumbnailPath string, metadata model.FileMetadata) (bool, error)
+
+	// GetProcessedByBlobID gets an active file of the blob whose media processing already filled in metadata
+	GetProcessedByBlobID(ctx context.Context, blobID int64) (*model.File, error)
+
+	// Delete soft deletes file, returns false if it was already deleted
+	Delete(ctx context.Context, fileID string) (bool, error)
+
+	// ListExpired lists active files whose expiry has passed, earliest first
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*model.File, error)
+
+	// ListExpiring lists active files expiring before the given time whose uploader has not been warned yet
+	ListExpiring(ctx context.Context, now, before time.Time, limit int) ([]*model.File, error)
+
+	// MarkExpiryWarned records that the uploaders of the files were warned
+	MarkExpiryWarned(ctx context.Context, fileIDs []string, warnedAt time.Time) error
+
+	// ListAbandoned lists pending files created before the given time that no open multipart upload or
+	// unfinished malware scan belongs to
+	ListAbandoned(ctx context.Context, createdBefore time.Time, limit int) ([]*model.File, error)
+
+	// ListAllByUserID lists files of the user in any status, oldest first
+	ListAllByUserID(ctx context.Context, userID string, limit int) ([]*model.File, error)
+
+	// HardDelete removes file records
+	HardDelete(ctx context.Context, fileIDs []string) error
+
+	// DeleteUploadsByUserID removes chunked upload records of the user
+	DeleteUploadsByUserID(ctx context.Context, userID string) (int64, error)
 
 	// WithTx uses transaction
 	WithTx(tx *gorm.DB) FileRepository
@@ -137,21 +168,133 @@ func (r *fileRepositoryImpl) UpdateStatus(ctx context.Context, fileID string, st
 		Update("status", status).Error
 }
 
-// Delete soft deletes file
-func (r *fileRepositoryImpl) Delete(ctx context.Context, fileID string) error {
+// CompareAndSetStatus changes the status only while it is still from
+func (r *fileRepositoryImpl) CompareAndSetStatus(ctx context.Context, fileID string, from, to model.FileStatus) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.File{}).
+		Where("file_id = ? AND status = ?", fileID, from).
+		Update("status", to)
+	return result.RowsAffected > 0, result.Error
+}
+
+// UpdateMimeType updates the MIME type detected from the content
+func (r *fileRepositoryImpl) UpdateMimeType(ctx context.Context, fileID, mimeType string) error {
 	return r.db.WithContext(ctx).
 		Model(&model.File{}).
 		Where("file_id = ?", fileID).
-		Update("status", model.FileStatusDeleted).Error
+		Update("mime_type", mimeType).Error
+}
+
+// UpdateProcessingResult stores the media processing result of an active file
+func (r *fileRepositoryImpl) UpdateProcessingResult(ctx context.Context, fileID string, fileSize int64, thumbnailPath string, metadata model.FileMetadata) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.File{}).
+		Where("file_id = ? AND status = ?", fileID, model.FileStatusActive).
+		Updates(map[string]interface{}{
+			"file_size":      fileSize,
+			"thumbnail_path": thumbnailPath,
+			"metadata":       metadata,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetProcessedByBlobID gets a processed active file of the blob
+func (r *fileRepositoryImpl) GetProcessedByBlobID(ctx context.Context, blobID int64) (*model.File, error) {
+	var file model.File
+	err := r.db.WithContext(ctx).
+		Where("blob_id = ? AND status = ? AND metadata IS NOT NULL", blobID, model.FileStatusActive).
+		First(&file).Error
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// Delete soft deletes file
+func (r *fileRepositoryImpl) Delete(ctx context.Context, fileID string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.File{}).
+		Where("file_id = ? AND status != ?", fileID, model.FileStatusDeleted).
+		Update("status", model.FileStatusDeleted)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListExpired lists active files whose expiry has passed
+func (r *fileRepositoryImpl) ListExpired(ctx context.Context, now time.Time, limit int) ([]*model.File, error) {
+	var files []*model.File
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at IS NOT NULL AND expires_at <= ?", model.FileStatusActive, now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&files).Error
+	return files, err
 }
 
-// DeleteExpired cleans up expired files
-func (r *fileRepositoryImpl) DeleteExpired(ctx context.Context) error {
-	now := time.Now()
+// ListExpiring lists active files expiring before the given time whose uploader has not been warned yet
+func (r *fileRepositoryImpl) ListExpiring(ctx context.Context, now, before time.Time, limit int) ([]*model.File, error) {
+	var files []*model.File
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at > ? AND expires_at <= ? AND expiry_warned_at IS NULL", model.FileStatusActive, now, before).
+		Where("file_type != ?", model.FileTypeLog).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&files).Error
+	return files, err
+}
+
+// MarkExpiryWarned records that the uploaders of the files were warned
+func (r *fileRepositoryImpl) MarkExpiryWarned(ctx context.Context, fileIDs []string, warnedAt time.Time) error {
+	if len(fileIDs) == 0 {
+		return nil
+	}
 	return r.db.WithContext(ctx).
 		Model(&model.File{}).
-		Where("expires_at IS NOT NULL AND expires_at < ? AND status != ?", now, model.FileStatusDeleted).
-		Update("status", model.FileStatusDeleted).Error
+		Where("file_id IN ?", fileIDs).
+		Update("expiry_warned_at", warnedAt).Error
+}
+
+// ListAbandoned lists pending files created before the given time that are neither uploading nor being scanned
+func (r *fileRepositoryImpl) ListAbandoned(ctx context.Context, createdBefore time.Time, limit int) ([]*model.File, error) {
+	var files []*model.File
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND created_at < ?", model.FileStatusProcessing, createdBefore).
+		Where("NOT EXISTS (SELECT 1 FROM file_uploads u WHERE u.file_id = files.file_id AND u.status IN ?)",
+			[]model.UploadStatus{model.UploadStatusPending, model.UploadStatusUploading}).
+		Where("NOT EXISTS (SELECT 1 FROM file_scans s WHERE s.file_id = files.file_id AND s.status IN ?)",
+			[]model.FileScanStatus{model.FileScanPending, model.FileScanRunning}).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&files).Error
+	return files, err
+}
+
+// ListAllByUserID lists files of the user in any status, oldest first
+func (r *fileRepositoryImpl) ListAllByUserID(ctx context.Context, userID string, limit int) ([]*model.File, error) {
+	var files []*model.File
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&files).Error
+	return files, err
+}
+
+// HardDelete removes file records
+func (r *fileRepositoryImpl) HardDelete(ctx context.Context, fileIDs []string) error {
+	if len(fileIDs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Where("file_id IN ?", fileIDs).
+		Delete(&model.File{}).Error
+}
+
+// DeleteUploadsByUserID removes chunked upload records of the user
+func (r *fileRepositoryImpl) DeleteUploadsByUserID(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.FileUpload{})
+	return result.RowsAffected, result.Error
 }
 
 // WithTx uses transaction
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FileScanRepository malware scan repository interface
+type FileScanRepository interface {
+	// Create adds a pending scan, returns false when the file already has one
+	Create(ctx context.Context, scan *model.FileScan) (bool, error)
+
+	// GetByFileID gets the scan of a file
+	GetByFileID(ctx context.Context, fileID string) (*model.FileScan, error)
+
+	// ClaimDue marks due pending scans and running scans with an expired lease as running until now+lease
+	// and returns them. Concurrent workers never claim the same scan
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.FileScan, error)
+
+	// MarkRetry puts a scan back to pending until nextRunAt
+	MarkRetry(ctx context.Context, id int64, nextRunAt time.Time, lastError string) error
+
+	// Finish records the outcome of a scan
+	Finish(ctx context.Context, id int64, status model.FileScanStatus, scanner, signature, lastError string) error
+
+	// Review moves a scan from one status to another on behalf of an administrator,
+	// returns false when it is no longer in the expected status
+	Review(ctx context.Context, fileID string, from, to model.FileScanStatus, adminID string) (bool, error)
+
+	// ListByStatus lists scans in the status, newest result first, optionally of one uploader
+	ListByStatus(ctx context.Context, status model.FileScanStatus, userID string, page, pageSize int) ([]*model.FileScan, int64, error)
+
+	// AddVerdict appends a verdict to the audit trail
+	AddVerdict(ctx context.Context, verdict *model.FileScanVerdict) error
+
+	// ListVerdicts lists the verdicts of the files, oldest first
+	ListVerdicts(ctx context.Context, fileIDs []string) ([]*model.FileScanVerdict, error)
+
+	// DeleteByFileIDs removes the scans of the files, their verdicts are kept
+	DeleteByFileIDs(ctx context.Context, fileIDs []string) error
+
+	// WithTx uses transaction
+	WithTx(tx *gorm.DB) FileScanRepository
+}
+
+// fileScanRepositoryImpl malware scan repository implementation
+type fileScanRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewFileScanRepository creates malware scan repository
+func NewFileScanRepository(db *gorm.DB) FileScanRepository {
+	return &fileScanRepositoryImpl{db: db}
+}
+
+// Create adds a pending scan
+func (r *fileScanRepositoryImpl) Create(ctx context.Context, scan *model.FileScan) (bool, error) {
+	now := time.Now()
+	scan.Status = model.FileScanPending
+	scan.NextRunAt = now
+	scan.CreatedAt = now
+	scan.UpdatedAt = now
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "file_id"}}, DoNothing: true}).
+		Create(scan)
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetByFileID gets the scan of a file
+func (r *fileScanRepositoryImpl) GetByFileID(ctx context.Context, fileID string) (*model.FileScan, error) {
+	var scan model.FileScan
+	err := r.db.WithContext(ctx).Where("file_id = ?", fileID).First(&scan).Error
+	if err != nil {
+		return nil, err
+	}
+	return &scan, nil
+}
+
+// ClaimDue claims due scans, rows locked by another worker are skipped
+func (r *fileScanRepositoryImpl) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.FileScan, error) {
+	var scans []*model.FileScan
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE file_scans
+		SET status = ?, attempts = attempts + 1, locked_until = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM file_scans
+			WHERE (status = ? AND next_run_at <= ?) OR (status = ? AND locked_until < ?)
+			ORDER BY next_run_at ASC, id ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		model.FileScanRunning, now.Add(lease), now,
+		model.FileScanPending, now, model.FileScanRunning, now,
+		limit,
+	).Scan(&scans).Error
+	return scans, err
+}
+
+// MarkRetry puts a scan back to pending
+func (r *fileScanRepositoryImpl) MarkRetry(ctx context.Context, id int64, nextRunAt time.Time, lastError string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.FileScan{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       model.FileScanPending,
+			"next_run_at":  nextRunAt,
+			"locked_until": nil,
+			"last_error":   lastError,
+			"updated_at":   time.Now(),
+		}).Error
+}
+
+// Finish records the outcome of a scan
+func (r *fileScanRepositoryImpl) Finish(ctx context.Context, id int64, status model.FileScanStatus, scanner, signature, lastError string) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).
+		Model(&model.FileScan{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"locked_until": nil,
+			"scanner":      scanner,
+			"signature":    signature,
+			"last_error":   lastError,
+			"scanned_at":   now,
+			"updated_at":   now,
+		}).Error
+}
+
+// Review moves a scan between statuses for an administrator
+func (r *fileScanRepositoryImpl) Review(ctx context.Context, fileID string, from, to model.FileScanStatus, adminID string) (bool, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&model.FileScan{}).
+		Where("file_id = ? AND status = ?", fileID, from).
+		Updates(map[string]interface{}{
+			"status":      to,
+			"reviewed_by": adminID,
+			"reviewed_at": now,
+			"updated_at":  now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListByStatus lists scans in the status
+func (r *fileScanRepositoryImpl) ListByStatus(ctx context.Context, status model.FileScanStatus, userID string, page, pageSize int) ([]*model.FileScan, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.FileScan{}).Where("status = ?", status)
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var scans []*model.FileScan
+	err := query.Order("scanned_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&scans).Error
+	return scans, total, err
+}
+
+// AddVerdict appends a verdict
+func (r *fileScanRepositoryImpl) AddVerdict(ctx context.Context, verdict *model.FileScanVerdict) error {
+	if verdict.CreatedAt.IsZero() {
+		verdict.CreatedAt = time.Now()
+	}
+	return r.db.WithContext(ctx).Create(verdict).Error
+}
+
+// ListVerdicts lists the verdicts of the files
+func (r *fileScanRepositoryImpl) ListVerdicts(ctx context.Context, fileIDs []string) ([]*model.FileScanVerdict, error) {
+	if len(fileIDs) == 0 {
+		return nil, nil
+	}
+	var verdicts []*model.FileScanVerdict
+	err := r.db.WithContext(ctx).
+		Where("file_id IN ?", fileIDs).
+		Order("id ASC").
+		Find(&verdicts).Error
+	return verdicts, err
+}
+
+// DeleteByFileIDs removes the scans of the files
+func (r *fileScanRepositoryImpl) DeleteByFileIDs(ctx context.Context, fileIDs []string) error {
+	if len(fileIDs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Where("file_id IN ?", fileIDs).
+		Delete(&model.FileScan{}).Error
+}
+
+// WithTx uses transaction
+func (r *fileScanRepositoryImpl) WithTx(tx *gorm.DB) FileScanRepository {
+	return &fileScanRepositoryImpl{db: tx}
+}
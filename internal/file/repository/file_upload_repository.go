@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/model"
+	"gorm.io/gorm"
+)
+
+// FileUploadRepository multipart upload session repository interface
+type FileUploadRepository interface {
+	// Create creates an upload session
+	Create(ctx context.Context, upload *model.FileUpload) error
+
+	// GetByUploadIDAndUserID gets an upload session of the user
+	GetByUploadIDAndUserID(ctx context.Context, uploadID, userID string) (*model.FileUpload, error)
+
+	// UpdateProgress records uploaded part numbers and moves the session to the given status
+	UpdateProgress(ctx context.Context, uploadID string, status model.UploadStatus, chunks model.ChunkInfo) error
+
+	// MarkCompleted marks an open session as completed, returns false if it was no longer open
+	MarkCompleted(ctx context.Context, uploadID string, chunks model.ChunkInfo) (bool, error)
+
+	// MarkFailed marks an open session as failed (aborted or expired), returns false if it was no longer open
+	MarkFailed(ctx context.Context, uploadID string) (bool, error)
+
+	// ListExpired lists open sessions past their expiry, oldest first
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*model.FileUpload, error)
+
+	// ListOpenByUserID lists open sessions of the user
+	ListOpenByUserID(ctx context.Context, userID string) ([]*model.FileUpload, error)
+
+	// WithTx uses transaction
+	WithTx(tx *gorm.DB) FileUploadRepository
+}
+
+// fileUploadRepositoryImpl multipart upload session repository implementation
+type fileUploadRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewFileUploadRepository creates multipart upload session repository
+func NewFileUploadRepository(db *gorm.DB) FileUploadRepository {
+	return &fileUploadRepositoryImpl{db: db}
+}
+
+// Create creates an upload session
+func (r *fileUploadRepositoryImpl) Create(ctx context.Context, upload *model.FileUpload) error {
+	return r.db.WithContext(ctx).Create(upload).Error
+}
+
+// GetByUploadIDAndUserID gets an upload session of the user
+func (r *fileUploadRepositoryImpl) GetByUploadIDAndUserID(ctx context.Context, uploadID, userID string) (*model.FileUpload, error) {
+	var upload model.FileUpload
+	err := r.db.WithContext(ctx).
+		Where("upload_id = ? AND user_id = ?", uploadID, userID).
+		First(&upload).Error
+	if err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
+
+// UpdateProgress records uploaded part numbers and moves the session to the given status
+func (r *fileUploadRepositoryImpl) UpdateProgress(ctx context.Context, uploadID string, status model.UploadStatus, chunks model.ChunkInfo) error {
+	return r.db.WithContext(ctx).
+		Model(&model.FileUpload{}).
+		Where("upload_id = ? AND status IN ?", uploadID, openUploadStatuses()).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"uploaded_chunks": chunks,
+			"updated_at":      time.Now(),
+		}).Error
+}
+
+// MarkCompleted marks an open session as completed, returns false if it was no longer open
+func (r *fileUploadRepositoryImpl) MarkCompleted(ctx context.Context, uploadID string, chunks model.ChunkInfo) (bool, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&model.FileUpload{}).
+		Where("upload_id = ? AND status IN ?", uploadID, openUploadStatuses()).
+		Updates(map[string]interface{}{
+			"status":          model.UploadStatusCompleted,
+			"uploaded_chunks": chunks,
+			"completed_at":    now,
+			"updated_at":      now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// MarkFailed marks an open session as failed (aborted or expired), returns false if it was no longer open
+func (r *fileUploadRepositoryImpl) MarkFailed(ctx context.Context, uploadID string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.FileUpload{}).
+		Where("upload_id = ? AND status IN ?", uploadID, openUploadStatuses()).
+		Updates(map[string]interface{}{
+			"status":     model.UploadStatusFailed,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListExpired lists open sessions past their expiry, oldest first
+func (r *fileUploadRepositoryImpl) ListExpired(ctx context.Context, now time.Time, limit int) ([]*model.FileUpload, error) {
+	var uploads []*model.FileUpload
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND expires_at < ?", openUploadStatuses(), now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&uploads).Error
+	return uploads, err
+}
+
+// ListOpenByUserID lists open sessions of the user
+func (r *fileUploadRepositoryImpl) ListOpenByUserID(ctx context.Context, userID string) ([]*model.FileUpload, error) {
+	var uploads []*model.FileUpload
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status IN ?", userID, openUploadStatuses()).
+		Order("id ASC").
+		Find(&uploads).Error
+	return uploads, err
+}
+
+// WithTx uses transaction
+func (r *fileUploadRepositoryImpl) WithTx(tx *gorm.DB) FileUploadRepository {
+	return &fileUploadRepositoryImpl{db: tx}
+}
+
+// openUploadStatuses statuses in which parts can still be uploaded
+func openUploadStatuses() []model.UploadStatus {
+	return []model.UploadStatus{model.UploadStatusPending, model.UploadStatusUploading}
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StorageUsageRepository storage usage ledger repository interface
+type StorageUsageRepository interface {
+	// Get gets the usage of an owner, an owner without a row has used nothing and has no settings
+	Get(ctx context.Context, ownerType model.StorageOwnerType, ownerID string) (*model.StorageUsage, error)
+
+	// Charge adds a file of the given size, returns false without changes when the usage would exceed
+	// quotaBytes. quotaBytes <= 0 means unlimited
+	Charge(ctx context.Context, ownerType model.StorageOwnerType, ownerID string, bytes, quotaBytes int64) (bool, error)
+
+	// Release removes a file of the given size
+	Release(ctx context.Context, ownerType model.StorageOwnerType, ownerID string, bytes int64) error
+
+	// Adjust changes the usage by delta bytes without changing the file count
+	Adjust(ctx context.Context, ownerType model.StorageOwnerType, ownerID string, delta int64) error
+
+	// SetQuota sets the tier and the quota override of an owner
+	SetQuota(ctx context.Context, ownerType model.StorageOwnerType, ownerID, tier string, quotaBytes *int64) error
+
+	// Delete removes the usage row of an owner
+	Delete(ctx context.Context, ownerType model.StorageOwnerType, ownerID string) error
+
+	// WithTx uses transaction
+	WithTx(tx *gorm.DB) StorageUsageRepository
+}
+
+// storageUsageRepositoryImpl storage usage ledger repository implementation
+type storageUsageRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewStorageUsageRepository creates storage usage ledger repository
+func NewStorageUsageRepository(db *gorm.DB) StorageUsageRepository {
+	return &storageUsageRepositoryImpl{db: db}
+}
+
+// Get gets the usage of an owner
+func (r *storageUsageRepositoryImpl) Get(ctx context.Context, ownerType model.StorageOwnerType, ownerID string) (*model.StorageUsage, error) {
+	var usage model.StorageUsage
+	err := r.db.WithContext(ctx).
+		Where("owner_type = ? AND owner_id = ?", ownerType, ownerID).
+		First(&usage).Error
+	if err == gorm.ErrRecordNotFound {
+		return &model.StorageUsage{OwnerType: ownerType, OwnerID: ownerID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// Charge adds a file of the given size when it fits the quota
+func (r *storageUsageRepositoryImpl) Charge(ctx context.Context, ownerType model.StorageOwnerType, ownerID string, bytes, quotaBytes int64) (bool, error) {
+	if quotaBytes > 0 && bytes > quotaBytes {
+		return false, nil
+	}
+	result := r.db.WithContext(ctx).Exec(`
+		INSERT INTO storage_usage (owner_type, owner_id, used_bytes, file_count, updated_at)
+		VALUES (?, ?, ?, 1, ?)
+		ON CONFLICT (owner_type, owner_id) DO UPDATE
+		SET used_bytes = storage_usage.used_bytes + EXCLUDED.used_bytes,
+			file_count = storage_usage.file_count + 1,
+			updated_at = EXCLUDED.updated_at
+		WHERE ? <= 0 OR storage_usage.used_bytes + EXCLUDED.used_bytes <= ?`,
+		ownerType, ownerID, bytes, time.Now(), quotaBytes, quotaBytes,
+	)
+	return result.RowsAffected > 0, result.Error
+}
+
+// Release removes a file of the given size
+func (r *storageUsageRepositoryImpl) Release(ctx context.Context, ownerType model.StorageOwnerType, ownerID string, bytes int64) error {
+	return r.db.WithContext(ctx).
+		Model(&model.StorageUsage{}).
+		Where("owner_type = ? AND owner_id = ?", ownerType, ownerID).
+		Updates(map[string]interface{}{
+			"used_bytes": gorm.Expr("GREATEST(used_bytes - ?, 0)", bytes),
+			"file_count": gorm.Expr("GREATEST(file_count - 1, 0)"),
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// Adjust changes the usage by delta bytes
+func (r *storageUsageRepositoryImpl) Adjust(ctx context.Context, ownerType model.StorageOwnerType, ownerID string, delta int64) error {
+	return r.db.WithContext(ctx).
+		Model(&model.StorageUsage{}).
+		Where("owner_type = ? AND owner_id = ?", ownerType, ownerID).
+		Updates(map[string]interface{}{
+			"used_bytes": gorm.Expr("GREATEST(used_bytes + ?, 0)", delta),
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// SetQuota sets the tier and the quota override of an owner
+func (r *storageUsageRepositoryImpl) SetQuota(ctx context.Context, ownerType model.StorageOwnerType, ownerID, tier string, quotaBytes *int64) error {
+	usage := &model.StorageUsage{
+		OwnerType:  ownerType,
+		OwnerID:    ownerID,
+		Tier:       tier,
+		QuotaBytes: quotaBytes,
+		UpdatedAt:  time.Now(),
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "owner_type"}, {Name: "owner_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"tier", "quota_bytes", "updated_at"}),
+		}).
+		Create(usage).Error
+}
+
+// Delete removes the usage row of an owner
+func (r *storageUsageRepositoryImpl) Delete(ctx context.Context, ownerType model.StorageOwnerType, ownerID string) error {
+	return r.db.WithContext(ctx).
+		Where("owner_type = ? AND owner_id = ?", ownerType, ownerID).
+		Delete(&model.StorageUsage{}).Error
+}
+
+// WithTx uses transaction
+func (r *storageUsageRepositoryImpl) WithTx(tx *gorm.DB) StorageUsageRepository {
+	return &storageUsageRepositoryImpl{db: tx}
+}
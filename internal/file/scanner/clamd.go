@@ -0,0 +1,155 @@
+package scanner
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// ClamdConfig clamd connection settings
+type ClamdConfig struct {
+	Address   string        // host:port, or the path of a unix socket
+	Timeout   time.Duration // limit of one scan including the upload of the stream
+	ChunkSize int           // bytes per INSTREAM chunk
+}
+
+// ClamdScanner streams objects to a ClamAV daemon with the INSTREAM command.
+// The daemon's StreamMaxLength must not be smaller than the largest file that is scanned
+type ClamdScanner struct {
+	network string
+	address string
+	timeout time.Duration
+	chunk   int
+}
+
+// NewClamdScanner creates clamd scanner
+func NewClamdScanner(cfg ClamdConfig) (*ClamdScanner, error) {
+	address := strings.TrimSpace(cfg.Address)
+	if address == "" {
+		return nil, fmt.Errorf("clamd address is required")
+	}
+	network := "tcp"
+	if strings.HasPrefix(address, "/") {
+		network = "unix"
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
+	chunk := cfg.ChunkSize
+	if chunk <= 0 {
+		chunk = 64 * 1024
+	}
+	return &ClamdScanner{network: network, address: address, timeout: timeout, chunk: chunk}, nil
+}
+
+// Name returns scanner name
+func (s *ClamdScanner) Name() string {
+	return "clamd"
+}
+
+// Ping checks that the daemon answers
+func (s *ClamdScanner) Ping(ctx context.Context) error {
+	conn, reader, err := s.send(ctx, "zPING\x00")
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	reply, err := readReply(reader)
+	if err != nil {
+		return err
+	}
+	if reply != "PONG" {
+		return fmt.Errorf("unexpected clamd reply: %s", reply)
+	}
+	return nil
+}
+
+// Scan sends r as length prefixed chunks followed by an empty chunk and parses the single line reply
+func (s *ClamdScanner) Scan(ctx context.Context, r io.Reader) (*Verdict, error) {
+	conn, reader, err := s.send(ctx, "zINSTREAM\x00")
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	buf := make([]byte, 4+s.chunk)
+	for {
+		n, readErr := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+			if _, err := conn.Write(buf[:4+n]); err != nil {
+				// the daemon closes the connection once the size limit is hit, its reply says so
+				if reply, replyErr := readReply(reader); replyErr == nil {
+					return parseScanReply(reply)
+				}
+				return nil, fmt.Errorf("write stream to clamd: %w", err)
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("read stream: %w", readErr)
+		}
+	}
+	if _, err := conn.Write([]byte{0, 0, 0, 0}); err != nil {
+		return nil, fmt.Errorf("finish stream to clamd: %w", err)
+	}
+
+	reply, err := readReply(reader)
+	if err != nil {
+		return nil, err
+	}
+	return parseScanReply(reply)
+}
+
+// send connects, applies the scan deadline and writes the command
+func (s *ClamdScanner) send(ctx context.Context, command string) (net.Conn, *bufio.Reader, error) {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to clamd: %w", err)
+	}
+	deadline := time.Now().Add(s.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+
+	if _, err := conn.Write([]byte(command)); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("send clamd command: %w", err)
+	}
+	return conn, bufio.NewReader(conn), nil
+}
+
+// readReply reads one NUL terminated reply
+func readReply(reader *bufio.Reader) (string, error) {
+	reply, err := reader.ReadString(0)
+	if err != nil && reply == "" {
+		return "", fmt.Errorf("read clamd reply: %w", err)
+	}
+	return strings.TrimSpace(strings.TrimRight(reply, "\x00")), nil
+}
+
+// parseScanReply parses "stream: OK", "stream: <signature> FOUND" and "<message> ERROR"
+func parseScanReply(reply string) (*Verdict, error) {
+	result := strings.TrimSpace(strings.TrimPrefix(reply, "stream:"))
+	switch {
+	case result == "OK":
+		return &Verdict{}, nil
+	case strings.HasSuffix(result, " FOUND"):
+		return &Verdict{Infected: true, Signature: strings.TrimSpace(strings.TrimSuffix(result, " FOUND"))}, nil
+	case strings.Contains(result, "size limit exceeded"):
+		return nil, ErrSizeLimit
+	default:
+		return nil, fmt.Errorf("clamd: %s", result)
+	}
+}
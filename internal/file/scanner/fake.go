@@ -0,0 +1,68 @@
+package scanner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// EICARSignature signature the fake scanner reports for the EICAR test file, the name ClamAV uses
+const EICARSignature = "Win.Test.EICAR_HDB-1"
+
+// eicar standard antivirus test string, harmless but detected by every engine
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// FakeScanner in-process scanner that flags the EICAR test string and configured byte patterns.
+// Used by local setups and integration tests without a ClamAV daemon; never use it in production
+type FakeScanner struct {
+	patterns map[string]string // content pattern -> reported signature
+	maxLen   int
+}
+
+// NewFakeScanner creates fake scanner, patterns maps content to the signature it is reported as
+func NewFakeScanner(patterns map[string]string) (*FakeScanner, error) {
+	s := &FakeScanner{patterns: map[string]string{eicar: EICARSignature}}
+	for pattern, signature := range patterns {
+		if pattern == "" || signature == "" {
+			return nil, fmt.Errorf("fake scanner pattern and signature must not be empty")
+		}
+		s.patterns[pattern] = signature
+	}
+	for pattern := range s.patterns {
+		s.maxLen = max(s.maxLen, len(pattern))
+	}
+	return s, nil
+}
+
+// Name returns scanner name
+func (s *FakeScanner) Name() string {
+	return "fake"
+}
+
+// Scan searches the stream for the patterns, keeping an overlap so matches across reads are found
+func (s *FakeScanner) Scan(ctx context.Context, r io.Reader) (*Verdict, error) {
+	buf := make([]byte, 0, 64*1024+s.maxLen)
+	chunk := make([]byte, 64*1024)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := r.Read(chunk)
+		buf = append(buf, chunk[:n]...)
+		for pattern, signature := range s.patterns {
+			if bytes.Contains(buf, []byte(pattern)) {
+				return &Verdict{Infected: true, Signature: signature}, nil
+			}
+		}
+		if len(buf) > s.maxLen {
+			buf = append(buf[:0], buf[len(buf)-s.maxLen:]...)
+		}
+		if err == io.EOF {
+			return &Verdict{}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read stream: %w", err)
+		}
+	}
+}
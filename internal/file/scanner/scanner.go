@@ -0,0 +1,24 @@
+package scanner
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrSizeLimit the stream is larger than the scanner accepts
+var ErrSizeLimit = errors.New("stream exceeds the scanner size limit")
+
+// Verdict result of scanning one object
+type Verdict struct {
+	Infected  bool
+	Signature string // name of the detected malware, empty when clean
+}
+
+// Scanner malware scanning engine uploads are streamed to
+type Scanner interface {
+	// Name scanner name recorded with each verdict
+	Name() string
+	// Scan reads r to the end and reports whether it contains malware. An error means no verdict was reached
+	Scan(ctx context.Context, r io.Reader) (*Verdict, error)
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+
+	"github.com/anychat/server/internal/file/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	minioclient "github.com/anychat/server/pkg/minio"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// eraseBatchSize files removed from storage per round
+const eraseBatchSize = 100
+
+// EraseUserData removes every file of a deleted account from MinIO, including files already marked deleted,
+// then drops the records. Content shared with other accounts only loses this account's references.
+// A record is dropped together with the outbox entries of its objects, which are retried until they are gone
+func (s *fileServiceImpl) EraseUserData(ctx context.Context, userID string) (map[string]int64, error) {
+	if userID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "user_id is required")
+	}
+
+	// free the stored parts of unfinished multipart uploads, their records go with the other upload records
+	uploads, err := s.uploadRepo.ListOpenByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to list uploads")
+	}
+	for _, upload := range uploads {
+		if upload.StorageUploadID == "" {
+			continue
+		}
+		err := s.minioClient.AbortMultipartUpload(ctx, upload.BucketName, upload.StoragePath, upload.StorageUploadID)
+		if err != nil && !minioclient.IsNoSuchUpload(err) {
+			logger.Error("Failed to abort upload of deleted account",
+				zap.String("userId", userID),
+				zap.String("uploadId", upload.UploadID),
+				zap.Error(err))
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to abort upload in storage")
+		}
+	}
+
+	erased := map[string]int64{"files": 0, "objects": 0}
+	for {
+		files, err := s.fileRepo.ListAllByUserID(ctx, userID, eraseBatchSize)
+		if err != nil {
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to list files")
+		}
+		if len(files) == 0 {
+			break
+		}
+
+		fileIDs := make([]string, 0, len(files))
+		for _, file := range files {
+			fileIDs = append(fileIDs, file.FileID)
+		}
+		if err := s.grantRepo.DeleteByFileIDs(ctx, fileIDs); err != nil {
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to delete file grants")
+		}
+		if err := s.jobRepo.DeleteByFileIDs(ctx, fileIDs); err != nil {
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to delete processing jobs")
+		}
+		// the verdicts stay as audit trail, they carry no account data
+		if err := s.scanRepo.DeleteByFileIDs(ctx, fileIDs); err != nil {
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to delete file scans")
+		}
+
+		// release and drop each record together, so a repeated run never releases a shared blob twice
+		for _, file := range files {
+			var deletions []*model.FileObjectDeletion
+			err := s.db.Transaction(func(tx *gorm.DB) error {
+				// group usage outlives the account, the account's own ledger row is dropped below
+				if err := s.releaseStorage(ctx, tx, file); err != nil {
+					return err
+				}
+				// a deleted file already gave up its blob reference
+				if file.Status != model.FileStatusDeleted || file.BlobID == nil {
+					var err error
+					if deletions, err = s.releaseFileObjects(ctx, tx, file); err != nil {
+						return err
+					}
+				}
+				return s.fileRepo.WithTx(tx).HardDelete(ctx, []string{file.FileID})
+			})
+			if err != nil {
+				logger.Error("Failed to erase file of deleted account",
+					zap.String("userId", userID),
+					zap.String("fileId", file.FileID),
+					zap.Error(err))
+				return nil, errors.NewBusiness(errors.CodeInternalError, "failed to erase file")
+			}
+			s.removeObjects(ctx, deletions)
+			erased["objects"] += int64(len(deletions))
+		}
+		erased["files"] += int64(len(fileIDs))
+	}
+
+	if err := s.usageRepo.Delete(ctx, model.StorageOwnerUser, userID); err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to delete storage usage")
+	}
+
+	count, err := s.fileRepo.DeleteUploadsByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to delete upload records")
+	}
+	erased["uploads"] = count
+
+	// grants of files the user forwarded, and the user's group recipient records
+	count, err = s.grantRepo.DeleteByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to delete file grants")
+	}
+	erased["grants"] = count
+
+	// the public avatar images are not file records
+	if err := s.RemoveAvatar(ctx, model.StorageOwnerUser, userID); err != nil {
+		return nil, err
+	}
+	return erased, nil
+}
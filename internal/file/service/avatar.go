@@ -0,0 +1,266 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/anychat/server/internal/file/dto"
+	"github.com/anychat/server/internal/file/media"
+	"github.com/anychat/server/internal/file/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	minioclient "github.com/anychat/server/pkg/minio"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// maxCompositeMembers members shown on a group composite avatar
+const maxCompositeMembers = 9
+
+// compositeObjectName object of the default group avatar in the group-avatar bucket
+const compositeObjectName = "composite.jpg"
+
+// AvatarConfig avatar processing settings
+type AvatarConfig struct {
+	PublicBaseURL  string // base of public avatar URLs, e.g. the MinIO endpoint or a CDN in front of it
+	Sizes          []int  // edges of the square images, the largest is bound to the profile or group
+	MaxSourceBytes int64  // larger uploads are rejected
+	MinSourceEdge  int    // shorter edge below which an upload is rejected
+	Quality        int    // JPEG quality
+	CompositeSize  int    // edge of the group composite avatar
+}
+
+// DefaultAvatarConfig returns the default avatar settings
+func DefaultAvatarConfig() AvatarConfig {
+	return AvatarConfig{
+		PublicBaseURL:  "http://localhost:9000",
+		Sizes:          []int{640, 160},
+		MaxSourceBytes: 10 * 1024 * 1024,
+		MinSourceEdge:  64,
+		Quality:        85,
+		CompositeSize:  320,
+	}
+}
+
+// SetAvatar crops the uploaded image to a centered square, writes every configured size and returns their
+// public URLs. Objects have stable names per owner, the URLs carry a content version so caches pick up a change
+func (s *fileServiceImpl) SetAvatar(ctx context.Context, req *dto.SetAvatarRequest) (*dto.AvatarResponse, error) {
+	ownerType := model.StorageOwnerType(req.OwnerType)
+	if err := validateStorageOwner(ownerType, req.OwnerID); err != nil {
+		return nil, err
+	}
+	if req.UserID == "" || req.FileID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "user_id and file_id are required")
+	}
+
+	file, err := s.fileRepo.GetByFileID(ctx, req.FileID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeFileNotFound, "file not found")
+		}
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get file")
+	}
+	// only the uploader may turn a file into an avatar, a received file would be published to everyone
+	if file.UserID != req.UserID {
+		return nil, errors.NewBusiness(errors.CodeFileAccessDenied, "no access to file")
+	}
+	if file.Status != model.FileStatusActive {
+		return nil, errors.NewBusiness(errors.CodeFileNotFound, "file is not active")
+	}
+	if file.FileType != model.FileTypeImage && !strings.HasPrefix(file.MimeType, "image/") {
+		return nil, errors.NewBusiness(errors.CodeInvalidAvatarImage, "avatar must be an image")
+	}
+	if file.FileSize > s.avatarConfig.MaxSourceBytes {
+		return nil, errors.NewBusiness(errors.CodeInvalidAvatarImage, fmt.Sprintf("avatar image exceeds %d bytes", s.avatarConfig.MaxSourceBytes))
+	}
+
+	data, err := s.readObject(ctx, file, s.avatarConfig.MaxSourceBytes)
+	if err != nil {
+		logger.Error("Failed to read avatar source",
+			zap.String("fileId", file.FileID),
+			zap.Error(err))
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to read image")
+	}
+	square, err := s.decodeAvatarSource(data)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := avatarBucket(ownerType)
+	sizes := s.avatarSizes()
+	encoded := make(map[int][]byte, len(sizes))
+	for _, size := range sizes {
+		img, err := media.EncodeJPEG(media.ScaleSquare(square, size), s.avatarConfig.Quality)
+		if err != nil {
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to encode avatar")
+		}
+		encoded[size] = img
+	}
+	// every size of one upload shares the version of the largest image
+	version := contentVersion(encoded[sizes[0]])
+
+	resp := &dto.AvatarResponse{Images: make([]*dto.AvatarImageResponse, 0, len(sizes))}
+	for _, size := range sizes {
+		objectName := avatarObjectName(req.OwnerID, size)
+		_, err := s.minioClient.PutObjectReader(ctx, bucket, objectName, bytes.NewReader(encoded[size]), int64(len(encoded[size])), "image/jpeg")
+		if err != nil {
+			logger.Error("Failed to write avatar",
+				zap.String("bucket", bucket),
+				zap.String("object", objectName),
+				zap.Error(err))
+			return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to store avatar")
+		}
+		resp.Images = append(resp.Images, &dto.AvatarImageResponse{
+			Size: int32(size),
+			URL:  s.publicURL(bucket, objectName, version),
+		})
+	}
+	resp.URL = resp.Images[0].URL
+	return resp, nil
+}
+
+// RemoveAvatar deletes the avatar images of a user or group, a group's composite is kept
+func (s *fileServiceImpl) RemoveAvatar(ctx context.Context, ownerType model.StorageOwnerType, ownerID string) error {
+	if err := validateStorageOwner(ownerType, ownerID); err != nil {
+		return err
+	}
+
+	sizes := s.avatarSizes()
+	objectNames := make([]string, 0, len(sizes))
+	for _, size := range sizes {
+		objectNames = append(objectNames, avatarObjectName(ownerID, size))
+	}
+	s.deleteObjects(ctx, avatarBucket(ownerType), objectNames...)
+	return nil
+}
+
+// GenerateGroupAvatar composes the default avatar of a group from the smallest avatar of up to nine members.
+// Members without an uploaded avatar get a colored placeholder tile
+func (s *fileServiceImpl) GenerateGroupAvatar(ctx context.Context, groupID string, memberIDs []string) (*dto.AvatarResponse, error) {
+	if groupID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "group_id is required")
+	}
+	memberIDs = uniqueStrings(memberIDs)
+	if len(memberIDs) > maxCompositeMembers {
+		memberIDs = memberIDs[:maxCompositeMembers]
+	}
+
+	sizes := s.avatarSizes()
+	tileSource := sizes[len(sizes)-1]
+	tiles := make([]*image.RGBA, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		tile, err := s.memberAvatarTile(ctx, memberID, tileSource)
+		if err != nil {
+			logger.Error("Failed to read member avatar",
+				zap.String("groupId", groupID),
+				zap.String("userId", memberID),
+				zap.Error(err))
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to read member avatar")
+		}
+		tiles = append(tiles, tile)
+	}
+
+	encoded, err := media.EncodeJPEG(media.Composite(tiles, s.avatarConfig.CompositeSize), s.avatarConfig.Quality)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to encode avatar")
+	}
+	objectName := groupID + "/" + compositeObjectName
+	_, err = s.minioClient.PutObjectReader(ctx, model.BucketGroupAvatar, objectName, bytes.NewReader(encoded), int64(len(encoded)), "image/jpeg")
+	if err != nil {
+		logger.Error("Failed to write group avatar",
+			zap.String("groupId", groupID),
+			zap.Error(err))
+		return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to store avatar")
+	}
+
+	url := s.publicURL(model.BucketGroupAvatar, objectName, contentVersion(encoded))
+	return &dto.AvatarResponse{
+		URL:    url,
+		Images: []*dto.AvatarImageResponse{{Size: int32(s.avatarConfig.CompositeSize), URL: url}},
+	}, nil
+}
+
+// decodeAvatarSource checks an uploaded image and cuts the upright centered square out of it
+func (s *fileServiceImpl) decodeAvatarSource(data []byte) (*image.RGBA, error) {
+	info, err := media.DecodeImageConfig(data)
+	if err == media.ErrUnsupported {
+		return nil, errors.NewBusiness(errors.CodeInvalidAvatarImage, "avatar must be a JPEG, PNG or GIF image")
+	}
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInvalidAvatarImage, "avatar image cannot be decoded")
+	}
+	if info.Width*info.Height > s.processingConfig.MaxImagePixels {
+		return nil, errors.NewBusiness(errors.CodeInvalidAvatarImage, "avatar image has too many pixels")
+	}
+	if min(info.Width, info.Height) < s.avatarConfig.MinSourceEdge {
+		return nil, errors.NewBusiness(errors.CodeInvalidAvatarImage, fmt.Sprintf("avatar image must be at least %dx%d", s.avatarConfig.MinSourceEdge, s.avatarConfig.MinSourceEdge))
+	}
+
+	img, info, err := media.DecodeImage(data)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInvalidAvatarImage, "avatar image cannot be decoded")
+	}
+	return media.SquareCrop(img, info.Orientation), nil
+}
+
+// memberAvatarTile reads a member's avatar of the given size, or a placeholder when the member has none
+func (s *fileServiceImpl) memberAvatarTile(ctx context.Context, userID string, size int) (*image.RGBA, error) {
+	object, err := s.minioClient.GetObject(ctx, model.BucketAvatar, avatarObjectName(userID, size))
+	if err != nil {
+		return nil, fmt.Errorf("get object: %w", err)
+	}
+	defer object.Close()
+
+	// the object is fetched on the first read, a missing avatar only shows up here
+	data, err := io.ReadAll(io.LimitReader(object, s.avatarConfig.MaxSourceBytes))
+	if err != nil {
+		if minioclient.IsNoSuchKey(err) {
+			return media.Placeholder(userID, size), nil
+		}
+		return nil, fmt.Errorf("read object: %w", err)
+	}
+	img, info, err := media.DecodeImage(data)
+	if err != nil {
+		// written by SetAvatar, a broken object is shown like a missing one
+		return media.Placeholder(userID, size), nil
+	}
+	return media.SquareCrop(img, info.Orientation), nil
+}
+
+// avatarSizes configured sizes, largest first
+func (s *fileServiceImpl) avatarSizes() []int {
+	sizes := append([]int(nil), s.avatarConfig.Sizes...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sizes)))
+	return sizes
+}
+
+// publicURL URL of an object in a public bucket, the version changes whenever the content does
+func (s *fileServiceImpl) publicURL(bucket, objectName, version string) string {
+	return fmt.Sprintf("%s/%s/%s?v=%s", strings.TrimSuffix(s.avatarConfig.PublicBaseURL, "/"), bucket, objectName, version)
+}
+
+// avatarBucket public bucket of the owner's avatars
+func avatarBucket(ownerType model.StorageOwnerType) string {
+	if ownerType == model.StorageOwnerGroup {
+		return model.BucketGroupAvatar
+	}
+	return model.BucketAvatar
+}
+
+// avatarObjectName stable object of one avatar size: {owner id}/{size}.jpg
+func avatarObjectName(ownerID string, size int) string {
+	return fmt.Sprintf("%s/%d.jpg", ownerID, size)
+}
+
+// contentVersion short content hash used as cache buster
+func contentVersion(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16]
+}
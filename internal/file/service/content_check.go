@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anychat/server/internal/file/media"
+	"github.com/anychat/server/internal/file/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// audioContainers containers detected as video that often hold audio only, e.g. voice notes recorded as MP4
+var audioContainers = map[string]bool{
+	"video/mp4":       true,
+	"video/webm":      true,
+	"video/3gpp":      true,
+	"application/ogg": true,
+}
+
+// genericMIMETypes detected types that say less than a declared non-media type, e.g. a docx is a zip
+var genericMIMETypes = map[string]bool{
+	"application/octet-stream": true,
+	"application/zip":          true,
+	"text/plain":               true,
+}
+
+// verifyUploadedContent checks a stored object against the size limit, the declared size and the
+// declared type, and returns the MIME type to store. A rejection is a business error; failing to read
+// the object is returned as is
+func (s *fileServiceImpl) verifyUploadedContent(ctx context.Context, file *model.File, size int64) (string, error) {
+	if err := s.validateFileSize(file.FileType, size); err != nil {
+		return "", err
+	}
+	if size != file.FileSize {
+		return "", errors.NewBusiness(errors.CodeFileContentMismatch,
+			fmt.Sprintf("uploaded %d bytes, declared %d", size, file.FileSize))
+	}
+
+	head, err := s.minioClient.GetObjectHead(ctx, file.BucketName, file.StoragePath, media.SniffLength)
+	if err != nil {
+		return "", err
+	}
+	detected := media.DetectMIME(head)
+	if !contentMatchesType(file.FileType, file.MimeType, detected) {
+		return "", errors.NewBusiness(errors.CodeFileContentMismatch,
+			fmt.Sprintf("content is %s, declared %s", detected, file.MimeType))
+	}
+
+	if genericMIMETypes[detected] && file.MimeType != "" {
+		return file.MimeType, nil
+	}
+	return detected, nil
+}
+
+// contentMatchesType reports whether the detected MIME type fits the declared file type, and the declared
+// MIME type when that names an image, video or audio format
+func contentMatchesType(fileType model.FileType, declared, detected string) bool {
+	switch fileType {
+	case model.FileTypeImage:
+		if !mimeIs(detected, "image") {
+			return false
+		}
+	case model.FileTypeVideo:
+		if !mimeIs(detected, "video") && detected != "application/ogg" {
+			return false
+		}
+	case model.FileTypeAudio:
+		if !mimeIs(detected, "audio") && !audioContainers[detected] {
+			return false
+		}
+	}
+
+	switch {
+	case mimeIs(declared, "image"):
+		return mimeIs(detected, "image")
+	case mimeIs(declared, "video"):
+		return mimeIs(detected, "video") || detected == "application/ogg"
+	case mimeIs(declared, "audio"):
+		return mimeIs(detected, "audio") || audioContainers[detected]
+	}
+	return true
+}
+
+// mimeIs reports whether mimeType has the given top-level type
+func mimeIs(mimeType, topLevel string) bool {
+	return strings.HasPrefix(strings.ToLower(mimeType), topLevel+"/")
+}
+
+// discardRejectedUpload removes an object that failed verification and deletes its pending file record,
+// the client has to request a new upload
+func (s *fileServiceImpl) discardRejectedUpload(ctx context.Context, file *model.File, cause error) {
+	logger.Warn("Uploaded content rejected",
+		zap.String("fileId", file.FileID),
+		zap.String("userId", file.UserID),
+		zap.Error(cause))
+	s.deleteObjects(ctx, file.BucketName, file.StoragePath)
+	if _, err := s.fileRepo.Delete(ctx, file.FileID); err != nil {
+		logger.Error("Failed to delete rejected file record",
+			zap.String("fileId", file.FileID),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	grouppb "github.com/anychat/server/api/proto/group"
+	"github.com/anychat/server/internal/file/dto"
+	"github.com/anychat/server/internal/file/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// maxFilesPerMessage files one message may reference
+const maxFilesPerMessage = 20
+
+// maxBatchDownloadURLs files one BatchGenerateDownloadURLs call may presign
+const maxBatchDownloadURLs = 100
+
+// GrantMessageFileAccess links the files referenced by a message to its conversation.
+// The sender must be able to read each file, so forwarding a received file works but foreign file IDs are rejected
+func (s *fileServiceImpl) GrantMessageFileAccess(ctx context.Context, req *dto.GrantMessageFileAccessRequest) (*dto.GrantMessageFileAccessResponse, error) {
+	if req.MessageID == "" || req.SenderID == "" || req.TargetID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "message_id, sender_id and target_id are required")
+	}
+	conversationType := model.GrantConversationType(req.ConversationType)
+	if conversationType != model.GrantConversationSingle && conversationType != model.GrantConversationGroup {
+		return nil, errors.NewBusiness(errors.CodeParamError, "conversation_type must be single or group")
+	}
+	fileIDs := uniqueStrings(req.FileIDs)
+	if len(fileIDs) > maxFilesPerMessage {
+		return nil, errors.NewBusiness(errors.CodeParamError, "too many files in one message")
+	}
+
+	resp := &dto.GrantMessageFileAccessResponse{GrantedFileIDs: []string{}, RejectedFileIDs: []string{}}
+	for _, fileID := range fileIDs {
+		file, err := s.fileRepo.GetByFileID(ctx, fileID)
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get file")
+		}
+		if file == nil || file.Status != model.FileStatusActive {
+			resp.RejectedFileIDs = append(resp.RejectedFileIDs, fileID)
+			continue
+		}
+		readable, err := s.canReadFile(ctx, file, req.SenderID)
+		if err != nil {
+			return nil, err
+		}
+		if !readable {
+			resp.RejectedFileIDs = append(resp.RejectedFileIDs, fileID)
+			continue
+		}
+		resp.GrantedFileIDs = append(resp.GrantedFileIDs, fileID)
+	}
+	if len(resp.GrantedFileIDs) == 0 {
+		return resp, nil
+	}
+
+	// group members at send time keep access after they leave the group
+	var recipientIDs []string
+	if conversationType == model.GrantConversationGroup {
+		var err error
+		recipientIDs, err = s.listGroupMemberIDs(ctx, req.TargetID, req.SenderID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		grantRepo := s.grantRepo.WithTx(tx)
+		for _, fileID := range resp.GrantedFileIDs {
+			grant := &model.FileAccessGrant{
+				FileID:           fileID,
+				MessageID:        req.MessageID,
+				ConversationID:   req.ConversationID,
+				ConversationType: conversationType,
+				TargetID:         req.TargetID,
+				SenderID:         req.SenderID,
+				CreatedAt:        now,
+			}
+			created, err := grantRepo.Create(ctx, grant)
+			if err != nil {
+				return err
+			}
+			if created {
+				if err := grantRepo.AddRecipients(ctx, grant.ID, recipientIDs); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("Failed to create file access grants",
+			zap.String("messageId", req.MessageID),
+			zap.Error(err))
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to create file access grants")
+	}
+
+	return resp, nil
+}
+
+// RevokeMessageFileAccess revokes the grants created for messages that were recalled or deleted
+func (s *fileServiceImpl) RevokeMessageFileAccess(ctx context.Context, messageIDs []string, reason string) (int64, error) {
+	switch reason {
+	case model.GrantRevokeRecalled, model.GrantRevokeDeleted, model.GrantRevokeAutoDeleted:
+	default:
+		return 0, errors.NewBusiness(errors.CodeParamError, "invalid revoke reason")
+	}
+
+	revoked, err := s.grantRepo.RevokeByMessageIDs(ctx, uniqueStrings(messageIDs), reason)
+	if err != nil {
+		return 0, errors.NewBusiness(errors.CodeInternalError, "failed to revoke file access grants")
+	}
+	return revoked, nil
+}
+
+// getReadableFile gets a file the user uploaded or can read through a message grant
+func (s *fileServiceImpl) getReadableFile(ctx context.Context, fileID, userID string) (*model.File, error) {
+	file, err := s.fileRepo.GetByFileID(ctx, fileID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeFileNotFound, "file not found")
+		}
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get file")
+	}
+
+	readable, err := s.canReadFile(ctx, file, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !readable {
+		return nil, errors.NewBusiness(errors.CodeFileAccessDenied, "no access to file")
+	}
+	return file, nil
+}
+
+// canReadFile checks whether the user uploaded the file or can read it through an active grant:
+// sender or peer of a single chat, group member when the message was sent, or current member of a group
+// that lets members see history (members who joined later only when the group allows viewing history)
+func (s *fileServiceImpl) canReadFile(ctx context.Context, file *model.File, userID string) (bool, error) {
+	if file.UserID == userID {
+		return true, nil
+	}
+
+	direct, err := s.grantRepo.HasDirectGrant(ctx, file.FileID, userID)
+	if err != nil {
+		return false, errors.NewBusiness(errors.CodeInternalError, "failed to check file access")
+	}
+	if direct {
+		return true, nil
+	}
+
+	grants, err := s.grantRepo.ListActiveGroupGrants(ctx, file.FileID)
+	if err != nil {
+		return false, errors.NewBusiness(errors.CodeInternalError, "failed to check file access")
+	}
+	if len(grants) == 0 || s.groupClient == nil {
+		return false, nil
+	}
+
+	// earliest grant per group: a member who joined before it, or any member when history is visible, can read
+	earliest := make(map[string]time.Time, len(grants))
+	for _, grant := range grants {
+		if t, ok := earliest[grant.TargetID]; !ok || grant.CreatedAt.Before(t) {
+			earliest[grant.TargetID] = grant.CreatedAt
+		}
+	}
+	for groupID, grantedAt := range earliest {
+		member, err := s.groupClient.IsMember(ctx, &grouppb.IsMemberRequest{GroupId: groupID, UserId: userID})
+		if err != nil {
+			return false, errors.NewBusiness(errors.CodeInternalError, "failed to verify group membership")
+		}
+		if !member.IsMember {
+			continue
+		}
+		if member.JoinedAt <= grantedAt.Unix() {
+			return true, nil
+		}
+		settings, err := s.groupClient.GetGroupSettings(ctx, &grouppb.GetGroupSettingsRequest{GroupId: groupID})
+		if err != nil {
+			return false, errors.NewBusiness(errors.CodeInternalError, "failed to get group settings")
+		}
+		if settings.AllowViewHistory {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// listGroupMemberIDs lists all members of a group
+func (s *fileServiceImpl) listGroupMemberIDs(ctx context.Context, groupID, operatorUserID string) ([]string, error) {
+	if s.groupClient == nil {
+		return nil, nil
+	}
+
+	const pageSizeValue int32 = 100
+	page := int32(1)
+	pageSize := pageSizeValue
+	var memberIDs []string
+	for {
+		resp, err := s.groupClient.GetGroupMembers(ctx, &grouppb.GetGroupMembersRequest{
+			GroupId:  groupID,
+			UserId:   operatorUserID,
+			Page:     &page,
+			PageSize: &pageSize,
+		})
+		if err != nil {
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to load group members")
+		}
+		for _, member := range resp.Members {
+			if member.UserId != "" {
+				memberIDs = append(memberIDs, member.UserId)
+			}
+		}
+		if len(resp.Members) == 0 || int64(page)*int64(pageSizeValue) >= resp.Total {
+			return uniqueStrings(memberIDs), nil
+		}
+		page++
+	}
+}
+
+// uniqueStrings removes empty and duplicate values, keeping order
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anychat/server/internal/file/model"
+	"gorm.io/gorm"
+)
+
+// errBlobReleased the blob lost its last reference between lookup and AddRef
+var errBlobReleased = stderrors.New("file blob released")
+
+// normalizeSHA256 lowercases a hex SHA-256, empty when value is not one
+func normalizeSHA256(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if len(value) != sha256.Size*2 {
+		return ""
+	}
+	if _, err := hex.DecodeString(value); err != nil {
+		return ""
+	}
+	return value
+}
+
+// instantUpload activates file on top of an already stored blob with the same hash and size.
+// Returns false when no such blob exists, file is left unchanged then
+func (s *fileServiceImpl) instantUpload(ctx context.Context, file *model.File) (bool, error) {
+	blob, err := s.blobRepo.GetActive(ctx, file.SHA256, file.FileSize)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	candidate := *file
+	candidate.BucketName = blob.BucketName
+	candidate.StoragePath = blob.StoragePath
+	candidate.BlobID = &blob.ID
+	candidate.Status = model.FileStatusActive
+
+	// the stored content must also fit the type declared for this file
+	mimeType, err := s.verifyUploadedContent(ctx, &candidate, blob.FileSize)
+	if err != nil {
+		return false, err
+	}
+	candidate.MimeType = mimeType
+
+	copied := false
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		ok, err := s.blobRepo.WithTx(tx).AddRef(ctx, blob.ID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errBlobReleased
+		}
+		if copied, err = s.copyProcessedMedia(ctx, tx, &candidate); err != nil {
+			return err
+		}
+		if err := s.chargeStorage(ctx, tx, &candidate); err != nil {
+			return err
+		}
+		if err := s.fileRepo.WithTx(tx).Create(ctx, &candidate); err != nil {
+			return err
+		}
+		if copied {
+			return nil
+		}
+		return s.jobRepo.WithTx(tx).Enqueue(ctx, candidate.FileID)
+	})
+	if err != nil {
+		if stderrors.Is(err, errBlobReleased) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	*file = candidate
+	if copied {
+		s.publishUploadCompleted(file)
+	}
+	return true, nil
+}
+
+// attachBlob points a verified upload at the blob of its content, creating the blob when this is the
+// first copy. Returns true when an existing blob was reused and the uploaded object is redundant
+func (s *fileServiceImpl) attachBlob(ctx context.Context, tx *gorm.DB, file *model.File) (bool, error) {
+	blobRepo := s.blobRepo.WithTx(tx)
+	// a blob released between lookup and AddRef is retried once, it no longer blocks a new one
+	for attempt := 0; attempt < 2; attempt++ {
+		blob, err := blobRepo.GetActive(ctx, file.SHA256, file.FileSize)
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return false, err
+		}
+		if err == nil {
+			ok, err := blobRepo.AddRef(ctx, blob.ID)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				file.BucketName = blob.BucketName
+				file.StoragePath = blob.StoragePath
+				file.BlobID = &blob.ID
+				return true, nil
+			}
+			continue
+		}
+
+		blob = &model.FileBlob{
+			SHA256:      file.SHA256,
+			FileSize:    file.FileSize,
+			BucketName:  file.BucketName,
+			StoragePath: file.StoragePath,
+			RefCount:    1,
+			Status:      model.BlobStatusActive,
+		}
+		created, err := blobRepo.Create(ctx, blob)
+		if err != nil {
+			return false, err
+		}
+		if created {
+			file.BlobID = &blob.ID
+			return false, nil
+		}
+		// another upload of the same content created it first
+	}
+	return false, fmt.Errorf("file blob %s changed concurrently", file.SHA256)
+}
+
+// copyProcessedMedia copies size, thumbnails and metadata from a processed file of the same blob,
+// false when none has been processed yet
+func (s *fileServiceImpl) copyProcessedMedia(ctx context.Context, tx *gorm.DB, file *model.File) (bool, error) {
+	processed, err := s.fileRepo.WithTx(tx).GetProcessedByBlobID(ctx, *file.BlobID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	file.FileSize = processed.FileSize
+	file.ThumbnailPath = processed.ThumbnailPath
+	file.Metadata = processed.Metadata
+	return true, nil
+}
+
+// objectSHA256 streams an object and returns its lowercase hex SHA-256
+func (s *fileServiceImpl) objectSHA256(ctx context.Context, bucketName, objectName string) (string, error) {
+	object, err := s.minioClient.GetObject(ctx, bucketName, objectName)
+	if err != nil {
+		return "", err
+	}
+	defer object.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, object); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// releaseFileObjects drops the file's reference to its content and schedules the objects for deletion once
+// nothing references them. The caller passes the result to removeObjects after committing tx
+func (s *fileServiceImpl) releaseFileObjects(ctx context.Context, tx *gorm.DB, file *model.File) ([]*model.FileObjectDeletion, error) {
+	objectNames := file.ObjectPaths()
+	if file.BlobID != nil {
+		// the release locks the blob row, so a concurrent AddRef waits and then sees it deleted
+		remaining, err := s.blobRepo.WithTx(tx).Release(ctx, *file.BlobID)
+		if err != nil {
+			return nil, err
+		}
+		if remaining > 0 {
+			return nil, nil
+		}
+		// thumbnails may have been written for another reference whose metadata this file never got
+		for _, size := range s.processingConfig.ThumbnailSizes {
+			objectNames = appendUnique(objectNames, thumbnailObjectName(file.StoragePath, size))
+		}
+	}
+	return s.scheduleObjectDeletion(ctx, tx, file.BucketName, objectNames)
+}
+
+// appendUnique appends value when it is not in values yet
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/anychat/server/internal/file/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/anychat/server/pkg/notification"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// LifecycleConfig file expiry, abandoned upload and object deletion settings
+type LifecycleConfig struct {
+	ExpiryWarning        time.Duration // uploaders are notified this long before a file expires, 0 disables it
+	PendingTTL           time.Duration // pending uploads older than this are purged
+	DeletionRetryBackoff time.Duration // delay after a failed object removal, doubles per attempt
+	DeletionMaxBackoff   time.Duration // upper bound of the delay between removal attempts
+}
+
+// DefaultLifecycleConfig returns the default lifecycle settings
+func DefaultLifecycleConfig() LifecycleConfig {
+	return LifecycleConfig{
+		ExpiryWarning:        24 * time.Hour,
+		PendingTTL:           24 * time.Hour,
+		DeletionRetryBackoff: 30 * time.Second,
+		DeletionMaxBackoff:   time.Hour,
+	}
+}
+
+// WarnExpiringFiles notifies uploaders of files expiring within the warning period, returns the number warned
+func (s *fileServiceImpl) WarnExpiringFiles(ctx context.Context, limit int) (int, error) {
+	if s.lifecycleConfig.ExpiryWarning <= 0 {
+		return 0, nil
+	}
+	now := time.Now()
+	files, err := s.fileRepo.ListExpiring(ctx, now, now.Add(s.lifecycleConfig.ExpiryWarning), limit)
+	if err != nil {
+		return 0, errors.NewBusiness(errors.CodeInternalError, "failed to list expiring files")
+	}
+	if len(files) == 0 {
+		return 0, nil
+	}
+
+	fileIDs := make([]string, 0, len(files))
+	for _, file := range files {
+		fileIDs = append(fileIDs, file.FileID)
+	}
+	// marked first, a failed publish loses one warning rather than repeating it every round
+	if err := s.fileRepo.MarkExpiryWarned(ctx, fileIDs, now); err != nil {
+		return 0, errors.NewBusiness(errors.CodeInternalError, "failed to mark files warned")
+	}
+	for _, file := range files {
+		s.publishFileNotification(file, notification.TypeFileExpiring, map[string]interface{}{
+			"file_id":         file.FileID,
+			"file_name":       file.FileName,
+			"file_type":       int32(file.FileType),
+			"expires_at":      file.ExpiresAt.Unix(),
+			"hours_remaining": int(math.Ceil(file.ExpiresAt.Sub(now).Hours())),
+		})
+	}
+	return len(files), nil
+}
+
+// ExpireFiles deletes active files past their expiry like DeleteFile does, returns the number expired
+func (s *fileServiceImpl) ExpireFiles(ctx context.Context, limit int) (int, error) {
+	files, err := s.fileRepo.ListExpired(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, errors.NewBusiness(errors.CodeInternalError, "failed to list expired files")
+	}
+
+	expired := 0
+	for _, file := range files {
+		deleted, err := s.removeFile(ctx, file)
+		if err != nil {
+			logger.Warn("Failed to expire file",
+				zap.String("fileId", file.FileID),
+				zap.Error(err))
+			continue
+		}
+		if deleted {
+			expired++
+		}
+	}
+	return expired, nil
+}
+
+// PurgeAbandonedUploads deletes pending files whose upload was never completed together with any partially
+// written object. Multipart uploads are left to CleanupExpiredUploads while they are open
+func (s *fileServiceImpl) PurgeAbandonedUploads(ctx context.Context, limit int) (int, error) {
+	files, err := s.fileRepo.ListAbandoned(ctx, time.Now().Add(-s.lifecycleConfig.PendingTTL), limit)
+	if err != nil {
+		return 0, errors.NewBusiness(errors.CodeInternalError, "failed to list abandoned uploads")
+	}
+
+	purged := 0
+	for _, file := range files {
+		var deletions []*model.FileObjectDeletion
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			// only while still pending, a completion racing the purge wins
+			deleted, err := s.fileRepo.WithTx(tx).CompareAndSetStatus(ctx, file.FileID, model.FileStatusProcessing, model.FileStatusDeleted)
+			if err != nil || !deleted {
+				return err
+			}
+			// a pending file has no blob yet, its object belongs to it alone
+			deletions, err = s.scheduleObjectDeletion(ctx, tx, file.BucketName, []string{file.StoragePath})
+			return err
+		})
+		if err != nil {
+			logger.Warn("Failed to purge abandoned upload",
+				zap.String("fileId", file.FileID),
+				zap.Error(err))
+			continue
+		}
+		s.removeObjects(ctx, deletions)
+		purged++
+	}
+	return purged, nil
+}
+
+// RetryObjectDeletions removes objects whose earlier removal failed, returns the number of attempts made
+func (s *fileServiceImpl) RetryObjectDeletions(ctx context.Context, limit int) (int, error) {
+	// the lease is the shortest retry delay, a worker that dies mid-removal is replaced after it
+	deletions, err := s.deletionRepo.ClaimDue(ctx, time.Now(), s.lifecycleConfig.DeletionRetryBackoff, limit)
+	if err != nil {
+		logger.Error("Failed to claim object deletions", zap.Error(err))
+		return 0, errors.NewBusiness(errors.CodeInternalError, "failed to claim object deletions")
+	}
+	s.removeObjects(ctx, deletions)
+	return len(deletions), nil
+}
+
+// removeFile soft deletes a file, releases its quota and content and removes the objects nothing references
+// any more. Returns false when the file was already deleted
+func (s *fileServiceImpl) removeFile(ctx context.Context, file *model.File) (bool, error) {
+	var deleted bool
+	var deletions []*model.FileObjectDeletion
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		deleted, err = s.fileRepo.WithTx(tx).Delete(ctx, file.FileID)
+		if err != nil || !deleted {
+			return err
+		}
+		if err := s.releaseStorage(ctx, tx, file); err != nil {
+			return err
+		}
+		// objects shared with other files stay until their last reference is deleted
+		deletions, err = s.releaseFileObjects(ctx, tx, file)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	s.removeObjects(ctx, deletions)
+	return deleted, nil
+}
+
+// scheduleObjectDeletion records objects in the deletion outbox. Called inside the transaction that makes
+// them unreferenced, so they are removed eventually even when storage is down or the process stops
+func (s *fileServiceImpl) scheduleObjectDeletion(ctx context.Context, tx *gorm.DB, bucketName string, objectNames []string) ([]*model.FileObjectDeletion, error) {
+	now := time.Now()
+	deletions := make([]*model.FileObjectDeletion, 0, len(objectNames))
+	for _, objectName := range objectNames {
+		deletions = append(deletions, &model.FileObjectDeletion{
+			BucketName: bucketName,
+			ObjectName: objectName,
+			// the caller removes them right after commit, the worker only picks up what that missed
+			Attempts:      1,
+			NextAttemptAt: now.Add(s.lifecycleConfig.DeletionRetryBackoff),
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+	if err := s.deletionRepo.WithTx(tx).Create(ctx, deletions); err != nil {
+		return nil, err
+	}
+	return deletions, nil
+}
+
+// removeObjects removes scheduled objects from storage and drops them from the outbox, a failed removal
+// is retried later with backoff
+func (s *fileServiceImpl) removeObjects(ctx context.Context, deletions []*model.FileObjectDeletion) {
+	for _, deletion := range deletions {
+		if err := s.minioClient.RemoveObject(ctx, deletion.BucketName, deletion.ObjectName); err != nil {
+			logger.Warn("Failed to remove object, will retry",
+				zap.String("bucket", deletion.BucketName),
+				zap.String("object", deletion.ObjectName),
+				zap.Int("attempts", deletion.Attempts),
+				zap.Error(err))
+			nextAttemptAt := time.Now().Add(s.deletionBackoff(deletion.Attempts))
+			if err := s.deletionRepo.MarkRetry(ctx, deletion.ID, nextAttemptAt, err.Error()); err != nil {
+				logger.Error("Failed to reschedule object deletion",
+					zap.Int64("id", deletion.ID),
+					zap.Error(err))
+			}
+			continue
+		}
+		if err := s.deletionRepo.Delete(ctx, deletion.ID); err != nil {
+			// removing an object twice is harmless, the row is retried and dropped later
+			logger.Error("Failed to drop object deletion",
+				zap.Int64("id", deletion.ID),
+				zap.Error(err))
+		}
+	}
+}
+
+// deleteObjects removes objects no transaction depends on, e.g. a rejected upload, retrying through the
+// outbox when storage fails
+func (s *fileServiceImpl) deleteObjects(ctx context.Context, bucketName string, objectNames ...string) {
+	deletions, err := s.scheduleObjectDeletion(ctx, s.db, bucketName, objectNames)
+	if err != nil {
+		logger.Error("Failed to schedule object deletion",
+			zap.String("bucket", bucketName),
+			zap.Strings("objects", objectNames),
+			zap.Error(err))
+		// not recorded, still try once
+		for _, objectName := range objectNames {
+			_ = s.minioClient.RemoveObject(ctx, bucketName, objectName)
+		}
+		return
+	}
+	s.removeObjects(ctx, deletions)
+}
+
+// deletionBackoff delay before the next removal attempt, doubling from DeletionRetryBackoff up to DeletionMaxBackoff
+func (s *fileServiceImpl) deletionBackoff(attempts int) time.Duration {
+	backoff := s.lifecycleConfig.DeletionRetryBackoff
+	for i := 1; i < attempts && backoff < s.lifecycleConfig.DeletionMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > s.lifecycleConfig.DeletionMaxBackoff {
+		backoff = s.lifecycleConfig.DeletionMaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,540 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/anychat/server/internal/file/dto"
+	"github.com/anychat/server/internal/file/model"
+	"github.com/anychat/server/internal/file/scanner"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/anychat/server/pkg/notification"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ScanConfig malware scanning settings, scanning is off when no scanner is configured
+type ScanConfig struct {
+	MaxFileBytes int64         // larger files are activated without a scan, 0 scans every file
+	MaxAttempts  int           // scanner failures before a file is quarantined for review
+	RetryBackoff time.Duration // delay after the first failure, grows quadratically
+	Lease        time.Duration // a running scan is picked up again after its lease expires
+}
+
+// DefaultScanConfig returns the default malware scanning settings
+func DefaultScanConfig() ScanConfig {
+	return ScanConfig{
+		MaxFileBytes: 100 * 1024 * 1024,
+		MaxAttempts:  5,
+		RetryBackoff: 30 * time.Second,
+		Lease:        5 * time.Minute,
+	}
+}
+
+// scanEnabled reports whether completed uploads wait for a malware scan
+func (s *fileServiceImpl) scanEnabled() bool {
+	return s.fileScanner != nil
+}
+
+// submitForScan queues the malware scan of a verified upload, the file stays pending until it is clean
+func (s *fileServiceImpl) submitForScan(ctx context.Context, file *model.File) (*dto.FileInfoResponse, error) {
+	// the quota is charged on activation, refuse early instead of after the scan
+	if err := s.checkStorageQuota(ctx, file); err != nil {
+		return nil, err
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.fileRepo.WithTx(tx).UpdateMimeType(ctx, file.FileID, file.MimeType); err != nil {
+			return err
+		}
+		return s.queueScan(ctx, tx, file)
+	})
+	if err != nil {
+		logger.Error("Failed to queue malware scan",
+			zap.String("fileId", file.FileID),
+			zap.Error(err))
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to update file status")
+	}
+	return s.toFileInfoResponse(file), nil
+}
+
+// queueScan adds the pending scan of a file, a repeated call keeps the existing one
+func (s *fileServiceImpl) queueScan(ctx context.Context, tx *gorm.DB, file *model.File) error {
+	_, err := s.scanRepo.WithTx(tx).Create(ctx, &model.FileScan{
+		FileID:       file.FileID,
+		UserID:       file.UserID,
+		SourceBucket: file.BucketName,
+	})
+	return err
+}
+
+// resumeScannedUpload answers a repeated completion of an upload that was handed to the scan: while scanning
+// the pending file is returned, a clean or released file whose activation failed, e.g. over quota, is activated again.
+// handled is false when the upload was never submitted
+func (s *fileServiceImpl) resumeScannedUpload(ctx context.Context, file *model.File) (*dto.FileInfoResponse, bool, error) {
+	scan, err := s.scanRepo.GetByFileID(ctx, file.FileID)
+	if err == gorm.ErrRecordNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, true, errors.NewBusiness(errors.CodeInternalError, "failed to get file scan")
+	}
+
+	switch scan.Status {
+	case model.FileScanPending, model.FileScanRunning:
+		return s.toFileInfoResponse(file), true, nil
+	case model.FileScanClean, model.FileScanSkipped, model.FileScanReleased:
+		resp, err := s.activateFile(ctx, file)
+		return resp, true, err
+	default:
+		return nil, true, errors.NewBusiness(errors.CodeInvalidFileID, "file already completed or deleted")
+	}
+}
+
+// ScanPendingFiles claims due scans and runs them
+func (s *fileServiceImpl) ScanPendingFiles(ctx context.Context, limit int) (int, error) {
+	if !s.scanEnabled() {
+		return 0, nil
+	}
+	scans, err := s.scanRepo.ClaimDue(ctx, time.Now(), s.scanConfig.Lease, limit)
+	if err != nil {
+		logger.Error("Failed to claim file scans", zap.Error(err))
+		return 0, errors.NewBusiness(errors.CodeInternalError, "failed to claim file scans")
+	}
+
+	for _, scan := range scans {
+		s.runScan(ctx, scan)
+	}
+	return len(scans), nil
+}
+
+// runScan streams one file to the scanner and acts on the verdict
+func (s *fileServiceImpl) runScan(ctx context.Context, scan *model.FileScan) {
+	file, err := s.fileRepo.GetByFileID(ctx, scan.FileID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		s.retryScan(ctx, scan, nil, err)
+		return
+	}
+	// deleted or purged while waiting
+	if file == nil || file.Status != model.FileStatusProcessing {
+		s.finishScan(ctx, scan, model.FileScanSkipped, "", "file removed before it was scanned")
+		return
+	}
+
+	if s.scanConfig.MaxFileBytes > 0 && file.FileSize > s.scanConfig.MaxFileBytes {
+		s.passScan(ctx, scan, file, model.ScanVerdictSkipped, fmt.Sprintf("larger than the %d byte scan limit", s.scanConfig.MaxFileBytes))
+		return
+	}
+
+	verdict, err := s.scanObject(ctx, file)
+	switch {
+	case stderrors.Is(err, scanner.ErrSizeLimit):
+		s.passScan(ctx, scan, file, model.ScanVerdictSkipped, err.Error())
+	case err != nil:
+		s.retryScan(ctx, scan, file, err)
+	case verdict.Infected:
+		s.quarantineFile(ctx, scan, file, model.ScanVerdictInfected, verdict.Signature, "")
+	default:
+		s.passScan(ctx, scan, file, model.ScanVerdictClean, "")
+	}
+}
+
+// scanObject streams the stored object to the scanner
+func (s *fileServiceImpl) scanObject(ctx context.Context, file *model.File) (*scanner.Verdict, error) {
+	object, err := s.minioClient.GetObject(ctx, file.BucketName, file.StoragePath)
+	if err != nil {
+		return nil, fmt.Errorf("get object: %w", err)
+	}
+	defer object.Close()
+	return s.fileScanner.Scan(ctx, object)
+}
+
+// passScan activates a file the scanner let through. A failed activation is retried by the next scan,
+// unless it was refused, e.g. over quota, then the uploader completes the upload again after freeing space
+func (s *fileServiceImpl) passScan(ctx context.Context, scan *model.FileScan, file *model.File, verdict, detail string) {
+	_, err := s.activateFile(ctx, file)
+	if bizErr, ok := err.(*errors.Business); ok && bizErr.Code == errors.CodeInternalError {
+		s.retryScan(ctx, scan, file, err)
+		return
+	}
+
+	status := model.FileScanClean
+	if verdict == model.ScanVerdictSkipped {
+		status = model.FileScanSkipped
+	}
+	s.finishScan(ctx, scan, status, "", detail)
+	s.recordVerdict(ctx, &model.FileScanVerdict{
+		FileID:  file.FileID,
+		Verdict: verdict,
+		Scanner: s.fileScanner.Name(),
+		Detail:  detail,
+	})
+
+	if err != nil {
+		logger.Warn("Scanned file was not activated",
+			zap.String("fileId", file.FileID),
+			zap.Error(err))
+		s.publishFileNotification(file, notification.TypeFileProcessing, map[string]interface{}{
+			"file_id": file.FileID,
+			"status":  "failed",
+			"reason":  err.Error(),
+		})
+	}
+}
+
+// retryScan schedules the next attempt, a file the scanner keeps failing on is quarantined for review
+// instead of being let through
+func (s *fileServiceImpl) retryScan(ctx context.Context, scan *model.FileScan, file *model.File, cause error) {
+	if file != nil && scan.Attempts >= s.scanConfig.MaxAttempts {
+		logger.Warn("File scan failed",
+			zap.String("fileId", scan.FileID),
+			zap.Int("attempts", scan.Attempts),
+			zap.Error(cause))
+		s.quarantineFile(ctx, scan, file, model.ScanVerdictError, "", cause.Error())
+		return
+	}
+
+	delay := s.scanConfig.RetryBackoff * time.Duration(scan.Attempts*scan.Attempts)
+	logger.Warn("File scan attempt failed, will retry",
+		zap.String("fileId", scan.FileID),
+		zap.Int("attempts", scan.Attempts),
+		zap.Duration("delay", delay),
+		zap.Error(cause))
+	if err := s.scanRepo.MarkRetry(ctx, scan.ID, time.Now().Add(delay), cause.Error()); err != nil {
+		logger.Error("Failed to schedule file scan retry",
+			zap.String("fileId", scan.FileID),
+			zap.Error(err))
+	}
+}
+
+// quarantineFile moves the object into the quarantine bucket, where no download URL is issued, and tells
+// the uploader. The record keeps pointing at the object, so deleting the file later removes it there
+func (s *fileServiceImpl) quarantineFile(ctx context.Context, scan *model.FileScan, file *model.File, verdict, signature, detail string) {
+	sourceBucket := file.BucketName
+	if _, err := s.minioClient.CopyObject(ctx, model.BucketQuarantine, file.StoragePath, sourceBucket, file.StoragePath); err != nil {
+		s.retryQuarantine(ctx, scan, fmt.Errorf("copy to quarantine: %w", err))
+		return
+	}
+
+	var deletions []*model.FileObjectDeletion
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		quarantined, err := s.fileRepo.WithTx(tx).CompareAndSetStatus(ctx, file.FileID, model.FileStatusProcessing, model.FileStatusQuarantined)
+		if err != nil {
+			return err
+		}
+		if !quarantined {
+			// deleted meanwhile, only the copy is left to remove
+			deletions, err = s.scheduleObjectDeletion(ctx, tx, model.BucketQuarantine, []string{file.StoragePath})
+			return err
+		}
+		file.Status = model.FileStatusQuarantined
+		file.BucketName = model.BucketQuarantine
+		if err := s.fileRepo.WithTx(tx).Update(ctx, file); err != nil {
+			return err
+		}
+		deletions, err = s.scheduleObjectDeletion(ctx, tx, sourceBucket, []string{file.StoragePath})
+		return err
+	})
+	if err != nil {
+		s.retryQuarantine(ctx, scan, err)
+		return
+	}
+	s.removeObjects(ctx, deletions)
+
+	if file.Status != model.FileStatusQuarantined {
+		s.finishScan(ctx, scan, model.FileScanSkipped, "", "file removed before it was quarantined")
+		return
+	}
+	s.finishScan(ctx, scan, model.FileScanQuarantined, signature, detail)
+	s.recordVerdict(ctx, &model.FileScanVerdict{
+		FileID:    file.FileID,
+		Verdict:   verdict,
+		Scanner:   s.fileScanner.Name(),
+		Signature: signature,
+		Detail:    detail,
+	})
+	logger.Warn("File quarantined",
+		zap.String("fileId", file.FileID),
+		zap.String("userId", file.UserID),
+		zap.String("verdict", verdict),
+		zap.String("signature", signature))
+
+	reason := "infected"
+	if verdict == model.ScanVerdictError {
+		reason = "scan_failed"
+	}
+	s.publishFileNotification(file, notification.TypeFileQuarantined, map[string]interface{}{
+		"file_id":   file.FileID,
+		"file_name": file.FileName,
+		"reason":    reason,
+		"signature": signature,
+	})
+}
+
+// retryQuarantine tries the quarantine again later, the object stays unreachable meanwhile since the
+// file is still pending
+func (s *fileServiceImpl) retryQuarantine(ctx context.Context, scan *model.FileScan, cause error) {
+	logger.Error("Failed to quarantine file, will retry",
+		zap.String("fileId", scan.FileID),
+		zap.Error(cause))
+	if err := s.scanRepo.MarkRetry(ctx, scan.ID, time.Now().Add(s.scanConfig.RetryBackoff), cause.Error()); err != nil {
+		logger.Error("Failed to schedule file scan retry",
+			zap.String("fileId", scan.FileID),
+			zap.Error(err))
+	}
+}
+
+// finishScan records the final status of a scan
+func (s *fileServiceImpl) finishScan(ctx context.Context, scan *model.FileScan, status model.FileScanStatus, signature, detail string) {
+	if err := s.scanRepo.Finish(ctx, scan.ID, status, s.fileScanner.Name(), signature, detail); err != nil {
+		logger.Error("Failed to record file scan result",
+			zap.String("fileId", scan.FileID),
+			zap.Error(err))
+	}
+}
+
+// recordVerdict appends to the verdict audit trail
+func (s *fileServiceImpl) recordVerdict(ctx context.Context, verdict *model.FileScanVerdict) {
+	if err := s.scanRepo.AddVerdict(ctx, verdict); err != nil {
+		logger.Error("Failed to record file scan verdict",
+			zap.String("fileId", verdict.FileID),
+			zap.String("verdict", verdict.Verdict),
+			zap.Error(err))
+	}
+}
+
+// ListQuarantinedFiles lists files waiting for review, optionally of one uploader
+func (s *fileServiceImpl) ListQuarantinedFiles(ctx context.Context, userID string, page, pageSize int) (*dto.ListQuarantinedFilesResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	scans, total, err := s.scanRepo.ListByStatus(ctx, model.FileScanQuarantined, userID, page, pageSize)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to list quarantined files")
+	}
+	fileIDs := make([]string, 0, len(scans))
+	for _, scan := range scans {
+		fileIDs = append(fileIDs, scan.FileID)
+	}
+	files, err := s.fileRepo.BatchGetByFileIDs(ctx, fileIDs)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get files")
+	}
+	verdicts, err := s.scanRepo.ListVerdicts(ctx, fileIDs)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to list scan verdicts")
+	}
+
+	fileByID := make(map[string]*model.File, len(files))
+	for _, file := range files {
+		fileByID[file.FileID] = file
+	}
+	verdictsByFile := make(map[string][]*model.FileScanVerdict, len(scans))
+	for _, verdict := range verdicts {
+		verdictsByFile[verdict.FileID] = append(verdictsByFile[verdict.FileID], verdict)
+	}
+
+	resp := &dto.ListQuarantinedFilesResponse{Files: make([]*dto.QuarantinedFileResponse, 0, len(scans)), Total: total}
+	for _, scan := range scans {
+		file, ok := fileByID[scan.FileID]
+		if !ok {
+			continue
+		}
+		resp.Files = append(resp.Files, s.toQuarantinedFileResponse(file, scan, verdictsByFile[scan.FileID]))
+	}
+	return resp, nil
+}
+
+// GetQuarantinedFile gets a scanned file with its verdict history
+func (s *fileServiceImpl) GetQuarantinedFile(ctx context.Context, fileID string) (*dto.QuarantinedFileResponse, error) {
+	file, scan, err := s.getScannedFile(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	return s.scannedFileResponse(ctx, file, scan)
+}
+
+// ReleaseQuarantinedFile moves a false positive back to its bucket and activates it like a clean upload
+func (s *fileServiceImpl) ReleaseQuarantinedFile(ctx context.Context, req *dto.ReviewQuarantinedFileRequest) (*dto.QuarantinedFileResponse, error) {
+	file, scan, err := s.getQuarantinedFile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// copy back first, the quarantined record never points at a missing object
+	if _, err := s.minioClient.CopyObject(ctx, scan.SourceBucket, file.StoragePath, file.BucketName, file.StoragePath); err != nil {
+		logger.Error("Failed to restore quarantined object",
+			zap.String("fileId", file.FileID),
+			zap.Error(err))
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to restore file")
+	}
+
+	var deletions []*model.FileObjectDeletion
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		reviewed, err := s.scanRepo.WithTx(tx).Review(ctx, file.FileID, model.FileScanQuarantined, model.FileScanReleased, req.AdminID)
+		if err != nil {
+			return err
+		}
+		if !reviewed {
+			return errors.NewBusiness(errors.CodeInvalidFileID, "file is not quarantined")
+		}
+		// back to pending, activation below charges the quota and queues processing as for a clean upload
+		released, err := s.fileRepo.WithTx(tx).CompareAndSetStatus(ctx, file.FileID, model.FileStatusQuarantined, model.FileStatusProcessing)
+		if err != nil {
+			return err
+		}
+		if !released {
+			return errors.NewBusiness(errors.CodeInvalidFileID, "file is not quarantined")
+		}
+		file.Status = model.FileStatusProcessing
+		file.BucketName = scan.SourceBucket
+		if err := s.fileRepo.WithTx(tx).Update(ctx, file); err != nil {
+			return err
+		}
+		deletions, err = s.scheduleObjectDeletion(ctx, tx, model.BucketQuarantine, []string{file.StoragePath})
+		return err
+	})
+	if err != nil {
+		if _, ok := err.(*errors.Business); ok {
+			return nil, err
+		}
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to release file")
+	}
+	s.removeObjects(ctx, deletions)
+	s.recordVerdict(ctx, &model.FileScanVerdict{
+		FileID:  file.FileID,
+		Verdict: model.ScanVerdictReleased,
+		Detail:  req.Note,
+		AdminID: req.AdminID,
+	})
+
+	// over quota the file stays pending, the uploader completes the upload again after freeing space
+	if _, err := s.activateFile(ctx, file); err != nil {
+		logger.Warn("Released file was not activated",
+			zap.String("fileId", file.FileID),
+			zap.Error(err))
+	}
+	return s.GetQuarantinedFile(ctx, file.FileID)
+}
+
+// DeleteQuarantinedFile deletes a quarantined file together with its object in the quarantine bucket
+func (s *fileServiceImpl) DeleteQuarantinedFile(ctx context.Context, req *dto.ReviewQuarantinedFileRequest) (*dto.QuarantinedFileResponse, error) {
+	file, _, err := s.getQuarantinedFile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var deletions []*model.FileObjectDeletion
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		reviewed, err := s.scanRepo.WithTx(tx).Review(ctx, file.FileID, model.FileScanQuarantined, model.FileScanRejected, req.AdminID)
+		if err != nil {
+			return err
+		}
+		if !reviewed {
+			return errors.NewBusiness(errors.CodeInvalidFileID, "file is not quarantined")
+		}
+		if _, err := s.fileRepo.WithTx(tx).Delete(ctx, file.FileID); err != nil {
+			return err
+		}
+		// a quarantined file has no blob and was never charged
+		deletions, err = s.releaseFileObjects(ctx, tx, file)
+		return err
+	})
+	if err != nil {
+		if _, ok := err.(*errors.Business); ok {
+			return nil, err
+		}
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to delete file")
+	}
+	s.removeObjects(ctx, deletions)
+	s.recordVerdict(ctx, &model.FileScanVerdict{
+		FileID:  file.FileID,
+		Verdict: model.ScanVerdictDeleted,
+		Detail:  req.Note,
+		AdminID: req.AdminID,
+	})
+	return s.GetQuarantinedFile(ctx, file.FileID)
+}
+
+// getQuarantinedFile validates a review request and gets the file waiting for it
+func (s *fileServiceImpl) getQuarantinedFile(ctx context.Context, req *dto.ReviewQuarantinedFileRequest) (*model.File, *model.FileScan, error) {
+	if req.FileID == "" || req.AdminID == "" {
+		return nil, nil, errors.NewBusiness(errors.CodeParamError, "file_id and admin_id are required")
+	}
+	file, scan, err := s.getScannedFile(ctx, req.FileID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if file.Status != model.FileStatusQuarantined || scan.Status != model.FileScanQuarantined {
+		return nil, nil, errors.NewBusiness(errors.CodeInvalidFileID, "file is not quarantined")
+	}
+	return file, scan, nil
+}
+
+// getScannedFile gets a file and its scan
+func (s *fileServiceImpl) getScannedFile(ctx context.Context, fileID string) (*model.File, *model.FileScan, error) {
+	file, err := s.fileRepo.GetByFileID(ctx, fileID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil, errors.NewBusiness(errors.CodeFileNotFound, "file not found")
+		}
+		return nil, nil, errors.NewBusiness(errors.CodeInternalError, "failed to get file")
+	}
+	scan, err := s.scanRepo.GetByFileID(ctx, fileID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil, errors.NewBusiness(errors.CodeFileNotFound, "file was not scanned")
+		}
+		return nil, nil, errors.NewBusiness(errors.CodeInternalError, "failed to get file scan")
+	}
+	return file, scan, nil
+}
+
+// scannedFileResponse loads the verdict history of a scanned file
+func (s *fileServiceImpl) scannedFileResponse(ctx context.Context, file *model.File, scan *model.FileScan) (*dto.QuarantinedFileResponse, error) {
+	verdicts, err := s.scanRepo.ListVerdicts(ctx, []string{file.FileID})
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to list scan verdicts")
+	}
+	return s.toQuarantinedFileResponse(file, scan, verdicts), nil
+}
+
+// toQuarantinedFileResponse converts to DTO
+func (s *fileServiceImpl) toQuarantinedFileResponse(file *model.File, scan *model.FileScan, verdicts []*model.FileScanVerdict) *dto.QuarantinedFileResponse {
+	resp := &dto.QuarantinedFileResponse{
+		File:       s.toFileInfoResponse(file),
+		ScanStatus: int32(scan.Status),
+		Scanner:    scan.Scanner,
+		Signature:  scan.Signature,
+		LastError:  scan.LastError,
+		Attempts:   int32(scan.Attempts),
+		ReviewedBy: scan.ReviewedBy,
+		Verdicts:   make([]*dto.FileScanVerdictResponse, 0, len(verdicts)),
+	}
+	if scan.ScannedAt != nil {
+		scannedAt := scan.ScannedAt.Unix()
+		resp.ScannedAt = &scannedAt
+	}
+	if scan.ReviewedAt != nil {
+		reviewedAt := scan.ReviewedAt.Unix()
+		resp.ReviewedAt = &reviewedAt
+	}
+	for _, verdict := range verdicts {
+		resp.Verdicts = append(resp.Verdicts, &dto.FileScanVerdictResponse{
+			Verdict:   verdict.Verdict,
+			Scanner:   verdict.Scanner,
+			Signature: verdict.Signature,
+			Detail:    verdict.Detail,
+			AdminID:   verdict.AdminID,
+			CreatedAt: verdict.CreatedAt.Unix(),
+		})
+	}
+	return resp
+}
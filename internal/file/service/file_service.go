@@ -2,17 +2,22 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
+	grouppb "github.com/anychat/server/api/proto/group"
 	"github.com/anychat/server/internal/file/dto"
 	"github.com/anychat/server/internal/file/model"
 	"github.com/anychat/server/internal/file/repository"
+	"github.com/anychat/server/internal/file/scanner"
+	"github.com/anychat/server/pkg/logger"
 	minioclient "github.com/anychat/server/pkg/minio"
+	"github.com/anychat/server/pkg/notification"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 
 	"github.com/anychat/server/pkg/errors"
@@ -29,6 +34,9 @@ type FileService interface {
 	// GenerateDownloadURL generates download URL
 	GenerateDownloadURL(ctx context.Context, fileID, userID string, expiresMinutes *int32) (*dto.GenerateDownloadURLResponse, error)
 
+	// BatchGenerateDownloadURLs generates download URLs of the readable, active files among fileIDs
+	BatchGenerateDownloadURLs(ctx context.Context, fileIDs []string, userID string, expiresMinutes *int32) ([]*dto.GenerateDownloadURLResponse, error)
+
 	// GetFileInfo gets file info
 	GetFileInfo(ctx context.Context, fileID, userID string) (*dto.FileInfoResponse, error)
 
@@ -40,21 +48,148 @@ type FileService interface {
 
 	// BatchGetFileInfo batch gets file info
 	BatchGetFileInfo(ctx context.Context, fileIDs []string, userID string) ([]*dto.FileInfoResponse, error)
+
+	// EraseUserData deletes all files of a deleted account from storage
+	EraseUserData(ctx context.Context, userID string) (map[string]int64, error)
+
+	// InitiateMultipartUpload starts a resumable multipart upload
+	InitiateMultipartUpload(ctx context.Context, userID string, req *dto.InitiateMultipartUploadRequest) (*dto.MultipartUploadResponse, error)
+
+	// GetPartUploadURLs presigns upload URLs for parts of a multipart upload
+	GetPartUploadURLs(ctx context.Context, uploadID, userID string, partNumbers []int32) (*dto.PartUploadURLsResponse, error)
+
+	// ListUploadedParts lists the stored parts of a multipart upload
+	ListUploadedParts(ctx context.Context, uploadID, userID string) (*dto.UploadedPartsResponse, error)
+
+	// CompleteMultipartUpload assembles the parts and activates the file
+	CompleteMultipartUpload(ctx context.Context, uploadID, userID string) (*dto.FileInfoResponse, error)
+
+	// AbortMultipartUpload cancels a multipart upload
+	AbortMultipartUpload(ctx context.Context, uploadID, userID string) error
+
+	// CleanupExpiredUploads aborts expired multipart uploads (called by the cleanup worker)
+	CleanupExpiredUploads(ctx context.Context, limit int) (int, error)
+
+	// GrantMessageFileAccess links the files of a sent message to its conversation (called by message-service)
+	GrantMessageFileAccess(ctx context.Context, req *dto.GrantMessageFileAccessRequest) (*dto.GrantMessageFileAccessResponse, error)
+
+	// RevokeMessageFileAccess revokes the grants of recalled or deleted messages (called by message-service)
+	RevokeMessageFileAccess(ctx context.Context, messageIDs []string, reason string) (int64, error)
+
+	// ProcessPendingFiles runs due thumbnail and metadata jobs (called by the processing worker)
+	ProcessPendingFiles(ctx context.Context, limit int) (int, error)
+
+	// GetStorageUsage gets the storage usage and quota of the user, or of a group the user belongs to
+	GetStorageUsage(ctx context.Context, userID, groupID string) (*dto.StorageUsageResponse, error)
+
+	// GetOwnerStorageUsage gets the storage usage and quota of any user or group (admin)
+	GetOwnerStorageUsage(ctx context.Context, ownerType model.StorageOwnerType, ownerID string) (*dto.StorageUsageResponse, error)
+
+	// SetStorageQuota changes the tier or quota override of a user or group (admin)
+	SetStorageQuota(ctx context.Context, req *dto.SetStorageQuotaRequest) (*dto.StorageUsageResponse, error)
+
+	// WarnExpiringFiles notifies uploaders of files about to expire (called by the lifecycle worker)
+	WarnExpiringFiles(ctx context.Context, limit int) (int, error)
+
+	// ExpireFiles deletes files past their expiry (called by the lifecycle worker)
+	ExpireFiles(ctx context.Context, limit int) (int, error)
+
+	// PurgeAbandonedUploads deletes pending files whose upload was never completed (called by the lifecycle worker)
+	PurgeAbandonedUploads(ctx context.Context, limit int) (int, error)
+
+	// RetryObjectDeletions retries failed MinIO object removals (called by the lifecycle worker)
+	RetryObjectDeletions(ctx context.Context, limit int) (int, error)
+
+	// SetAvatar crops and resizes an uploaded image into the public avatar of a user or group
+	SetAvatar(ctx context.Context, req *dto.SetAvatarRequest) (*dto.AvatarResponse, error)
+
+	// RemoveAvatar deletes the avatar images of a user or group
+	RemoveAvatar(ctx context.Context, ownerType model.StorageOwnerType, ownerID string) error
+
+	// GenerateGroupAvatar composes the default avatar of a group from member avatars (called by group-service)
+	GenerateGroupAvatar(ctx context.Context, groupID string, memberIDs []string) (*dto.AvatarResponse, error)
+
+	// ScanPendingFiles runs due malware scans of completed uploads (called by the scan worker)
+	ScanPendingFiles(ctx context.Context, limit int) (int, error)
+
+	// ListQuarantinedFiles lists files waiting for review after the malware scan (admin)
+	ListQuarantinedFiles(ctx context.Context, userID string, page, pageSize int) (*dto.ListQuarantinedFilesResponse, error)
+
+	// GetQuarantinedFile gets a scanned file with its verdict history (admin)
+	GetQuarantinedFile(ctx context.Context, fileID string) (*dto.QuarantinedFileResponse, error)
+
+	// ReleaseQuarantinedFile lets a quarantined file through as a false positive (admin)
+	ReleaseQuarantinedFile(ctx context.Context, req *dto.ReviewQuarantinedFileRequest) (*dto.QuarantinedFileResponse, error)
+
+	// DeleteQuarantinedFile deletes a quarantined file for good (admin)
+	DeleteQuarantinedFile(ctx context.Context, req *dto.ReviewQuarantinedFileRequest) (*dto.QuarantinedFileResponse, error)
 }
 
 // fileServiceImpl file service implementation
 type fileServiceImpl struct {
-	fileRepo    repository.FileRepository
-	minioClient *minioclient.Client
-	db          *gorm.DB
+	fileRepo         repository.FileRepository
+	uploadRepo       repository.FileUploadRepository
+	grantRepo        repository.FileGrantRepository
+	jobRepo          repository.FileProcessingJobRepository
+	blobRepo         repository.FileBlobRepository
+	usageRepo        repository.StorageUsageRepository
+	deletionRepo     repository.FileObjectDeletionRepository
+	scanRepo         repository.FileScanRepository
+	minioClient      *minioclient.Client
+	fileScanner      scanner.Scanner
+	groupClient      grouppb.GroupServiceClient
+	notificationPub  notification.Publisher
+	db               *gorm.DB
+	multipartConfig  MultipartConfig
+	processingConfig ProcessingConfig
+	quotaConfig      QuotaConfig
+	lifecycleConfig  LifecycleConfig
+	avatarConfig     AvatarConfig
+	scanConfig       ScanConfig
 }
 
 // NewFileService creates file service
-func NewFileService(fileRepo repository.FileRepository, minioClient *minioclient.Client, db *gorm.DB) FileService {
+func NewFileService(
+	fileRepo repository.FileRepository,
+	uploadRepo repository.FileUploadRepository,
+	grantRepo repository.FileGrantRepository,
+	jobRepo repository.FileProcessingJobRepository,
+	blobRepo repository.FileBlobRepository,
+	usageRepo repository.StorageUsageRepository,
+	deletionRepo repository.FileObjectDeletionRepository,
+	scanRepo repository.FileScanRepository,
+	minioClient *minioclient.Client,
+	fileScanner scanner.Scanner,
+	groupClient grouppb.GroupServiceClient,
+	notificationPub notification.Publisher,
+	db *gorm.DB,
+	multipartConfig MultipartConfig,
+	processingConfig ProcessingConfig,
+	quotaConfig QuotaConfig,
+	lifecycleConfig LifecycleConfig,
+	avatarConfig AvatarConfig,
+	scanConfig ScanConfig,
+) FileService {
 	return &fileServiceImpl{
-		fileRepo:    fileRepo,
-		minioClient: minioClient,
-		db:          db,
+		fileRepo:         fileRepo,
+		uploadRepo:       uploadRepo,
+		grantRepo:        grantRepo,
+		jobRepo:          jobRepo,
+		blobRepo:         blobRepo,
+		usageRepo:        usageRepo,
+		deletionRepo:     deletionRepo,
+		scanRepo:         scanRepo,
+		minioClient:      minioClient,
+		fileScanner:      fileScanner,
+		groupClient:      groupClient,
+		notificationPub:  notificationPub,
+		db:               db,
+		multipartConfig:  multipartConfig,
+		processingConfig: processingConfig,
+		quotaConfig:      quotaConfig,
+		lifecycleConfig:  lifecycleConfig,
+		avatarConfig:     avatarConfig,
+		scanConfig:       scanConfig,
 	}
 }
 
@@ -66,6 +201,21 @@ func (s *fileServiceImpl) GenerateUploadToken(ctx context.Context, userID string
 		return nil, err
 	}
 
+	// files shared in a group count against the group's quota
+	if req.GroupID != "" {
+		if err := s.checkGroupUpload(ctx, req.GroupID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	// a declared hash enables instant upload and is verified on completion
+	var sha256 string
+	if req.SHA256 != "" {
+		if sha256 = normalizeSHA256(req.SHA256); sha256 == "" {
+			return nil, errors.NewBusiness(errors.CodeParamError, "invalid sha256")
+		}
+	}
+
 	// generate unique file_id
 	fileID := fmt.Sprintf("file-%s", uuid.New().String())
 
@@ -78,12 +228,6 @@ func (s *fileServiceImpl) GenerateUploadToken(ctx context.Context, userID string
 	ext := s.getFileExtension(req.FileName)
 	storagePath := fmt.Sprintf("%s/%s/%s.%s", userID, dateStr, uuid.New().String(), ext)
 
-	// generate presigned upload URL (1 hour validity)
-	uploadURL, err := s.minioClient.PresignedPutObject(ctx, bucketName, storagePath, time.Hour)
-	if err != nil {
-		return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to generate upload URL")
-	}
-
 	// calculate expiration time
 	var expiresAt *time.Time
 	if req.ExpiresHours != nil && *req.ExpiresHours > 0 {
@@ -102,18 +246,53 @@ func (s *fileServiceImpl) GenerateUploadToken(ctx context.Context, userID string
 		StoragePath: storagePath,
 		BucketName:  bucketName,
 		Status:      model.FileStatusProcessing,
+		SHA256:      sha256,
+		GroupID:     req.GroupID,
 		CreatedAt:   now,
 		ExpiresAt:   expiresAt,
 	}
 
+	if err := s.checkStorageQuota(ctx, file); err != nil {
+		return nil, err
+	}
+
+	// identical content already stored: reference it instead of uploading again
+	if sha256 != "" {
+		instant, err := s.instantUpload(ctx, file)
+		if err != nil {
+			if _, ok := err.(*errors.Business); ok {
+				return nil, err
+			}
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to create file record")
+		}
+		if instant {
+			return &dto.GenerateUploadTokenResponse{
+				FileID:        fileID,
+				InstantUpload: true,
+			}, nil
+		}
+	}
+
+	// generate presigned upload URL (1 hour validity), the signature pins the declared size and type
+	uploadURL, uploadHeaders, err := s.minioClient.PresignedPutObjectWithHeaders(ctx, bucketName, storagePath, time.Hour, req.FileSize, req.MimeType)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to generate upload URL")
+	}
+
 	if err := s.fileRepo.Create(ctx, file); err != nil {
 		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to create file record")
 	}
 
+	headers := make(map[string]string, len(uploadHeaders))
+	for name := range uploadHeaders {
+		headers[name] = uploadHeaders.Get(name)
+	}
+
 	return &dto.GenerateUploadTokenResponse{
-		FileID:    fileID,
-		UploadURL: uploadURL.String(),
-		ExpiresIn: 3600, // 1 hour
+		FileID:        fileID,
+		UploadURL:     uploadURL.String(),
+		ExpiresIn:     3600, // 1 hour
+		UploadHeaders: headers,
 	}, nil
 }
 
@@ -133,55 +312,184 @@ func (s *fileServiceImpl) CompleteUpload(ctx context.Context, fileID, userID str
 		return nil, errors.NewBusiness(errors.CodeInvalidFileID, "file already completed or deleted")
 	}
 
+	// completed before and handed to the malware scan
+	if s.scanEnabled() {
+		if resp, handled, err := s.resumeScannedUpload(ctx, file); handled {
+			return resp, err
+		}
+	}
+
 	// validate file exists in MinIO
-	_, err = s.minioClient.StatObject(ctx, file.BucketName, file.StoragePath)
+	objectInfo, err := s.minioClient.StatObject(ctx, file.BucketName, file.StoragePath)
 	if err != nil {
 		return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "file not found in storage")
 	}
 
-	// update status to active
+	// check the real size and the magic bytes, a mismatching object is deleted
+	mimeType, err := s.verifyUploadedContent(ctx, file, objectInfo.Size)
+	if err != nil {
+		if _, ok := err.(*errors.Business); !ok {
+			return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to read uploaded file")
+		}
+		s.discardRejectedUpload(ctx, file, err)
+		return nil, err
+	}
+	file.MimeType = mimeType
+
+	// verify the declared hash before the content can be shared with other uploads
+	if file.SHA256 != "" {
+		sum, err := s.objectSHA256(ctx, file.BucketName, file.StoragePath)
+		if err != nil {
+			return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to read uploaded file")
+		}
+		if sum != file.SHA256 {
+			return nil, errors.NewBusiness(errors.CodeFileHashMismatch, "uploaded content does not match sha256")
+		}
+	}
+
+	// the file stays pending until the scan finds it clean
+	if s.scanEnabled() {
+		return s.submitForScan(ctx, file)
+	}
+	return s.activateFile(ctx, file)
+}
+
+// activateFile makes a verified upload active: shares stored content with identical files, charges the
+// owner's quota and queues thumbnails and metadata extraction
+func (s *fileServiceImpl) activateFile(ctx context.Context, file *model.File) (*dto.FileInfoResponse, error) {
+	uploadedPath := file.StoragePath
+	reused, copied := false, false
 	file.Status = model.FileStatusActive
-	if err := s.fileRepo.Update(ctx, file); err != nil {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// a concurrent completion or the abandoned upload purge got there first
+		activated, err := s.fileRepo.WithTx(tx).CompareAndSetStatus(ctx, file.FileID, model.FileStatusProcessing, model.FileStatusActive)
+		if err != nil {
+			return err
+		}
+		if !activated {
+			return errors.NewBusiness(errors.CodeInvalidFileID, "file already completed or deleted")
+		}
+		if file.SHA256 != "" {
+			if reused, err = s.attachBlob(ctx, tx, file); err != nil {
+				return err
+			}
+			if reused {
+				if copied, err = s.copyProcessedMedia(ctx, tx, file); err != nil {
+					return err
+				}
+			}
+		}
+		if err := s.chargeStorage(ctx, tx, file); err != nil {
+			return err
+		}
+		if err := s.fileRepo.WithTx(tx).Update(ctx, file); err != nil {
+			return err
+		}
+		if copied {
+			return nil
+		}
+		return s.jobRepo.WithTx(tx).Enqueue(ctx, file.FileID)
+	})
+	if err != nil {
+		// over quota the upload stays pending and can be completed again after freeing space
+		if _, ok := err.(*errors.Business); ok {
+			return nil, err
+		}
 		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to update file status")
 	}
 
+	// the same content was stored meanwhile, drop this copy
+	if reused {
+		s.deleteObjects(ctx, file.BucketName, uploadedPath)
+	}
+	if copied {
+		s.publishUploadCompleted(file)
+	}
+
 	// return file info
 	return s.toFileInfoResponse(file), nil
 }
 
 // GenerateDownloadURL generates download URL
 func (s *fileServiceImpl) GenerateDownloadURL(ctx context.Context, fileID, userID string, expiresMinutes *int32) (*dto.GenerateDownloadURLResponse, error) {
-	// validate permission
-	file, err := s.fileRepo.GetByFileIDAndUserID(ctx, fileID, userID)
+	// validate permission: uploader, or participant of a conversation the file was sent to
+	file, err := s.getReadableFile(ctx, fileID, userID)
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, errors.NewBusiness(errors.CodeFileNotFound, "file not found")
-		}
-		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get file")
+		return nil, err
+	}
+
+	// check if file is expired, also once the lifecycle worker has deleted it
+	if file.ExpiresAt != nil && file.ExpiresAt.Before(time.Now()) {
+		return nil, errors.NewBusiness(errors.CodeFileExpired, "file has expired")
 	}
 
 	// validate file status
+	if file.Status == model.FileStatusQuarantined {
+		return nil, errors.NewBusiness(errors.CodeFileQuarantined, "file is quarantined")
+	}
 	if file.Status != model.FileStatusActive {
 		return nil, errors.NewBusiness(errors.CodeFileNotFound, "file is not active")
 	}
 
-	// check if file is expired
-	if file.ExpiresAt != nil && file.ExpiresAt.Before(time.Now()) {
-		return nil, errors.NewBusiness(errors.CodeFileExpired, "file has expired")
+	return s.presignDownload(ctx, file, downloadExpiry(expiresMinutes))
+}
+
+// BatchGenerateDownloadURLs generates download URLs of several files, e.g. for a media gallery.
+// Files the user cannot read, expired and inactive files are left out instead of failing the batch
+func (s *fileServiceImpl) BatchGenerateDownloadURLs(ctx context.Context, fileIDs []string, userID string, expiresMinutes *int32) ([]*dto.GenerateDownloadURLResponse, error) {
+	fileIDs = uniqueStrings(fileIDs)
+	if len(fileIDs) == 0 {
+		return []*dto.GenerateDownloadURLResponse{}, nil
+	}
+	if len(fileIDs) > maxBatchDownloadURLs {
+		return nil, errors.NewBusiness(errors.CodeParamError, "too many files in one batch")
+	}
+
+	files, err := s.fileRepo.BatchGetByFileIDs(ctx, fileIDs)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to batch get files")
+	}
+
+	now := time.Now()
+	expires := downloadExpiry(expiresMinutes)
+	urls := make([]*dto.GenerateDownloadURLResponse, 0, len(files))
+	for _, file := range files {
+		if file.Status != model.FileStatusActive || (file.ExpiresAt != nil && file.ExpiresAt.Before(now)) {
+			continue
+		}
+		readable, err := s.canReadFile(ctx, file, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !readable {
+			continue
+		}
+		resp, err := s.presignDownload(ctx, file, expires)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, resp)
 	}
+	return urls, nil
+}
 
-	// generate download URL
-	expires := 60 * time.Minute
+// downloadExpiry validity of presigned download URLs, 60 minutes unless requested otherwise
+func downloadExpiry(expiresMinutes *int32) time.Duration {
 	if expiresMinutes != nil && *expiresMinutes > 0 {
-		expires = time.Duration(*expiresMinutes) * time.Minute
+		return time.Duration(*expiresMinutes) * time.Minute
 	}
+	return 60 * time.Minute
+}
 
+// presignDownload presigns the download URL of a file and of its thumbnails
+func (s *fileServiceImpl) presignDownload(ctx context.Context, file *model.File, expires time.Duration) (*dto.GenerateDownloadURLResponse, error) {
 	downloadURL, err := s.minioClient.PresignedGetObject(ctx, file.BucketName, file.StoragePath, expires)
 	if err != nil {
 		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to generate download URL")
 	}
 
 	resp := &dto.GenerateDownloadURLResponse{
+		FileID:      file.FileID,
 		DownloadURL: downloadURL.String(),
 		ExpiresIn:   int64(expires.Seconds()),
 	}
@@ -193,18 +501,27 @@ func (s *fileServiceImpl) GenerateDownloadURL(ctx context.Context, fileID, userI
 			resp.ThumbnailURL = thumbnailURL.String()
 		}
 	}
+	for _, thumbnail := range file.Metadata.Thumbnails {
+		thumbnailURL, err := s.minioClient.PresignedGetObject(ctx, file.BucketName, thumbnail.Path, expires)
+		if err != nil {
+			continue
+		}
+		resp.Thumbnails = append(resp.Thumbnails, &dto.ThumbnailURLInfo{
+			Size:   int32(thumbnail.Size),
+			Width:  int32(thumbnail.Width),
+			Height: int32(thumbnail.Height),
+			URL:    thumbnailURL.String(),
+		})
+	}
 
 	return resp, nil
 }
 
 // GetFileInfo gets file info
 func (s *fileServiceImpl) GetFileInfo(ctx context.Context, fileID, userID string) (*dto.FileInfoResponse, error) {
-	file, err := s.fileRepo.GetByFileIDAndUserID(ctx, fileID, userID)
+	file, err := s.getReadableFile(ctx, fileID, userID)
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, errors.NewBusiness(errors.CodeFileNotFound, "file not found")
-		}
-		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get file")
+		return nil, err
 	}
 
 	return s.toFileInfoResponse(file), nil
@@ -220,29 +537,19 @@ func (s *fileServiceImpl) DeleteFile(ctx context.Context, fileID, userID string)
 		}
 		return errors.NewBusiness(errors.CodeInternalError, "failed to get file")
 	}
+	// kept for the administrator's review, deleted or released there
+	if file.Status == model.FileStatusQuarantined {
+		return errors.NewBusiness(errors.CodeFileQuarantined, "file is quarantined")
+	}
 
-	// use transaction to delete
-	return s.db.Transaction(func(tx *gorm.DB) error {
-		fileRepo := s.fileRepo.WithTx(tx)
-
-		// soft delete database record
-		if err := fileRepo.Delete(ctx, fileID); err != nil {
-			return errors.NewBusiness(errors.CodeInternalError, "failed to delete file record")
-		}
-
-		// delete MinIO object
-		if err := s.minioClient.RemoveObject(ctx, file.BucketName, file.StoragePath); err != nil {
-			// log error but don't rollback, since database record is already marked as deleted
-			// TODO: can use async task to cleanup
-		}
-
-		// delete thumbnail
-		if file.ThumbnailPath != "" {
-			_ = s.minioClient.RemoveObject(ctx, file.BucketName, file.ThumbnailPath)
-		}
-
-		return nil
-	})
+	// soft delete the record, objects are removed after commit and retried by the lifecycle worker on failure
+	if _, err := s.removeFile(ctx, file); err != nil {
+		logger.Error("Failed to delete file",
+			zap.String("fileId", fileID),
+			zap.Error(err))
+		return errors.NewBusiness(errors.CodeInternalError, "failed to delete file record")
+	}
+	return nil
 }
 
 // ListUserFiles lists user files
@@ -276,10 +583,14 @@ func (s *fileServiceImpl) BatchGetFileInfo(ctx context.Context, fileIDs []string
 		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to batch get files")
 	}
 
-	// only return files that user has permission to (belongs to the user)
+	// only return files that user has permission to (uploaded by the user or sent to the user's conversations)
 	fileInfos := make([]*dto.FileInfoResponse, 0, len(files))
 	for _, file := range files {
-		if file.UserID == userID {
+		readable, err := s.canReadFile(ctx, file, userID)
+		if err != nil {
+			return nil, err
+		}
+		if readable {
 			fileInfos = append(fileInfos, s.toFileInfoResponse(file))
 		}
 	}
@@ -315,8 +626,8 @@ func (s *fileServiceImpl) validateFileSize(fileType model.FileType, fileSize int
 
 // getBucketName gets bucket name by file type
 func (s *fileServiceImpl) getBucketName(_ model.FileType) string {
-	// currently all files are stored in chat-file bucket
-	// in the future can separate by file type
+	// uploads are stored in the chat-file bucket, also images that become avatars:
+	// the public avatar buckets only hold the processed copies written by SetAvatar
 	return model.BucketChatFile
 }
 
@@ -353,11 +664,26 @@ func (s *fileServiceImpl) toFileInfoResponse(file *model.File) *dto.FileInfoResp
 		resp.ExpiresAt = &expiresAt
 	}
 
-	// convert metadata
-	if file.Metadata.Width > 0 || file.Metadata.Height > 0 || file.Metadata.Duration > 0 || file.Metadata.Format != "" {
-		metadataBytes, _ := json.Marshal(file.Metadata)
+	// convert metadata, thumbnails are returned with the download URL
+	if !file.Metadata.IsEmpty() {
 		metadata := make(map[string]string)
-		_ = json.Unmarshal(metadataBytes, &metadata)
+		if file.Metadata.Width > 0 || file.Metadata.Height > 0 {
+			metadata["width"] = strconv.Itoa(file.Metadata.Width)
+			metadata["height"] = strconv.Itoa(file.Metadata.Height)
+		}
+		if file.Metadata.Duration > 0 {
+			metadata["duration"] = strconv.Itoa(file.Metadata.Duration)
+		}
+		if file.Metadata.Format != "" {
+			metadata["format"] = file.Metadata.Format
+		}
+		if len(file.Metadata.Thumbnails) > 0 {
+			sizes := make([]string, 0, len(file.Metadata.Thumbnails))
+			for _, thumbnail := range file.Metadata.Thumbnails {
+				sizes = append(sizes, strconv.Itoa(thumbnail.Size))
+			}
+			metadata["thumbnail_sizes"] = strings.Join(sizes, ",")
+		}
 		resp.Metadata = metadata
 	}
 
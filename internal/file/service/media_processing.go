@@ -0,0 +1,351 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anychat/server/internal/file/media"
+	"github.com/anychat/server/internal/file/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/anychat/server/pkg/notification"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// reencodeQuality JPEG quality of originals re-encoded upright when their EXIF orientation is dropped
+const reencodeQuality = 92
+
+// errUnprocessable content that will never decode, the job fails without retries
+var errUnprocessable = stderrors.New("unprocessable media")
+
+// ProcessingConfig media processing settings
+type ProcessingConfig struct {
+	ThumbnailSizes       []int         // longest edge of each thumbnail
+	DefaultThumbnailSize int           // size exposed as thumbnail_path
+	ThumbnailQuality     int           // JPEG quality of thumbnails
+	StripImageMetadata   bool          // remove EXIF/GPS, XMP and text metadata from original images
+	MaxImageBytes        int64         // larger images are not decoded
+	MaxImagePixels       int           // decompression bomb guard
+	MaxAttempts          int           // attempts before a job fails
+	RetryBackoff         time.Duration // delay after the first failure, grows quadratically
+	Lease                time.Duration // a running job is picked up again after its lease expires
+}
+
+// DefaultProcessingConfig returns the default media processing settings
+func DefaultProcessingConfig() ProcessingConfig {
+	return ProcessingConfig{
+		ThumbnailSizes:       []int{160, 480, 1080},
+		DefaultThumbnailSize: 480,
+		ThumbnailQuality:     80,
+		StripImageMetadata:   true,
+		MaxImageBytes:        50 * 1024 * 1024,
+		MaxImagePixels:       50_000_000,
+		MaxAttempts:          5,
+		RetryBackoff:         30 * time.Second,
+		Lease:                5 * time.Minute,
+	}
+}
+
+// processingResult file columns written by a processing job
+type processingResult struct {
+	fileSize      int64
+	thumbnailPath string
+	metadata      model.FileMetadata
+}
+
+// ProcessPendingFiles claims due processing jobs and runs them
+func (s *fileServiceImpl) ProcessPendingFiles(ctx context.Context, limit int) (int, error) {
+	jobs, err := s.jobRepo.ClaimDue(ctx, time.Now(), s.processingConfig.Lease, limit)
+	if err != nil {
+		logger.Error("Failed to claim processing jobs", zap.Error(err))
+		return 0, errors.NewBusiness(errors.CodeInternalError, "failed to claim processing jobs")
+	}
+
+	for _, job := range jobs {
+		s.runProcessingJob(ctx, job)
+	}
+	return len(jobs), nil
+}
+
+// runProcessingJob processes one file and records the outcome on the job
+func (s *fileServiceImpl) runProcessingJob(ctx context.Context, job *model.FileProcessingJob) {
+	file, err := s.fileRepo.GetByFileID(ctx, job.FileID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		s.retryProcessingJob(ctx, job, nil, err)
+		return
+	}
+	// deleted before it was processed
+	if file == nil || file.Status != model.FileStatusActive {
+		s.completeProcessingJob(ctx, job)
+		return
+	}
+
+	s.publishFileNotification(file, notification.TypeFileProcessing, map[string]interface{}{
+		"file_id": file.FileID,
+		"status":  "processing",
+		"attempt": job.Attempts,
+	})
+
+	result, err := s.processMedia(ctx, file)
+	if err != nil {
+		s.retryProcessingJob(ctx, job, file, err)
+		return
+	}
+
+	updated, err := s.fileRepo.UpdateProcessingResult(ctx, file.FileID, result.fileSize, result.thumbnailPath, result.metadata)
+	if err != nil {
+		s.retryProcessingJob(ctx, job, file, err)
+		return
+	}
+	if !updated {
+		// deleted while processing, its delete only removed the objects it knew about.
+		// Thumbnails of a shared blob are also those of its other files and go with the blob
+		if file.BlobID == nil {
+			thumbnailPaths := make([]string, 0, len(result.metadata.Thumbnails))
+			for _, thumbnail := range result.metadata.Thumbnails {
+				thumbnailPaths = append(thumbnailPaths, thumbnail.Path)
+			}
+			s.deleteObjects(ctx, file.BucketName, thumbnailPaths...)
+		}
+		s.completeProcessingJob(ctx, job)
+		return
+	}
+	s.completeProcessingJob(ctx, job)
+	s.adjustStorage(ctx, file, result.fileSize-file.FileSize)
+
+	file.FileSize = result.fileSize
+	file.ThumbnailPath = result.thumbnailPath
+	file.Metadata = result.metadata
+	s.publishUploadCompleted(file)
+}
+
+// publishUploadCompleted notifies the uploader that the file is ready with its metadata
+func (s *fileServiceImpl) publishUploadCompleted(file *model.File) {
+	info := s.toFileInfoResponse(file)
+	s.publishFileNotification(file, notification.TypeFileUploadCompleted, map[string]interface{}{
+		"file_id":        info.FileID,
+		"file_name":      info.FileName,
+		"file_type":      info.FileType,
+		"file_size":      info.FileSize,
+		"mime_type":      info.MimeType,
+		"metadata":       info.Metadata,
+		"thumbnail_path": info.ThumbnailPath,
+	})
+}
+
+// completeProcessingJob marks the job completed
+func (s *fileServiceImpl) completeProcessingJob(ctx context.Context, job *model.FileProcessingJob) {
+	if err := s.jobRepo.MarkCompleted(ctx, job.ID); err != nil {
+		logger.Error("Failed to mark processing job completed",
+			zap.String("fileId", job.FileID),
+			zap.Error(err))
+	}
+}
+
+// retryProcessingJob schedules the next attempt, or fails the job when the content cannot be
+// processed or the attempts are used up
+func (s *fileServiceImpl) retryProcessingJob(ctx context.Context, job *model.FileProcessingJob, file *model.File, cause error) {
+	if stderrors.Is(cause, errUnprocessable) || job.Attempts >= s.processingConfig.MaxAttempts {
+		logger.Warn("File processing failed",
+			zap.String("fileId", job.FileID),
+			zap.Int("attempts", job.Attempts),
+			zap.Error(cause))
+		if err := s.jobRepo.MarkFailed(ctx, job.ID, cause.Error()); err != nil {
+			logger.Error("Failed to mark processing job failed",
+				zap.String("fileId", job.FileID),
+				zap.Error(err))
+		}
+		if file != nil {
+			s.publishFileNotification(file, notification.TypeFileProcessing, map[string]interface{}{
+				"file_id": file.FileID,
+				"status":  "failed",
+				"attempt": job.Attempts,
+			})
+		}
+		return
+	}
+
+	delay := s.processingConfig.RetryBackoff * time.Duration(job.Attempts*job.Attempts)
+	logger.Warn("File processing attempt failed, will retry",
+		zap.String("fileId", job.FileID),
+		zap.Int("attempts", job.Attempts),
+		zap.Duration("delay", delay),
+		zap.Error(cause))
+	if err := s.jobRepo.MarkRetry(ctx, job.ID, time.Now().Add(delay), cause.Error()); err != nil {
+		logger.Error("Failed to schedule processing job retry",
+			zap.String("fileId", job.FileID),
+			zap.Error(err))
+	}
+}
+
+// processMedia reads metadata and writes thumbnails for images, audio and video; other files are left as they are
+func (s *fileServiceImpl) processMedia(ctx context.Context, file *model.File) (*processingResult, error) {
+	result := &processingResult{
+		fileSize:      file.FileSize,
+		thumbnailPath: file.ThumbnailPath,
+		metadata:      file.Metadata,
+	}
+
+	switch {
+	case file.FileType == model.FileTypeImage || strings.HasPrefix(file.MimeType, "image/"):
+		return result, s.processImage(ctx, file, result)
+	case file.FileType == model.FileTypeVideo || file.FileType == model.FileTypeAudio,
+		strings.HasPrefix(file.MimeType, "video/"), strings.HasPrefix(file.MimeType, "audio/"):
+		return result, s.processAV(ctx, file, result)
+	default:
+		return result, nil
+	}
+}
+
+// processImage strips metadata from the original, records its size and writes the thumbnails
+func (s *fileServiceImpl) processImage(ctx context.Context, file *model.File, result *processingResult) error {
+	data, err := s.readObject(ctx, file, s.processingConfig.MaxImageBytes)
+	if err != nil {
+		return err
+	}
+
+	info, err := media.DecodeImageConfig(data)
+	if err == media.ErrUnsupported {
+		// WebP, HEIC and other formats without a decoder are served as uploaded
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", errUnprocessable, err)
+	}
+	if info.Width*info.Height > s.processingConfig.MaxImagePixels {
+		return fmt.Errorf("%w: %dx%d exceeds the pixel limit", errUnprocessable, info.Width, info.Height)
+	}
+	img, info, err := media.DecodeImage(data)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errUnprocessable, err)
+	}
+
+	if s.processingConfig.StripImageMetadata {
+		stripped, changed, err := media.StripImageMetadata(data, img, info, reencodeQuality)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errUnprocessable, err)
+		}
+		if changed {
+			_, err := s.minioClient.PutObjectReader(ctx, file.BucketName, file.StoragePath, bytes.NewReader(stripped), int64(len(stripped)), file.MimeType)
+			if err != nil {
+				return fmt.Errorf("write stripped image: %w", err)
+			}
+			result.fileSize = int64(len(stripped))
+		}
+	}
+
+	sizes := append([]int(nil), s.processingConfig.ThumbnailSizes...)
+	sort.Ints(sizes)
+	longest := max(info.Width, info.Height)
+	thumbnails := make([]model.Thumbnail, 0, len(sizes))
+	thumbnailPath := ""
+	for _, size := range sizes {
+		// the original already fits, a thumbnail would only be a re-encoded copy
+		if longest <= size {
+			break
+		}
+		thumbnail := media.Thumbnail(img, info.Orientation, size)
+		encoded, err := media.EncodeJPEG(thumbnail, s.processingConfig.ThumbnailQuality)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errUnprocessable, err)
+		}
+		objectName := thumbnailObjectName(file.StoragePath, size)
+		_, err = s.minioClient.PutObjectReader(ctx, file.BucketName, objectName, bytes.NewReader(encoded), int64(len(encoded)), "image/jpeg")
+		if err != nil {
+			return fmt.Errorf("write thumbnail: %w", err)
+		}
+		thumbnails = append(thumbnails, model.Thumbnail{
+			Size:   size,
+			Width:  thumbnail.Bounds().Dx(),
+			Height: thumbnail.Bounds().Dy(),
+			Path:   objectName,
+		})
+		if size <= s.processingConfig.DefaultThumbnailSize || thumbnailPath == "" {
+			thumbnailPath = objectName
+		}
+	}
+
+	result.thumbnailPath = thumbnailPath
+	result.metadata.Width = info.Width
+	result.metadata.Height = info.Height
+	result.metadata.Format = info.Format
+	result.metadata.Thumbnails = thumbnails
+	return nil
+}
+
+// processAV reads duration and dimensions from the container headers with ranged reads
+func (s *fileServiceImpl) processAV(ctx context.Context, file *model.File, result *processingResult) error {
+	stat, err := s.minioClient.StatObject(ctx, file.BucketName, file.StoragePath)
+	if err != nil {
+		return fmt.Errorf("stat object: %w", err)
+	}
+	object, err := s.minioClient.GetObject(ctx, file.BucketName, file.StoragePath)
+	if err != nil {
+		return fmt.Errorf("get object: %w", err)
+	}
+	defer object.Close()
+
+	info, err := media.ProbeAV(object, stat.Size)
+	if err == media.ErrUnsupported {
+		// OGG, AMR, WebM and other containers keep the metadata given by the client
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	result.metadata.Width = info.Width
+	result.metadata.Height = info.Height
+	result.metadata.Format = info.Format
+	if info.Duration > 0 {
+		result.metadata.Duration = max(1, int(math.Round(info.Duration.Seconds())))
+	}
+	return nil
+}
+
+// readObject reads a whole object of at most limit bytes
+func (s *fileServiceImpl) readObject(ctx context.Context, file *model.File, limit int64) ([]byte, error) {
+	object, err := s.minioClient.GetObject(ctx, file.BucketName, file.StoragePath)
+	if err != nil {
+		return nil, fmt.Errorf("get object: %w", err)
+	}
+	defer object.Close()
+
+	data, err := io.ReadAll(io.LimitReader(object, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("read object: %w", err)
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%w: image larger than %d bytes", errUnprocessable, limit)
+	}
+	return data, nil
+}
+
+// thumbnailObjectName stores thumbnails next to the original: {path without extension}_thumb_{size}.jpg
+func thumbnailObjectName(storagePath string, size int) string {
+	return fmt.Sprintf("%s_thumb_%d.jpg", strings.TrimSuffix(storagePath, path.Ext(storagePath)), size)
+}
+
+// publishFileNotification notifies the uploader
+func (s *fileServiceImpl) publishFileNotification(file *model.File, notifType string, payload map[string]interface{}) {
+	if s.notificationPub == nil {
+		return
+	}
+
+	notif := notification.NewNotification(notifType, "", notification.PriorityNormal).WithPayload(payload)
+	if err := s.notificationPub.PublishToUser(file.UserID, notif); err != nil {
+		logger.Error("Failed to publish file notification",
+			zap.String("fileId", file.FileID),
+			zap.String("type", notifType),
+			zap.Error(err))
+	}
+}
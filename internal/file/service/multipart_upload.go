@@ -0,0 +1,525 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/anychat/server/internal/file/dto"
+	"github.com/anychat/server/internal/file/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	minioclient "github.com/anychat/server/pkg/minio"
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// maxPartURLsPerRequest part URLs presigned per GetPartUploadURLs call
+const maxPartURLsPerRequest = 100
+
+// MultipartConfig multipart upload configuration
+type MultipartConfig struct {
+	PartSize      int64         // default part size when the client does not choose one
+	UploadTTL     time.Duration // time allowed to finish an upload before it is aborted
+	PartURLExpire time.Duration // validity of presigned part URLs
+}
+
+// DefaultMultipartConfig returns the default multipart upload configuration
+func DefaultMultipartConfig() MultipartConfig {
+	return MultipartConfig{
+		PartSize:      model.DefaultPartSize,
+		UploadTTL:     24 * time.Hour,
+		PartURLExpire: time.Hour,
+	}
+}
+
+// InitiateMultipartUpload starts a resumable upload: a processing file record and a MinIO multipart upload
+func (s *fileServiceImpl) InitiateMultipartUpload(ctx context.Context, userID string, req *dto.InitiateMultipartUploadRequest) (*dto.MultipartUploadResponse, error) {
+	reqFileType := model.FileType(req.FileType)
+	if err := s.validateFileSize(reqFileType, req.FileSize); err != nil {
+		return nil, err
+	}
+	if req.GroupID != "" {
+		if err := s.checkGroupUpload(ctx, req.GroupID, userID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkStorageQuota(ctx, &model.File{UserID: userID, FileType: reqFileType, FileSize: req.FileSize, GroupID: req.GroupID}); err != nil {
+		return nil, err
+	}
+
+	partSize, totalParts, err := s.planParts(req.FileSize, req.PartSize)
+	if err != nil {
+		return nil, err
+	}
+
+	fileID := fmt.Sprintf("file-%s", uuid.New().String())
+	uploadID := fmt.Sprintf("upload-%s", uuid.New().String())
+	bucketName := s.getBucketName(reqFileType)
+	now := time.Now()
+	storagePath := fmt.Sprintf("%s/%s/%s.%s", userID, now.Format("2006-01-02"), uuid.New().String(), s.getFileExtension(req.FileName))
+
+	storageUploadID, err := s.minioClient.NewMultipartUpload(ctx, bucketName, storagePath, req.MimeType)
+	if err != nil {
+		logger.Error("Failed to start multipart upload", zap.String("userId", userID), zap.Error(err))
+		return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to start multipart upload")
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresHours != nil && *req.ExpiresHours > 0 {
+		expires := now.Add(time.Duration(*req.ExpiresHours) * time.Hour)
+		expiresAt = &expires
+	}
+
+	file := &model.File{
+		FileID:      fileID,
+		UserID:      userID,
+		FileName:    req.FileName,
+		FileType:    reqFileType,
+		FileSize:    req.FileSize,
+		MimeType:    req.MimeType,
+		StoragePath: storagePath,
+		BucketName:  bucketName,
+		Status:      model.FileStatusProcessing,
+		GroupID:     req.GroupID,
+		CreatedAt:   now,
+		ExpiresAt:   expiresAt,
+	}
+	upload := &model.FileUpload{
+		UploadID:        uploadID,
+		UserID:          userID,
+		FileID:          fileID,
+		FileName:        req.FileName,
+		FileSize:        req.FileSize,
+		ChunkSize:       partSize,
+		TotalParts:      totalParts,
+		BucketName:      bucketName,
+		StoragePath:     storagePath,
+		StorageUploadID: storageUploadID,
+		UploadedChunks:  model.ChunkInfo{Chunks: []int{}},
+		Status:          model.UploadStatusPending,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		ExpiresAt:       now.Add(s.multipartConfig.UploadTTL),
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.fileRepo.WithTx(tx).Create(ctx, file); err != nil {
+			return err
+		}
+		return s.uploadRepo.WithTx(tx).Create(ctx, upload)
+	})
+	if err != nil {
+		_ = s.minioClient.AbortMultipartUpload(ctx, bucketName, storagePath, storageUploadID)
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to create upload record")
+	}
+
+	return s.toMultipartUploadResponse(upload), nil
+}
+
+// GetPartUploadURLs presigns upload URLs for the given part numbers
+func (s *fileServiceImpl) GetPartUploadURLs(ctx context.Context, uploadID, userID string, partNumbers []int32) (*dto.PartUploadURLsResponse, error) {
+	upload, err := s.getOpenUpload(ctx, uploadID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(partNumbers) == 0 {
+		return nil, errors.NewBusiness(errors.CodeParamError, "part_numbers is required")
+	}
+	if len(partNumbers) > maxPartURLsPerRequest {
+		return nil, errors.NewBusiness(errors.CodeParamError, fmt.Sprintf("at most %d parts per request", maxPartURLsPerRequest))
+	}
+
+	parts := make([]*dto.PartUploadURL, 0, len(partNumbers))
+	seen := make(map[int32]bool, len(partNumbers))
+	for _, partNumber := range partNumbers {
+		if partNumber < 1 || int(partNumber) > upload.TotalParts {
+			return nil, errors.NewBusiness(errors.CodeParamError, fmt.Sprintf("part number must be between 1 and %d", upload.TotalParts))
+		}
+		if seen[partNumber] {
+			continue
+		}
+		seen[partNumber] = true
+
+		partURL, err := s.minioClient.PresignedUploadPartURL(ctx, upload.BucketName, upload.StoragePath, upload.StorageUploadID, int(partNumber), s.multipartConfig.PartURLExpire)
+		if err != nil {
+			return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to generate part upload URL")
+		}
+		parts = append(parts, &dto.PartUploadURL{PartNumber: partNumber, UploadURL: partURL.String()})
+	}
+
+	if upload.Status == model.UploadStatusPending {
+		if err := s.uploadRepo.UpdateProgress(ctx, upload.UploadID, model.UploadStatusUploading, upload.UploadedChunks); err != nil {
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to update upload status")
+		}
+	}
+
+	return &dto.PartUploadURLsResponse{
+		Parts:     parts,
+		ExpiresIn: int64(s.multipartConfig.PartURLExpire.Seconds()),
+	}, nil
+}
+
+// ListUploadedParts lists the parts already stored, so an interrupted client knows which parts to resend
+func (s *fileServiceImpl) ListUploadedParts(ctx context.Context, uploadID, userID string) (*dto.UploadedPartsResponse, error) {
+	upload, err := s.getUpload(ctx, uploadID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.UploadedPartsResponse{Parts: []*dto.UploadedPart{}}
+	if !upload.IsOpen() {
+		// the storage upload no longer exists, report the progress last recorded
+		resp.Upload = s.toMultipartUploadResponse(upload)
+		if upload.Status == model.UploadStatusCompleted {
+			resp.UploadedBytes = upload.FileSize
+		}
+		return resp, nil
+	}
+
+	objectParts, err := s.minioClient.ListObjectParts(ctx, upload.BucketName, upload.StoragePath, upload.StorageUploadID)
+	if err != nil {
+		if minioclient.IsNoSuchUpload(err) {
+			return nil, errors.NewBusiness(errors.CodeUploadClosed, "upload no longer exists in storage")
+		}
+		return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to list uploaded parts")
+	}
+
+	chunks := model.ChunkInfo{Chunks: make([]int, 0, len(objectParts))}
+	for _, part := range objectParts {
+		resp.Parts = append(resp.Parts, &dto.UploadedPart{
+			PartNumber: int32(part.PartNumber),
+			Size:       part.Size,
+			ETag:       part.ETag,
+		})
+		resp.UploadedBytes += part.Size
+		chunks.Chunks = append(chunks.Chunks, part.PartNumber)
+	}
+
+	// storage is the source of truth, keep the recorded progress in step with it
+	if !equalChunks(upload.UploadedChunks.Chunks, chunks.Chunks) {
+		status := upload.Status
+		if len(chunks.Chunks) > 0 {
+			status = model.UploadStatusUploading
+		}
+		if err := s.uploadRepo.UpdateProgress(ctx, upload.UploadID, status, chunks); err != nil {
+			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to update upload progress")
+		}
+		upload.Status = status
+		upload.UploadedChunks = chunks
+	}
+
+	resp.Upload = s.toMultipartUploadResponse(upload)
+	return resp, nil
+}
+
+// CompleteMultipartUpload assembles the parts into the file object and activates the file
+func (s *fileServiceImpl) CompleteMultipartUpload(ctx context.Context, uploadID, userID string) (*dto.FileInfoResponse, error) {
+	upload, err := s.getUpload(ctx, uploadID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// a repeated call after success returns the file again
+	if upload.Status == model.UploadStatusCompleted {
+		if s.scanEnabled() {
+			file, err := s.fileRepo.GetByFileID(ctx, upload.FileID)
+			if err == nil && file.Status == model.FileStatusProcessing {
+				if resp, handled, err := s.resumeScannedUpload(ctx, file); handled {
+					return resp, err
+				}
+			}
+		}
+		return s.GetFileInfo(ctx, upload.FileID, userID)
+	}
+	if !upload.IsOpen() || upload.ExpiresAt.Before(time.Now()) {
+		return nil, errors.NewBusiness(errors.CodeUploadClosed, "upload has expired or was aborted")
+	}
+
+	objectParts, err := s.minioClient.ListObjectParts(ctx, upload.BucketName, upload.StoragePath, upload.StorageUploadID)
+	if err != nil {
+		if minioclient.IsNoSuchUpload(err) {
+			// the object may have been assembled by a call that failed before updating the records
+			return s.finishAssembledUpload(ctx, upload)
+		}
+		return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to list uploaded parts")
+	}
+
+	completeParts, err := s.checkUploadedParts(upload, objectParts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.minioClient.CompleteMultipartUpload(ctx, upload.BucketName, upload.StoragePath, upload.StorageUploadID, completeParts); err != nil {
+		logger.Error("Failed to complete multipart upload",
+			zap.String("uploadId", upload.UploadID),
+			zap.Error(err))
+		return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to assemble uploaded parts")
+	}
+
+	return s.verifyAndMarkCompleted(ctx, upload, upload.FileSize)
+}
+
+// AbortMultipartUpload cancels an upload, frees its stored parts and deletes the pending file record
+func (s *fileServiceImpl) AbortMultipartUpload(ctx context.Context, uploadID, userID string) error {
+	upload, err := s.getUpload(ctx, uploadID, userID)
+	if err != nil {
+		return err
+	}
+
+	switch upload.Status {
+	case model.UploadStatusFailed:
+		return nil
+	case model.UploadStatusCompleted:
+		return errors.NewBusiness(errors.CodeUploadClosed, "upload already completed")
+	}
+
+	return s.abortUpload(ctx, upload)
+}
+
+// CleanupExpiredUploads aborts open uploads past their expiry, returns the number aborted
+func (s *fileServiceImpl) CleanupExpiredUploads(ctx context.Context, limit int) (int, error) {
+	uploads, err := s.uploadRepo.ListExpired(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	aborted := 0
+	for _, upload := range uploads {
+		if err := s.abortUpload(ctx, upload); err != nil {
+			logger.Warn("Failed to abort expired upload",
+				zap.String("uploadId", upload.UploadID),
+				zap.Error(err))
+			continue
+		}
+		aborted++
+	}
+	return aborted, nil
+}
+
+// abortUpload aborts the storage upload, marks the session failed and deletes the pending file record
+func (s *fileServiceImpl) abortUpload(ctx context.Context, upload *model.FileUpload) error {
+	err := s.minioClient.AbortMultipartUpload(ctx, upload.BucketName, upload.StoragePath, upload.StorageUploadID)
+	if err != nil && !minioclient.IsNoSuchUpload(err) {
+		return errors.NewBusiness(errors.CodeFileUploadFailed, "failed to abort upload in storage")
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		marked, err := s.uploadRepo.WithTx(tx).MarkFailed(ctx, upload.UploadID)
+		if err != nil {
+			return errors.NewBusiness(errors.CodeInternalError, "failed to update upload status")
+		}
+		if !marked {
+			return nil
+		}
+		if _, err := s.fileRepo.WithTx(tx).Delete(ctx, upload.FileID); err != nil {
+			return errors.NewBusiness(errors.CodeInternalError, "failed to delete file record")
+		}
+		return nil
+	})
+}
+
+// finishAssembledUpload completes the records when the storage upload is gone but the object exists
+func (s *fileServiceImpl) finishAssembledUpload(ctx context.Context, upload *model.FileUpload) (*dto.FileInfoResponse, error) {
+	info, err := s.minioClient.StatObject(ctx, upload.BucketName, upload.StoragePath)
+	if err != nil || info.Size != upload.FileSize {
+		return nil, errors.NewBusiness(errors.CodeUploadClosed, "upload no longer exists in storage")
+	}
+	return s.verifyAndMarkCompleted(ctx, upload, info.Size)
+}
+
+// verifyAndMarkCompleted checks the assembled object like a single upload and activates the file,
+// a rejected object is deleted together with the session
+func (s *fileServiceImpl) verifyAndMarkCompleted(ctx context.Context, upload *model.FileUpload, size int64) (*dto.FileInfoResponse, error) {
+	file, err := s.fileRepo.GetByFileID(ctx, upload.FileID)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get file")
+	}
+
+	mimeType, err := s.verifyUploadedContent(ctx, file, size)
+	if err != nil {
+		if _, ok := err.(*errors.Business); !ok {
+			return nil, errors.NewBusiness(errors.CodeFileUploadFailed, "failed to read uploaded file")
+		}
+		s.discardRejectedUpload(ctx, file, err)
+		if _, markErr := s.uploadRepo.MarkFailed(ctx, upload.UploadID); markErr != nil {
+			logger.Error("Failed to mark rejected upload failed",
+				zap.String("uploadId", upload.UploadID),
+				zap.Error(markErr))
+		}
+		return nil, err
+	}
+	file.MimeType = mimeType
+	return s.markUploadCompleted(ctx, upload, file)
+}
+
+// markUploadCompleted marks the session completed and activates the file, or queues its malware scan
+func (s *fileServiceImpl) markUploadCompleted(ctx context.Context, upload *model.FileUpload, file *model.File) (*dto.FileInfoResponse, error) {
+	chunks := model.ChunkInfo{Chunks: make([]int, 0, upload.TotalParts)}
+	for i := 1; i <= upload.TotalParts; i++ {
+		chunks.Chunks = append(chunks.Chunks, i)
+	}
+	// the quota is charged after the scan, refuse early while the session can still be completed again
+	if s.scanEnabled() {
+		if err := s.checkStorageQuota(ctx, file); err != nil {
+			return nil, err
+		}
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		marked, err := s.uploadRepo.WithTx(tx).MarkCompleted(ctx, upload.UploadID, chunks)
+		if err != nil {
+			return err
+		}
+		if !marked {
+			return errors.NewBusiness(errors.CodeUploadClosed, "upload has expired or was aborted")
+		}
+		if s.scanEnabled() {
+			if err := s.fileRepo.WithTx(tx).UpdateMimeType(ctx, upload.FileID, file.MimeType); err != nil {
+				return err
+			}
+			return s.queueScan(ctx, tx, file)
+		}
+		if err := s.chargeStorage(ctx, tx, file); err != nil {
+			return err
+		}
+		if err := s.fileRepo.WithTx(tx).UpdateMimeType(ctx, upload.FileID, file.MimeType); err != nil {
+			return err
+		}
+		if err := s.fileRepo.WithTx(tx).UpdateStatus(ctx, upload.FileID, model.FileStatusActive); err != nil {
+			return err
+		}
+		return s.jobRepo.WithTx(tx).Enqueue(ctx, upload.FileID)
+	})
+	if err != nil {
+		if _, ok := err.(*errors.Business); ok {
+			return nil, err
+		}
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to update file status")
+	}
+
+	return s.GetFileInfo(ctx, upload.FileID, upload.UserID)
+}
+
+// checkUploadedParts verifies every part is stored and the sizes add up to the declared file size
+func (s *fileServiceImpl) checkUploadedParts(upload *model.FileUpload, objectParts []minio.ObjectPart) ([]minio.CompletePart, error) {
+	byNumber := make(map[int]minio.ObjectPart, len(objectParts))
+	for _, part := range objectParts {
+		byNumber[part.PartNumber] = part
+	}
+
+	var missing []int
+	var totalSize int64
+	completeParts := make([]minio.CompletePart, 0, upload.TotalParts)
+	for i := 1; i <= upload.TotalParts; i++ {
+		part, ok := byNumber[i]
+		if !ok {
+			missing = append(missing, i)
+			continue
+		}
+		totalSize += part.Size
+		completeParts = append(completeParts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+
+	if len(missing) > 0 {
+		return nil, errors.NewBusiness(errors.CodeUploadIncomplete, fmt.Sprintf("%d parts missing, first missing part %d", len(missing), missing[0]))
+	}
+	if len(byNumber) > upload.TotalParts {
+		return nil, errors.NewBusiness(errors.CodeUploadIncomplete, "unexpected parts uploaded")
+	}
+	if totalSize != upload.FileSize {
+		return nil, errors.NewBusiness(errors.CodeUploadIncomplete, fmt.Sprintf("uploaded %d bytes, expected %d", totalSize, upload.FileSize))
+	}
+	return completeParts, nil
+}
+
+// planParts picks the part size and part count for a file
+func (s *fileServiceImpl) planParts(fileSize, requested int64) (int64, int, error) {
+	partSize := requested
+	if partSize == 0 {
+		partSize = s.multipartConfig.PartSize
+		// grow the default part size so very large files stay within the part limit
+		if minSize := ceilDiv(fileSize, model.MaxUploadParts); partSize < minSize {
+			partSize = ceilDiv(minSize, 1024*1024) * 1024 * 1024
+		}
+	}
+
+	if partSize < model.MinPartSize || partSize > model.MaxPartSize {
+		return 0, 0, errors.NewBusiness(errors.CodeParamError,
+			fmt.Sprintf("part size must be between %d and %d bytes", model.MinPartSize, model.MaxPartSize))
+	}
+
+	totalParts := ceilDiv(fileSize, partSize)
+	if totalParts > model.MaxUploadParts {
+		return 0, 0, errors.NewBusiness(errors.CodeParamError,
+			fmt.Sprintf("file needs more than %d parts, use a larger part size", model.MaxUploadParts))
+	}
+	return partSize, int(totalParts), nil
+}
+
+// getUpload gets an upload session of the user
+func (s *fileServiceImpl) getUpload(ctx context.Context, uploadID, userID string) (*model.FileUpload, error) {
+	upload, err := s.uploadRepo.GetByUploadIDAndUserID(ctx, uploadID, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeUploadNotFound, "upload not found")
+		}
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get upload")
+	}
+	return upload, nil
+}
+
+// getOpenUpload gets an upload session that still accepts parts
+func (s *fileServiceImpl) getOpenUpload(ctx context.Context, uploadID, userID string) (*model.FileUpload, error) {
+	upload, err := s.getUpload(ctx, uploadID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !upload.IsOpen() || upload.ExpiresAt.Before(time.Now()) {
+		return nil, errors.NewBusiness(errors.CodeUploadClosed, "upload has expired, was aborted or is completed")
+	}
+	return upload, nil
+}
+
+// toMultipartUploadResponse converts to DTO
+func (s *fileServiceImpl) toMultipartUploadResponse(upload *model.FileUpload) *dto.MultipartUploadResponse {
+	uploaded := make([]int32, 0, len(upload.UploadedChunks.Chunks))
+	for _, partNumber := range upload.UploadedChunks.Chunks {
+		uploaded = append(uploaded, int32(partNumber))
+	}
+	sort.Slice(uploaded, func(i, j int) bool { return uploaded[i] < uploaded[j] })
+
+	return &dto.MultipartUploadResponse{
+		UploadID:      upload.UploadID,
+		FileID:        upload.FileID,
+		FileName:      upload.FileName,
+		FileSize:      upload.FileSize,
+		PartSize:      upload.ChunkSize,
+		TotalParts:    int32(upload.TotalParts),
+		UploadedParts: uploaded,
+		Status:        int32(upload.Status),
+		ExpiresAt:     upload.ExpiresAt.Unix(),
+	}
+}
+
+// equalChunks reports whether two part number lists are the same
+func equalChunks(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ceilDiv divides rounding up
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
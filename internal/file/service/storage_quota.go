@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	grouppb "github.com/anychat/server/api/proto/group"
+	"github.com/anychat/server/internal/file/dto"
+	"github.com/anychat/server/internal/file/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// QuotaConfig storage quota settings
+type QuotaConfig struct {
+	DefaultTier string           // tier of users without one
+	Tiers       map[string]int64 // quota bytes of each user tier, 0 means unlimited
+	GroupBytes  int64            // quota bytes of each group, 0 means unlimited
+}
+
+// DefaultQuotaConfig returns the default quota configuration
+func DefaultQuotaConfig() QuotaConfig {
+	return QuotaConfig{
+		DefaultTier: "standard",
+		Tiers: map[string]int64{
+			"standard": 2 * 1024 * 1024 * 1024,
+			"premium":  20 * 1024 * 1024 * 1024,
+		},
+		GroupBytes: 10 * 1024 * 1024 * 1024,
+	}
+}
+
+// storageOwner returns the ledger a file counts against, false for client logs which never count
+func storageOwner(file *model.File) (model.StorageOwnerType, string, bool) {
+	if file.FileType == model.FileTypeLog {
+		return 0, "", false
+	}
+	if file.GroupID != "" {
+		return model.StorageOwnerGroup, file.GroupID, true
+	}
+	return model.StorageOwnerUser, file.UserID, true
+}
+
+// quotaOf resolves the quota of an owner: the administrator override, else the user's tier or the group default.
+// 0 means unlimited
+func (s *fileServiceImpl) quotaOf(usage *model.StorageUsage) int64 {
+	if usage.QuotaBytes != nil {
+		return *usage.QuotaBytes
+	}
+	if usage.OwnerType == model.StorageOwnerGroup {
+		return s.quotaConfig.GroupBytes
+	}
+	if quota, ok := s.quotaConfig.Tiers[usage.Tier]; ok && usage.Tier != "" {
+		return quota
+	}
+	return s.quotaConfig.Tiers[s.quotaConfig.DefaultTier]
+}
+
+// checkStorageQuota rejects an upload that cannot fit before any bytes are sent.
+// Concurrent uploads may all pass, the charge on completion is authoritative
+func (s *fileServiceImpl) checkStorageQuota(ctx context.Context, file *model.File) error {
+	ownerType, ownerID, ok := storageOwner(file)
+	if !ok {
+		return nil
+	}
+	usage, err := s.usageRepo.Get(ctx, ownerType, ownerID)
+	if err != nil {
+		return errors.NewBusiness(errors.CodeInternalError, "failed to get storage usage")
+	}
+	if quota := s.quotaOf(usage); quota > 0 && usage.UsedBytes+file.FileSize > quota {
+		return errors.NewBusiness(errors.CodeStorageQuotaExceeded,
+			fmt.Sprintf("storage quota exceeded: %d of %d bytes used", usage.UsedBytes, quota))
+	}
+	return nil
+}
+
+// chargeStorage counts a file that becomes active against its owner's quota
+func (s *fileServiceImpl) chargeStorage(ctx context.Context, tx *gorm.DB, file *model.File) error {
+	ownerType, ownerID, ok := storageOwner(file)
+	if !ok {
+		return nil
+	}
+	usageRepo := s.usageRepo.WithTx(tx)
+	usage, err := usageRepo.Get(ctx, ownerType, ownerID)
+	if err != nil {
+		return err
+	}
+	quota := s.quotaOf(usage)
+	charged, err := usageRepo.Charge(ctx, ownerType, ownerID, file.FileSize, quota)
+	if err != nil {
+		return err
+	}
+	if !charged {
+		return errors.NewBusiness(errors.CodeStorageQuotaExceeded,
+			fmt.Sprintf("storage quota exceeded: %d of %d bytes used", usage.UsedBytes, quota))
+	}
+	return nil
+}
+
+// releaseStorage removes an active file from its owner's usage
+func (s *fileServiceImpl) releaseStorage(ctx context.Context, tx *gorm.DB, file *model.File) error {
+	ownerType, ownerID, ok := storageOwner(file)
+	if !ok || file.Status != model.FileStatusActive {
+		return nil
+	}
+	return s.usageRepo.WithTx(tx).Release(ctx, ownerType, ownerID, file.FileSize)
+}
+
+// adjustStorage records a size change of an active file, e.g. after metadata was stripped
+func (s *fileServiceImpl) adjustStorage(ctx context.Context, file *model.File, delta int64) {
+	ownerType, ownerID, ok := storageOwner(file)
+	if !ok || delta == 0 {
+		return
+	}
+	if err := s.usageRepo.Adjust(ctx, ownerType, ownerID, delta); err != nil {
+		logger.Error("Failed to adjust storage usage",
+			zap.String("fileId", file.FileID),
+			zap.Int64("delta", delta),
+			zap.Error(err))
+	}
+}
+
+// checkGroupUpload verifies the uploader may store files counted against the group
+func (s *fileServiceImpl) checkGroupUpload(ctx context.Context, groupID, userID string) error {
+	if s.groupClient == nil {
+		return errors.NewBusiness(errors.CodeInternalError, "group service unavailable")
+	}
+	member, err := s.groupClient.IsMember(ctx, &grouppb.IsMemberRequest{GroupId: groupID, UserId: userID})
+	if err != nil {
+		return errors.NewBusiness(errors.CodeInternalError, "failed to verify group membership")
+	}
+	if !member.IsMember {
+		return errors.NewBusiness(errors.CodeFileAccessDenied, "not a member of the group")
+	}
+	return nil
+}
+
+// GetStorageUsage gets the usage and quota of the user, or of a group the user belongs to
+func (s *fileServiceImpl) GetStorageUsage(ctx context.Context, userID, groupID string) (*dto.StorageUsageResponse, error) {
+	if groupID != "" {
+		if err := s.checkGroupUpload(ctx, groupID, userID); err != nil {
+			return nil, err
+		}
+		return s.GetOwnerStorageUsage(ctx, model.StorageOwnerGroup, groupID)
+	}
+	return s.GetOwnerStorageUsage(ctx, model.StorageOwnerUser, userID)
+}
+
+// GetOwnerStorageUsage gets the usage and quota of any user or group (admin)
+func (s *fileServiceImpl) GetOwnerStorageUsage(ctx context.Context, ownerType model.StorageOwnerType, ownerID string) (*dto.StorageUsageResponse, error) {
+	if err := validateStorageOwner(ownerType, ownerID); err != nil {
+		return nil, err
+	}
+	usage, err := s.usageRepo.Get(ctx, ownerType, ownerID)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get storage usage")
+	}
+	return s.toStorageUsageResponse(usage), nil
+}
+
+// SetStorageQuota changes the tier or the quota override of a user or group (admin)
+func (s *fileServiceImpl) SetStorageQuota(ctx context.Context, req *dto.SetStorageQuotaRequest) (*dto.StorageUsageResponse, error) {
+	ownerType := model.StorageOwnerType(req.OwnerType)
+	if err := validateStorageOwner(ownerType, req.OwnerID); err != nil {
+		return nil, err
+	}
+	usage, err := s.usageRepo.Get(ctx, ownerType, req.OwnerID)
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get storage usage")
+	}
+
+	if req.Tier != nil {
+		if ownerType != model.StorageOwnerUser {
+			return nil, errors.NewBusiness(errors.CodeParamError, "tiers only apply to users")
+		}
+		if _, ok := s.quotaConfig.Tiers[*req.Tier]; !ok && *req.Tier != "" {
+			return nil, errors.NewBusiness(errors.CodeParamError, fmt.Sprintf("unknown tier %q", *req.Tier))
+		}
+		usage.Tier = *req.Tier
+	}
+	switch {
+	case req.ClearQuota:
+		usage.QuotaBytes = nil
+	case req.QuotaBytes != nil:
+		if *req.QuotaBytes < 0 {
+			return nil, errors.NewBusiness(errors.CodeParamError, "quota_bytes must not be negative")
+		}
+		usage.QuotaBytes = req.QuotaBytes
+	}
+
+	if err := s.usageRepo.SetQuota(ctx, ownerType, req.OwnerID, usage.Tier, usage.QuotaBytes); err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to set storage quota")
+	}
+	return s.toStorageUsageResponse(usage), nil
+}
+
+// validateStorageOwner checks the owner of a quota request
+func validateStorageOwner(ownerType model.StorageOwnerType, ownerID string) error {
+	if ownerType != model.StorageOwnerUser && ownerType != model.StorageOwnerGroup {
+		return errors.NewBusiness(errors.CodeParamError, "invalid owner type")
+	}
+	if ownerID == "" {
+		return errors.NewBusiness(errors.CodeParamError, "owner id is required")
+	}
+	return nil
+}
+
+// toStorageUsageResponse converts a ledger row with its effective quota
+func (s *fileServiceImpl) toStorageUsageResponse(usage *model.StorageUsage) *dto.StorageUsageResponse {
+	resp := &dto.StorageUsageResponse{
+		OwnerType:       int32(usage.OwnerType),
+		OwnerID:         usage.OwnerID,
+		UsedBytes:       usage.UsedBytes,
+		QuotaBytes:      s.quotaOf(usage),
+		FileCount:       int32(usage.FileCount),
+		QuotaOverridden: usage.QuotaBytes != nil,
+	}
+	if usage.OwnerType == model.StorageOwnerUser {
+		resp.Tier = usage.Tier
+		if resp.Tier == "" {
+			resp.Tier = s.quotaConfig.DefaultTier
+		}
+	}
+	return resp
+}
@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/service"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// FileLifecycleWorker warns about and deletes expired files, purges abandoned uploads and retries failed
+// object removals
+type FileLifecycleWorker struct {
+	fileService service.FileService
+	batchSize   int
+	interval    time.Duration
+	stopCh      chan struct{}
+}
+
+func NewFileLifecycleWorker(
+	fileService service.FileService,
+	batchSize int,
+	interval time.Duration,
+) *FileLifecycleWorker {
+	return &FileLifecycleWorker{
+		fileService: fileService,
+		batchSize:   batchSize,
+		interval:    interval,
+		stopCh:      make(chan struct{}),
+	}
+}
+
+func (w *FileLifecycleWorker) Start() {
+	logger.Info("FileLifecycleWorker starting", zap.Int("batchSize", w.batchSize), zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			logger.Info("FileLifecycleWorker stopped")
+			return
+		case <-ticker.C:
+			w.runOnce()
+		}
+	}
+}
+
+func (w *FileLifecycleWorker) Stop() {
+	close(w.stopCh)
+}
+
+func (w *FileLifecycleWorker) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), w.interval)
+	defer cancel()
+
+	// warnings go out before expiry runs, so a file expiring within one interval is still announced
+	w.drain(ctx, "Warned about expiring files", w.fileService.WarnExpiringFiles)
+	w.drain(ctx, "Expired files", w.fileService.ExpireFiles)
+	w.drain(ctx, "Purged abandoned uploads", w.fileService.PurgeAbandonedUploads)
+	w.drain(ctx, "Retried object deletions", w.fileService.RetryObjectDeletions)
+}
+
+// drain runs task in batches until a batch comes back short
+func (w *FileLifecycleWorker) drain(ctx context.Context, message string, task func(ctx context.Context, limit int) (int, error)) {
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		count, err := task(ctx, w.batchSize)
+		if err != nil {
+			logger.Error("File lifecycle task failed", zap.String("task", message), zap.Error(err))
+			return
+		}
+		if count == 0 {
+			return
+		}
+
+		logger.Info(message, zap.Int("count", count))
+		if count < w.batchSize {
+			return
+		}
+	}
+}
+
+func (w *FileLifecycleWorker) StartAsync() {
+	go w.Start()
+}
@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/service"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// FileScanWorker streams completed uploads to the malware scanner and activates or quarantines them
+type FileScanWorker struct {
+	fileService service.FileService
+	batchSize   int
+	interval    time.Duration
+	timeout     time.Duration // per batch, no longer than the scan lease so a claimed scan is not run twice
+	stopCh      chan struct{}
+}
+
+func NewFileScanWorker(
+	fileService service.FileService,
+	batchSize int,
+	interval time.Duration,
+	timeout time.Duration,
+) *FileScanWorker {
+	return &FileScanWorker{
+		fileService: fileService,
+		batchSize:   batchSize,
+		interval:    interval,
+		timeout:     timeout,
+		stopCh:      make(chan struct{}),
+	}
+}
+
+func (w *FileScanWorker) Start() {
+	logger.Info("FileScanWorker starting", zap.Int("batchSize", w.batchSize), zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			logger.Info("FileScanWorker stopped")
+			return
+		case <-ticker.C:
+			w.process()
+		}
+	}
+}
+
+func (w *FileScanWorker) Stop() {
+	close(w.stopCh)
+}
+
+func (w *FileScanWorker) process() {
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		default:
+		}
+
+		processed, err := w.processBatch()
+		if err != nil {
+			logger.Error("Failed to scan uploaded files", zap.Error(err))
+			return
+		}
+		if processed < w.batchSize {
+			return
+		}
+	}
+}
+
+func (w *FileScanWorker) processBatch() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+
+	return w.fileService.ScanPendingFiles(ctx, w.batchSize)
+}
+
+func (w *FileScanWorker) StartAsync() {
+	go w.Start()
+}
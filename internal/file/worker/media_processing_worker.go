@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/service"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// MediaProcessingWorker runs queued thumbnail, metadata and EXIF stripping jobs of completed uploads
+type MediaProcessingWorker struct {
+	fileService service.FileService
+	batchSize   int
+	interval    time.Duration
+	timeout     time.Duration // per batch, no longer than the job lease so a claimed job is not run twice
+	stopCh      chan struct{}
+}
+
+func NewMediaProcessingWorker(
+	fileService service.FileService,
+	batchSize int,
+	interval time.Duration,
+	timeout time.Duration,
+) *MediaProcessingWorker {
+	return &MediaProcessingWorker{
+		fileService: fileService,
+		batchSize:   batchSize,
+		interval:    interval,
+		timeout:     timeout,
+		stopCh:      make(chan struct{}),
+	}
+}
+
+func (w *MediaProcessingWorker) Start() {
+	logger.Info("MediaProcessingWorker starting", zap.Int("batchSize", w.batchSize), zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			logger.Info("MediaProcessingWorker stopped")
+			return
+		case <-ticker.C:
+			w.process()
+		}
+	}
+}
+
+func (w *MediaProcessingWorker) Stop() {
+	close(w.stopCh)
+}
+
+func (w *MediaProcessingWorker) process() {
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		default:
+		}
+
+		processed, err := w.processBatch()
+		if err != nil {
+			logger.Error("Failed to process uploaded files", zap.Error(err))
+			return
+		}
+		if processed < w.batchSize {
+			return
+		}
+	}
+}
+
+func (w *MediaProcessingWorker) processBatch() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+
+	return w.fileService.ProcessPendingFiles(ctx, w.batchSize)
+}
+
+func (w *MediaProcessingWorker) StartAsync() {
+	go w.Start()
+}
@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/file/service"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// UploadCleanupWorker aborts multipart uploads that were not completed before they expired
+type UploadCleanupWorker struct {
+	fileService service.FileService
+	batchSize   int
+	interval    time.Duration
+	stopCh      chan struct{}
+}
+
+func NewUploadCleanupWorker(
+	fileService service.FileService,
+	batchSize int,
+	interval time.Duration,
+) *UploadCleanupWorker {
+	return &UploadCleanupWorker{
+		fileService: fileService,
+		batchSize:   batchSize,
+		interval:    interval,
+		stopCh:      make(chan struct{}),
+	}
+}
+
+func (w *UploadCleanupWorker) Start() {
+	logger.Info("UploadCleanupWorker starting", zap.Int("batchSize", w.batchSize), zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			logger.Info("UploadCleanupWorker stopped")
+			return
+		case <-ticker.C:
+			w.cleanup()
+		}
+	}
+}
+
+func (w *UploadCleanupWorker) Stop() {
+	close(w.stopCh)
+}
+
+func (w *UploadCleanupWorker) cleanup() {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		default:
+		}
+
+		aborted, err := w.fileService.CleanupExpiredUploads(ctx, w.batchSize)
+		if err != nil {
+			logger.Error("Failed to clean up expired uploads", zap.Error(err))
+			return
+		}
+		if aborted == 0 {
+			return
+		}
+
+		logger.Info("Aborted expired uploads", zap.Int("count", aborted))
+		if aborted < w.batchSize {
+			return
+		}
+	}
+}
+
+func (w *UploadCleanupWorker) StartAsync() {
+	go w.Start()
+}
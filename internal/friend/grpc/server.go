@@ -260,6 +260,15 @@ func (s *FriendServer) BatchCheckFriend(ctx context.Context, req *friendpb.Batch
 	}, nil
 }
 
+// EraseUserData erases friend data of a deleted account (called by auth-service)
+func (s *FriendServer) EraseUserData(ctx context.Context, req *commonpb.EraseUserDataRequest) (*commonpb.EraseUserDataResponse, error) {
+	erased, err := s.friendService.EraseUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &commonpb.EraseUserDataResponse{Erased: erased}, nil
+}
+
 // convertError converts business errors to gRPC errors
 func convertError(err error) error {
 	if bizErr, ok := err.(*errors.Business); ok {
@@ -14,6 +14,8 @@ type BlacklistRepository interface {
 	GetBlacklist(ctx context.Context, userID string) ([]*model.Blacklist, error)
 	Delete(ctx context.Context, userID, blockedUserID string) error
 	IsBlocked(ctx context.Context, userID, targetUserID string) (bool, error)
+	// DeleteAllByUser removes entries where the user blocked someone or was blocked
+	DeleteAllByUser(ctx context.Context, userID string) (int64, error)
 	WithTx(tx *gorm.DB) BlacklistRepository
 }
 
@@ -72,6 +74,14 @@ func (r *blacklistRepositoryImpl) IsBlocked(ctx context.Context, userID, targetU
 	return count > 0, err
 }
 
+// DeleteAllByUser removes entries where the user blocked someone or was blocked
+func (r *blacklistRepositoryImpl) DeleteAllByUser(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? OR blocked_user_id = ?", userID, userID).
+		Delete(&model.Blacklist{})
+	return result.RowsAffected, result.Error
+}
+
 // WithTx uses transaction
 func (r *blacklistRepositoryImpl) WithTx(tx *gorm.DB) BlacklistRepository {
 	return &blacklistRepositoryImpl{db: tx}
@@ -17,6 +17,8 @@ type FriendRequestRepository interface {
 	GetSentRequests(ctx context.Context, userID string) ([]*model.FriendRequest, error)
 	UpdateStatus(ctx context.Context, id int64, status model.FriendRequestStatus) error
 	Update(ctx context.Context, request *model.FriendRequest) error
+	// DeleteAllByUser removes requests sent or received by the user
+	DeleteAllByUser(ctx context.Context, userID string) (int64, error)
 	WithTx(tx *gorm.DB) FriendRequestRepository
 }
 
@@ -104,6 +106,14 @@ func (r *friendRequestRepositoryImpl) Update(ctx context.Context, request *model
 	return r.db.WithContext(ctx).Save(request).Error
 }
 
+// DeleteAllByUser removes requests sent or received by the user
+func (r *friendRequestRepositoryImpl) DeleteAllByUser(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("from_user_id = ? OR to_user_id = ?", userID, userID).
+		Delete(&model.FriendRequest{})
+	return result.RowsAffected, result.Error
+}
+
 // WithTx uses transaction
 func (r *friendRequestRepositoryImpl) WithTx(tx *gorm.DB) FriendRequestRepository {
 	return &friendRequestRepositoryImpl{db: tx}
@@ -20,6 +20,8 @@ type FriendshipRepository interface {
 	Delete(ctx context.Context, userID, friendID string) error
 	DeleteBidirectional(ctx context.Context, userID, friendID string) error
 	IsFriend(ctx context.Context, userID, friendID string) (bool, error)
+	// DeleteAllByUser removes every friendship of the user in both directions, including deleted ones
+	DeleteAllByUser(ctx context.Context, userID string) (int64, error)
 	WithTx(tx *gorm.DB) FriendshipRepository
 }
 
@@ -106,6 +108,14 @@ func (r *friendshipRepositoryImpl) DeleteBidirectional(ctx context.Context, user
 	return r.Delete(ctx, friendID, userID)
 }
 
+// DeleteAllByUser removes every friendship of the user in both directions
+func (r *friendshipRepositoryImpl) DeleteAllByUser(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? OR friend_id = ?", userID, userID).
+		Delete(&model.Friendship{})
+	return result.RowsAffected, result.Error
+}
+
 // IsFriend checks if users are friends
 func (r *friendshipRepositoryImpl) IsFriend(ctx context.Context, userID, friendID string) (bool, error) {
 	var count int64
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// EraseUserData removes a deleted account from friend lists, friend requests and blacklists.
+// Remaining friends are told the friendship ended; calling it again erases nothing more
+func (s *friendServiceImpl) EraseUserData(ctx context.Context, userID string) (map[string]int64, error) {
+	if userID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "user_id is required")
+	}
+
+	friends, err := s.friendshipRepo.GetFriendList(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	erased := make(map[string]int64, 3)
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		count, err := s.friendshipRepo.WithTx(tx).DeleteAllByUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		erased["friendships"] = count
+
+		if count, err = s.requestRepo.WithTx(tx).DeleteAllByUser(ctx, userID); err != nil {
+			return err
+		}
+		erased["friend_requests"] = count
+
+		if count, err = s.blacklistRepo.WithTx(tx).DeleteAllByUser(ctx, userID); err != nil {
+			return err
+		}
+		erased["blacklist_entries"] = count
+		return nil
+	})
+	if err != nil {
+		logger.Error("Failed to erase friend data", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	for _, friendship := range friends {
+		s.publishFriendDeletedNotification(userID, friendship.FriendID)
+	}
+	return erased, nil
+}
@@ -31,6 +31,7 @@ type FriendService interface {
 	IsFriend(ctx context.Context, userID, friendID string) (bool, error)
 	IsBlocked(ctx context.Context, userID, targetUserID string) (bool, error)
 	BatchCheckFriend(ctx context.Context, userID string, friendIDs []string) (map[string]bool, error)
+	EraseUserData(ctx context.Context, userID string) (map[string]int64, error)
 }
 
 // friendServiceImpl is the friend service implementation
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"github.com/anychat/server/api/proto/auth"
+	gwmiddleware "github.com/anychat/server/internal/gateway/middleware"
+	"github.com/anychat/server/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// DeleteAccountRequest delete account request
+type DeleteAccountRequest struct {
+	Password   string `json:"password" example:"OldPass123"`                     // required when the account has a password
+	VerifyCode string `json:"verify_code" example:"123456"`                      // purpose 8 (delete_account) code for accounts without a password
+	MFACode    string `json:"mfa_code" example:"123456"`                         // required when two-factor authentication is enabled
+	Reason     string `json:"reason" binding:"max=256" example:"no longer used"` // optional, at most 256 characters
+}
+
+// AccountDeletionResponse account deletion request and its progress
+type AccountDeletionResponse struct {
+	ID             int64    `json:"id" example:"7"`
+	Status         int32    `json:"status" example:"1"` // 1-pending 2-erasing 3-completed 4-cancelled
+	RequestedAt    int64    `json:"requested_at" example:"1700000000"`
+	EraseAfter     int64    `json:"erase_after" example:"1701209600"`
+	CancelledAt    int64    `json:"cancelled_at" example:"0"`
+	CompletedAt    int64    `json:"completed_at" example:"0"`
+	CompletedSteps []string `json:"completed_steps"` // services already erased: user, friend, group, conversation, message, file, auth
+}
+
+// DeleteAccount delete my account
+// @Summary      delete my account
+// @Description  Re-verifies the user (password, or a delete_account verification code for accounts without a password, plus the MFA code when enabled) and schedules the account for erasure after the grace period. All devices are signed out; logging in again during the grace period cancels the deletion
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      DeleteAccountRequest  true  "re-verification"
+// @Success      200      {object}  response.Response{data=AccountDeletionResponse}  "success"
+// @Failure      400      {object}  response.Response  "parameter error or wrong verification code"
+// @Failure      401      {object}  response.Response  "wrong password"
+// @Failure      403      {object}  response.Response  "MFA code required"
+// @Failure      409      {object}  response.Response  "deletion already scheduled"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/account/delete [post]
+func (h *AuthHandler) DeleteAccount(c *gin.Context) {
+	var req DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	resp, err := h.clientManager.Auth().DeleteAccount(c.Request.Context(), &authpb.DeleteAccountRequest{
+		UserId:     gwmiddleware.GetUserID(c),
+		Password:   req.Password,
+		VerifyCode: req.VerifyCode,
+		MfaCode:    req.MFACode,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, toAccountDeletionResponse(resp))
+}
+
+// GetAccountDeletion get my account deletion status
+// @Summary      get my account deletion status
+// @Description  Latest deletion request of the current user and the services whose data is already erased
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  response.Response{data=AccountDeletionResponse}  "success"
+// @Failure      401  {object}  response.Response  "unauthorized"
+// @Failure      404  {object}  response.Response  "no deletion request"
+// @Failure      500  {object}  response.Response  "server error"
+// @Router       /auth/account/deletion [get]
+func (h *AuthHandler) GetAccountDeletion(c *gin.Context) {
+	resp, err := h.clientManager.Auth().GetAccountDeletion(c.Request.Context(), &authpb.GetAccountDeletionRequest{
+		UserId: gwmiddleware.GetUserID(c),
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, toAccountDeletionResponse(resp))
+}
+
+func toAccountDeletionResponse(info *authpb.AccountDeletionInfo) *AccountDeletionResponse {
+	steps := info.CompletedSteps
+	if steps == nil {
+		steps = []string{}
+	}
+	return &AccountDeletionResponse{
+		ID:             info.Id,
+		Status:         int32(info.Status),
+		RequestedAt:    info.RequestedAt,
+		EraseAfter:     info.EraseAfter,
+		CancelledAt:    info.CancelledAt,
+		CompletedAt:    info.CompletedAt,
+		CompletedSteps: steps,
+	}
+}
@@ -20,7 +20,7 @@ type AuthHandler struct {
 type SendCodeRequest struct {
 	Target     string `json:"target" binding:"required" example:"13800138000"`
 	TargetType int16  `json:"target_type" binding:"required,oneof=1 2" example:"1"`
-	Purpose    int16  `json:"purpose" binding:"required,oneof=1 2 3 4 5 6 7" example:"1"`
+	Purpose    int16  `json:"purpose" binding:"required,oneof=1 2 3 4 5 6 7 8" example:"1"`
 	DeviceID   string `json:"device_id" example:"device-uuid-123"`
 }
 
@@ -44,6 +44,18 @@ type LoginRequest struct {
 	DeviceID      string `json:"device_id" binding:"required" example:"device-uuid-123"`
 	ClientVersion string `json:"client_version" binding:"required" example:"1.0.0"`
 	IpAddress     string `json:"ip_address"`
+	CaptchaToken  string `json:"captcha_token"` // required after repeated failures (error 10121)
+}
+
+// LoginByCodeRequest verification code login request
+type LoginByCodeRequest struct {
+	Target        string  `json:"target" binding:"required" example:"13800138000"`
+	TargetType    int16   `json:"target_type" binding:"required,oneof=1 2" example:"1"`
+	VerifyCode    string  `json:"verify_code" binding:"required" example:"123456"`
+	DeviceType    int16   `json:"device_type" binding:"required,oneof=1 2 3 4 5" example:"1"`
+	DeviceID      string  `json:"device_id" binding:"required" example:"device-uuid-123"`
+	ClientVersion string  `json:"client_version" binding:"required" example:"1.0.0"`
+	Nickname      *string `json:"nickname" example:"张三"`
 }
 
 // RefreshTokenRequest refresh token request
@@ -61,6 +73,7 @@ type ChangePasswordRequest struct {
 	DeviceID    string `json:"device_id" binding:"required" example:"device-uuid-123"`
 	OldPassword string `json:"old_password" binding:"required" example:"oldpass123"`
 	NewPassword string `json:"new_password" binding:"required" example:"newpass123"`
+	MFACode     string `json:"mfa_code,omitempty" example:"654321"` // required when MFA is enabled
 }
 
 // ResetPasswordRequest reset password request (forgot password)
@@ -77,6 +90,8 @@ type AuthResponse struct {
 	RefreshToken string    `json:"refresh_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
 	ExpiresIn    int64     `json:"expires_in" example:"7200"`
 	User         *UserInfo `json:"user,omitempty"`
+	MFARequired  bool      `json:"mfa_required,omitempty" example:"false"` // second factor required, call /auth/login/mfa with mfa_token
+	MFAToken     string    `json:"mfa_token,omitempty" example:""`
 }
 
 // SendCodeResponse send verification code response
@@ -211,6 +226,8 @@ func (h *AuthHandler) Register(c *gin.Context) {
 // @Success      200      {object}  response.Response{data=AuthResponse}  "login success"
 // @Failure      400      {object}  response.Response  "parameter error"
 // @Failure      401      {object}  response.Response  "incorrect account or password"
+// @Failure      403      {object}  response.Response  "CAPTCHA required"
+// @Failure      429      {object}  response.Response  "too many failed attempts or account locked"
 // @Failure      500      {object}  response.Response  "server error"
 // @Router       /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -228,13 +245,66 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	// Call auth-service gRPC
-	resp, err := h.clientManager.Auth().Login(c.Request.Context(), &authpb.LoginRequest{
+	pbReq := &authpb.LoginRequest{
 		Account:       req.Account,
 		Password:      req.Password,
 		DeviceType:    authpb.DeviceType(deviceType),
 		DeviceId:      req.DeviceID,
 		ClientVersion: req.ClientVersion,
 		IpAddress:     c.ClientIP(),
+		UserAgent:     c.Request.UserAgent(),
+	}
+	if req.CaptchaToken != "" {
+		pbReq.CaptchaToken = &req.CaptchaToken
+	}
+	resp, err := h.clientManager.Auth().Login(c.Request.Context(), pbReq)
+
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, loginResult(resp))
+}
+
+// LoginByCode verification code login
+// @Summary      verification code login
+// @Description  Passwordless login via SMS/email verification code (purpose=2). Unknown phone numbers may be auto-registered when enabled by server config
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      LoginByCodeRequest  true  "login info"
+// @Success      200      {object}  response.Response{data=AuthResponse}  "login success"
+// @Failure      400      {object}  response.Response  "parameter error or incorrect verification code"
+// @Failure      404      {object}  response.Response  "user not found"
+// @Failure      429      {object}  response.Response  "too many verification attempts"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/login/code [post]
+func (h *AuthHandler) LoginByCode(c *gin.Context) {
+	var req LoginByCodeRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	deviceType := authmodel.DeviceType(req.DeviceType)
+	if !deviceType.IsValid() {
+		response.ParamError(c, "invalid device_type")
+		return
+	}
+
+	// Call auth-service gRPC
+	resp, err := h.clientManager.Auth().LoginByCode(c.Request.Context(), &authpb.LoginByCodeRequest{
+		Target:        req.Target,
+		TargetType:    authpb.VerificationTargetType(req.TargetType),
+		VerifyCode:    req.VerifyCode,
+		DeviceType:    authpb.DeviceType(deviceType),
+		DeviceId:      req.DeviceID,
+		ClientVersion: req.ClientVersion,
+		IpAddress:     c.ClientIP(),
+		UserAgent:     c.Request.UserAgent(),
+		Nickname:      req.Nickname,
 	})
 
 	if err != nil {
@@ -242,6 +312,19 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	response.Success(c, loginResult(resp))
+}
+
+// loginResult builds login response body
+func loginResult(resp *authpb.LoginResponse) gin.H {
+	if resp.MfaRequired {
+		return gin.H{
+			"user_id":      resp.UserId,
+			"mfa_required": true,
+			"mfa_token":    resp.MfaToken,
+		}
+	}
+
 	result := gin.H{
 		"user_id":       resp.UserId,
 		"access_token":  resp.AccessToken,
@@ -259,7 +342,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		}
 	}
 
-	response.Success(c, result)
+	return result
 }
 
 // Logout user logout
@@ -347,6 +430,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 // @Success      200      {object}  response.Response  "change success"
 // @Failure      400      {object}  response.Response  "parameter error"
 // @Failure      401      {object}  response.Response  "unauthorized or wrong original password"
+// @Failure      403      {object}  response.Response  "MFA code required"
 // @Failure      500      {object}  response.Response  "server error"
 // @Router       /auth/password/change [post]
 func (h *AuthHandler) ChangePassword(c *gin.Context) {
@@ -360,12 +444,17 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 	userID := gwmiddleware.GetUserID(c)
 
 	// Call auth-service gRPC
-	_, err := h.clientManager.Auth().ChangePassword(c.Request.Context(), &authpb.ChangePasswordRequest{
+	pbReq := &authpb.ChangePasswordRequest{
 		UserId:      userID,
 		DeviceId:    req.DeviceID,
 		OldPassword: req.OldPassword,
 		NewPassword: req.NewPassword,
-	})
+	}
+	if req.MFACode != "" {
+		pbReq.MfaCode = &req.MFACode
+	}
+
+	_, err := h.clientManager.Auth().ChangePassword(c.Request.Context(), pbReq)
 
 	if err != nil {
 		handleGRPCError(c, err)
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"github.com/anychat/server/api/proto/auth"
+	gwmiddleware "github.com/anychat/server/internal/gateway/middleware"
+	"github.com/anychat/server/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// DeviceInfo device of the current user
+type DeviceInfo struct {
+	DeviceID      string `json:"device_id" example:"device-uuid-123"`
+	DeviceType    int32  `json:"device_type" example:"1"` // 1-ios 2-android 3-web 4-pc 5-h5
+	Name          string `json:"name" example:"My iPhone"`
+	ClientVersion string `json:"client_version" example:"1.0.0"`
+	LastLoginAt   int64  `json:"last_login_at" example:"1700000000"`
+	LastLoginIP   string `json:"last_login_ip" example:"203.0.113.10"`
+	LastActiveAt  int64  `json:"last_active_at" example:"1700003600"`
+	IsCurrent     bool   `json:"is_current" example:"true"`
+	SignedIn      bool   `json:"signed_in" example:"true"`
+}
+
+// ListDevicesResponse device list response
+type ListDevicesResponse struct {
+	Devices []*DeviceInfo `json:"devices"`
+}
+
+// RenameDeviceRequest rename device request
+type RenameDeviceRequest struct {
+	Name string `json:"name" binding:"required,max=64" example:"My iPhone"`
+}
+
+// ListDevices list my devices
+// @Summary      list my devices
+// @Description  Devices the current user has logged in on, with client version, last login IP, last activity and whether each still has a live session
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  response.Response{data=ListDevicesResponse}  "success"
+// @Failure      401  {object}  response.Response  "unauthorized"
+// @Failure      500  {object}  response.Response  "server error"
+// @Router       /auth/devices [get]
+func (h *AuthHandler) ListDevices(c *gin.Context) {
+	resp, err := h.clientManager.Auth().ListDevices(c.Request.Context(), &authpb.ListDevicesRequest{
+		UserId:          gwmiddleware.GetUserID(c),
+		CurrentDeviceId: gwmiddleware.GetDeviceID(c),
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	devices := make([]*DeviceInfo, 0, len(resp.Devices))
+	for _, device := range resp.Devices {
+		devices = append(devices, &DeviceInfo{
+			DeviceID:      device.DeviceId,
+			DeviceType:    int32(device.DeviceType),
+			Name:          device.Name,
+			ClientVersion: device.ClientVersion,
+			LastLoginAt:   device.LastLoginAt,
+			LastLoginIP:   device.LastLoginIp,
+			LastActiveAt:  device.LastActiveAt,
+			IsCurrent:     device.IsCurrent,
+			SignedIn:      device.SignedIn,
+		})
+	}
+
+	response.Success(c, &ListDevicesResponse{Devices: devices})
+}
+
+// LogoutDevice sign out a device
+// @Summary      sign out a device
+// @Description  Revokes the device session, sends auth.force_logout to it and closes its WebSocket connection
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Param        deviceId  path      string  true  "device ID"
+// @Success      200       {object}  response.Response  "success"
+// @Failure      401       {object}  response.Response  "unauthorized"
+// @Failure      404       {object}  response.Response  "device not found"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /auth/devices/{deviceId} [delete]
+func (h *AuthHandler) LogoutDevice(c *gin.Context) {
+	_, err := h.clientManager.Auth().LogoutDevice(c.Request.Context(), &authpb.DeviceRequest{
+		UserId:   gwmiddleware.GetUserID(c),
+		DeviceId: c.Param("deviceId"),
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, nil)
+}
+
+// LogoutOtherDevices sign out all other devices
+// @Summary      sign out all other devices
+// @Description  Signs out every device except the one making the request
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  response.Response  "success"
+// @Failure      401  {object}  response.Response  "unauthorized"
+// @Failure      500  {object}  response.Response  "server error"
+// @Router       /auth/devices/logout-others [post]
+func (h *AuthHandler) LogoutOtherDevices(c *gin.Context) {
+	_, err := h.clientManager.Auth().LogoutOtherDevices(c.Request.Context(), &authpb.DeviceRequest{
+		UserId:   gwmiddleware.GetUserID(c),
+		DeviceId: gwmiddleware.GetDeviceID(c),
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, nil)
+}
+
+// RenameDevice rename a device
+// @Summary      rename a device
+// @Description  Sets a display name for one of the current user's devices
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        deviceId  path      string               true  "device ID"
+// @Param        request   body      RenameDeviceRequest  true  "new name"
+// @Success      200       {object}  response.Response  "success"
+// @Failure      400       {object}  response.Response  "parameter error"
+// @Failure      401       {object}  response.Response  "unauthorized"
+// @Failure      404       {object}  response.Response  "device not found"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /auth/devices/{deviceId}/name [put]
+func (h *AuthHandler) RenameDevice(c *gin.Context) {
+	var req RenameDeviceRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	_, err := h.clientManager.Auth().RenameDevice(c.Request.Context(), &authpb.RenameDeviceRequest{
+		UserId:   gwmiddleware.GetUserID(c),
+		DeviceId: c.Param("deviceId"),
+		Name:     req.Name,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, nil)
+}
@@ -24,7 +24,7 @@ func NewFileHandler(clientManager *client.Manager) *FileHandler {
 
 // GenerateUploadToken generate file upload token
 // @Summary      generate file upload token
-// @Description  Generate presigned upload URL, client uses this URL to upload directly to MinIO
+// @Description  Generate presigned upload URL, client uses this URL to upload directly to MinIO. The signature covers file_size and mime_type: the PUT must send the returned upload_headers unchanged. With sha256 set, content already stored is reused: instant_upload is true, the file is active and no upload is needed; otherwise the hash is verified on completion. The file counts against the uploader's storage quota, or the group's with group_id set
 // @Tags         file
 // @Accept       json
 // @Produce      json
@@ -33,6 +33,8 @@ func NewFileHandler(clientManager *client.Manager) *FileHandler {
 // @Success      200      {object}  response.Response{data=object}  "success"
 // @Failure      400      {object}  response.Response  "parameter error"
 // @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      403      {object}  response.Response  "not a member of the group"
+// @Failure      429      {object}  response.Response  "storage quota exceeded"
 // @Failure      500      {object}  response.Response  "server error"
 // @Router       /files/upload-token [post]
 func (h *FileHandler) GenerateUploadToken(c *gin.Context) {
@@ -44,6 +46,8 @@ func (h *FileHandler) GenerateUploadToken(c *gin.Context) {
 		MimeType     string `json:"mime_type" binding:"required" example:"image/jpeg"`
 		FileType     int32  `json:"file_type" binding:"required,oneof=1 2 3 4 5" example:"1"`
 		ExpiresHours int32  `json:"expires_hours,omitempty" example:"0"`
+		SHA256       string `json:"sha256,omitempty" binding:"omitempty,len=64,hexadecimal" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+		GroupID      string `json:"group_id,omitempty" example:"group-123"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -51,14 +55,21 @@ func (h *FileHandler) GenerateUploadToken(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.clientManager.File().GenerateUploadToken(c.Request.Context(), &filepb.GenerateUploadTokenRequest{
+	grpcReq := &filepb.GenerateUploadTokenRequest{
 		UserId:       userID,
 		FileName:     req.FileName,
 		FileSize:     req.FileSize,
 		MimeType:     req.MimeType,
 		FileType:     filepb.FileType(req.FileType),
 		ExpiresHours: &req.ExpiresHours,
-	})
+	}
+	if req.SHA256 != "" {
+		grpcReq.Sha256 = &req.SHA256
+	}
+	if req.GroupID != "" {
+		grpcReq.GroupId = &req.GroupID
+	}
+	resp, err := h.clientManager.File().GenerateUploadToken(c.Request.Context(), grpcReq)
 
 	if err != nil {
 		handleGRPCError(c, err)
@@ -70,7 +81,7 @@ func (h *FileHandler) GenerateUploadToken(c *gin.Context) {
 
 // CompleteUpload complete file upload
 // @Summary      complete file upload
-// @Description  After client finishes upload, notify server to activate file
+// @Description  After client finishes upload, notify server to activate file. The stored size is checked against the declared size and the limit of the file type, and the magic bytes against file_type/mime_type; a mismatching object is deleted (70103/70115) and the detected MIME type is stored. Thumbnails, metadata and EXIF stripping run asynchronously, file.upload_completed is pushed when done
 // @Tags         file
 // @Accept       json
 // @Produce      json
@@ -106,7 +117,7 @@ func (h *FileHandler) CompleteUpload(c *gin.Context) {
 
 // GenerateDownloadURL generate file download URL
 // @Summary      generate file download URL
-// @Description  Generate presigned download URL, client uses this URL to download directly from MinIO
+// @Description  Generate presigned download URL, client uses this URL to download directly from MinIO. Available to the uploader and to participants of conversations the file was sent to. Images also get a URL for every generated thumbnail size
 // @Tags         file
 // @Accept       json
 // @Produce      json
@@ -154,7 +165,7 @@ func (h *FileHandler) GenerateDownloadURL(c *gin.Context) {
 
 // GetFileInfo get file info
 // @Summary      get file info
-// @Description  Get file metadata info
+// @Description  Get file metadata info. Available to the uploader and to participants of conversations the file was sent to
 // @Tags         file
 // @Accept       json
 // @Produce      json
@@ -281,3 +292,32 @@ func (h *FileHandler) ListFiles(c *gin.Context) {
 
 	response.Success(c, resp)
 }
+
+// GetStorageUsage get storage usage
+// @Summary      get storage usage
+// @Description  Total size of active files counted against the current user's quota, or against a group the user belongs to. quota_bytes 0 means unlimited; client logs do not count
+// @Tags         file
+// @Produce      json
+// @Security     BearerAuth
+// @Param        group_id  query  string  false  "group ID, usage of the group instead of the user"
+// @Success      200       {object}  response.Response{data=object}  "success"
+// @Failure      401       {object}  response.Response  "unauthorized"
+// @Failure      403       {object}  response.Response  "not a member of the group"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /files/storage-usage [get]
+func (h *FileHandler) GetStorageUsage(c *gin.Context) {
+	userID := gwmiddleware.GetUserID(c)
+
+	req := &filepb.GetStorageUsageRequest{UserId: userID}
+	if groupID := c.Query("group_id"); groupID != "" {
+		req.GroupId = &groupID
+	}
+
+	resp, err := h.clientManager.File().GetStorageUsage(c.Request.Context(), req)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	filepb "github.com/anychat/server/api/proto/file"
+	gwmiddleware "github.com/anychat/server/internal/gateway/middleware"
+	"github.com/anychat/server/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// InitiateMultipartUpload start a multipart upload
+// @Summary      start a multipart upload
+// @Description  Start a resumable upload for large files. The file is split into parts of part_size bytes (the last part may be smaller), each part is uploaded to its own presigned URL
+// @Tags         file
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      object  true  "file_name, file_size, mime_type, file_type, optional part_size (bytes, 5MB-100MB), expires_hours and group_id (count against the group's quota)"
+// @Success      200      {object}  response.Response{data=object}  "upload session"
+// @Failure      400      {object}  response.Response  "parameter error"
+// @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      403      {object}  response.Response  "not a member of the group"
+// @Failure      429      {object}  response.Response  "storage quota exceeded"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /files/multipart [post]
+func (h *FileHandler) InitiateMultipartUpload(c *gin.Context) {
+	userID := gwmiddleware.GetUserID(c)
+
+	var req struct {
+		FileName     string `json:"file_name" binding:"required" example:"video.mp4"`
+		FileSize     int64  `json:"file_size" binding:"required,gt=0" example:"524288000"`
+		MimeType     string `json:"mime_type" binding:"required" example:"video/mp4"`
+		FileType     int32  `json:"file_type" binding:"required,oneof=1 2 3 4 5" example:"2"`
+		PartSize     *int64 `json:"part_size,omitempty" example:"8388608"`
+		ExpiresHours int32  `json:"expires_hours,omitempty" example:"0"`
+		GroupID      string `json:"group_id,omitempty" example:"group-123"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	grpcReq := &filepb.InitiateMultipartUploadRequest{
+		UserId:       userID,
+		FileName:     req.FileName,
+		FileSize:     req.FileSize,
+		MimeType:     req.MimeType,
+		FileType:     filepb.FileType(req.FileType),
+		PartSize:     req.PartSize,
+		ExpiresHours: &req.ExpiresHours,
+	}
+	if req.GroupID != "" {
+		grpcReq.GroupId = &req.GroupID
+	}
+	resp, err := h.clientManager.File().InitiateMultipartUpload(c.Request.Context(), grpcReq)
+
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// GetPartUploadURLs get presigned part upload URLs
+// @Summary      get part upload URLs
+// @Description  Presign PUT URLs for up to 100 parts. Upload each part body to its URL; URLs can be requested again when they expire
+// @Tags         file
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        uploadId  path      string  true  "upload ID"
+// @Param        request   body      object  true  "part_numbers: 1-based part numbers"
+// @Success      200       {object}  response.Response{data=object}  "success"
+// @Failure      400       {object}  response.Response  "parameter error or upload closed"
+// @Failure      401       {object}  response.Response  "unauthorized"
+// @Failure      404       {object}  response.Response  "upload not found"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /files/multipart/{uploadId}/part-urls [post]
+func (h *FileHandler) GetPartUploadURLs(c *gin.Context) {
+	userID := gwmiddleware.GetUserID(c)
+	uploadID := c.Param("uploadId")
+
+	var req struct {
+		PartNumbers []int32 `json:"part_numbers" binding:"required,min=1,max=100" example:"1,2,3"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	resp, err := h.clientManager.File().GetPartUploadURLs(c.Request.Context(), &filepb.GetPartUploadURLsRequest{
+		UploadId:    uploadID,
+		UserId:      userID,
+		PartNumbers: req.PartNumbers,
+	})
+
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListUploadedParts list uploaded parts
+// @Summary      list uploaded parts
+// @Description  List the parts already stored, so an interrupted upload can resume with the missing parts
+// @Tags         file
+// @Produce      json
+// @Security     BearerAuth
+// @Param        uploadId  path      string  true  "upload ID"
+// @Success      200       {object}  response.Response{data=object}  "success"
+// @Failure      400       {object}  response.Response  "upload closed"
+// @Failure      401       {object}  response.Response  "unauthorized"
+// @Failure      404       {object}  response.Response  "upload not found"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /files/multipart/{uploadId}/parts [get]
+func (h *FileHandler) ListUploadedParts(c *gin.Context) {
+	userID := gwmiddleware.GetUserID(c)
+	uploadID := c.Param("uploadId")
+
+	resp, err := h.clientManager.File().ListUploadedParts(c.Request.Context(), &filepb.ListUploadedPartsRequest{
+		UploadId: uploadID,
+		UserId:   userID,
+	})
+
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// CompleteMultipartUpload complete a multipart upload
+// @Summary      complete a multipart upload
+// @Description  Assemble the uploaded parts into the file and activate it. All parts must be uploaded and add up to file_size
+// @Tags         file
+// @Produce      json
+// @Security     BearerAuth
+// @Param        uploadId  path      string  true  "upload ID"
+// @Success      200       {object}  response.Response{data=object}  "file info"
+// @Failure      400       {object}  response.Response  "parts missing or upload closed"
+// @Failure      401       {object}  response.Response  "unauthorized"
+// @Failure      404       {object}  response.Response  "upload not found"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /files/multipart/{uploadId}/complete [post]
+func (h *FileHandler) CompleteMultipartUpload(c *gin.Context) {
+	userID := gwmiddleware.GetUserID(c)
+	uploadID := c.Param("uploadId")
+
+	resp, err := h.clientManager.File().CompleteMultipartUpload(c.Request.Context(), &filepb.CompleteMultipartUploadRequest{
+		UploadId: uploadID,
+		UserId:   userID,
+	})
+
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// AbortMultipartUpload abort a multipart upload
+// @Summary      abort a multipart upload
+// @Description  Cancel the upload and free the uploaded parts
+// @Tags         file
+// @Produce      json
+// @Security     BearerAuth
+// @Param        uploadId  path      string  true  "upload ID"
+// @Success      200       {object}  response.Response  "success"
+// @Failure      400       {object}  response.Response  "upload already completed"
+// @Failure      401       {object}  response.Response  "unauthorized"
+// @Failure      404       {object}  response.Response  "upload not found"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /files/multipart/{uploadId} [delete]
+func (h *FileHandler) AbortMultipartUpload(c *gin.Context) {
+	userID := gwmiddleware.GetUserID(c)
+	uploadID := c.Param("uploadId")
+
+	_, err := h.clientManager.File().AbortMultipartUpload(c.Request.Context(), &filepb.AbortMultipartUploadRequest{
+		UploadId: uploadID,
+		UserId:   userID,
+	})
+
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, nil)
+}
@@ -127,7 +127,7 @@ func (h *GroupHandler) GetGroupInfo(c *gin.Context) {
 
 // UpdateGroup update group info
 // @Summary      update group info
-// @Description  Update group name, avatar, announcement etc (requires admin permission)
+// @Description  Update group name, announcement etc (requires admin permission). The avatar can only be cleared here
 // @Tags         group
 // @Accept       json
 // @Produce      json
@@ -166,6 +166,44 @@ func (h *GroupHandler) UpdateGroup(c *gin.Context) {
 	response.Success(c, nil)
 }
 
+// SetGroupAvatar set group avatar
+// @Summary      set group avatar
+// @Description  Crop an uploaded image to a square, resize it to the standard avatar sizes and bind it to the group (owner and admins).
+// @Description  Groups without an uploaded avatar show a composite of the first nine members' avatars
+// @Tags         group
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                          true  "group ID"
+// @Param        request  body      groupdto.SetGroupAvatarRequest  true  "uploaded image"
+// @Success      200      {object}  response.Response{data=object{avatar=string}}  "avatar set"
+// @Failure      400      {object}  response.Response  "parameter error or invalid image"
+// @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      403      {object}  response.Response  "no permission"
+// @Router       /groups/{id}/avatar [put]
+func (h *GroupHandler) SetGroupAvatar(c *gin.Context) {
+	userID := gwmiddleware.GetUserID(c)
+	groupID := c.Param("id")
+
+	var req groupdto.SetGroupAvatarRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	resp, err := h.clientManager.Group().SetGroupAvatar(c.Request.Context(), &grouppb.SetGroupAvatarRequest{
+		UserId:  userID,
+		GroupId: groupID,
+		FileId:  req.FileID,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"avatar": resp.Avatar})
+}
+
 // DissolveGroup dissolve group
 // @Summary      dissolve group
 // @Description  Dissolve group (owner only)
@@ -764,6 +802,7 @@ func (h *GroupHandler) UpdateGroupSettings(c *gin.Context) {
 		AllowViewHistory:  req.AllowViewHistory,
 		AllowAddFriend:    req.AllowAddFriend,
 		AllowMemberModify: req.AllowMemberModify,
+		SlowModeSeconds:   req.SlowModeSeconds,
 	})
 	if err != nil {
 		handleGRPCError(c, err)
@@ -789,6 +828,7 @@ func (h *GroupHandler) GetGroupSettings(c *gin.Context) {
 		"allow_view_history":  resp.AllowViewHistory,
 		"allow_add_friend":    resp.AllowAddFriend,
 		"allow_member_modify": resp.AllowMemberModify,
+		"slow_mode_seconds":   resp.SlowModeSeconds,
 	})
 }
 
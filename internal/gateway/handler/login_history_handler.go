@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/anychat/server/api/proto/auth"
+	gwmiddleware "github.com/anychat/server/internal/gateway/middleware"
+	"github.com/anychat/server/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// LoginHistoryEntry login attempt of the current user
+type LoginHistoryEntry struct {
+	ID          int64    `json:"id" example:"42"`
+	DeviceID    string   `json:"device_id" example:"device-uuid-123"`
+	DeviceType  int32    `json:"device_type" example:"1"`         // 1-ios 2-android 3-web 4-pc 5-h5
+	LoginMethod string   `json:"login_method" example:"password"` // password, code, qr, oidc
+	IPAddress   string   `json:"ip_address" example:"203.0.113.10"`
+	Location    string   `json:"location" example:"CN Shanghai"`
+	UserAgent   string   `json:"user_agent" example:"AnyChat/1.0.0 (iOS 17.0)"`
+	Result      int32    `json:"result" example:"1"` // 1-success 2-wrong password 3-blocked
+	Unusual     bool     `json:"unusual" example:"false"`
+	RiskReasons []string `json:"risk_reasons"` // new_device, new_network, new_country
+	Reported    bool     `json:"reported" example:"false"`
+	CreatedAt   int64    `json:"created_at" example:"1700000000"`
+}
+
+// ListLoginHistoryResponse login history response
+type ListLoginHistoryResponse struct {
+	Entries []*LoginHistoryEntry `json:"entries"`
+}
+
+// ReportUnusualLoginRequest "this wasn't me" request
+type ReportUnusualLoginRequest struct {
+	Token string `json:"token" binding:"required" example:"Jk3xQ9..."`
+}
+
+// ListLoginHistory list my login history
+// @Summary      list my login history
+// @Description  Recent logins and rejected password attempts, newest first, with device, IP, location and whether the login was flagged as unusual
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit  query     int  false  "max entries, default and max 100"
+// @Success      200    {object}  response.Response{data=ListLoginHistoryResponse}  "success"
+// @Failure      401    {object}  response.Response  "unauthorized"
+// @Failure      500    {object}  response.Response  "server error"
+// @Router       /auth/login-history [get]
+func (h *AuthHandler) ListLoginHistory(c *gin.Context) {
+	req := &authpb.ListLoginHistoryRequest{UserId: gwmiddleware.GetUserID(c)}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if limit, err := strconv.Atoi(limitStr); err == nil {
+			req.Limit = int32(limit)
+		}
+	}
+
+	resp, err := h.clientManager.Auth().ListLoginHistory(c.Request.Context(), req)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	entries := make([]*LoginHistoryEntry, 0, len(resp.Entries))
+	for _, entry := range resp.Entries {
+		reasons := entry.RiskReasons
+		if reasons == nil {
+			reasons = []string{}
+		}
+		entries = append(entries, &LoginHistoryEntry{
+			ID:          entry.Id,
+			DeviceID:    entry.DeviceId,
+			DeviceType:  int32(entry.DeviceType),
+			LoginMethod: entry.LoginMethod,
+			IPAddress:   entry.IpAddress,
+			Location:    entry.Location,
+			UserAgent:   entry.UserAgent,
+			Result:      int32(entry.Result),
+			Unusual:     entry.Unusual,
+			RiskReasons: reasons,
+			Reported:    entry.Reported,
+			CreatedAt:   entry.CreatedAt,
+		})
+	}
+
+	response.Success(c, &ListLoginHistoryResponse{Entries: entries})
+}
+
+// ReportUnusualLogin report an unusual login
+// @Summary      report an unusual login
+// @Description  Called from the "this wasn't me" link of an unusual login email: signs out the device that logged in and requires a password reset before the next password login. Each link works once
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      ReportUnusualLoginRequest  true  "report token from the email link"
+// @Success      200      {object}  response.Response  "success"
+// @Failure      400      {object}  response.Response  "link expired or already used"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/unusual-login/report [post]
+func (h *AuthHandler) ReportUnusualLogin(c *gin.Context) {
+	var req ReportUnusualLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	_, err := h.clientManager.Auth().ReportUnusualLogin(c.Request.Context(), &authpb.ReportUnusualLoginRequest{
+		Token: req.Token,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, nil)
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"strconv"
+	"strings"
 
 	conversationpb "github.com/anychat/server/api/proto/conversation"
 	messagepb "github.com/anychat/server/api/proto/message"
@@ -401,6 +402,69 @@ func (h *MessageHandler) GetMessageByID(c *gin.Context) {
 	response.Success(c, resp)
 }
 
+// ListConversationMedia list conversation media gallery
+// @Summary      list conversation media
+// @Description  Images, videos, files and links shared in a conversation, newest first, with download and thumbnail URLs
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        conversationId  path      string  true   "conversation ID"
+// @Param        categories      query     string  false  "comma separated categories (1-image/2-video/3-file/4-link), empty for all"
+// @Param        cursor          query     string  false  "next_cursor of the previous page"
+// @Param        limit           query     int32   false  "page size (default 20, max 100)"
+// @Success      200             {object}  response.Response{data=object}  "success"
+// @Failure      400             {object}  response.Response  "parameter error"
+// @Failure      401             {object}  response.Response  "unauthorized"
+// @Failure      403             {object}  response.Response  "not a group member"
+// @Failure      500             {object}  response.Response  "server error"
+// @Router       /conversations/{conversationId}/media [get]
+func (h *MessageHandler) ListConversationMedia(c *gin.Context) {
+	userID := gwmiddleware.GetUserID(c)
+	conversationID := c.Param("conversation_id")
+	if conversationID == "" {
+		response.ParamError(c, "conversation_id is required")
+		return
+	}
+
+	req := &messagepb.ListConversationMediaRequest{
+		ConversationId: conversationID,
+	}
+
+	if categories := c.Query("categories"); categories != "" {
+		for _, category := range strings.Split(categories, ",") {
+			categoryInt, err := strconv.Atoi(strings.TrimSpace(category))
+			if err != nil || categoryInt < int(messagepb.MediaCategory_MEDIA_CATEGORY_IMAGE) || categoryInt > int(messagepb.MediaCategory_MEDIA_CATEGORY_LINK) {
+				response.ParamError(c, "categories must be one of 1,2,3,4")
+				return
+			}
+			req.Categories = append(req.Categories, messagepb.MediaCategory(categoryInt))
+		}
+	}
+
+	if cursor := c.Query("cursor"); cursor != "" {
+		req.Cursor = &cursor
+	}
+
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil {
+			response.ParamError(c, "limit must be an integer")
+			return
+		}
+		req.Limit = int32(limit)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(c.Request.Context(), "x-user-id", userID)
+	resp, err := h.clientManager.Message().ListConversationMedia(ctx, req)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
 // SearchMessages search conversation messages
 // @Summary      search messages
 // @Description  Search messages by keyword and conversation scope
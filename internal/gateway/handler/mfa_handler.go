@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"github.com/anychat/server/api/proto/auth"
+	gwmiddleware "github.com/anychat/server/internal/gateway/middleware"
+	"github.com/anychat/server/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyLoginMFARequest complete login MFA challenge request
+type VerifyLoginMFARequest struct {
+	MFAToken string `json:"mfa_token" binding:"required" example:"3f9a0c1e2b4d5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e"`
+	Code     string `json:"code" binding:"required" example:"654321"` // TOTP or recovery code
+}
+
+// ConfirmMFAEnrollmentRequest confirm TOTP enrollment request
+type ConfirmMFAEnrollmentRequest struct {
+	Code string `json:"code" binding:"required" example:"654321"`
+}
+
+// MFAReauthRequest re-authentication for disabling MFA or regenerating recovery codes
+type MFAReauthRequest struct {
+	Password string `json:"password" example:"password123"`           // may be empty for accounts without password
+	Code     string `json:"code" binding:"required" example:"654321"` // TOTP or recovery code
+}
+
+// MFAStatusResponse MFA status response
+type MFAStatusResponse struct {
+	Enabled                bool  `json:"enabled" example:"true"`
+	RemainingRecoveryCodes int64 `json:"remaining_recovery_codes" example:"10"`
+}
+
+// BeginMFAEnrollmentResponse TOTP enrollment response
+type BeginMFAEnrollmentResponse struct {
+	Secret     string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	OtpauthURI string `json:"otpauth_uri" example:"otpauth://totp/AnyChat:user%40example.com?algorithm=SHA1&digits=6&issuer=AnyChat&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+}
+
+// MFARecoveryCodesResponse recovery codes response
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes" example:"k7m2p-x9q4r,a3c5e-h8j2n"`
+}
+
+// VerifyLoginMFA complete login with second factor
+// @Summary      complete login with second factor
+// @Description  When login returns mfa_required, submit the mfa_token with a TOTP or recovery code to obtain tokens. The token expires after 5 minutes or 5 wrong codes
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      VerifyLoginMFARequest  true  "MFA challenge"
+// @Success      200      {object}  response.Response{data=AuthResponse}  "login success"
+// @Failure      400      {object}  response.Response  "parameter error or wrong code"
+// @Failure      401      {object}  response.Response  "mfa_token invalid or expired"
+// @Failure      429      {object}  response.Response  "too many failed attempts"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/login/mfa [post]
+func (h *AuthHandler) VerifyLoginMFA(c *gin.Context) {
+	var req VerifyLoginMFARequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	resp, err := h.clientManager.Auth().VerifyLoginMFA(c.Request.Context(), &authpb.VerifyLoginMFARequest{
+		MfaToken: req.MFAToken,
+		Code:     req.Code,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, loginResult(resp))
+}
+
+// GetMFAStatus get MFA status
+// @Summary      get MFA status
+// @Description  Returns whether TOTP two-factor authentication is enabled and how many recovery codes are left
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200      {object}  response.Response{data=MFAStatusResponse}  "success"
+// @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/mfa [get]
+func (h *AuthHandler) GetMFAStatus(c *gin.Context) {
+	resp, err := h.clientManager.Auth().GetMFAStatus(c.Request.Context(), &authpb.MFAUserRequest{
+		UserId: gwmiddleware.GetUserID(c),
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"enabled":                  resp.Enabled,
+		"remaining_recovery_codes": resp.RemainingRecoveryCodes,
+	})
+}
+
+// BeginMFAEnrollment start TOTP enrollment
+// @Summary      start TOTP enrollment
+// @Description  Generates a new TOTP secret. Render otpauth_uri as a QR code for authenticator apps, then confirm with the first code. Calling again replaces the pending secret
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200      {object}  response.Response{data=BeginMFAEnrollmentResponse}  "success"
+// @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      409      {object}  response.Response  "MFA already enabled"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/mfa/enroll [post]
+func (h *AuthHandler) BeginMFAEnrollment(c *gin.Context) {
+	resp, err := h.clientManager.Auth().BeginMFAEnrollment(c.Request.Context(), &authpb.MFAUserRequest{
+		UserId: gwmiddleware.GetUserID(c),
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"secret":      resp.Secret,
+		"otpauth_uri": resp.OtpauthUri,
+	})
+}
+
+// ConfirmMFAEnrollment confirm TOTP enrollment
+// @Summary      confirm TOTP enrollment
+// @Description  Enables MFA after verifying the first TOTP code. Recovery codes are returned only once and must be saved by the user
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      ConfirmMFAEnrollmentRequest  true  "TOTP code"
+// @Success      200      {object}  response.Response{data=MFARecoveryCodesResponse}  "success"
+// @Failure      400      {object}  response.Response  "parameter error or wrong code"
+// @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      409      {object}  response.Response  "MFA already enabled"
+// @Failure      429      {object}  response.Response  "too many failed attempts"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/mfa/enroll/confirm [post]
+func (h *AuthHandler) ConfirmMFAEnrollment(c *gin.Context) {
+	var req ConfirmMFAEnrollmentRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	resp, err := h.clientManager.Auth().ConfirmMFAEnrollment(c.Request.Context(), &authpb.ConfirmMFAEnrollmentRequest{
+		UserId: gwmiddleware.GetUserID(c),
+		Code:   req.Code,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"recovery_codes": resp.RecoveryCodes,
+	})
+}
+
+// DisableMFA disable MFA
+// @Summary      disable MFA
+// @Description  Disables TOTP two-factor authentication and deletes all recovery codes. Requires the account password and a TOTP or recovery code
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      MFAReauthRequest  true  "re-authentication"
+// @Success      200      {object}  response.Response  "success"
+// @Failure      400      {object}  response.Response  "parameter error, wrong code or MFA not enabled"
+// @Failure      401      {object}  response.Response  "unauthorized or wrong password"
+// @Failure      429      {object}  response.Response  "too many failed attempts"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/mfa/disable [post]
+func (h *AuthHandler) DisableMFA(c *gin.Context) {
+	var req MFAReauthRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	if _, err := h.clientManager.Auth().DisableMFA(c.Request.Context(), h.mfaReauth(c, &req)); err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, nil)
+}
+
+// RegenerateRecoveryCodes regenerate recovery codes
+// @Summary      regenerate recovery codes
+// @Description  Invalidates all existing recovery codes and returns a new set. Requires the account password and a TOTP or recovery code
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      MFAReauthRequest  true  "re-authentication"
+// @Success      200      {object}  response.Response{data=MFARecoveryCodesResponse}  "success"
+// @Failure      400      {object}  response.Response  "parameter error, wrong code or MFA not enabled"
+// @Failure      401      {object}  response.Response  "unauthorized or wrong password"
+// @Failure      429      {object}  response.Response  "too many failed attempts"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/mfa/recovery-codes [post]
+func (h *AuthHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	var req MFAReauthRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	resp, err := h.clientManager.Auth().RegenerateRecoveryCodes(c.Request.Context(), h.mfaReauth(c, &req))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"recovery_codes": resp.RecoveryCodes,
+	})
+}
+
+// mfaReauth builds MFA re-authentication request for the current user
+func (h *AuthHandler) mfaReauth(c *gin.Context, req *MFAReauthRequest) *authpb.MFAReauthRequest {
+	return &authpb.MFAReauthRequest{
+		UserId:   gwmiddleware.GetUserID(c),
+		Password: req.Password,
+		Code:     req.Code,
+	}
+}
@@ -0,0 +1,302 @@
+package handler
+
+import (
+	"github.com/anychat/server/api/proto/auth"
+	commonpb "github.com/anychat/server/api/proto/common"
+	authmodel "github.com/anychat/server/internal/auth/model"
+	gwmiddleware "github.com/anychat/server/internal/gateway/middleware"
+	"github.com/anychat/server/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// OIDCProviderInfo configured identity provider
+type OIDCProviderInfo struct {
+	Name        string `json:"name" example:"zitadel"`
+	DisplayName string `json:"display_name" example:"ZITADEL"`
+}
+
+// ListOIDCProvidersResponse identity provider list response
+type ListOIDCProvidersResponse struct {
+	Providers []*OIDCProviderInfo `json:"providers"`
+}
+
+// BeginOIDCLoginRequest start OIDC authorization request
+type BeginOIDCLoginRequest struct {
+	RedirectURI string `json:"redirect_uri" example:"https://app.anychat.im/oidc/callback"` // must be registered for the provider, empty uses the default
+}
+
+// BeginOIDCLoginResponse authorization to open in a browser
+type BeginOIDCLoginResponse struct {
+	AuthorizationURL string `json:"authorization_url" example:"https://sso.example.com/oauth/v2/authorize?client_id=..."`
+	State            string `json:"state" example:"Jx3ZbH0kq2cT9yV6fW1sNQ"`
+	ExpiresIn        int64  `json:"expires_in" example:"600"`
+}
+
+// CompleteOIDCLoginRequest OIDC callback login request
+type CompleteOIDCLoginRequest struct {
+	Code          string  `json:"code" binding:"required" example:"wVqk8n2HbJ"`
+	State         string  `json:"state" binding:"required" example:"Jx3ZbH0kq2cT9yV6fW1sNQ"`
+	DeviceType    int16   `json:"device_type" binding:"required,oneof=1 2 3 4 5" example:"3"`
+	DeviceID      string  `json:"device_id" binding:"required" example:"device-uuid-123"`
+	ClientVersion string  `json:"client_version" binding:"required" example:"1.0.0"`
+	Nickname      *string `json:"nickname" example:"张三"` // used only when a new account is created
+}
+
+// LinkIdentityRequest link identity request
+type LinkIdentityRequest struct {
+	Code  string `json:"code" binding:"required" example:"wVqk8n2HbJ"`
+	State string `json:"state" binding:"required" example:"Jx3ZbH0kq2cT9yV6fW1sNQ"`
+}
+
+// IdentityInfo identity linked to the current user
+type IdentityInfo struct {
+	Provider    string `json:"provider" example:"zitadel"`
+	DisplayName string `json:"display_name" example:"ZITADEL"`
+	Email       string `json:"email" example:"user@example.com"`
+	LinkedAt    int64  `json:"linked_at" example:"1700000000"`
+	LastLoginAt int64  `json:"last_login_at" example:"1700003600"`
+}
+
+// ListIdentitiesResponse linked identity list response
+type ListIdentitiesResponse struct {
+	Identities []*IdentityInfo `json:"identities"`
+}
+
+// ListOIDCProviders list identity providers
+// @Summary      list identity providers
+// @Description  OIDC identity providers the client can offer for single sign-on
+// @Tags         auth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=ListOIDCProvidersResponse}  "success"
+// @Failure      500  {object}  response.Response  "server error"
+// @Router       /auth/oidc/providers [get]
+func (h *AuthHandler) ListOIDCProviders(c *gin.Context) {
+	resp, err := h.clientManager.Auth().ListOIDCProviders(c.Request.Context(), &commonpb.Empty{})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	providers := make([]*OIDCProviderInfo, 0, len(resp.Providers))
+	for _, provider := range resp.Providers {
+		providers = append(providers, &OIDCProviderInfo{
+			Name:        provider.Name,
+			DisplayName: provider.DisplayName,
+		})
+	}
+
+	response.Success(c, &ListOIDCProvidersResponse{Providers: providers})
+}
+
+// BeginOIDCLogin start OIDC login
+// @Summary      start OIDC login
+// @Description  Creates an authorization request (authorization code + PKCE). Open authorization_url in a browser, the provider redirects back to redirect_uri with code and state
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        provider  path      string                 true   "identity provider name"
+// @Param        request   body      BeginOIDCLoginRequest  false  "redirect URI"
+// @Success      200       {object}  response.Response{data=BeginOIDCLoginResponse}  "success"
+// @Failure      400       {object}  response.Response  "redirect URI not registered"
+// @Failure      404       {object}  response.Response  "identity provider not found"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /auth/oidc/{provider}/authorize [post]
+func (h *AuthHandler) BeginOIDCLogin(c *gin.Context) {
+	h.beginOIDCAuthorization(c, "")
+}
+
+// CompleteOIDCLogin OIDC login callback
+// @Summary      OIDC login callback
+// @Description  Exchanges the authorization code and signs in the user the identity belongs to. An unknown identity is linked to the account with the same verified email, or a new account is created when the provider allows it. May return mfa_required like password login
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        provider  path      string                    true  "identity provider name"
+// @Param        request   body      CompleteOIDCLoginRequest  true  "callback parameters and device info"
+// @Success      200       {object}  response.Response{data=AuthResponse}  "login success"
+// @Failure      400       {object}  response.Response  "parameter error or invalid state"
+// @Failure      401       {object}  response.Response  "identity provider authentication failed"
+// @Failure      404       {object}  response.Response  "identity not linked to any account"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /auth/oidc/{provider}/callback [post]
+func (h *AuthHandler) CompleteOIDCLogin(c *gin.Context) {
+	var req CompleteOIDCLoginRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	deviceType := authmodel.DeviceType(req.DeviceType)
+	if !deviceType.IsValid() {
+		response.ParamError(c, "invalid device_type")
+		return
+	}
+
+	resp, err := h.clientManager.Auth().CompleteOIDCLogin(c.Request.Context(), &authpb.CompleteOIDCLoginRequest{
+		Provider:      c.Param("provider"),
+		Code:          req.Code,
+		State:         req.State,
+		DeviceType:    authpb.DeviceType(deviceType),
+		DeviceId:      req.DeviceID,
+		ClientVersion: req.ClientVersion,
+		IpAddress:     c.ClientIP(),
+		UserAgent:     c.Request.UserAgent(),
+		Nickname:      req.Nickname,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, loginResult(resp))
+}
+
+// BeginLinkIdentity start linking an identity
+// @Summary      start linking an identity
+// @Description  Same as OIDC login authorize, but the state is bound to the current user and can only be completed by POST /auth/identities/{provider}
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider  path      string                 true   "identity provider name"
+// @Param        request   body      BeginOIDCLoginRequest  false  "redirect URI"
+// @Success      200       {object}  response.Response{data=BeginOIDCLoginResponse}  "success"
+// @Failure      400       {object}  response.Response  "redirect URI not registered"
+// @Failure      401       {object}  response.Response  "unauthorized"
+// @Failure      404       {object}  response.Response  "identity provider not found"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /auth/identities/{provider}/authorize [post]
+func (h *AuthHandler) BeginLinkIdentity(c *gin.Context) {
+	h.beginOIDCAuthorization(c, gwmiddleware.GetUserID(c))
+}
+
+// LinkIdentity link an identity
+// @Summary      link an identity
+// @Description  Completes a link authorization and attaches the provider identity to the current user
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider  path      string               true  "identity provider name"
+// @Param        request   body      LinkIdentityRequest  true  "callback parameters"
+// @Success      200       {object}  response.Response{data=IdentityInfo}  "success"
+// @Failure      400       {object}  response.Response  "parameter error or invalid state"
+// @Failure      401       {object}  response.Response  "unauthorized or identity provider authentication failed"
+// @Failure      409       {object}  response.Response  "identity already linked"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /auth/identities/{provider} [post]
+func (h *AuthHandler) LinkIdentity(c *gin.Context) {
+	var req LinkIdentityRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	resp, err := h.clientManager.Auth().LinkIdentity(c.Request.Context(), &authpb.LinkIdentityRequest{
+		UserId:   gwmiddleware.GetUserID(c),
+		Provider: c.Param("provider"),
+		Code:     req.Code,
+		State:    req.State,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, toIdentityInfo(resp))
+}
+
+// ListIdentities list linked identities
+// @Summary      list linked identities
+// @Description  Identity providers linked to the current user
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  response.Response{data=ListIdentitiesResponse}  "success"
+// @Failure      401  {object}  response.Response  "unauthorized"
+// @Failure      500  {object}  response.Response  "server error"
+// @Router       /auth/identities [get]
+func (h *AuthHandler) ListIdentities(c *gin.Context) {
+	resp, err := h.clientManager.Auth().ListIdentities(c.Request.Context(), &authpb.ListIdentitiesRequest{
+		UserId: gwmiddleware.GetUserID(c),
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	identities := make([]*IdentityInfo, 0, len(resp.Identities))
+	for _, identity := range resp.Identities {
+		identities = append(identities, toIdentityInfo(identity))
+	}
+
+	response.Success(c, &ListIdentitiesResponse{Identities: identities})
+}
+
+// UnlinkIdentity unlink an identity
+// @Summary      unlink an identity
+// @Description  Removes a linked identity. Refused when it is the only way left to sign in
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider  path      string  true  "identity provider name"
+// @Success      200       {object}  response.Response  "success"
+// @Failure      401       {object}  response.Response  "unauthorized"
+// @Failure      403       {object}  response.Response  "last sign-in method"
+// @Failure      404       {object}  response.Response  "identity not found"
+// @Failure      500       {object}  response.Response  "server error"
+// @Router       /auth/identities/{provider} [delete]
+func (h *AuthHandler) UnlinkIdentity(c *gin.Context) {
+	_, err := h.clientManager.Auth().UnlinkIdentity(c.Request.Context(), &authpb.UnlinkIdentityRequest{
+		UserId:   gwmiddleware.GetUserID(c),
+		Provider: c.Param("provider"),
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, nil)
+}
+
+// beginOIDCAuthorization starts a login authorization, or a link authorization when userID is set
+func (h *AuthHandler) beginOIDCAuthorization(c *gin.Context, userID string) {
+	var req BeginOIDCLoginRequest
+
+	// body is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.ParamError(c, err.Error())
+			return
+		}
+	}
+
+	resp, err := h.clientManager.Auth().BeginOIDCLogin(c.Request.Context(), &authpb.BeginOIDCLoginRequest{
+		Provider:    c.Param("provider"),
+		RedirectUri: req.RedirectURI,
+		UserId:      userID,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, &BeginOIDCLoginResponse{
+		AuthorizationURL: resp.AuthorizationUrl,
+		State:            resp.State,
+		ExpiresIn:        resp.ExpiresIn,
+	})
+}
+
+// toIdentityInfo converts a linked identity
+func toIdentityInfo(identity *authpb.IdentityInfo) *IdentityInfo {
+	return &IdentityInfo{
+		Provider:    identity.Provider,
+		DisplayName: identity.DisplayName,
+		Email:       identity.Email,
+		LinkedAt:    identity.LinkedAt,
+		LastLoginAt: identity.LastLoginAt,
+	}
+}
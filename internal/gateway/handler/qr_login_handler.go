@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/anychat/server/api/proto/auth"
+	authmodel "github.com/anychat/server/internal/auth/model"
+	gwmiddleware "github.com/anychat/server/internal/gateway/middleware"
+	"github.com/anychat/server/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateQRLoginRequest create QR login ticket request
+type CreateQRLoginRequest struct {
+	DeviceType    int16  `json:"device_type" binding:"required,oneof=3 4 5" example:"3"`
+	DeviceID      string `json:"device_id" binding:"required" example:"web-device-uuid-123"`
+	ClientVersion string `json:"client_version" binding:"required" example:"1.0.0"`
+}
+
+// QRLoginActionRequest scan/confirm/cancel QR login request
+type QRLoginActionRequest struct {
+	Ticket string `json:"ticket" binding:"required" example:"9f2c4e0b7a1d4c3e8b6a5f4e3d2c1b0a"`
+}
+
+// QRLoginTicketResponse QR login ticket response
+type QRLoginTicketResponse struct {
+	Ticket     string `json:"ticket" example:"9f2c4e0b7a1d4c3e8b6a5f4e3d2c1b0a"`
+	QRContent  string `json:"qr_content" example:"anychat://qr-login?ticket=9f2c4e0b7a1d4c3e8b6a5f4e3d2c1b0a"`
+	ExpiresIn  int64  `json:"expires_in" example:"120"`
+	PollSecret string `json:"poll_secret" example:"3b9f0c1e5a7d4f2b8c6e0a9d1f3b5c7e9a2d4f6b8c0e1a3d5f7b9c2e4a6d8f0b"` // keep private, required when polling status
+}
+
+// QRLoginStatusResponse QR login status response
+type QRLoginStatusResponse struct {
+	Status int32         `json:"status" example:"1"` // 1-pending 2-scanned 3-confirmed 4-cancelled 5-expired
+	Login  *AuthResponse `json:"login,omitempty"`
+}
+
+// QRLoginScanResponse desktop device waiting for confirmation
+type QRLoginScanResponse struct {
+	DeviceType    int32  `json:"device_type" example:"3"`
+	ClientVersion string `json:"client_version" example:"1.0.0"`
+	IpAddress     string `json:"ip_address" example:"203.0.113.10"`
+	ExpiresIn     int64  `json:"expires_in" example:"95"`
+}
+
+// CreateQRLoginTicket create QR login ticket
+// @Summary      create QR login ticket
+// @Description  Web/PC/H5 client requests a short-lived ticket and renders qr_content as a QR code
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      CreateQRLoginRequest  true  "desktop device info"
+// @Success      200      {object}  response.Response{data=QRLoginTicketResponse}  "success"
+// @Failure      400      {object}  response.Response  "parameter error"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/qr-login/ticket [post]
+func (h *AuthHandler) CreateQRLoginTicket(c *gin.Context) {
+	var req CreateQRLoginRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	deviceType := authmodel.DeviceType(req.DeviceType)
+	if !deviceType.IsValid() {
+		response.ParamError(c, "invalid device_type")
+		return
+	}
+
+	resp, err := h.clientManager.Auth().CreateQRLoginTicket(c.Request.Context(), &authpb.CreateQRLoginTicketRequest{
+		DeviceType:    authpb.DeviceType(deviceType),
+		DeviceId:      req.DeviceID,
+		ClientVersion: req.ClientVersion,
+		IpAddress:     c.ClientIP(),
+		UserAgent:     c.Request.UserAgent(),
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"ticket":      resp.Ticket,
+		"qr_content":  resp.QrContent,
+		"expires_in":  resp.ExpiresIn,
+		"poll_secret": resp.PollSecret,
+	})
+}
+
+// GetQRLoginStatus poll QR login status
+// @Summary      poll QR login status
+// @Description  Long-polls until the ticket status differs from last_status or wait_seconds elapses. Returns login tokens once confirmed
+// @Tags         auth
+// @Produce      json
+// @Param        ticket        query     string  true   "ticket"
+// @Param        device_id     query     string  true   "device ID that created the ticket"
+// @Param        poll_secret   query     string  true   "poll secret returned when the ticket was created"
+// @Param        last_status   query     int     false  "status already known by the client"
+// @Param        wait_seconds  query     int     false  "long-poll wait seconds, max 30"
+// @Success      200           {object}  response.Response{data=QRLoginStatusResponse}  "success"
+// @Failure      400           {object}  response.Response  "parameter error"
+// @Failure      500           {object}  response.Response  "server error"
+// @Router       /auth/qr-login/status [get]
+func (h *AuthHandler) GetQRLoginStatus(c *gin.Context) {
+	ticket := c.Query("ticket")
+	deviceID := c.Query("device_id")
+	pollSecret := c.Query("poll_secret")
+	if ticket == "" || deviceID == "" || pollSecret == "" {
+		response.ParamError(c, "ticket, device_id and poll_secret required")
+		return
+	}
+	lastStatus, _ := strconv.Atoi(c.DefaultQuery("last_status", "0"))
+	waitSeconds, _ := strconv.Atoi(c.DefaultQuery("wait_seconds", "0"))
+
+	resp, err := h.clientManager.Auth().GetQRLoginStatus(c.Request.Context(), &authpb.GetQRLoginStatusRequest{
+		Ticket:      ticket,
+		DeviceId:    deviceID,
+		PollSecret:  pollSecret,
+		LastStatus:  authpb.QRLoginStatus(lastStatus),
+		WaitSeconds: int32(waitSeconds),
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	result := gin.H{
+		"status": int32(resp.Status),
+	}
+	if resp.Login != nil {
+		result["login"] = loginResult(resp.Login)
+	}
+
+	response.Success(c, result)
+}
+
+// ScanQRLogin scan QR login ticket
+// @Summary      scan QR login ticket
+// @Description  Logged-in mobile client reports the scanned ticket and receives the desktop device info to show on the confirmation screen
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      QRLoginActionRequest  true  "ticket"
+// @Success      200      {object}  response.Response{data=QRLoginScanResponse}  "success"
+// @Failure      400      {object}  response.Response  "parameter error or ticket already scanned"
+// @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      403      {object}  response.Response  "not a mobile device"
+// @Failure      404      {object}  response.Response  "ticket expired"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/qr-login/scan [post]
+func (h *AuthHandler) ScanQRLogin(c *gin.Context) {
+	var req QRLoginActionRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	resp, err := h.clientManager.Auth().ScanQRLogin(c.Request.Context(), h.qrLoginAction(c, req.Ticket))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"device_type":    int32(resp.DeviceType),
+		"client_version": resp.ClientVersion,
+		"ip_address":     resp.IpAddress,
+		"expires_in":     resp.ExpiresIn,
+	})
+}
+
+// ConfirmQRLogin confirm QR login
+// @Summary      confirm QR login
+// @Description  Mobile client that scanned the ticket confirms login; the waiting desktop receives tokens on its next poll
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      QRLoginActionRequest  true  "ticket"
+// @Success      200      {object}  response.Response  "success"
+// @Failure      400      {object}  response.Response  "parameter error or ticket not scanned by this device"
+// @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      404      {object}  response.Response  "ticket expired"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/qr-login/confirm [post]
+func (h *AuthHandler) ConfirmQRLogin(c *gin.Context) {
+	var req QRLoginActionRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	if _, err := h.clientManager.Auth().ConfirmQRLogin(c.Request.Context(), h.qrLoginAction(c, req.Ticket)); err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, nil)
+}
+
+// CancelQRLogin cancel QR login
+// @Summary      cancel QR login
+// @Description  Mobile client that scanned the ticket rejects the login
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      QRLoginActionRequest  true  "ticket"
+// @Success      200      {object}  response.Response  "success"
+// @Failure      400      {object}  response.Response  "parameter error or ticket not scanned by this device"
+// @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      404      {object}  response.Response  "ticket expired"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /auth/qr-login/cancel [post]
+func (h *AuthHandler) CancelQRLogin(c *gin.Context) {
+	var req QRLoginActionRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	if _, err := h.clientManager.Auth().CancelQRLogin(c.Request.Context(), h.qrLoginAction(c, req.Ticket)); err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	response.Success(c, nil)
+}
+
+// qrLoginAction builds QR login action request from the authenticated mobile device
+func (h *AuthHandler) qrLoginAction(c *gin.Context, ticket string) *authpb.QRLoginActionRequest {
+	return &authpb.QRLoginActionRequest{
+		UserId:     gwmiddleware.GetUserID(c),
+		DeviceId:   gwmiddleware.GetDeviceID(c),
+		DeviceType: authpb.DeviceType(gwmiddleware.GetDeviceType(c)),
+		Ticket:     ticket,
+		IpAddress:  c.ClientIP(),
+	}
+}
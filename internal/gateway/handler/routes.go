@@ -36,8 +36,16 @@ func RegisterRoutes(r *gin.Engine, clientManager *client.Manager, jwtManager *jw
 			auth.POST("/send-code", authHandler.SendCode)
 			auth.POST("/register", authHandler.Register)
 			auth.POST("/login", authHandler.Login)
+			auth.POST("/login/code", authHandler.LoginByCode)
+			auth.POST("/login/mfa", authHandler.VerifyLoginMFA)
 			auth.POST("/refresh", authHandler.RefreshToken)
 			auth.POST("/password/reset", authHandler.ResetPassword)
+			auth.POST("/qr-login/ticket", authHandler.CreateQRLoginTicket)
+			auth.GET("/qr-login/status", authHandler.GetQRLoginStatus)
+			auth.GET("/oidc/providers", authHandler.ListOIDCProviders)
+			auth.POST("/oidc/:provider/authorize", authHandler.BeginOIDCLogin)
+			auth.POST("/oidc/:provider/callback", authHandler.CompleteOIDCLogin)
+			auth.POST("/unusual-login/report", authHandler.ReportUnusualLogin)
 		}
 
 		// group QR code preview (no auth required)
@@ -53,6 +61,25 @@ func RegisterRoutes(r *gin.Engine, clientManager *client.Manager, jwtManager *jw
 			{
 				authGroup.POST("/logout", authHandler.Logout)
 				authGroup.POST("/password/change", authHandler.ChangePassword)
+				authGroup.POST("/qr-login/scan", authHandler.ScanQRLogin)
+				authGroup.POST("/qr-login/confirm", authHandler.ConfirmQRLogin)
+				authGroup.POST("/qr-login/cancel", authHandler.CancelQRLogin)
+				authGroup.GET("/mfa", authHandler.GetMFAStatus)
+				authGroup.POST("/mfa/enroll", authHandler.BeginMFAEnrollment)
+				authGroup.POST("/mfa/enroll/confirm", authHandler.ConfirmMFAEnrollment)
+				authGroup.POST("/mfa/disable", authHandler.DisableMFA)
+				authGroup.POST("/mfa/recovery-codes", authHandler.RegenerateRecoveryCodes)
+				authGroup.GET("/devices", authHandler.ListDevices)
+				authGroup.POST("/devices/logout-others", authHandler.LogoutOtherDevices)
+				authGroup.DELETE("/devices/:deviceId", authHandler.LogoutDevice)
+				authGroup.PUT("/devices/:deviceId/name", authHandler.RenameDevice)
+				authGroup.GET("/login-history", authHandler.ListLoginHistory)
+				authGroup.POST("/account/delete", authHandler.DeleteAccount)
+				authGroup.GET("/account/deletion", authHandler.GetAccountDeletion)
+				authGroup.GET("/identities", authHandler.ListIdentities)
+				authGroup.POST("/identities/:provider/authorize", authHandler.BeginLinkIdentity)
+				authGroup.POST("/identities/:provider", authHandler.LinkIdentity)
+				authGroup.DELETE("/identities/:provider", authHandler.UnlinkIdentity)
 			}
 
 			// Version routes (client version check - public)
@@ -75,6 +102,7 @@ func RegisterRoutes(r *gin.Engine, clientManager *client.Manager, jwtManager *jw
 				// personal profile
 				users.GET("/me", userHandler.GetProfile)
 				users.PUT("/me", userHandler.UpdateProfile)
+				users.PUT("/me/avatar", userHandler.SetAvatar)
 				users.POST("/me/phone/bind", userHandler.BindPhone)
 				users.POST("/me/phone/change", userHandler.ChangePhone)
 				users.POST("/me/email/bind", userHandler.BindEmail)
@@ -126,6 +154,7 @@ func RegisterRoutes(r *gin.Engine, clientManager *client.Manager, jwtManager *jw
 				groups.POST("/join-by-qrcode", groupHandler.JoinGroupByQRCode)
 				groups.GET("/:id", groupHandler.GetGroupInfo)
 				groups.PUT("/:id", groupHandler.UpdateGroup)
+				groups.PUT("/:id/avatar", groupHandler.SetGroupAvatar)
 				groups.DELETE("/:id", groupHandler.DissolveGroup)
 
 				// member management
@@ -171,11 +200,17 @@ func RegisterRoutes(r *gin.Engine, clientManager *client.Manager, jwtManager *jw
 			files := authorized.Group("/files")
 			{
 				files.POST("/upload-token", fileHandler.GenerateUploadToken)
+				files.GET("/storage-usage", fileHandler.GetStorageUsage)
 				files.POST("/:fileId/complete", fileHandler.CompleteUpload)
 				files.GET("/:fileId/download", fileHandler.GenerateDownloadURL)
 				files.GET("/:fileId", fileHandler.GetFileInfo)
 				files.DELETE("/:fileId", fileHandler.DeleteFile)
 				files.GET("", fileHandler.ListFiles)
+				files.POST("/multipart", fileHandler.InitiateMultipartUpload)
+				files.POST("/multipart/:uploadId/part-urls", fileHandler.GetPartUploadURLs)
+				files.GET("/multipart/:uploadId/parts", fileHandler.ListUploadedParts)
+				files.POST("/multipart/:uploadId/complete", fileHandler.CompleteMultipartUpload)
+				files.DELETE("/multipart/:uploadId", fileHandler.AbortMultipartUpload)
 			}
 
 			// Log routes
@@ -212,6 +247,7 @@ func RegisterRoutes(r *gin.Engine, clientManager *client.Manager, jwtManager *jw
 				conversations.GET("/:conversation_id/messages/unread-count", conversationHandler.GetMessageUnreadCount)
 				conversations.GET("/:conversation_id/messages/read-receipts", conversationHandler.GetMessageReadReceipts)
 				conversations.GET("/:conversation_id/messages/sequence", conversationHandler.GetMessageSequence)
+				conversations.GET("/:conversation_id/media", messageHandler.ListConversationMedia)
 				conversations.POST("/:conversation_id/messages/read", conversationHandler.MarkMessagesRead)
 				conversations.DELETE("/:conversation_id", conversationHandler.DeleteConversation)
 				conversations.PUT("/:conversation_id/pin", conversationHandler.SetPinned)
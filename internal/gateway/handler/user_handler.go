@@ -20,13 +20,18 @@ type UserHandler struct {
 // UpdateProfileRequest update profile request
 type UpdateProfileRequest struct {
 	Nickname  *string    `json:"nickname" example:"张三"`
-	Avatar    *string    `json:"avatar" example:"https://example.com/avatar.jpg"`
+	Avatar    *string    `json:"avatar" example:""` // only "" to clear it, use PUT /users/me/avatar to set one
 	Signature *string    `json:"signature" example:"这是我的个性签名"`
 	Gender    *int32     `json:"gender" example:"1" enums:"0,1,2"`
 	Birthday  *time.Time `json:"birthday" example:"1990-01-01T00:00:00Z"`
 	Region    *string    `json:"region" example:"北京"`
 }
 
+// SetAvatarRequest set avatar request
+type SetAvatarRequest struct {
+	FileID string `json:"file_id" binding:"required" example:"file-123"` // image uploaded through the file API
+}
+
 // UserProfile user profile
 type UserProfile struct {
 	UserID    string     `json:"user_id" example:"user-123"`
@@ -100,6 +105,7 @@ type ChangePhoneRequest struct {
 	NewPhoneNumber string  `json:"new_phone_number" binding:"required" example:"13900139000"`
 	NewVerifyCode  string  `json:"new_verify_code" binding:"required" example:"123456"`
 	OldVerifyCode  *string `json:"old_verify_code,omitempty" example:"123456"`
+	MFACode        string  `json:"mfa_code,omitempty" example:"654321"` // required when MFA is enabled
 }
 
 // BindEmailRequest bind email request
@@ -114,6 +120,7 @@ type ChangeEmailRequest struct {
 	NewEmail      string  `json:"new_email" binding:"required" example:"new@example.com"`
 	NewVerifyCode string  `json:"new_verify_code" binding:"required" example:"123456"`
 	OldVerifyCode *string `json:"old_verify_code,omitempty" example:"123456"`
+	MFACode       string  `json:"mfa_code,omitempty" example:"654321"` // required when MFA is enabled
 }
 
 // NewUserHandler creates user handler
@@ -231,6 +238,62 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 	response.Success(c, result)
 }
 
+// SetAvatar set avatar
+// @Summary      set avatar
+// @Description  Crop an uploaded image to a square, resize it to the standard avatar sizes and bind it to the profile.
+// @Description  The image must be uploaded by the current user, JPEG, PNG or GIF, at least 64x64
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      SetAvatarRequest  true  "uploaded image"
+// @Success      200      {object}  response.Response{data=UserProfile}  "avatar set"
+// @Failure      400      {object}  response.Response  "parameter error or invalid image"
+// @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      500      {object}  response.Response  "server error"
+// @Router       /users/me/avatar [put]
+func (h *UserHandler) SetAvatar(c *gin.Context) {
+	var req SetAvatarRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ParamError(c, err.Error())
+		return
+	}
+
+	userID := gwmiddleware.GetUserID(c)
+
+	resp, err := h.clientManager.User().SetAvatar(c.Request.Context(), &userpb.SetAvatarRequest{
+		UserId: userID,
+		FileId: req.FileID,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	result := gin.H{
+		"user_id":    resp.UserId,
+		"nickname":   resp.Nickname,
+		"avatar":     resp.Avatar,
+		"signature":  resp.Signature,
+		"gender":     resp.Gender,
+		"region":     resp.Region,
+		"qrcode_url": resp.QrcodeUrl,
+		"created_at": resp.CreatedAt.AsTime(),
+	}
+
+	if resp.Birthday != nil {
+		result["birthday"] = resp.Birthday.AsTime()
+	}
+	if resp.Phone != nil {
+		result["phone"] = *resp.Phone
+	}
+	if resp.Email != nil {
+		result["email"] = *resp.Email
+	}
+
+	response.Success(c, result)
+}
+
 // GetUserInfo get user info
 // @Summary      get user info
 // @Description  Get public info of specified user
@@ -542,6 +605,7 @@ func (h *UserHandler) BindPhone(c *gin.Context) {
 // @Success      200      {object}  response.Response{data=object}  "change success"
 // @Failure      400      {object}  response.Response  "parameter error"
 // @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      403      {object}  response.Response  "MFA code required"
 // @Failure      409      {object}  response.Response  "phone number already in use"
 // @Failure      500      {object}  response.Response  "server error"
 // @Router       /users/me/phone/change [post]
@@ -564,6 +628,9 @@ func (h *UserHandler) ChangePhone(c *gin.Context) {
 	if req.OldVerifyCode != nil {
 		pbReq.OldVerifyCode = req.OldVerifyCode
 	}
+	if req.MFACode != "" {
+		pbReq.MfaCode = &req.MFACode
+	}
 
 	resp, err := h.clientManager.User().ChangePhone(c.Request.Context(), pbReq)
 	if err != nil {
@@ -626,6 +693,7 @@ func (h *UserHandler) BindEmail(c *gin.Context) {
 // @Success      200      {object}  response.Response{data=object}  "change success"
 // @Failure      400      {object}  response.Response  "parameter error"
 // @Failure      401      {object}  response.Response  "unauthorized"
+// @Failure      403      {object}  response.Response  "MFA code required"
 // @Failure      409      {object}  response.Response  "email already in use"
 // @Failure      500      {object}  response.Response  "server error"
 // @Router       /users/me/email/change [post]
@@ -648,6 +716,9 @@ func (h *UserHandler) ChangeEmail(c *gin.Context) {
 	if req.OldVerifyCode != nil {
 		pbReq.OldVerifyCode = req.OldVerifyCode
 	}
+	if req.MFACode != "" {
+		pbReq.MfaCode = &req.MFACode
+	}
 
 	resp, err := h.clientManager.User().ChangeEmail(c.Request.Context(), pbReq)
 	if err != nil {
@@ -15,6 +15,8 @@ import (
 	"github.com/gin-gonic/gin"
 	gorillaws "github.com/gorilla/websocket"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
 )
 
 var upgrader = gorillaws.Upgrader{
@@ -201,6 +203,10 @@ func (h *WSHandler) handleSendMessage(c *websocket.Client, payload json.RawMessa
 			Message: err.Error(),
 			LocalID: req.LocalID,
 		}
+		if reason, message, ok := sendRestrictionReason(err); ok {
+			wsErr.Code = reason
+			wsErr.Message = message
+		}
 		errData, _ := json.Marshal(wsErr)
 		h.wsManager.SendMessageToUser(c.UserID, &websocket.Message{
 			Type:    "message.error",
@@ -229,6 +235,20 @@ func (h *WSHandler) handleSendMessage(c *websocket.Client, payload json.RawMessa
 	h.wsManager.SendMessageToUser(c.UserID, wsResp)
 }
 
+// sendRestrictionReason returns the reason of a send rejected by a mute or slow mode, e.g. member_muted
+func sendRestrictionReason(err error) (string, string, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return "", "", false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason != "" {
+			return info.Reason, st.Message(), true
+		}
+	}
+	return "", "", false
+}
+
 func (h *WSHandler) handleSendTyping(c *websocket.Client, payload json.RawMessage) {
 	var req sendTypingPayload
 	if err := json.Unmarshal(payload, &req); err != nil {
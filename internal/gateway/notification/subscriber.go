@@ -89,9 +89,24 @@ func (s *Subscriber) handleNotification(userID string, data []byte) {
 		Payload: json.RawMessage(payload),
 	}
 
-	if !s.manager.SendMessageToUser(userID, wsMsg) {
+	var sent bool
+	if notif.Type == pkgnotification.TypeAuthUnusualLogin {
+		// the device that just signed in is the one being reported on, warn the others
+		deviceID, _ := notif.Payload["device_id"].(string)
+		sent = s.manager.SendMessageToUserExceptDevice(userID, deviceID, wsMsg)
+	} else {
+		sent = s.manager.SendMessageToUser(userID, wsMsg)
+	}
+	if !sent {
 		logger.Debug("User not connected, notification dropped",
 			zap.String("userID", userID),
 			zap.String("type", notif.Type))
 	}
+
+	// a signed out device gets the notification above, then its connection is closed
+	if notif.Type == pkgnotification.TypeAuthForceLogout {
+		if deviceID, _ := notif.Payload["device_id"].(string); deviceID != "" {
+			s.manager.DisconnectDevice(userID, deviceID, "force logout")
+		}
+	}
 }
@@ -2,6 +2,7 @@ package websocket
 
 import (
 	"encoding/json"
+	"sync"
 	"time"
 
 	"github.com/anychat/server/pkg/logger"
@@ -28,8 +29,11 @@ type Client struct {
 	DeviceID string
 	Conn     *gorillaws.Conn
 	Send     chan []byte   // message queue to send
-	Done     chan struct{} // close signal (closed when replaced by new connection)
+	Done     chan struct{} // close signal (closed when replaced by new connection or signed out)
 	manager  *Manager
+
+	closeOnce   sync.Once
+	closeReason string
 }
 
 // NewClient creates new WebSocket client
@@ -44,6 +48,14 @@ func NewClient(userID, deviceID string, conn *gorillaws.Conn, manager *Manager)
 	}
 }
 
+// Close asks WritePump to flush queued messages and close the connection with reason, safe to call more than once
+func (c *Client) Close(reason string) {
+	c.closeOnce.Do(func() {
+		c.closeReason = reason
+		close(c.Done)
+	})
+}
+
 // WritePump handles sending messages to client (with heartbeat), runs in separate goroutine
 func (c *Client) WritePump() {
 	ticker := time.NewTicker(pingPeriod)
@@ -73,9 +85,29 @@ func (c *Client) WritePump() {
 				return
 			}
 		case <-c.Done:
-			// replaced by new connection, close old connection
+			// replaced by new connection or signed out, deliver what is queued (e.g. force_logout) then close
+			c.flush()
+			c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
 			c.Conn.WriteMessage(gorillaws.CloseMessage, gorillaws.FormatCloseMessage(
-				gorillaws.CloseNormalClosure, "replaced by new connection"))
+				gorillaws.CloseNormalClosure, c.closeReason))
+			return
+		}
+	}
+}
+
+// flush writes messages already queued without waiting for more
+func (c *Client) flush() {
+	for {
+		select {
+		case message, ok := <-c.Send:
+			if !ok {
+				return
+			}
+			c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.Conn.WriteMessage(gorillaws.TextMessage, message); err != nil {
+				return
+			}
+		default:
 			return
 		}
 	}
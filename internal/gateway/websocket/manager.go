@@ -31,7 +31,7 @@ func (m *Manager) Register(client *Client) {
 	}
 
 	if old, exists := m.clients[client.UserID][client.DeviceID]; exists {
-		close(old.Done)
+		old.Close("replaced by new connection")
 		logger.Info("Replaced existing WebSocket connection",
 			zap.String("userID", client.UserID),
 			zap.String("deviceID", client.DeviceID))
@@ -62,8 +62,43 @@ func (m *Manager) Unregister(client *Client) {
 	}
 }
 
+// DisconnectDevice closes the connection of one device after its queued messages are sent, returns whether it was connected
+func (m *Manager) DisconnectDevice(userID, deviceID, reason string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	userClients, exists := m.clients[userID]
+	if !exists {
+		return false
+	}
+	client, ok := userClients[deviceID]
+	if !ok {
+		return false
+	}
+
+	delete(userClients, deviceID)
+	if len(userClients) == 0 {
+		delete(m.clients, userID)
+	}
+	client.Close(reason)
+	logger.Info("WebSocket client disconnected",
+		zap.String("userID", userID),
+		zap.String("deviceID", deviceID),
+		zap.String("reason", reason))
+	return true
+}
+
 // SendToUser send raw message to specified user, returns success status
 func (m *Manager) SendToUser(userID string, data []byte) bool {
+	return m.sendToUser(userID, "", data)
+}
+
+// SendToUserExceptDevice send message to all devices of the user except the given one
+func (m *Manager) SendToUserExceptDevice(userID, excludeDeviceID string, data []byte) bool {
+	return m.sendToUser(userID, excludeDeviceID, data)
+}
+
+func (m *Manager) sendToUser(userID, excludeDeviceID string, data []byte) bool {
 	m.mu.RLock()
 	userClients, exists := m.clients[userID]
 	if !exists || len(userClients) == 0 {
@@ -76,6 +111,9 @@ func (m *Manager) SendToUser(userID string, data []byte) bool {
 	}
 	targets := make([]targetClient, 0, len(userClients))
 	for deviceID, client := range userClients {
+		if excludeDeviceID != "" && deviceID == excludeDeviceID {
+			continue
+		}
 		targets = append(targets, targetClient{
 			deviceID: deviceID,
 			client:   client,
@@ -107,6 +145,16 @@ func (m *Manager) SendMessageToUser(userID string, msg *Message) bool {
 	return m.SendToUser(userID, data)
 }
 
+// SendMessageToUserExceptDevice send structured message to all devices of the user except the given one
+func (m *Manager) SendMessageToUserExceptDevice(userID, excludeDeviceID string, msg *Message) bool {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		logger.Error("Failed to marshal WebSocket message", zap.Error(err))
+		return false
+	}
+	return m.SendToUserExceptDevice(userID, excludeDeviceID, data)
+}
+
 // IsOnline check if user is online
 func (m *Manager) IsOnline(userID string) bool {
 	m.mu.RLock()
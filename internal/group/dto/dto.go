@@ -42,14 +42,19 @@ const (
 // CreateGroupRequest request for creating a group
 type CreateGroupRequest struct {
 	Name      string   `json:"name" binding:"required,min=1,max=100" example:"Tech Discussion Group"`
-	Avatar    string   `json:"avatar" binding:"omitempty,url" example:"https://example.com/avatar.jpg"`
+	Avatar    string   `json:"avatar" binding:"omitempty,url" example:""` // must be empty, upload one with PUT /groups/{id}/avatar
 	MemberIDs []string `json:"member_ids" binding:"required,min=1" example:"user-123,user-456"`
 }
 
+// SetGroupAvatarRequest request for setting the group avatar
+type SetGroupAvatarRequest struct {
+	FileID string `json:"file_id" binding:"required" example:"file-123"` // image uploaded through the file API
+}
+
 // UpdateGroupRequest request for updating group info
 type UpdateGroupRequest struct {
 	Name         *string `json:"name,omitempty" binding:"omitempty,min=1,max=100" example:"New group name"`
-	Avatar       *string `json:"avatar,omitempty" binding:"omitempty,url" example:"https://example.com/new-avatar.jpg"`
+	Avatar       *string `json:"avatar,omitempty" binding:"omitempty,url" example:""` // only "" to clear it (owner and admins)
 	Announcement *string `json:"announcement,omitempty" binding:"omitempty,max=1000" example:"Group announcement content"`
 	Description  *string `json:"description,omitempty" binding:"omitempty,max=1000" example:"Group description content"`
 }
@@ -95,7 +100,8 @@ type UpdateGroupSettingsRequest struct {
 	AllowMemberInvite *bool `json:"allow_member_invite,omitempty" example:"true"`
 	AllowViewHistory  *bool `json:"allow_view_history,omitempty" example:"true"`
 	AllowAddFriend    *bool `json:"allow_add_friend,omitempty" example:"true"`
-	AllowMemberModify *bool `json:"allow_member_modify,omitempty" example:"false"`
+	AllowMemberModify *bool  `json:"allow_member_modify,omitempty" example:"false"`
+	SlowModeSeconds   *int32 `json:"slow_mode_seconds,omitempty" example:"30"` // 0 turns slow mode off, at most 3600
 }
 
 // PinGroupMessageRequest request for pinning group message
@@ -193,6 +199,16 @@ type GroupSettingsResponse struct {
 	AllowViewHistory  bool   `json:"allow_view_history" example:"true"`
 	AllowAddFriend    bool   `json:"allow_add_friend" example:"true"`
 	AllowMemberModify bool   `json:"allow_member_modify" example:"false"`
+	SlowModeSeconds   int32  `json:"slow_mode_seconds" example:"0"`
+}
+
+// MemberStatusResponse membership and send restrictions of a user, checked by Message Service before sending
+type MemberStatusResponse struct {
+	Role            GroupRole
+	JoinedAt        time.Time
+	MutedUntil      *time.Time // nil when not muted or the mute has expired
+	GroupMuted      bool
+	SlowModeSeconds int32
 }
 
 // PinnedMessageResponse response for pinned message
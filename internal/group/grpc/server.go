@@ -106,15 +106,26 @@ func (s *GroupServer) GetGroupMembers(ctx context.Context, req *grouppb.GetGroup
 
 // IsMember checks if user is group member
 func (s *GroupServer) IsMember(ctx context.Context, req *grouppb.IsMemberRequest) (*grouppb.IsMemberResponse, error) {
-	isMember, role, err := s.groupService.IsMember(ctx, req.GroupId, req.UserId)
+	member, err := s.groupService.IsMember(ctx, req.GroupId, req.UserId)
 	if err != nil {
 		return nil, convertError(err)
 	}
+	if member == nil {
+		return &grouppb.IsMemberResponse{}, nil
+	}
 
-	return &grouppb.IsMemberResponse{
-		IsMember: isMember,
-		Role:     grouppb.GroupRole(role),
-	}, nil
+	resp := &grouppb.IsMemberResponse{
+		IsMember:        true,
+		Role:            grouppb.GroupRole(member.Role),
+		JoinedAt:        member.JoinedAt.Unix(),
+		GroupMuted:      member.GroupMuted,
+		SlowModeSeconds: member.SlowModeSeconds,
+	}
+	if member.MutedUntil != nil {
+		mutedUntil := member.MutedUntil.Unix()
+		resp.MutedUntil = &mutedUntil
+	}
+	return resp, nil
 }
 
 // GetUserGroups gets list of groups user joined
@@ -197,6 +208,16 @@ func (s *GroupServer) UpdateGroup(ctx context.Context, req *grouppb.UpdateGroupR
 	return &commonpb.Empty{}, nil
 }
 
+// SetGroupAvatar sets the group avatar from an uploaded image
+func (s *GroupServer) SetGroupAvatar(ctx context.Context, req *grouppb.SetGroupAvatarRequest) (*grouppb.SetGroupAvatarResponse, error) {
+	avatar, err := s.groupService.SetGroupAvatar(ctx, req.UserId, req.GroupId, req.FileId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &grouppb.SetGroupAvatarResponse{Avatar: avatar}, nil
+}
+
 // DissolveGroup dissolves a group
 func (s *GroupServer) DissolveGroup(ctx context.Context, req *grouppb.DissolveGroupRequest) (*commonpb.Empty, error) {
 	err := s.groupService.DissolveGroup(ctx, req.UserId, req.GroupId)
@@ -472,6 +493,7 @@ func (s *GroupServer) UpdateGroupSettings(ctx context.Context, req *grouppb.Upda
 		AllowViewHistory:  req.AllowViewHistory,
 		AllowAddFriend:    req.AllowAddFriend,
 		AllowMemberModify: req.AllowMemberModify,
+		SlowModeSeconds:   req.SlowModeSeconds,
 	}
 
 	// Call service layer
@@ -497,6 +519,7 @@ func (s *GroupServer) GetGroupSettings(ctx context.Context, req *grouppb.GetGrou
 		AllowViewHistory:  resp.AllowViewHistory,
 		AllowAddFriend:    resp.AllowAddFriend,
 		AllowMemberModify: resp.AllowMemberModify,
+		SlowModeSeconds:   resp.SlowModeSeconds,
 	}, nil
 }
 
@@ -567,6 +590,15 @@ func (s *GroupServer) JoinGroupByQRCode(ctx context.Context, req *grouppb.JoinGr
 	return result, nil
 }
 
+// EraseUserData takes a deleted account out of its groups (called by auth-service)
+func (s *GroupServer) EraseUserData(ctx context.Context, req *commonpb.EraseUserDataRequest) (*commonpb.EraseUserDataResponse, error) {
+	erased, err := s.groupService.EraseUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &commonpb.EraseUserDataResponse{Erased: erased}, nil
+}
+
 // convertError converts business errors to gRPC errors
 func convertError(err error) error {
 	if bizErr, ok := err.(*errors.Business); ok {
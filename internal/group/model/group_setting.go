@@ -11,6 +11,7 @@ type GroupSetting struct {
 	AllowAddFriend     bool      `gorm:"column:allow_add_friend;default:true" json:"allowAddFriend"`
 	AllowMemberModify  bool      `gorm:"column:allow_member_modify;default:false" json:"allowMemberModify"`
 	ShowMemberNickname bool      `gorm:"column:show_member_nickname;default:true" json:"showMemberNickname"`
+	SlowModeSeconds    int32     `gorm:"column:slow_mode_seconds;not null;default:0" json:"slowModeSeconds"`
 	CreatedAt          time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP" json:"createdAt"`
 	UpdatedAt          time.Time `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP" json:"updatedAt"`
 }
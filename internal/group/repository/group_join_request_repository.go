@@ -17,6 +17,7 @@ type GroupJoinRequestRepository interface {
 	GetPendingRequestsByUser(ctx context.Context, userID string) ([]*model.GroupJoinRequest, error)
 	GetRequestsByGroup(ctx context.Context, groupID string, status *model.JoinRequestStatus) ([]*model.GroupJoinRequest, error)
 	GetExistingRequest(ctx context.Context, groupID, userID string) (*model.GroupJoinRequest, error)
+	DeleteByUser(ctx context.Context, userID string) (int64, error)
 	WithTx(tx *gorm.DB) GroupJoinRequestRepository
 }
 
@@ -106,6 +107,14 @@ func (r *groupJoinRequestRepositoryImpl) GetExistingRequest(ctx context.Context,
 	return &request, nil
 }
 
+// DeleteByUser deletes join requests of the user, including invitations the user sent
+func (r *groupJoinRequestRepositoryImpl) DeleteByUser(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? OR inviter_id = ?", userID, userID).
+		Delete(&model.GroupJoinRequest{})
+	return result.RowsAffected, result.Error
+}
+
 // WithTx uses transaction
 func (r *groupJoinRequestRepositoryImpl) WithTx(tx *gorm.DB) GroupJoinRequestRepository {
 	return &groupJoinRequestRepositoryImpl{db: tx}
@@ -21,6 +21,7 @@ type GroupMemberRepository interface {
 	GetMembers(ctx context.Context, groupID string, page, pageSize int) ([]*model.GroupMember, int64, error)
 	GetMembersByRole(ctx context.Context, groupID string, role model.GroupRole) ([]*model.GroupMember, error)
 	GetMemberCount(ctx context.Context, groupID string) (int64, error)
+	GetMemberIDs(ctx context.Context, groupID string) ([]string, error)
 	IsMember(ctx context.Context, groupID, userID string) (bool, error)
 	GetUserGroups(ctx context.Context, userID string) ([]*model.GroupMember, error)
 	GetUserGroupsByUpdateTime(ctx context.Context, userID string, lastUpdateTime time.Time) ([]*model.GroupMember, error)
@@ -158,6 +159,16 @@ func (r *groupMemberRepositoryImpl) GetMemberCount(ctx context.Context, groupID
 	return count, err
 }
 
+// GetMemberIDs gets user IDs of all group members
+func (r *groupMemberRepositoryImpl) GetMemberIDs(ctx context.Context, groupID string) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&model.GroupMember{}).
+		Where("group_id = ?", groupID).
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
 // IsMember checks if user is a member
 func (r *groupMemberRepositoryImpl) IsMember(ctx context.Context, groupID, userID string) (bool, error) {
 	var count int64
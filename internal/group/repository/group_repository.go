@@ -18,6 +18,8 @@ type GroupRepository interface {
 	UpdateMemberCount(ctx context.Context, groupID string, delta int32) error
 	GetGroupsByOwner(ctx context.Context, ownerID string) ([]*model.Group, error)
 	Search(ctx context.Context, keyword string, limit int) ([]*model.Group, error)
+	ListAvatarStale(ctx context.Context, limit int) ([]*model.Group, error)
+	SetCompositeAvatar(ctx context.Context, groupID, avatar string, staleAt time.Time) (bool, error)
 	WithTx(tx *gorm.DB) GroupRepository
 }
 
@@ -73,14 +75,16 @@ func (r *groupRepositoryImpl) Delete(ctx context.Context, groupID string) error
 		}).Error
 }
 
-// UpdateMemberCount updates member count (atomic operation)
+// UpdateMemberCount updates member count (atomic operation), the composite avatar is regenerated afterwards
 func (r *groupRepositoryImpl) UpdateMemberCount(ctx context.Context, groupID string, delta int32) error {
+	now := time.Now()
 	return r.db.WithContext(ctx).
 		Model(&model.Group{}).
 		Where("group_id = ?", groupID).
 		Updates(map[string]interface{}{
-			"member_count": gorm.Expr("member_count + ?", delta),
-			"updated_at":   time.Now(),
+			"member_count":    gorm.Expr("member_count + ?", delta),
+			"avatar_stale_at": now, // ignored while the avatar is uploaded
+			"updated_at":      now,
 		}).Error
 }
 
@@ -105,6 +109,31 @@ func (r *groupRepositoryImpl) Search(ctx context.Context, keyword string, limit
 	return groups, err
 }
 
+// ListAvatarStale gets groups whose composite avatar needs to be regenerated, longest waiting first
+func (r *groupRepositoryImpl) ListAvatarStale(ctx context.Context, limit int) ([]*model.Group, error) {
+	var groups []*model.Group
+	err := r.db.WithContext(ctx).
+		Where("avatar_stale_at IS NOT NULL AND avatar_custom = ? AND status = ?", false, model.GroupStatusNormal).
+		Order("avatar_stale_at ASC").
+		Limit(limit).
+		Find(&groups).Error
+	return groups, err
+}
+
+// SetCompositeAvatar stores a regenerated composite avatar. Nothing is written when an avatar was uploaded or
+// the members changed again since staleAt was read, the next run composes the newer member list
+func (r *groupRepositoryImpl) SetCompositeAvatar(ctx context.Context, groupID, avatar string, staleAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.Group{}).
+		Where("group_id = ? AND avatar_custom = ? AND avatar_stale_at = ?", groupID, false, staleAt).
+		Updates(map[string]interface{}{
+			"avatar":          avatar,
+			"avatar_stale_at": nil,
+			"updated_at":      time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
 // WithTx uses transaction
 func (r *groupRepositoryImpl) WithTx(tx *gorm.DB) GroupRepository {
 	return &groupRepositoryImpl{db: tx}
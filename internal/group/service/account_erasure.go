@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+
+	"github.com/anychat/server/internal/group/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// EraseUserData takes a deleted account out of every group.
+// Owned groups pass to the longest-serving admin, or the longest-serving member when there is no admin;
+// a group with no other member is dissolved. Calling it again erases nothing more
+func (s *groupServiceImpl) EraseUserData(ctx context.Context, userID string) (map[string]int64, error) {
+	if userID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "user_id is required")
+	}
+
+	memberships, err := s.memberRepo.GetUserGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	erased := map[string]int64{
+		"memberships":        0,
+		"groups_transferred": 0,
+		"groups_dissolved":   0,
+	}
+	for _, member := range memberships {
+		if _, err := s.groupRepo.GetByGroupID(ctx, member.GroupID); err != nil {
+			if err != gorm.ErrRecordNotFound {
+				return nil, err
+			}
+			// dissolved group, only the membership row is left
+			if err := s.memberRepo.RemoveMember(ctx, member.GroupID, userID); err != nil {
+				return nil, err
+			}
+			erased["memberships"]++
+			continue
+		}
+
+		if member.IsOwner() {
+			successor, err := s.nextGroupOwner(ctx, member.GroupID, userID)
+			if err != nil {
+				return nil, err
+			}
+			if successor == nil {
+				if err := s.DissolveGroup(ctx, userID, member.GroupID); err != nil {
+					return nil, err
+				}
+				if err := s.memberRepo.RemoveMember(ctx, member.GroupID, userID); err != nil {
+					return nil, err
+				}
+				erased["groups_dissolved"]++
+				erased["memberships"]++
+				continue
+			}
+
+			if err := s.TransferOwnership(ctx, userID, member.GroupID, successor.UserID); err != nil {
+				return nil, err
+			}
+			s.publishRoleChangedNotification(member.GroupID, successor.UserID, userID, successor.Role, model.GroupRoleOwner)
+			erased["groups_transferred"]++
+		}
+
+		if err := s.QuitGroup(ctx, userID, member.GroupID); err != nil {
+			return nil, err
+		}
+		erased["memberships"]++
+	}
+
+	count, err := s.joinRequestRepo.DeleteByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	erased["join_requests"] = count
+
+	logger.Info("Group data erased", zap.String("userId", userID), zap.Any("erased", erased))
+	return erased, nil
+}
+
+// nextGroupOwner picks who takes over a group from its owner, nil when nobody else is left
+func (s *groupServiceImpl) nextGroupOwner(ctx context.Context, groupID, ownerID string) (*model.GroupMember, error) {
+	for _, role := range []model.GroupRole{model.GroupRoleAdmin, model.GroupRoleMember} {
+		members, err := s.memberRepo.GetMembersByRole(ctx, groupID, role)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if member.UserID != ownerID {
+				return member, nil
+			}
+		}
+	}
+	return nil, nil
+}
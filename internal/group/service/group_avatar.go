@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+
+	filepb "github.com/anychat/server/api/proto/file"
+	"github.com/anychat/server/internal/group/dto"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// compositeAvatarMembers members shown on the default group avatar, the earliest to join first
+const compositeAvatarMembers = 9
+
+// SetGroupAvatar turns an image the operator uploaded into the group avatar, owner and admins only.
+// The composite avatar is no longer regenerated until the uploaded one is cleared
+func (s *groupServiceImpl) SetGroupAvatar(ctx context.Context, userID, groupID, fileID string) (string, error) {
+	if fileID == "" {
+		return "", errors.NewBusiness(errors.CodeParamError, "file_id is required")
+	}
+	if _, err := s.groupRepo.GetByGroupID(ctx, groupID); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", errors.NewBusiness(errors.CodeGroupNotFound, "Group not found")
+		}
+		return "", err
+	}
+	member, err := s.memberRepo.GetMember(ctx, groupID, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", errors.NewBusiness(errors.CodeNotGroupMember, "You are not a group member")
+		}
+		return "", err
+	}
+	if !member.CanManageGroup() {
+		return "", errors.NewBusiness(errors.CodeNoAdminPermission, "Only owner and admins can change the group avatar")
+	}
+
+	avatar, err := s.fileClient.SetAvatar(ctx, &filepb.SetAvatarRequest{
+		UserId:    userID,
+		FileId:    fileID,
+		OwnerType: filepb.StorageOwnerType_STORAGE_OWNER_TYPE_GROUP,
+		OwnerId:   groupID,
+	})
+	if err != nil {
+		return "", fileError(err, "failed to set group avatar")
+	}
+
+	err = s.groupRepo.UpdateFields(ctx, groupID, map[string]any{
+		"avatar":          avatar.Url,
+		"avatar_custom":   true,
+		"avatar_stale_at": nil,
+	})
+	if err != nil {
+		logger.Error("Failed to update group avatar", zap.Error(err))
+		return "", err
+	}
+
+	s.publishGroupInfoUpdatedNotification(groupID, userID, &dto.UpdateGroupRequest{Avatar: &avatar.Url})
+	return avatar.Url, nil
+}
+
+// RefreshCompositeAvatars regenerates the composite avatars of groups whose members changed and returns how
+// many were stored. A group that fails is retried by the next run
+func (s *groupServiceImpl) RefreshCompositeAvatars(ctx context.Context, limit int) (int, error) {
+	groups, err := s.groupRepo.ListAvatarStale(ctx, limit)
+	if err != nil {
+		logger.Error("Failed to list groups with stale avatars", zap.Error(err))
+		return 0, err
+	}
+
+	refreshed := 0
+	for _, group := range groups {
+		members, _, err := s.memberRepo.GetMembers(ctx, group.GroupID, 1, compositeAvatarMembers)
+		if err != nil {
+			logger.Error("Failed to get members for group avatar",
+				zap.String("groupId", group.GroupID),
+				zap.Error(err))
+			continue
+		}
+		memberIDs := make([]string, 0, len(members))
+		for _, member := range members {
+			memberIDs = append(memberIDs, member.UserID)
+		}
+
+		avatar, err := s.fileClient.GenerateGroupAvatar(ctx, &filepb.GenerateGroupAvatarRequest{
+			GroupId:   group.GroupID,
+			MemberIds: memberIDs,
+		})
+		if err != nil {
+			logger.Error("Failed to generate group avatar",
+				zap.String("groupId", group.GroupID),
+				zap.Error(err))
+			continue
+		}
+
+		stored, err := s.groupRepo.SetCompositeAvatar(ctx, group.GroupID, avatar.Url, *group.AvatarStaleAt)
+		if err != nil {
+			logger.Error("Failed to store group avatar",
+				zap.String("groupId", group.GroupID),
+				zap.Error(err))
+			continue
+		}
+		refreshed++
+		if stored && avatar.Url != group.Avatar {
+			s.publishGroupInfoUpdatedNotification(group.GroupID, "", &dto.UpdateGroupRequest{Avatar: &avatar.Url})
+		}
+	}
+	return refreshed, nil
+}
+
+// removeGroupAvatarImages deletes the uploaded avatar images after the group no longer points at them
+func (s *groupServiceImpl) removeGroupAvatarImages(ctx context.Context, groupID string) {
+	_, err := s.fileClient.RemoveAvatar(ctx, &filepb.RemoveAvatarRequest{
+		OwnerType: filepb.StorageOwnerType_STORAGE_OWNER_TYPE_GROUP,
+		OwnerId:   groupID,
+	})
+	if err != nil {
+		// the images stay reachable by URL, a later SetGroupAvatar overwrites them
+		logger.Warn("Failed to remove group avatar images", zap.String("groupId", groupID), zap.Error(err))
+	}
+}
+
+// fileError keeps the reason of a rejected file-service request, other failures become internal errors
+func fileError(err error, msg string) error {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied:
+		return errors.NewBusiness(errors.CodeParamError, st.Message())
+	}
+	logger.Error("File service call failed", zap.String("operation", msg), zap.Error(err))
+	return errors.NewBusiness(errors.CodeInternalError, msg)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
 
+	filepb "github.com/anychat/server/api/proto/file"
 	messagepb "github.com/anychat/server/api/proto/message"
 	userpb "github.com/anychat/server/api/proto/user"
 	"github.com/anychat/server/internal/group/dto"
@@ -28,6 +30,7 @@ const (
 	pinnedMessagePreviewMaxRuneCount       = 100
 	muteTypePermanent                int16 = 1
 	muteTypeTemporary                int16 = 2
+	maxSlowModeSeconds                     = 3600
 )
 
 type pinnedMessageSnapshot struct {
@@ -42,6 +45,7 @@ type GroupService interface {
 	CreateGroup(ctx context.Context, ownerID string, req *dto.CreateGroupRequest) (*dto.GroupResponse, error)
 	GetGroupInfo(ctx context.Context, userID, groupID string) (*dto.GroupResponse, error)
 	UpdateGroup(ctx context.Context, userID, groupID string, req *dto.UpdateGroupRequest) error
+	SetGroupAvatar(ctx context.Context, userID, groupID, fileID string) (string, error)
 	DissolveGroup(ctx context.Context, userID, groupID string) error
 	GetUserGroups(ctx context.Context, userID string, lastUpdateTime *int64) (*dto.GroupListResponse, error)
 
@@ -79,7 +83,11 @@ type GroupService interface {
 	JoinGroupByQRCode(ctx context.Context, userID, token string) (*dto.JoinGroupByQRCodeResponse, error)
 
 	// Internal gRPC methods (called by other services)
-	IsMember(ctx context.Context, groupID, userID string) (bool, model.GroupRole, error)
+	IsMember(ctx context.Context, groupID, userID string) (*dto.MemberStatusResponse, error)
+	EraseUserData(ctx context.Context, userID string) (map[string]int64, error)
+
+	// Background jobs
+	RefreshCompositeAvatars(ctx context.Context, limit int) (int, error)
 }
 
 // groupServiceImpl represents the group service implementation
@@ -92,6 +100,7 @@ type groupServiceImpl struct {
 	qrcodeRepo      repository.GroupQRCodeRepository
 	messageClient   messagepb.MessageServiceClient
 	userClient      userpb.UserServiceClient
+	fileClient      filepb.FileServiceClient
 	notificationPub notification.Publisher
 	db              *gorm.DB
 }
@@ -106,6 +115,7 @@ func NewGroupService(
 	qrcodeRepo repository.GroupQRCodeRepository,
 	messageClient messagepb.MessageServiceClient,
 	userClient userpb.UserServiceClient,
+	fileClient filepb.FileServiceClient,
 	notificationPub notification.Publisher,
 	db *gorm.DB,
 ) GroupService {
@@ -118,6 +128,7 @@ func NewGroupService(
 		qrcodeRepo:      qrcodeRepo,
 		messageClient:   messageClient,
 		userClient:      userClient,
+		fileClient:      fileClient,
 		notificationPub: notificationPub,
 		db:              db,
 	}
@@ -136,20 +147,26 @@ func (s *groupServiceImpl) CreateGroup(ctx context.Context, ownerID string, req
 		return nil, errors.NewBusiness(errors.CodeGroupMemberLimitReached, "Group member count exceeds limit")
 	}
 
+	// Avatars are uploaded once the group exists, until then it gets a composite of member avatars
+	if req.Avatar != "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "avatar must be uploaded through the group avatar endpoint")
+	}
+
 	// Generate unique group ID
 	groupID := uuid.New().String()
 
 	// Create group object
+	now := time.Now()
 	group := &model.Group{
-		GroupID:     groupID,
-		Name:        req.Name,
-		Avatar:      req.Avatar,
-		OwnerID:     ownerID,
-		MemberCount: int32(totalMembers),
-		MaxMembers:  500,
-		Status:      model.GroupStatusNormal,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		GroupID:       groupID,
+		Name:          req.Name,
+		OwnerID:       ownerID,
+		MemberCount:   int32(totalMembers),
+		MaxMembers:    500,
+		Status:        model.GroupStatusNormal,
+		AvatarStaleAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
 	}
 
 	// Create group with transaction
@@ -185,7 +202,6 @@ func (s *groupServiceImpl) CreateGroup(ctx context.Context, ownerID string, req
 
 		// 4. Add initial members
 		members := make([]*model.GroupMember, 0, len(req.MemberIDs))
-		now := time.Now()
 		for _, memberID := range req.MemberIDs {
 			if memberID == ownerID {
 				continue // skip owner
@@ -216,6 +232,14 @@ func (s *groupServiceImpl) CreateGroup(ctx context.Context, ownerID string, req
 	for _, memberID := range req.MemberIDs {
 		s.publishMemberJoinedNotification(groupID, memberID, ownerID)
 	}
+	if len(req.MemberIDs) > 0 {
+		s.sendGroupSystemEvent(ctx, groupID, groupSystemEvent{
+			event:      messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_JOINED,
+			operatorID: ownerID,
+			userIDs:    req.MemberIDs,
+			silent:     true,
+		})
+	}
 
 	// Build response
 	return &dto.GroupResponse{
@@ -303,13 +327,42 @@ func (s *groupServiceImpl) UpdateGroup(ctx context.Context, userID, groupID stri
 		}
 	}
 
+	// Avatar can only be cleared here, new images are processed by SetGroupAvatar
+	removeAvatar := false
+	renamed := false
+	if req.Avatar != nil || req.Name != nil {
+		group, err := s.groupRepo.GetByGroupID(ctx, groupID)
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errors.NewBusiness(errors.CodeGroupNotFound, "Group not found")
+			}
+			return err
+		}
+		renamed = req.Name != nil && *req.Name != group.Name
+		switch {
+		case req.Avatar == nil:
+			// only the name is checked
+		case *req.Avatar == group.Avatar:
+			req.Avatar = nil // unchanged, e.g. a client sending the whole group info back
+		case *req.Avatar != "":
+			return errors.NewBusiness(errors.CodeParamError, "avatar must be uploaded through the group avatar endpoint")
+		case !member.CanManageGroup():
+			return errors.NewBusiness(errors.CodeNoAdminPermission, "Only owner and admins can change the group avatar")
+		default:
+			removeAvatar = group.AvatarCustom
+		}
+	}
+
 	// Build update fields
 	updates := make(map[string]any)
 	if req.Name != nil {
 		updates["name"] = *req.Name
 	}
 	if req.Avatar != nil {
-		updates["avatar"] = *req.Avatar
+		// the composite avatar replaces the cleared one once the worker regenerates it
+		updates["avatar"] = ""
+		updates["avatar_custom"] = false
+		updates["avatar_stale_at"] = time.Now()
 	}
 	if req.Announcement != nil {
 		updates["announcement"] = *req.Announcement
@@ -327,6 +380,17 @@ func (s *groupServiceImpl) UpdateGroup(ctx context.Context, userID, groupID stri
 		logger.Error("Failed to update group", zap.Error(err))
 		return err
 	}
+	if removeAvatar {
+		s.removeGroupAvatarImages(ctx, groupID)
+	}
+
+	if renamed {
+		s.sendGroupSystemEvent(ctx, groupID, groupSystemEvent{
+			event:      messagepb.SystemEvent_SYSTEM_EVENT_GROUP_RENAMED,
+			operatorID: userID,
+			params:     map[string]string{"name": *req.Name},
+		})
+	}
 
 	// Publish group info updated notification
 	s.publishGroupInfoUpdatedNotification(groupID, userID, req)
@@ -522,6 +586,7 @@ func (s *groupServiceImpl) InviteMembers(ctx context.Context, userID, groupID st
 	}
 
 	// Process each invitee
+	var addedIDs []string
 	for _, inviteeID := range req.UserIDs {
 		// Check if already a member
 		isMember, _ := s.memberRepo.IsMember(ctx, groupID, inviteeID)
@@ -566,9 +631,19 @@ func (s *groupServiceImpl) InviteMembers(ctx context.Context, userID, groupID st
 				logger.Error("Failed to add member", zap.String("inviteeID", inviteeID), zap.Error(err))
 				continue
 			}
+			addedIDs = append(addedIDs, inviteeID)
 		}
 	}
 
+	if len(addedIDs) > 0 {
+		s.sendGroupSystemEvent(ctx, groupID, groupSystemEvent{
+			event:      messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_JOINED,
+			operatorID: userID,
+			userIDs:    addedIDs,
+			silent:     true,
+		})
+	}
+
 	return nil
 }
 
@@ -621,6 +696,15 @@ func (s *groupServiceImpl) RemoveMember(ctx context.Context, userID, groupID, ta
 		return err
 	}
 
+	// the removed member still sees the notice in the conversation they were removed from
+	s.sendGroupSystemEvent(ctx, groupID, groupSystemEvent{
+		event:           messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_REMOVED,
+		operatorID:      userID,
+		userIDs:         []string{targetUserID},
+		silent:          true,
+		extraRecipients: []string{targetUserID},
+	})
+
 	// Publish member left notification
 	s.publishMemberLeftNotification(groupID, targetUserID, userID, "removed_by_admin")
 
@@ -671,6 +755,14 @@ func (s *groupServiceImpl) QuitGroup(ctx context.Context, userID, groupID string
 		return err
 	}
 
+	s.sendGroupSystemEvent(ctx, groupID, groupSystemEvent{
+		event:           messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_LEFT,
+		operatorID:      userID,
+		userIDs:         []string{userID},
+		silent:          true,
+		extraRecipients: []string{userID},
+	})
+
 	// Publish member left notification
 	s.publishMemberLeftNotification(groupID, userID, userID, "self_quit")
 
@@ -712,6 +804,13 @@ func (s *groupServiceImpl) UpdateMemberRole(ctx context.Context, userID, groupID
 		return err
 	}
 
+	s.sendGroupSystemEvent(ctx, groupID, groupSystemEvent{
+		event:      messagepb.SystemEvent_SYSTEM_EVENT_GROUP_ROLE_CHANGED,
+		operatorID: userID,
+		userIDs:    []string{targetUserID},
+		params:     map[string]string{"role": systemRoleName(req.Role)},
+	})
+
 	// Publish role changed notification
 	s.publishRoleChangedNotification(groupID, targetUserID, userID, target.Role, req.Role)
 
@@ -790,6 +889,12 @@ func (s *groupServiceImpl) TransferOwnership(ctx context.Context, userID, groupI
 		return err
 	}
 
+	s.sendGroupSystemEvent(ctx, groupID, groupSystemEvent{
+		event:      messagepb.SystemEvent_SYSTEM_EVENT_GROUP_OWNERSHIP_TRANSFERRED,
+		operatorID: userID,
+		userIDs:    []string{newOwnerID},
+	})
+
 	return nil
 }
 
@@ -955,6 +1060,13 @@ func (s *groupServiceImpl) JoinGroup(ctx context.Context, userID, groupID string
 		return nil, err
 	}
 
+	s.sendGroupSystemEvent(ctx, groupID, groupSystemEvent{
+		event:      messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_JOINED,
+		operatorID: userID,
+		userIDs:    []string{userID},
+		silent:     true,
+	})
+
 	return &dto.JoinGroupResponse{
 		NeedVerify: false,
 		Message:    "Successfully joined the group",
@@ -1022,6 +1134,13 @@ func (s *groupServiceImpl) HandleJoinRequest(ctx context.Context, userID string,
 			logger.Error("Failed to accept join request", zap.Error(err))
 			return err
 		}
+
+		s.sendGroupSystemEvent(ctx, request.GroupID, groupSystemEvent{
+			event:      messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_JOINED,
+			operatorID: userID,
+			userIDs:    []string{request.UserID},
+			silent:     true,
+		})
 	} else {
 		// Reject request
 		if err := s.joinRequestRepo.UpdateStatus(ctx, requestID, model.JoinRequestStatusRejected); err != nil {
@@ -1270,13 +1389,11 @@ func (s *groupServiceImpl) SetGroupMute(ctx context.Context, userID, groupID str
 		return err
 	}
 
-	messageText := "Group mute disabled"
-	if enabled {
-		messageText = "Group mute enabled"
-	}
-	if err := s.sendGroupSystemMessage(ctx, groupID, userID, messageText); err != nil {
-		logger.Warn("Failed to send group mute system message", zap.String("groupID", groupID), zap.Error(err))
-	}
+	s.sendGroupSystemEvent(ctx, groupID, groupSystemEvent{
+		event:      messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MUTE_CHANGED,
+		operatorID: userID,
+		params:     map[string]string{"enabled": strconv.FormatBool(enabled)},
+	})
 
 	s.publishGroupMutedNotification(groupID, userID, enabled)
 	return nil
@@ -1314,6 +1431,12 @@ func (s *groupServiceImpl) UpdateGroupSettings(ctx context.Context, userID, grou
 	if req.AllowMemberModify != nil {
 		updates["allow_member_modify"] = *req.AllowMemberModify
 	}
+	if req.SlowModeSeconds != nil {
+		if *req.SlowModeSeconds < 0 || *req.SlowModeSeconds > maxSlowModeSeconds {
+			return errors.NewBusiness(errors.CodeParamError, fmt.Sprintf("Slow mode interval must be between 0 and %d seconds", maxSlowModeSeconds))
+		}
+		updates["slow_mode_seconds"] = *req.SlowModeSeconds
+	}
 
 	if len(updates) == 0 {
 		return nil
@@ -1355,20 +1478,44 @@ func (s *groupServiceImpl) GetGroupSettings(ctx context.Context, groupID string)
 		AllowViewHistory:  settings.AllowViewHistory,
 		AllowAddFriend:    settings.AllowAddFriend,
 		AllowMemberModify: settings.AllowMemberModify,
+		SlowModeSeconds:   settings.SlowModeSeconds,
 	}, nil
 }
 
-// IsMember checks if user is group member (called by other services)
-func (s *groupServiceImpl) IsMember(ctx context.Context, groupID, userID string) (bool, model.GroupRole, error) {
+// IsMember checks if user is group member (called by other services), returns nil when not a member.
+// The response carries the member and group mutes and the slow mode interval so senders need a single call
+func (s *groupServiceImpl) IsMember(ctx context.Context, groupID, userID string) (*dto.MemberStatusResponse, error) {
 	member, err := s.memberRepo.GetMember(ctx, groupID, userID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return false, model.GroupRoleUnknown, nil
+			return nil, nil
 		}
-		return false, model.GroupRoleUnknown, err
+		return nil, err
+	}
+
+	group, err := s.groupRepo.GetByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	slowModeSeconds := int32(0)
+	settings, err := s.settingRepo.GetSettings(ctx, groupID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if settings != nil {
+		slowModeSeconds = settings.SlowModeSeconds
 	}
 
-	return true, member.Role, nil
+	status := &dto.MemberStatusResponse{
+		Role:            member.Role,
+		JoinedAt:        member.JoinedAt,
+		GroupMuted:      group.IsMuted,
+		SlowModeSeconds: slowModeSeconds,
+	}
+	if member.IsMutedNow() {
+		status.MutedUntil = member.MutedUntil
+	}
+	return status, nil
 }
 
 // UpdateMemberRemark sets/clears group remark (only visible to self)
@@ -1575,6 +1722,12 @@ func (s *groupServiceImpl) JoinGroupByQRCode(ctx context.Context, userID, token
 	}
 
 	s.publishMemberJoinedNotification(groupID, userID, qr.CreatedBy)
+	s.sendGroupSystemEvent(ctx, groupID, groupSystemEvent{
+		event:      messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_JOINED,
+		operatorID: qr.CreatedBy,
+		userIDs:    []string{userID},
+		silent:     true,
+	})
 
 	return &dto.JoinGroupByQRCodeResponse{
 		Joined:     true,
@@ -1637,6 +1790,9 @@ func (s *groupServiceImpl) getPinnedMessageSnapshot(ctx context.Context, groupID
 	if msg.GetStatus() != 0 {
 		return nil, errors.NewBusiness(errors.CodeMessageNotFound, "Message not found or invisible")
 	}
+	if msg.GetContentType() == messagepb.ContentType_CONTENT_TYPE_SYSTEM {
+		return nil, errors.NewBusiness(errors.CodeParamError, "System messages cannot be pinned")
+	}
 
 	contentType := model.PinnedMessageContentType(msg.GetContentType())
 	if contentType == model.PinnedMessageContentTypeUnspecified {
@@ -1697,26 +1853,6 @@ func truncateWithEllipsis(text string, maxRunes int) string {
 	return string(runes[:maxRunes]) + "..."
 }
 
-func (s *groupServiceImpl) sendGroupSystemMessage(ctx context.Context, groupID, operatorID, text string) error {
-	if s.messageClient == nil {
-		return nil
-	}
-
-	content, err := json.Marshal(map[string]string{"text": text})
-	if err != nil {
-		return err
-	}
-
-	_, err = s.messageClient.SendMessage(ctx, &messagepb.SendMessageRequest{
-		SenderId:       operatorID,
-		ConversationId: groupID,
-		ContentType:    messagepb.ContentType_CONTENT_TYPE_TEXT,
-		Content:        string(content),
-		LocalId:        uuid.NewString(),
-	})
-	return err
-}
-
 // publishMemberJoinedNotification publishes member joined notification
 func (s *groupServiceImpl) publishMemberJoinedNotification(groupID, userID, inviterID string) {
 	if s.notificationPub == nil {
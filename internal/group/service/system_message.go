@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	messagepb "github.com/anychat/server/api/proto/message"
+	"github.com/anychat/server/internal/group/model"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// groupSystemEvent describes a system notice written into the group conversation
+type groupSystemEvent struct {
+	event      messagepb.SystemEvent
+	operatorID string
+	userIDs    []string
+	params     map[string]string
+	// silent notices are not counted as unread and not pushed
+	silent bool
+	// extraRecipients are notified besides the current members, e.g. a removed member
+	extraRecipients []string
+}
+
+// sendGroupSystemEvent stores the notice through the message service.
+// The group action has already succeeded, so failures are only logged
+func (s *groupServiceImpl) sendGroupSystemEvent(ctx context.Context, groupID string, e groupSystemEvent) {
+	if s.messageClient == nil {
+		return
+	}
+
+	memberIDs, err := s.memberRepo.GetMemberIDs(ctx, groupID)
+	if err != nil {
+		logger.Warn("Failed to load group members for system message",
+			zap.String("groupID", groupID),
+			zap.Error(err))
+	}
+
+	_, err = s.messageClient.SendSystemMessage(ctx, &messagepb.SendSystemMessageRequest{
+		ConversationId:   groupID,
+		ConversationType: messagepb.ConversationType_CONVERSATION_TYPE_GROUP,
+		TargetId:         groupID,
+		Event:            e.event,
+		OperatorId:       e.operatorID,
+		UserIds:          e.userIDs,
+		Params:           e.params,
+		Silent:           e.silent,
+		RecipientIds:     append(memberIDs, e.extraRecipients...),
+	})
+	if err != nil {
+		logger.Warn("Failed to send group system message",
+			zap.String("groupID", groupID),
+			zap.String("event", e.event.String()),
+			zap.Error(err))
+	}
+}
+
+// systemRoleName is the role value carried in role change notices
+func systemRoleName(role model.GroupRole) string {
+	switch role {
+	case model.GroupRoleOwner:
+		return "owner"
+	case model.GroupRoleAdmin:
+		return "admin"
+	default:
+		return "member"
+	}
+}
@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/group/service"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// GroupAvatarWorker regenerates the composite avatars of groups without an uploaded avatar after their members change
+type GroupAvatarWorker struct {
+	groupService service.GroupService
+	batchSize    int
+	interval     time.Duration
+	stopCh       chan struct{}
+}
+
+func NewGroupAvatarWorker(
+	groupService service.GroupService,
+	batchSize int,
+	interval time.Duration,
+) *GroupAvatarWorker {
+	return &GroupAvatarWorker{
+		groupService: groupService,
+		batchSize:    batchSize,
+		interval:     interval,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+func (w *GroupAvatarWorker) Start() {
+	logger.Info("GroupAvatarWorker starting", zap.Int("batchSize", w.batchSize), zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			logger.Info("GroupAvatarWorker stopped")
+			return
+		case <-ticker.C:
+			w.process()
+		}
+	}
+}
+
+func (w *GroupAvatarWorker) Stop() {
+	close(w.stopCh)
+}
+
+func (w *GroupAvatarWorker) process() {
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		default:
+		}
+
+		// a batch with failed groups ends the run, they are retried on the next tick
+		refreshed, err := w.processBatch()
+		if err != nil {
+			logger.Error("Failed to refresh group avatars", zap.Error(err))
+			return
+		}
+		if refreshed < w.batchSize {
+			return
+		}
+	}
+}
+
+func (w *GroupAvatarWorker) processBatch() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	return w.groupService.RefreshCompositeAvatars(ctx, w.batchSize)
+}
+
+func (w *GroupAvatarWorker) StartAsync() {
+	go w.Start()
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	stderrors "errors"
+	"strconv"
 
 	commonpb "github.com/anychat/server/api/proto/common"
 	messagepb "github.com/anychat/server/api/proto/message"
@@ -10,6 +11,7 @@ import (
 	pkgerrors "github.com/anychat/server/pkg/errors"
 	"github.com/anychat/server/pkg/logger"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -63,6 +65,22 @@ func (s *Server) SendMessage(ctx context.Context, req *messagepb.SendMessageRequ
 	return resp, nil
 }
 
+// SendSystemMessage stores a system notice on behalf of another service
+func (s *Server) SendSystemMessage(ctx context.Context, req *messagepb.SendSystemMessageRequest) (*messagepb.SendMessageResponse, error) {
+	logger.Info("SendSystemMessage called",
+		zap.String("conversationId", req.ConversationId),
+		zap.String("event", req.Event.String()),
+		zap.Bool("silent", req.Silent))
+
+	resp, err := s.messageService.SendSystemMessage(ctx, req)
+	if err != nil {
+		logger.Error("Failed to send system message", zap.Error(err))
+		return nil, toStatusError(err)
+	}
+
+	return resp, nil
+}
+
 // SendTyping sends typing status
 func (s *Server) SendTyping(ctx context.Context, req *messagepb.SendTypingRequest) (*commonpb.Empty, error) {
 	logger.Info("SendTyping called",
@@ -462,6 +480,42 @@ func (s *Server) SearchMessages(ctx context.Context, req *messagepb.SearchMessag
 	return resp, nil
 }
 
+// ListConversationMedia lists the media gallery of a conversation
+func (s *Server) ListConversationMedia(ctx context.Context, req *messagepb.ListConversationMediaRequest) (*messagepb.ListConversationMediaResponse, error) {
+	operatorUserID := getOperatorUserID(ctx)
+	if operatorUserID == "" {
+		return nil, status.Error(codes.InvalidArgument, "x-user-id metadata is required")
+	}
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+
+	resp, err := s.messageService.ListConversationMedia(ctx, operatorUserID, req)
+	if err != nil {
+		logger.Error("Failed to list conversation media",
+			zap.String("conversationId", req.ConversationId),
+			zap.Error(err))
+		return nil, toStatusError(err)
+	}
+
+	return resp, nil
+}
+
+// EraseUserData erases message data of a deleted account (called by auth-service)
+func (s *Server) EraseUserData(ctx context.Context, req *commonpb.EraseUserDataRequest) (*commonpb.EraseUserDataResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	erased, err := s.messageService.EraseUserData(ctx, req.UserId)
+	if err != nil {
+		logger.Error("Failed to erase message data", zap.String("userId", req.UserId), zap.Error(err))
+		return nil, toStatusError(err)
+	}
+
+	return &commonpb.EraseUserDataResponse{Erased: erased}, nil
+}
+
 func toStatusError(err error) error {
 	var bizErr *pkgerrors.Business
 	if !stderrors.As(err, &bizErr) {
@@ -477,9 +531,33 @@ func toStatusError(err error) error {
 		return status.Error(codes.PermissionDenied, bizErr.Message)
 	case pkgerrors.CodeUserBlocked:
 		return status.Error(codes.PermissionDenied, bizErr.Message)
+	case pkgerrors.CodeMemberMuted, pkgerrors.CodeGroupMuted:
+		return sendRestrictionError(codes.PermissionDenied, bizErr)
+	case pkgerrors.CodeGroupSlowMode:
+		return sendRestrictionError(codes.ResourceExhausted, bizErr)
 	case pkgerrors.CodeInvalidOperation:
 		return status.Error(codes.FailedPrecondition, bizErr.Message)
 	default:
 		return status.Error(codes.Internal, bizErr.Message)
 	}
 }
+
+// sendRestrictionReasons reasons of rejected sends, attached as ErrorInfo so clients can tell mutes and slow mode apart
+var sendRestrictionReasons = map[int]string{
+	pkgerrors.CodeMemberMuted:   "member_muted",
+	pkgerrors.CodeGroupMuted:    "group_muted",
+	pkgerrors.CodeGroupSlowMode: "slow_mode",
+}
+
+func sendRestrictionError(code codes.Code, bizErr *pkgerrors.Business) error {
+	st := status.New(code, bizErr.Message)
+	withInfo, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   sendRestrictionReasons[bizErr.Code],
+		Domain:   "message",
+		Metadata: map[string]string{"code": strconv.Itoa(bizErr.Code)},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withInfo.Err()
+}
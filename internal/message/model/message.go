@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -25,6 +26,7 @@ const (
 	ContentTypeFile        ContentType = 5
 	ContentTypeLocation    ContentType = 6
 	ContentTypeCard        ContentType = 7
+	ContentTypeSystem      ContentType = 8 // system notice without a human sender
 )
 
 // MessageStatus represents message lifecycle state.
@@ -38,7 +40,7 @@ type Message struct {
 	ConversationType           ConversationType `gorm:"column:conversation_type;type:smallint;not null" json:"conversationType"` // 1-single/2-group
 	TargetID                   string     `gorm:"column:target_id;not null;default:'';index:idx_messages_target_id" json:"targetId"`
 	SenderID                   string     `gorm:"column:sender_id;not null;index:idx_sender_time" json:"senderId"`
-	ContentType                ContentType `gorm:"column:content_type;type:smallint;not null" json:"contentType"` // 1-text/2-image/3-video/4-audio/5-file/6-location/7-card
+	ContentType                ContentType `gorm:"column:content_type;type:smallint;not null" json:"contentType"` // 1-text/2-image/3-video/4-audio/5-file/6-location/7-card/8-system
 	Content                    string     `gorm:"column:content;type:jsonb;not null" json:"content"`
 	Sequence                   int64      `gorm:"column:sequence;not null;uniqueIndex:uk_conversation_sequence" json:"sequence"`
 	ReplyTo                    *string    `gorm:"column:reply_to" json:"replyTo,omitempty"`
@@ -48,6 +50,7 @@ type Message struct {
 	AutoDeleteExpireTime       *time.Time `gorm:"column:auto_delete_expire_time" json:"autoDeleteExpireTime,omitempty"`              // auto-delete policy expiration time
 	BurnAfterReadingExpireTime *time.Time `gorm:"column:burn_after_reading_expire_time" json:"burnAfterReadingExpireTime,omitempty"` // burn-after-reading policy expiration time
 	ExpireTime                 *time.Time `gorm:"column:expire_time;index:idx_expire_time" json:"expireTime,omitempty"`              // message expiration time, NULL means never expires
+	Silent                     bool       `gorm:"column:silent;not null;default:false" json:"silent"`                                // system notice not counted as unread
 	CreatedAt                  time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;index:idx_created_at" json:"createdAt"`
 	UpdatedAt                  time.Time  `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP" json:"updatedAt"`
 }
@@ -79,3 +82,27 @@ func (m *Message) IsRecalled() bool {
 func (m *Message) IsDeleted() bool {
 	return m.Status == MessageStatusDeleted
 }
+
+// file grant revoke reasons understood by file-service
+const (
+	FileGrantRevokeRecalled    = "recalled"
+	FileGrantRevokeDeleted     = "deleted"
+	FileGrantRevokeAutoDeleted = "auto_deleted"
+)
+
+// FileIDs returns the file referenced by an image, video, voice or file message ("file_id" of the JSON content)
+func (m *Message) FileIDs() []string {
+	switch m.ContentType {
+	case ContentTypeImage, ContentTypeVideo, ContentTypeAudio, ContentTypeFile:
+	default:
+		return nil
+	}
+
+	var media struct {
+		FileID string `json:"file_id"`
+	}
+	if err := json.Unmarshal([]byte(m.Content), &media); err != nil || media.FileID == "" {
+		return nil
+	}
+	return []string{media.FileID}
+}
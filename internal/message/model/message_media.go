@@ -0,0 +1,104 @@
+package model
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+)
+
+// MediaCategory media gallery category
+type MediaCategory int16
+
+const (
+	MediaCategoryUnspecified MediaCategory = 0
+	MediaCategoryImage       MediaCategory = 1 // image message
+	MediaCategoryVideo       MediaCategory = 2 // video message
+	MediaCategoryFile        MediaCategory = 3 // file message
+	MediaCategoryLink        MediaCategory = 4 // URL in a text message
+)
+
+// maxLinksPerMessage links of one text message added to the gallery
+const maxLinksPerMessage = 10
+
+// linkPattern URLs in text messages, stops at whitespace, quotes and full-width punctuation.
+// The backfill in migration 000028 uses the same pattern
+var linkPattern = regexp.MustCompile(`https?://[^\s<>"'，。！？；：、）】》]+`)
+
+// MessageMedia media gallery entry of a conversation, written in the transaction that stores the message
+type MessageMedia struct {
+	ID             int64         `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	MessageID      string        `gorm:"column:message_id;not null" json:"messageId"`
+	ConversationID string        `gorm:"column:conversation_id;not null" json:"conversationId"`
+	Sequence       int64         `gorm:"column:sequence;not null" json:"sequence"`
+	Category       MediaCategory `gorm:"column:category;type:smallint;not null" json:"category"`
+	SenderID       string        `gorm:"column:sender_id;not null" json:"senderId"`
+	FileID         string        `gorm:"column:file_id;not null;default:''" json:"fileId,omitempty"` // image, video and file entries
+	URL            string        `gorm:"column:url;not null;default:''" json:"url,omitempty"`        // link entries
+	Content        string        `gorm:"column:content;->" json:"content,omitempty"`                 // content of the message, read only
+	CreatedAt      time.Time     `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP" json:"createdAt"`
+}
+
+// TableName returns table name
+func (MessageMedia) TableName() string {
+	return "message_media"
+}
+
+// ExtractMedia returns the gallery entries of a message: its image, video or file, or the links of a text message.
+// Burn-after-reading messages are never added, voice messages are not part of the gallery
+func ExtractMedia(m *Message) []*MessageMedia {
+	if m.BurnAfterReadingSeconds > 0 {
+		return nil
+	}
+
+	entry := func(category MediaCategory) *MessageMedia {
+		return &MessageMedia{
+			MessageID:      m.MessageID,
+			ConversationID: m.ConversationID,
+			Sequence:       m.Sequence,
+			Category:       category,
+			SenderID:       m.SenderID,
+			CreatedAt:      m.CreatedAt,
+		}
+	}
+
+	switch m.ContentType {
+	case ContentTypeImage, ContentTypeVideo, ContentTypeFile:
+		fileIDs := m.FileIDs()
+		if len(fileIDs) == 0 {
+			return nil
+		}
+		category := MediaCategoryFile
+		if m.ContentType == ContentTypeImage {
+			category = MediaCategoryImage
+		} else if m.ContentType == ContentTypeVideo {
+			category = MediaCategoryVideo
+		}
+		media := entry(category)
+		media.FileID = fileIDs[0]
+		return []*MessageMedia{media}
+	case ContentTypeText:
+		var text struct {
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal([]byte(m.Content), &text); err != nil || text.Text == "" {
+			return nil
+		}
+		var media []*MessageMedia
+		seen := make(map[string]struct{})
+		for _, link := range linkPattern.FindAllString(text.Text, -1) {
+			if _, ok := seen[link]; ok {
+				continue
+			}
+			seen[link] = struct{}{}
+			entry := entry(MediaCategoryLink)
+			entry.URL = link
+			media = append(media, entry)
+			if len(media) == maxLinksPerMessage {
+				break
+			}
+		}
+		return media
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,23 @@
+package model
+
+// SystemSenderID sender of system notices, they have no human author
+const SystemSenderID = ""
+
+// system notice events, clients render a localized text from the event and its params
+const (
+	SystemEventGroupMemberJoined         = "group.member_joined"
+	SystemEventGroupMemberLeft           = "group.member_left"
+	SystemEventGroupMemberRemoved        = "group.member_removed"
+	SystemEventGroupRoleChanged          = "group.role_changed"
+	SystemEventGroupRenamed              = "group.renamed"
+	SystemEventGroupMuteChanged          = "group.mute_changed"
+	SystemEventGroupOwnershipTransferred = "group.ownership_transferred"
+)
+
+// SystemContent JSON content of a system notice
+type SystemContent struct {
+	Event      string            `json:"event"`
+	OperatorID string            `json:"operator_id,omitempty"` // user who caused the event
+	UserIDs    []string          `json:"user_ids,omitempty"`    // users the event is about
+	Params     map[string]string `json:"params,omitempty"`
+}
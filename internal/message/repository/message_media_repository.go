@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anychat/server/internal/message/model"
+	"gorm.io/gorm"
+)
+
+// MediaQuery media gallery page of a conversation
+type MediaQuery struct {
+	ConversationID string
+	Categories     []model.MediaCategory // empty means all categories
+	VisibleSince   *time.Time            // hides media sent before this time, e.g. before a group member joined
+	BeforeSequence int64                 // cursor, 0 starts from the latest
+	BeforeID       int64
+	Limit          int
+}
+
+// MessageMediaRepository media gallery repository interface
+type MessageMediaRepository interface {
+	// CreateBatch adds the gallery entries of a message
+	CreateBatch(ctx context.Context, media []*model.MessageMedia) error
+	// ListByConversation lists the media of visible messages, newest first, together with the message content
+	ListByConversation(ctx context.Context, query MediaQuery) ([]*model.MessageMedia, error)
+	WithTx(tx *gorm.DB) MessageMediaRepository
+}
+
+// messageMediaRepositoryImpl media gallery repository implementation
+type messageMediaRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewMessageMediaRepository creates media gallery repository
+func NewMessageMediaRepository(db *gorm.DB) MessageMediaRepository {
+	return &messageMediaRepositoryImpl{db: db}
+}
+
+// CreateBatch adds the gallery entries of a message
+func (r *messageMediaRepositoryImpl) CreateBatch(ctx context.Context, media []*model.MessageMedia) error {
+	if len(media) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Omit("Content").Create(media).Error
+}
+
+// ListByConversation lists the media of visible messages, newest first. Entries stay when their message is
+// recalled, deleted or expires, the join with messages hides them
+func (r *messageMediaRepositoryImpl) ListByConversation(ctx context.Context, query MediaQuery) ([]*model.MessageMedia, error) {
+	db := r.db.WithContext(ctx).
+		Table("message_media AS mm").
+		Select("mm.*, m.content").
+		Joins("JOIN messages m ON m.message_id = mm.message_id").
+		Where("mm.conversation_id = ?", query.ConversationID).
+		Where("m.status = ? AND m.burn_after_reading_seconds = 0", model.MessageStatusNormal).
+		Where("(m.expire_time IS NULL OR m.expire_time > ?)", time.Now())
+
+	if len(query.Categories) > 0 {
+		db = db.Where("mm.category IN ?", query.Categories)
+	}
+	if query.VisibleSince != nil {
+		db = db.Where("mm.created_at >= ?", *query.VisibleSince)
+	}
+	if query.BeforeSequence > 0 {
+		db = db.Where("(mm.sequence, mm.id) < (?, ?)", query.BeforeSequence, query.BeforeID)
+	}
+
+	var media []*model.MessageMedia
+	err := db.Order("mm.sequence DESC, mm.id DESC").
+		Limit(query.Limit).
+		Find(&media).Error
+	return media, err
+}
+
+// WithTx uses transaction
+func (r *messageMediaRepositoryImpl) WithTx(tx *gorm.DB) MessageMediaRepository {
+	return &messageMediaRepositoryImpl{db: tx}
+}
@@ -149,12 +149,12 @@ func (r *messageRepositoryImpl) CountByConversation(ctx context.Context, convers
 	return count, err
 }
 
-// CountUnreadByConversation counts unread messages in conversation
+// CountUnreadByConversation counts unread messages in conversation, silent system notices are not counted
 func (r *messageRepositoryImpl) CountUnreadByConversation(ctx context.Context, conversationID string, lastReadSeq int64) (int64, error) {
 	var count int64
 	err := r.db.WithContext(ctx).
 		Model(&model.Message{}).
-		Where("conversation_id = ? AND sequence > ? AND status = ?", conversationID, lastReadSeq, model.MessageStatusNormal).
+		Where("conversation_id = ? AND sequence > ? AND status = ? AND silent = ?", conversationID, lastReadSeq, model.MessageStatusNormal, false).
 		Count(&count).Error
 	return count, err
 }
@@ -170,7 +170,8 @@ func (r *messageRepositoryImpl) SearchMessages(ctx context.Context, keyword stri
 
 	// Keyword search (using JSONB contains query)
 	if keyword != "" {
-		query = query.Where("content::text ILIKE ?", "%"+keyword+"%")
+		// system notices hold event keys and user IDs, not text
+		query = query.Where("content::text ILIKE ? AND content_type <> ?", "%"+keyword+"%", model.ContentTypeSystem)
 	}
 
 	// Conversation filter
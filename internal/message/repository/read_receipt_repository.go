@@ -15,6 +15,7 @@ type ReadReceiptRepository interface {
 	GetByConversation(ctx context.Context, conversationID string) ([]*model.MessageReadReceipt, error)
 	GetByUser(ctx context.Context, userID string) ([]*model.MessageReadReceipt, error)
 	Delete(ctx context.Context, conversationID, userID string) error
+	DeleteByUser(ctx context.Context, userID string) (int64, error)
 	WithTx(tx *gorm.DB) ReadReceiptRepository
 }
 
@@ -83,6 +84,14 @@ func (r *readReceiptRepositoryImpl) Delete(ctx context.Context, conversationID,
 		Delete(&model.MessageReadReceipt{}).Error
 }
 
+// DeleteByUser deletes all read receipts of the user
+func (r *readReceiptRepositoryImpl) DeleteByUser(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.MessageReadReceipt{})
+	return result.RowsAffected, result.Error
+}
+
 // WithTx uses transaction
 func (r *readReceiptRepositoryImpl) WithTx(tx *gorm.DB) ReadReceiptRepository {
 	return &readReceiptRepositoryImpl{db: tx}
@@ -11,8 +11,10 @@ import (
 // SendIdempotencyRepository send idempotency repository interface
 type SendIdempotencyRepository interface {
 	CreateIfNotExists(ctx context.Context, rec *model.MessageSendIdempotency) error
+	GetByKey(ctx context.Context, senderID, conversationID, localID string) (*model.MessageSendIdempotency, error)
 	GetForUpdateByKey(ctx context.Context, senderID, conversationID, localID string) (*model.MessageSendIdempotency, error)
 	BindMessageID(ctx context.Context, senderID, conversationID, localID, messageID string) error
+	DeleteBySender(ctx context.Context, senderID string) (int64, error)
 	WithTx(tx *gorm.DB) SendIdempotencyRepository
 }
 
@@ -32,6 +34,18 @@ func (r *sendIdempotencyRepositoryImpl) CreateIfNotExists(ctx context.Context, r
 		Create(rec).Error
 }
 
+// GetByKey queries by key without locking
+func (r *sendIdempotencyRepositoryImpl) GetByKey(ctx context.Context, senderID, conversationID, localID string) (*model.MessageSendIdempotency, error) {
+	var rec model.MessageSendIdempotency
+	err := r.db.WithContext(ctx).
+		Where("sender_id = ? AND conversation_id = ? AND local_id = ?", senderID, conversationID, localID).
+		First(&rec).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
 // GetForUpdateByKey queries by key and acquires row lock
 func (r *sendIdempotencyRepositoryImpl) GetForUpdateByKey(ctx context.Context, senderID, conversationID, localID string) (*model.MessageSendIdempotency, error) {
 	var rec model.MessageSendIdempotency
@@ -53,6 +67,14 @@ func (r *sendIdempotencyRepositoryImpl) BindMessageID(ctx context.Context, sende
 		Update("message_id", messageID).Error
 }
 
+// DeleteBySender deletes all idempotency records of the sender
+func (r *sendIdempotencyRepositoryImpl) DeleteBySender(ctx context.Context, senderID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("sender_id = ?", senderID).
+		Delete(&model.MessageSendIdempotency{})
+	return result.RowsAffected, result.Error
+}
+
 // WithTx uses transaction
 func (r *sendIdempotencyRepositoryImpl) WithTx(tx *gorm.DB) SendIdempotencyRepository {
 	return &sendIdempotencyRepositoryImpl{db: tx}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pkgredis "github.com/anychat/server/pkg/redis"
+)
+
+// SlowModeRepository per-member send interval cache repository
+type SlowModeRepository interface {
+	// AcquireSendSlot reserves the next send of the member for interval, wait is the time left when already reserved
+	AcquireSendSlot(ctx context.Context, conversationID, userID string, interval time.Duration) (acquired bool, wait time.Duration, err error)
+	ReleaseSendSlot(ctx context.Context, conversationID, userID string) error
+}
+
+type slowModeRepositoryImpl struct {
+	cache *pkgredis.Client
+}
+
+// NewSlowModeRepository creates slow mode cache repository
+func NewSlowModeRepository(cache *pkgredis.Client) SlowModeRepository {
+	return &slowModeRepositoryImpl{cache: cache}
+}
+
+func (r *slowModeRepositoryImpl) AcquireSendSlot(ctx context.Context, conversationID, userID string, interval time.Duration) (bool, time.Duration, error) {
+	key := r.slotKey(conversationID, userID)
+	acquired, err := r.cache.GetClient().SetNX(ctx, key, "1", interval).Result()
+	if err != nil || acquired {
+		return acquired, 0, err
+	}
+
+	wait, err := r.cache.GetClient().PTTL(ctx, key).Result()
+	if err != nil {
+		return false, 0, err
+	}
+	if wait < 0 {
+		// expired between the two calls
+		wait = 0
+	}
+	return false, wait, nil
+}
+
+func (r *slowModeRepositoryImpl) ReleaseSendSlot(ctx context.Context, conversationID, userID string) error {
+	return r.cache.Del(ctx, r.slotKey(conversationID, userID))
+}
+
+func (r *slowModeRepositoryImpl) slotKey(conversationID, userID string) string {
+	return fmt.Sprintf("msg:slowmode:%s:%s", conversationID, userID)
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// EraseUserData deletes read positions and send deduplication records of a deleted account.
+// Messages the account sent stay in the conversations of the other participants
+func (s *messageServiceImpl) EraseUserData(ctx context.Context, userID string) (map[string]int64, error) {
+	erased := make(map[string]int64, 2)
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		count, err := s.readReceiptRepo.WithTx(tx).DeleteByUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		erased["read_receipts"] = count
+
+		if count, err = s.sendIdempotencyRepo.WithTx(tx).DeleteBySender(ctx, userID); err != nil {
+			return err
+		}
+		erased["send_idempotency_records"] = count
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return erased, nil
+}
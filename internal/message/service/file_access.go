@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	filepb "github.com/anychat/server/api/proto/file"
+	"github.com/anychat/server/internal/message/model"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// grantFileAccess links the files referenced by a new message to its conversation
+func (s *messageServiceImpl) grantFileAccess(ctx context.Context, msg *model.Message) error {
+	fileIDs := msg.FileIDs()
+	if len(fileIDs) == 0 || s.fileClient == nil {
+		return nil
+	}
+
+	resp, err := s.fileClient.GrantMessageFileAccess(ctx, &filepb.GrantMessageFileAccessRequest{
+		MessageId:        msg.MessageID,
+		ConversationId:   msg.ConversationID,
+		ConversationType: int32(msg.ConversationType),
+		TargetId:         msg.TargetID,
+		SenderId:         msg.SenderID,
+		FileIds:          fileIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.RejectedFileIds) > 0 {
+		logger.Warn("Message references files the sender cannot share",
+			zap.String("messageID", msg.MessageID),
+			zap.Strings("fileIDs", resp.RejectedFileIds))
+	}
+	return nil
+}
+
+// revokeFileAccess revokes the file grants of recalled or deleted messages
+func (s *messageServiceImpl) revokeFileAccess(ctx context.Context, messageIDs []string, reason string) error {
+	if len(messageIDs) == 0 || s.fileClient == nil {
+		return nil
+	}
+	_, err := s.fileClient.RevokeMessageFileAccess(ctx, &filepb.RevokeMessageFileAccessRequest{
+		MessageIds: messageIDs,
+		Reason:     reason,
+	})
+	return err
+}
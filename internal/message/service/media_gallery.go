@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	conversationpb "github.com/anychat/server/api/proto/conversation"
+	filepb "github.com/anychat/server/api/proto/file"
+	grouppb "github.com/anychat/server/api/proto/group"
+	messagepb "github.com/anychat/server/api/proto/message"
+	"github.com/anychat/server/internal/message/model"
+	"github.com/anychat/server/internal/message/repository"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultMediaPageLimit = 20
+	maxMediaPageLimit     = 100
+)
+
+// ListConversationMedia lists the images, videos, files and links of a conversation, newest first, with
+// download and thumbnail URLs. Recalled, deleted, expired and burn-after-reading messages are left out, and
+// group members who joined later only see earlier media when the group allows viewing history
+func (s *messageServiceImpl) ListConversationMedia(ctx context.Context, userID string, req *messagepb.ListConversationMediaRequest) (*messagepb.ListConversationMediaResponse, error) {
+	if userID == "" || req.ConversationId == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "user_id and conversation_id are required")
+	}
+
+	query := repository.MediaQuery{
+		ConversationID: req.ConversationId,
+		Limit:          normalizeMediaPageLimit(req.Limit),
+	}
+	for _, category := range req.Categories {
+		if category < messagepb.MediaCategory_MEDIA_CATEGORY_IMAGE || category > messagepb.MediaCategory_MEDIA_CATEGORY_LINK {
+			return nil, errors.NewBusiness(errors.CodeParamError, "invalid media category")
+		}
+		query.Categories = append(query.Categories, model.MediaCategory(category))
+	}
+	if cursor := req.GetCursor(); cursor != "" {
+		var err error
+		if query.BeforeSequence, query.BeforeID, err = decodeMediaCursor(cursor); err != nil {
+			return nil, errors.NewBusiness(errors.CodeParamError, "invalid cursor")
+		}
+	}
+
+	visibleSince, err := s.mediaVisibleSince(ctx, userID, req.ConversationId)
+	if err != nil {
+		return nil, err
+	}
+	query.VisibleSince = visibleSince
+
+	// one extra row tells whether there is another page
+	query.Limit++
+	media, err := s.mediaRepo.ListByConversation(ctx, query)
+	if err != nil {
+		logger.Error("Failed to list conversation media",
+			zap.String("conversationID", req.ConversationId),
+			zap.Error(err))
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to list conversation media")
+	}
+	hasMore := len(media) == query.Limit
+	if hasMore {
+		media = media[:query.Limit-1]
+	}
+
+	urls := s.batchDownloadURLs(ctx, userID, media)
+	items := make([]*messagepb.ConversationMedia, 0, len(media))
+	for _, m := range media {
+		item := &messagepb.ConversationMedia{
+			MessageId: m.MessageID,
+			Sequence:  m.Sequence,
+			Category:  messagepb.MediaCategory(m.Category),
+			SenderId:  m.SenderID,
+			Content:   m.Content,
+			CreatedAt: timestamppb.New(m.CreatedAt),
+		}
+		if m.FileID != "" {
+			item.FileId = &m.FileID
+			if url, ok := urls[m.FileID]; ok {
+				item.DownloadUrl = &url.DownloadUrl
+				item.ThumbnailUrl = url.ThumbnailUrl
+			}
+		}
+		if m.URL != "" {
+			item.Url = &m.URL
+		}
+		items = append(items, item)
+	}
+
+	resp := &messagepb.ListConversationMediaResponse{
+		Items:   items,
+		HasMore: hasMore,
+	}
+	if hasMore {
+		last := media[len(media)-1]
+		resp.NextCursor = encodeMediaCursor(last.Sequence, last.ID)
+	}
+	return resp, nil
+}
+
+// mediaVisibleSince checks that the user can read the conversation and returns the earliest time of media
+// the user may see: the join time of a group member when the group hides history from new members
+func (s *messageServiceImpl) mediaVisibleSince(ctx context.Context, userID, conversationID string) (*time.Time, error) {
+	if s.conversationClient == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "conversation client is not initialized")
+	}
+	conversation, err := s.conversationClient.GetConversation(ctx, &conversationpb.GetConversationRequest{
+		UserId:         userID,
+		ConversationId: conversationID,
+	})
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeConversationNotFound, "conversation not found")
+	}
+	if conversation.ConversationType != conversationpb.ConversationType_CONVERSATION_TYPE_GROUP {
+		return nil, nil
+	}
+
+	if s.groupClient == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "group client is not initialized")
+	}
+	member, err := s.groupClient.IsMember(ctx, &grouppb.IsMemberRequest{
+		GroupId: conversation.TargetId,
+		UserId:  userID,
+	})
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to verify group membership")
+	}
+	if !member.IsMember {
+		return nil, errors.NewBusiness(errors.CodeMessagePermissionDenied, "user is not a group member")
+	}
+	settings, err := s.groupClient.GetGroupSettings(ctx, &grouppb.GetGroupSettingsRequest{GroupId: conversation.TargetId})
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to get group settings")
+	}
+	if settings.AllowViewHistory {
+		return nil, nil
+	}
+	joinedAt := time.Unix(member.JoinedAt, 0)
+	return &joinedAt, nil
+}
+
+// batchDownloadURLs presigns the files of a page in one call. Files that are gone or no longer readable
+// get no URL, a failed call leaves the page without URLs rather than failing it
+func (s *messageServiceImpl) batchDownloadURLs(ctx context.Context, userID string, media []*model.MessageMedia) map[string]*filepb.GenerateDownloadURLResponse {
+	var fileIDs []string
+	for _, m := range media {
+		if m.FileID != "" {
+			fileIDs = append(fileIDs, m.FileID)
+		}
+	}
+	if len(fileIDs) == 0 || s.fileClient == nil {
+		return nil
+	}
+
+	resp, err := s.fileClient.BatchGenerateDownloadURLs(ctx, &filepb.BatchGenerateDownloadURLsRequest{
+		FileIds: fileIDs,
+		UserId:  userID,
+	})
+	if err != nil {
+		logger.Error("Failed to generate media download URLs", zap.Error(err))
+		return nil
+	}
+	urls := make(map[string]*filepb.GenerateDownloadURLResponse, len(resp.Urls))
+	for _, url := range resp.Urls {
+		urls[url.FileId] = url
+	}
+	return urls
+}
+
+func normalizeMediaPageLimit(limit int32) int {
+	if limit <= 0 {
+		return defaultMediaPageLimit
+	}
+	if limit > maxMediaPageLimit {
+		return maxMediaPageLimit
+	}
+	return int(limit)
+}
+
+// encodeMediaCursor cursor of the page after the given entry, opaque to clients
+func encodeMediaCursor(sequence, id int64) string {
+	return fmt.Sprintf("%d.%d", sequence, id)
+}
+
+func decodeMediaCursor(cursor string) (int64, int64, error) {
+	seqPart, idPart, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return 0, 0, fmt.Errorf("malformed cursor")
+	}
+	sequence, err := strconv.ParseInt(seqPart, 10, 64)
+	if err != nil || sequence <= 0 {
+		return 0, 0, fmt.Errorf("malformed cursor sequence")
+	}
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, 0, fmt.Errorf("malformed cursor id")
+	}
+	return sequence, id, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	conversationpb "github.com/anychat/server/api/proto/conversation"
+	filepb "github.com/anychat/server/api/proto/file"
 	friendpb "github.com/anychat/server/api/proto/friend"
 	grouppb "github.com/anychat/server/api/proto/group"
 	messagepb "github.com/anychat/server/api/proto/message"
@@ -23,6 +24,7 @@ import (
 // MessageService message service interface
 type MessageService interface {
 	SendMessage(ctx context.Context, req *messagepb.SendMessageRequest) (*messagepb.SendMessageResponse, error)
+	SendSystemMessage(ctx context.Context, req *messagepb.SendSystemMessageRequest) (*messagepb.SendMessageResponse, error)
 	SendTyping(ctx context.Context, req *messagepb.SendTypingRequest) error
 	GetMessages(ctx context.Context, req *messagepb.GetMessagesRequest) (*messagepb.GetMessagesResponse, error)
 	GetMessagesBefore(ctx context.Context, userID string, req *messagepb.GetMessagesBeforeRequest) (*messagepb.GetMessagesBeforeResponse, error)
@@ -39,6 +41,8 @@ type MessageService interface {
 	GetReadReceipts(ctx context.Context, conversationID, userID string) (*messagepb.GetReadReceiptsResponse, error)
 	GetConversationSequence(ctx context.Context, conversationID string) (int64, error)
 	SearchMessages(ctx context.Context, userID string, req *messagepb.SearchMessagesRequest) (*messagepb.SearchMessagesResponse, error)
+	ListConversationMedia(ctx context.Context, userID string, req *messagepb.ListConversationMediaRequest) (*messagepb.ListConversationMediaResponse, error)
+	EraseUserData(ctx context.Context, userID string) (map[string]int64, error)
 }
 
 // MessageRepo message repository interface
@@ -61,11 +65,21 @@ type SendIdempotencyRepo interface {
 	repository.SendIdempotencyRepository
 }
 
+// MessageMediaRepo media gallery repository interface
+type MessageMediaRepo interface {
+	repository.MessageMediaRepository
+}
+
 // TypingRepo typing status repository interface
 type TypingRepo interface {
 	repository.TypingRepository
 }
 
+// SlowModeRepo group slow mode repository interface
+type SlowModeRepo interface {
+	repository.SlowModeRepository
+}
+
 // TypingConfig typing status configuration
 type TypingConfig struct {
 	DefaultTTL   time.Duration
@@ -80,11 +94,14 @@ type messageServiceImpl struct {
 	readReceiptRepo     ReadReceiptRepo
 	sequenceRepo        SequenceRepo
 	sendIdempotencyRepo SendIdempotencyRepo
+	mediaRepo           MessageMediaRepo
 	typingRepo          TypingRepo
+	slowModeRepo        SlowModeRepo
 	typingConfig        TypingConfig
 	conversationClient  conversationpb.ConversationServiceClient
 	friendClient        friendpb.FriendServiceClient
 	groupClient         grouppb.GroupServiceClient
+	fileClient          filepb.FileServiceClient
 	notificationPub     notification.Publisher
 	db                  *gorm.DB
 }
@@ -100,11 +117,14 @@ func NewMessageService(
 	readReceiptRepo repository.ReadReceiptRepository,
 	sequenceRepo repository.SequenceRepository,
 	sendIdempotencyRepo repository.SendIdempotencyRepository,
+	mediaRepo repository.MessageMediaRepository,
 	typingRepo repository.TypingRepository,
+	slowModeRepo repository.SlowModeRepository,
 	typingConfig TypingConfig,
 	conversationClient conversationpb.ConversationServiceClient,
 	friendClient friendpb.FriendServiceClient,
 	groupClient grouppb.GroupServiceClient,
+	fileClient filepb.FileServiceClient,
 	notificationPub notification.Publisher,
 	db *gorm.DB,
 ) MessageService {
@@ -129,11 +149,14 @@ func NewMessageService(
 		readReceiptRepo:     readReceiptRepo,
 		sequenceRepo:        sequenceRepo,
 		sendIdempotencyRepo: sendIdempotencyRepo,
+		mediaRepo:           mediaRepo,
 		typingRepo:          typingRepo,
+		slowModeRepo:        slowModeRepo,
 		typingConfig:        typingConfig,
 		conversationClient:  conversationClient,
 		friendClient:        friendClient,
 		groupClient:         groupClient,
+		fileClient:          fileClient,
 		notificationPub:     notificationPub,
 		db:                  db,
 	}
@@ -141,7 +164,7 @@ func NewMessageService(
 
 // SendMessage sends a message
 func (s *messageServiceImpl) SendMessage(ctx context.Context, req *messagepb.SendMessageRequest) (*messagepb.SendMessageResponse, error) {
-	conversation, err := s.authorizeSend(ctx, req.SenderId, req.ConversationId)
+	conversation, slowMode, err := s.authorizeSend(ctx, req.SenderId, req.ConversationId)
 	if err != nil {
 		return nil, err
 	}
@@ -153,88 +176,52 @@ func (s *messageServiceImpl) SendMessage(ctx context.Context, req *messagepb.Sen
 	if s.sendIdempotencyRepo == nil {
 		return nil, errors.NewBusiness(errors.CodeInternalError, "idempotency repo is not initialized")
 	}
+	if model.ContentType(req.ContentType) == model.ContentTypeSystem {
+		return nil, errors.NewBusiness(errors.CodeParamError, "system messages can only be sent by the server")
+	}
 
-	var message *model.Message
-	created := false
-
-	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		messageRepoTx := s.messageRepo.WithTx(tx)
-		sequenceRepoTx := s.sequenceRepo.WithTx(tx)
-		idempotencyRepoTx := s.sendIdempotencyRepo.WithTx(tx)
-
-		if err := idempotencyRepoTx.CreateIfNotExists(ctx, &model.MessageSendIdempotency{
-			SenderID:       req.SenderId,
-			ConversationID: req.ConversationId,
-			LocalID:        localID,
-		}); err != nil {
-			return err
-		}
-
-		idempotencyRecord, err := idempotencyRepoTx.GetForUpdateByKey(ctx, req.SenderId, req.ConversationId, localID)
-		if err != nil {
-			return err
-		}
-
-		// Idempotency hit: return existing message
-		if idempotencyRecord.MessageID != "" {
-			existing, err := messageRepoTx.GetByMessageID(ctx, idempotencyRecord.MessageID)
-			if err != nil {
-				return err
-			}
-			message = existing
-			return nil
-		}
-
-		// Create new message (in same transaction as sequence allocation)
-		sequence, err := sequenceRepoTx.IncrementAndGet(ctx, req.ConversationId)
+	slotAcquired := false
+	if slowMode > 0 {
+		slotAcquired, err = s.acquireSlowModeSlot(ctx, req.SenderId, req.ConversationId, localID, slowMode)
 		if err != nil {
-			logger.Error("Failed to increment sequence", zap.Error(err))
-			return errors.NewBusiness(errors.CodeSequenceGenerateFailed, "")
-		}
-
-		now := time.Now()
-		newMessage := &model.Message{
-			MessageID:        uuid.New().String(),
-			ConversationID:   req.ConversationId,
-			ConversationType: model.ConversationType(conversation.ConversationType),
-			TargetID:         conversation.TargetId,
-			SenderID:         req.SenderId,
-			ContentType:      model.ContentType(req.ContentType),
-			Content:          req.Content,
-			Sequence:         sequence,
-			Status:           model.MessageStatusNormal,
-			CreatedAt:        now,
-			UpdatedAt:        now,
-		}
-
-		if conversation.AutoDeleteDuration > 0 {
-			expireTime := now.Add(time.Duration(conversation.AutoDeleteDuration) * time.Second)
-			newMessage.AutoDeleteExpireTime = &expireTime
-			newMessage.ExpireTime = &expireTime
-		}
-		if conversation.BurnAfterReading > 0 {
-			newMessage.BurnAfterReadingSeconds = conversation.BurnAfterReading
-		}
-		if req.ReplyTo != nil {
-			newMessage.ReplyTo = req.ReplyTo
-		}
-		if len(req.AtUsers) > 0 {
-			newMessage.AtUsers = req.AtUsers
+			return nil, err
 		}
+	}
 
-		if err := messageRepoTx.Create(ctx, newMessage); err != nil {
-			logger.Error("Failed to create message", zap.Error(err))
-			return errors.NewBusiness(errors.CodeMessageSendFailed, "")
-		}
+	now := time.Now()
+	newMessage := &model.Message{
+		MessageID:        uuid.New().String(),
+		ConversationID:   req.ConversationId,
+		ConversationType: model.ConversationType(conversation.ConversationType),
+		TargetID:         conversation.TargetId,
+		SenderID:         req.SenderId,
+		ContentType:      model.ContentType(req.ContentType),
+		Content:          req.Content,
+		Status:           model.MessageStatusNormal,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
 
-		if err := idempotencyRepoTx.BindMessageID(ctx, req.SenderId, req.ConversationId, localID, newMessage.MessageID); err != nil {
-			return err
-		}
+	if conversation.AutoDeleteDuration > 0 {
+		expireTime := now.Add(time.Duration(conversation.AutoDeleteDuration) * time.Second)
+		newMessage.AutoDeleteExpireTime = &expireTime
+		newMessage.ExpireTime = &expireTime
+	}
+	if conversation.BurnAfterReading > 0 {
+		newMessage.BurnAfterReadingSeconds = conversation.BurnAfterReading
+	}
+	if req.ReplyTo != nil {
+		newMessage.ReplyTo = req.ReplyTo
+	}
+	if len(req.AtUsers) > 0 {
+		newMessage.AtUsers = req.AtUsers
+	}
 
-		message = newMessage
-		created = true
-		return nil
-	})
+	message, created, err := s.storeMessage(ctx, newMessage, localID)
+	if slotAcquired && !created {
+		// nothing was sent, the member may send again right away
+		s.releaseSlowModeSlot(ctx, req.SenderId, req.ConversationId)
+	}
 	if err != nil {
 		logger.Error("Failed to send message in transaction", zap.Error(err))
 		if errors.IsBusiness(err) {
@@ -248,6 +235,13 @@ func (s *messageServiceImpl) SendMessage(ctx context.Context, req *messagepb.Sen
 	}
 
 	if created {
+		// link referenced files before recipients are notified, so they can download them right away
+		if err := s.grantFileAccess(ctx, message); err != nil {
+			logger.Error("Failed to grant file access",
+				zap.String("messageID", message.MessageID),
+				zap.Error(err))
+		}
+
 		if err := s.publishNewMessageNotification(ctx, message, req.AtUsers); err != nil {
 			logger.Error("Failed to publish message notification", zap.Error(err))
 		}
@@ -266,6 +260,74 @@ func (s *messageServiceImpl) SendMessage(ctx context.Context, req *messagepb.Sen
 	}, nil
 }
 
+// storeMessage allocates the next sequence of the conversation and saves the message in one transaction.
+// With a local ID a repeated send returns the stored message and created is false
+func (s *messageServiceImpl) storeMessage(ctx context.Context, newMessage *model.Message, localID string) (*model.Message, bool, error) {
+	var message *model.Message
+	created := false
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		messageRepoTx := s.messageRepo.WithTx(tx)
+		sequenceRepoTx := s.sequenceRepo.WithTx(tx)
+		idempotencyRepoTx := s.sendIdempotencyRepo.WithTx(tx)
+
+		if localID != "" {
+			if err := idempotencyRepoTx.CreateIfNotExists(ctx, &model.MessageSendIdempotency{
+				SenderID:       newMessage.SenderID,
+				ConversationID: newMessage.ConversationID,
+				LocalID:        localID,
+			}); err != nil {
+				return err
+			}
+
+			idempotencyRecord, err := idempotencyRepoTx.GetForUpdateByKey(ctx, newMessage.SenderID, newMessage.ConversationID, localID)
+			if err != nil {
+				return err
+			}
+
+			// Idempotency hit: return existing message
+			if idempotencyRecord.MessageID != "" {
+				existing, err := messageRepoTx.GetByMessageID(ctx, idempotencyRecord.MessageID)
+				if err != nil {
+					return err
+				}
+				message = existing
+				return nil
+			}
+		}
+
+		// Create new message (in same transaction as sequence allocation)
+		sequence, err := sequenceRepoTx.IncrementAndGet(ctx, newMessage.ConversationID)
+		if err != nil {
+			logger.Error("Failed to increment sequence", zap.Error(err))
+			return errors.NewBusiness(errors.CodeSequenceGenerateFailed, "")
+		}
+		newMessage.Sequence = sequence
+
+		if err := messageRepoTx.Create(ctx, newMessage); err != nil {
+			logger.Error("Failed to create message", zap.Error(err))
+			return errors.NewBusiness(errors.CodeMessageSendFailed, "")
+		}
+
+		// gallery entries commit together with the message
+		if err := s.mediaRepo.WithTx(tx).CreateBatch(ctx, model.ExtractMedia(newMessage)); err != nil {
+			logger.Error("Failed to index message media", zap.Error(err))
+			return errors.NewBusiness(errors.CodeMessageSendFailed, "")
+		}
+
+		if localID != "" {
+			if err := idempotencyRepoTx.BindMessageID(ctx, newMessage.SenderID, newMessage.ConversationID, localID, newMessage.MessageID); err != nil {
+				return err
+			}
+		}
+
+		message = newMessage
+		created = true
+		return nil
+	})
+	return message, created, err
+}
+
 // SendTyping sends typing status
 func (s *messageServiceImpl) SendTyping(ctx context.Context, req *messagepb.SendTypingRequest) error {
 	if req.FromUserId == "" || req.ConversationId == "" {
@@ -275,7 +337,7 @@ func (s *messageServiceImpl) SendTyping(ctx context.Context, req *messagepb.Send
 		return errors.NewBusiness(errors.CodeInternalError, "typing repo is not initialized")
 	}
 
-	conversation, err := s.authorizeSend(ctx, req.FromUserId, req.ConversationId)
+	conversation, _, err := s.authorizeSend(ctx, req.FromUserId, req.ConversationId)
 	if err != nil {
 		return err
 	}
@@ -323,12 +385,14 @@ func (s *messageServiceImpl) SendTyping(ctx context.Context, req *messagepb.Send
 	return s.publishTypingNotification(conversation.TargetId, req, true, now.Add(ttl))
 }
 
-func (s *messageServiceImpl) authorizeSend(ctx context.Context, senderID, conversationID string) (*conversationpb.Conversation, error) {
+// authorizeSend checks that the sender may post to the conversation, the returned interval is the group slow mode
+// that applies to the sender, 0 when the sender is not restricted
+func (s *messageServiceImpl) authorizeSend(ctx context.Context, senderID, conversationID string) (*conversationpb.Conversation, time.Duration, error) {
 	if s.conversationClient == nil {
-		return nil, errors.NewBusiness(errors.CodeInternalError, "conversation client is not initialized")
+		return nil, 0, errors.NewBusiness(errors.CodeInternalError, "conversation client is not initialized")
 	}
 	if senderID == "" || conversationID == "" {
-		return nil, errors.NewBusiness(errors.CodeParamError, "sender_id and conversation_id are required")
+		return nil, 0, errors.NewBusiness(errors.CodeParamError, "sender_id and conversation_id are required")
 	}
 
 	conversation, err := s.conversationClient.GetConversation(ctx, &conversationpb.GetConversationRequest{
@@ -336,50 +400,55 @@ func (s *messageServiceImpl) authorizeSend(ctx context.Context, senderID, conver
 		ConversationId: conversationID,
 	})
 	if err != nil {
-		return nil, errors.NewBusiness(errors.CodeConversationNotFound, "conversation not found")
+		return nil, 0, errors.NewBusiness(errors.CodeConversationNotFound, "conversation not found")
 	}
 	if conversation.ConversationType != conversationpb.ConversationType_CONVERSATION_TYPE_SINGLE &&
 		conversation.ConversationType != conversationpb.ConversationType_CONVERSATION_TYPE_GROUP {
-		return nil, errors.NewBusiness(errors.CodeParamError, "conversation_type must be single or group")
+		return nil, 0, errors.NewBusiness(errors.CodeParamError, "conversation_type must be single or group")
 	}
 
 	targetID := conversation.TargetId
 	if targetID == "" {
-		return nil, errors.NewBusiness(errors.CodeParamError, "target_id is required")
+		return nil, 0, errors.NewBusiness(errors.CodeParamError, "target_id is required")
 	}
 
 	if conversation.ConversationType == conversationpb.ConversationType_CONVERSATION_TYPE_GROUP {
 		if s.groupClient == nil {
-			return nil, errors.NewBusiness(errors.CodeInternalError, "group client is not initialized")
+			return nil, 0, errors.NewBusiness(errors.CodeInternalError, "group client is not initialized")
 		}
 		memberResp, err := s.groupClient.IsMember(ctx, &grouppb.IsMemberRequest{
 			GroupId: targetID,
 			UserId:  senderID,
 		})
 		if err != nil {
-			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to verify group membership")
+			return nil, 0, errors.NewBusiness(errors.CodeInternalError, "failed to verify group membership")
 		}
 		if !memberResp.IsMember {
-			return nil, errors.NewBusiness(errors.CodeMessagePermissionDenied, "sender is not a group member")
+			return nil, 0, errors.NewBusiness(errors.CodeMessagePermissionDenied, "sender is not a group member")
+		}
+		slowMode, err := checkGroupSendRestrictions(memberResp, time.Now())
+		if err != nil {
+			return nil, 0, err
 		}
+		return conversation, slowMode, nil
 	}
 	if conversation.ConversationType == conversationpb.ConversationType_CONVERSATION_TYPE_SINGLE {
 		if s.friendClient == nil {
-			return nil, errors.NewBusiness(errors.CodeInternalError, "friend client is not initialized")
+			return nil, 0, errors.NewBusiness(errors.CodeInternalError, "friend client is not initialized")
 		}
 		blockedResp, err := s.friendClient.IsBlocked(ctx, &friendpb.IsBlockedRequest{
 			UserId:       senderID,
 			TargetUserId: targetID,
 		})
 		if err != nil {
-			return nil, errors.NewBusiness(errors.CodeInternalError, "failed to verify blacklist")
+			return nil, 0, errors.NewBusiness(errors.CodeInternalError, "failed to verify blacklist")
 		}
 		if blockedResp.IsBlocked {
-			return nil, errors.NewBusiness(errors.CodeUserBlocked, "user blocked")
+			return nil, 0, errors.NewBusiness(errors.CodeUserBlocked, "user blocked")
 		}
 	}
 
-	return conversation, nil
+	return conversation, 0, nil
 }
 
 // GetMessages retrieves message list
@@ -637,7 +706,14 @@ func (s *messageServiceImpl) RecallMessage(ctx context.Context, messageID, userI
 		return errors.NewBusiness(errors.CodeMessageRecallFailed, "")
 	}
 
-	// 5. If message is pinned in group, auto unpin (failure only logs, does not block recall)
+	// 5. Revoke access to files referenced by the message (failure only logs, does not block recall)
+	if err := s.revokeFileAccess(ctx, []string{messageID}, model.FileGrantRevokeRecalled); err != nil {
+		logger.Warn("Failed to revoke file access of recalled message",
+			zap.String("messageID", messageID),
+			zap.Error(err))
+	}
+
+	// 6. If message is pinned in group, auto unpin (failure only logs, does not block recall)
 	if err := s.autoUnpinRecalledGroupMessage(ctx, message); err != nil {
 		logger.Warn("Failed to auto-unpin recalled group message",
 			zap.String("messageID", message.MessageID),
@@ -645,7 +721,7 @@ func (s *messageServiceImpl) RecallMessage(ctx context.Context, messageID, userI
 			zap.Error(err))
 	}
 
-	// 6. Publish recall notification
+	// 7. Publish recall notification
 	if err := s.publishRecallNotification(ctx, message, userID); err != nil {
 		logger.Error("Failed to publish recall notification", zap.Error(err))
 	}
@@ -695,6 +771,13 @@ func (s *messageServiceImpl) DeleteMessage(ctx context.Context, messageID, userI
 		return errors.NewBusiness(errors.CodeMessageDeleteFailed, "")
 	}
 
+	// 4. Revoke access to files referenced by the message
+	if err := s.revokeFileAccess(ctx, []string{messageID}, model.FileGrantRevokeDeleted); err != nil {
+		logger.Warn("Failed to revoke file access of deleted message",
+			zap.String("messageID", messageID),
+			zap.Error(err))
+	}
+
 	return nil
 }
 
@@ -1241,6 +1324,7 @@ func (s *messageServiceImpl) modelToProto(msg *model.Message) *messagepb.Message
 		Content:          msg.Content,
 		Sequence:         msg.Sequence,
 		Status:           int32(msg.Status),
+		Silent:           msg.Silent,
 		CreatedAt:        timestamppb.New(msg.CreatedAt),
 		UpdatedAt:        timestamppb.New(msg.UpdatedAt),
 	}
@@ -1511,6 +1595,8 @@ func (s *messageServiceImpl) getContentPreview(content string, contentType model
 		return "[Location]"
 	case model.ContentTypeCard:
 		return "[Contact]"
+	case model.ContentTypeSystem:
+		return "[Notice]"
 	default:
 		return "[Message]"
 	}
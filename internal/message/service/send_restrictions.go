@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	grouppb "github.com/anychat/server/api/proto/group"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// permanentMuteAfter mutes ending later than this are permanent, Group Service stores them as year 9999
+var permanentMuteAfter = time.Date(9000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// checkGroupSendRestrictions rejects a send while the member or the whole group is muted and returns the slow mode
+// interval of the member. Owners and admins are not restricted
+func checkGroupSendRestrictions(member *grouppb.IsMemberResponse, now time.Time) (time.Duration, error) {
+	if member.Role == grouppb.GroupRole_GROUP_ROLE_OWNER || member.Role == grouppb.GroupRole_GROUP_ROLE_ADMIN {
+		return 0, nil
+	}
+
+	if member.MutedUntil != nil {
+		mutedUntil := time.Unix(member.GetMutedUntil(), 0)
+		if mutedUntil.After(now) {
+			if mutedUntil.After(permanentMuteAfter) {
+				return 0, errors.NewBusiness(errors.CodeMemberMuted, "You are muted in this group")
+			}
+			return 0, errors.NewBusiness(errors.CodeMemberMuted,
+				fmt.Sprintf("You are muted in this group until %s", mutedUntil.UTC().Format(time.RFC3339)))
+		}
+	}
+	if member.GroupMuted {
+		return 0, errors.NewBusiness(errors.CodeGroupMuted, "Only the owner and admins can send messages while the group is muted")
+	}
+
+	return time.Duration(member.SlowModeSeconds) * time.Second, nil
+}
+
+// acquireSlowModeSlot reserves the next send of the member in a slow mode group. A retry of a message that was
+// already sent passes without a slot, so clients resending after a timeout are not rejected
+func (s *messageServiceImpl) acquireSlowModeSlot(ctx context.Context, senderID, conversationID, localID string, interval time.Duration) (bool, error) {
+	if s.slowModeRepo == nil {
+		return false, nil
+	}
+
+	acquired, wait, err := s.slowModeRepo.AcquireSendSlot(ctx, conversationID, senderID, interval)
+	if err != nil {
+		// slow mode only throttles, a cache outage must not stop the group from chatting
+		logger.Warn("Failed to acquire slow mode slot, sending without it",
+			zap.String("conversationID", conversationID),
+			zap.String("senderID", senderID),
+			zap.Error(err))
+		return false, nil
+	}
+	if acquired {
+		return true, nil
+	}
+
+	if rec, err := s.sendIdempotencyRepo.GetByKey(ctx, senderID, conversationID, localID); err == nil && rec.MessageID != "" {
+		return false, nil
+	}
+
+	seconds := int64((wait + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	return false, errors.NewBusiness(errors.CodeGroupSlowMode,
+		fmt.Sprintf("Slow mode is on, wait %d seconds before sending again", seconds))
+}
+
+// releaseSlowModeSlot gives back a slot that was not used for a new message
+func (s *messageServiceImpl) releaseSlowModeSlot(ctx context.Context, senderID, conversationID string) {
+	if err := s.slowModeRepo.ReleaseSendSlot(ctx, conversationID, senderID); err != nil {
+		logger.Warn("Failed to release slow mode slot",
+			zap.String("conversationID", conversationID),
+			zap.String("senderID", senderID),
+			zap.Error(err))
+	}
+}
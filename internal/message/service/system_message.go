@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	messagepb "github.com/anychat/server/api/proto/message"
+	"github.com/anychat/server/internal/message/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"github.com/anychat/server/pkg/notification"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// systemEventKeys maps proto events to the event key stored in the message content
+var systemEventKeys = map[messagepb.SystemEvent]string{
+	messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_JOINED:         model.SystemEventGroupMemberJoined,
+	messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_LEFT:           model.SystemEventGroupMemberLeft,
+	messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MEMBER_REMOVED:        model.SystemEventGroupMemberRemoved,
+	messagepb.SystemEvent_SYSTEM_EVENT_GROUP_ROLE_CHANGED:          model.SystemEventGroupRoleChanged,
+	messagepb.SystemEvent_SYSTEM_EVENT_GROUP_RENAMED:               model.SystemEventGroupRenamed,
+	messagepb.SystemEvent_SYSTEM_EVENT_GROUP_MUTE_CHANGED:          model.SystemEventGroupMuteChanged,
+	messagepb.SystemEvent_SYSTEM_EVENT_GROUP_OWNERSHIP_TRANSFERRED: model.SystemEventGroupOwnershipTransferred,
+}
+
+// SendSystemMessage stores a structured system notice in the conversation and notifies the given recipients.
+// It is only reachable over gRPC from other services, clients cannot send system messages
+func (s *messageServiceImpl) SendSystemMessage(ctx context.Context, req *messagepb.SendSystemMessageRequest) (*messagepb.SendMessageResponse, error) {
+	if req.ConversationId == "" || req.TargetId == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "conversation_id and target_id are required")
+	}
+	conversationType := model.ConversationType(req.ConversationType)
+	if conversationType != model.ConversationTypeSingle && conversationType != model.ConversationTypeGroup {
+		return nil, errors.NewBusiness(errors.CodeParamError, "invalid conversation_type")
+	}
+	eventKey, ok := systemEventKeys[req.Event]
+	if !ok {
+		return nil, errors.NewBusiness(errors.CodeParamError, "invalid system event")
+	}
+	if req.DedupKey != "" && s.sendIdempotencyRepo == nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "idempotency repo is not initialized")
+	}
+
+	content, err := json.Marshal(&model.SystemContent{
+		Event:      eventKey,
+		OperatorID: req.OperatorId,
+		UserIDs:    req.UserIds,
+		Params:     req.Params,
+	})
+	if err != nil {
+		return nil, errors.NewBusiness(errors.CodeInternalError, "failed to encode system message")
+	}
+
+	now := time.Now()
+	newMessage := &model.Message{
+		MessageID:        uuid.New().String(),
+		ConversationID:   req.ConversationId,
+		ConversationType: conversationType,
+		TargetID:         req.TargetId,
+		SenderID:         model.SystemSenderID,
+		ContentType:      model.ContentTypeSystem,
+		Content:          string(content),
+		Silent:           req.Silent,
+		Status:           model.MessageStatusNormal,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	message, created, err := s.storeMessage(ctx, newMessage, req.DedupKey)
+	if err != nil {
+		logger.Error("Failed to store system message",
+			zap.String("conversationID", req.ConversationId),
+			zap.String("event", eventKey),
+			zap.Error(err))
+		if errors.IsBusiness(err) {
+			return nil, err
+		}
+		return nil, errors.NewBusiness(errors.CodeMessageSendFailed, "")
+	}
+
+	if created {
+		if err := s.publishSystemMessageNotification(message, req.RecipientIds); err != nil {
+			logger.Error("Failed to publish system message notification",
+				zap.String("messageID", message.MessageID),
+				zap.Error(err))
+		}
+	}
+
+	return &messagepb.SendMessageResponse{
+		MessageId: message.MessageID,
+		Sequence:  message.Sequence,
+		Timestamp: timestamppb.New(message.CreatedAt),
+	}, nil
+}
+
+// publishSystemMessageNotification delivers the notice to the recipients chosen by the calling service,
+// which may include users who are no longer members, e.g. a removed member
+func (s *messageServiceImpl) publishSystemMessageNotification(msg *model.Message, recipientIDs []string) error {
+	if len(recipientIDs) == 0 {
+		return nil
+	}
+
+	var system model.SystemContent
+	if err := json.Unmarshal([]byte(msg.Content), &system); err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"message_id":        msg.MessageID,
+		"conversation_id":   msg.ConversationID,
+		"conversation_type": msg.ConversationType,
+		"target_id":         msg.TargetID,
+		"from_user_id":      msg.SenderID,
+		"content_type":      msg.ContentType,
+		"content":           s.getContentPreview(msg.Content, msg.ContentType),
+		"system":            system,
+		"silent":            msg.Silent,
+		"sent_at":           msg.CreatedAt.Unix(),
+		"seq":               msg.Sequence,
+	}
+
+	notif := notification.NewNotification(
+		notification.TypeMessageNew,
+		msg.SenderID,
+		notification.PriorityNormal,
+	).WithPayload(payload)
+
+	seen := make(map[string]struct{}, len(recipientIDs))
+	userIDs := make([]string, 0, len(recipientIDs))
+	for _, userID := range recipientIDs {
+		if userID == "" {
+			continue
+		}
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+		seen[userID] = struct{}{}
+		userIDs = append(userIDs, userID)
+	}
+	return s.notificationPub.PublishToUsers(userIDs, notif)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	filepb "github.com/anychat/server/api/proto/file"
 	"github.com/anychat/server/internal/message/model"
 	"github.com/anychat/server/internal/message/repository"
 	"github.com/anychat/server/pkg/logger"
@@ -13,6 +14,7 @@ import (
 
 type AutoDeleteWorker struct {
 	messageRepo     repository.MessageRepository
+	fileClient      filepb.FileServiceClient
 	notificationPub notification.Publisher
 	batchSize       int
 	interval        time.Duration
@@ -21,12 +23,14 @@ type AutoDeleteWorker struct {
 
 func NewAutoDeleteWorker(
 	messageRepo repository.MessageRepository,
+	fileClient filepb.FileServiceClient,
 	notificationPub notification.Publisher,
 	batchSize int,
 	interval time.Duration,
 ) *AutoDeleteWorker {
 	return &AutoDeleteWorker{
 		messageRepo:     messageRepo,
+		fileClient:      fileClient,
 		notificationPub: notificationPub,
 		batchSize:       batchSize,
 		interval:        interval,
@@ -79,6 +83,7 @@ func (w *AutoDeleteWorker) cleanup() {
 		}
 
 		messageIDs := make([]string, 0, len(expiredMessages))
+		var fileMessageIDs []string
 		reasons := map[string][]string{
 			"auto_delete":        {},
 			"burn_after_reading": {},
@@ -86,6 +91,9 @@ func (w *AutoDeleteWorker) cleanup() {
 		}
 		for _, msg := range expiredMessages {
 			messageIDs = append(messageIDs, msg.MessageID)
+			if len(msg.FileIDs()) > 0 {
+				fileMessageIDs = append(fileMessageIDs, msg.MessageID)
+			}
 			reason := inferDeleteReason(msg)
 			reasons[reason] = append(reasons[reason], msg.MessageID)
 		}
@@ -97,6 +105,8 @@ func (w *AutoDeleteWorker) cleanup() {
 
 		logger.Info("Deleted expired messages", zap.Int("count", len(messageIDs)))
 
+		w.revokeFileAccess(ctx, fileMessageIDs)
+
 		for reason, ids := range reasons {
 			if len(ids) == 0 {
 				continue
@@ -125,6 +135,19 @@ func inferDeleteReason(msg *model.Message) string {
 	return "auto_delete"
 }
 
+func (w *AutoDeleteWorker) revokeFileAccess(ctx context.Context, messageIDs []string) {
+	if len(messageIDs) == 0 || w.fileClient == nil {
+		return
+	}
+	_, err := w.fileClient.RevokeMessageFileAccess(ctx, &filepb.RevokeMessageFileAccessRequest{
+		MessageIds: messageIDs,
+		Reason:     model.FileGrantRevokeAutoDeleted,
+	})
+	if err != nil {
+		logger.Warn("Failed to revoke file access of expired messages", zap.Int("count", len(messageIDs)), zap.Error(err))
+	}
+}
+
 func (w *AutoDeleteWorker) publishNotification(ctx context.Context, messageIDs []string, reason string) {
 	notif := notification.NewNotification(notification.TypeMessageAutoDeleted, "", notification.PriorityNormal).
 		AddPayloadField("message_ids", messageIDs).
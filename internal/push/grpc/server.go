@@ -2,9 +2,11 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	pushpb "github.com/anychat/server/api/proto/push"
 	"github.com/anychat/server/internal/push/model"
+	"github.com/anychat/server/internal/push/repository"
 	"github.com/anychat/server/internal/push/service"
 	"github.com/anychat/server/pkg/logger"
 	"go.uber.org/zap"
@@ -12,6 +14,13 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// defaultStatsRange time range of GetPushStats when start_time is not specified
+	defaultStatsRange = 7 * 24 * time.Hour
+	// maxStatsRange max time range of GetPushStats
+	maxStatsRange = 90 * 24 * time.Hour
+)
+
 // Server Push gRPC server
 type Server struct {
 	pushpb.UnimplementedPushServiceServer
@@ -51,3 +60,122 @@ func (s *Server) SendPush(ctx context.Context, req *pushpb.SendPushRequest) (*pu
 		MsgId:        msgID,
 	}, nil
 }
+
+// ListPushLogs queries push logs
+func (s *Server) ListPushLogs(ctx context.Context, req *pushpb.ListPushLogsRequest) (*pushpb.ListPushLogsResponse, error) {
+	filter := &repository.PushLogFilter{
+		UserID:    req.GetUserId(),
+		StartTime: unixOrZero(req.StartTime),
+		EndTime:   unixOrZero(req.EndTime),
+	}
+	if req.PushType != nil {
+		v := model.PushType(*req.PushType)
+		filter.PushType = &v
+	}
+	if req.Status != nil {
+		v := model.PushStatus(*req.Status)
+		filter.Status = &v
+	}
+
+	logs, total, err := s.pushService.ListPushLogs(ctx, filter, int(req.Page), int(req.PageSize))
+	if err != nil {
+		logger.Error("ListPushLogs gRPC failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbLogs := make([]*pushpb.PushLog, 0, len(logs))
+	for _, log := range logs {
+		pbLogs = append(pbLogs, toProtoPushLog(log))
+	}
+	return &pushpb.ListPushLogsResponse{
+		Logs:     pbLogs,
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// GetPushStats aggregates push results by day and type
+func (s *Server) GetPushStats(ctx context.Context, req *pushpb.GetPushStatsRequest) (*pushpb.GetPushStatsResponse, error) {
+	filter := &repository.PushLogFilter{
+		UserID:    req.GetUserId(),
+		StartTime: unixOrZero(req.StartTime),
+		EndTime:   unixOrZero(req.EndTime),
+	}
+	if req.PushType != nil {
+		v := model.PushType(*req.PushType)
+		filter.PushType = &v
+	}
+	// fill in the defaults first so the range limit also covers open-ended queries
+	if filter.EndTime.IsZero() {
+		filter.EndTime = time.Now()
+	}
+	if filter.StartTime.IsZero() {
+		filter.StartTime = filter.EndTime.Add(-defaultStatsRange)
+	}
+	if !filter.StartTime.Before(filter.EndTime) {
+		return nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+	if filter.EndTime.Sub(filter.StartTime) > maxStatsRange {
+		return nil, status.Error(codes.InvalidArgument, "time range exceeds 90 days")
+	}
+
+	daily, errorStats, err := s.pushService.GetPushStats(ctx, filter)
+	if err != nil {
+		logger.Error("GetPushStats gRPC failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pushpb.GetPushStatsResponse{
+		DailyStats: make([]*pushpb.PushDailyStat, 0, len(daily)),
+		ErrorStats: make([]*pushpb.PushErrorStat, 0, len(errorStats)),
+		StartTime:  filter.StartTime.Unix(),
+		EndTime:    filter.EndTime.Unix(),
+	}
+	for _, row := range daily {
+		resp.DailyStats = append(resp.DailyStats, &pushpb.PushDailyStat{
+			Date:         row.Date,
+			PushType:     pushpb.PushType(row.PushType),
+			PushCount:    row.PushCount,
+			SentCount:    row.SentCount,
+			FailedCount:  row.FailedCount,
+			SkippedCount: row.SkippedCount,
+			TargetCount:  row.TargetCount,
+			SuccessCount: row.SuccessCount,
+			FailureCount: row.FailureCount,
+		})
+	}
+	for _, row := range errorStats {
+		resp.ErrorStats = append(resp.ErrorStats, &pushpb.PushErrorStat{
+			ErrorCode: int32(row.ErrorCode),
+			ErrorMsg:  row.ErrorMsg,
+			Count:     row.Count,
+		})
+	}
+	return resp, nil
+}
+
+func toProtoPushLog(log *model.PushLog) *pushpb.PushLog {
+	return &pushpb.PushLog{
+		Id:           log.ID,
+		UserId:       log.UserID,
+		PushType:     pushpb.PushType(log.PushType),
+		Title:        log.Title,
+		Content:      log.Content,
+		TargetCount:  int32(log.TargetCount),
+		SuccessCount: int32(log.SuccessCount),
+		FailureCount: int32(log.FailureCount),
+		JpushMsgId:   log.JPushMsgID,
+		Status:       pushpb.PushStatus(log.Status),
+		ErrorCode:    int32(log.ErrorCode),
+		ErrorMsg:     log.ErrorMsg,
+		CreatedAt:    log.CreatedAt.Unix(),
+	}
+}
+
+func unixOrZero(ts *int64) time.Time {
+	if ts == nil || *ts <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(*ts, 0)
+}
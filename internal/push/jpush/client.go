@@ -12,6 +12,8 @@ import (
 
 const (
 	pushURL = "https://api.jpush.cn/v3/push"
+	// withdrawURL withdraws a previously sent push, followed by msg_id
+	withdrawURL = "https://api.jpush.cn/v3/push/"
 )
 
 // Client JPush client
@@ -20,16 +22,19 @@ type Client struct {
 	masterSecret string
 	httpClient   *http.Client
 	auth         string // base64(appKey:masterSecret)
+	// apnsProduction sends iOS pushes to production APNs instead of the sandbox
+	apnsProduction bool
 }
 
 // NewClient creates JPush client
-func NewClient(appKey, masterSecret string) *Client {
+func NewClient(appKey, masterSecret string, apnsProduction bool) *Client {
 	auth := base64.StdEncoding.EncodeToString([]byte(appKey + ":" + masterSecret))
 	return &Client{
-		appKey:       appKey,
-		masterSecret: masterSecret,
-		auth:         auth,
-		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		appKey:         appKey,
+		masterSecret:   masterSecret,
+		auth:           auth,
+		httpClient:     &http.Client{Timeout: 10 * time.Second},
+		apnsProduction: apnsProduction,
 	}
 }
 
@@ -46,6 +51,7 @@ type pushRequest struct {
 	Platform     interface{}   `json:"platform"`
 	Audience     audience      `json:"audience"`
 	Notification *notification `json:"notification,omitempty"`
+	Message      *message      `json:"message,omitempty"`
 	Options      options       `json:"options"`
 }
 
@@ -56,6 +62,16 @@ type audience struct {
 type notification struct {
 	IOS     *iosNotification     `json:"ios,omitempty"`
 	Android *androidNotification `json:"android,omitempty"`
+	// VoIP iOS PushKit payload, delivered to the app even when it is not running
+	VoIP map[string]string `json:"voip,omitempty"`
+}
+
+// message custom (data) message, delivered to the app without a system banner
+type message struct {
+	MsgContent  string            `json:"msg_content"`
+	Title       string            `json:"title,omitempty"`
+	ContentType string            `json:"content_type,omitempty"`
+	Extras      map[string]string `json:"extras,omitempty"`
 }
 
 type iosNotification struct {
@@ -79,6 +95,8 @@ type androidNotification struct {
 type options struct {
 	TimeToLive     int  `json:"time_to_live"`
 	ApnsProduction bool `json:"apns_production"`
+	// Classification 0-operational / 1-system message, system messages bypass vendor quota on Android
+	Classification int `json:"classification,omitempty"`
 }
 
 type pushResponse struct {
@@ -92,6 +110,17 @@ type jpushErrorBody struct {
 	Message string `json:"message"`
 }
 
+// APIError error returned by JPush REST API
+type APIError struct {
+	StatusCode int
+	Code       int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("jpush: error %d: %s", e.Code, e.Message)
+}
+
 // PushToRegistrationIDs pushes notification to specified Registration ID list
 // regIDs: JPush device registration ID (generated by JPush SDK)
 func (c *Client) PushToRegistrationIDs(regIDs []string, title, content string, extras map[string]string) (*PushResult, error) {
@@ -120,7 +149,7 @@ func (c *Client) PushToRegistrationIDs(regIDs []string, title, content string, e
 		},
 		Options: options{
 			TimeToLive:     86400, // discard if not received within 1 day
-			ApnsProduction: false, // controlled by config file, dev environment by default
+			ApnsProduction: c.apnsProduction,
 		},
 	}
 
@@ -132,6 +161,68 @@ func (c *Client) PushToRegistrationIDs(regIDs []string, title, content string, e
 	return result, nil
 }
 
+// PushCallToRegistrationIDs pushes an incoming-call event to specified Registration ID list.
+// iOS devices receive a VoIP (PushKit) payload, Android devices receive a high-priority
+// data message; both carry the call metadata in extras. ttl is the ring timeout in seconds,
+// the push is discarded once the call can no longer be answered.
+func (c *Client) PushCallToRegistrationIDs(regIDs []string, title, content string, extras map[string]string, ttl int) (*PushResult, error) {
+	if len(regIDs) == 0 {
+		return &PushResult{}, nil
+	}
+
+	req := pushRequest{
+		Platform: []string{"ios", "android"},
+		Audience: audience{RegistrationID: regIDs},
+		Notification: &notification{
+			VoIP: extras,
+		},
+		Message: &message{
+			MsgContent:  content,
+			Title:       title,
+			ContentType: "call",
+			Extras:      extras,
+		},
+		Options: options{
+			TimeToLive:     ttl,
+			ApnsProduction: c.apnsProduction,
+			Classification: 1,
+		},
+	}
+
+	result, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	result.SuccessCount = len(regIDs)
+	return result, nil
+}
+
+// WithdrawPush withdraws a push that has not yet been delivered or displayed.
+// Only pushes sent within the last day can be withdrawn.
+func (c *Client) WithdrawPush(msgID string) error {
+	if msgID == "" {
+		return nil
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, withdrawURL+msgID, nil)
+	if err != nil {
+		return fmt.Errorf("jpush: create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Basic "+c.auth)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("jpush: http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("jpush: unexpected status %d: %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
+
 func (c *Client) doRequest(payload interface{}) (*PushResult, error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
@@ -156,17 +247,19 @@ func (c *Client) doRequest(payload interface{}) (*PushResult, error) {
 		return nil, fmt.Errorf("jpush: read response: %w", err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("jpush: unexpected status %d: %s", resp.StatusCode, string(respBody))
-	}
-
 	var pushResp pushResponse
 	if err := json.Unmarshal(respBody, &pushResp); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("jpush: unexpected status %d: %s", resp.StatusCode, string(respBody))
+		}
 		return nil, fmt.Errorf("jpush: unmarshal response: %w", err)
 	}
 
 	if pushResp.Error != nil {
-		return nil, fmt.Errorf("jpush: error %d: %s", pushResp.Error.Code, pushResp.Error.Message)
+		return nil, &APIError{StatusCode: resp.StatusCode, Code: pushResp.Error.Code, Message: pushResp.Error.Message}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jpush: unexpected status %d: %s", resp.StatusCode, string(respBody))
 	}
 
 	return &PushResult{
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// CallPushStatus represents delivery state of an incoming-call push.
+type CallPushStatus int16
+
+const (
+	CallPushStatusUnspecified CallPushStatus = 0
+	CallPushStatusDelivered   CallPushStatus = 1
+	CallPushStatusFailed      CallPushStatus = 2
+	CallPushStatusCancelled   CallPushStatus = 3
+)
+
+// CallPushRecord tracks the incoming-call push sent for a call session,
+// so it can be withdrawn once the call leaves the ringing state.
+type CallPushRecord struct {
+	ID          int64          `gorm:"primaryKey;autoIncrement"`
+	CallID      string         `gorm:"column:call_id;uniqueIndex;not null"`
+	CallerID    string         `gorm:"column:caller_id;not null"`
+	CalleeID    string         `gorm:"column:callee_id;not null;index"`
+	TargetCount int            `gorm:"column:target_count;not null;default:0"`
+	JPushMsgID  string         `gorm:"column:jpush_msg_id"`
+	Status      CallPushStatus `gorm:"column:status;type:smallint;not null;default:0"` // 1-delivered/2-failed/3-cancelled
+	CancelledAt *time.Time     `gorm:"column:cancelled_at"`
+	CreatedAt   time.Time      `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (CallPushRecord) TableName() string {
+	return "call_push_records"
+}
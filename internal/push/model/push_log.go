@@ -12,6 +12,7 @@ const (
 	PushTypeFriendRequest  PushType = 3
 	PushTypeGroupInvited   PushType = 4
 	PushTypeCallInvite     PushType = 5
+	PushTypeCallEnded      PushType = 6
 )
 
 // PushStatus represents push delivery status.
@@ -22,6 +23,7 @@ const (
 	PushStatusPending     PushStatus = 1
 	PushStatusSent        PushStatus = 2
 	PushStatusFailed      PushStatus = 3
+	PushStatusSkipped     PushStatus = 4 // user has no registered push device
 )
 
 // PushLog push log model
@@ -35,7 +37,8 @@ type PushLog struct {
 	SuccessCount int        `gorm:"column:success_count;not null;default:0"`
 	FailureCount int        `gorm:"column:failure_count;not null;default:0"`
 	JPushMsgID   string     `gorm:"column:jpush_msg_id"`
-	Status       PushStatus `gorm:"column:status;type:smallint;not null;default:1"` // 1-pending/2-sent/3-failed
+	Status       PushStatus `gorm:"column:status;type:smallint;not null;default:1"` // 1-pending/2-sent/3-failed/4-skipped
+	ErrorCode    int        `gorm:"column:error_code;not null;default:0"`           // JPush error code, 0 if none
 	ErrorMsg     string     `gorm:"column:error_msg"`
 	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime"`
 }
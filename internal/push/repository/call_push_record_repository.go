@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/anychat/server/internal/push/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CallPushRecordRepository incoming-call push record repository interface
+type CallPushRecordRepository interface {
+	Upsert(record *model.CallPushRecord) error
+	GetByCallID(callID string) (*model.CallPushRecord, error)
+	MarkCancelled(callID string, cancelledAt time.Time) error
+}
+
+type callPushRecordRepository struct {
+	db *gorm.DB
+}
+
+// NewCallPushRecordRepository creates incoming-call push record repository
+func NewCallPushRecordRepository(db *gorm.DB) CallPushRecordRepository {
+	return &callPushRecordRepository{db: db}
+}
+
+// Upsert creates the record, or overwrites it when the invite push is resent for the same call
+func (r *callPushRecordRepository) Upsert(record *model.CallPushRecord) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "call_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"target_count", "jpush_msg_id", "status", "updated_at"}),
+	}).Create(record).Error
+}
+
+// GetByCallID retrieves push record of specified call
+func (r *callPushRecordRepository) GetByCallID(callID string) (*model.CallPushRecord, error) {
+	var record model.CallPushRecord
+	if err := r.db.Where("call_id = ?", callID).First(&record).Error; err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// MarkCancelled marks the invite push of specified call as cancelled
+func (r *callPushRecordRepository) MarkCancelled(callID string, cancelledAt time.Time) error {
+	return r.db.Model(&model.CallPushRecord{}).
+		Where("call_id = ?", callID).
+		Updates(map[string]interface{}{
+			"status":       model.CallPushStatusCancelled,
+			"cancelled_at": cancelledAt,
+		}).Error
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/anychat/server/internal/push/model"
 	usermodel "github.com/anychat/server/internal/user/model"
 	"gorm.io/gorm"
@@ -14,9 +16,42 @@ type PushTokenRow struct {
 	Platform usermodel.PushPlatform // 1-ios / 2-android
 }
 
+// PushLogFilter push log query conditions, zero values are ignored
+type PushLogFilter struct {
+	UserID    string
+	PushType  *model.PushType
+	Status    *model.PushStatus
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// PushDailyStatRow push results of one type aggregated by day
+type PushDailyStatRow struct {
+	Date         string
+	PushType     model.PushType
+	PushCount    int64
+	SentCount    int64
+	FailedCount  int64
+	SkippedCount int64
+	TargetCount  int64
+	SuccessCount int64
+	FailureCount int64
+}
+
+// PushErrorStatRow failed pushes grouped by provider error code
+type PushErrorStatRow struct {
+	ErrorCode int
+	ErrorMsg  string
+	Count     int64
+}
+
 // PushLogRepository push log repository interface
 type PushLogRepository interface {
 	Create(log *model.PushLog) error
+	List(filter *PushLogFilter, page, pageSize int) ([]*model.PushLog, int64, error)
+	GetDailyStats(filter *PushLogFilter) ([]*PushDailyStatRow, error)
+	GetErrorStats(filter *PushLogFilter, limit int) ([]*PushErrorStatRow, error)
+	DeleteBefore(before time.Time, limit int) (int64, error)
 	GetTokensByUserID(userID string) ([]*PushTokenRow, error)
 	GetTokensByUserIDs(userIDs []string) (map[string][]*PushTokenRow, error)
 }
@@ -35,6 +70,87 @@ func (r *pushLogRepository) Create(log *model.PushLog) error {
 	return r.db.Create(log).Error
 }
 
+// List queries push logs by filter, newest first
+func (r *pushLogRepository) List(filter *PushLogFilter, page, pageSize int) ([]*model.PushLog, int64, error) {
+	var logs []*model.PushLog
+	var total int64
+
+	query := r.applyFilter(r.db.Model(&model.PushLog{}), filter)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	if err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
+
+// GetDailyStats aggregates push results by day and push type
+func (r *pushLogRepository) GetDailyStats(filter *PushLogFilter) ([]*PushDailyStatRow, error) {
+	var rows []*PushDailyStatRow
+	err := r.applyFilter(r.db.Model(&model.PushLog{}), filter).
+		Select(`TO_CHAR(DATE_TRUNC('day', created_at), 'YYYY-MM-DD') AS date,
+			push_type,
+			COUNT(*) AS push_count,
+			COUNT(*) FILTER (WHERE status = ?) AS sent_count,
+			COUNT(*) FILTER (WHERE status = ?) AS failed_count,
+			COUNT(*) FILTER (WHERE status = ?) AS skipped_count,
+			COALESCE(SUM(target_count), 0) AS target_count,
+			COALESCE(SUM(success_count), 0) AS success_count,
+			COALESCE(SUM(failure_count), 0) AS failure_count`,
+			model.PushStatusSent, model.PushStatusFailed, model.PushStatusSkipped).
+		Group("date, push_type").
+		Order("date DESC, push_type ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// GetErrorStats groups failed pushes by provider error code, most frequent first
+func (r *pushLogRepository) GetErrorStats(filter *PushLogFilter, limit int) ([]*PushErrorStatRow, error) {
+	var rows []*PushErrorStatRow
+	err := r.applyFilter(r.db.Model(&model.PushLog{}), filter).
+		Select("error_code, MAX(error_msg) AS error_msg, COUNT(*) AS count").
+		Where("status = ?", model.PushStatusFailed).
+		Group("error_code").
+		Order("count DESC").
+		Limit(limit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// DeleteBefore deletes at most limit push logs created before the given time
+func (r *pushLogRepository) DeleteBefore(before time.Time, limit int) (int64, error) {
+	result := r.db.Exec(
+		`DELETE FROM push_logs
+		  WHERE id IN (SELECT id FROM push_logs WHERE created_at < ? LIMIT ?)`, before, limit,
+	)
+	return result.RowsAffected, result.Error
+}
+
+func (r *pushLogRepository) applyFilter(query *gorm.DB, filter *PushLogFilter) *gorm.DB {
+	if filter == nil {
+		return query
+	}
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.PushType != nil {
+		query = query.Where("push_type = ?", *filter.PushType)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("created_at < ?", filter.EndTime)
+	}
+	return query
+}
+
 // GetTokensByUserID retrieves all push tokens for specified user
 func (r *pushLogRepository) GetTokensByUserID(userID string) ([]*PushTokenRow, error) {
 	var rows []*PushTokenRow
@@ -3,8 +3,11 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/anychat/server/internal/push/jpush"
 	"github.com/anychat/server/internal/push/model"
@@ -13,6 +16,25 @@ import (
 	"github.com/anychat/server/pkg/notification"
 	"github.com/nats-io/nats.go"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultCallPushTTL used when the invite does not carry ring_timeout (seconds)
+	defaultCallPushTTL = 60
+	// callEndedPushTTL lifetime of the "call ended" push (seconds)
+	callEndedPushTTL = 60
+	// errorStatsLimit max number of provider error groups returned
+	errorStatsLimit = 20
+)
+
+// errNoPushToken recorded when the target user has no registered push device
+var errNoPushToken = errors.New("no push token registered")
+
+// call push event, carried in extras as call_event
+const (
+	callEventInvite = "invite"
+	callEventEnded  = "ended"
 )
 
 // pushTypeTitle default titles for each push type
@@ -22,34 +44,51 @@ var pushTypeTitle = map[model.PushType]string{
 	model.PushTypeFriendRequest:  "Friend Request",
 	model.PushTypeGroupInvited:   "Group Invitation",
 	model.PushTypeCallInvite:     "Incoming Call",
+	model.PushTypeCallEnded:      "Call Ended",
 }
 
 var notificationPushTypeMap = map[string]model.PushType{
-	notification.TypeMessageNew:        model.PushTypeMessageNew,
-	notification.TypeMessageMentioned:  model.PushTypeMessageMention,
-	notification.TypeFriendRequest:     model.PushTypeFriendRequest,
-	notification.TypeGroupInvited:      model.PushTypeGroupInvited,
-	notification.TypeLiveKitCallInvite: model.PushTypeCallInvite,
+	notification.TypeMessageNew:       model.PushTypeMessageNew,
+	notification.TypeMessageMentioned: model.PushTypeMessageMention,
+	notification.TypeFriendRequest:    model.PushTypeFriendRequest,
+	notification.TypeGroupInvited:     model.PushTypeGroupInvited,
 }
 
 // PushService push service interface
 type PushService interface {
 	// SendPush sends push to specified user list
 	SendPush(ctx context.Context, userIDs []string, title, content string, pushType model.PushType, extras map[string]string) (successCount, failureCount int, msgID string, err error)
+	// SendCallInvitePush sends high-priority incoming-call push to callee and reports ringing to caller
+	SendCallInvitePush(ctx context.Context, callID, callerID, calleeID string, extras map[string]string) error
+	// SendCallEndedPush withdraws the incoming-call push and tells callee devices to stop ringing
+	SendCallEndedPush(ctx context.Context, callID, calleeID string, extras map[string]string) error
 	// HandleNotification handles NATS notification events
 	HandleNotification(msg *nats.Msg)
+	// ListPushLogs queries push logs by filter
+	ListPushLogs(ctx context.Context, filter *repository.PushLogFilter, page, pageSize int) ([]*model.PushLog, int64, error)
+	// GetPushStats aggregates push results by day and type, with provider error breakdown
+	GetPushStats(ctx context.Context, filter *repository.PushLogFilter) ([]*repository.PushDailyStatRow, []*repository.PushErrorStatRow, error)
 }
 
 type pushServiceImpl struct {
-	jpushClient *jpush.Client
-	repo        repository.PushLogRepository
+	jpushClient     *jpush.Client
+	repo            repository.PushLogRepository
+	callRecordRepo  repository.CallPushRecordRepository
+	notificationPub notification.Publisher
 }
 
 // NewPushService creates push service
-func NewPushService(jpushClient *jpush.Client, repo repository.PushLogRepository) PushService {
+func NewPushService(
+	jpushClient *jpush.Client,
+	repo repository.PushLogRepository,
+	callRecordRepo repository.CallPushRecordRepository,
+	notificationPub notification.Publisher,
+) PushService {
 	return &pushServiceImpl{
-		jpushClient: jpushClient,
-		repo:        repo,
+		jpushClient:     jpushClient,
+		repo:            repo,
+		callRecordRepo:  callRecordRepo,
+		notificationPub: notificationPub,
 	}
 }
 
@@ -86,6 +125,7 @@ func (s *pushServiceImpl) SendPush(
 		// All users have no push tokens (not registered JPush or no device)
 		logger.Info("PushService: no push tokens found, skip push",
 			zap.Strings("userIDs", userIDs))
+		s.logPush(userIDs[0], pushType, title, content, 0, 0, 0, "", model.PushStatusSkipped, errNoPushToken)
 		return 0, 0, "", nil
 	}
 
@@ -94,12 +134,12 @@ func (s *pushServiceImpl) SendPush(
 	if pushErr != nil {
 		logger.Error("PushService: JPush request failed", zap.Error(pushErr))
 		failureCount = len(regIDs)
-		s.logPush(userIDs[0], pushType, title, content, len(regIDs), 0, failureCount, "", model.PushStatusFailed, pushErr.Error())
+		s.logPush(userIDs[0], pushType, title, content, len(regIDs), 0, failureCount, "", model.PushStatusFailed, pushErr)
 		return 0, failureCount, "", pushErr
 	}
 
 	successCount = len(regIDs)
-	s.logPush(userIDs[0], pushType, title, content, len(regIDs), successCount, 0, result.MsgID, model.PushStatusSent, "")
+	s.logPush(userIDs[0], pushType, title, content, len(regIDs), successCount, 0, result.MsgID, model.PushStatusSent, nil)
 
 	logger.Info("PushService: push sent",
 		zap.Strings("userIDs", userIDs),
@@ -110,6 +150,129 @@ func (s *pushServiceImpl) SendPush(
 	return successCount, 0, result.MsgID, nil
 }
 
+// SendCallInvitePush sends incoming-call push to all devices of callee.
+// Once the push is accepted by JPush, the caller is notified so its UI can switch to "ringing".
+func (s *pushServiceImpl) SendCallInvitePush(ctx context.Context, callID, callerID, calleeID string, extras map[string]string) error {
+	extras = withCallExtras(extras, callID, callEventInvite)
+	ttl := defaultCallPushTTL
+	if v, err := strconv.Atoi(extras["ring_timeout"]); err == nil && v > 0 {
+		ttl = v
+	}
+
+	content := "Incoming voice call"
+	if extras["call_type"] == "1" {
+		content = "Incoming video call"
+	}
+
+	msgID, targetCount, err := s.sendCallPush(calleeID, model.PushTypeCallInvite, pushTypeTitle[model.PushTypeCallInvite], content, extras, ttl)
+	if targetCount == 0 {
+		// callee has no push-capable device, nothing to track
+		return err
+	}
+
+	record := &model.CallPushRecord{
+		CallID:      callID,
+		CallerID:    callerID,
+		CalleeID:    calleeID,
+		TargetCount: targetCount,
+		JPushMsgID:  msgID,
+		Status:      model.CallPushStatusDelivered,
+	}
+	if err != nil {
+		record.Status = model.CallPushStatusFailed
+	}
+	if recErr := s.callRecordRepo.Upsert(record); recErr != nil {
+		logger.Warn("PushService: failed to save call push record",
+			zap.String("callID", callID), zap.Error(recErr))
+	}
+	if err != nil {
+		return err
+	}
+
+	notif := notification.NewNotification(notification.TypeLiveKitCallRinging, calleeID, notification.PriorityHigh).
+		AddPayloadField("call_id", callID).
+		AddPayloadField("callee_id", calleeID)
+	if pubErr := s.notificationPub.PublishToUser(callerID, notif); pubErr != nil {
+		logger.Warn("PushService: failed to notify caller ringing",
+			zap.String("callID", callID), zap.Error(pubErr))
+	}
+	return nil
+}
+
+// SendCallEndedPush cancels the incoming-call push of a call that is no longer ringing.
+// The original push is withdrawn if it has not been displayed yet, and a "call ended" push
+// makes devices that already show the incoming call UI dismiss it.
+func (s *pushServiceImpl) SendCallEndedPush(ctx context.Context, callID, calleeID string, extras map[string]string) error {
+	record, err := s.callRecordRepo.GetByCallID(callID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if record.Status != model.CallPushStatusDelivered {
+		return nil
+	}
+
+	if err := s.jpushClient.WithdrawPush(record.JPushMsgID); err != nil {
+		logger.Warn("PushService: failed to withdraw call push",
+			zap.String("callID", callID), zap.String("msgID", record.JPushMsgID), zap.Error(err))
+	}
+
+	extras = withCallExtras(extras, callID, callEventEnded)
+	if _, _, err := s.sendCallPush(calleeID, model.PushTypeCallEnded, pushTypeTitle[model.PushTypeCallEnded], "", extras, callEndedPushTTL); err != nil {
+		return err
+	}
+
+	if err := s.callRecordRepo.MarkCancelled(callID, time.Now()); err != nil {
+		logger.Warn("PushService: failed to mark call push cancelled",
+			zap.String("callID", callID), zap.Error(err))
+	}
+	return nil
+}
+
+// sendCallPush sends call push to all devices of user, returns JPush msg_id and target device count
+func (s *pushServiceImpl) sendCallPush(userID string, pushType model.PushType, title, content string,
+	extras map[string]string, ttl int,
+) (string, int, error) {
+	rows, err := s.repo.GetTokensByUserID(userID)
+	if err != nil {
+		logger.Error("PushService: failed to get push tokens", zap.Error(err))
+		return "", 0, err
+	}
+
+	var regIDs []string
+	for _, row := range rows {
+		if row.Token != "" {
+			regIDs = append(regIDs, row.Token)
+		}
+	}
+	if len(regIDs) == 0 {
+		logger.Info("PushService: no push tokens found, skip call push",
+			zap.String("userID", userID))
+		s.logPush(userID, pushType, title, content, 0, 0, 0, "", model.PushStatusSkipped, errNoPushToken)
+		return "", 0, nil
+	}
+
+	result, pushErr := s.jpushClient.PushCallToRegistrationIDs(regIDs, title, content, extras, ttl)
+	if pushErr != nil {
+		logger.Error("PushService: JPush call push failed", zap.Error(pushErr))
+		s.logPush(userID, pushType, title, content, len(regIDs), 0, len(regIDs), "", model.PushStatusFailed, pushErr)
+		return "", len(regIDs), pushErr
+	}
+
+	s.logPush(userID, pushType, title, content, len(regIDs), len(regIDs), 0, result.MsgID, model.PushStatusSent, nil)
+
+	logger.Info("PushService: call push sent",
+		zap.String("userID", userID),
+		zap.String("callID", extras["call_id"]),
+		zap.String("callEvent", extras["call_event"]),
+		zap.Int("regIDCount", len(regIDs)),
+		zap.String("msgID", result.MsgID))
+
+	return result.MsgID, len(regIDs), nil
+}
+
 // HandleNotification handles NATS notification events, decides whether to push
 func (s *pushServiceImpl) HandleNotification(msg *nats.Msg) {
 	var notif notification.Notification
@@ -118,6 +281,17 @@ func (s *pushServiceImpl) HandleNotification(msg *nats.Msg) {
 		return
 	}
 
+	switch notif.Type {
+	case notification.TypeLiveKitCallInvite, notification.TypeLiveKitCallEnded:
+		s.handleCallNotification(notif)
+		return
+	}
+
+	// silent system notices only show up in the conversation
+	if silent, _ := notif.Payload["silent"].(bool); silent {
+		return
+	}
+
 	pushType, title, ok := s.buildPushContent(notif)
 	if !ok {
 		return
@@ -137,6 +311,56 @@ func (s *pushServiceImpl) HandleNotification(msg *nats.Msg) {
 	)
 }
 
+// ListPushLogs queries push logs by filter, newest first
+func (s *pushServiceImpl) ListPushLogs(ctx context.Context, filter *repository.PushLogFilter, page, pageSize int) ([]*model.PushLog, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	return s.repo.List(filter, page, pageSize)
+}
+
+// GetPushStats aggregates push results by day and type within the filter time range
+func (s *pushServiceImpl) GetPushStats(ctx context.Context, filter *repository.PushLogFilter) ([]*repository.PushDailyStatRow, []*repository.PushErrorStatRow, error) {
+	daily, err := s.repo.GetDailyStats(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	errorStats, err := s.repo.GetErrorStats(filter, errorStatsLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return daily, errorStats, nil
+}
+
+// handleCallNotification routes call notifications to the dedicated call push path
+func (s *pushServiceImpl) handleCallNotification(notif notification.Notification) {
+	if notif.ToUserID == "" {
+		return
+	}
+	extras := s.extractExtras(notif)
+	callID := extras["call_id"]
+	if callID == "" {
+		logger.Warn("PushService: call notification without call_id", zap.String("type", notif.Type))
+		return
+	}
+
+	var err error
+	if notif.Type == notification.TypeLiveKitCallInvite {
+		err = s.SendCallInvitePush(context.Background(), callID, extras["caller_id"], notif.ToUserID, extras)
+	} else {
+		err = s.SendCallEndedPush(context.Background(), callID, notif.ToUserID, extras)
+	}
+	if err != nil {
+		logger.Warn("PushService: call push failed",
+			zap.String("type", notif.Type),
+			zap.String("callID", callID),
+			zap.Error(err))
+	}
+}
+
 // buildPushContent maps notification type to push type and title.
 func (s *pushServiceImpl) buildPushContent(notif notification.Notification) (model.PushType, string, bool) {
 	pushType, ok := notificationPushTypeMap[notif.Type]
@@ -205,11 +429,32 @@ func (s *pushServiceImpl) extractExtras(notif notification.Notification) map[str
 	return extras
 }
 
+// withCallExtras copies extras and sets call metadata fields
+func withCallExtras(extras map[string]string, callID, event string) map[string]string {
+	result := make(map[string]string, len(extras)+2)
+	for k, v := range extras {
+		result[k] = v
+	}
+	result["call_id"] = callID
+	result["call_event"] = event
+	return result
+}
+
 // logPush asynchronously writes push log
 func (s *pushServiceImpl) logPush(userID string, pushType model.PushType, title, content string,
 	targetCount, successCount, failureCount int,
-	jpushMsgID string, status model.PushStatus, errMsg string,
+	jpushMsgID string, status model.PushStatus, pushErr error,
 ) {
+	var errCode int
+	var errMsg string
+	if pushErr != nil {
+		errMsg = pushErr.Error()
+		var apiErr *jpush.APIError
+		if errors.As(pushErr, &apiErr) {
+			errCode = apiErr.Code
+		}
+	}
+
 	log := &model.PushLog{
 		UserID:       userID,
 		PushType:     pushType,
@@ -220,6 +465,7 @@ func (s *pushServiceImpl) logPush(userID string, pushType model.PushType, title,
 		FailureCount: failureCount,
 		JPushMsgID:   jpushMsgID,
 		Status:       status,
+		ErrorCode:    errCode,
 		ErrorMsg:     errMsg,
 	}
 	if err := s.repo.Create(log); err != nil {
@@ -0,0 +1,88 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/anychat/server/internal/push/repository"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// LogRetentionWorker periodically deletes push logs older than the retention period
+type LogRetentionWorker struct {
+	repo      repository.PushLogRepository
+	retention time.Duration
+	batchSize int
+	interval  time.Duration
+	stopCh    chan struct{}
+}
+
+func NewLogRetentionWorker(
+	repo repository.PushLogRepository,
+	retention time.Duration,
+	batchSize int,
+	interval time.Duration,
+) *LogRetentionWorker {
+	return &LogRetentionWorker{
+		repo:      repo,
+		retention: retention,
+		batchSize: batchSize,
+		interval:  interval,
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (w *LogRetentionWorker) Start() {
+	logger.Info("LogRetentionWorker starting",
+		zap.Duration("retention", w.retention),
+		zap.Int("batchSize", w.batchSize),
+		zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			logger.Info("LogRetentionWorker stopped")
+			return
+		case <-ticker.C:
+			w.cleanup()
+		}
+	}
+}
+
+func (w *LogRetentionWorker) Stop() {
+	close(w.stopCh)
+}
+
+func (w *LogRetentionWorker) cleanup() {
+	before := time.Now().Add(-w.retention)
+	var total int64
+
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		default:
+		}
+
+		deleted, err := w.repo.DeleteBefore(before, w.batchSize)
+		if err != nil {
+			logger.Error("Failed to delete expired push logs", zap.Error(err))
+			break
+		}
+		total += deleted
+		if deleted < int64(w.batchSize) {
+			break
+		}
+	}
+
+	if total > 0 {
+		logger.Info("Deleted expired push logs", zap.Int64("count", total), zap.Time("before", before))
+	}
+}
+
+func (w *LogRetentionWorker) StartAsync() {
+	go w.Start()
+}
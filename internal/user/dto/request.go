@@ -47,6 +47,7 @@ type ChangePhoneRequest struct {
 	NewPhoneNumber string  `json:"new_phone_number" binding:"required"`
 	NewVerifyCode  string  `json:"new_verify_code" binding:"required"`
 	OldVerifyCode  *string `json:"old_verify_code"`
+	MFACode        string  `json:"mfa_code"`
 	DeviceID       string  `json:"-"`
 }
 
@@ -62,6 +63,7 @@ type ChangeEmailRequest struct {
 	NewEmail      string  `json:"new_email" binding:"required"`
 	NewVerifyCode string  `json:"new_verify_code" binding:"required"`
 	OldVerifyCode *string `json:"old_verify_code"`
+	MFACode       string  `json:"mfa_code"`
 	DeviceID      string  `json:"-"`
 }
 
@@ -112,6 +112,37 @@ func (s *UserServer) UpdateProfile(ctx context.Context, req *userpb.UpdateProfil
 	return pbResp, nil
 }
 
+// SetAvatar sets the avatar from an uploaded image
+func (s *UserServer) SetAvatar(ctx context.Context, req *userpb.SetAvatarRequest) (*userpb.UserProfileResponse, error) {
+	resp, err := s.userService.SetAvatar(ctx, req.UserId, req.FileId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	pbResp := &userpb.UserProfileResponse{
+		UserId:    resp.UserID,
+		Nickname:  resp.Nickname,
+		Avatar:    resp.Avatar,
+		Signature: resp.Signature,
+		Gender:    int32(resp.Gender),
+		Region:    resp.Region,
+		QrcodeUrl: resp.QRCodeURL,
+		CreatedAt: timestamppb.New(resp.CreatedAt),
+	}
+
+	if resp.Birthday != nil {
+		pbResp.Birthday = timestamppb.New(*resp.Birthday)
+	}
+	if resp.Phone != nil {
+		pbResp.Phone = resp.Phone
+	}
+	if resp.Email != nil {
+		pbResp.Email = resp.Email
+	}
+
+	return pbResp, nil
+}
+
 // GetUserInfo retrieves user info
 func (s *UserServer) GetUserInfo(ctx context.Context, req *userpb.GetUserInfoRequest) (*userpb.UserInfoResponse, error) {
 	resp, err := s.userService.GetUserInfo(ctx, req.UserId, req.TargetUserId)
@@ -294,6 +325,7 @@ func (s *UserServer) ChangePhone(ctx context.Context, req *userpb.ChangePhoneReq
 		NewPhoneNumber: req.NewPhoneNumber,
 		NewVerifyCode:  req.NewVerifyCode,
 		DeviceID:       req.DeviceId,
+		MFACode:        req.GetMfaCode(),
 	}
 	if req.OldVerifyCode != nil {
 		dtoReq.OldVerifyCode = req.OldVerifyCode
@@ -333,6 +365,7 @@ func (s *UserServer) ChangeEmail(ctx context.Context, req *userpb.ChangeEmailReq
 		NewEmail:      req.NewEmail,
 		NewVerifyCode: req.NewVerifyCode,
 		DeviceID:      req.DeviceId,
+		MFACode:       req.GetMfaCode(),
 	}
 	if req.OldVerifyCode != nil {
 		dtoReq.OldVerifyCode = req.OldVerifyCode
@@ -359,6 +392,15 @@ func (s *UserServer) InitUserData(ctx context.Context, req *userpb.InitUserDataR
 	return &commonpb.Empty{}, nil
 }
 
+// EraseUserData anonymizes user data of a deleted account (called by auth-service)
+func (s *UserServer) EraseUserData(ctx context.Context, req *commonpb.EraseUserDataRequest) (*commonpb.EraseUserDataResponse, error) {
+	erased, err := s.userService.EraseUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &commonpb.EraseUserDataResponse{Erased: erased}, nil
+}
+
 // convertError converts business error to gRPC error
 func convertError(err error) error {
 	if bizErr, ok := err.(*errors.Business); ok {
@@ -391,6 +433,10 @@ func convertError(err error) error {
 			return status.Error(codes.ResourceExhausted, bizErr.Message)
 		case errors.CodeQRCodeExpired, errors.CodeQRCodeInvalid:
 			return status.Error(codes.InvalidArgument, bizErr.Message)
+		case errors.CodeMFARequired:
+			return status.Error(codes.PermissionDenied, bizErr.Message)
+		case errors.CodeMFACodeError:
+			return status.Error(codes.InvalidArgument, bizErr.Message)
 		default:
 			return status.Error(codes.Internal, bizErr.Message)
 		}
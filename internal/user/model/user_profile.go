@@ -16,6 +16,7 @@ type UserProfile struct {
 	Region          string     `gorm:"column:region" json:"region"`
 	QRCodeURL       string     `gorm:"column:qrcode_url" json:"qrcodeUrl"`
 	QRCodeUpdatedAt *time.Time `gorm:"column:qrcode_updated_at" json:"qrcodeUpdatedAt"`
+	ErasedAt        *time.Time `gorm:"column:erased_at" json:"erasedAt"` // set when the account was deleted and its profile anonymized
 	CreatedAt       time.Time  `gorm:"column:created_at" json:"createdAt"`
 	UpdatedAt       time.Time  `gorm:"column:updated_at" json:"updatedAt"`
 }
@@ -25,6 +26,9 @@ func (UserProfile) TableName() string {
 	return "user_profiles"
 }
 
+// DeletedUserNickname nickname shown for deleted accounts
+const DeletedUserNickname = "Deleted User"
+
 // Gender gender constants
 const (
 	GenderUnknown = 0 // unknown
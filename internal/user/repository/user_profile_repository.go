@@ -15,6 +15,7 @@ type UserProfileRepository interface {
 	UpdateQRCode(ctx context.Context, userID, qrcodeURL string) error
 	CheckNicknameExists(ctx context.Context, nickname string, excludeUserID string) (bool, error)
 	SearchByKeyword(ctx context.Context, keyword string, limit, offset int) ([]*model.UserProfile, int64, error)
+	Anonymize(ctx context.Context, userID, nickname string) (int64, error)
 }
 
 // userProfileRepositoryImpl user profile repository implementation
@@ -80,9 +81,9 @@ func (r *userProfileRepositoryImpl) SearchByKeyword(ctx context.Context, keyword
 	var profiles []*model.UserProfile
 	var total int64
 
-	// Search nickname
+	// Search nickname, erased accounts are not searchable
 	query := r.db.WithContext(ctx).Model(&model.UserProfile{}).
-		Where("nickname LIKE ?", "%"+keyword+"%")
+		Where("nickname LIKE ? AND erased_at IS NULL", "%"+keyword+"%")
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -96,3 +97,22 @@ func (r *userProfileRepositoryImpl) SearchByKeyword(ctx context.Context, keyword
 
 	return profiles, total, nil
 }
+
+// Anonymize clears personal fields of a deleted account, the row stays so the account still resolves in chats
+func (r *userProfileRepositoryImpl) Anonymize(ctx context.Context, userID, nickname string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.UserProfile{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"nickname":          nickname,
+			"avatar":            "",
+			"signature":         "",
+			"gender":            model.GenderUnknown,
+			"birthday":          nil,
+			"region":            "",
+			"qrcode_url":        "",
+			"qrcode_updated_at": nil,
+			"erased_at":         gorm.Expr("NOW()"),
+		})
+	return result.RowsAffected, result.Error
+}
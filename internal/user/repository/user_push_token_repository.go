@@ -13,6 +13,7 @@ type UserPushTokenRepository interface {
 	GetByUserID(ctx context.Context, userID string) ([]*model.UserPushToken, error)
 	GetByUserIDAndDeviceID(ctx context.Context, userID, deviceID string) (*model.UserPushToken, error)
 	Delete(ctx context.Context, userID, deviceID string) error
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
 }
 
 // userPushTokenRepositoryImpl push token repository implementation
@@ -63,3 +64,11 @@ func (r *userPushTokenRepositoryImpl) Delete(ctx context.Context, userID, device
 		Where("user_id = ? AND device_id = ?", userID, deviceID).
 		Delete(&model.UserPushToken{}).Error
 }
+
+// DeleteByUserID deletes push tokens of all devices of the user
+func (r *userPushTokenRepositoryImpl) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.UserPushToken{})
+	return result.RowsAffected, result.Error
+}
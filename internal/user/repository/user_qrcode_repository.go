@@ -14,6 +14,7 @@ type UserQRCodeRepository interface {
 	GetByToken(ctx context.Context, token string) (*model.UserQRCode, error)
 	GetLatestByUserID(ctx context.Context, userID string) (*model.UserQRCode, error)
 	DeleteExpired(ctx context.Context) error
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
 }
 
 // userQRCodeRepositoryImpl user QR code repository implementation
@@ -62,3 +63,11 @@ func (r *userQRCodeRepositoryImpl) DeleteExpired(ctx context.Context) error {
 		Where("expires_at < ?", time.Now()).
 		Delete(&model.UserQRCode{}).Error
 }
+
+// DeleteByUserID deletes all QR codes of the user
+func (r *userQRCodeRepositoryImpl) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.UserQRCode{})
+	return result.RowsAffected, result.Error
+}
@@ -12,6 +12,7 @@ type UserSettingsRepository interface {
 	Create(ctx context.Context, settings *model.UserSettings) error
 	GetByUserID(ctx context.Context, userID string) (*model.UserSettings, error)
 	Update(ctx context.Context, settings *model.UserSettings) error
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
 }
 
 // userSettingsRepositoryImpl user settings repository implementation
@@ -43,3 +44,11 @@ func (r *userSettingsRepositoryImpl) GetByUserID(ctx context.Context, userID str
 func (r *userSettingsRepositoryImpl) Update(ctx context.Context, settings *model.UserSettings) error {
 	return r.db.WithContext(ctx).Save(settings).Error
 }
+
+// DeleteByUserID deletes user settings
+func (r *userSettingsRepositoryImpl) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.UserSettings{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"github.com/anychat/server/internal/user/model"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// EraseUserData anonymizes the profile of a deleted account and removes its settings, QR codes and push tokens.
+// The profile row is kept so that old messages still resolve to "Deleted User"; calling it again is harmless
+func (s *userServiceImpl) EraseUserData(ctx context.Context, userID string) (map[string]int64, error) {
+	if userID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "user_id is required")
+	}
+
+	erased := make(map[string]int64, 4)
+	count, err := s.profileRepo.Anonymize(ctx, userID, model.DeletedUserNickname)
+	if err != nil {
+		logger.Error("Failed to anonymize user profile", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+	erased["profiles"] = count
+
+	if count, err = s.settingsRepo.DeleteByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+	erased["settings"] = count
+
+	if count, err = s.qrcodeRepo.DeleteByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+	erased["qrcodes"] = count
+
+	if count, err = s.pushTokenRepo.DeleteByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+	erased["push_tokens"] = count
+
+	return erased, nil
+}
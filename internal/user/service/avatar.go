@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	filepb "github.com/anychat/server/api/proto/file"
+	"github.com/anychat/server/internal/user/dto"
+	"github.com/anychat/server/pkg/errors"
+	"github.com/anychat/server/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// SetAvatar turns an image the user uploaded into the avatar and binds its URL to the profile.
+// The avatar objects have stable names, so a failed bind leaves the old URL pointing at the new images
+// until the next attempt, never at missing ones
+func (s *userServiceImpl) SetAvatar(ctx context.Context, userID, fileID string) (*dto.UserProfileResponse, error) {
+	if fileID == "" {
+		return nil, errors.NewBusiness(errors.CodeParamError, "file_id is required")
+	}
+	profile, err := s.profileRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewBusiness(errors.CodeUserProfileNotFound, "")
+		}
+		return nil, err
+	}
+
+	avatar, err := s.fileClient.SetAvatar(ctx, &filepb.SetAvatarRequest{
+		UserId:    userID,
+		FileId:    fileID,
+		OwnerType: filepb.StorageOwnerType_STORAGE_OWNER_TYPE_USER,
+		OwnerId:   userID,
+	})
+	if err != nil {
+		return nil, fileError(err, "failed to set avatar")
+	}
+
+	profile.Avatar = avatar.Url
+	if err := s.profileRepo.Update(ctx, profile); err != nil {
+		return nil, err
+	}
+	return s.GetProfile(ctx, userID)
+}
+
+// removeAvatar deletes the avatar images after the profile no longer points at them
+func (s *userServiceImpl) removeAvatar(ctx context.Context, userID string) {
+	_, err := s.fileClient.RemoveAvatar(ctx, &filepb.RemoveAvatarRequest{
+		OwnerType: filepb.StorageOwnerType_STORAGE_OWNER_TYPE_USER,
+		OwnerId:   userID,
+	})
+	if err != nil {
+		// the images stay reachable by URL, a later SetAvatar overwrites them
+		logger.Warn("Failed to remove avatar images", zap.String("userId", userID), zap.Error(err))
+	}
+}
+
+// fileError keeps the reason of a rejected file-service request, other failures become internal errors
+func fileError(err error, msg string) error {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied:
+		return errors.NewBusiness(errors.CodeParamError, st.Message())
+	}
+	logger.Error("File service call failed", zap.String("operation", msg), zap.Error(err))
+	return errors.NewBusiness(errors.CodeInternalError, msg)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	filepb "github.com/anychat/server/api/proto/file"
 	friendpb "github.com/anychat/server/api/proto/friend"
 	authdto "github.com/anychat/server/internal/auth/dto"
 	authmodel "github.com/anychat/server/internal/auth/model"
@@ -24,6 +25,7 @@ type UserService interface {
 	// User profile
 	GetProfile(ctx context.Context, userID string) (*dto.UserProfileResponse, error)
 	UpdateProfile(ctx context.Context, userID string, req *dto.UpdateProfileRequest) (*dto.UserProfileResponse, error)
+	SetAvatar(ctx context.Context, userID, fileID string) (*dto.UserProfileResponse, error)
 	GetUserInfo(ctx context.Context, userID, targetUserID string) (*dto.UserInfoResponse, error)
 	SearchUsers(ctx context.Context, req *dto.SearchUsersRequest) (*dto.SearchUsersResponse, error)
 
@@ -46,6 +48,9 @@ type UserService interface {
 
 	// Initialize user data
 	InitUserData(ctx context.Context, userID, nickname string) error
+
+	// Erase data of a deleted account (called by auth-service)
+	EraseUserData(ctx context.Context, userID string) (map[string]int64, error)
 }
 
 // userServiceImpl user service implementation
@@ -55,9 +60,11 @@ type userServiceImpl struct {
 	qrcodeRepo    repository.UserQRCodeRepository
 	pushTokenRepo repository.UserPushTokenRepository
 	friendClient  friendpb.FriendServiceClient
+	fileClient    filepb.FileServiceClient
 	authUserRepo  authrepo.UserRepository
 	sessionRepo   authrepo.UserSessionRepository
 	verifySvc     authservice.VerificationService
+	mfaSvc        authservice.MFAService
 }
 
 // NewUserService creates user service
@@ -67,9 +74,11 @@ func NewUserService(
 	qrcodeRepo repository.UserQRCodeRepository,
 	pushTokenRepo repository.UserPushTokenRepository,
 	friendClient friendpb.FriendServiceClient,
+	fileClient filepb.FileServiceClient,
 	authUserRepo authrepo.UserRepository,
 	sessionRepo authrepo.UserSessionRepository,
 	verifySvc authservice.VerificationService,
+	mfaSvc authservice.MFAService,
 ) UserService {
 	return &userServiceImpl{
 		profileRepo:   profileRepo,
@@ -77,9 +86,11 @@ func NewUserService(
 		qrcodeRepo:    qrcodeRepo,
 		pushTokenRepo: pushTokenRepo,
 		friendClient:  friendClient,
+		fileClient:    fileClient,
 		authUserRepo:  authUserRepo,
 		sessionRepo:   sessionRepo,
 		verifySvc:     verifySvc,
+		mfaSvc:        mfaSvc,
 	}
 }
 
@@ -189,9 +200,14 @@ func (s *userServiceImpl) UpdateProfile(ctx context.Context, userID string, req
 		profile.Nickname = *req.Nickname
 	}
 
-	// Update avatar
-	if req.Avatar != nil {
-		profile.Avatar = *req.Avatar
+	// Update avatar: it can only be cleared here, new images are processed by SetAvatar
+	removeAvatar := false
+	if req.Avatar != nil && *req.Avatar != profile.Avatar {
+		if *req.Avatar != "" {
+			return nil, errors.NewBusiness(errors.CodeParamError, "avatar must be uploaded through the avatar endpoint")
+		}
+		profile.Avatar = ""
+		removeAvatar = true
 	}
 
 	// Update signature
@@ -221,6 +237,9 @@ func (s *userServiceImpl) UpdateProfile(ctx context.Context, userID string, req
 	if err := s.profileRepo.Update(ctx, profile); err != nil {
 		return nil, err
 	}
+	if removeAvatar {
+		s.removeAvatar(ctx, userID)
+	}
 
 	return &dto.UserProfileResponse{
 		UserID:    profile.UserID,
@@ -521,6 +540,9 @@ func (s *userServiceImpl) ChangePhone(ctx context.Context, userID string, req *d
 	if req.NewPhoneNumber == req.OldPhoneNumber {
 		return nil, errors.NewBusiness(errors.CodeParamError, "new phone number cannot be the same as old phone number")
 	}
+	if err := s.requireMFA(ctx, userID, req.MFACode); err != nil {
+		return nil, err
+	}
 
 	if req.OldVerifyCode != nil && *req.OldVerifyCode != "" {
 		if err := s.verifyCode(ctx, req.OldPhoneNumber, authmodel.TargetTypeSMS, authmodel.PurposeChangePhone, *req.OldVerifyCode); err != nil {
@@ -599,6 +621,9 @@ func (s *userServiceImpl) ChangeEmail(ctx context.Context, userID string, req *d
 	if req.NewEmail == req.OldEmail {
 		return nil, errors.NewBusiness(errors.CodeParamError, "new email cannot be the same as old email")
 	}
+	if err := s.requireMFA(ctx, userID, req.MFACode); err != nil {
+		return nil, err
+	}
 
 	if req.OldVerifyCode != nil && *req.OldVerifyCode != "" {
 		if err := s.verifyCode(ctx, req.OldEmail, authmodel.TargetTypeEmail, authmodel.PurposeChangeEmail, *req.OldVerifyCode); err != nil {
@@ -661,6 +686,14 @@ func (s *userServiceImpl) ensureEmailAvailable(ctx context.Context, email, exclu
 	return nil
 }
 
+// requireMFA checks the second factor for sensitive account changes when the user has MFA enabled
+func (s *userServiceImpl) requireMFA(ctx context.Context, userID, code string) error {
+	if s.mfaSvc == nil {
+		return nil
+	}
+	return s.mfaSvc.RequireIfEnabled(ctx, userID, code)
+}
+
 func (s *userServiceImpl) verifyCode(ctx context.Context, target string, targetType authmodel.VerificationTargetType, purpose authmodel.VerificationPurpose, code string) error {
 	if s.verifySvc == nil {
 		return errors.NewBusiness(errors.CodeInternalError, "verification module not initialized")
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS call_push_records;
\ No newline at end of file
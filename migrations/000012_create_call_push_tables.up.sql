@@ -0,0 +1,15 @@
+-- Incoming-call push records (one per call session)
+CREATE TABLE IF NOT EXISTS call_push_records (
+    id            BIGSERIAL    PRIMARY KEY,
+    call_id       VARCHAR(36)  NOT NULL UNIQUE,
+    caller_id     VARCHAR(36)  NOT NULL,
+    callee_id     VARCHAR(36)  NOT NULL,
+    target_count  INT          NOT NULL DEFAULT 0,
+    jpush_msg_id  VARCHAR(100),
+    status        SMALLINT     NOT NULL DEFAULT 0,  -- 1-delivered/2-failed/3-cancelled
+    cancelled_at  TIMESTAMP,
+    created_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_call_push_records_callee_id ON call_push_records (callee_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_push_logs_type_created_at;
+ALTER TABLE push_logs DROP COLUMN IF EXISTS error_code;
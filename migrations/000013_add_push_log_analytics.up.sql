@@ -0,0 +1,7 @@
+-- Provider error code for push failure breakdown
+ALTER TABLE push_logs ADD COLUMN IF NOT EXISTS error_code INT NOT NULL DEFAULT 0;
+
+-- Status 4: skipped, user has no registered push device
+COMMENT ON COLUMN push_logs.status IS '1-pending/2-sent/3-failed/4-skipped';
+
+CREATE INDEX IF NOT EXISTS idx_push_logs_type_created_at ON push_logs (push_type, created_at DESC);
@@ -0,0 +1,2 @@
+-- Drop QR login audit events table
+DROP TABLE IF EXISTS qr_login_events;
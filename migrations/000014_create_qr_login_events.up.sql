@@ -0,0 +1,14 @@
+-- QR login audit events table
+CREATE TABLE IF NOT EXISTS qr_login_events (
+    id              BIGSERIAL    PRIMARY KEY,
+    ticket          VARCHAR(64)  NOT NULL,
+    event           VARCHAR(32)  NOT NULL,  -- created, scanned, confirmed, cancelled, expired, logged_in
+    user_id         VARCHAR(36),            -- empty before the ticket is scanned
+    device_id       VARCHAR(128) NOT NULL,  -- device performing the action
+    device_type     SMALLINT     NOT NULL,  -- 1-ios,2-android,3-web,4-pc,5-h5
+    ip_address      VARCHAR(64),
+    created_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_qr_login_events_ticket ON qr_login_events (ticket);
+CREATE INDEX idx_qr_login_events_user_id ON qr_login_events (user_id, created_at);
@@ -0,0 +1,21 @@
+-- User TOTP two-factor authentication table
+CREATE TABLE IF NOT EXISTS user_mfa (
+    user_id          VARCHAR(36)  PRIMARY KEY,
+    secret_encrypted VARCHAR(255) NOT NULL,           -- AES-GCM encrypted base32 TOTP secret
+    enabled          BOOLEAN      NOT NULL DEFAULT false,  -- false until the first code is confirmed
+    enabled_at       TIMESTAMP,
+    last_used_step   BIGINT       NOT NULL DEFAULT 0, -- last accepted TOTP time step
+    created_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One-time recovery codes table
+CREATE TABLE IF NOT EXISTS user_mfa_recovery_codes (
+    id          BIGSERIAL    PRIMARY KEY,
+    user_id     VARCHAR(36)  NOT NULL,
+    code_hash   VARCHAR(128) NOT NULL,  -- HMAC-SHA256 of the normalized code
+    used_at     TIMESTAMP,
+    created_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_user_mfa_recovery_codes_user_id ON user_mfa_recovery_codes (user_id);
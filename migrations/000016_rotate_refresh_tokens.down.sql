@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS refresh_tokens;
+
+DROP INDEX IF EXISTS idx_user_sessions_family_id;
+DROP INDEX IF EXISTS idx_user_sessions_access_token_hash;
+DROP INDEX IF EXISTS idx_user_sessions_refresh_token_hash;
+
+ALTER TABLE user_sessions DROP COLUMN IF EXISTS family_id;
+
+-- Hashed tokens cannot be restored, clients must log in again
+DELETE FROM user_sessions;
+
+ALTER TABLE user_sessions ALTER COLUMN access_token_hash TYPE TEXT;
+ALTER TABLE user_sessions ALTER COLUMN refresh_token_hash TYPE TEXT;
+ALTER TABLE user_sessions RENAME COLUMN access_token_hash TO access_token;
+ALTER TABLE user_sessions RENAME COLUMN refresh_token_hash TO refresh_token;
+
+CREATE INDEX idx_user_sessions_access_token ON user_sessions(access_token);
+CREATE INDEX idx_user_sessions_refresh_token ON user_sessions(refresh_token);
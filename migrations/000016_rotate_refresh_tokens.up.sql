@@ -0,0 +1,43 @@
+-- Store session tokens as SHA-256 hashes instead of plaintext
+DROP INDEX IF EXISTS idx_user_sessions_access_token;
+DROP INDEX IF EXISTS idx_user_sessions_refresh_token;
+
+ALTER TABLE user_sessions RENAME COLUMN access_token TO access_token_hash;
+ALTER TABLE user_sessions RENAME COLUMN refresh_token TO refresh_token_hash;
+
+UPDATE user_sessions SET
+    access_token_hash  = encode(sha256(convert_to(access_token_hash, 'UTF8')), 'hex'),
+    refresh_token_hash = encode(sha256(convert_to(refresh_token_hash, 'UTF8')), 'hex');
+
+ALTER TABLE user_sessions ALTER COLUMN access_token_hash TYPE VARCHAR(64);
+ALTER TABLE user_sessions ALTER COLUMN refresh_token_hash TYPE VARCHAR(64);
+
+-- Every session owns one refresh token family, existing sessions start a family of their own
+ALTER TABLE user_sessions ADD COLUMN family_id VARCHAR(36);
+UPDATE user_sessions SET family_id = gen_random_uuid()::text;
+ALTER TABLE user_sessions ALTER COLUMN family_id SET NOT NULL;
+
+CREATE INDEX idx_user_sessions_access_token_hash ON user_sessions(access_token_hash);
+CREATE INDEX idx_user_sessions_refresh_token_hash ON user_sessions(refresh_token_hash);
+CREATE UNIQUE INDEX idx_user_sessions_family_id ON user_sessions(family_id);
+
+-- Refresh token history, used to detect reuse of rotated tokens. Rows are removed with the owning session
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id          BIGSERIAL    PRIMARY KEY,
+    family_id   VARCHAR(36)  NOT NULL,
+    user_id     VARCHAR(36)  NOT NULL,
+    device_id   VARCHAR(100) NOT NULL,
+    token_hash  VARCHAR(64)  NOT NULL,
+    expires_at  TIMESTAMP    NOT NULL,
+    rotated_at  TIMESTAMP,              -- exchanged for a new token, presenting it again means reuse
+    created_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_refresh_tokens_token_hash ON refresh_tokens(token_hash);
+CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens(family_id);
+CREATE INDEX idx_refresh_tokens_user_id ON refresh_tokens(user_id);
+
+-- Carry current session refresh tokens over so existing logins keep working
+INSERT INTO refresh_tokens (family_id, user_id, device_id, token_hash, expires_at)
+SELECT family_id, user_id, device_id, refresh_token_hash, refresh_token_expires_at
+FROM user_sessions;
@@ -0,0 +1,2 @@
+-- Drop JWT signing keys table
+DROP TABLE IF EXISTS jwt_signing_keys;
@@ -0,0 +1,14 @@
+-- JWT signing keys, public halves are published at /.well-known/jwks.json
+CREATE TABLE IF NOT EXISTS jwt_signing_keys (
+    kid                   VARCHAR(36)  PRIMARY KEY,
+    algorithm             VARCHAR(16)  NOT NULL,           -- RS256 / EdDSA
+    private_key_encrypted TEXT         NOT NULL,           -- AES-GCM encrypted PKCS#8 PEM
+    public_key            TEXT         NOT NULL,           -- PKIX PEM
+    status                VARCHAR(16)  NOT NULL,           -- next: published only, active: signing, retired: verifying only
+    activated_at          TIMESTAMP,
+    retired_at            TIMESTAMP,
+    expires_at            TIMESTAMP,                       -- retired key is removed once every token it signed has expired
+    created_at            TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_jwt_signing_keys_status ON jwt_signing_keys (status);
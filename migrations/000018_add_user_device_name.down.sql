@@ -0,0 +1,2 @@
+ALTER TABLE user_devices DROP COLUMN IF EXISTS last_active_at;
+ALTER TABLE user_devices DROP COLUMN IF EXISTS name;
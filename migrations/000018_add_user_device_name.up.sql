@@ -0,0 +1,5 @@
+-- User-facing device management: custom device name and last activity (login or token refresh)
+ALTER TABLE user_devices ADD COLUMN name VARCHAR(64);
+ALTER TABLE user_devices ADD COLUMN last_active_at TIMESTAMP;
+
+UPDATE user_devices SET last_active_at = last_login_at;
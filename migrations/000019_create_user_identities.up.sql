@@ -0,0 +1,16 @@
+-- External OIDC identities linked to AnyChat users, one user can link several providers
+CREATE TABLE IF NOT EXISTS user_identities (
+    id             BIGSERIAL    PRIMARY KEY,
+    user_id        VARCHAR(36)  NOT NULL,
+    provider       VARCHAR(32)  NOT NULL,  -- provider name from auth.oidc.providers
+    subject        VARCHAR(255) NOT NULL,  -- sub claim, stable per provider
+    email          VARCHAR(100),           -- email claim at link time, informational only
+    display_name   VARCHAR(100),
+    last_login_at  TIMESTAMP,
+    created_at     TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at     TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_user_identities_provider_subject UNIQUE (provider, subject),
+    CONSTRAINT uk_user_identities_user_provider UNIQUE (user_id, provider)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities (user_id);
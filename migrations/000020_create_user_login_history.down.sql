@@ -0,0 +1,4 @@
+-- Drop login history and the password reset flag
+ALTER TABLE users DROP COLUMN IF EXISTS password_reset_required;
+
+DROP TABLE IF EXISTS user_login_history;
@@ -0,0 +1,25 @@
+-- Login history: successful sign-ins and rejected password attempts, used for unusual login detection
+CREATE TABLE IF NOT EXISTS user_login_history (
+    id            BIGSERIAL    PRIMARY KEY,
+    user_id       VARCHAR(36)  NOT NULL,
+    device_id     VARCHAR(100),
+    device_type   SMALLINT,
+    login_method  VARCHAR(16)  NOT NULL,           -- password, code, qr, oidc
+    ip_address    VARCHAR(64),
+    ip_prefix     VARCHAR(64),                     -- /24 for IPv4, /48 for IPv6, identifies a known network
+    country       VARCHAR(64),                     -- from the offline GeoIP database, empty when not configured
+    region        VARCHAR(64),
+    city          VARCHAR(64),
+    user_agent    VARCHAR(512),
+    result        SMALLINT     NOT NULL,           -- 1-success 2-failed 3-blocked
+    risk_score    SMALLINT     NOT NULL DEFAULT 0,
+    risk_reasons  VARCHAR(128),                    -- comma separated: new_device, new_network, new_country
+    unusual       BOOLEAN      NOT NULL DEFAULT FALSE,
+    reported_at   TIMESTAMP,                       -- user reported "this wasn't me"
+    created_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_user_login_history_user_created ON user_login_history (user_id, created_at DESC);
+
+-- Set when the user reports an unusual login, password login is refused until the password is reset
+ALTER TABLE users ADD COLUMN password_reset_required BOOLEAN NOT NULL DEFAULT FALSE;
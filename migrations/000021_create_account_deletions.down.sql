@@ -0,0 +1,7 @@
+-- Drop account deletion tables and the erased profile flag
+DELETE FROM verification_templates WHERE purpose = 8;
+
+ALTER TABLE user_profiles DROP COLUMN IF EXISTS erased_at;
+
+DROP TABLE IF EXISTS account_deletion_logs;
+DROP TABLE IF EXISTS account_deletions;
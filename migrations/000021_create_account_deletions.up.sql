@@ -0,0 +1,40 @@
+-- Account deletion requests: the account is erased across services once the grace period ends
+CREATE TABLE IF NOT EXISTS account_deletions (
+    id            BIGSERIAL    PRIMARY KEY,
+    user_id       VARCHAR(36)  NOT NULL,
+    status        SMALLINT     NOT NULL DEFAULT 1, -- 1-pending 2-erasing 3-completed 4-cancelled
+    reason        VARCHAR(256),
+    requested_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    erase_after   TIMESTAMP    NOT NULL,           -- end of the grace period
+    cancelled_at  TIMESTAMP,
+    completed_at  TIMESTAMP,
+    attempts      INT          NOT NULL DEFAULT 0, -- failed erasure runs
+    last_error    VARCHAR(512),
+    created_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- At most one open request per user
+CREATE UNIQUE INDEX uk_account_deletions_open_user ON account_deletions (user_id) WHERE status IN (1, 2);
+CREATE INDEX idx_account_deletions_user ON account_deletions (user_id, id DESC);
+CREATE INDEX idx_account_deletions_due ON account_deletions (status, erase_after);
+
+-- Audit trail of a deletion, step_completed rows also record which services are already erased
+CREATE TABLE IF NOT EXISTS account_deletion_logs (
+    id           BIGSERIAL    PRIMARY KEY,
+    deletion_id  BIGINT       NOT NULL,
+    user_id      VARCHAR(36)  NOT NULL,
+    action       VARCHAR(32)  NOT NULL,            -- requested, cancelled, step_completed, step_failed, completed
+    service      VARCHAR(32),                      -- erasure step: user, friend, group, conversation, message, file, auth
+    detail       TEXT,                             -- JSON: erased row counts, error or request details
+    created_at   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_account_deletion_logs_deletion ON account_deletion_logs (deletion_id, id);
+
+-- Set when the profile of a deleted account is anonymized, erased profiles are not searchable
+ALTER TABLE user_profiles ADD COLUMN erased_at TIMESTAMP;
+
+INSERT INTO verification_templates (purpose, name, sms_template_id, sms_content, email_subject, email_content) VALUES
+(8, 'Delete Account', 'SMS_123463', '【AnyChat】You are deleting your account, verification code is {code}, valid for 5 minutes. Ignore this message if it was not you.', 'AnyChat Delete Account', '<!DOCTYPE html><html><head><meta charset="utf-8"></head><body><div style="max-width: 600px; margin: 0 auto; padding: 20px;"><h2 style="color: #333;">AnyChat Delete Account</h2><p>Hello,</p><p>You are deleting your account, verification code is: <strong style="font-size: 24px; color: #1890ff;">{code}</strong></p><p>The code is valid for 5 minutes, please do not share with others.</p><p style="color: #999; font-size: 12px;">If this was not your operation, please change your password.</p></div></body></html>')
+ON CONFLICT (purpose) DO NOTHING;
@@ -0,0 +1,9 @@
+-- Drop multipart upload columns
+DROP INDEX IF EXISTS idx_file_uploads_open_expires;
+
+ALTER TABLE file_uploads
+    DROP COLUMN IF EXISTS storage_upload_id,
+    DROP COLUMN IF EXISTS storage_path,
+    DROP COLUMN IF EXISTS bucket_name,
+    DROP COLUMN IF EXISTS total_parts,
+    DROP COLUMN IF EXISTS file_id;
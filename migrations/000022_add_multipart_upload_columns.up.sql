@@ -0,0 +1,14 @@
+-- Multipart upload sessions: each row maps to a MinIO multipart upload of one file object
+ALTER TABLE file_uploads
+    ADD COLUMN IF NOT EXISTS file_id           VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS total_parts       INT          NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS bucket_name       VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS storage_path      VARCHAR(500),
+    ADD COLUMN IF NOT EXISTS storage_upload_id VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_file_uploads_open_expires ON file_uploads (expires_at) WHERE status IN (1, 2);
+
+COMMENT ON COLUMN file_uploads.file_id IS 'File record created when the upload starts';
+COMMENT ON COLUMN file_uploads.total_parts IS 'Number of parts: ceil(file_size / chunk_size)';
+COMMENT ON COLUMN file_uploads.storage_path IS 'MinIO object key';
+COMMENT ON COLUMN file_uploads.storage_upload_id IS 'MinIO multipart upload ID';
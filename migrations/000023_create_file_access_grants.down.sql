@@ -0,0 +1,3 @@
+-- Drop file access grants
+DROP TABLE IF EXISTS file_access_grant_recipients;
+DROP TABLE IF EXISTS file_access_grants;
@@ -0,0 +1,29 @@
+-- File access grants: a file sent in a message can be read by the participants of that conversation
+CREATE TABLE IF NOT EXISTS file_access_grants (
+    id                BIGSERIAL    PRIMARY KEY,
+    file_id           VARCHAR(64)  NOT NULL,
+    message_id        VARCHAR(64)  NOT NULL,
+    conversation_id   VARCHAR(64)  NOT NULL,
+    conversation_type SMALLINT     NOT NULL,           -- 1-single 2-group
+    target_id         VARCHAR(64)  NOT NULL,           -- peer user ID or group ID
+    sender_id         VARCHAR(36)  NOT NULL,
+    created_at        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at        TIMESTAMP,
+    revoke_reason     VARCHAR(20)                      -- recalled, deleted, auto_deleted
+);
+
+CREATE UNIQUE INDEX uk_file_access_grants_file_message ON file_access_grants (file_id, message_id);
+CREATE INDEX idx_file_access_grants_message ON file_access_grants (message_id);
+CREATE INDEX idx_file_access_grants_sender ON file_access_grants (sender_id);
+
+-- Group members at the time the message was sent, they keep access after leaving the group
+CREATE TABLE IF NOT EXISTS file_access_grant_recipients (
+    grant_id BIGINT      NOT NULL REFERENCES file_access_grants (id) ON DELETE CASCADE,
+    user_id  VARCHAR(36) NOT NULL,
+    PRIMARY KEY (grant_id, user_id)
+);
+
+CREATE INDEX idx_file_access_grant_recipients_user ON file_access_grant_recipients (user_id);
+
+COMMENT ON TABLE file_access_grants IS 'Files linked to the messages that reference them';
+COMMENT ON TABLE file_access_grant_recipients IS 'Group members when a file message was sent';
@@ -0,0 +1,2 @@
+-- Drop media processing queue
+DROP TABLE IF EXISTS file_processing_jobs;
@@ -0,0 +1,20 @@
+-- Media processing queue: one job per completed upload, claimed by file-service workers
+CREATE TABLE IF NOT EXISTS file_processing_jobs (
+    id           BIGSERIAL   PRIMARY KEY,
+    file_id      VARCHAR(64) NOT NULL,
+    status       SMALLINT    NOT NULL DEFAULT 1,  -- 1-pending 2-running 3-completed 4-failed
+    attempts     INT         NOT NULL DEFAULT 0,
+    next_run_at  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until TIMESTAMP,
+    last_error   TEXT,
+    created_at   TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX uk_file_processing_jobs_file ON file_processing_jobs (file_id);
+CREATE INDEX idx_file_processing_jobs_due ON file_processing_jobs (next_run_at) WHERE status IN (1, 2);
+
+COMMENT ON TABLE file_processing_jobs IS 'Thumbnail, metadata and EXIF stripping jobs of uploaded files';
+COMMENT ON COLUMN file_processing_jobs.next_run_at IS 'Earliest time a pending job is picked up, pushed back after failures';
+COMMENT ON COLUMN file_processing_jobs.locked_until IS 'Lease of a running job, an expired lease means the worker died and the job runs again';
@@ -0,0 +1,8 @@
+-- Drop content-addressed blobs
+DROP INDEX IF EXISTS idx_files_blob_id;
+
+ALTER TABLE files
+    DROP COLUMN IF EXISTS blob_id,
+    DROP COLUMN IF EXISTS sha256;
+
+DROP TABLE IF EXISTS file_blobs;
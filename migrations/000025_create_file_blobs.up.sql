@@ -0,0 +1,26 @@
+-- Content-addressed blobs: files with the same SHA-256 and size share one MinIO object
+CREATE TABLE IF NOT EXISTS file_blobs (
+    id           BIGSERIAL    PRIMARY KEY,
+    sha256       VARCHAR(64)  NOT NULL,
+    file_size    BIGINT       NOT NULL,
+    bucket_name  VARCHAR(50)  NOT NULL,
+    storage_path VARCHAR(500) NOT NULL,
+    ref_count    INT          NOT NULL DEFAULT 0,
+    status       SMALLINT     NOT NULL DEFAULT 1,  -- 0-deleted 1-active
+    created_at   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX uk_file_blobs_active_hash ON file_blobs (sha256, file_size) WHERE status = 1;
+
+ALTER TABLE files
+    ADD COLUMN IF NOT EXISTS sha256  VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS blob_id BIGINT;
+
+CREATE INDEX IF NOT EXISTS idx_files_blob_id ON files (blob_id) WHERE blob_id IS NOT NULL;
+
+COMMENT ON TABLE file_blobs IS 'Verified stored objects shared by files with the same content';
+COMMENT ON COLUMN file_blobs.sha256 IS 'SHA-256 (lowercase hex) of the uploaded bytes';
+COMMENT ON COLUMN file_blobs.ref_count IS 'Files referencing the blob, the object is removed when it drops to 0';
+COMMENT ON COLUMN files.sha256 IS 'SHA-256 declared by the client, verified on completion';
+COMMENT ON COLUMN files.blob_id IS 'Shared blob, NULL for files uploaded without a hash';
@@ -0,0 +1,4 @@
+-- Drop storage usage ledger
+ALTER TABLE files DROP COLUMN IF EXISTS group_id;
+
+DROP TABLE IF EXISTS storage_usage;
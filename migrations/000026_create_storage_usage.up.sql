@@ -0,0 +1,26 @@
+-- Storage usage ledger and quota settings per user and per group
+CREATE TABLE IF NOT EXISTS storage_usage (
+    owner_type  SMALLINT    NOT NULL,  -- 1-user 2-group
+    owner_id    VARCHAR(36) NOT NULL,
+    used_bytes  BIGINT      NOT NULL DEFAULT 0,
+    file_count  INT         NOT NULL DEFAULT 0,
+    tier        VARCHAR(32) NOT NULL DEFAULT '',
+    quota_bytes BIGINT,
+    updated_at  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner_type, owner_id)
+);
+
+ALTER TABLE files ADD COLUMN IF NOT EXISTS group_id VARCHAR(36);
+
+-- active files uploaded before the ledger existed, client logs do not count
+INSERT INTO storage_usage (owner_type, owner_id, used_bytes, file_count)
+SELECT 1, user_id, SUM(file_size), COUNT(*)
+FROM files
+WHERE status = 1 AND file_type != 5
+GROUP BY user_id
+ON CONFLICT (owner_type, owner_id) DO NOTHING;
+
+COMMENT ON TABLE storage_usage IS 'Bytes of active files counted against each user or group quota';
+COMMENT ON COLUMN storage_usage.tier IS 'Quota tier of a user, empty for the default tier';
+COMMENT ON COLUMN storage_usage.quota_bytes IS 'Quota set by an administrator, NULL to use the tier or group default, 0 for unlimited';
+COMMENT ON COLUMN files.group_id IS 'Group whose quota the file counts against, empty for files counted against the uploader';
@@ -0,0 +1,7 @@
+-- Drop object deletion outbox
+DROP INDEX IF EXISTS idx_files_pending_created_at;
+DROP INDEX IF EXISTS idx_files_active_expires_at;
+
+ALTER TABLE files DROP COLUMN IF EXISTS expiry_warned_at;
+
+DROP TABLE IF EXISTS file_object_deletions;
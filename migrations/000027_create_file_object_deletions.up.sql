@@ -0,0 +1,22 @@
+-- Outbox of MinIO objects to remove, written in the transaction that makes them unreferenced
+CREATE TABLE IF NOT EXISTS file_object_deletions (
+    id              BIGSERIAL    PRIMARY KEY,
+    bucket_name     VARCHAR(50)  NOT NULL,
+    object_name     VARCHAR(500) NOT NULL,
+    attempts        INT          NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error      TEXT,
+    created_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_file_object_deletions_next_attempt ON file_object_deletions (next_attempt_at);
+
+ALTER TABLE files ADD COLUMN IF NOT EXISTS expiry_warned_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_files_active_expires_at ON files (expires_at) WHERE status = 1 AND expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_files_pending_created_at ON files (created_at) WHERE status = 2;
+
+COMMENT ON TABLE file_object_deletions IS 'MinIO objects to remove, retried by the lifecycle worker until the removal succeeds';
+COMMENT ON COLUMN file_object_deletions.next_attempt_at IS 'Earliest time of the next removal attempt, also the lease of a claimed row';
+COMMENT ON COLUMN files.expiry_warned_at IS 'When the uploader was notified that the file expires soon';
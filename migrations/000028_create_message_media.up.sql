@@ -0,0 +1,39 @@
+-- Media index of conversations (images, videos, files, links), filled when a message is sent
+CREATE TABLE IF NOT EXISTS message_media (
+    id              BIGSERIAL    PRIMARY KEY,
+    message_id      VARCHAR(64)  NOT NULL,
+    conversation_id VARCHAR(64)  NOT NULL,
+    sequence        BIGINT       NOT NULL,
+    category        SMALLINT     NOT NULL,
+    sender_id       VARCHAR(64)  NOT NULL,
+    file_id         VARCHAR(64)  NOT NULL DEFAULT '',
+    url             TEXT         NOT NULL DEFAULT '',
+    created_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_media_conversation ON message_media (conversation_id, category, sequence DESC, id DESC);
+
+-- Backfill from existing messages, burn-after-reading messages are never indexed
+INSERT INTO message_media (message_id, conversation_id, sequence, category, sender_id, file_id, created_at)
+SELECT message_id, conversation_id, sequence,
+       CASE content_type WHEN 2 THEN 1 WHEN 3 THEN 2 ELSE 3 END,
+       sender_id, content->>'file_id', created_at
+FROM messages
+WHERE content_type IN (2, 3, 5)
+  AND status = 0
+  AND burn_after_reading_seconds = 0
+  AND COALESCE(content->>'file_id', '') <> ''
+ORDER BY conversation_id, sequence;
+
+INSERT INTO message_media (message_id, conversation_id, sequence, category, sender_id, url, created_at)
+SELECT DISTINCT m.message_id, m.conversation_id, m.sequence, 4, m.sender_id, l.match[1], m.created_at
+FROM messages m
+CROSS JOIN LATERAL regexp_matches(m.content->>'text', '(https?://[^\s<>"''，。！？；：、）】》]+)', 'g') AS l(match)
+WHERE m.content_type = 1
+  AND m.status = 0
+  AND m.burn_after_reading_seconds = 0;
+
+COMMENT ON TABLE message_media IS 'Images, videos, files and links shared in conversations, for the media gallery';
+COMMENT ON COLUMN message_media.category IS '1-image 2-video 3-file 4-link';
+COMMENT ON COLUMN message_media.file_id IS 'File of image, video and file entries';
+COMMENT ON COLUMN message_media.url IS 'URL of link entries';
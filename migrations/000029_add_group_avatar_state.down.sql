@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_groups_avatar_stale_at;
+ALTER TABLE groups DROP COLUMN IF EXISTS avatar_stale_at;
+ALTER TABLE groups DROP COLUMN IF EXISTS avatar_custom;
@@ -0,0 +1,13 @@
+-- Custom avatars are uploaded by the owner or an admin, otherwise the avatar is composed from member avatars
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS avatar_custom BOOLEAN NOT NULL DEFAULT false;
+-- Set when the members shown on the composite avatar may have changed, cleared once it is regenerated
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS avatar_stale_at TIMESTAMP;
+
+COMMENT ON COLUMN groups.avatar_custom IS 'true: uploaded avatar, false: composed from member avatars';
+COMMENT ON COLUMN groups.avatar_stale_at IS 'composite avatar needs to be regenerated since';
+
+-- Existing avatars were set by clients and are kept, groups without one get a composite
+UPDATE groups SET avatar_custom = true WHERE avatar IS NOT NULL AND avatar <> '';
+UPDATE groups SET avatar_stale_at = CURRENT_TIMESTAMP WHERE (avatar IS NULL OR avatar = '') AND status = 1;
+
+CREATE INDEX IF NOT EXISTS idx_groups_avatar_stale_at ON groups (avatar_stale_at) WHERE avatar_stale_at IS NOT NULL;
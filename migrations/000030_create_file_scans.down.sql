@@ -0,0 +1,3 @@
+-- Drop malware scanning queue and verdicts
+DROP TABLE IF EXISTS file_scan_verdicts;
+DROP TABLE IF EXISTS file_scans;
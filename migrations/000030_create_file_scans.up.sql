@@ -0,0 +1,43 @@
+-- Malware scanning queue: one row per completed upload, the file stays pending until its scan finishes
+CREATE TABLE IF NOT EXISTS file_scans (
+    id            BIGSERIAL    PRIMARY KEY,
+    file_id       VARCHAR(64)  NOT NULL,
+    user_id       VARCHAR(36)  NOT NULL,
+    status        SMALLINT     NOT NULL DEFAULT 1,  -- 1-pending 2-running 3-clean 4-quarantined 5-released 6-rejected 7-skipped
+    attempts      INT          NOT NULL DEFAULT 0,
+    next_run_at   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until  TIMESTAMP,
+    last_error    TEXT,
+    scanner       VARCHAR(32),
+    signature     VARCHAR(255),
+    source_bucket VARCHAR(50)  NOT NULL,
+    scanned_at    TIMESTAMP,
+    reviewed_by   VARCHAR(36),
+    reviewed_at   TIMESTAMP,
+    created_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX uk_file_scans_file ON file_scans (file_id);
+CREATE INDEX idx_file_scans_due ON file_scans (next_run_at) WHERE status IN (1, 2);
+CREATE INDEX idx_file_scans_quarantined ON file_scans (scanned_at DESC) WHERE status = 4;
+
+-- Every verdict on a file, from the scanner or an administrator, append only
+CREATE TABLE IF NOT EXISTS file_scan_verdicts (
+    id         BIGSERIAL    PRIMARY KEY,
+    file_id    VARCHAR(64)  NOT NULL,
+    verdict    VARCHAR(20)  NOT NULL,  -- clean, infected, error, skipped, released, deleted
+    scanner    VARCHAR(32),
+    signature  VARCHAR(255),
+    detail     TEXT,
+    admin_id   VARCHAR(36),
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_file_scan_verdicts_file ON file_scan_verdicts (file_id, id);
+
+COMMENT ON TABLE file_scans IS 'Malware scan of each completed upload, claimed by file-service workers';
+COMMENT ON COLUMN file_scans.source_bucket IS 'Bucket the file was uploaded to, a released file is moved back there';
+COMMENT ON COLUMN file_scans.locked_until IS 'Lease of a running scan, an expired lease means the worker died and the scan runs again';
+COMMENT ON TABLE file_scan_verdicts IS 'Audit trail of scan results and quarantine reviews';
+COMMENT ON COLUMN file_scan_verdicts.admin_id IS 'Reviewing administrator, empty for scanner verdicts';
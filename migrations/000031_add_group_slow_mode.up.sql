@@ -0,0 +1,4 @@
+-- Minimum interval between two messages of the same member, owners and admins are exempt
+ALTER TABLE group_settings ADD COLUMN IF NOT EXISTS slow_mode_seconds INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN group_settings.slow_mode_seconds IS 'slow mode interval in seconds, 0: off';
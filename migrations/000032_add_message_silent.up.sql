@@ -0,0 +1,4 @@
+-- System notices of routine events are stored silent, they appear in the timeline but are not counted as unread
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS silent BOOLEAN NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN messages.silent IS 'true: system notice not counted as unread and not pushed';
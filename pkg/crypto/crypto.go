@@ -3,6 +3,7 @@ package crypto
 import (
 	"crypto/md5"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"regexp"
@@ -42,6 +43,12 @@ func MD5(str string) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
+// HashToken returns the SHA-256 hex digest of a token for storage and lookup
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 // GenerateRandomString generates random string
 func GenerateRandomString(length int) (string, error) {
 	bytes := make([]byte, length)
@@ -14,15 +14,39 @@ const (
 
 // Auth Service error codes (10xxx)
 const (
-	CodeUserExists          = 10101 // User already exists
-	CodePasswordWeak        = 10103 // Password too weak
-	CodeUserNotFound        = 10104 // User not found
-	CodePasswordError       = 10105 // Incorrect password
-	CodeAccountDisabled     = 10106 // Account disabled
-	CodeRefreshTokenInvalid = 10107 // Invalid RefreshToken
-	CodeRefreshTokenExpired = 10108 // RefreshToken expired
-	CodeTokenInvalid        = 10109 // Invalid Token
-	CodeTokenExpired        = 10110 // Token expired
+	CodeUserExists            = 10101 // User already exists
+	CodePasswordWeak          = 10103 // Password too weak
+	CodeUserNotFound          = 10104 // User not found
+	CodePasswordError         = 10105 // Incorrect password
+	CodeAccountDisabled       = 10106 // Account disabled
+	CodeRefreshTokenInvalid   = 10107 // Invalid RefreshToken
+	CodeRefreshTokenExpired   = 10108 // RefreshToken expired
+	CodeTokenInvalid          = 10109 // Invalid Token
+	CodeTokenExpired          = 10110 // Token expired
+	CodeQRLoginExpired        = 10111 // QR login ticket expired
+	CodeQRLoginInvalid        = 10112 // Invalid QR login ticket state
+	CodeMFARequired           = 10113 // Two-factor authentication code required
+	CodeMFACodeError          = 10114 // Incorrect two-factor authentication code
+	CodeMFAAlreadyEnabled     = 10115 // Two-factor authentication already enabled
+	CodeMFANotEnabled         = 10116 // Two-factor authentication not enabled
+	CodeMFAChallengeInvalid   = 10117 // MFA challenge expired or invalid
+	CodeRefreshTokenReused    = 10118 // Rotated RefreshToken presented again
+	CodeAccountLocked         = 10119 // Account temporarily locked after repeated login failures
+	CodeLoginThrottled        = 10120 // Login retried before the progressive delay elapsed
+	CodeCaptchaRequired       = 10121 // CAPTCHA required before the next login attempt
+	CodeCaptchaInvalid        = 10122 // CAPTCHA verification failed
+	CodeDeviceNotFound        = 10123 // Device not found
+	CodeOIDCProviderNotFound  = 10124 // Identity provider not configured
+	CodeOIDCStateInvalid      = 10125 // OIDC sign-in request expired or invalid
+	CodeOIDCAuthFailed        = 10126 // Code exchange or ID token verification failed
+	CodeIdentityNotLinked     = 10127 // No account linked to the external identity
+	CodeIdentityAlreadyLinked = 10128 // External identity already linked
+	CodeIdentityNotFound      = 10129 // Linked identity not found
+	CodeLastSignInMethod      = 10130 // Removing the identity would leave no way to sign in
+	CodeLoginReportInvalid    = 10131 // "This wasn't me" link expired or already used
+	CodePasswordResetRequired = 10132 // Password must be reset after a reported unusual login
+	CodeAccountDeletionExists = 10133 // Account deletion already scheduled
+	CodeAccountDeletionNone   = 10134 // No account deletion request
 
 	// Verification code sub-domain error codes (102xx)
 	CodeSendRateLimited        = 10201 // Sending too frequently
@@ -94,6 +118,8 @@ const (
 	CodeGroupQRExpired           = 40117 // Group QR code expired
 	CodeGroupQRInvalid           = 40118 // Invalid group QR code
 	CodeGroupPinnedLimitExceeded = 40119 // Group pinned limit exceeded
+	CodeGroupMuted               = 40120 // Group muted
+	CodeGroupSlowMode            = 40121 // Sending too fast in slow mode
 )
 
 // Message Service error codes (50xxx)
@@ -125,6 +151,13 @@ const (
 	CodeFileExpired          = 70108 // File expired
 	CodeStorageQuotaExceeded = 70109 // Storage quota exceeded
 	CodeThumbnailGenFailed   = 70110 // Thumbnail generation failed
+	CodeUploadNotFound       = 70111 // Multipart upload not found
+	CodeUploadIncomplete     = 70112 // Multipart upload has missing parts
+	CodeUploadClosed         = 70113 // Multipart upload expired, aborted or completed
+	CodeFileHashMismatch     = 70114 // Uploaded content does not match the declared SHA-256
+	CodeFileContentMismatch  = 70115 // Uploaded content does not match the declared size or type
+	CodeInvalidAvatarImage   = 70116 // Image cannot be used as an avatar
+	CodeFileQuarantined      = 70117 // File was quarantined by the malware scan
 )
 
 // Sync Service error codes (11xxx)
@@ -191,15 +224,39 @@ var errorMessages = map[int]string{
 	CodeForbidden:     "Forbidden",
 	CodeNotFound:      "Resource not found",
 
-	CodeUserExists:          "User already exists",
-	CodePasswordWeak:        "Password too weak",
-	CodeUserNotFound:        "User not found",
-	CodePasswordError:       "Incorrect password",
-	CodeAccountDisabled:     "Account disabled",
-	CodeRefreshTokenInvalid: "Invalid RefreshToken",
-	CodeRefreshTokenExpired: "RefreshToken expired",
-	CodeTokenInvalid:        "Invalid Token",
-	CodeTokenExpired:        "Token expired",
+	CodeUserExists:            "User already exists",
+	CodePasswordWeak:          "Password too weak",
+	CodeUserNotFound:          "User not found",
+	CodePasswordError:         "Incorrect password",
+	CodeAccountDisabled:       "Account disabled",
+	CodeRefreshTokenInvalid:   "Invalid RefreshToken",
+	CodeRefreshTokenExpired:   "RefreshToken expired",
+	CodeTokenInvalid:          "Invalid Token",
+	CodeTokenExpired:          "Token expired",
+	CodeQRLoginExpired:        "QR login ticket expired",
+	CodeQRLoginInvalid:        "Invalid QR login ticket state",
+	CodeMFARequired:           "Two-factor authentication code required",
+	CodeMFACodeError:          "Incorrect two-factor authentication code",
+	CodeMFAAlreadyEnabled:     "Two-factor authentication already enabled",
+	CodeMFANotEnabled:         "Two-factor authentication not enabled",
+	CodeMFAChallengeInvalid:   "MFA challenge expired or invalid",
+	CodeRefreshTokenReused:    "RefreshToken already used, please log in again",
+	CodeAccountLocked:         "Account temporarily locked due to too many failed login attempts",
+	CodeLoginThrottled:        "Too many failed login attempts, please try again later",
+	CodeCaptchaRequired:       "CAPTCHA verification required",
+	CodeCaptchaInvalid:        "CAPTCHA verification failed",
+	CodeDeviceNotFound:        "Device not found",
+	CodeOIDCProviderNotFound:  "Identity provider not found",
+	CodeOIDCStateInvalid:      "Sign-in request expired or invalid, please try again",
+	CodeOIDCAuthFailed:        "Identity provider sign-in failed",
+	CodeIdentityNotLinked:     "No account is linked to this identity",
+	CodeIdentityAlreadyLinked: "Identity already linked to an account",
+	CodeIdentityNotFound:      "Linked identity not found",
+	CodeLastSignInMethod:      "Cannot remove the only way to sign in, set a password or bind a phone number first",
+	CodeLoginReportInvalid:    "Link expired or already used",
+	CodePasswordResetRequired: "Password reset required, please reset your password with a verification code",
+	CodeAccountDeletionExists: "Account deletion already scheduled",
+	CodeAccountDeletionNone:   "No account deletion request",
 
 	CodeNicknameUsed:        "Nickname already used",
 	CodeNicknameSensitive:   "Nickname contains sensitive words",
@@ -250,6 +307,8 @@ var errorMessages = map[int]string{
 	CodeGroupQRExpired:           "Group QR code expired",
 	CodeGroupQRInvalid:           "Invalid group QR code",
 	CodeGroupPinnedLimitExceeded: "Group pinned limit exceeded, please unpin some first",
+	CodeGroupMuted:               "Group muted",
+	CodeGroupSlowMode:            "Slow mode is on, please wait before sending again",
 
 	CodeMessageNotFound:         "Message not found",
 	CodeMessageSendFailed:       "Message send failed",
@@ -275,6 +334,13 @@ var errorMessages = map[int]string{
 	CodeFileExpired:          "File expired",
 	CodeStorageQuotaExceeded: "Storage quota exceeded",
 	CodeThumbnailGenFailed:   "Thumbnail generation failed",
+	CodeUploadNotFound:       "Upload not found",
+	CodeUploadIncomplete:     "Upload incomplete",
+	CodeUploadClosed:         "Upload closed",
+	CodeFileHashMismatch:     "File hash mismatch",
+	CodeFileContentMismatch:  "File content mismatch",
+	CodeInvalidAvatarImage:   "Invalid avatar image",
+	CodeFileQuarantined:      "File quarantined",
 
 	CodeSessionNotFound:     "Session not found",
 	CodeSessionDeleted:      "Session deleted",
@@ -0,0 +1,142 @@
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// JWKSClientConfig JWKS client configuration
+type JWKSClientConfig struct {
+	URL             string
+	CacheTTL        time.Duration // how long a fetched key set is trusted, default 5 minutes
+	MinRefreshDelay time.Duration // lower bound between fetches triggered by unknown kids, default 10 seconds
+	HTTPTimeout     time.Duration // default 5 seconds
+}
+
+// JWKSClient resolves verification keys from a remote JWKS endpoint and caches them
+type JWKSClient struct {
+	config     JWKSClientConfig
+	httpClient *http.Client
+
+	mu          sync.RWMutex
+	keys        map[string]*VerificationKey
+	fetchedAt   time.Time
+	lastAttempt time.Time
+	refreshMu   sync.Mutex // serializes fetches
+}
+
+// NewJWKSClient creates a JWKS client
+func NewJWKSClient(config JWKSClientConfig) *JWKSClient {
+	if config.CacheTTL <= 0 {
+		config.CacheTTL = 5 * time.Minute
+	}
+	if config.MinRefreshDelay <= 0 {
+		config.MinRefreshDelay = 10 * time.Second
+	}
+	if config.HTTPTimeout <= 0 {
+		config.HTTPTimeout = 5 * time.Second
+	}
+	return &JWKSClient{
+		config:     config,
+		httpClient: &http.Client{Timeout: config.HTTPTimeout},
+		keys:       make(map[string]*VerificationKey),
+	}
+}
+
+// VerificationKey returns the key for kid, refetching the key set when it is stale or the kid is unknown
+func (c *JWKSClient) VerificationKey(kid string) (*VerificationKey, error) {
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	fresh := time.Since(c.fetchedAt) < c.config.CacheTTL
+	c.mu.RUnlock()
+	if ok && fresh {
+		return key, nil
+	}
+
+	// a stale cache is still better than failing every request while auth-service is unreachable
+	if err := c.refresh(context.Background(), !ok); err != nil && !ok {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if key, ok := c.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key: %s", kid)
+}
+
+// Refresh fetches the key set immediately
+func (c *JWKSClient) Refresh(ctx context.Context) error {
+	return c.refresh(ctx, false)
+}
+
+// refresh fetches the key set, limited to one fetch per MinRefreshDelay
+func (c *JWKSClient) refresh(ctx context.Context, unknownKid bool) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	c.mu.RLock()
+	fresh := time.Since(c.fetchedAt) < c.config.CacheTTL
+	throttled := time.Since(c.lastAttempt) < c.config.MinRefreshDelay
+	c.mu.RUnlock()
+	// another caller refreshed while we waited, or unknown kids are arriving faster than allowed
+	if (fresh && !unknownKid) || throttled {
+		return nil
+	}
+
+	c.mu.Lock()
+	c.lastAttempt = time.Now()
+	c.mu.Unlock()
+
+	keys, err := c.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.fetchedAt = time.Now()
+	c.mu.Unlock()
+	return nil
+}
+
+// fetch downloads and parses the key set
+func (c *JWKSClient) fetch(ctx context.Context) (map[string]*VerificationKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.config.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch jwks failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch jwks failed: status %d", resp.StatusCode)
+	}
+
+	var set JWKS
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("decode jwks failed: %w", err)
+	}
+
+	keys := make(map[string]*VerificationKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := ParseJWK(jwk)
+		if err != nil {
+			// skip keys this version does not understand rather than failing the whole set
+			continue
+		}
+		keys[key.ID] = key
+	}
+	return keys, nil
+}
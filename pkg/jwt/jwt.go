@@ -2,9 +2,17 @@ package jwt
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Token audiences
+const (
+	AudienceAPI   = "anychat-api"
+	AudienceAdmin = "anychat-admin"
 )
 
 // Claims JWT claims
@@ -18,79 +26,138 @@ type Claims struct {
 
 // Config JWT configuration
 type Config struct {
-	Secret             string
+	Issuer             string // iss of issued tokens, verified when set
+	Audience           string // aud of issued tokens, verified when set
 	AccessTokenExpire  time.Duration
 	RefreshTokenExpire time.Duration
+	// LegacySecret still accepts HS256 tokens signed before asymmetric keys were introduced,
+	// leave empty once they have all expired
+	LegacySecret string
 }
 
 // Manager JWT manager
 type Manager struct {
-	config *Config
+	config   *Config
+	signer   Signer      // nil for verify-only managers
+	resolver KeyResolver // resolves kid to public key
 }
 
-// NewManager creates a new JWT manager
-func NewManager(config *Config) *Manager {
+// NewManager creates a new JWT manager, signer may be nil when the manager only verifies tokens
+func NewManager(config *Config, signer Signer, resolver KeyResolver) *Manager {
 	return &Manager{
-		config: config,
+		config:   config,
+		signer:   signer,
+		resolver: resolver,
 	}
 }
 
+// AccessTokenExpire returns access token lifetime
+func (m *Manager) AccessTokenExpire() time.Duration {
+	return m.config.AccessTokenExpire
+}
+
+// RefreshTokenExpire returns refresh token lifetime
+func (m *Manager) RefreshTokenExpire() time.Duration {
+	return m.config.RefreshTokenExpire
+}
+
 // GenerateAccessToken generates an access token
 func (m *Manager) GenerateAccessToken(userID, deviceID string, deviceType int16) (string, error) {
-	now := time.Now()
-	claims := Claims{
-		UserID:     userID,
-		DeviceID:   deviceID,
-		DeviceType: deviceType,
-		TokenType:  "access",
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(m.config.AccessTokenExpire)),
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-		},
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.config.Secret))
+	return m.generateToken(userID, deviceID, deviceType, "access", m.config.AccessTokenExpire)
 }
 
 // GenerateRefreshToken generates a refresh token
 func (m *Manager) GenerateRefreshToken(userID, deviceID string, deviceType int16) (string, error) {
+	return m.generateToken(userID, deviceID, deviceType, "refresh", m.config.RefreshTokenExpire)
+}
+
+// generateToken signs claims with the current signing key, the kid header tells verifiers which key to use
+func (m *Manager) generateToken(userID, deviceID string, deviceType int16, tokenType string, expire time.Duration) (string, error) {
+	if m.signer == nil {
+		return "", fmt.Errorf("jwt manager has no signing key")
+	}
+	key, err := m.signer.SigningKey()
+	if err != nil {
+		return "", err
+	}
+	method, err := signingMethod(key.Algorithm)
+	if err != nil {
+		return "", err
+	}
+
 	now := time.Now()
 	claims := Claims{
 		UserID:     userID,
 		DeviceID:   deviceID,
 		DeviceType: deviceType,
-		TokenType:  "refresh",
+		TokenType:  tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(m.config.RefreshTokenExpire)),
+			ID:        uuid.NewString(), // keeps tokens issued within the same second distinct, allows revoking one token
+			Issuer:    m.config.Issuer,
+			ExpiresAt: jwt.NewNumericDate(now.Add(expire)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 		},
 	}
+	if m.config.Audience != "" {
+		claims.Audience = jwt.ClaimStrings{m.config.Audience}
+	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.config.Secret))
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.PrivateKey)
 }
 
 // ParseToken parses a token
 func (m *Manager) ParseToken(tokenString string) (*Claims, error) {
+	legacy := false
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+			if m.config.LegacySecret == "" {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			legacy = true
+			return []byte(m.config.LegacySecret), nil
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, fmt.Errorf("missing kid header")
+		}
+		if m.resolver == nil {
+			return nil, fmt.Errorf("no verification keys configured")
+		}
+		key, err := m.resolver.VerificationKey(kid)
+		if err != nil {
+			return nil, err
+		}
+		// the key decides the algorithm, never the token header
+		if token.Method.Alg() != key.Algorithm {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(m.config.Secret), nil
-	})
+		return key.PublicKey, nil
+	}, jwt.WithValidMethods([]string{AlgorithmRS256, AlgorithmEdDSA, jwt.SigningMethodHS256.Alg()}))
 
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		return claims, nil
+	claims, ok := token.Claims.(*Claims)
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("invalid token")
 	}
 
-	return nil, fmt.Errorf("invalid token")
+	// legacy tokens predate iss/aud claims
+	if !legacy {
+		if m.config.Issuer != "" && claims.Issuer != m.config.Issuer {
+			return nil, fmt.Errorf("invalid token issuer")
+		}
+		if m.config.Audience != "" && !slices.Contains(claims.Audience, m.config.Audience) {
+			return nil, fmt.Errorf("invalid token audience")
+		}
+	}
+
+	return claims, nil
 }
 
 // ValidateAccessToken validates an access token
@@ -3,7 +3,10 @@ package minio
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/minio/minio-go/v7"
@@ -17,6 +20,8 @@ type Config struct {
 	SecretKey string   `mapstructure:"secret_key"`
 	UseSSL    bool     `mapstructure:"use_ssl"`
 	Buckets   []string `mapstructure:"buckets"`
+	// PublicBuckets buckets anyone may read objects from, e.g. avatars
+	PublicBuckets []string `mapstructure:"public_buckets"`
 }
 
 // Client MinIO client wrapper
@@ -61,9 +66,20 @@ func NewClient(cfg *Config) (*Client, error) {
 		}
 	}
 
+	for _, bucket := range cfg.PublicBuckets {
+		if err := minioClient.SetBucketPolicy(ctx, bucket, publicReadPolicy(bucket)); err != nil {
+			return nil, fmt.Errorf("failed to make bucket %s public: %w", bucket, err)
+		}
+	}
+
 	return client, nil
 }
 
+// publicReadPolicy bucket policy that lets anonymous clients get objects but not list or write them
+func publicReadPolicy(bucket string) string {
+	return fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::%s/*"]}]}`, bucket)
+}
+
 // PutObject uploads an object
 func (c *Client) PutObject(ctx context.Context, bucketName, objectName, filePath string, contentType string) (minio.UploadInfo, error) {
 	return c.client.FPutObject(ctx, bucketName, objectName, filePath, minio.PutObjectOptions{
@@ -85,6 +101,13 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string) (
 	return c.client.GetObject(ctx, bucketName, objectName, minio.GetObjectOptions{})
 }
 
+// CopyObject copies an object on the server side, e.g. into another bucket
+func (c *Client) CopyObject(ctx context.Context, dstBucket, dstObject, srcBucket, srcObject string) (minio.UploadInfo, error) {
+	return c.client.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: dstBucket, Object: dstObject},
+		minio.CopySrcOptions{Bucket: srcBucket, Object: srcObject})
+}
+
 // RemoveObject deletes an object
 func (c *Client) RemoveObject(ctx context.Context, bucketName, objectName string) error {
 	return c.client.RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
@@ -113,6 +136,98 @@ func (c *Client) PresignedPutObject(ctx context.Context, bucketName, objectName
 	return c.client.PresignedPutObject(ctx, bucketName, objectName, expires)
 }
 
+// PresignedPutObjectWithHeaders generates an upload presigned URL whose signature covers Content-Length
+// and, when set, Content-Type. The upload must send exactly the returned headers, MinIO rejects others.
+// Default expiration is 1 hour
+func (c *Client) PresignedPutObjectWithHeaders(ctx context.Context, bucketName, objectName string, expires time.Duration, contentLength int64, contentType string) (*url.URL, http.Header, error) {
+	if expires == 0 {
+		expires = time.Hour
+	}
+	headers := http.Header{}
+	headers.Set("Content-Length", strconv.FormatInt(contentLength, 10))
+	if contentType != "" {
+		headers.Set("Content-Type", contentType)
+	}
+	u, err := c.client.PresignHeader(ctx, http.MethodPut, bucketName, objectName, expires, nil, headers)
+	if err != nil {
+		return nil, nil, err
+	}
+	return u, headers, nil
+}
+
+// GetObjectHead reads up to length bytes from the start of an object
+func (c *Client) GetObjectHead(ctx context.Context, bucketName, objectName string, length int64) ([]byte, error) {
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(0, length-1); err != nil {
+		return nil, err
+	}
+	object, err := c.client.GetObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	return io.ReadAll(io.LimitReader(object, length))
+}
+
+// NewMultipartUpload starts a multipart upload, returns the storage upload ID
+func (c *Client) NewMultipartUpload(ctx context.Context, bucketName, objectName, contentType string) (string, error) {
+	core := minio.Core{Client: c.client}
+	return core.NewMultipartUpload(ctx, bucketName, objectName, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+}
+
+// PresignedUploadPartURL generates a presigned URL uploading one part of a multipart upload
+func (c *Client) PresignedUploadPartURL(ctx context.Context, bucketName, objectName, uploadID string, partNumber int, expires time.Duration) (*url.URL, error) {
+	if expires == 0 {
+		expires = time.Hour
+	}
+	params := url.Values{}
+	params.Set("uploadId", uploadID)
+	params.Set("partNumber", strconv.Itoa(partNumber))
+	return c.client.Presign(ctx, http.MethodPut, bucketName, objectName, expires, params)
+}
+
+// ListObjectParts lists all uploaded parts of a multipart upload in part number order
+func (c *Client) ListObjectParts(ctx context.Context, bucketName, objectName, uploadID string) ([]minio.ObjectPart, error) {
+	core := minio.Core{Client: c.client}
+	var parts []minio.ObjectPart
+	marker := 0
+	for {
+		result, err := core.ListObjectParts(ctx, bucketName, objectName, uploadID, marker, 1000)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, result.ObjectParts...)
+		if !result.IsTruncated {
+			return parts, nil
+		}
+		marker = result.NextPartNumberMarker
+	}
+}
+
+// CompleteMultipartUpload assembles the uploaded parts into the object
+func (c *Client) CompleteMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string, parts []minio.CompletePart) (minio.UploadInfo, error) {
+	core := minio.Core{Client: c.client}
+	return core.CompleteMultipartUpload(ctx, bucketName, objectName, uploadID, parts, minio.PutObjectOptions{})
+}
+
+// AbortMultipartUpload aborts a multipart upload and frees its uploaded parts
+func (c *Client) AbortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string) error {
+	core := minio.Core{Client: c.client}
+	return core.AbortMultipartUpload(ctx, bucketName, objectName, uploadID)
+}
+
+// IsNoSuchUpload reports whether the error means the multipart upload no longer exists
+func IsNoSuchUpload(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchUpload"
+}
+
+// IsNoSuchKey reports whether the error means the object does not exist
+func IsNoSuchKey(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchKey"
+}
+
 // GetClient gets the underlying minio.Client
 func (c *Client) GetClient() *minio.Client {
 	return c.client
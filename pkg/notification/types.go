@@ -52,6 +52,12 @@ const (
 	TypeAuthForceLogout     = "auth.force_logout"     // Force logout
 	TypeAuthUnusualLogin    = "auth.unusual_login"    // Unusual login
 	TypeAuthPasswordChanged = "auth.password_changed" // Password changed
+	TypeAuthAccountLocked   = "auth.account_locked"   // Account locked after repeated login failures
+
+	TypeAuthAccountDeletionRequested = "auth.account_deletion_requested" // Account deletion scheduled
+	TypeAuthAccountDeletionCancelled = "auth.account_deletion_cancelled" // Account deletion cancelled by a login
+	TypeAuthAccountErasureStep       = "auth.account_erasure_step"       // One service finished erasing a deleted account
+	TypeAuthAccountErased            = "auth.account_erased"             // Every service finished erasing a deleted account
 )
 
 // File Service notification types
@@ -59,6 +65,7 @@ const (
 	TypeFileUploadCompleted = "file.upload_completed" // File upload completed
 	TypeFileProcessing      = "file.processing"       // File processing
 	TypeFileExpiring        = "file.expiring"         // File expiring soon
+	TypeFileQuarantined     = "file.quarantined"      // Upload held back by the malware scan
 )
 
 // Push Service notification types
@@ -72,6 +79,8 @@ const (
 	TypeLiveKitCallInvite   = "livekit.call_invite"   // Audio/Video invitation
 	TypeLiveKitCallStatus   = "livekit.call_status"   // Call status changed
 	TypeLiveKitCallRejected = "livekit.call_rejected" // Call rejected
+	TypeLiveKitCallEnded    = "livekit.call_ended"    // Call left ringing state, dismiss incoming call UI
+	TypeLiveKitCallRinging  = "livekit.call_ringing"  // Incoming call push delivered to callee
 )
 
 // Sync Service notification types
@@ -154,9 +154,90 @@ else
     fail "Expected 200, actual $HTTP_CODE"
 fi
 
-# ── Test 12: Logout ────────────────────────────────────
+# ── Test 12: Unlock unknown user ─────────────────────────
 
-echo "Test 12: Logout"
+echo "Test 12: Unlock login of non-existent user"
+HTTP_CODE=$(curl -s -o /dev/null -w "%{http_code}" -X POST "${ADMIN_URL}/api/admin/users/00000000-0000-0000-0000-000000000000/unlock" \
+    -H "Authorization: Bearer $ADMIN_TOKEN")
+if [ "$HTTP_CODE" = "404" ]; then
+    pass "Unlocking unknown user returns 404"
+else
+    fail "Expected 404, actual $HTTP_CODE"
+fi
+
+# ── Test 13: Override user storage quota ─────────────────────
+
+GATEWAY_API="${GATEWAY_URL:-http://localhost:8080}/api/v1"
+QUOTA_TS=$(date +%s)
+QUOTA_USER_TOKEN=$(register_and_login_test_user "${GATEWAY_API}" "quota_${QUOTA_TS}@test.com" "Test@1234" "Quota${QUOTA_TS}" "quota-dev-${QUOTA_TS}" || true)
+QUOTA_USER_ID=""
+if [ -n "$QUOTA_USER_TOKEN" ]; then
+    QUOTA_USER_ID=$(get_user_id_by_token "${GATEWAY_API}" "$QUOTA_USER_TOKEN" || true)
+fi
+QUOTA_UPLOAD_DATA='{"file_name":"quota.txt","file_size":2048,"mime_type":"text/plain","file_type":4}'
+
+echo "Test 13: Override user storage quota"
+if [ -z "$QUOTA_USER_ID" ]; then
+    fail "Cannot register quota test user through the gateway, skip storage quota tests"
+else
+    RESP=$(curl -s -X PUT "${ADMIN_URL}/api/admin/storage/users/${QUOTA_USER_ID}" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d '{"quota_bytes":1024}')
+    if echo "$RESP" | jq -e '.data.quota_bytes == 1024 and .data.quota_overridden == true' > /dev/null; then
+        pass "Quota override set to 1024 bytes"
+    else
+        fail "Quota override not applied (response: $RESP)"
+    fi
+
+    # ── Test 14: Upload over the override is refused ────────────
+
+    echo "Test 14: Upload token over the quota override"
+    RESP=$(http_post "${GATEWAY_API}/files/upload-token" "$QUOTA_UPLOAD_DATA" "$QUOTA_USER_TOKEN")
+    CODE=$(json_code "$RESP")
+    USAGE=$(http_get "${GATEWAY_API}/files/storage-usage" "$QUOTA_USER_TOKEN")
+    if [ "$CODE" = "429" ] && echo "$USAGE" | jq -e '.data.quota_bytes == 1024' > /dev/null; then
+        pass "Upload over quota returns 429, user sees quota_bytes 1024"
+    else
+        fail "Expected 429 and quota_bytes 1024, actual code $CODE (usage: $USAGE)"
+    fi
+
+    # ── Test 15: Clear the override ───────────────────────────
+
+    echo "Test 15: Clear storage quota override"
+    RESP=$(curl -s -X PUT "${ADMIN_URL}/api/admin/storage/users/${QUOTA_USER_ID}" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d '{"clear_quota":true}')
+    OVERRIDDEN=$(echo "$RESP" | jq -r '.data.quota_overridden // false')
+    CODE=$(json_code "$(http_post "${GATEWAY_API}/files/upload-token" "$QUOTA_UPLOAD_DATA" "$QUOTA_USER_TOKEN")")
+    if [ "$OVERRIDDEN" = "false" ] && [ "$CODE" = "0" ]; then
+        pass "Override cleared, tier quota applies again"
+    else
+        fail "Expected override cleared and upload allowed, actual overridden=$OVERRIDDEN code=$CODE"
+    fi
+
+    # ── Test 16: Invalid quota updates ───────────────────────
+
+    echo "Test 16: Invalid storage quota updates"
+    HTTP_CODE_TIER=$(curl -s -o /dev/null -w "%{http_code}" -X PUT "${ADMIN_URL}/api/admin/storage/users/${QUOTA_USER_ID}" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d '{"tier":"no-such-tier"}')
+    HTTP_CODE_EMPTY=$(curl -s -o /dev/null -w "%{http_code}" -X PUT "${ADMIN_URL}/api/admin/storage/users/${QUOTA_USER_ID}" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d '{}')
+    if [ "$HTTP_CODE_TIER" = "400" ] && [ "$HTTP_CODE_EMPTY" = "400" ]; then
+        pass "Unknown tier and empty update return 400"
+    else
+        fail "Expected 400/400, actual $HTTP_CODE_TIER/$HTTP_CODE_EMPTY"
+    fi
+fi
+
+# ── Test 17: Logout ────────────────────────────────────
+
+echo "Test 17: Logout"
 HTTP_CODE=$(curl -s -o /dev/null -w "%{http_code}" -X POST "${ADMIN_URL}/api/admin/auth/logout" \
     -H "Authorization: Bearer $ADMIN_TOKEN")
 if [ "$HTTP_CODE" = "200" ]; then
@@ -15,6 +15,7 @@ NC='\033[0m' # No Color
 # Configuration
 GATEWAY_URL="${GATEWAY_URL:-http://localhost:8080}"
 API_BASE="${GATEWAY_URL}/api/v1"
+AUTH_HTTP_URL="${AUTH_HTTP_URL:-http://localhost:8001}"
 
 # Test data
 TIMESTAMP=$(date +%s)
@@ -23,8 +24,11 @@ TEST_EMAIL="test${TIMESTAMP}@example.com"
 TEST_PASSWORD="Test@123456"
 TEST_DEVICE_ID="test-device-${TIMESTAMP}"
 FIXED_CODE="${VERIFY_DEBUG_FIXED_CODE:-123456}"
+# file written by the fake SMS provider (verify.sms.provider=fake), same path as the auth service
+SMS_FAKE_FILE="${SMS_FAKE_FILE:-}"
 DEVICE_TYPE_IOS=1
 DEVICE_TYPE_WEB=3
+DEVICE_TYPE_PC=4
 
 # Global variables
 ACCESS_TOKEN=""
@@ -80,6 +84,25 @@ http_get() {
     fi
 }
 
+http_put() {
+    local url=$1
+    local data=$2
+    local token=$3
+
+    curl -s -X PUT "${url}" \
+        -H "Content-Type: application/json" \
+        -H "Authorization: Bearer ${token}" \
+        -d "${data}"
+}
+
+http_delete() {
+    local url=$1
+    local token=$2
+
+    curl -s -X DELETE "${url}" \
+        -H "Authorization: Bearer ${token}"
+}
+
 # Check code field in JSON response
 check_response() {
     local response=$1
@@ -121,6 +144,7 @@ send_code() {
         change_phone) purpose=5 ;;
         bind_email) purpose=6 ;;
         change_email) purpose=7 ;;
+        delete_account) purpose=8 ;;
     esac
 
     local data=$(cat <<EOF
@@ -527,6 +551,665 @@ EOF
     fi
 }
 
+# 14. Login with verification code
+test_login_by_code() {
+    print_header "14. Login with Verification Code"
+
+    local send_response=$(send_code "${TEST_PHONE}" "sms" "login" "${TEST_DEVICE_ID}_3")
+    print_info "Send code response: $send_response"
+    if ! check_response "$send_response"; then
+        return 1
+    fi
+
+    local data=$(cat <<EOF
+{
+    "target": "${TEST_PHONE}",
+    "target_type": 1,
+    "verify_code": "${FIXED_CODE}",
+    "device_type": ${DEVICE_TYPE_WEB},
+    "device_id": "${TEST_DEVICE_ID}_3",
+    "client_version": "1.0.0"
+}
+EOF
+)
+
+    print_info "Login info: target=${TEST_PHONE}"
+
+    local response=$(http_post "${API_BASE}/auth/login/code" "$data")
+    print_info "Response: $response"
+
+    if check_response "$response"; then
+        local code_user_id=$(echo "$response" | jq -r '.data.user_id // empty')
+        if [ "$code_user_id" != "$USER_ID" ]; then
+            print_error "Code login returned unexpected user ID: ${code_user_id}"
+            return 1
+        fi
+
+        print_success "Verification code login successful"
+        return 0
+    else
+        return 1
+    fi
+}
+
+# 15. QR code login
+test_qr_login() {
+    print_header "15. QR Code Login"
+
+    local qr_device_id="${TEST_DEVICE_ID}_qr"
+    local data=$(cat <<EOF
+{
+    "device_type": ${DEVICE_TYPE_WEB},
+    "device_id": "${qr_device_id}",
+    "client_version": "1.0.0"
+}
+EOF
+)
+
+    local response=$(http_post "${API_BASE}/auth/qr-login/ticket" "$data")
+    print_info "Create ticket response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local ticket=$(echo "$response" | jq -r '.data.ticket // empty')
+    local poll_secret=$(echo "$response" | jq -r '.data.poll_secret // empty')
+    if [ -z "$poll_secret" ]; then
+        print_error "Expected poll_secret in ticket response"
+        return 1
+    fi
+
+    local ticket_data="{\"ticket\": \"${ticket}\"}"
+
+    response=$(http_post "${API_BASE}/auth/qr-login/scan" "$ticket_data" "$ACCESS_TOKEN")
+    print_info "Scan response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+
+    response=$(http_get "${API_BASE}/auth/qr-login/status?ticket=${ticket}&device_id=${qr_device_id}&poll_secret=${poll_secret}")
+    print_info "Status response: $response"
+    if [ "$(echo "$response" | jq -r '.data.status')" != "2" ]; then
+        print_error "Expected scanned status"
+        return 1
+    fi
+
+    response=$(http_post "${API_BASE}/auth/qr-login/confirm" "$ticket_data" "$ACCESS_TOKEN")
+    print_info "Confirm response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+
+    # someone who saw the QR code and knows the device ID cannot collect the tokens
+    response=$(http_get "${API_BASE}/auth/qr-login/status?ticket=${ticket}&device_id=${qr_device_id}&poll_secret=${poll_secret}x")
+    print_info "Wrong poll secret response: $response"
+    if [ "$(echo "$response" | jq -r '.code')" = "0" ]; then
+        print_error "Expected poll with a wrong poll_secret to be rejected"
+        return 1
+    fi
+    response=$(http_get "${API_BASE}/auth/qr-login/status?ticket=${ticket}&device_id=${qr_device_id}")
+    print_info "Missing poll secret response: $response"
+    if [ "$(echo "$response" | jq -r '.code')" = "0" ]; then
+        print_error "Expected poll without poll_secret to be rejected"
+        return 1
+    fi
+
+    response=$(http_get "${API_BASE}/auth/qr-login/status?ticket=${ticket}&device_id=${qr_device_id}&poll_secret=${poll_secret}&last_status=2&wait_seconds=5")
+    print_info "Status response: $response"
+
+    if check_response "$response"; then
+        local qr_user_id=$(echo "$response" | jq -r '.data.login.user_id // empty')
+        if [ "$qr_user_id" != "$USER_ID" ]; then
+            print_error "QR login returned unexpected user ID: ${qr_user_id}"
+            return 1
+        fi
+
+        print_success "QR code login successful"
+        return 0
+    else
+        return 1
+    fi
+}
+
+# 16. Two-factor authentication enrollment
+test_mfa_enrollment() {
+    print_header "16. Two-Factor Authentication Enrollment"
+
+    local response=$(http_get "${API_BASE}/auth/mfa" "$ACCESS_TOKEN")
+    print_info "Status response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    if [ "$(echo "$response" | jq -r '.data.enabled')" != "false" ]; then
+        print_error "Expected MFA disabled for new account"
+        return 1
+    fi
+
+    response=$(http_post "${API_BASE}/auth/mfa/enroll" "{}" "$ACCESS_TOKEN")
+    print_info "Enroll response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local otpauth_uri=$(echo "$response" | jq -r '.data.otpauth_uri // empty')
+    if [[ "$otpauth_uri" != otpauth://totp/* ]]; then
+        print_error "Unexpected otpauth URI: ${otpauth_uri}"
+        return 1
+    fi
+
+    response=$(http_post "${API_BASE}/auth/mfa/enroll/confirm" '{"code": "000000"}' "$ACCESS_TOKEN")
+    print_info "Confirm with wrong code response: $response"
+    if [ "$(echo "$response" | jq -r '.code')" == "0" ]; then
+        print_error "Confirm with wrong code should fail"
+        return 1
+    fi
+
+    print_success "MFA enrollment flow works as expected"
+    return 0
+}
+
+# 17. Refresh token reuse detection
+test_refresh_token_reuse() {
+    print_header "17. Refresh Token Reuse Detection"
+
+    local data=$(cat <<EOF
+{
+    "account": "${TEST_PHONE}",
+    "password": "${TEST_PASSWORD}",
+    "device_type": ${DEVICE_TYPE_WEB},
+    "device_id": "${TEST_DEVICE_ID}_reuse",
+    "client_version": "1.0.0"
+}
+EOF
+)
+
+    local response=$(http_post "${API_BASE}/auth/login" "$data")
+    print_info "Login response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local old_refresh=$(echo "$response" | jq -r '.data.refresh_token // empty')
+
+    response=$(http_post "${API_BASE}/auth/refresh" "{\"refresh_token\": \"${old_refresh}\"}")
+    print_info "Rotate response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local new_refresh=$(echo "$response" | jq -r '.data.refresh_token // empty')
+
+    response=$(http_post "${API_BASE}/auth/refresh" "{\"refresh_token\": \"${old_refresh}\"}")
+    print_info "Reuse old token response: $response"
+    if [ "$(echo "$response" | jq -r '.code')" == "0" ]; then
+        print_error "Reusing a rotated refresh token should fail"
+        return 1
+    fi
+
+    response=$(http_post "${API_BASE}/auth/refresh" "{\"refresh_token\": \"${new_refresh}\"}")
+    print_info "Refresh after reuse response: $response"
+    if [ "$(echo "$response" | jq -r '.code')" == "0" ]; then
+        print_error "Token family should be revoked after reuse"
+        return 1
+    fi
+
+    print_success "Refresh token reuse revoked the token family"
+    return 0
+}
+
+test_jwks() {
+    print_header "18. JWKS and Token Signing Key"
+
+    local response=$(http_get "${AUTH_HTTP_URL}/.well-known/jwks.json")
+    print_info "JWKS response: $response"
+    local key_count=$(echo "$response" | jq '.keys | length')
+    if [ -z "$key_count" ] || [ "$key_count" -eq 0 ]; then
+        print_error "JWKS should publish at least one key"
+        return 1
+    fi
+
+    if [ -z "$ACCESS_TOKEN" ]; then
+        print_error "No access token available"
+        return 1
+    fi
+
+    # decode JWT header (base64url, padding restored)
+    local header=$(echo "$ACCESS_TOKEN" | cut -d. -f1 | tr '_-' '/+')
+    while [ $(( ${#header} % 4 )) -ne 0 ]; do header="${header}="; done
+    header=$(echo "$header" | base64 -d 2>/dev/null)
+    print_info "Token header: $header"
+
+    local kid=$(echo "$header" | jq -r '.kid // empty')
+    local alg=$(echo "$header" | jq -r '.alg // empty')
+    if [ "$alg" == "HS256" ] || [ -z "$kid" ]; then
+        print_error "Token should be signed asymmetrically with a kid header"
+        return 1
+    fi
+
+    if [ "$(echo "$response" | jq --arg kid "$kid" '[.keys[] | select(.kid == $kid)] | length')" -eq 0 ]; then
+        print_error "Signing key ${kid} not published in JWKS"
+        return 1
+    fi
+
+    print_success "Token signed with ${alg} key ${kid} published in JWKS"
+    return 0
+}
+
+test_login_brute_force() {
+    print_header "19. Login Brute-force Protection"
+
+    local data=$(cat <<EOF
+{
+    "account": "${TEST_PHONE}",
+    "password": "Wrong@${TIMESTAMP}",
+    "device_type": ${DEVICE_TYPE_WEB},
+    "device_id": "${TEST_DEVICE_ID}_guard",
+    "client_version": "1.0.0"
+}
+EOF
+)
+
+    # progressive delay starts after the third failure
+    local response
+    for i in 1 2 3; do
+        response=$(http_post "${API_BASE}/auth/login" "$data")
+        print_info "Wrong password attempt ${i}: $response"
+        if [ "$(echo "$response" | jq -r '.code')" != "401" ]; then
+            print_error "Wrong password should return 401"
+            return 1
+        fi
+    done
+
+    response=$(http_post "${API_BASE}/auth/login" "$data")
+    print_info "Immediate retry response: $response"
+    if [ "$(echo "$response" | jq -r '.code')" != "429" ]; then
+        print_error "Retry within the delay should be throttled"
+        return 1
+    fi
+
+    print_success "Repeated login failures are throttled"
+    return 0
+}
+
+test_device_management() {
+    print_header "20. Device Management"
+
+    local device_id="${TEST_DEVICE_ID}_pc"
+    local data=$(cat <<EOF
+{
+    "account": "${TEST_PHONE}",
+    "password": "${TEST_PASSWORD}",
+    "device_type": ${DEVICE_TYPE_PC},
+    "device_id": "${device_id}",
+    "client_version": "2.1.0"
+}
+EOF
+)
+
+    local response=$(http_post "${API_BASE}/auth/login" "$data")
+    print_info "PC login response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local pc_token=$(echo "$response" | jq -r '.data.access_token // empty')
+
+    response=$(http_get "${API_BASE}/auth/devices" "$pc_token")
+    print_info "Device list response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local device=$(echo "$response" | jq --arg id "$device_id" '.data.devices[] | select(.device_id == $id)')
+    if [ "$(echo "$device" | jq -r '.is_current')" != "true" ] || \
+       [ "$(echo "$device" | jq -r '.signed_in')" != "true" ] || \
+       [ "$(echo "$device" | jq -r '.client_version')" != "2.1.0" ]; then
+        print_error "PC device should be listed as current and signed in with its client version"
+        return 1
+    fi
+
+    response=$(http_put "${API_BASE}/auth/devices/${device_id}/name" '{"name": "Office PC"}' "$pc_token")
+    print_info "Rename response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+
+    # sign out the PC from another device
+    response=$(http_delete "${API_BASE}/auth/devices/${device_id}" "$ACCESS_TOKEN")
+    print_info "Sign out device response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+
+    response=$(http_get "${API_BASE}/auth/devices" "$ACCESS_TOKEN")
+    device=$(echo "$response" | jq --arg id "$device_id" '.data.devices[] | select(.device_id == $id)')
+    if [ "$(echo "$device" | jq -r '.name')" != "Office PC" ] || \
+       [ "$(echo "$device" | jq -r '.signed_in')" != "false" ]; then
+        print_error "Signed out device should keep its name and have no session: $device"
+        return 1
+    fi
+
+    response=$(http_delete "${API_BASE}/auth/devices/unknown_${TIMESTAMP}" "$ACCESS_TOKEN")
+    print_info "Sign out unknown device response: $response"
+    if [ "$(echo "$response" | jq -r '.code')" != "404" ]; then
+        print_error "Unknown device should return 404"
+        return 1
+    fi
+
+    print_success "Devices listed, renamed and signed out"
+    return 0
+}
+
+test_fake_sms_provider() {
+    print_header "21. Fake SMS Provider"
+
+    if [ -z "$SMS_FAKE_FILE" ]; then
+        print_info "SMS_FAKE_FILE not set, skipped"
+        return 0
+    fi
+
+    local phone=$(make_phone 3)
+    local response=$(send_code "$phone" "sms" "register" "${TEST_DEVICE_ID}-fake-sms")
+    print_info "Send code response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+
+    if [ ! -r "$SMS_FAKE_FILE" ]; then
+        print_error "Fake SMS file is not readable: ${SMS_FAKE_FILE}"
+        return 1
+    fi
+    local record=$(grep "\"to\":\"${phone}\"" "$SMS_FAKE_FILE" | tail -n 1)
+    print_info "Fake SMS record: $record"
+    local code=$(echo "$record" | jq -r '.code // empty')
+    if [ -z "$code" ]; then
+        print_error "Verification code not found in fake SMS file"
+        return 1
+    fi
+
+    local data=$(cat <<EOF
+{
+    "phone_number": "${phone}",
+    "password": "${TEST_PASSWORD}",
+    "verify_code": "${code}",
+    "nickname": "FakeSms${TIMESTAMP}",
+    "device_type": ${DEVICE_TYPE_IOS},
+    "device_id": "${TEST_DEVICE_ID}_fake_sms",
+    "client_version": "1.0.0"
+}
+EOF
+)
+    response=$(http_post "${API_BASE}/auth/register" "$data")
+    print_info "Register response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+
+    print_success "Code read from fake SMS file and accepted"
+    return 0
+}
+
+# oidc_authorize signs in at the fake OIDC issuer, prints "code state"
+# usage: oidc_authorize <begin url> <email> [token]
+oidc_authorize() {
+    local response=$(http_post "$1" '{}' "$3")
+    local authorization_url=$(echo "$response" | jq -r '.data.authorization_url // empty')
+    if [ -z "$authorization_url" ]; then
+        echo "$response" >&2
+        return 1
+    fi
+    # the fake issuer signs in login_hint and redirects straight back
+    local redirect=$(curl -s -o /dev/null -w '%{redirect_url}' "${authorization_url}&login_hint=$2")
+    local code=$(echo "$redirect" | sed -n 's/.*[?&]code=\([^&]*\).*/\1/p')
+    local state=$(echo "$redirect" | sed -n 's/.*[?&]state=\([^&]*\).*/\1/p')
+    if [ -z "$code" ] || [ -z "$state" ]; then
+        echo "$redirect" >&2
+        return 1
+    fi
+    echo "$code $state"
+}
+
+test_oidc_login() {
+    print_header "22. OIDC Login"
+
+    local response=$(http_get "${API_BASE}/auth/oidc/providers")
+    print_info "Provider list response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    if [ -z "$(echo "$response" | jq -r '.data.providers[] | select(.name == "fake") | .name')" ]; then
+        print_info "Fake OIDC issuer not enabled (AUTH_OIDC_FAKE_ISSUER=true), skipped"
+        return 0
+    fi
+
+    # first sign-in creates an account
+    local email="oidc${TIMESTAMP}@example.com"
+    local result
+    if ! result=$(oidc_authorize "${API_BASE}/auth/oidc/fake/authorize" "$email"); then
+        print_error "Fake issuer authorization failed"
+        return 1
+    fi
+    local callback=$(cat <<EOF
+{
+    "code": "${result% *}",
+    "state": "${result#* }",
+    "device_type": ${DEVICE_TYPE_WEB},
+    "device_id": "${TEST_DEVICE_ID}_oidc",
+    "client_version": "1.0.0"
+}
+EOF
+)
+    response=$(http_post "${API_BASE}/auth/oidc/fake/callback" "$callback")
+    print_info "OIDC callback response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local oidc_user_id=$(echo "$response" | jq -r '.data.user_id // empty')
+    local oidc_token=$(echo "$response" | jq -r '.data.access_token // empty')
+    if [ -z "$oidc_token" ]; then
+        print_error "OIDC login returned no access token"
+        return 1
+    fi
+
+    # codes and states are single use
+    response=$(http_post "${API_BASE}/auth/oidc/fake/callback" "$callback")
+    if [ "$(echo "$response" | jq -r '.code')" != "400" ]; then
+        print_error "Replayed callback should return 400: $response"
+        return 1
+    fi
+
+    # signing in again reaches the same account
+    result=$(oidc_authorize "${API_BASE}/auth/oidc/fake/authorize" "$email")
+    response=$(http_post "${API_BASE}/auth/oidc/fake/callback" "{\"code\": \"${result% *}\", \"state\": \"${result#* }\", \"device_type\": ${DEVICE_TYPE_WEB}, \"device_id\": \"${TEST_DEVICE_ID}_oidc\", \"client_version\": \"1.0.0\"}")
+    if [ "$(echo "$response" | jq -r '.data.user_id')" != "$oidc_user_id" ]; then
+        print_error "Second OIDC login should sign in the same user: $response"
+        return 1
+    fi
+
+    response=$(http_get "${API_BASE}/auth/identities" "$oidc_token")
+    print_info "Identity list response: $response"
+    if [ "$(echo "$response" | jq -r '.data.identities[0].provider')" != "fake" ]; then
+        print_error "Auto-registered user should have the fake identity linked"
+        return 1
+    fi
+
+    # a login state cannot be used to link
+    result=$(oidc_authorize "${API_BASE}/auth/oidc/fake/authorize" "link${TIMESTAMP}@example.com")
+    response=$(http_post "${API_BASE}/auth/identities/fake" "{\"code\": \"${result% *}\", \"state\": \"${result#* }\"}" "$ACCESS_TOKEN")
+    if [ "$(echo "$response" | jq -r '.code')" != "400" ]; then
+        print_error "Linking with a login state should return 400: $response"
+        return 1
+    fi
+
+    # link an identity to the password user, then unlink it
+    if ! result=$(oidc_authorize "${API_BASE}/auth/identities/fake/authorize" "link${TIMESTAMP}@example.com" "$ACCESS_TOKEN"); then
+        print_error "Link authorization failed"
+        return 1
+    fi
+    response=$(http_post "${API_BASE}/auth/identities/fake" "{\"code\": \"${result% *}\", \"state\": \"${result#* }\"}" "$ACCESS_TOKEN")
+    print_info "Link identity response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+
+    response=$(http_delete "${API_BASE}/auth/identities/fake" "$ACCESS_TOKEN")
+    print_info "Unlink identity response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    response=$(http_delete "${API_BASE}/auth/identities/fake" "$ACCESS_TOKEN")
+    if [ "$(echo "$response" | jq -r '.code')" != "404" ]; then
+        print_error "Unlinking twice should return 404: $response"
+        return 1
+    fi
+
+    print_success "OIDC login, auto registration, link and unlink work"
+    return 0
+}
+
+test_unusual_login() {
+    print_header "23. Login History and Unusual Login"
+
+    # a device and network the account has never used, gateway takes the client IP from X-Forwarded-For
+    local device_id="${TEST_DEVICE_ID}_unusual"
+    local data=$(cat <<EOF
+{
+    "account": "${TEST_PHONE}",
+    "password": "${TEST_PASSWORD}",
+    "device_type": ${DEVICE_TYPE_WEB},
+    "device_id": "${device_id}",
+    "client_version": "1.0.0"
+}
+EOF
+)
+
+    local response=$(curl -s -X POST "${API_BASE}/auth/login" \
+        -H "Content-Type: application/json" \
+        -H "X-Forwarded-For: 198.51.100.7" \
+        -A "AnyChat-API-Test/1.0" \
+        -d "$data")
+    print_info "Login from new network response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local token=$(echo "$response" | jq -r '.data.access_token // empty')
+
+    response=$(http_get "${API_BASE}/auth/login-history?limit=10" "$token")
+    print_info "Login history response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local entry=$(echo "$response" | jq --arg id "$device_id" '[.data.entries[] | select(.device_id == $id)][0]')
+    if [ "$(echo "$entry" | jq -r '.ip_address')" != "198.51.100.7" ] || \
+       [ "$(echo "$entry" | jq -r '.user_agent')" != "AnyChat-API-Test/1.0" ] || \
+       [ "$(echo "$entry" | jq -r '.result')" != "1" ]; then
+        print_error "Login history should record IP, user agent and success: $entry"
+        return 1
+    fi
+    if [ "$(echo "$entry" | jq -r '.unusual')" != "true" ] || \
+       [ "$(echo "$entry" | jq -r '.risk_reasons | index("new_device") != null and index("new_network") != null')" != "true" ]; then
+        print_error "Login from a new device and network should be unusual: $entry"
+        return 1
+    fi
+    if [ "$(echo "$response" | jq '[.data.entries[] | select(.result == 2)] | length')" = "0" ]; then
+        print_error "Wrong password attempts should be listed in login history"
+        return 1
+    fi
+
+    response=$(http_post "${API_BASE}/auth/unusual-login/report" '{"token": "invalid-token"}')
+    print_info "Report with invalid token response: $response"
+    if [ "$(echo "$response" | jq -r '.code')" != "400" ]; then
+        print_error "Invalid report token should return 400"
+        return 1
+    fi
+
+    print_success "Login history recorded and unusual login flagged"
+    return 0
+}
+
+test_account_deletion() {
+    print_header "24. Account Deletion and Cancellation by Login"
+
+    local email="delete_${TIMESTAMP}@example.com"
+    local device_id="${TEST_DEVICE_ID}-delete"
+    local response=$(send_code "$email" "email" "register" "$device_id")
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local data=$(cat <<EOF
+{
+    "email": "${email}",
+    "password": "${TEST_PASSWORD}",
+    "verify_code": "${FIXED_CODE}",
+    "nickname": "DeleteMe${TIMESTAMP}",
+    "device_type": ${DEVICE_TYPE_WEB},
+    "device_id": "${device_id}",
+    "client_version": "1.0.0"
+}
+EOF
+)
+    response=$(http_post "${API_BASE}/auth/register" "$data")
+    print_info "Register response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local token=$(echo "$response" | jq -r '.data.access_token // empty')
+
+    response=$(http_post "${API_BASE}/auth/account/delete" '{"password": "Wrong@123456"}' "$token")
+    print_info "Delete with wrong password response: $response"
+    if [ "$(echo "$response" | jq -r '.code')" != "401" ]; then
+        print_error "Deleting with a wrong password should return 401"
+        return 1
+    fi
+
+    # the reason limit counts characters, multi-byte text up to the limit is stored as is
+    local max_reason=$(printf '注%.0s' $(seq 1 256))
+    local long_reason="${max_reason}注"
+    response=$(http_post "${API_BASE}/auth/account/delete" "{\"password\": \"${TEST_PASSWORD}\", \"reason\": \"${long_reason}\"}" "$token")
+    print_info "Delete with a 257 character reason response: $response"
+    if [ "$(echo "$response" | jq -r '.code')" != "400" ]; then
+        print_error "A reason longer than 256 characters should return 400"
+        return 1
+    fi
+
+    response=$(http_post "${API_BASE}/auth/account/delete" "{\"password\": \"${TEST_PASSWORD}\", \"reason\": \"${max_reason}\"}" "$token")
+    print_info "Delete account response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    if [ "$(echo "$response" | jq -r '.data.status')" != "1" ] || \
+       [ "$(echo "$response" | jq -r '.data.erase_after > .data.requested_at')" != "true" ]; then
+        print_error "Deletion should be pending until the grace period ends"
+        return 1
+    fi
+
+    # signing in during the grace period keeps the account
+    data=$(cat <<EOF
+{
+    "account": "${email}",
+    "password": "${TEST_PASSWORD}",
+    "device_type": ${DEVICE_TYPE_WEB},
+    "device_id": "${device_id}",
+    "client_version": "1.0.0"
+}
+EOF
+)
+    response=$(http_post "${API_BASE}/auth/login" "$data")
+    print_info "Login during grace period response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    token=$(echo "$response" | jq -r '.data.access_token // empty')
+
+    response=$(http_get "${API_BASE}/auth/account/deletion" "$token")
+    print_info "Deletion status response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    if [ "$(echo "$response" | jq -r '.data.status')" != "4" ] || \
+       [ "$(echo "$response" | jq -r '.data.cancelled_at > 0')" != "true" ]; then
+        print_error "Login during the grace period should cancel the deletion"
+        return 1
+    fi
+
+    print_success "Account deletion scheduled and cancelled by login"
+    return 0
+}
+
 # ========================================
 # Main function
 # ========================================
@@ -576,6 +1259,20 @@ main() {
     test_refresh_token || ((failed++))
     sleep 1
     test_logout || ((failed++))
+    sleep 1
+    test_login_by_code || ((failed++))
+    test_qr_login || ((failed++))
+    test_mfa_enrollment || ((failed++))
+    test_refresh_token_reuse || ((failed++))
+    test_jwks || ((failed++))
+    test_login_brute_force || ((failed++))
+    # wait for the progressive login delay left by the previous test
+    sleep 2
+    test_device_management || ((failed++))
+    test_fake_sms_provider || ((failed++))
+    test_oidc_login || ((failed++))
+    test_unusual_login || ((failed++))
+    test_account_deletion || ((failed++))
 
     # Output test results
     echo ""
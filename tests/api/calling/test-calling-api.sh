@@ -199,6 +199,139 @@ else
     fail "Cleanup blacklist failed, code=$CODE"
 fi
 
+# ── Ring timeout and call push ──────────────────────────────
+#
+# Needs LiveKit for the room, the flow is skipped if the call cannot be initiated.
+# CALLING_RING_TIMEOUT_SECONDS must match livekit.ring_timeout_seconds of calling-service,
+# lower it in the test config to keep the wait short. Push logs are read through the admin API.
+
+ADMIN_URL="${ADMIN_URL:-http://localhost:8011}"
+RING_TIMEOUT="${CALLING_RING_TIMEOUT_SECONDS:-60}"
+RING_SWEEP_INTERVAL=5
+TEST_START=$(date +%s)
+
+ADMIN_TOKEN=$(curl -s -X POST "${ADMIN_URL}/api/admin/auth/login" \
+    -H "Content-Type: application/json" \
+    -d '{"username":"admin","password":"Admin@123456"}' | jq -r '.data.token // empty')
+
+# count_push_logs <user_id> <push_type> prints the number of push logs since the test started
+count_push_logs() {
+    curl -s "${ADMIN_URL}/api/admin/push/logs?userId=$1&pushType=$2&startTime=${TEST_START}" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" | jq -r '.data.total // 0'
+}
+
+# call_status <call_id> prints the call status seen by user A (0-ringing is omitted in JSON)
+call_status() {
+    curl -s "${BASE_URL}/calling/calls/$1" \
+        -H "Authorization: Bearer $TOKEN_A" | jq -r '.data.status // 0'
+}
+
+echo "Test 11: Callee registers a push device"
+RESP=$(curl -s -X POST "${BASE_URL}/users/me/push-token" \
+    -H "Authorization: Bearer $TOKEN_B" \
+    -H "Content-Type: application/json" \
+    -d '{"device_id":"calling-dev-00000002","push_token":"calling-test-regid-00000002","platform":2}')
+CODE=$(json_code "$RESP")
+if [ "$CODE" = "0" ]; then
+    pass "Callee push token registered"
+else
+    fail "Register push token failed, code=$CODE"
+fi
+
+echo "Test 12: Initiate call is ringing"
+RESP=$(curl -s -X POST "${BASE_URL}/calling/calls" \
+    -H "Authorization: Bearer $TOKEN_A" \
+    -H "Content-Type: application/json" \
+    -d "{\"callee_id\":\"${USER_B_ID}\",\"call_type\":0}")
+CALL_ID=$(echo "$RESP" | jq -r '.data.call_id // empty')
+
+if [ -z "$CALL_ID" ]; then
+    echo -e "${YELLOW}Skip ring timeout tests: call could not be initiated (LiveKit unavailable?) response: $RESP${NC}"
+else
+    STATUS=$(call_status "$CALL_ID")
+    if [ "$STATUS" = "0" ]; then
+        pass "New call is ringing"
+    else
+        fail "Expected status 0 (ringing), actual $STATUS"
+    fi
+
+    echo "Test 13: Call invite push is logged for the callee"
+    sleep 2
+    if [ -z "$ADMIN_TOKEN" ]; then
+        echo -e "${YELLOW}Skip: admin login failed, push logs cannot be checked${NC}"
+    else
+        INVITE_LOGS=$(count_push_logs "$USER_B_ID" 5)
+        if [ "$INVITE_LOGS" -ge 1 ] 2>/dev/null; then
+            pass "Call invite push logged (${INVITE_LOGS})"
+        else
+            fail "Expected a call invite push log for callee, got ${INVITE_LOGS}"
+        fi
+    fi
+
+    echo "Test 14: Unanswered call is marked missed after the ring timeout"
+    echo "  waiting $((RING_TIMEOUT + RING_SWEEP_INTERVAL + 2))s..."
+    sleep $((RING_TIMEOUT + RING_SWEEP_INTERVAL + 2))
+    STATUS=$(call_status "$CALL_ID")
+    if [ "$STATUS" = "4" ]; then
+        pass "Call marked missed (status 4)"
+    else
+        fail "Expected status 4 (missed), actual $STATUS"
+    fi
+
+    echo "Test 15: Joining a missed call is rejected"
+    RESP=$(curl -s -X POST "${BASE_URL}/calling/calls/${CALL_ID}/join" \
+        -H "Authorization: Bearer $TOKEN_B")
+    if check_response_fail "$RESP"; then
+        pass "Join after timeout rejected (code $(json_code "$RESP"))"
+    else
+        fail "Join after timeout should fail"
+    fi
+    STATUS=$(call_status "$CALL_ID")
+    if [ "$STATUS" = "4" ]; then
+        pass "Missed status kept after late join"
+    else
+        fail "Late join changed status to $STATUS"
+    fi
+
+    echo "Test 16: Call ended push follows a delivered invite"
+    if [ -z "$ADMIN_TOKEN" ]; then
+        echo -e "${YELLOW}Skip: admin login failed, push logs cannot be checked${NC}"
+    else
+        SENT_INVITES=$(curl -s "${ADMIN_URL}/api/admin/push/logs?userId=${USER_B_ID}&pushType=5&status=2&startTime=${TEST_START}" \
+            -H "Authorization: Bearer $ADMIN_TOKEN" | jq -r '.data.total // 0')
+        ENDED_LOGS=$(count_push_logs "$USER_B_ID" 6)
+        if [ "$SENT_INVITES" = "0" ]; then
+            echo -e "${YELLOW}Skip: invite push was not delivered (JPush not configured), no ended push expected${NC}"
+        elif [ "$ENDED_LOGS" -ge 1 ] 2>/dev/null; then
+            pass "Call ended push logged (${ENDED_LOGS})"
+        else
+            fail "Expected a call ended push log for callee, got ${ENDED_LOGS}"
+        fi
+    fi
+
+    echo "Test 17: Caller cancels a ringing call"
+    RESP=$(curl -s -X POST "${BASE_URL}/calling/calls" \
+        -H "Authorization: Bearer $TOKEN_A" \
+        -H "Content-Type: application/json" \
+        -d "{\"callee_id\":\"${USER_B_ID}\",\"call_type\":1}")
+    CANCEL_CALL_ID=$(echo "$RESP" | jq -r '.data.call_id // empty')
+    RESP=$(curl -s -X POST "${BASE_URL}/calling/calls/${CANCEL_CALL_ID}/end" \
+        -H "Authorization: Bearer $TOKEN_A")
+    STATUS=$(call_status "$CANCEL_CALL_ID")
+    if [ -n "$CANCEL_CALL_ID" ] && [ "$STATUS" = "5" ]; then
+        pass "Ringing call cancelled (status 5)"
+    else
+        fail "Expected status 5 (cancelled), actual $STATUS"
+    fi
+    RESP=$(curl -s -X POST "${BASE_URL}/calling/calls/${CANCEL_CALL_ID}/join" \
+        -H "Authorization: Bearer $TOKEN_B")
+    if check_response_fail "$RESP"; then
+        pass "Join after cancel rejected"
+    else
+        fail "Join after cancel should fail"
+    fi
+fi
+
 # ── Summary ──────────────────────────────────────────────
 
 echo ""
@@ -41,6 +41,8 @@ USER1_ID=""
 USER2_ID=""
 CONVERSATION_ID=""
 HAS_GRPCURL=false
+GALLERY_CONVERSATION_ID=""
+GALLERY_MESSAGE_IDS=()
 
 # ────────────────────────────────────────
 # Utility functions
@@ -216,6 +218,61 @@ seed_conversation_via_grpc() {
     return 1
 }
 
+# Befriend user 2 so a real single conversation exists for user 1 to send messages into
+setup_gallery_conversation() {
+    if [ -n "$GALLERY_CONVERSATION_ID" ]; then
+        return 0
+    fi
+
+    local response
+    response=$(http_post "${API_BASE}/friends/requests" "{\"user_id\": \"${USER2_ID}\", \"message\": \"gallery test\", \"source\": 1}" "$USER1_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Send friend request failed"
+        return 1
+    fi
+    local request_id auto_accepted
+    request_id=$(echo "$response" | jq -r '.data.requestId // .data.request_id // empty')
+    auto_accepted=$(echo "$response" | jq -r '.data.autoAccepted // .data.auto_accepted // false')
+    if [ "$auto_accepted" != "true" ]; then
+        response=$(http_put "${API_BASE}/friends/requests/${request_id}" '{"action": 1}' "$USER2_TOKEN")
+        if ! check_response "$response"; then
+            print_error "Accept friend request failed"
+            return 1
+        fi
+    fi
+
+    local i
+    for i in $(seq 1 5); do
+        response=$(http_get "${API_BASE}/conversations?limit=100" "$USER1_TOKEN")
+        GALLERY_CONVERSATION_ID=$(echo "$response" | jq -r --arg uid "$USER2_ID" '
+            .data.conversations[]? |
+            select((.conversationType // .conversation_type) == 1 and (.targetId // .target_id) == $uid) |
+            (.conversationId // .conversation_id)
+        ' | head -n 1)
+        if [ -n "$GALLERY_CONVERSATION_ID" ] && [ "$GALLERY_CONVERSATION_ID" != "null" ]; then
+            print_info "Gallery conversation: ${GALLERY_CONVERSATION_ID}"
+            return 0
+        fi
+        GALLERY_CONVERSATION_ID=""
+        sleep 1
+    done
+    print_error "Conversation with user 2 not found"
+    return 1
+}
+
+# Send a text message carrying one link into the gallery conversation, echoes the message ID
+send_link_message() {
+    local link=$1
+    local data="{\"conversation_id\": \"${GALLERY_CONVERSATION_ID}\", \"content_type\": 1, \"content\": \"{\\\"text\\\":\\\"see ${link}\\\"}\", \"local_id\": \"local-link-${TIMESTAMP}-${RANDOM}\"}"
+    http_post "${API_BASE}/messages" "$data" "$USER1_TOKEN" | jq -r '.data.message_id // .data.messageId // empty'
+}
+
+# List one gallery page of user 1, query is appended to the URL as is
+list_gallery() {
+    local query=$1
+    http_get "${API_BASE}/conversations/${GALLERY_CONVERSATION_ID}/media?${query}" "$USER1_TOKEN"
+}
+
 # ────────────────────────────────────────
 # Test cases
 # ────────────────────────────────────────
@@ -524,6 +581,215 @@ test_get_conversations_after_delete() {
     return 1
 }
 
+# 15-18: Media gallery, uses a befriended conversation so messages can actually be sent
+
+test_media_gallery_links() {
+    print_header "15. Media Gallery Lists Shared Links"
+
+    setup_gallery_conversation || return 1
+
+    local i message_id
+    for i in 1 2 3 4 5; do
+        message_id=$(send_link_message "https://example.com/gallery/${TIMESTAMP}/${i}")
+        if [ -z "$message_id" ]; then
+            print_error "Send link message ${i} failed"
+            return 1
+        fi
+        GALLERY_MESSAGE_IDS+=("$message_id")
+    done
+    print_info "Sent ${#GALLERY_MESSAGE_IDS[@]} link messages"
+
+    local response
+    response=$(list_gallery "limit=20")
+    print_info "Response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+
+    local count first_id
+    count=$(echo "$response" | jq -r '.data.items // [] | length')
+    first_id=$(echo "$response" | jq -r '.data.items[0].message_id // empty')
+    if [ "$count" -ne 5 ]; then
+        print_error "Expected 5 gallery items, got ${count}"
+        return 1
+    fi
+    if [ "$first_id" != "${GALLERY_MESSAGE_IDS[4]}" ]; then
+        print_error "Expected newest message first, got ${first_id}"
+        return 1
+    fi
+    if [ "$(echo "$response" | jq -r '[.data.items[] | select(.category != 4 and .category != "MEDIA_CATEGORY_LINK")] | length')" -ne 0 ]; then
+        print_error "Text messages produced non-link entries"
+        return 1
+    fi
+    if [ "$(echo "$response" | jq -r '[.data.items[] | select(.url | startswith("https://example.com/gallery/"))] | length')" -ne 5 ]; then
+        print_error "Link entries do not carry the shared URLs"
+        return 1
+    fi
+    print_success "All links listed newest first with their URLs"
+    return 0
+}
+
+test_media_gallery_category_filter() {
+    print_header "16. Media Gallery Category Filter"
+
+    if [ -z "$GALLERY_CONVERSATION_ID" ]; then
+        print_skip "No gallery conversation available, skipping"
+        return 0
+    fi
+
+    local response count
+    response=$(list_gallery "categories=4")
+    if ! check_response "$response"; then
+        return 1
+    fi
+    count=$(echo "$response" | jq -r '.data.items // [] | length')
+    if [ "$count" -ne 5 ]; then
+        print_error "categories=4 expected 5 links, got ${count}"
+        return 1
+    fi
+    print_success "categories=4 returns the links"
+
+    response=$(list_gallery "categories=1,2,3")
+    if ! check_response "$response"; then
+        return 1
+    fi
+    count=$(echo "$response" | jq -r '.data.items // [] | length')
+    if [ "$count" -ne 0 ]; then
+        print_error "categories=1,2,3 expected no items, got ${count}"
+        return 1
+    fi
+    print_success "Image, video and file filter excludes links"
+
+    response=$(list_gallery "categories=9")
+    print_info "Invalid category response: $response"
+    if ! check_response_fail "$response"; then
+        return 1
+    fi
+    print_success "Unknown category rejected"
+    return 0
+}
+
+test_media_gallery_cursor_pagination() {
+    print_header "17. Media Gallery Cursor Pagination"
+
+    if [ -z "$GALLERY_CONVERSATION_ID" ]; then
+        print_skip "No gallery conversation available, skipping"
+        return 0
+    fi
+
+    # 5 items in pages of 2: 2 + 2 + 1, has_more only on the first two
+    local cursor="" page=0 seen=() response has_more count
+    while true; do
+        page=$((page + 1))
+        if [ -n "$cursor" ]; then
+            response=$(list_gallery "categories=4&limit=2&cursor=${cursor}")
+        else
+            response=$(list_gallery "categories=4&limit=2")
+        fi
+        if ! check_response "$response"; then
+            return 1
+        fi
+        count=$(echo "$response" | jq -r '.data.items // [] | length')
+        has_more=$(echo "$response" | jq -r '.data.has_more // false')
+        cursor=$(echo "$response" | jq -r '.data.next_cursor // empty')
+        print_info "Page ${page}: ${count} items, has_more=${has_more}"
+        seen+=($(echo "$response" | jq -r '.data.items[]?.message_id'))
+
+        if [ "$has_more" != "true" ]; then
+            break
+        fi
+        if [ -z "$cursor" ]; then
+            print_error "has_more without next_cursor on page ${page}"
+            return 1
+        fi
+        if [ "$page" -ge 5 ]; then
+            print_error "Pagination did not terminate"
+            return 1
+        fi
+    done
+
+    if [ "$page" -ne 3 ] || [ "$count" -ne 1 ]; then
+        print_error "Expected 3 pages ending with 1 item, got ${page} pages ending with ${count}"
+        return 1
+    fi
+    if [ -n "$cursor" ]; then
+        print_error "Last page returned next_cursor ${cursor}"
+        return 1
+    fi
+    local unique
+    unique=$(printf '%s\n' "${seen[@]}" | sort -u | wc -l)
+    if [ "${#seen[@]}" -ne 5 ] || [ "$unique" -ne 5 ]; then
+        print_error "Expected 5 distinct items across pages, got ${#seen[@]} (${unique} distinct)"
+        return 1
+    fi
+    print_success "Pages cover every item once and the last page has no cursor"
+
+    # A page size equal to what is left ends the listing instead of promising an empty page
+    response=$(list_gallery "categories=4&limit=5")
+    if ! check_response "$response"; then
+        return 1
+    fi
+    has_more=$(echo "$response" | jq -r '.data.has_more // false')
+    if [ "$has_more" != "false" ]; then
+        print_error "limit equal to the item count reported has_more"
+        return 1
+    fi
+    response=$(list_gallery "categories=4&limit=4")
+    cursor=$(echo "$response" | jq -r '.data.next_cursor // empty')
+    response=$(list_gallery "categories=4&limit=4&cursor=${cursor}")
+    if ! check_response "$response"; then
+        return 1
+    fi
+    if [ "$(echo "$response" | jq -r '.data.items // [] | length')" -ne 1 ] || \
+       [ "$(echo "$response" | jq -r '.data.items[0].message_id')" != "${GALLERY_MESSAGE_IDS[0]}" ]; then
+        print_error "Page after the boundary cursor should hold only the oldest link"
+        return 1
+    fi
+    print_success "Boundary cursor continues right after the last item"
+
+    response=$(list_gallery "cursor=not-a-cursor")
+    print_info "Invalid cursor response: $response"
+    if ! check_response_fail "$response"; then
+        return 1
+    fi
+    print_success "Malformed cursor rejected"
+    return 0
+}
+
+test_media_gallery_recalled_excluded() {
+    print_header "18. Recalled Message Drops Out of the Gallery"
+
+    if [ ${#GALLERY_MESSAGE_IDS[@]} -eq 0 ]; then
+        print_skip "No gallery messages available, skipping"
+        return 0
+    fi
+
+    local recalled=${GALLERY_MESSAGE_IDS[2]}
+    local response
+    response=$(http_post "${API_BASE}/messages/recall" "{\"message_id\": \"${recalled}\"}" "$USER1_TOKEN")
+    print_info "Recall response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+
+    response=$(list_gallery "categories=4")
+    if ! check_response "$response"; then
+        return 1
+    fi
+    local count
+    count=$(echo "$response" | jq -r '.data.items // [] | length')
+    if echo "$response" | jq -e --arg id "$recalled" '.data.items[]? | select(.message_id == $id)' &>/dev/null; then
+        print_error "Recalled message still listed"
+        return 1
+    fi
+    if [ "$count" -ne 4 ]; then
+        print_error "Expected 4 items after recall, got ${count}"
+        return 1
+    fi
+    print_success "Recalled link no longer listed"
+    return 0
+}
+
 # ────────────────────────────────────────
 # Main function
 # ────────────────────────────────────────
@@ -578,6 +844,12 @@ main() {
     test_delete_conversation             || ((failed++))
     test_get_conversations_after_delete  || ((failed++))
 
+    # Media gallery
+    test_media_gallery_links             || ((failed++))
+    test_media_gallery_category_filter   || ((failed++))
+    test_media_gallery_cursor_pagination || ((failed++))
+    test_media_gallery_recalled_excluded || ((failed++))
+
     # Output results
     echo ""
     echo -e "${YELLOW}========================================${NC}"
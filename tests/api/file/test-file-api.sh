@@ -16,6 +16,19 @@ NC='\033[0m' # No Color
 GATEWAY_URL="${GATEWAY_URL:-http://localhost:8080}"
 API_BASE="${GATEWAY_URL}/api/v1"
 
+# Lifecycle timing, set the same variables for file-service so the worker acts within the run
+FILE_LIFECYCLE_INTERVAL_SECONDS="${FILE_LIFECYCLE_INTERVAL_SECONDS:-60}"
+FILE_PENDING_TTL_SECONDS="${FILE_PENDING_TTL_SECONDS:-86400}"
+FILE_DELETION_RETRY_BACKOFF_SECONDS="${FILE_DELETION_RETRY_BACKOFF_SECONDS:-30}"
+# expires_hours is in real hours, the expiry test waits an hour and only runs when set to 1
+FILE_TEST_EXPIRY="${FILE_TEST_EXPIRY:-0}"
+# Docker container of MinIO, the deletion retry test stops it while deleting a file
+FILE_TEST_MINIO_CONTAINER="${FILE_TEST_MINIO_CONTAINER:-}"
+# Admin backend for the quarantine review tests
+ADMIN_URL="${ADMIN_URL:-http://localhost:8011}"
+ADMIN_USERNAME="${ADMIN_USERNAME:-admin}"
+ADMIN_PASSWORD="${ADMIN_PASSWORD:-Admin@123456}"
+
 # Test data
 TIMESTAMP=$(date +%s)
 TEST_EMAIL="filetest_${TIMESTAMP}@example.com"
@@ -28,6 +41,14 @@ USER_ID=""
 FILE_ID=""
 UPLOAD_URL=""
 DOWNLOAD_URL=""
+UPLOAD_ID=""
+PEER_USER_ID=""
+PEER_USER_TOKEN=""
+PEER_CONVERSATION_ID=""
+UPLOADED_FILE_ID=""
+LAST_RESPONSE=""
+QUARANTINED_FILE_ID=""
+ADMIN_TOKEN=""
 
 # Print functions
 print_header() {
@@ -78,6 +99,17 @@ http_get() {
     fi
 }
 
+http_put() {
+    local url=$1
+    local data=$2
+    local token=$3
+
+    curl -s -X PUT "${url}" \
+        -H "Content-Type: application/json" \
+        -H "Authorization: Bearer ${token}" \
+        -d "${data}"
+}
+
 http_delete() {
     local url=$1
     local token=$2
@@ -100,6 +132,237 @@ check_response() {
     fi
 }
 
+# Response code field, -1 when missing
+json_code() {
+    echo "$1" | jq -r '.code // -1'
+}
+
+# PUT a local file to a presigned upload URL, prints the HTTP status
+put_object() {
+    local url=$1
+    local path=$2
+    local content_type=$3
+
+    curl -s -o /dev/null -w "%{http_code}" -X PUT "$url" \
+        -H "Content-Type: ${content_type}" \
+        --data-binary "@${path}" \
+        --max-time 30
+}
+
+# SHA-256 of a local file in lowercase hex
+file_sha256() {
+    if command -v sha256sum &> /dev/null; then
+        sha256sum "$1" | awk '{print $1}'
+    else
+        shasum -a 256 "$1" | awk '{print $1}'
+    fi
+}
+
+# Upload a local file and complete the upload, sets UPLOADED_FILE_ID and LAST_RESPONSE (the complete response,
+# or the upload token response for an instant upload)
+upload_file() {
+    local path=$1
+    local file_name=$2
+    local mime_type=$3
+    local file_type=$4
+    local token=${5:-$USER_TOKEN}
+    local sha256=$6
+
+    local size=$(wc -c < "$path" | tr -d ' ')
+    local hash_field=""
+    if [ -n "$sha256" ]; then
+        hash_field=", \"sha256\": \"${sha256}\""
+    fi
+    local data="{\"file_name\": \"${file_name}\", \"file_size\": ${size}, \"mime_type\": \"${mime_type}\", \"file_type\": ${file_type}${hash_field}}"
+    LAST_RESPONSE=$(http_post "${API_BASE}/files/upload-token" "$data" "$token")
+    if ! check_response "$LAST_RESPONSE"; then
+        return 1
+    fi
+    UPLOADED_FILE_ID=$(echo "$LAST_RESPONSE" | jq -r '.data.file_id')
+    if [ "$(echo "$LAST_RESPONSE" | jq -r '.data.instant_upload // false')" = "true" ]; then
+        return 0
+    fi
+
+    local upload_url=$(echo "$LAST_RESPONSE" | jq -r '.data.upload_url')
+    local http_code=$(put_object "$upload_url" "$path" "$mime_type")
+    if [ "$http_code" != "200" ]; then
+        print_error "Upload to MinIO failed (HTTP $http_code)"
+        return 1
+    fi
+
+    LAST_RESPONSE=$(http_post "${API_BASE}/files/${UPLOADED_FILE_ID}/complete" "{}" "$token")
+    check_response "$LAST_RESPONSE"
+}
+
+# 320x240 JPEG whose EXIF block carries the camera make "AnyChatTestCam"
+TEST_JPEG_BASE64="
+/9j/4QAxRXhpZgAASUkqAAgAAAABAA8BAgAPAAAAGgAAAAAAAABBbnlDaGF0VGVzdENhbQD/2wCE
+ABsSFBcUERsXFhceHBsgKEIrKCUlKFE6PTBCYFVlZF9VXVtqeJmBanGQc1tdhbWGkJ6jq62rZ4C8
+ybqmx5moq6QBHB4eKCMoTisrTqRuXW6kpKSkpKSkpKSkpKSkpKSkpKSkpKSkpKSkpKSkpKSkpKSk
+pKSkpKSkpKSkpKSkpKSkpP/AABEIAPABQAMBIgACEQEDEQH/xAGiAAABBQEBAQEBAQAAAAAAAAAA
+AQIDBAUGBwgJCgsQAAIBAwMCBAMFBQQEAAABfQECAwAEEQUSITFBBhNRYQcicRQygZGhCCNCscEV
+UtHwJDNicoIJChYXGBkaJSYnKCkqNDU2Nzg5OkNERUZHSElKU1RVVldYWVpjZGVmZ2hpanN0dXZ3
+eHl6g4SFhoeIiYqSk5SVlpeYmZqio6Slpqeoqaqys7S1tre4ubrCw8TFxsfIycrS09TV1tfY2drh
+4uPk5ebn6Onq8fLz9PX29/j5+gEAAwEBAQEBAQEBAQAAAAAAAAECAwQFBgcICQoLEQACAQIEBAME
+BwUEBAABAncAAQIDEQQFITEGEkFRB2FxEyIygQgUQpGhscEJIzNS8BVictEKFiQ04SXxFxgZGiYn
+KCkqNTY3ODk6Q0RFRkdISUpTVFVWV1hZWmNkZWZnaGlqc3R1dnd4eXqCg4SFhoeIiYqSk5SVlpeY
+mZqio6Slpqeoqaqys7S1tre4ubrCw8TFxsfIycrS09TV1tfY2dri4+Tl5ufo6ery8/T19vf4+fr/
+2gAMAwEAAhEDEQA/AIKKKK7ziCiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACii
+igAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKK
+ACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooA
+KKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAo
+oooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACii
+igAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKK
+ACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooA
+KKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAo
+oooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACii
+igAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKK
+ACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooA
+KKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAo
+oooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACii
+igAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKK
+ACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooA
+KKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAo
+oooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACii
+igAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKK
+ACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooA
+KKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAo
+oooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACiiigAooooAKKKKACii
+igAooooAKKKKACiiigAooooA/9k=
+"
+
+# Write the test JPEG to a path
+make_test_jpeg() {
+    echo "$TEST_JPEG_BASE64" | base64 --decode > "$1"
+}
+
+# Wait until a file reaches the expected status, uploads stay processing (2) while the malware scan runs
+wait_file_status() {
+    local file_id=$1
+    local expected=$2
+    local token=${3:-$USER_TOKEN}
+
+    local i
+    for i in $(seq 1 "${FILE_WAIT_SECONDS:-30}"); do
+        local status=$(http_get "${API_BASE}/files/${file_id}" "$token" | jq -r '.data.status // empty')
+        if [ "$status" = "$expected" ]; then
+            return 0
+        fi
+        sleep 1
+    done
+    return 1
+}
+
+# Register a second user and make them friends with the test user, sets PEER_USER_* and
+# PEER_CONVERSATION_ID (the test user's single conversation with the peer)
+setup_peer_user() {
+    if [ -n "$PEER_CONVERSATION_ID" ]; then
+        return 0
+    fi
+
+    local data="{\"email\": \"filepeer_${TIMESTAMP}@example.com\", \"password\": \"${TEST_PASSWORD}\", \"verify_code\": \"123456\", \"nickname\": \"FilePeer_${TIMESTAMP}\", \"device_type\": 1, \"device_id\": \"${TEST_DEVICE_ID}-peer\", \"client_version\": \"1.0.0\"}"
+    local response=$(http_post "${API_BASE}/auth/register" "$data")
+    if ! check_response "$response"; then
+        print_error "Peer user registration failed"
+        return 1
+    fi
+    PEER_USER_ID=$(echo "$response" | jq -r '.data.user_id // .data.userId // empty')
+    PEER_USER_TOKEN=$(echo "$response" | jq -r '.data.access_token // .data.accessToken // empty')
+
+    response=$(http_post "${API_BASE}/friends/requests" "{\"user_id\": \"${PEER_USER_ID}\", \"message\": \"file test\", \"source\": 1}" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Send friend request failed"
+        return 1
+    fi
+    local request_id=$(echo "$response" | jq -r '.data.requestId // .data.request_id // empty')
+    local auto_accepted=$(echo "$response" | jq -r '.data.autoAccepted // .data.auto_accepted // false')
+    if [ "$auto_accepted" != "true" ]; then
+        response=$(http_put "${API_BASE}/friends/requests/${request_id}" '{"action": 1}' "$PEER_USER_TOKEN")
+        if ! check_response "$response"; then
+            print_error "Accept friend request failed"
+            return 1
+        fi
+    fi
+
+    local i
+    for i in $(seq 1 5); do
+        response=$(http_get "${API_BASE}/conversations?limit=100" "$USER_TOKEN")
+        PEER_CONVERSATION_ID=$(echo "$response" | jq -r --arg uid "$PEER_USER_ID" '
+            .data.conversations[]? |
+            select((.conversationType // .conversation_type) == 1 and (.targetId // .target_id) == $uid) |
+            (.conversationId // .conversation_id)
+        ' | head -n 1)
+        if [ -n "$PEER_CONVERSATION_ID" ] && [ "$PEER_CONVERSATION_ID" != "null" ]; then
+            print_info "Peer user ${PEER_USER_ID}, conversation ${PEER_CONVERSATION_ID}"
+            return 0
+        fi
+        sleep 1
+    done
+    PEER_CONVERSATION_ID=""
+    print_error "Single conversation with the peer user not found"
+    return 1
+}
+
+# Send a file message to the peer, prints the message ID
+send_file_message() {
+    local file_id=$1
+    local content_type=${2:-5}
+
+    local content="{\\\"file_id\\\":\\\"${file_id}\\\"}"
+    local data="{\"conversation_id\": \"${PEER_CONVERSATION_ID}\", \"content_type\": ${content_type}, \"content\": \"${content}\", \"local_id\": \"local-file-${file_id}\"}"
+    http_post "${API_BASE}/messages" "$data" "$USER_TOKEN" | jq -r '.data.message_id // .data.messageId // empty'
+}
+
+# EICAR antivirus test string, flagged by the fake scanner and by clamd
+EICAR_TEST_STRING='X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*'
+
+# Upload the EICAR test file and wait for the scan, sets UPLOADED_FILE_ID. Returns 2 when the file was
+# activated instead of quarantined, i.e. scanning is disabled
+upload_eicar_file() {
+    local temp_file=$(mktemp /tmp/test-eicar-XXXXXX.txt)
+    printf '%s' "$EICAR_TEST_STRING" > "$temp_file"
+    upload_file "$temp_file" "eicar-${TIMESTAMP}-${RANDOM}.txt" "text/plain" 4
+    local uploaded=$?
+    rm -f "$temp_file"
+    if [ $uploaded -ne 0 ]; then
+        return 1
+    fi
+
+    local i
+    for i in $(seq 1 "${FILE_WAIT_SECONDS:-30}"); do
+        local status=$(http_get "${API_BASE}/files/${UPLOADED_FILE_ID}" "$USER_TOKEN" | jq -r '.data.status // empty')
+        case "$status" in
+            3) return 0 ;;
+            1) return 2 ;;
+        esac
+        sleep 1
+    done
+    return 1
+}
+
+# Sign in to the admin backend once, sets ADMIN_TOKEN
+admin_login() {
+    if [ -n "$ADMIN_TOKEN" ]; then
+        return 0
+    fi
+    local response=$(http_post "${ADMIN_URL}/api/admin/auth/login" "{\"username\": \"${ADMIN_USERNAME}\", \"password\": \"${ADMIN_PASSWORD}\"}")
+    ADMIN_TOKEN=$(echo "$response" | jq -r '.data.token // .token // empty' 2>/dev/null)
+    [ -n "$ADMIN_TOKEN" ]
+}
+
+# Call the admin backend, which answers with real HTTP status codes. Outputs the body, then the status code
+# on the last line
+admin_request() {
+    local method=$1
+    local path=$2
+    local data=${3:-}
+
+    curl -s -w "\n%{http_code}" -X "$method" "${ADMIN_URL}/api/admin${path}" \
+        -H "Content-Type: application/json" \
+        -H "Authorization: Bearer ${ADMIN_TOKEN}" \
+        ${data:+-d "$data"}
+}
+
 # ========================================
 # Setup: Create test user
 # ========================================
@@ -153,10 +416,12 @@ test_health_check() {
 test_generate_upload_token() {
     print_header "Test 2: Generate Upload Token"
 
+    # the declared size and type must match the uploaded content
+    local file_size=$(echo "$TEST_JPEG_BASE64" | base64 --decode | wc -c | tr -d ' ')
     local data=$(cat <<EOF
 {
     "file_name": "test-image-${TIMESTAMP}.jpg",
-    "file_size": 102400,
+    "file_size": ${file_size},
     "mime_type": "image/jpeg",
     "file_type": 1,
     "expires_hours": 0
@@ -205,9 +470,9 @@ test_upload_to_minio() {
 
     # Create a temp test file
     local temp_file=$(mktemp /tmp/test-file-XXXXXX.jpg)
-    dd if=/dev/zero of="$temp_file" bs=1024 count=100 2>/dev/null
+    make_test_jpeg "$temp_file"
 
-    print_info "Created temp test file: $temp_file (100KB)"
+    print_info "Created temp test file: $temp_file ($(wc -c < "$temp_file" | tr -d ' ') bytes)"
 
     # Upload to MinIO presigned URL (with verbose output)
     print_info "Uploading file to MinIO..."
@@ -369,6 +634,788 @@ test_delete_file() {
     fi
 }
 
+# Upload one part of a multipart upload, prints the HTTP status
+upload_part() {
+    local part_number=$1
+    local size_kb=$2
+
+    local response=$(http_post "${API_BASE}/files/multipart/${UPLOAD_ID}/part-urls" "{\"part_numbers\": [${part_number}]}" "$USER_TOKEN")
+    local part_url=$(echo "$response" | jq -r '.data.parts[0].upload_url // empty')
+    if [ -z "$part_url" ]; then
+        echo "000"
+        return
+    fi
+
+    local temp_file=$(mktemp /tmp/test-part-XXXXXX)
+    dd if=/dev/urandom of="$temp_file" bs=1024 count="$size_kb" 2>/dev/null
+    curl -s -o /dev/null -w "%{http_code}" -X PUT "$part_url" --data-binary "@$temp_file" --max-time 60
+    rm -f "$temp_file"
+}
+
+# Test 9: Multipart upload with resume
+test_multipart_upload() {
+    print_header "Test 9: Multipart Upload"
+
+    # 6MB file in 5MB parts: part 1 is 5MB, part 2 is 1MB. The parts are random bytes, so the file is
+    # declared as a generic file to pass the content type check
+    local data=$(cat <<EOF
+{
+    "file_name": "test-archive-${TIMESTAMP}.bin",
+    "file_size": 6291456,
+    "mime_type": "application/octet-stream",
+    "file_type": 4,
+    "part_size": 5242880
+}
+EOF
+)
+
+    print_info "Starting multipart upload..."
+    local response=$(http_post "${API_BASE}/files/multipart" "$data" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Initiate multipart upload failed"
+        return 1
+    fi
+    UPLOAD_ID=$(echo "$response" | jq -r '.data.upload_id')
+    local file_id=$(echo "$response" | jq -r '.data.file_id')
+    local total_parts=$(echo "$response" | jq -r '.data.total_parts')
+    print_success "Multipart upload started (upload: ${UPLOAD_ID}, parts: ${total_parts})"
+    if [ "$total_parts" != "2" ]; then
+        print_error "Expected 2 parts, got ${total_parts}"
+        return 1
+    fi
+
+    print_info "Uploading part 1..."
+    local http_code=$(upload_part 1 5120)
+    if [ "$http_code" != "200" ]; then
+        print_error "Upload part 1 failed (HTTP $http_code)"
+        return 1
+    fi
+    print_success "Part 1 uploaded"
+
+    print_info "Completing with a missing part (should fail)..."
+    response=$(http_post "${API_BASE}/files/multipart/${UPLOAD_ID}/complete" "{}" "$USER_TOKEN")
+    local code=$(echo "$response" | jq -r '.code // -1')
+    if [ "$code" = "0" ]; then
+        print_error "Complete should fail while part 2 is missing"
+        return 1
+    fi
+    print_success "Incomplete upload rejected (code: ${code})"
+
+    print_info "Listing uploaded parts to resume..."
+    response=$(http_get "${API_BASE}/files/multipart/${UPLOAD_ID}/parts" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "List uploaded parts failed"
+        return 1
+    fi
+    local uploaded=$(echo "$response" | jq -r '[.data.parts[].part_number] | join(",")')
+    print_info "Uploaded parts: ${uploaded}, bytes: $(echo "$response" | jq -r '.data.uploaded_bytes')"
+    if [ "$uploaded" != "1" ]; then
+        print_error "Expected part 1 only"
+        return 1
+    fi
+
+    print_info "Uploading missing part 2..."
+    http_code=$(upload_part 2 1024)
+    if [ "$http_code" != "200" ]; then
+        print_error "Upload part 2 failed (HTTP $http_code)"
+        return 1
+    fi
+
+    response=$(http_post "${API_BASE}/files/multipart/${UPLOAD_ID}/complete" "{}" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Complete multipart upload failed"
+        return 1
+    fi
+    local status=$(echo "$response" | jq -r '.data.status')
+    local file_size=$(echo "$response" | jq -r '.data.file_size')
+    print_success "Multipart upload completed (file: ${file_id}, size: ${file_size}, status: ${status})"
+
+    print_info "Completing again (should return the same file)..."
+    response=$(http_post "${API_BASE}/files/multipart/${UPLOAD_ID}/complete" "{}" "$USER_TOKEN")
+    if check_response "$response" && [ "$(echo "$response" | jq -r '.data.file_id')" = "$file_id" ]; then
+        print_success "Repeated complete returned the file"
+    else
+        print_error "Repeated complete failed"
+        return 1
+    fi
+}
+
+# Test 10: Abort multipart upload
+test_abort_multipart_upload() {
+    print_header "Test 10: Abort Multipart Upload"
+
+    local data=$(cat <<EOF
+{
+    "file_name": "test-abort-${TIMESTAMP}.bin",
+    "file_size": 10485760,
+    "mime_type": "application/octet-stream",
+    "file_type": 4
+}
+EOF
+)
+
+    local response=$(http_post "${API_BASE}/files/multipart" "$data" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Initiate multipart upload failed"
+        return 1
+    fi
+    UPLOAD_ID=$(echo "$response" | jq -r '.data.upload_id')
+    print_info "Upload ID: ${UPLOAD_ID}, part size: $(echo "$response" | jq -r '.data.part_size')"
+
+    response=$(http_delete "${API_BASE}/files/multipart/${UPLOAD_ID}" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Abort multipart upload failed"
+        return 1
+    fi
+    print_success "Multipart upload aborted"
+
+    print_info "Requesting part URLs after abort (should fail)..."
+    response=$(http_post "${API_BASE}/files/multipart/${UPLOAD_ID}/part-urls" '{"part_numbers": [1]}' "$USER_TOKEN")
+    local code=$(echo "$response" | jq -r '.code // -1')
+    if [ "$code" = "0" ]; then
+        print_error "Aborted upload still accepts parts"
+        return 1
+    fi
+    print_success "Aborted upload rejected (code: ${code})"
+}
+
+# Test 11: Files sent in a conversation are readable by its participants until the message is recalled
+test_conversation_file_access() {
+    print_header "Test 11: Conversation File Access"
+
+    setup_peer_user || return 1
+
+    local temp_file=$(mktemp /tmp/test-share-XXXXXX.txt)
+    echo "shared file ${TIMESTAMP}" > "$temp_file"
+    upload_file "$temp_file" "share-${TIMESTAMP}.txt" "text/plain" 4
+    local uploaded=$?
+    rm -f "$temp_file"
+    if [ $uploaded -ne 0 ]; then
+        print_error "Upload shared file failed"
+        return 1
+    fi
+    local file_id=$UPLOADED_FILE_ID
+    if ! wait_file_status "$file_id" 1; then
+        print_error "File did not become active"
+        return 1
+    fi
+
+    print_info "Peer reads the file before it is sent (should fail)..."
+    local response=$(http_get "${API_BASE}/files/${file_id}" "$PEER_USER_TOKEN")
+    if [ "$(json_code "$response")" != "403" ]; then
+        print_error "Expected 403 before sending, got $(json_code "$response")"
+        return 1
+    fi
+    print_success "Unshared file denied to the peer"
+
+    local message_id=$(send_file_message "$file_id")
+    if [ -z "$message_id" ]; then
+        print_error "Send file message failed"
+        return 1
+    fi
+    print_info "File message: ${message_id}"
+
+    response=$(http_get "${API_BASE}/files/${file_id}/download?expiresMinutes=5" "$PEER_USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Peer cannot download the sent file"
+        return 1
+    fi
+    print_success "Peer downloads the sent file"
+
+    response=$(http_post "${API_BASE}/messages/recall" "{\"message_id\": \"${message_id}\"}" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Recall message failed"
+        return 1
+    fi
+
+    response=$(http_get "${API_BASE}/files/${file_id}/download?expiresMinutes=5" "$PEER_USER_TOKEN")
+    if [ "$(json_code "$response")" != "403" ]; then
+        print_error "Expected 403 after recall, got $(json_code "$response")"
+        return 1
+    fi
+    print_success "Access revoked after recall"
+
+    response=$(http_get "${API_BASE}/files/${file_id}/download?expiresMinutes=5" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Uploader lost access after recall"
+        return 1
+    fi
+    print_success "Uploader keeps access"
+}
+
+# Test 12: Image processing records dimensions, generates thumbnails and strips EXIF
+test_media_processing() {
+    print_header "Test 12: Media Processing"
+
+    local temp_file=$(mktemp /tmp/test-photo-XXXXXX.jpg)
+    make_test_jpeg "$temp_file"
+    local original_size=$(wc -c < "$temp_file" | tr -d ' ')
+    upload_file "$temp_file" "photo-${TIMESTAMP}.jpg" "image/jpeg" 1
+    local uploaded=$?
+    rm -f "$temp_file"
+    if [ $uploaded -ne 0 ]; then
+        print_error "Upload photo failed"
+        return 1
+    fi
+    local file_id=$UPLOADED_FILE_ID
+
+    print_info "Waiting for thumbnails and metadata..."
+    local response=""
+    local i
+    for i in $(seq 1 "${FILE_WAIT_SECONDS:-30}"); do
+        response=$(http_get "${API_BASE}/files/${file_id}" "$USER_TOKEN")
+        if [ -n "$(echo "$response" | jq -r '.data.thumbnail_path // empty')" ]; then
+            break
+        fi
+        sleep 1
+    done
+
+    local width=$(echo "$response" | jq -r '.data.metadata.width // empty')
+    local height=$(echo "$response" | jq -r '.data.metadata.height // empty')
+    if [ "$width" != "320" ] || [ "$height" != "240" ]; then
+        print_error "Expected 320x240 metadata, got ${width}x${height}"
+        print_info "Response: $response"
+        return 1
+    fi
+    print_success "Metadata recorded (${width}x${height}, $(echo "$response" | jq -r '.data.metadata.format // empty'))"
+
+    local file_size=$(echo "$response" | jq -r '.data.file_size')
+    if [ "$file_size" -ge "$original_size" ]; then
+        print_error "File size not reduced after stripping EXIF (${file_size} >= ${original_size})"
+        return 1
+    fi
+
+    response=$(http_get "${API_BASE}/files/${file_id}/download?expiresMinutes=5" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Generate download URL failed"
+        return 1
+    fi
+    # 320px wide images only get the 160 thumbnail, larger sizes are skipped
+    local sizes=$(echo "$response" | jq -r '[.data.thumbnails[]?.size] | join(",")')
+    if [ "$sizes" != "160" ] || [ -z "$(echo "$response" | jq -r '.data.thumbnail_url // empty')" ]; then
+        print_error "Expected a single 160 thumbnail, got [${sizes}]"
+        return 1
+    fi
+    print_success "Thumbnail generated (sizes: ${sizes})"
+
+    local download_url=$(echo "$response" | jq -r '.data.download_url')
+    if curl -s --max-time 30 "$download_url" | grep -qa "AnyChatTestCam"; then
+        print_error "EXIF still present in the stored image"
+        return 1
+    fi
+    print_success "EXIF stripped (${original_size} -> ${file_size} bytes)"
+}
+
+# Test 13: Instant upload of stored content creates a new file, the object lives until its last reference is deleted
+test_instant_upload() {
+    print_header "Test 13: Dedup Instant Upload"
+
+    setup_peer_user || return 1
+
+    local temp_file=$(mktemp /tmp/test-dedup-XXXXXX.txt)
+    echo "dedup content ${TIMESTAMP} ${RANDOM}" > "$temp_file"
+    local sha256=$(file_sha256 "$temp_file")
+
+    upload_file "$temp_file" "dedup-${TIMESTAMP}.txt" "text/plain" 4 "$USER_TOKEN" "$sha256"
+    local uploaded=$?
+    if [ $uploaded -ne 0 ] || ! wait_file_status "$UPLOADED_FILE_ID" 1; then
+        rm -f "$temp_file"
+        print_error "First upload failed"
+        return 1
+    fi
+    local first_id=$UPLOADED_FILE_ID
+
+    print_info "Peer uploads the same content..."
+    upload_file "$temp_file" "dedup-copy-${TIMESTAMP}.txt" "text/plain" 4 "$PEER_USER_TOKEN" "$sha256"
+    uploaded=$?
+    rm -f "$temp_file"
+    if [ $uploaded -ne 0 ]; then
+        print_error "Second upload failed"
+        return 1
+    fi
+    local second_id=$UPLOADED_FILE_ID
+    if [ "$(echo "$LAST_RESPONSE" | jq -r '.data.instant_upload // false')" != "true" ]; then
+        print_error "Expected instant_upload for stored content"
+        print_info "Response: $LAST_RESPONSE"
+        return 1
+    fi
+    if [ "$second_id" = "$first_id" ] || [ -n "$(echo "$LAST_RESPONSE" | jq -r '.data.upload_url // empty')" ]; then
+        print_error "Instant upload must return a new file_id and no upload_url"
+        return 1
+    fi
+    print_success "Instant upload returned new file ${second_id}"
+
+    local response=$(http_get "${API_BASE}/files/${second_id}/download?expiresMinutes=5" "$PEER_USER_TOKEN")
+    local download_url=$(echo "$response" | jq -r '.data.download_url // empty')
+    if [ -z "$download_url" ]; then
+        print_error "Generate download URL for the instant upload failed"
+        return 1
+    fi
+
+    response=$(http_delete "${API_BASE}/files/${first_id}" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Delete first file failed"
+        return 1
+    fi
+    local http_code=$(curl -s -o /dev/null -w "%{http_code}" --max-time 30 "$download_url")
+    if [ "$http_code" != "200" ]; then
+        print_error "Object removed while still referenced (HTTP $http_code)"
+        return 1
+    fi
+    print_success "Object kept after deleting one reference"
+
+    response=$(http_delete "${API_BASE}/files/${second_id}" "$PEER_USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Delete second file failed"
+        return 1
+    fi
+    http_code=$(curl -s -o /dev/null -w "%{http_code}" --max-time 30 "$download_url")
+    if [ "$http_code" != "404" ]; then
+        print_error "Expected object removed after the last reference (HTTP $http_code)"
+        return 1
+    fi
+    print_success "Object removed with the last reference"
+}
+
+# Test 14: Completing an upload whose content does not match the declared sha256 is rejected
+test_hash_mismatch() {
+    print_header "Test 14: Hash Mismatch"
+
+    local declared_file=$(mktemp /tmp/test-hash-XXXXXX.txt)
+    local other_file=$(mktemp /tmp/test-hash-XXXXXX.txt)
+    echo "declared ${TIMESTAMP} ${RANDOM}" > "$declared_file"
+    tr 'a-z' 'A-Z' < "$declared_file" > "$other_file"
+    local sha256=$(file_sha256 "$declared_file")
+    local size=$(wc -c < "$declared_file" | tr -d ' ')
+
+    local data="{\"file_name\": \"hash-${TIMESTAMP}.txt\", \"file_size\": ${size}, \"mime_type\": \"text/plain\", \"file_type\": 4, \"sha256\": \"${sha256}\"}"
+    local response=$(http_post "${API_BASE}/files/upload-token" "$data" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        rm -f "$declared_file" "$other_file"
+        print_error "Generate upload token failed"
+        return 1
+    fi
+    local file_id=$(echo "$response" | jq -r '.data.file_id')
+    local upload_url=$(echo "$response" | jq -r '.data.upload_url')
+
+    print_info "Uploading content of the same size but a different hash..."
+    put_object "$upload_url" "$other_file" "text/plain" > /dev/null
+    response=$(http_post "${API_BASE}/files/${file_id}/complete" "{}" "$USER_TOKEN")
+    if [ "$(json_code "$response")" != "400" ] || ! echo "$response" | jq -r '.message' | grep -q "sha256"; then
+        rm -f "$declared_file" "$other_file"
+        print_error "Expected hash mismatch rejection, got: $response"
+        return 1
+    fi
+    print_success "Hash mismatch rejected"
+
+    print_info "Re-uploading the declared content and completing again..."
+    put_object "$upload_url" "$declared_file" "text/plain" > /dev/null
+    rm -f "$declared_file" "$other_file"
+    response=$(http_post "${API_BASE}/files/${file_id}/complete" "{}" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Complete after re-upload failed"
+        return 1
+    fi
+    # let the scan finish so later usage checks are not affected
+    wait_file_status "$file_id" 1 || true
+    print_success "Upload completed after re-uploading matching content"
+}
+
+# Test 15: Uploads are rejected when the size or the sniffed content type does not match the declaration
+test_content_verification() {
+    print_header "Test 15: Size and Content Type Verification"
+
+    print_info "Requesting an image above the size limit (should fail)..."
+    local data="{\"file_name\": \"huge-${TIMESTAMP}.jpg\", \"file_size\": 20971520, \"mime_type\": \"image/jpeg\", \"file_type\": 1}"
+    local response=$(http_post "${API_BASE}/files/upload-token" "$data" "$USER_TOKEN")
+    if [ "$(json_code "$response")" != "400" ]; then
+        print_error "Expected 400 for an oversized image, got: $response"
+        return 1
+    fi
+    print_success "Oversized image rejected"
+
+    local temp_file=$(mktemp /tmp/test-fake-XXXXXX.jpg)
+    echo "this is plain text, not a jpeg ${TIMESTAMP}" > "$temp_file"
+    local size=$(wc -c < "$temp_file" | tr -d ' ')
+
+    print_info "Uploading more bytes than declared (should be refused by storage)..."
+    data="{\"file_name\": \"short-${TIMESTAMP}.txt\", \"file_size\": $((size - 1)), \"mime_type\": \"text/plain\", \"file_type\": 4}"
+    response=$(http_post "${API_BASE}/files/upload-token" "$data" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        rm -f "$temp_file"
+        return 1
+    fi
+    local http_code=$(put_object "$(echo "$response" | jq -r '.data.upload_url')" "$temp_file" "text/plain")
+    if [ "$http_code" = "200" ]; then
+        rm -f "$temp_file"
+        print_error "Storage accepted a body larger than the signed size"
+        return 1
+    fi
+    print_success "Size mismatch refused by storage (HTTP $http_code)"
+
+    print_info "Uploading text declared as a JPEG (should fail on complete)..."
+    upload_file "$temp_file" "fake-${TIMESTAMP}.jpg" "image/jpeg" 1 > /dev/null
+    rm -f "$temp_file"
+    local file_id=$UPLOADED_FILE_ID
+    if [ "$(json_code "$LAST_RESPONSE")" != "400" ]; then
+        print_error "Expected 400 for mismatching content, got: $LAST_RESPONSE"
+        return 1
+    fi
+    response=$(http_get "${API_BASE}/files/${file_id}" "$USER_TOKEN")
+    if [ "$(json_code "$response")" != "404" ]; then
+        print_error "Rejected upload still exists: $response"
+        return 1
+    fi
+    print_success "Mismatching content rejected and discarded ($(echo "$LAST_RESPONSE" | jq -r '.message'))"
+
+    print_info "Completing a multipart video made of random bytes (should fail)..."
+    data="{\"file_name\": \"fake-${TIMESTAMP}.mp4\", \"file_size\": 1048576, \"mime_type\": \"video/mp4\", \"file_type\": 2}"
+    response=$(http_post "${API_BASE}/files/multipart" "$data" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        return 1
+    fi
+    UPLOAD_ID=$(echo "$response" | jq -r '.data.upload_id')
+    file_id=$(echo "$response" | jq -r '.data.file_id')
+    http_code=$(upload_part 1 1024)
+    if [ "$http_code" != "200" ]; then
+        print_error "Upload part failed (HTTP $http_code)"
+        return 1
+    fi
+    response=$(http_post "${API_BASE}/files/multipart/${UPLOAD_ID}/complete" "{}" "$USER_TOKEN")
+    if [ "$(json_code "$response")" != "400" ]; then
+        print_error "Expected 400 for a multipart upload with mismatching content, got: $response"
+        return 1
+    fi
+    response=$(http_get "${API_BASE}/files/${file_id}" "$USER_TOKEN")
+    if [ "$(json_code "$response")" != "404" ]; then
+        print_error "Rejected multipart file still exists: $response"
+        return 1
+    fi
+    print_success "Multipart upload with mismatching content rejected"
+}
+
+# Test 16: Storage usage counts active files and releases them on delete
+test_storage_usage() {
+    print_header "Test 16: Storage Usage"
+
+    local response=$(http_get "${API_BASE}/files/storage-usage" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        print_error "Get storage usage failed"
+        return 1
+    fi
+    local used_before=$(echo "$response" | jq -r '.data.used_bytes // 0')
+    local count_before=$(echo "$response" | jq -r '.data.file_count // 0')
+    print_info "Used: ${used_before} bytes, files: ${count_before}, quota: $(echo "$response" | jq -r '.data.quota_bytes // 0') ($(echo "$response" | jq -r '.data.tier // empty'))"
+
+    local temp_file=$(mktemp /tmp/test-usage-XXXXXX.txt)
+    echo "usage ${TIMESTAMP} ${RANDOM}" > "$temp_file"
+    local size=$(wc -c < "$temp_file" | tr -d ' ')
+    upload_file "$temp_file" "usage-${TIMESTAMP}.txt" "text/plain" 4
+    local uploaded=$?
+    rm -f "$temp_file"
+    if [ $uploaded -ne 0 ] || ! wait_file_status "$UPLOADED_FILE_ID" 1; then
+        print_error "Upload failed"
+        return 1
+    fi
+    local file_id=$UPLOADED_FILE_ID
+
+    response=$(http_get "${API_BASE}/files/storage-usage" "$USER_TOKEN")
+    local used=$(echo "$response" | jq -r '.data.used_bytes // 0')
+    local count=$(echo "$response" | jq -r '.data.file_count // 0')
+    if [ "$used" != "$((used_before + size))" ] || [ "$count" != "$((count_before + 1))" ]; then
+        print_error "Expected ${size} more bytes and one more file, got used=${used} files=${count}"
+        return 1
+    fi
+    print_success "Completed upload counted (${used} bytes, ${count} files)"
+
+    http_delete "${API_BASE}/files/${file_id}" "$USER_TOKEN" > /dev/null
+    response=$(http_get "${API_BASE}/files/storage-usage" "$USER_TOKEN")
+    used=$(echo "$response" | jq -r '.data.used_bytes // 0')
+    count=$(echo "$response" | jq -r '.data.file_count // 0')
+    if [ "$used" != "$used_before" ] || [ "$count" != "$count_before" ]; then
+        print_error "Delete not released, got used=${used} files=${count}"
+        return 1
+    fi
+    print_success "Deleted file released"
+}
+
+# Test 17: Files past expires_at are deleted by the lifecycle worker and their object removed
+test_file_expiry() {
+    print_header "Test 17: File Expiry"
+
+    if [ "$FILE_TEST_EXPIRY" != "1" ]; then
+        print_info "Skipped: set FILE_TEST_EXPIRY=1 to wait an hour for the file to expire"
+        return 0
+    fi
+
+    local temp_file=$(mktemp /tmp/test-expiry-XXXXXX.txt)
+    echo "expiring ${TIMESTAMP} ${RANDOM}" > "$temp_file"
+    local size=$(wc -c < "$temp_file" | tr -d ' ')
+    local data="{\"file_name\": \"expiry-${TIMESTAMP}.txt\", \"file_size\": ${size}, \"mime_type\": \"text/plain\", \"file_type\": 4, \"expires_hours\": 1}"
+    local response=$(http_post "${API_BASE}/files/upload-token" "$data" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        rm -f "$temp_file"
+        return 1
+    fi
+    local file_id=$(echo "$response" | jq -r '.data.file_id')
+    put_object "$(echo "$response" | jq -r '.data.upload_url')" "$temp_file" "text/plain" > /dev/null
+    rm -f "$temp_file"
+    response=$(http_post "${API_BASE}/files/${file_id}/complete" "{}" "$USER_TOKEN")
+    if ! check_response "$response" || ! wait_file_status "$file_id" 1; then
+        print_error "Upload of the expiring file failed"
+        return 1
+    fi
+
+    local download_url=$(http_get "${API_BASE}/files/${file_id}/download?expiresMinutes=30" "$USER_TOKEN" | jq -r '.data.download_url // empty')
+    local used_before=$(http_get "${API_BASE}/files/storage-usage" "$USER_TOKEN" | jq -r '.data.used_bytes // 0')
+
+    local wait_seconds=$((3600 + FILE_LIFECYCLE_INTERVAL_SECONDS + 3))
+    print_info "Waiting ${wait_seconds}s for the file to expire and be swept..."
+    sleep "$wait_seconds"
+
+    response=$(http_get "${API_BASE}/files/${file_id}/download?expiresMinutes=5" "$USER_TOKEN")
+    if [ "$(json_code "$response")" = "0" ] || ! echo "$response" | jq -r '.message' | grep -q "expired"; then
+        print_error "Expected file expired, got: $response"
+        return 1
+    fi
+    print_success "Expired file reported as expired"
+
+    local http_code=$(curl -s -o /dev/null -w "%{http_code}" --max-time 30 "$download_url")
+    if [ "$http_code" != "404" ]; then
+        print_error "Object of the expired file still stored (HTTP $http_code)"
+        return 1
+    fi
+    local used=$(http_get "${API_BASE}/files/storage-usage" "$USER_TOKEN" | jq -r '.data.used_bytes // 0')
+    if [ "$used" != "$((used_before - size))" ]; then
+        print_error "Storage not released, used ${used_before} -> ${used}"
+        return 1
+    fi
+    print_success "Expired file swept, object removed and storage released"
+}
+
+# Test 18: Uploads never completed are purged after pending_ttl_seconds
+test_abandoned_upload_purge() {
+    print_header "Test 18: Abandoned Upload Purge"
+
+    if [ "$FILE_PENDING_TTL_SECONDS" -gt 120 ]; then
+        print_info "Skipped: start file-service and this script with FILE_PENDING_TTL_SECONDS<=120 (e.g. 10) and FILE_LIFECYCLE_INTERVAL_SECONDS=5"
+        return 0
+    fi
+
+    local temp_file=$(mktemp /tmp/test-abandoned-XXXXXX.txt)
+    echo "abandoned ${TIMESTAMP} ${RANDOM}" > "$temp_file"
+    local size=$(wc -c < "$temp_file" | tr -d ' ')
+    local data="{\"file_name\": \"abandoned-${TIMESTAMP}.txt\", \"file_size\": ${size}, \"mime_type\": \"text/plain\", \"file_type\": 4}"
+    local response=$(http_post "${API_BASE}/files/upload-token" "$data" "$USER_TOKEN")
+    if ! check_response "$response"; then
+        rm -f "$temp_file"
+        return 1
+    fi
+    local file_id=$(echo "$response" | jq -r '.data.file_id')
+    put_object "$(echo "$response" | jq -r '.data.upload_url')" "$temp_file" "text/plain" > /dev/null
+    rm -f "$temp_file"
+
+    local wait_seconds=$((FILE_PENDING_TTL_SECONDS + FILE_LIFECYCLE_INTERVAL_SECONDS + 3))
+    print_info "Uploaded without completing, waiting ${wait_seconds}s for the purge..."
+    sleep "$wait_seconds"
+
+    response=$(http_post "${API_BASE}/files/${file_id}/complete" "{}" "$USER_TOKEN")
+    if [ "$(json_code "$response")" = "0" ]; then
+        print_error "Purged upload could still be completed"
+        return 1
+    fi
+    response=$(http_get "${API_BASE}/files/${file_id}" "$USER_TOKEN")
+    if [ "$(json_code "$response")" != "404" ]; then
+        print_error "Expected purged file not found, got: $response"
+        return 1
+    fi
+    print_success "Abandoned upload purged"
+}
+
+# Test 19: Objects whose removal failed are removed later by the deletion retry
+test_deletion_retry() {
+    print_header "Test 19: Object Deletion Retry"
+
+    if [ -z "$FILE_TEST_MINIO_CONTAINER" ] || ! command -v docker &> /dev/null; then
+        print_info "Skipped: set FILE_TEST_MINIO_CONTAINER to the MinIO container name (docker required)"
+        return 0
+    fi
+
+    local temp_file=$(mktemp /tmp/test-retry-XXXXXX.txt)
+    echo "retry ${TIMESTAMP} ${RANDOM}" > "$temp_file"
+    upload_file "$temp_file" "retry-${TIMESTAMP}.txt" "text/plain" 4
+    local uploaded=$?
+    rm -f "$temp_file"
+    if [ $uploaded -ne 0 ] || ! wait_file_status "$UPLOADED_FILE_ID" 1; then
+        print_error "Upload failed"
+        return 1
+    fi
+    local file_id=$UPLOADED_FILE_ID
+    local download_url=$(http_get "${API_BASE}/files/${file_id}/download?expiresMinutes=30" "$USER_TOKEN" | jq -r '.data.download_url // empty')
+
+    print_info "Stopping MinIO and deleting the file..."
+    docker stop "$FILE_TEST_MINIO_CONTAINER" > /dev/null
+    local response=$(http_delete "${API_BASE}/files/${file_id}" "$USER_TOKEN")
+    docker start "$FILE_TEST_MINIO_CONTAINER" > /dev/null
+    if ! check_response "$response"; then
+        print_error "Delete must succeed while MinIO is down"
+        return 1
+    fi
+    print_success "Delete succeeded with MinIO down"
+
+    local i
+    for i in $(seq 1 30); do
+        if [ "$(curl -s -o /dev/null -w "%{http_code}" --max-time 5 "$download_url")" = "200" ]; then
+            break
+        fi
+        sleep 1
+    done
+
+    local wait_seconds=$((FILE_DELETION_RETRY_BACKOFF_SECONDS + FILE_LIFECYCLE_INTERVAL_SECONDS + 3))
+    print_info "MinIO is back, waiting ${wait_seconds}s for the retry..."
+    sleep "$wait_seconds"
+
+    local http_code=$(curl -s -o /dev/null -w "%{http_code}" --max-time 30 "$download_url")
+    if [ "$http_code" != "404" ]; then
+        print_error "Object not removed by the retry (HTTP $http_code)"
+        return 1
+    fi
+    print_success "Object removed by the deletion retry"
+}
+
+# Test 20: Quarantined files cannot be downloaded, deleted or shared
+test_quarantined_file() {
+    print_header "Test 20: Quarantined File"
+
+    print_info "Uploading the EICAR test file..."
+    upload_eicar_file
+    local result=$?
+    if [ $result -eq 2 ]; then
+        print_info "File activated without a scan, scanning disabled (FILE_SCAN_SCANNER=fake), skipped"
+        return 0
+    fi
+    if [ $result -ne 0 ]; then
+        print_error "EICAR file was not quarantined"
+        return 1
+    fi
+    QUARANTINED_FILE_ID=$UPLOADED_FILE_ID
+    print_success "EICAR file quarantined (${QUARANTINED_FILE_ID})"
+
+    local response=$(http_get "${API_BASE}/files/${QUARANTINED_FILE_ID}/download?expiresMinutes=5" "$USER_TOKEN")
+    if [ "$(json_code "$response")" != "403" ]; then
+        print_error "Expected 403 for downloading a quarantined file, got $(json_code "$response")"
+        return 1
+    fi
+    response=$(http_delete "${API_BASE}/files/${QUARANTINED_FILE_ID}" "$USER_TOKEN")
+    if [ "$(json_code "$response")" != "403" ]; then
+        print_error "Expected 403 for deleting a quarantined file, got $(json_code "$response")"
+        return 1
+    fi
+    print_success "Uploader can neither download nor delete it"
+
+    setup_peer_user || return 1
+    local message_id=$(send_file_message "$QUARANTINED_FILE_ID")
+    print_info "Message referencing the file: ${message_id:-not sent}"
+    response=$(http_get "${API_BASE}/files/${QUARANTINED_FILE_ID}" "$PEER_USER_TOKEN")
+    if [ "$(json_code "$response")" != "403" ]; then
+        print_error "Peer can read the quarantined file (code $(json_code "$response"))"
+        return 1
+    fi
+    response=$(http_get "${API_BASE}/files/${QUARANTINED_FILE_ID}/download?expiresMinutes=5" "$PEER_USER_TOKEN")
+    if [ "$(json_code "$response")" != "403" ]; then
+        print_error "Peer can download the quarantined file (code $(json_code "$response"))"
+        return 1
+    fi
+    print_success "Sending it in a message does not share it"
+    return 0
+}
+
+# Test 21: Administrator review releases or deletes quarantined files
+test_quarantine_review() {
+    print_header "Test 21: Quarantine Review"
+
+    if [ -z "$QUARANTINED_FILE_ID" ]; then
+        print_info "No quarantined file, skipped"
+        return 0
+    fi
+    if ! admin_login; then
+        print_info "Admin backend not reachable at ${ADMIN_URL}, skipped"
+        return 0
+    fi
+
+    local output=$(admin_request GET "/files/quarantine?userId=${USER_ID}")
+    local http_code=$(echo "$output" | tail -n1)
+    local body=$(echo "$output" | sed '$d')
+    if [ "$http_code" != "200" ] || \
+       ! echo "$body" | jq -e --arg id "$QUARANTINED_FILE_ID" '.data.files[]? | select(.file.file_id == $id)' &>/dev/null; then
+        print_error "Quarantined file missing from the admin list (HTTP ${http_code})"
+        print_info "Response: $body"
+        return 1
+    fi
+    print_success "Listed for review"
+
+    output=$(admin_request GET "/files/quarantine/${QUARANTINED_FILE_ID}")
+    body=$(echo "$output" | sed '$d')
+    local signature=$(echo "$body" | jq -r '.data.signature // empty')
+    local verdict=$(echo "$body" | jq -r '.data.verdicts[-1].verdict // empty')
+    if [ -z "$signature" ] || [ "$verdict" != "infected" ]; then
+        print_error "Expected an infected verdict with a signature, got '${verdict}' '${signature}'"
+        print_info "Response: $body"
+        return 1
+    fi
+    print_success "Detail shows ${signature}"
+
+    print_info "Releasing as a false positive..."
+    output=$(admin_request POST "/files/quarantine/${QUARANTINED_FILE_ID}/release" '{"note": "api test release"}')
+    http_code=$(echo "$output" | tail -n1)
+    if [ "$http_code" != "200" ]; then
+        print_error "Release failed (HTTP ${http_code}): $(echo "$output" | sed '$d')"
+        return 1
+    fi
+    if ! wait_file_status "$QUARANTINED_FILE_ID" 1; then
+        print_error "Released file did not become active"
+        return 1
+    fi
+    local response=$(http_get "${API_BASE}/files/${QUARANTINED_FILE_ID}/download?expiresMinutes=5" "$USER_TOKEN")
+    local download_url=$(echo "$response" | jq -r '.data.download_url // empty')
+    if [ -z "$download_url" ] || [ "$(curl -s --max-time 30 "$download_url")" != "$EICAR_TEST_STRING" ]; then
+        print_error "Released file cannot be downloaded"
+        return 1
+    fi
+    output=$(admin_request POST "/files/quarantine/${QUARANTINED_FILE_ID}/release")
+    if [ "$(echo "$output" | tail -n1)" = "200" ]; then
+        print_error "Releasing twice succeeded"
+        return 1
+    fi
+    print_success "Released file is active and downloadable again"
+
+    print_info "Uploading a second EICAR file and confirming the verdict..."
+    upload_eicar_file
+    if [ $? -ne 0 ]; then
+        print_error "Second EICAR file was not quarantined"
+        return 1
+    fi
+    local rejected_id=$UPLOADED_FILE_ID
+    output=$(admin_request DELETE "/files/quarantine/${rejected_id}" '{"note": "api test delete"}')
+    http_code=$(echo "$output" | tail -n1)
+    if [ "$http_code" != "200" ]; then
+        print_error "Delete failed (HTTP ${http_code}): $(echo "$output" | sed '$d')"
+        return 1
+    fi
+    response=$(http_get "${API_BASE}/files/${rejected_id}" "$USER_TOKEN")
+    if [ "$(json_code "$response")" != "404" ]; then
+        print_error "Deleted file still visible to the uploader (code $(json_code "$response"))"
+        return 1
+    fi
+    output=$(admin_request GET "/files/quarantine?userId=${USER_ID}")
+    if echo "$output" | sed '$d' | jq -e --arg id "$rejected_id" '.data.files[]? | select(.file.file_id == $id)' &>/dev/null; then
+        print_error "Deleted file still listed for review"
+        return 1
+    fi
+    print_success "Deleted file is gone for the uploader and the review list"
+    return 0
+}
+
 # ========================================
 # Main function
 # ========================================
@@ -402,6 +1449,19 @@ main() {
     test_generate_download_url || ((failed++))
     test_list_user_files || ((failed++))
     test_delete_file || ((failed++))
+    test_multipart_upload || ((failed++))
+    test_abort_multipart_upload || ((failed++))
+    test_conversation_file_access || ((failed++))
+    test_media_processing || ((failed++))
+    test_instant_upload || ((failed++))
+    test_hash_mismatch || ((failed++))
+    test_content_verification || ((failed++))
+    test_storage_usage || ((failed++))
+    test_file_expiry || ((failed++))
+    test_abandoned_upload_purge || ((failed++))
+    test_deletion_retry || ((failed++))
+    test_quarantined_file || ((failed++))
+    test_quarantine_review || ((failed++))
 
     # Summary
     print_header "Tests Complete"
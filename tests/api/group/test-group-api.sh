@@ -6,6 +6,10 @@
 
 set -e
 
+# Shared image fixtures and upload helpers, the functions below override the common print/http/check helpers
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+source "${SCRIPT_DIR}/../common.sh"
+
 # Color output
 RED='\033[0;31m'
 GREEN='\033[0;32m'
@@ -15,6 +19,8 @@ NC='\033[0m' # No Color
 # Configuration
 GATEWAY_URL="${GATEWAY_URL:-http://localhost:8080}"
 API_BASE="${GATEWAY_URL}/api/v1"
+# Group conversations are only created by the server, messaging tests seed them through the conversation gRPC API
+CONVERSATION_GRPC="${CONVERSATION_GRPC:-localhost:9006}"
 
 # Test data
 TIMESTAMP=$(date +%s)
@@ -41,6 +47,13 @@ JOIN_REQUEST_ID=""
 TEST_PASSED=0
 TEST_FAILED=0
 TEST_PIN_MESSAGE_ID="test-pin-msg-${TIMESTAMP}"
+AVATAR_GROUP_ID=""
+GROUP_AVATAR_WAIT_SECONDS="${GROUP_AVATAR_WAIT_SECONDS:-30}"
+RESTRICT_GROUP_ID=""
+RESTRICT_CONV_OWNER=""
+RESTRICT_CONV_ADMIN=""
+RESTRICT_CONV_MEMBER=""
+SLOW_MODE_SECONDS=5
 
 # Print functions
 print_header() {
@@ -112,7 +125,7 @@ http_delete() {
         -H "Authorization: Bearer ${token}"
 }
 
-# Find an existing message ID from group conversation (for pin test)
+# Find an existing non-system message ID from group conversation (for pin test)
 resolve_group_message_id_for_pin() {
     local retries=5
     local i
@@ -127,6 +140,7 @@ resolve_group_message_id_for_pin() {
                 .data.conversations[]?
                 | select((.conversationId // .conversation_id) == $gid and (.conversationType // .conversation_type) == 2)
                 | .messages[]?
+                | select(((.contentType // .content_type) | tostring) as $t | $t != "8" and $t != "CONTENT_TYPE_SYSTEM")
                 | (.messageId // .message_id)
                 | select(. != null and . != "")
             ' | head -n 1)
@@ -143,6 +157,70 @@ resolve_group_message_id_for_pin() {
     return 1
 }
 
+# Sync the group conversation as a user, outputs its system messages as a JSON array
+# with the content decoded into .notice
+sync_group_system_messages() {
+    local token=$1
+    local group_id=$2
+    local sync_data="{\"conversationSeqs\":[{\"conversationId\":\"${group_id}\",\"conversationType\":2,\"lastSeq\":0}],\"limitPerConversation\":100}"
+    local sync_resp=$(http_post "${API_BASE}/sync/messages" "$sync_data" "$token")
+
+    if [ "$(echo "$sync_resp" | jq -r '.code // empty')" != "0" ]; then
+        echo "[]"
+        return 1
+    fi
+
+    echo "$sync_resp" | jq -c --arg gid "$group_id" '
+        [.data.conversations[]?
+        | select((.conversationId // .conversation_id) == $gid)
+        | .messages[]?
+        | select(((.contentType // .content_type) | tostring) as $t | $t == "8" or $t == "CONTENT_TYPE_SYSTEM")
+        | . + {notice: ((.content // "{}") | fromjson? // {})}]
+    '
+}
+
+# Wait until the group avatar matches a pattern, outputs the avatar URL
+wait_group_avatar() {
+    local group_id=$1
+    local pattern=$2
+
+    local i
+    for i in $(seq 1 "$GROUP_AVATAR_WAIT_SECONDS"); do
+        local avatar=$(http_get "${API_BASE}/groups/${group_id}" "$USER1_TOKEN" | jq -r '.data.avatar // empty')
+        if [[ "$avatar" == *"$pattern"* ]]; then
+            echo "$avatar"
+            return 0
+        fi
+        sleep 1
+    done
+    return 1
+}
+
+# Create the group conversation of a member via grpcurl, outputs the conversation ID
+seed_group_conversation() {
+    local user_id=$1
+    local group_id=$2
+
+    if ! command -v grpcurl &> /dev/null; then
+        return 1
+    fi
+    grpcurl -plaintext \
+        -d "{\"conversation_type\": \"CONVERSATION_TYPE_GROUP\", \"user_id\": \"${user_id}\", \"target_id\": \"${group_id}\"}" \
+        "${CONVERSATION_GRPC}" \
+        anychat.conversation.ConversationService/CreateOrUpdateConversation 2>/dev/null | jq -r '.conversationId // empty'
+}
+
+# Send a text message, outputs the raw response
+send_text_message() {
+    local conversation_id=$1
+    local token=$2
+    local text=$3
+    local local_id=${4:-local-${TIMESTAMP}-${RANDOM}}
+
+    local data="{\"conversation_id\":\"${conversation_id}\",\"content_type\":1,\"content\":\"{\\\"text\\\":\\\"${text}\\\"}\",\"local_id\":\"${local_id}\"}"
+    http_post "${API_BASE}/messages" "$data" "$token"
+}
+
 # Check JSON response status
 check_response() {
     local response=$1
@@ -588,9 +666,368 @@ test_pin_unpin_message() {
     check_response "$unpin_resp" "0" "Unpin message"
 }
 
-# Test 18: Dissolve group
+# Test 18: Default composite avatar
+test_group_composite_avatar() {
+    print_header "Test 18: Default Composite Group Avatar"
+
+    local data="{\"name\":\"AvatarGroup_${TIMESTAMP}\",\"memberIds\":[\"${USER2_ID}\",\"${USER3_ID}\"]}"
+    local response=$(http_post "${API_BASE}/groups" "$data" "$USER1_TOKEN")
+    AVATAR_GROUP_ID=$(echo "$response" | jq -r '.data.groupId // empty')
+    if [ -z "$AVATAR_GROUP_ID" ]; then
+        print_error "Create avatar test group failed"
+        print_info "Response: $response"
+        return 0
+    fi
+    print_info "Avatar group ID: $AVATAR_GROUP_ID"
+
+    # the group avatar worker builds it from the member avatars in the background
+    local avatar=$(wait_group_avatar "$AVATAR_GROUP_ID" "/group-avatar/${AVATAR_GROUP_ID}/composite.jpg")
+    if [ -z "$avatar" ]; then
+        print_error "Composite avatar not generated within ${GROUP_AVATAR_WAIT_SECONDS}s"
+        return 0
+    fi
+    print_success "Composite avatar generated"
+    print_info "Avatar: $avatar"
+
+    local image_file=$(mktemp /tmp/test-group-avatar-XXXXXX.jpg)
+    local http_code=$(fetch_public "$avatar" "$image_file")
+    local size=$(image_size "$image_file")
+    rm -f "$image_file"
+    if [ "$http_code" = "200" ]; then
+        print_success "Composite avatar readable without authentication"
+    else
+        print_error "Anonymous composite avatar download failed (HTTP ${http_code})"
+    fi
+    if [ -z "$size" ]; then
+        print_info "file/identify not installed, skipping the size check"
+    elif [ "$size" = "320x320" ]; then
+        print_success "Composite avatar is 320x320"
+    else
+        print_error "Composite avatar size ${size}, expected 320x320"
+    fi
+}
+
+# Test 19: Upload group avatar
+test_set_group_avatar() {
+    print_header "Test 19: Upload Group Avatar"
+
+    if [ -z "$AVATAR_GROUP_ID" ]; then
+        print_error "Skip test - avatar group ID is empty"
+        return 0
+    fi
+
+    local source_file=$(mktemp /tmp/test-group-avatar-XXXXXX.jpg)
+    write_fixture "$TEST_IMAGE_JPEG_BASE64" "$source_file"
+    local owner_file_id member_file_id
+    owner_file_id=$(upload_test_image "${API_BASE}" "$USER1_TOKEN" "$source_file" "group-avatar-${TIMESTAMP}.jpg") || true
+    member_file_id=$(upload_test_image "${API_BASE}" "$USER2_TOKEN" "$source_file" "member-avatar-${TIMESTAMP}.jpg") || true
+    rm -f "$source_file"
+    if [ -z "$owner_file_id" ] || [ -z "$member_file_id" ]; then
+        print_error "Upload group avatar source images failed"
+        return 0
+    fi
+
+    local member_resp=$(http_put "${API_BASE}/groups/${AVATAR_GROUP_ID}/avatar" "{\"file_id\":\"${member_file_id}\"}" "$USER2_TOKEN")
+    if [ "$(echo "$member_resp" | jq -r '.code')" = "403" ]; then
+        print_success "Ordinary member cannot set the group avatar"
+    else
+        print_error "Ordinary member set avatar - expected 403"
+        print_info "Response: $member_resp"
+    fi
+
+    local response=$(http_put "${API_BASE}/groups/${AVATAR_GROUP_ID}/avatar" "{\"file_id\":\"${owner_file_id}\"}" "$USER1_TOKEN")
+    check_response "$response" "0" "Owner sets group avatar" || return 0
+    local avatar=$(echo "$response" | jq -r '.data.avatar // empty')
+    if [[ "$avatar" == *"/group-avatar/${AVATAR_GROUP_ID}/640.jpg?v="* ]]; then
+        print_success "Group avatar URL points at the uploaded square"
+    else
+        print_error "Unexpected group avatar URL: $avatar"
+    fi
+
+    local info_avatar=$(http_get "${API_BASE}/groups/${AVATAR_GROUP_ID}" "$USER2_TOKEN" | jq -r '.data.avatar // empty')
+    if [ "$info_avatar" = "$avatar" ]; then
+        print_success "Members see the uploaded avatar"
+    else
+        print_error "Group info avatar not updated: $info_avatar"
+    fi
+
+    local image_file=$(mktemp /tmp/test-group-avatar-XXXXXX.jpg)
+    local http_code=$(fetch_public "$avatar" "$image_file")
+    local size=$(image_size "$image_file")
+    rm -f "$image_file"
+    if [ "$http_code" = "200" ]; then
+        print_success "Group avatar readable without authentication"
+    else
+        print_error "Anonymous group avatar download failed (HTTP ${http_code})"
+    fi
+    if [ -n "$size" ] && [ "$size" != "240x240" ]; then
+        print_error "Group avatar size ${size}, expected the 240x240 center crop"
+    elif [ -n "$size" ]; then
+        print_success "Group avatar cropped to ${size}"
+    fi
+
+    local url_resp=$(http_put "${API_BASE}/groups/${AVATAR_GROUP_ID}" '{"avatar":"https://example.com/group.jpg"}' "$USER1_TOKEN")
+    if [ "$(echo "$url_resp" | jq -r '.code')" = "400" ]; then
+        print_success "External avatar URL rejected"
+    else
+        print_error "External avatar URL - expected 400"
+        print_info "Response: $url_resp"
+    fi
+
+    # clearing hands the group back to the composite avatar
+    local clear_resp=$(http_put "${API_BASE}/groups/${AVATAR_GROUP_ID}" '{"avatar":""}' "$USER1_TOKEN")
+    check_response "$clear_resp" "0" "Clear group avatar" || return 0
+    if wait_group_avatar "$AVATAR_GROUP_ID" "/group-avatar/${AVATAR_GROUP_ID}/composite.jpg" > /dev/null; then
+        print_success "Cleared group returns to the composite avatar"
+    else
+        print_error "Composite avatar not restored within ${GROUP_AVATAR_WAIT_SECONDS}s"
+    fi
+
+    local dissolve_resp=$(http_delete "${API_BASE}/groups/${AVATAR_GROUP_ID}" "$USER1_TOKEN")
+    check_response "$dissolve_resp" "0" "Dissolve avatar test group" || true
+}
+
+# Test 20: Muted members cannot send, admins are exempt
+test_send_restrictions_mute() {
+    print_header "Test 20: Send Restrictions - Mute"
+
+    local data="{\"name\":\"RestrictGroup_${TIMESTAMP}\",\"memberIds\":[\"${USER2_ID}\",\"${USER3_ID}\"]}"
+    local response=$(http_post "${API_BASE}/groups" "$data" "$USER1_TOKEN")
+    RESTRICT_GROUP_ID=$(echo "$response" | jq -r '.data.groupId // empty')
+    if [ -z "$RESTRICT_GROUP_ID" ]; then
+        print_error "Create restriction test group failed"
+        print_info "Response: $response"
+        return 0
+    fi
+    local role_resp=$(http_put "${API_BASE}/groups/${RESTRICT_GROUP_ID}/members/${USER2_ID}/role" '{"role":2}' "$USER1_TOKEN")
+    check_response "$role_resp" "0" "User 2 is admin of the restriction group" || return 0
+
+    RESTRICT_CONV_OWNER=$(seed_group_conversation "$USER1_ID" "$RESTRICT_GROUP_ID") || true
+    RESTRICT_CONV_ADMIN=$(seed_group_conversation "$USER2_ID" "$RESTRICT_GROUP_ID") || true
+    RESTRICT_CONV_MEMBER=$(seed_group_conversation "$USER3_ID" "$RESTRICT_GROUP_ID") || true
+    if [ -z "$RESTRICT_CONV_OWNER" ] || [ -z "$RESTRICT_CONV_ADMIN" ] || [ -z "$RESTRICT_CONV_MEMBER" ]; then
+        print_info "grpcurl or conversation-service (${CONVERSATION_GRPC}) not available, skipping send restriction tests"
+        return 0
+    fi
+
+    local send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "hello before mute")
+    check_response "$send_resp" "0" "Member sends before being muted"
+
+    local mute_resp=$(http_put "${API_BASE}/groups/${RESTRICT_GROUP_ID}/members/${USER3_ID}/mute" '{"type":2,"duration_minutes":10}' "$USER1_TOKEN")
+    check_response "$mute_resp" "0" "Mute member for 10 minutes"
+    send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "hello while muted")
+    local code=$(echo "$send_resp" | jq -r '.code')
+    local message=$(echo "$send_resp" | jq -r '.message // empty')
+    if [ "$code" = "403" ] && [[ "$message" == *"muted in this group until"* ]]; then
+        print_success "Muted member rejected with the mute end: $message"
+    else
+        print_error "Muted member send - expected 403 with the mute end"
+        print_info "Response: $send_resp"
+    fi
+
+    mute_resp=$(http_put "${API_BASE}/groups/${RESTRICT_GROUP_ID}/members/${USER3_ID}/mute" '{"type":1}' "$USER1_TOKEN")
+    check_response "$mute_resp" "0" "Mute member permanently"
+    send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "hello while muted forever")
+    message=$(echo "$send_resp" | jq -r '.message // empty')
+    if [ "$(echo "$send_resp" | jq -r '.code')" = "403" ] && [ "$message" = "You are muted in this group" ]; then
+        print_success "Permanently muted member rejected without an end time"
+    else
+        print_error "Permanently muted member send - expected 403 without an end time"
+        print_info "Response: $send_resp"
+    fi
+
+    local unmute_resp=$(http_delete "${API_BASE}/groups/${RESTRICT_GROUP_ID}/members/${USER3_ID}/mute" "$USER1_TOKEN")
+    check_response "$unmute_resp" "0" "Unmute member"
+    send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "hello after unmute")
+    check_response "$send_resp" "0" "Unmuted member sends again"
+
+    local group_mute_resp=$(http_put "${API_BASE}/groups/${RESTRICT_GROUP_ID}/mute" '{"enabled":true}' "$USER1_TOKEN")
+    check_response "$group_mute_resp" "0" "Enable all mute"
+    send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "hello in a muted group")
+    message=$(echo "$send_resp" | jq -r '.message // empty')
+    if [ "$(echo "$send_resp" | jq -r '.code')" = "403" ] && [[ "$message" == *"group is muted"* ]]; then
+        print_success "Member rejected while the group is muted: $message"
+    else
+        print_error "Member send in a muted group - expected 403"
+        print_info "Response: $send_resp"
+    fi
+    send_resp=$(send_text_message "$RESTRICT_CONV_ADMIN" "$USER2_TOKEN" "admin in a muted group")
+    check_response "$send_resp" "0" "Admin sends while the group is muted"
+    send_resp=$(send_text_message "$RESTRICT_CONV_OWNER" "$USER1_TOKEN" "owner in a muted group")
+    check_response "$send_resp" "0" "Owner sends while the group is muted"
+
+    # the owner can mute an admin, but the restriction does not apply to admins
+    mute_resp=$(http_put "${API_BASE}/groups/${RESTRICT_GROUP_ID}/members/${USER2_ID}/mute" '{"type":2,"duration_minutes":10}' "$USER1_TOKEN")
+    if [ "$(echo "$mute_resp" | jq -r '.code')" = "0" ]; then
+        send_resp=$(send_text_message "$RESTRICT_CONV_ADMIN" "$USER2_TOKEN" "muted admin")
+        check_response "$send_resp" "0" "Muted admin still sends"
+        http_delete "${API_BASE}/groups/${RESTRICT_GROUP_ID}/members/${USER2_ID}/mute" "$USER1_TOKEN" > /dev/null
+    else
+        print_info "Admins cannot be muted: $(echo "$mute_resp" | jq -r '.message // empty')"
+    fi
+
+    group_mute_resp=$(http_put "${API_BASE}/groups/${RESTRICT_GROUP_ID}/mute" '{"enabled":false}' "$USER1_TOKEN")
+    check_response "$group_mute_resp" "0" "Disable all mute"
+}
+
+# Test 21: Slow mode
+test_send_restrictions_slow_mode() {
+    print_header "Test 21: Send Restrictions - Slow Mode"
+
+    if [ -z "$RESTRICT_CONV_MEMBER" ]; then
+        print_info "No seeded group conversation, skipping slow mode test"
+        return 0
+    fi
+
+    local settings_resp=$(http_put "${API_BASE}/groups/${RESTRICT_GROUP_ID}/settings" "{\"slow_mode_seconds\":${SLOW_MODE_SECONDS}}" "$USER1_TOKEN")
+    check_response "$settings_resp" "0" "Enable ${SLOW_MODE_SECONDS}s slow mode" || return 0
+
+    local first_local_id="slow-${TIMESTAMP}-1"
+    local send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "slow 1" "$first_local_id")
+    check_response "$send_resp" "0" "First message in the window is sent"
+    local first_id=$(echo "$send_resp" | jq -r '.data.message_id // .data.messageId // empty')
+
+    send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "slow 2")
+    local message=$(echo "$send_resp" | jq -r '.message // empty')
+    if [ "$(echo "$send_resp" | jq -r '.code')" = "429" ] && [[ "$message" == *"wait"*"seconds"* ]]; then
+        print_success "Second message in the window rejected: $message"
+    else
+        print_error "Second message in the window - expected 429"
+        print_info "Response: $send_resp"
+    fi
+
+    # a client resending after a timeout gets the stored message, not a slow mode error
+    send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "slow 1" "$first_local_id")
+    if [ "$(echo "$send_resp" | jq -r '.code')" = "0" ] && \
+       [ "$(echo "$send_resp" | jq -r '.data.message_id // .data.messageId // empty')" = "$first_id" ]; then
+        print_success "Retry of the sent message passes inside the window"
+    else
+        print_error "Retry of the sent message - expected the stored message"
+        print_info "Response: $send_resp"
+    fi
+
+    send_resp=$(send_text_message "$RESTRICT_CONV_ADMIN" "$USER2_TOKEN" "admin slow 1")
+    check_response "$send_resp" "0" "Admin first message"
+    send_resp=$(send_text_message "$RESTRICT_CONV_ADMIN" "$USER2_TOKEN" "admin slow 2")
+    check_response "$send_resp" "0" "Admin is not slowed down"
+
+    print_info "Waiting $((SLOW_MODE_SECONDS + 1))s for the window to pass..."
+    sleep $((SLOW_MODE_SECONDS + 1))
+
+    # content is stored as JSON, so this send takes the slot and then fails
+    local bad_data="{\"conversation_id\":\"${RESTRICT_CONV_MEMBER}\",\"content_type\":1,\"content\":\"not json\",\"local_id\":\"slow-${TIMESTAMP}-bad\"}"
+    send_resp=$(http_post "${API_BASE}/messages" "$bad_data" "$USER3_TOKEN")
+    if [ "$(echo "$send_resp" | jq -r '.code')" != "0" ]; then
+        print_success "Failed send rejected (code $(echo "$send_resp" | jq -r '.code'))"
+    else
+        print_error "Send with invalid content succeeded"
+    fi
+    send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "slow after failure")
+    check_response "$send_resp" "0" "Failed send released the slot, next message is sent"
+
+    settings_resp=$(http_put "${API_BASE}/groups/${RESTRICT_GROUP_ID}/settings" '{"slow_mode_seconds":0}' "$USER1_TOKEN")
+    check_response "$settings_resp" "0" "Disable slow mode"
+    send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "fast 1")
+    check_response "$send_resp" "0" "Message after disabling slow mode"
+    send_resp=$(send_text_message "$RESTRICT_CONV_MEMBER" "$USER3_TOKEN" "fast 2")
+    check_response "$send_resp" "0" "Back-to-back message after disabling slow mode"
+
+    local dissolve_resp=$(http_delete "${API_BASE}/groups/${RESTRICT_GROUP_ID}" "$USER1_TOKEN")
+    check_response "$dissolve_resp" "0" "Dissolve restriction test group" || true
+}
+
+# Test 22: System messages
+test_group_system_messages() {
+    print_header "Test 22: Group System Messages"
+
+    if [ -z "$GROUP_ID" ]; then
+        print_error "Skip test - group ID is empty"
+        return 1
+    fi
+
+    # clients cannot send system messages themselves
+    local send_data="{\"conversation_id\":\"${GROUP_ID}\",\"content_type\":8,\"content\":\"{\\\"event\\\":\\\"group.renamed\\\"}\",\"local_id\":\"system-${TIMESTAMP}\"}"
+    local send_resp=$(http_post "${API_BASE}/messages" "$send_data" "$USER1_TOKEN")
+    if [ "$(echo "$send_resp" | jq -r '.code')" = "400" ]; then
+        print_success "Client cannot send system message"
+    else
+        print_error "Client system message - expected 400"
+        print_info "Response: $send_resp"
+    fi
+
+    local notices=$(sync_group_system_messages "$USER1_TOKEN" "$GROUP_ID")
+    local notice_count=$(echo "$notices" | jq 'length')
+    if [ "$notice_count" -gt 0 ]; then
+        print_success "Group conversation contains system messages: ${notice_count}"
+    else
+        print_error "No system messages found in group conversation"
+        return 1
+    fi
+
+    local joined_count=$(echo "$notices" | jq '[.[] | select(.notice.event == "group.member_joined")] | length')
+    local joined_loud=$(echo "$notices" | jq '[.[] | select(.notice.event == "group.member_joined" and (.silent // false) != true)] | length')
+    if [ "$joined_count" -gt 0 ] && [ "$joined_loud" = "0" ]; then
+        print_success "Member joined notices are silent"
+    else
+        print_error "Member joined notices validation failed, total=${joined_count} not silent=${joined_loud}"
+    fi
+
+    local renamed_silent=$(echo "$notices" | jq -r '[.[] | select(.notice.event == "group.renamed")][0] | if . == null then "missing" else ((.silent // false) | tostring) end')
+    if [ "$renamed_silent" = "false" ]; then
+        print_success "Rename notice is not silent"
+    else
+        print_error "Rename notice validation failed, silent=${renamed_silent}"
+    fi
+
+    # unread counts are kept per conversation ID, the group conversation is only readable
+    # through it when the user's conversation row uses the group ID
+    local unread_resp=$(http_get "${API_BASE}/conversations/${GROUP_ID}/messages/unread-count" "$USER1_TOKEN")
+    if [ "$(echo "$unread_resp" | jq -r '.code // empty')" = "0" ]; then
+        local unread=$(echo "$unread_resp" | jq -r '.data.unread_count // .data.unreadCount // 0')
+        local expected=$(echo "$notices" | jq '[.[] | select((.silent // false) != true and ((.status // 0) == 0))] | length')
+        if [ "$unread" -le "$expected" ]; then
+            print_success "Silent notices are not counted as unread (${unread}/${expected})"
+        else
+            print_error "Silent notices counted as unread, unread=${unread} non-silent notices=${expected}"
+        fi
+    else
+        print_info "Skip unread check - group conversation unread count not available: $(echo "$unread_resp" | jq -r '.message // empty')"
+    fi
+
+    local notice_id=$(echo "$notices" | jq -r '.[0] | (.messageId // .message_id) // empty')
+
+    local recall_resp=$(http_post "${API_BASE}/messages/recall" "{\"message_id\":\"${notice_id}\"}" "$USER1_TOKEN")
+    local recall_code=$(echo "$recall_resp" | jq -r '.code // empty')
+    if [ -n "$recall_code" ] && [ "$recall_code" != "0" ]; then
+        print_success "System message cannot be recalled (code: ${recall_code})"
+    else
+        print_error "System message recall should fail"
+        print_info "Response: $recall_resp"
+    fi
+
+    local pin_resp=$(http_post "${API_BASE}/groups/${GROUP_ID}/pin" "{\"messageId\":\"${notice_id}\"}" "$USER1_TOKEN")
+    if [ "$(echo "$pin_resp" | jq -r '.code')" = "400" ]; then
+        print_success "System message cannot be pinned"
+    else
+        print_error "Pin system message - expected 400"
+        print_info "Response: $pin_resp"
+    fi
+
+    # USER3 was removed in Test 13 and still gets the notice about it
+    if [ -n "$USER3_ID" ] && [ -n "$USER3_TOKEN" ]; then
+        local removed_notices=$(sync_group_system_messages "$USER3_TOKEN" "$GROUP_ID")
+        local removed_count=$(echo "$removed_notices" | jq --arg uid "$USER3_ID" '[.[] | select(.notice.event == "group.member_removed" and ((.notice.user_ids // []) | index($uid)))] | length')
+        if [ "$removed_count" -ge 1 ]; then
+            print_success "Removed member receives the member removed notice"
+        else
+            print_error "Removed member did not receive the member removed notice"
+        fi
+    fi
+}
+
+# Test 23: Dissolve group
 test_dissolve_group() {
-    print_header "Test 18: Dissolve Group"
+    print_header "Test 23: Dissolve Group"
 
     if [ -z "$GROUP_ID" ]; then
         print_error "Skip test - group ID is empty"
@@ -661,6 +1098,11 @@ main() {
     test_get_my_groups
     test_set_group_mute
     test_pin_unpin_message
+    test_group_composite_avatar
+    test_set_group_avatar
+    test_send_restrictions_mute
+    test_send_restrictions_slow_mode
+    test_group_system_messages
     test_dissolve_group
 
     # Print results
@@ -7,6 +7,7 @@
 #   ./test-push-api.sh
 #   GATEWAY_URL=http://localhost:8080 ./test-push-api.sh
 #   PUSH_GRPC=localhost:9008 ./test-push-api.sh
+#   ADMIN_URL=http://localhost:8011 ./test-push-api.sh
 #
 # Notes:
 #   Push service is primarily driven by NATS events with no direct HTTP endpoints.
@@ -14,6 +15,7 @@
 #   1. Push service health check endpoint
 #   2. Direct SendPush gRPC call via grpcurl (if available)
 #   3. After sending messages trigger NATS notification, verify push-service doesn't crash
+#   4. Push log query and stats through the admin API
 #
 
 set -e
@@ -28,6 +30,7 @@ NC='\033[0m'
 GATEWAY_URL="${GATEWAY_URL:-http://localhost:8080}"
 PUSH_HTTP="${PUSH_HTTP:-http://localhost:8008}"
 PUSH_GRPC="${PUSH_GRPC:-localhost:9008}"
+ADMIN_URL="${ADMIN_URL:-http://localhost:8011}"
 API_BASE="${GATEWAY_URL}/api/v1"
 
 # Test data
@@ -39,6 +42,7 @@ TEST_DEVICE_ID="push-test-device-${TIMESTAMP}"
 # Global variables
 USER_TOKEN=""
 USER_ID=""
+ADMIN_TOKEN=""
 HAS_GRPCURL=false
 PASS=0
 FAIL=0
@@ -249,6 +253,138 @@ test_update_push_token_via_gateway() {
     fi
 }
 
+setup_admin() {
+    ADMIN_TOKEN=$(curl -s -X POST "${ADMIN_URL}/api/admin/auth/login" \
+        -H "Content-Type: application/json" \
+        -d '{"username":"admin","password":"Admin@123456"}' | python3 -c "import sys,json; print((json.load(sys.stdin).get('data') or {}).get('token') or '')" 2>/dev/null || true)
+}
+
+test_push_logs_requires_admin() {
+    print_header "Test 6: Push Logs Require Admin Auth"
+
+    local status
+    status=$(curl -s -o /dev/null -w "%{http_code}" "${ADMIN_URL}/api/admin/push/logs")
+    check_http_status "Unauthenticated push log query returns 401" "401" "$status" ""
+}
+
+test_push_logs_by_user() {
+    print_header "Test 7: Query Push Logs by User"
+
+    if [ -z "$ADMIN_TOKEN" ]; then
+        echo "  Skip: admin login failed"
+        return
+    fi
+
+    # Test 4 logged a skipped push and test 5 registered a token, one more push gets a sent/failed log
+    if [ "$HAS_GRPCURL" = true ]; then
+        grpcurl -plaintext \
+            -d "{\"user_ids\":[\"${USER_ID}\"],\"title\":\"Test push\",\"content\":\"Content\",\"push_type\":\"PUSH_TYPE_MESSAGE_NEW\"}" \
+            "${PUSH_GRPC}" anychat.push.PushService/SendPush >/dev/null 2>&1 || true
+    fi
+
+    local body
+    body=$(curl -s "${ADMIN_URL}/api/admin/push/logs?userId=${USER_ID}&startTime=${TIMESTAMP}" \
+        -H "Authorization: Bearer ${ADMIN_TOKEN}")
+    local result
+    result=$(echo "$body" | python3 -c "
+import sys, json
+d = json.load(sys.stdin)
+data = d.get('data') or {}
+logs = data.get('logs') or []
+others = [l for l in logs if l.get('user_id') != '${USER_ID}']
+print('%s %d %d' % (d.get('code'), data.get('total', 0), len(others)))
+" 2>/dev/null || echo "error 0 0")
+    local code total others
+    read -r code total others <<<"$result"
+
+    if [ "$code" != "0" ]; then
+        fail "Push log query" "Response: $body"
+        return
+    fi
+    if [ "$others" = "0" ]; then
+        pass "Push logs filtered by user"
+    else
+        fail "Push log user filter" "${others} logs belong to other users"
+    fi
+    if [ "$HAS_GRPCURL" = false ]; then
+        echo "  Skip log count check: grpcurl not available to trigger pushes"
+    elif [ "$total" -ge 2 ]; then
+        pass "Pushes of this run are logged (${total})"
+    else
+        fail "Push log count" "Expected at least 2 logs, got ${total}"
+    fi
+}
+
+test_push_logs_status_filter() {
+    print_header "Test 8: Query Push Logs by Status"
+
+    if [ -z "$ADMIN_TOKEN" ]; then
+        echo "  Skip: admin login failed"
+        return
+    fi
+
+    local body
+    body=$(curl -s "${ADMIN_URL}/api/admin/push/logs?userId=${USER_ID}&status=4&startTime=${TIMESTAMP}" \
+        -H "Authorization: Bearer ${ADMIN_TOKEN}")
+    local result
+    result=$(echo "$body" | python3 -c "
+import sys, json
+d = json.load(sys.stdin)
+logs = (d.get('data') or {}).get('logs') or []
+print('%s %d %d' % (d.get('code'), len(logs), len([l for l in logs if l.get('status') != 4])))
+" 2>/dev/null || echo "error 0 0")
+    local code count others
+    read -r code count others <<<"$result"
+
+    if [ "$code" = "0" ] && [ "$others" = "0" ]; then
+        pass "Status filter returns only skipped pushes (${count})"
+    else
+        fail "Push log status filter" "Response: $body"
+    fi
+    if [ "$HAS_GRPCURL" = true ] && [ "$count" -lt 1 ]; then
+        fail "Skipped push log" "Push to a user without token should be logged as skipped"
+    fi
+}
+
+test_push_stats() {
+    print_header "Test 9: Push Stats"
+
+    if [ -z "$ADMIN_TOKEN" ]; then
+        echo "  Skip: admin login failed"
+        return
+    fi
+
+    local body
+    body=$(curl -s "${ADMIN_URL}/api/admin/push/stats?userId=${USER_ID}" \
+        -H "Authorization: Bearer ${ADMIN_TOKEN}")
+    local code
+    code=$(echo "$body" | python3 -c "import sys,json; print(json.load(sys.stdin).get('code'))" 2>/dev/null || echo "error")
+    if [ "$code" = "0" ]; then
+        pass "Push stats default range (last 7 days)"
+    else
+        fail "Push stats" "Response: $body"
+    fi
+
+    local start=$((TIMESTAMP - 100 * 86400))
+    body=$(curl -s "${ADMIN_URL}/api/admin/push/stats?startTime=${start}&endTime=${TIMESTAMP}" \
+        -H "Authorization: Bearer ${ADMIN_TOKEN}")
+    code=$(echo "$body" | python3 -c "import sys,json; print(json.load(sys.stdin).get('code'))" 2>/dev/null || echo "error")
+    if [ "$code" != "0" ]; then
+        pass "Stats range over 90 days rejected"
+    else
+        fail "Stats range limit" "Expected error for a 100 day range, response: $body"
+    fi
+
+    body=$(curl -s "${ADMIN_URL}/api/admin/push/stats?startTime=${start}" \
+        -H "Authorization: Bearer ${ADMIN_TOKEN}")
+    code=$(echo "$body" | python3 -c "import sys,json; print(json.load(sys.stdin).get('code'))" 2>/dev/null || echo "error")
+    if [ "$code" != "0" ]; then
+        pass "Stats start time 100 days ago without end time rejected"
+    else
+        fail "Stats range limit" "Expected error for an open-ended 100 day range, response: $body"
+    fi
+}
+
 # ────────────────────────────────────────
 # Summary
 # ────────────────────────────────────────
@@ -282,11 +418,16 @@ echo "Time: $(date '+%Y-%m-%d %H:%M:%S')"
 
 detect_grpcurl
 setup_user
+setup_admin
 
 test_push_service_health
 test_send_push_grpc_missing_users
 test_send_push_grpc_missing_title
 test_send_push_grpc_no_token
 test_update_push_token_via_gateway
+test_push_logs_requires_admin
+test_push_logs_by_user
+test_push_logs_status_filter
+test_push_stats
 
 print_summary
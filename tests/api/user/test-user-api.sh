@@ -26,6 +26,7 @@ ACCESS_TOKEN=""
 USER_ID=""
 ACCESS_TOKEN_2=""
 USER_ID_2=""
+AVATAR_URL=""
 
 # ========================================
 # Setup: Create test users
@@ -417,6 +418,143 @@ EOF
     return 0
 }
 
+# 15. Set avatar from an uploaded image
+test_set_avatar() {
+    print_header "15. Set Avatar from Uploaded Image"
+
+    local source_file=$(mktemp /tmp/test-avatar-XXXXXX.jpg)
+    write_fixture "$TEST_IMAGE_JPEG_BASE64" "$source_file"
+    local file_id
+    file_id=$(upload_test_image "${API_BASE}" "$ACCESS_TOKEN" "$source_file" "avatar-${TIMESTAMP}.jpg") || true
+    rm -f "$source_file"
+    if [ -z "$file_id" ]; then
+        print_error "Upload avatar source image failed"
+        return 1
+    fi
+    print_info "Source image: ${file_id} (400x240)"
+
+    local response=$(http_put "${API_BASE}/users/me/avatar" "{\"file_id\": \"${file_id}\"}" "$ACCESS_TOKEN")
+    print_info "Response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+    AVATAR_URL=$(echo "$response" | jq -r '.data.avatar // empty')
+    if [[ "$AVATAR_URL" != *"/avatar/${USER_ID}/640.jpg?v="* ]]; then
+        print_error "Unexpected avatar URL: ${AVATAR_URL}"
+        return 1
+    fi
+
+    local profile_avatar=$(http_get "${API_BASE}/users/me" "$ACCESS_TOKEN" | jq -r '.data.avatar // empty')
+    if [ "$profile_avatar" != "$AVATAR_URL" ]; then
+        print_error "Profile avatar not updated: ${profile_avatar}"
+        return 1
+    fi
+    print_success "Avatar URL bound to the profile"
+
+    # the avatar bucket is public: no token, no signature
+    local image_file=$(mktemp /tmp/test-avatar-XXXXXX.jpg)
+    local http_code=$(fetch_public "$AVATAR_URL" "$image_file")
+    local large_size=$(image_size "$image_file")
+    local small_url="${AVATAR_URL%%\?*}"
+    small_url="${small_url%/640.jpg}/160.jpg"
+    local small_code=$(fetch_public "$small_url" "$image_file")
+    local small_size=$(image_size "$image_file")
+    rm -f "$image_file"
+    if [ "$http_code" != "200" ] || [ "$small_code" != "200" ]; then
+        print_error "Anonymous avatar download failed (640: HTTP ${http_code}, 160: HTTP ${small_code})"
+        return 1
+    fi
+    print_success "Avatar images readable without authentication"
+
+    if [ -z "$large_size" ]; then
+        print_info "file/identify not installed, skipping the size check"
+    elif [ "$large_size" != "240x240" ] || [ "$small_size" != "160x160" ]; then
+        # the source is never upscaled: 640 keeps the 240x240 crop, 160 is scaled down
+        print_error "Expected 240x240 and 160x160 squares, got ${large_size} and ${small_size}"
+        return 1
+    else
+        print_success "Cropped to a centered square: ${large_size}, ${small_size}"
+    fi
+    return 0
+}
+
+# 16. Invalid avatar sources are rejected
+test_set_avatar_rejected() {
+    print_header "16. Invalid Avatar Sources Rejected"
+
+    local source_file=$(mktemp /tmp/test-avatar-XXXXXX.jpg)
+    write_fixture "$TEST_SMALL_JPEG_BASE64" "$source_file"
+    local small_id
+    small_id=$(upload_test_image "${API_BASE}" "$ACCESS_TOKEN" "$source_file" "small-${TIMESTAMP}.jpg") || true
+    write_fixture "$TEST_IMAGE_JPEG_BASE64" "$source_file"
+    local other_id
+    other_id=$(upload_test_image "${API_BASE}" "$ACCESS_TOKEN_2" "$source_file" "other-${TIMESTAMP}.jpg") || true
+    rm -f "$source_file"
+    if [ -z "$small_id" ] || [ -z "$other_id" ]; then
+        print_error "Upload test images failed"
+        return 1
+    fi
+
+    print_info "48x48 image (below the minimum edge)..."
+    local response=$(http_put "${API_BASE}/users/me/avatar" "{\"file_id\": \"${small_id}\"}" "$ACCESS_TOKEN")
+    print_info "Response: $response"
+    if ! (check_response_fail "$response" && check_fail_code "$response" "400"); then
+        return 1
+    fi
+
+    print_info "Image uploaded by another user..."
+    response=$(http_put "${API_BASE}/users/me/avatar" "{\"file_id\": \"${other_id}\"}" "$ACCESS_TOKEN")
+    print_info "Response: $response"
+    if ! (check_response_fail "$response" && check_fail_code "$response" "400"); then
+        return 1
+    fi
+
+    print_info "External URL through the profile update..."
+    response=$(http_put "${API_BASE}/users/me" '{"avatar": "https://example.com/avatar.jpg"}' "$ACCESS_TOKEN")
+    print_info "Response: $response"
+    if ! (check_response_fail "$response" && check_fail_code "$response" "400"); then
+        return 1
+    fi
+
+    local profile_avatar=$(http_get "${API_BASE}/users/me" "$ACCESS_TOKEN" | jq -r '.data.avatar // empty')
+    if [ -n "$AVATAR_URL" ] && [ "$profile_avatar" != "$AVATAR_URL" ]; then
+        print_error "Rejected request changed the avatar: ${profile_avatar}"
+        return 1
+    fi
+    print_success "Invalid avatar sources rejected, avatar unchanged"
+    return 0
+}
+
+# 17. Clear avatar
+test_clear_avatar() {
+    print_header "17. Clear Avatar"
+
+    if [ -z "$AVATAR_URL" ]; then
+        print_info "No avatar set, skipping"
+        return 0
+    fi
+
+    local response=$(http_put "${API_BASE}/users/me" '{"avatar": ""}' "$ACCESS_TOKEN")
+    print_info "Response: $response"
+    if ! check_response "$response"; then
+        return 1
+    fi
+
+    local profile_avatar=$(http_get "${API_BASE}/users/me" "$ACCESS_TOKEN" | jq -r '.data.avatar // empty')
+    if [ -n "$profile_avatar" ]; then
+        print_error "Avatar not cleared: ${profile_avatar}"
+        return 1
+    fi
+
+    local http_code=$(fetch_public "$AVATAR_URL" /dev/null)
+    if [ "$http_code" = "200" ]; then
+        print_error "Avatar image still served after clearing"
+        return 1
+    fi
+    print_success "Avatar cleared and its images deleted (HTTP ${http_code})"
+    return 0
+}
+
 # ========================================
 # Main function
 # ========================================
@@ -464,6 +602,10 @@ main() {
     test_change_email || ((failed++))
     sleep 1
     test_blacklist_blocks_user_info || ((failed++))
+    sleep 1
+    test_set_avatar || ((failed++))
+    test_set_avatar_rejected || ((failed++))
+    test_clear_avatar || ((failed++))
 
     # Output test results
     echo ""